@@ -12,7 +12,8 @@ if [ -z "$DEST" ]; then
   DEST=x
 fi
 
-EXTRA=$2
+# the loaders call the generated funcs without a context
+EXTRA="--no-context $2"
 
 XOBIN=$(which xo)
 if [ -e ./xo ]; then
@@ -87,6 +88,7 @@ $XOBIN $PGDB -N -M -B -T Table -F PgTables --query-type-comment "$COMMENT" -o $D
 SELECT
   c.relkind::varchar AS type,
   c.relname::varchar AS table_name,
+  COALESCE(obj_description(c.oid, 'pg_class'), '')::varchar AS table_comment,
   false::boolean AS manual_pk
 FROM pg_class c
   JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
@@ -94,21 +96,24 @@ WHERE n.nspname = %%schema string%% AND c.relkind = %%relkind string%%
 ENDSQL
 
 # postgres table column list query
-FIELDS='FieldOrdinal int,ColumnName string,ColumnComment string,DataType string,NotNull bool,DefaultValue sql.NullString,IsPrimaryKey bool'
+FIELDS='FieldOrdinal int,ColumnName string,ColumnComment string,DataType string,NotNull bool,DefaultValue sql.NullString,IsPrimaryKey bool,Extra string'
 COMMENT='Column represents column info.'
 $XOBIN $PGDB -N -M -B -T Column -F PgTableColumns -Z "$FIELDS" --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
 SELECT
   a.attnum::integer AS field_ordinal,
   a.attname::varchar AS column_name,
+  COALESCE(col_description(c.oid, a.attnum), '')::varchar AS column_comment,
   format_type(a.atttypid, a.atttypmod)::varchar AS data_type,
   a.attnotnull::boolean AS not_null,
   COALESCE(pg_get_expr(ad.adbin, ad.adrelid), '')::varchar AS default_value,
-  COALESCE(ct.contype = 'p', false)::boolean AS is_primary_key
+  COALESCE(ct.contype = 'p', false)::boolean AS is_primary_key,
+  (CASE WHEN ic.is_identity = 'YES' THEN 'identity' WHEN ic.is_generated = 'ALWAYS' THEN 'STORED GENERATED' ELSE '' END)::varchar AS extra
 FROM pg_attribute a
   JOIN ONLY pg_class c ON c.oid = a.attrelid
   JOIN ONLY pg_namespace n ON n.oid = c.relnamespace
   LEFT JOIN pg_constraint ct ON ct.conrelid = c.oid AND a.attnum = ANY(ct.conkey) AND ct.contype = 'p'
   LEFT JOIN pg_attrdef ad ON ad.adrelid = c.oid AND ad.adnum = a.attnum
+  LEFT JOIN information_schema.columns ic ON ic.table_schema = n.nspname AND ic.table_name = c.relname AND ic.column_name = a.attname
 WHERE a.attisdropped = false AND n.nspname = %%schema string%% AND c.relname = %%table string%% AND (%%sys bool%% OR a.attnum > 0)
 ORDER BY a.attnum
 ENDSQL
@@ -123,19 +128,32 @@ SELECT
   c.relname::varchar AS ref_table_name,
   d.attname::varchar AS ref_column_name,
   0::integer AS key_id,
-  0::integer AS seq_no,
-  ''::varchar AS on_update,
-  ''::varchar AS on_delete,
+  array_position(r.conkey, b.attnum)::integer AS seq_no,
+  (CASE r.confupdtype WHEN 'r' THEN 'RESTRICT' WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' ELSE 'NO ACTION' END)::varchar AS on_update,
+  (CASE r.confdeltype WHEN 'r' THEN 'RESTRICT' WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' ELSE 'NO ACTION' END)::varchar AS on_delete,
   ''::varchar AS match
 FROM pg_constraint r
   JOIN ONLY pg_class a ON a.oid = r.conrelid
   JOIN ONLY pg_attribute b ON b.attisdropped = false AND b.attnum = ANY(r.conkey) AND b.attrelid = r.conrelid
   JOIN ONLY pg_class i on i.oid = r.conindid
   JOIN ONLY pg_class c on c.oid = r.confrelid
-  JOIN ONLY pg_attribute d ON d.attisdropped = false AND d.attnum = ANY(r.confkey) AND d.attrelid = r.confrelid
+  JOIN ONLY pg_attribute d ON d.attisdropped = false AND d.attnum = r.confkey[array_position(r.conkey, b.attnum)] AND d.attrelid = r.confrelid
   JOIN ONLY pg_namespace n ON n.oid = r.connamespace
 WHERE r.contype = 'f' AND n.nspname = %%schema string%% AND a.relname = %%table string%%
-ORDER BY r.conname, b.attname
+ORDER BY r.conname, seq_no
+ENDSQL
+
+# postgres table check constraint list query
+COMMENT='CheckConstraint represents a check constraint.'
+$XOBIN $PGDB -N -M -B -T CheckConstraint -F PgTableCheckConstraints --query-type-comment "$COMMENT" -o $DEST $EXTRA << ENDSQL
+SELECT
+  r.conname::varchar AS constraint_name,
+  pg_get_constraintdef(r.oid, true)::varchar AS check_clause
+FROM pg_constraint r
+  JOIN ONLY pg_class a ON a.oid = r.conrelid
+  JOIN ONLY pg_namespace n ON n.oid = r.connamespace
+WHERE r.contype = 'c' AND n.nspname = %%schema string%% AND a.relname = %%table string%%
+ORDER BY r.conname
 ENDSQL
 
 # postgres table index list query
@@ -201,7 +219,8 @@ ENDSQL
 # mysql autoincrement list query
 $XOBIN $MYDB -N -M -B -T MyAutoIncrement -F MyAutoIncrements -o $DEST $EXTRA << ENDSQL
 SELECT
-  table_name
+  table_name,
+  IF(table_type = 'VIEW', '', COALESCE(table_comment, '')) AS table_comment
 FROM information_schema.tables
 WHERE auto_increment IS NOT null AND table_schema = %%schema string%%
 ENDSQL
@@ -217,6 +236,15 @@ INNER JOIN information_schema.parameters p
 WHERE r.routine_schema = %%schema string%%
 ENDSQL
 
+# mysql stored procedure list query
+$XOBIN $MYDB -a -N -M -B -T Proc -F MyProcedures -o $DEST $EXTRA << ENDSQL
+SELECT
+  routine_name AS proc_name,
+  'void' AS return_type
+FROM information_schema.routines
+WHERE routine_schema = %%schema string%% AND routine_type = 'PROCEDURE'
+ENDSQL
+
 # mysql proc parameter list query
 $XOBIN $MYDB -a -N -M -B -T ProcParam -F MyProcParams -o $DEST $EXTRA << ENDSQL
 SELECT
@@ -229,7 +257,8 @@ ENDSQL
 # mysql table list query
 $XOBIN $MYDB -a -N -M -B -T Table -F MyTables -o $DEST $EXTRA << ENDSQL
 SELECT
-  table_name
+  table_name,
+  IF(table_type = 'VIEW', '', COALESCE(table_comment, '')) AS table_comment
 FROM information_schema.tables
 WHERE table_schema = %%schema string%% AND table_type = %%relkind string%%
 ENDSQL
@@ -243,7 +272,8 @@ SELECT
   IF(data_type = 'enum', column_name, column_type) AS data_type,
   IF(is_nullable = 'YES', false, true) AS not_null,
   column_default AS default_value,
-  IF(column_key = 'PRI', true, false) AS is_primary_key
+  IF(column_key = 'PRI', true, false) AS is_primary_key,
+  extra
 FROM information_schema.columns
 WHERE table_schema = %%schema string%% AND table_name = %%table string%%
 ORDER BY ordinal_position
@@ -252,12 +282,27 @@ ENDSQL
 # mysql table foreign key list query
 $XOBIN $MYDB -a -N -M -B -T ForeignKey -F MyTableForeignKeys -o $DEST $EXTRA << ENDSQL
 SELECT
-  constraint_name AS foreign_key_name,
-  column_name AS column_name,
-  referenced_table_name AS ref_table_name,
-  referenced_column_name AS ref_column_name
-FROM information_schema.key_column_usage
-WHERE referenced_table_name IS NOT NULL AND table_schema = %%schema string%% AND table_name = %%table string%%
+  k.constraint_name AS foreign_key_name,
+  k.column_name AS column_name,
+  k.referenced_table_name AS ref_table_name,
+  k.referenced_column_name AS ref_column_name,
+  rc.update_rule AS on_update,
+  rc.delete_rule AS on_delete
+FROM information_schema.key_column_usage k
+  JOIN information_schema.referential_constraints rc ON rc.constraint_schema = k.table_schema AND rc.constraint_name = k.constraint_name
+WHERE k.referenced_table_name IS NOT NULL AND k.table_schema = %%schema string%% AND k.table_name = %%table string%%
+ORDER BY k.constraint_name, k.ordinal_position
+ENDSQL
+
+# mysql table check constraint list query
+$XOBIN $MYDB -a -N -M -B -T CheckConstraint -F MyTableCheckConstraints -o $DEST $EXTRA << ENDSQL
+SELECT
+  t.constraint_name,
+  c.check_clause
+FROM information_schema.table_constraints t
+  JOIN information_schema.check_constraints c ON c.constraint_schema = t.constraint_schema AND c.constraint_name = t.constraint_name
+WHERE t.constraint_type = 'CHECK' AND t.table_schema = %%schema string%% AND t.table_name = %%table string%%
+ORDER BY t.constraint_name
 ENDSQL
 
 # mysql table index list query
@@ -299,25 +344,25 @@ ENDSQL
 # sqlite table column list query
 FIELDS='FieldOrdinal int,ColumnName string,DataType string,NotNull bool,DefaultValue sql.NullString,PkColIndex int'
 $XOBIN $SQDB -I -N -M -B -T SqColumn -F SqTableColumns -Z "$FIELDS" -o $DEST $EXTRA << ENDSQL
-PRAGMA table_info(%%table string,interpolate%%)
+PRAGMA table_info(%%table string,interpolate,ident%%)
 ENDSQL
 
 # sqlite table foreign key list query
 FIELDS='KeyID int,SeqNo int,RefTableName string,ColumnName string,RefColumnName string,OnUpdate string,OnDelete string,Match string'
 $XOBIN $SQDB -a -I -N -M -B -T ForeignKey -F SqTableForeignKeys -Z "$FIELDS" -o $DEST $EXTRA << ENDSQL
-PRAGMA foreign_key_list(%%table string,interpolate%%)
+PRAGMA foreign_key_list(%%table string,interpolate,ident%%)
 ENDSQL
 
 # sqlite table index list query
 FIELDS='SeqNo int,IndexName string,IsUnique bool,Origin string,IsPartial bool'
 $XOBIN $SQDB -a -I -N -M -B -T Index -F SqTableIndexes -Z "$FIELDS" -o $DEST $EXTRA << ENDSQL
-PRAGMA index_list(%%table string,interpolate%%)
+PRAGMA index_list(%%table string,interpolate,ident%%)
 ENDSQL
 
 # sqlite index column list query
 FIELDS='SeqNo int,Cid int,ColumnName string'
 $XOBIN $SQDB -a -I -N -M -B -T IndexColumn -F SqIndexColumns -Z "$FIELDS" -o $DEST $EXTRA << ENDSQL
-PRAGMA index_info(%%index string,interpolate%%)
+PRAGMA index_info(%%index string,interpolate,ident%%)
 ENDSQL
 
 # mssql identity table list query
@@ -331,10 +376,12 @@ ENDSQL
 # mssql table list query
 $XOBIN $MSDB -a -N -M -B -T Table -F MsTables -o $DEST $EXTRA << ENDSQL
 SELECT
-  xtype AS type,
-  name AS table_name
-FROM sysobjects
-WHERE SCHEMA_NAME(uid) = %%schema string%% AND xtype = %%relkind string%%
+  o.xtype AS type,
+  o.name AS table_name,
+  COALESCE(CAST(p.value AS nvarchar(4000)), '') AS table_comment
+FROM sysobjects o
+  LEFT JOIN sys.extended_properties p ON p.major_id = o.id AND p.minor_id = 0 AND p.name = 'MS_Description'
+WHERE SCHEMA_NAME(o.uid) = %%schema string%% AND o.xtype = %%relkind string%%
 ENDSQL
 
 # mssql table column list query
@@ -342,6 +389,7 @@ $XOBIN $MSDB -a -N -M -B -T Column -F MsTableColumns -o $DEST $EXTRA << ENDSQL
 SELECT
   c.colid AS field_ordinal,
   c.name AS column_name,
+  COALESCE(CAST(p.value AS nvarchar(4000)), '') AS column_comment,
   TYPE_NAME(c.xtype)+IIF(c.prec > 0, '('+CAST(c.prec AS varchar)+IIF(c.scale > 0,','+CAST(c.scale AS varchar),'')+')', '') as data_type,
   IIF(c.isnullable=1, 0, 1) AS not_null,
   x.text AS default_value,
@@ -350,11 +398,13 @@ SELECT
     FROM sysindexes i
       INNER JOIN sysindexkeys z ON i.id = z.id AND i.indid = z.indid AND z.colid = c.colid
     WHERE i.id = o.id AND i.name = k.name
-  ), 0) > 0, 1, 0) AS is_primary_key
+  ), 0) > 0, 1, 0) AS is_primary_key,
+  (CASE WHEN COLUMNPROPERTY(c.id, c.name, 'IsIdentity') = 1 THEN 'identity' WHEN c.iscomputed = 1 THEN 'computed' ELSE '' END) AS extra
 FROM syscolumns c
   JOIN sysobjects o ON o.id = c.id
   LEFT JOIN sysobjects k ON k.xtype='PK' AND k.parent_obj = o.id
   LEFT JOIN syscomments x ON x.id = c.cdefault
+  LEFT JOIN sys.extended_properties p ON p.major_id = c.id AND p.minor_id = c.colid AND p.name = 'MS_Description'
 WHERE o.type IN('U', 'V') AND SCHEMA_NAME(o.uid) = %%schema string%% AND o.name = %%table string%%
 ORDER BY c.colid
 ENDSQL
@@ -365,8 +415,11 @@ SELECT
   f.name AS foreign_key_name,
   c.name AS column_name,
   o.name AS ref_table_name,
-  x.name AS ref_column_name
+  x.name AS ref_column_name,
+  REPLACE(k.update_referential_action_desc, '_', ' ') AS on_update,
+  REPLACE(k.delete_referential_action_desc, '_', ' ') AS on_delete
 FROM sysobjects f
+  INNER JOIN sys.foreign_keys k ON f.id = k.object_id
   INNER JOIN sysobjects t ON f.parent_obj = t.id
   INNER JOIN sysreferences r ON f.id = r.constid
   INNER JOIN sysobjects o ON r.rkeyid = o.id
@@ -453,7 +506,9 @@ SELECT
   LOWER(a.constraint_name) AS foreign_key_name,
   LOWER(a.column_name) AS column_name,
   LOWER(r.constraint_name) AS ref_index_name,
-  LOWER(r.table_name) AS ref_table_name
+  LOWER(r.table_name) AS ref_table_name,
+  'NO ACTION' AS on_update,
+  c.delete_rule AS on_delete
 FROM all_cons_columns a
   JOIN all_constraints c ON a.owner = c.owner AND a.constraint_name = c.constraint_name
   JOIN all_constraints r ON c.r_owner = r.owner AND c.r_constraint_name = r.constraint_name
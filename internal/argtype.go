@@ -1,6 +1,9 @@
 package internal
 
-import "database/sql"
+import (
+	"database/sql"
+	"regexp"
+)
 
 // ArgType is the type that specifies the command line arguments.
 type ArgType struct {
@@ -44,6 +47,43 @@ type ArgType struct {
 	// handled by xo in the generated code.
 	IgnoreFields []string `arg:"--ignore-fields,help:fields to exclude from the generated Go code types"`
 
+	// Include is a regular expression matching the names of the tables and
+	// views to generate Go types for. When empty, all tables are included.
+	Include string `arg:"--include,help:regexp of table names to generate Go types for"`
+
+	// Exclude is a regular expression matching the names of the tables and
+	// views to skip. Exclude takes precedence over Include.
+	Exclude string `arg:"--exclude,help:regexp of table names to exclude from the generated Go types"`
+
+	// SoftDeleteColumn is the name of the column marking soft deleted rows.
+	// The column's Go type determines how it is used: a bool is a deleted
+	// flag, a time is a deletion timestamp, and any other nullable type (ie,
+	// deleted_by) is set to a caller supplied value.
+	SoftDeleteColumn string `arg:"--soft-delete-column,help:name of the column marking soft deleted rows"`
+
+	// SoftDeleteDefault toggles generated Delete methods to soft delete rows,
+	// generating HardDelete methods for the actual deletes.
+	SoftDeleteDefault bool `arg:"--soft-delete-default,help:make generated Delete methods soft delete rows"`
+
+	// DeleteByIndex toggles generating funcs deleting all the rows matched by
+	// an index (ie, DeleteBooksByAuthorID), soft deleting them when
+	// SoftDeleteDefault is toggled.
+	DeleteByIndex bool `arg:"--delete-by-index,help:generate funcs deleting the rows matched by each index"`
+
+	// VersionColumn is the name of the integer column used for optimistic
+	// locking.
+	VersionColumn string `arg:"--version-column,help:name of the integer column used for optimistic locking"`
+
+	// CreatedAtColumn is the name of the time column set to the current time
+	// by generated Insert methods. None when empty.
+	CreatedAtColumn string `arg:"--created-at-column,help:name of the time column set on insert"`
+
+	// UpdatedAtColumn is the name of the time column set to the current time
+	// by generated Insert methods, and bumped by generated Update methods.
+	// Ignored for columns maintained by the database on update. None when
+	// empty.
+	UpdatedAtColumn string `arg:"--updated-at-column,help:name of the time column set on insert and update"`
+
 	// ForeignKeyMode is the foreign key mode for generating foreign key names.
 	ForeignKeyMode *FkMode `arg:"--fk-mode,-k,help:sets mode for naming foreign key funcs in generated Go code [values: <smart|parent|field|key>]"`
 
@@ -55,6 +95,17 @@ type ArgType struct {
 	// instead of 'authors_title_idx'.
 	UseIndexNames bool `arg:"--use-index-names,-j,help:use index names as defined in schema for generated Go code"`
 
+	// StringEnums toggles backing all enums with their string values
+	// instead of their ordinal, keeping the values of the generated Go
+	// constants when the order of the database enum values changes.
+	StringEnums bool `arg:"--string-enums,help:back generated enums with their string values instead of uint16"`
+
+	// ProcResultSets toggles generating the stored procedures run with CALL
+	// (MySQL), returning their result sets. The result sets are introspected
+	// by calling the procedures with NULL params in a transaction rolled back
+	// afterwards.
+	ProcResultSets bool `arg:"--proc-result-sets,help:generate stored procedures returning result sets introspected by calling them with NULL params in a rolled back transaction"`
+
 	// UseReversedEnumConstNames toggles using reversed enum names.
 	UseReversedEnumConstNames bool `arg:"--use-reversed-enum-const-names,-R,help:use reversed enum names for generated consts in Go code"`
 
@@ -65,6 +116,29 @@ type ArgType struct {
 	// cli args take precedence over stdin.
 	Query string `arg:"-Q,help:query to generate Go type and func from"`
 
+	// QueryDir is the directory of the annotated .sql files to generate the
+	// query types and funcs from, in a single pass (see ParseQueryFile).
+	QueryDir string `arg:"--query-dir,help:directory of annotated .sql files to generate Go types and funcs from"`
+
+	// QueryParamTypes are the types of the query parameters whose type is
+	// not given in the query (ie, declared by the xo:param annotations of a
+	// .sql file).
+	QueryParamTypes map[string]string `arg:"-"`
+
+	// QueryLines are the lines of the .sql file of the lines of the query,
+	// reported by the errors of a malformed query (see ParseQueryFile).
+	QueryLines []int `arg:"-"`
+
+	// QueryUntypedParams are the params of a sqlc query file without an
+	// xo:param annotation, with the SQL type they are cast to (ie, "int8" for
+	// "@id::int8"), or an empty string. Their types are inferred by the
+	// loader.
+	QueryUntypedParams map[string]string `arg:"-"`
+
+	// QueryExec forces generating the query func as the func of a statement
+	// with no result set (ie, by the :exec mode of a sqlc query file).
+	QueryExec bool `arg:"-"`
+
 	// QueryType is the name to give to the Go type generated from the query.
 	QueryType string `arg:"--query-type,-T,help:query's generated Go type"`
 
@@ -83,21 +157,138 @@ type ArgType struct {
 	// QueryInterpolate enables interpolation in generated query.
 	QueryInterpolate bool `arg:"--query-interpolate,-I,help:toggle query interpolation in generated Go code"`
 
+	// QueryInlineLimit enables interpolation of the LIMIT and OFFSET params in
+	// generated query, for the databases that cannot bind them (ie, older
+	// MySQL).
+	QueryInlineLimit bool `arg:"--query-inline-limit,help:toggle interpolating LIMIT and OFFSET params into query in generated Go code"`
+
 	// TypeComment is the type comment for a query.
 	QueryTypeComment string `arg:"--query-type-comment,help:comment for query's generated Go type"`
 
 	// FuncComment is the func comment to provide the named query.
 	QueryFuncComment string `arg:"--query-func-comment,help:comment for query's generated Go func"`
 
+	// QueryDocSQL toggles adding the query to the doc comment of the
+	// generated query func, as a code block.
+	QueryDocSQL bool `arg:"--query-doc-sql,help:toggle adding the query to the doc comment of query's generated Go func"`
+
 	// QueryParamDelimiter is the delimiter for parameterized values for a query.
 	QueryParamDelimiter string `arg:"--query-delimiter,-D,help:delimiter for query's embedded Go parameters"`
 
 	// QueryFields are the fields to scan the result to.
 	QueryFields string `arg:"--query-fields,-Z,help:comma separated list of field names to scan query's results to the query's associated Go type"`
 
+	// QueryEmbed are the tables whose generated Go types are embedded in the
+	// query's Go type, the query selecting the columns of each table in order
+	// (ie, "SELECT o.*, c.* FROM orders o JOIN customers c ...").
+	QueryEmbed string `arg:"--query-embed,help:comma separated list of tables whose generated Go types are embedded in the query's Go type"`
+
+	// QueryMap toggles the generated query code to return the results as maps
+	// keyed by column name, along with the columns in order, instead of as
+	// the query's Go type.
+	QueryMap bool `arg:"--query-map,help:toggle query's generated Go func to return results as maps"`
+
+	// QueryPackage is the sub-package of the output path to generate the
+	// query's Go type and func into (ie, "reports"), along with its own copy
+	// of the xo code, keeping them out of the package of the schema types.
+	QueryPackage string `arg:"--query-package,help:sub-package of the output path to generate query's Go type and func into"`
+
+	// QueryNewType toggles generating the query's Go type even when the
+	// query's columns match the columns of a table, whose Go type is
+	// otherwise returned.
+	QueryNewType bool `arg:"--query-new-type,help:toggle generating query's Go type even when its columns match a table's"`
+
 	// QueryAllowNulls indicates that custom query results can contain null types.
 	QueryAllowNulls bool `arg:"--query-allow-nulls,-U,help:use query column NULL state"`
 
+	// QueryNullFields are the columns of the custom query results that can be
+	// NULL, whatever their inspected NULL state.
+	QueryNullFields string `arg:"--query-null-fields,help:comma separated list of query's result columns that can be NULL"`
+
+	// VerifyQueries toggles preparing the custom queries against the database,
+	// failing on the database error of an invalid query.
+	VerifyQueries bool `arg:"--verify-queries,help:prepare custom queries against the database and fail on invalid SQL"`
+
+	// NoContext disables the context.Context parameter on generated funcs.
+	NoContext bool `arg:"--no-context,help:disable context.Context parameters in generated Go code"`
+
+	// BatchSize is the default number of rows written per statement by the
+	// generated batch funcs.
+	BatchSize int `arg:"--batch-size,help:default number of rows per statement in generated batch funcs"`
+
+	// Repository enables generating a store interface and a XODB backed
+	// repository implementing it for each table.
+	Repository bool `arg:"--repository,help:generate store interfaces and repository implementations for tables"`
+
+	// Mocks enables generating a mock implementing each store interface,
+	// recording its calls. Mocks implies Repository.
+	Mocks bool `arg:"--mocks,help:generate mocks for the store interfaces (implies --repository)"`
+
+	// Sqlx toggles generating code targeting github.com/jmoiron/sqlx: struct
+	// db tags, and sqlx.Get/sqlx.Select for the index funcs instead of
+	// scanning rows. XODB then requires the sqlx query methods.
+	Sqlx bool `arg:"--sqlx,help:generate Go code using github.com/jmoiron/sqlx"`
+
+	// Pgx toggles generating code targeting github.com/jackc/pgx/v5 instead of
+	// database/sql, with XODB satisfied by pgx.Conn, pgxpool.Pool and pgx.Tx,
+	// and nullable columns using the native pgtype types. PostgreSQL only.
+	Pgx bool `arg:"--pgx,help:generate Go code using github.com/jackc/pgx/v5 (PostgreSQL only)"`
+
+	// Generics toggles using the generic query helpers in xo_db.xo.go instead
+	// of generating a Scan loop per func. Requires Go 1.18 or later.
+	Generics bool `arg:"--generics,help:use generic helpers in generated Go code (requires Go 1.18+)"`
+
+	// StmtCache toggles generating XOStmtCache, a XODB reusing prepared
+	// statements for the generated queries.
+	StmtCache bool `arg:"--stmt-cache,help:generate a prepared statement caching XODB"`
+
+	// Retry toggles generating XORetryPolicy, XOWithTxRetry and XORetryDB,
+	// retrying the statements and transactions failing with a deadlock or a
+	// serialization failure. XORetryDB does not retry QueryRow, so it does
+	// not cover the PostgreSQL inserts, updates and upserts (RETURNING
+	// their columns), which need XOWithTxRetry.
+	Retry bool `arg:"--retry,help:generate helpers retrying deadlocked and serialization failed statements (XORetryDB does not retry QueryRow so PostgreSQL writes need XOWithTxRetry)"`
+
+	// ReadWriteSplit toggles generating XOReadWriteDB, a XODB running the
+	// statements of the generated read funcs with a separate handle (ie, a
+	// read replica).
+	ReadWriteSplit bool `arg:"--read-write-split,help:generate a XODB routing the generated reads to a separate handle"`
+
+	// TableNameHook toggles building the table names of the generated sql
+	// statements at runtime with XOTableName (ie, to serve tenant_1_users and
+	// tenant_2_users with the same generated package).
+	TableNameHook bool `arg:"--table-name-hook,help:build the table names of the generated queries at runtime with XOTableName"`
+
+	// SchemaHook toggles building the schema names of the generated sql
+	// statements at runtime with XOSchemaName, the schema set on the context
+	// with XOWithSchema by default (ie, to serve per tenant schemas with the
+	// same generated package).
+	SchemaHook bool `arg:"--schema-hook,help:build the schema names of the generated queries at runtime with XOSchemaName"`
+
+	// Otel toggles starting an OpenTelemetry span in each generated func
+	// running a query, named after the func and with the table and operation
+	// as attributes.
+	Otel bool `arg:"--otel,help:trace generated queries with OpenTelemetry spans"`
+
+	// Metrics toggles calling the XOHook instrumentation hook around the
+	// statements run by each generated func, and generating
+	// XOPrometheusHook, a Prometheus implementation of the hook.
+	Metrics bool `arg:"--metrics,help:generate instrumentation hooks and Prometheus metrics for generated queries"`
+
+	// BinaryUUID indicates BINARY(16) columns are mapped to uuid.UUID, as
+	// configured by the uuid_columns of the methods config file.
+	BinaryUUID bool `arg:"-"`
+
+	// Decimal toggles mapping the NUMERIC and DECIMAL columns to
+	// github.com/shopspring/decimal's Decimal and NullDecimal.
+	Decimal bool `arg:"--decimal,help:map NUMERIC and DECIMAL columns to github.com/shopspring/decimal"`
+
+	// TimeLocation is the name of the location the times scanned by the
+	// generated funcs are normalized to (ie, UTC). The wall clock of the
+	// columns without a time zone (ie, MySQL's DATETIME) is kept, and the
+	// times of the columns with one (ie, MySQL's TIMESTAMP) are converted.
+	TimeLocation string `arg:"--time-location,help:normalize scanned times to the named location (ie UTC)"`
+
 	// EscapeAll toggles escaping schema, table, and column names in SQL queries.
 	EscapeAll bool `arg:"--escape-all,-X,help:escape all names in SQL queries"`
 
@@ -129,6 +320,12 @@ type ArgType struct {
 	// Filename is the output filename, as derived from Out.
 	Filename string `arg:"-"`
 
+	// IncludeRE is the compiled Include expression.
+	IncludeRE *regexp.Regexp `arg:"-"`
+
+	// ExcludeRE is the compiled Exclude expression.
+	ExcludeRE *regexp.Regexp `arg:"-"`
+
 	// LoaderType is the loader type.
 	LoaderType string `arg:"-"`
 
@@ -147,6 +344,10 @@ type ArgType struct {
 	// Generated is the generated proto templates after a run.
 	GeneratedProto []TBuf `arg:"-"`
 
+	// Warnings are the warnings reported after a run (ie, the params
+	// interpolated into the custom queries).
+	Warnings []string `arg:"-"`
+
 	// KnownTypeMap is the collection of known Go types.
 	KnownTypeMap map[string]bool `arg:"-"`
 
@@ -172,6 +373,68 @@ type ArgType struct {
 	// eg. github.com/sundayfun/daycam-server/backend
 	ServerProtoPathPrefix string `arg:"--server-proto-path-prefix"`
 
+	// ProtoLockFile is the file locking the field numbers of the proto
+	// messages, so that the fields keep their numbers when columns are added
+	// or dropped. It is updated with the numbers of the new columns.
+	ProtoLockFile string `arg:"--proto-lock-file,help:file locking the field numbers of proto messages"`
+
+	// ProtoLock are the field numbers of the columns of the proto messages,
+	// read from ProtoLockFile.
+	ProtoLock map[string]map[string]int `arg:"-"`
+
+	// ProtoOptional enables mapping the nullable columns to proto3 optional
+	// scalar fields, instead of the google.protobuf wrapper types.
+	ProtoOptional bool `arg:"--proto-optional,help:toggle proto3 optional fields for nullable columns instead of wrapper types in proto output"`
+
+	// ProtoGoogleTypes enables mapping the DATE and TIME columns to the
+	// google.type.Date and google.type.TimeOfDay messages, instead of
+	// google.protobuf.Timestamp and string.
+	ProtoGoogleTypes bool `arg:"--proto-google-types,help:toggle google.type.Date and google.type.TimeOfDay for DATE and TIME columns in proto output"`
+
+	// ProtoPtypes enables generating the proto conversions against the
+	// deprecated github.com/golang/protobuf ptypes and wrappers packages,
+	// for the services still using them, instead of google.golang.org/protobuf.
+	ProtoPtypes bool `arg:"--proto-ptypes,help:toggle generating proto conversions with the deprecated github.com/golang/protobuf ptypes"`
+
+	// ProtoTests enables generating a test per table converted to a proto
+	// message, converting a model with representative values to its proto
+	// message and back. The tests are written to their own _test.go file.
+	ProtoTests bool `arg:"--proto-tests,help:toggle generating round trip tests of the proto converters"`
+
+	// ProtoServices enables generating a service with the Get, List, Create,
+	// Update and Delete RPCs, and their request and response messages, for
+	// each table with a primary key in the proto output.
+	ProtoServices bool `arg:"--proto-services,help:toggle generating a CRUD service per table in proto output"`
+
+	// ProtoHTTP enables annotating the methods of the services of the proto
+	// output with google.api.http, mapping them to REST (ie, GET
+	// /v1/authors/{id} for GetAuthor) for grpc-gateway. It implies
+	// ProtoServices.
+	ProtoHTTP bool `arg:"--proto-http,help:toggle google.api.http annotations of the CRUD services in proto output"`
+
+	// ProtoServer enables generating the gRPC servers of the services of the
+	// proto output, using the generated funcs and the proto converters of
+	// their table. It implies ProtoServices.
+	ProtoServer bool `arg:"--proto-server,help:toggle generating the gRPC servers of the CRUD services of proto output"`
+
+	// ProtoBuf enables writing the buf.yaml and buf.gen.yaml configs along
+	// the proto output, when missing, and running buf lint and buf generate
+	// on it (or printing the commands when buf is not installed). The proto
+	// packages and fields are also checked against the buf lint naming rules.
+	ProtoBuf bool `arg:"--proto-buf,help:toggle writing buf configs along proto output and running buf lint and buf generate"`
+
+	// ProtoValidate enables annotating the fields of the proto messages with
+	// the protoc-gen-validate rules derived from the schema: the NOT NULL
+	// message fields are required, the strings are limited to the length of
+	// their column, and the check constraints bound the numbers and list the
+	// allowed strings. The generated servers validate the created messages.
+	ProtoValidate bool `arg:"--proto-validate,help:toggle protoc-gen-validate rules derived from the schema constraints in proto output"`
+
+	// ProtoSplit enables writing the message (and service) of each table to
+	// its own proto file, named after the service and the table (ie,
+	// library_author_model.proto), instead of one proto file per service.
+	ProtoSplit bool `arg:"--proto-split,help:toggle writing one proto file per table instead of one per service"`
+
 	Imports map[string][]string `arg:"-"`
 
 	ToPBTypeMap map[string]string `arg:"-"`
@@ -180,6 +443,10 @@ type ArgType struct {
 
 	WrapperTypeMap map[string]string `arg:"-"`
 
+	// OptionalTypeMap are the proto3 optional scalar types of the Go null
+	// types, used instead of the wrapper types with ProtoOptional.
+	OptionalTypeMap map[string]string `arg:"-"`
+
 	ImportMap map[string]string `arg:"-"`
 
 	ConfigTables map[string]struct{} `arg:"-"`
@@ -198,6 +465,9 @@ func NewDefaultArgs() *ArgType {
 		ForeignKeyMode:      &fkMode,
 		QueryParamDelimiter: "%%",
 		NameConflictSuffix:  "Val",
+		BatchSize:           500,
+		SoftDeleteColumn:    "is_deleted",
+		VersionColumn:       "version",
 
 		// KnownTypeMap is the collection of known Go types.
 		KnownTypeMap: map[string]bool{
@@ -278,11 +548,19 @@ func NewDefaultArgs() *ArgType {
 			"sql.NullBool":    "BoolValue",
 		},
 
+		OptionalTypeMap: map[string]string{
+			"sql.NullString":  "string",
+			"sql.NullInt64":   "int64",
+			"sql.NullFloat64": "double",
+			"sql.NullBool":    "bool",
+		},
+
 		ImportMap: map[string]string{
 			"sql.NullString":  "google/protobuf/wrappers.proto",
 			"sql.NullInt64":   "google/protobuf/wrappers.proto",
 			"sql.NullFloat64": "google/protobuf/wrappers.proto",
 			"sql.NullBool":    "google/protobuf/wrappers.proto",
+			"uuid.NullUUID":   "google/protobuf/wrappers.proto",
 			"mysql.NullTime":  "google/protobuf/timestamp.proto",
 			"time.Time":       "google/protobuf/timestamp.proto",
 		},
@@ -293,6 +571,16 @@ func NewDefaultArgs() *ArgType {
 	}
 }
 
+// TableIncluded reports whether the table or view named name passes the
+// Include and Exclude filters.
+func (a *ArgType) TableIncluded(name string) bool {
+	if a.ExcludeRE != nil && a.ExcludeRE.MatchString(name) {
+		return false
+	}
+
+	return a.IncludeRE == nil || a.IncludeRE.MatchString(name)
+}
+
 // Description provides the description for the command output.
 func (a *ArgType) Description() string {
 	return `xo is a command line utility to generate Go code from a database schema.
@@ -102,3 +102,15 @@ func fkName(mode FkMode, fkMap map[string]*ForeignKey, fk *ForeignKey) string {
 func (a *ArgType) ForeignKeyName(fkMap map[string]*ForeignKey, fk *ForeignKey) string {
 	return fkName(*a.ForeignKeyMode, fkMap, fk)
 }
+
+// fkAction normalizes a referential action as reported by the database (ie,
+// "SET_NULL", "cascade", "") to its SQL form (ie, "SET NULL", "CASCADE",
+// "NO ACTION").
+func fkAction(action string) string {
+	action = strings.ToUpper(strings.TrimSpace(strings.Replace(action, "_", " ", -1)))
+	if action == "" {
+		return "NO ACTION"
+	}
+
+	return action
+}
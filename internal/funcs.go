@@ -3,10 +3,13 @@ package internal
 import (
 	"fmt"
 	"log"
+	"path"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/gedex/inflector"
 	"github.com/kenshaw/snaker"
@@ -24,26 +27,99 @@ func (a *ArgType) NewTemplateFuncs() template.FuncMap {
 		"colnamesquery":      a.colnamesquery,
 		"colnamesquerymulti": a.colnamesquerymulti,
 		"colprefixnames":     a.colprefixnames,
+		"colprefixjoin":      a.colprefixjoin,
+		"colprefixquery":     a.colprefixquery,
 		"colvals":            a.colvals,
 		"colvalsmulti":       a.colvalsmulti,
 		"fieldnames":         a.fieldnames,
+		"queryfields":        a.queryfields,
 		"fieldnamesmulti":    a.fieldnamesmulti,
 		"goparamlist":        a.goparamlist,
+		"mapparam":           a.mapparam,
 		"reniltype":          a.reniltype,
 		"retype":             a.retype,
 		"shortname":          a.shortname,
 		"convext":            a.convext,
 		"schema":             a.schemafn,
+		"sqltable":           a.sqltable,
+		"sqldecl":            a.sqldecl,
 		"colname":            a.colname,
+		"colconst":           a.colconst,
+		"structtags":         a.structtags,
+		"embedtags":          a.embedtags,
+		"skiptags":           a.skiptags,
+		"nullimports":        a.nullimports,
+		"scanfield":          a.scanfield,
+		"sqlarg":             a.sqlarg,
+		"sqlparamlist":       a.sqlparamlist,
 		"hascolumn":          a.hascolumn,
 		"hasfield":           a.hasfield,
 		"getstartcount":      a.getstartcount,
+		"updateignore":       a.updateignore,
+		"versionset":         a.versionset,
+		"updatedset":         a.updatedset,
+		"reloadfields":       a.reloadfields,
+		"insertfields":       a.insertfields,
+		"generatedfields":    a.generatedfields,
+		"shardkeyfields":     a.shardkeyfields,
+		"pkfields":           a.pkfields,
+		"wherefields":        a.wherefields,
+		"guardfields":        a.guardfields,
+		"upsertfields":       a.upsertfields,
+		"returningfields":    a.returningfields,
+		"nowvalue":           a.nowvalue,
+		"versioncond":        a.versioncond,
+		"upsertclause":       a.upsertclause,
+		"mergeclause":        a.mergeclause,
+		"nthparam":           a.nthparam,
+		"nthparamgo":         a.nthparamgo,
+		"limitclause":        a.limitclause,
+		"limitclausego":      a.limitclausego,
+		"add":                a.add,
+		"existsquery":        a.existsquery,
+		"lockclause":         a.lockclause,
+		"insertignore":       a.insertignore,
+		"queryargs":          a.queryargs,
+		"queryarg":           a.queryarg,
+		"queryparamdefaults": a.queryparamdefaults,
+		"interpolchecks":     a.interpolchecks,
+		"quoteident":         a.quoteident,
+		"gocomment":          a.gocomment,
+		"gocode":             a.gocode,
+		"softdeletemode":     a.softdeletemode,
+		"softdeletecond":     a.softdeletecond,
+		"softdeletevalue":    a.softdeletevalue,
+		"softdeletedefault":  a.softdeletedefault,
+		"isgeo":              a.isgeo,
+		"isnulltype":         a.isnulltype,
+		"typekind":           a.typekind,
+		"ctxparam":           a.ctxparam,
+		"ctxarg":             a.ctxarg,
+		"dbfn":               a.dbfn,
+		"sqlx":               a.sqlx,
+		"pgx":                a.pgx,
+		"generics":           a.generics,
+		"deletebyindex":      a.deletebyindex,
+		"otel":               a.otel,
+		"metrics":            a.metrics,
+		"protoserver":        a.protoserver,
+		"xooptions":          a.xooptions,
+		"xoinstrument":       a.xoinstrument,
 		"pluralize":          a.pluralize,
+		"repomethod":         a.repomethod,
 		"snaketocamel":       a.snaketocamel,
 		"modelToPB":          a.modelToPB,
 		"PBToModel":          a.PBToModel,
+		"applyfieldmask":     a.applyfieldmask,
+		"maskfields":         a.maskfields,
+		"pbtestfields":       a.pbtestfields,
 		"proto":              a.proto,
-		"GoPackageName":      goPackageName,
+		"protovalidate":      a.protovalidate,
+		"pbname":             pbname,
+		"protoname":          protoname,
+		"pbkeys":             a.pbkeys,
+		"pkindex":            pkindex,
+		"GoPackageName":      a.protogoname,
 	}
 }
 
@@ -138,6 +214,8 @@ func (a *ArgType) shortname(typ string, scopeConflicts ...interface{}) string {
 	// initial conflicts are the default imported packages from
 	// xo_package.go.tpl
 	conflicts := map[string]bool{
+		"context": true,
+		"ctx":     true,
 		"sql":     true,
 		"driver":  true,
 		"csv":     true,
@@ -145,6 +223,8 @@ func (a *ArgType) shortname(typ string, scopeConflicts ...interface{}) string {
 		"fmt":     true,
 		"regexp":  true,
 		"strings": true,
+		"sync":    true,
+		"atomic":  true,
 		"time":    true,
 	}
 
@@ -308,12 +388,17 @@ func (a *ArgType) colnamesgeomulti(fields []*Field, ignoreNames []*Field) string
 // Used to create a list of column names in a WHERE clause (ie, "field_1 = $1
 // AND field_2 = $2 AND ...") or in an UPDATE clause (ie, "field = $1, field =
 // $2, ...").
-func (a *ArgType) colnamesquery(fields []*Field, hasDeletedField bool, sep string, ignoreNames ...string) string {
+//
+// When deleted is a *Type with a soft delete field, or true, the condition
+// excluding soft deleted rows is appended (see softdeletefilter).
+func (a *ArgType) colnamesquery(fields []*Field, deleted interface{}, sep string, ignoreNames ...string) string {
 	ignore := map[string]bool{}
 	for _, n := range ignoreNames {
 		ignore[n] = true
 	}
 
+	col, cond := a.softdeletefilter(deleted)
+
 	str := ""
 	i := 0
 	var skipDeleted bool
@@ -322,7 +407,7 @@ func (a *ArgType) colnamesquery(fields []*Field, hasDeletedField bool, sep strin
 			continue
 		}
 
-		if f.Name == "is_deleted" {
+		if f.Col.ColumnName == col {
 			skipDeleted = true
 		}
 
@@ -337,9 +422,9 @@ func (a *ArgType) colnamesquery(fields []*Field, hasDeletedField bool, sep strin
 		i++
 	}
 
-	if hasDeletedField && !skipDeleted {
+	if cond != "" && !skipDeleted {
 		str += sep
-		str += "is_deleted = false"
+		str += cond
 	}
 
 	return str
@@ -351,12 +436,16 @@ func (a *ArgType) colnamesquery(fields []*Field, hasDeletedField bool, sep strin
 // Used to create a list of column names in a WHERE clause (ie, "field_1 = $1
 // AND field_2 = $2 AND ...") or in an UPDATE clause (ie, "field = $1, field =
 // $2, ...").
-func (a *ArgType) colnamesquerymulti(fields []*Field, hasDeletedField bool, sep string, startCount int, ignoreNames []*Field) string {
+//
+// deleted is handled as in colnamesquery.
+func (a *ArgType) colnamesquerymulti(fields []*Field, deleted interface{}, sep string, startCount int, ignoreNames []*Field) string {
 	ignore := map[string]bool{}
 	for _, f := range ignoreNames {
 		ignore[f.Name] = true
 	}
 
+	col, cond := a.softdeletefilter(deleted)
+
 	str := ""
 	i := startCount
 	var skipDeleted bool
@@ -365,7 +454,7 @@ func (a *ArgType) colnamesquerymulti(fields []*Field, hasDeletedField bool, sep
 			continue
 		}
 
-		if f.Name == "is_deleted" {
+		if f.Col.ColumnName == col {
 			skipDeleted = true
 		}
 
@@ -380,14 +469,35 @@ func (a *ArgType) colnamesquerymulti(fields []*Field, hasDeletedField bool, sep
 		i++
 	}
 
-	if hasDeletedField && !skipDeleted {
+	if cond != "" && !skipDeleted {
 		str += sep
-		str += "is_deleted = false"
+		str += cond
 	}
 
 	return str
 }
 
+// softdeletefilter returns the soft delete column name and the condition
+// excluding soft deleted rows for deleted, which is either a *Type or a bool.
+//
+// A bool is supported for compatibility with older templates passing
+// Type.HasDeletedField, and assumes ArgType.SoftDeleteColumn is a boolean
+// flag.
+func (a *ArgType) softdeletefilter(deleted interface{}) (string, string) {
+	switch d := deleted.(type) {
+	case bool:
+		if d {
+			return a.SoftDeleteColumn, a.SoftDeleteColumn + " = " + a.sqlfalse()
+		}
+	case *Type:
+		if d.SoftDeleteField != nil {
+			return d.SoftDeleteField.Col.ColumnName, a.softdeletecond(d)
+		}
+	}
+
+	return "", ""
+}
+
 // colprefixnames creates a list of the column names found in fields with the
 // supplied prefix, excluding any Field with Name contained in ignoreNames.
 //
@@ -416,6 +526,41 @@ func (a *ArgType) colprefixnames(fields []*Field, prefix string, ignoreNames ...
 	return str
 }
 
+// colprefixjoin creates the condition joining the columns of fields with the
+// supplied prefix to the columns at the same position in refFields with the
+// supplied refPrefix.
+//
+// Used in the ON clause of a JOIN (ie, "j.field_1 = r.ref_1 AND j.field_2 =
+// r.ref_2").
+func (a *ArgType) colprefixjoin(fields []*Field, prefix string, refFields []*Field, refPrefix string) string {
+	str := ""
+	for i, f := range fields {
+		if i != 0 {
+			str = str + " AND "
+		}
+		str = str + prefix + "." + a.colname(f.Col) + " = " + refPrefix + "." + a.colname(refFields[i].Col)
+	}
+
+	return str
+}
+
+// colprefixquery creates a list of the column names found in fields with the
+// supplied prefix and their value place holders, joined by sep.
+//
+// Used in a WHERE clause of a query having multiple tables (ie, "t.field_1 =
+// $1 AND t.field_2 = $2 AND ...").
+func (a *ArgType) colprefixquery(fields []*Field, prefix string, sep string) string {
+	str := ""
+	for i, f := range fields {
+		if i != 0 {
+			str = str + sep
+		}
+		str = str + prefix + "." + a.colname(f.Col) + " = " + a.Loader.NthParam(i)
+	}
+
+	return str
+}
+
 // colvals creates a list of value place holders for fields excluding any Field
 // with Name contained in ignoreNames.
 //
@@ -482,6 +627,8 @@ func (a *ArgType) colvalsmulti(fields []*Field, ignoreNames []*Field) string {
 
 // fieldnames creates a list of field names from fields of the adding the
 // provided prefix, and excluding any Field with Name contained in ignoreNames.
+// A prefix starting with & creates a list of scan destinations (see
+// scanfield).
 //
 // Used to present a comma separated list of field names, ie in a Go statement
 // (ie, "t.Field1, t.Field2, t.Field3 ...")
@@ -501,13 +648,85 @@ func (a *ArgType) fieldnames(fields []*Field, prefix string, ignoreNames ...stri
 		if i != 0 {
 			str = str + ", "
 		}
-		str = str + prefix + "." + f.Name
+		if strings.HasPrefix(prefix, "&") {
+			str = str + a.scanfield(prefix[1:], f)
+		} else {
+			str = str + a.sqlarg(prefix+"."+f.Name, f)
+		}
 		i++
 	}
 
 	return str
 }
 
+// queryfields returns the pointers to the fields of the variable v of the query
+// type t scanned by the query, in order, including the fields of its embedded
+// table types (ie, "&v.Order.ID, &v.Customer.ID").
+func (a *ArgType) queryfields(t *Type, v string) string {
+	if len(t.Embeds) == 0 {
+		return a.fieldnames(t.Fields, "&"+v)
+	}
+
+	var fields []string
+	for _, e := range t.Embeds {
+		fields = append(fields, a.fieldnames(e.Fields, "&"+v+"."+e.Name))
+	}
+
+	return strings.Join(fields, ", ")
+}
+
+// sqlarg returns the Go expression passing expr, the value of the field f, as
+// a query argument: the bytes of the uuid of a BINARY(16) column, and expr
+// otherwise.
+func (a *ArgType) sqlarg(expr string, f *Field) string {
+	if !strings.HasPrefix(f.Type, "uuid.") || !strings.HasPrefix(strings.ToLower(f.Col.DataType), "binary") {
+		return expr
+	}
+
+	if f.Type == "uuid.NullUUID" {
+		return "xoNullUUIDBytes(" + expr + ")"
+	}
+	if strings.HasPrefix(expr, "*") {
+		expr = "(" + expr + ")"
+	}
+
+	return expr + "[:]"
+}
+
+// scanfield returns the scan destination of the field f of prefix (ie,
+// "&t.Field"). The times are scanned through xoTime when
+// ArgType.TimeLocation is set.
+func (a *ArgType) scanfield(prefix string, f *Field) string {
+	expr := prefix + "." + f.Name
+	if a.TimeLocation == "" || a.typekind(f) != "time" {
+		return "&" + expr
+	}
+
+	// the wall clock of the columns without a time zone is kept
+	dt, wall := strings.ToLower(f.Col.DataType), true
+	switch {
+	case strings.Contains(dt, "without time zone"):
+	case strings.Contains(dt, "with time zone"), strings.Contains(dt, "offset"),
+		a.LoaderType == "mysql" && strings.HasPrefix(dt, "timestamp"):
+		wall = false
+	}
+
+	switch {
+	case f.Type == "time.Time":
+		return fmt.Sprintf("xoTime{&%s, nil, %t}", expr, wall)
+	case nullTimeTypes[a.basenulltype(f.Type)]:
+		field := a.nullvaluefield(f.Type)
+		if field == "" {
+			field = "Time"
+		}
+		return fmt.Sprintf("xoTime{&%s.%s, &%s.Valid, %t}", expr, field, expr, wall)
+	}
+
+	// the other types embedding a time.Time have no Valid field (ie,
+	// xoutil.SqTime)
+	return fmt.Sprintf("xoTime{&%s.Time, nil, %t}", expr, wall)
+}
+
 // fieldnamesmulti creates a list of field names from fields of the adding the
 // provided prefix, and excluding any Field with the slice contained in ignoreNames.
 //
@@ -529,7 +748,7 @@ func (a *ArgType) fieldnamesmulti(fields []*Field, prefix string, ignoreNames []
 		if i != 0 {
 			str = str + ", "
 		}
-		str = str + prefix + "." + f.Name
+		str = str + a.sqlarg(prefix+"."+f.Name, f)
 		i++
 	}
 
@@ -609,6 +828,46 @@ var goReservedNames = map[string]string{
 	"complex128": "c128",
 }
 
+// goparamname converts the field name into the name of its Go parameter (ie,
+// "userID" for "UserID"), substituting the Go reserved names by their value in
+// goReservedNames.
+func (a *ArgType) goparamname(name string) string {
+	n := strings.Split(snaker.CamelToSnake(name), "_")
+	s := strings.ToLower(n[0]) + name[len(n[0]):]
+
+	// check go reserved names
+	if r, ok := goReservedNames[strings.ToLower(s)]; ok {
+		s = r
+	}
+
+	// avoid shadowing the context and options params, and the locals
+	// declared by the prologue of the generated funcs
+	if (s == "ctx" && !a.NoContext) || s == "opts" || s == "cancel" || s == "span" {
+		s = s + a.NameConflictSuffix
+	}
+
+	return s
+}
+
+// mapparam returns the name of the slice param of the map func of ix, or of
+// its elements when elem is true, after the field of its index (ie, "emails"
+// or "email"). The names of the locals of the func get
+// ArgType.NameConflictSuffix.
+func (a *ArgType) mapparam(ix *Index, elem bool) string {
+	name := a.pluralize(ix.MapField.Name)
+	if elem {
+		name = ix.MapField.Name
+	}
+
+	s := a.goparamname(name)
+	switch s {
+	case "res", "n", "in", "args", "i", "sqlstr", "q", "rows", "err":
+		s += a.NameConflictSuffix
+	}
+
+	return s
+}
+
 // goparamlist converts a list of fields into their named Go parameters,
 // skipping any Field with Name contained in ignoreNames. addType will cause
 // the go Type to be added after each variable name. addPrefix will cause the
@@ -636,13 +895,7 @@ func (a *ArgType) goparamlist(fields []*Field, addPrefix bool, addType bool, ign
 
 		s := "v" + strconv.Itoa(i)
 		if len(f.Name) > 0 {
-			n := strings.Split(snaker.CamelToSnake(f.Name), "_")
-			s = strings.ToLower(n[0]) + f.Name[len(n[0]):]
-		}
-
-		// check go reserved names
-		if r, ok := goReservedNames[strings.ToLower(s)]; ok {
-			s = r
+			s = a.goparamname(f.Name)
 		}
 
 		// add the go type
@@ -665,6 +918,27 @@ func (a *ArgType) goparamlist(fields []*Field, addPrefix bool, addType bool, ign
 	return str
 }
 
+// sqlparamlist is goparamlist without the types, passing the params as query
+// arguments (see sqlarg).
+func (a *ArgType) sqlparamlist(fields []*Field, addPrefix bool) string {
+	str := a.goparamlist(fields, false, false)
+	if str == "" {
+		return ""
+	}
+
+	params := strings.Split(str, ", ")
+	for i, f := range fields {
+		params[i] = a.sqlarg(params[i], f)
+	}
+
+	str = strings.Join(params, ", ")
+	if addPrefix {
+		return ", " + str
+	}
+
+	return str
+}
+
 // convext generates the Go conversion for f in order for it to be assignable
 // to t.
 //
@@ -676,9 +950,9 @@ func (a *ArgType) convext(prefix string, f *Field, t *Field) string {
 	}
 
 	ft := f.Type
-	if strings.HasPrefix(ft, "sql.Null") {
-		expr = expr + "." + f.Type[8:]
-		ft = strings.ToLower(f.Type[8:])
+	if v := a.nullvaluefield(ft); v != "" {
+		expr = expr + "." + v
+		ft = strings.ToLower(v)
 	}
 
 	if t.Type != ft {
@@ -713,6 +987,52 @@ func (a *ArgType) schemafn(s string, names ...string) string {
 	return s + n
 }
 
+// sqltable returns the table name used in a generated sql statement. When
+// ArgType.TableNameHook is toggled, the name is built at runtime by XOTableName
+// (ie, "` + XOTableName(ctx, "users") + `"), and when ArgType.SchemaHook is
+// toggled, the schema by XOSchemaName. Otherwise it is the same as schemafn.
+func (a *ArgType) sqltable(s, table string) string {
+	if !a.TableNameHook && !a.SchemaHook {
+		return a.schemafn(s, table)
+	}
+
+	n := a.sqlname(table, "XOTableName", TableEsc, a.EscapeTableNames, a.TableNameHook)
+	if s == "" {
+		return n
+	}
+
+	return a.sqlname(s, "XOSchemaName", SchemaEsc, a.EscapeSchemaName, a.SchemaHook) + "." + n
+}
+
+// sqlname returns name, built at runtime by the hook func when hook is true,
+// and escaped when esc is true.
+func (a *ArgType) sqlname(name, fn string, typ EscType, esc, hook bool) string {
+	if !hook {
+		if esc {
+			return a.Loader.Escape(typ, name)
+		}
+		return name
+	}
+
+	n := "` + " + fn + "(" + a.ctxarg() + strconv.Quote(name) + ") + `"
+	if esc {
+		n = strings.Replace(a.Loader.Escape(typ, "\x00"), "\x00", n, 1)
+	}
+
+	return n
+}
+
+// sqldecl returns the declaration of the sql statement name, a const unless
+// ArgType.TableNameHook or ArgType.SchemaHook is toggled, in which case the
+// statement is only known at runtime.
+func (a *ArgType) sqldecl(name string) string {
+	if a.TableNameHook || a.SchemaHook {
+		return name + " :="
+	}
+
+	return "const " + name + " ="
+}
+
 // colname returns the ColumnName of col, optionally escaping it if
 // ArgType.EscapeColumnNames is toggled.
 func (a *ArgType) colname(col *models.Column) string {
@@ -723,6 +1043,17 @@ func (a *ArgType) colname(col *models.Column) string {
 	return col.ColumnName
 }
 
+// colconst returns the Go expression for the column name of f used in the
+// generated SQL: the generated column name constant of typ, or a quoted
+// string when the column name is escaped.
+func (a *ArgType) colconst(typ *Type, f *Field) string {
+	if a.EscapeColumnNames {
+		return fmt.Sprintf("%q", a.colname(f.Col))
+	}
+
+	return typ.Name + "Col" + f.Name
+}
+
 // hascolumn takes a list of fields and determines if field with the specified
 // column name is in the list.
 func (a *ArgType) hascolumn(fields []*Field, name string) bool {
@@ -752,253 +1083,2637 @@ func (a *ArgType) getstartcount(fields []*Field, pkFields []*Field) int {
 	return len(fields) - len(pkFields)
 }
 
-func (a *ArgType) pluralize(name string) string {
-	return inflector.Pluralize(name)
+// updateignore returns the fields of t that should be excluded from the SET
+// clause of a generated UPDATE statement: the primary key fields, any fields
+// maintained or generated by the database, and the version, audit and guard
+// fields.
+//
+// Used with colnamesquerymulti, fieldnamesmulti and friends.
+func (a *ArgType) updateignore(t *Type) []*Field {
+	ignore := make([]*Field, 0, len(t.PrimaryKeyFields)+len(t.AutoUpdateFields)+4)
+	ignore = append(ignore, t.PrimaryKeyFields...)
+	ignore = append(ignore, t.AutoUpdateFields...)
+	ignore = append(ignore, a.generatedfields(t)...)
+	for _, f := range []*Field{t.VersionField, t.CreatedAtField, t.UpdatedAtField, t.GuardField} {
+		if f != nil {
+			ignore = append(ignore, f)
+		}
+	}
+
+	return ignore
 }
 
-func (a *ArgType) snaketocamel(name string) string {
-	return snaker.ForceLowerCamelIdentifier(name)
+// reloadfields returns the fields of t changed by the database on update,
+// that are reloaded after a generated UPDATE statement.
+func (a *ArgType) reloadfields(t *Type) []*Field {
+	fields := append([]*Field{}, t.AutoUpdateFields...)
+	if t.UpdatedAtField != nil {
+		fields = append(fields, t.UpdatedAtField)
+	}
+	for _, f := range a.generatedfields(t) {
+		if !f.AutoUpdate && f != t.UpdatedAtField {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
 }
 
-func (a *ArgType) modelToPB(option *MethodsOption) string {
-	if !option.ModelToPB {
-		return ""
+// generatedfields returns the fields of t generated by the database, other
+// than its primary key fields.
+func (a *ArgType) generatedfields(t *Type) []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.IsGenerated && !f.Col.IsPrimaryKey {
+			fields = append(fields, f)
+		}
 	}
-	var body, shortName string
 
-	fieldsAssignment := make([]string, 0, len(option.Type.Fields))
+	return fields
+}
 
-	shortName = a.shortname(option.Type.Name)
+// shardkeyfields returns the shard key field of t when its queries require it
+// (see ArgType.crossShard), or nil.
+//
+// Used with goparamlist to add the shard key param to the generated funcs of
+// t not filtering by an index (ie, the Where funcs).
+func (a *ArgType) shardkeyfields(t *Type) []*Field {
+	if !a.crossShard(t, nil, "") {
+		return nil
+	}
 
-	for _, field := range option.Type.Fields {
-		if _, ok := option.ModelToPBConfig.SkipFields[field.Col.ColumnName]; ok {
-			continue
-		}
-		if !field.Col.NotNull && field.Type != "[]byte" {
-			continue
+	return []*Field{t.ShardKeyField}
+}
+
+// pkfields returns the fields identifying a row of t in the generated funcs
+// taking its primary key: the primary key fields, preceded by the shard key of
+// t when it is not part of the primary key, and by the guard field of t.
+func (a *ArgType) pkfields(t *Type) []*Field {
+	for _, f := range t.PrimaryKeyFields {
+		if f == t.ShardKeyField {
+			return guardFirst(t, shardKeyFirst(t, t.PrimaryKeyFields))
 		}
-		var f, fa string
-		if field.Type == "time.Time" {
-			f = snaker.ForceLowerCamelIdentifier(field.Col.ColumnName)
-			body = body + fmt.Sprintf(
-				`%s, err := ptypes.TimestampProto(%s.%s)
-	if err != nil {
-		return nil, err
 	}
-`, f, shortName, field.Name)
-			fa = fmt.Sprintf(`%s:%s,`, SnakeToCamelWithoutInitialisms(field.Col.ColumnName), f)
-		} else {
-			if t, ok := a.ToPBTypeMap[field.Type]; ok && !a.IncompatilbePBType[t] {
-				fa = fmt.Sprintf(`%s:%s(%s.%s),`, SnakeToCamelWithoutInitialisms(field.Col.ColumnName),
-					t, shortName, field.Name)
-			} else {
-				fa = fmt.Sprintf(`%s:%s.%s,`, SnakeToCamelWithoutInitialisms(field.Col.ColumnName),
-					shortName, field.Name)
-			}
-		}
-		fieldsAssignment = append(fieldsAssignment, fa)
+
+	return guardFirst(t, append(a.shardkeyfields(t), t.PrimaryKeyFields...))
+}
+
+// wherefields returns the fields of the conditions of the generated UPDATE and
+// DELETE statements of a row of t: the primary key fields, followed by the
+// guard field of t.
+func (a *ArgType) wherefields(t *Type) []*Field {
+	if !guarded(t, t.PrimaryKeyFields) {
+		return t.PrimaryKeyFields
 	}
-	body = body + fmt.Sprintf(
-		`proto%s := &%s.%s{
-	%s
+
+	return append(t.PrimaryKeyFields[:len(t.PrimaryKeyFields):len(t.PrimaryKeyFields)], t.GuardField)
 }
-`, option.Type.Name, goPackageName(option.ModelToPBConfig.ImportService), option.Type.Name, strings.Join(fieldsAssignment, "\n"))
-	for _, field := range option.Type.Fields {
-		if field.Col.NotNull || field.Type == "[]byte" {
-			continue
-		}
-		if _, ok := option.ModelToPBConfig.SkipFields[field.Col.ColumnName]; ok {
-			continue
+
+// guardfields returns the guard field of t, or nil when t has none.
+//
+// Used with goparamlist to add the guard param to the generated funcs of t not
+// filtering by an index (ie, the Where funcs).
+func (a *ArgType) guardfields(t *Type) []*Field {
+	if t.GuardField == nil {
+		return nil
+	}
+
+	return []*Field{t.GuardField}
+}
+
+// insertfields returns the fields of t provided by a generated INSERT
+// statement, excluding the fields generated by the database.
+func (a *ArgType) insertfields(t *Type) []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if !f.IsGenerated {
+			fields = append(fields, f)
 		}
-		var s, fa string
-		s = SnakeToCamelWithoutInitialisms(field.Col.ColumnName)
-		if field.Type == "mysql.NullTime" {
-			f := snaker.ForceLowerCamelIdentifier(field.Col.ColumnName)
-			fa = fmt.Sprintf(
-				`if %s.%s.Valid {
-	%s, err := ptypes.TimestampProto(%s.%s.Time)
-	if err != nil {
-		return nil, err
 	}
-	proto%s.%s = %s
+
+	return fields
 }
-`, shortName, field.Name,
-				f, shortName, field.Name,
-				option.Type.Name, s, f)
-		} else if typ, ok := a.WrapperTypeMap[field.Type]; ok {
-			fa = fmt.Sprintf(
-				`if %s.%s.Valid {
-	proto%s.%s = &wrappers.%s{Value:%s.%s.%s}
+
+// upsertfields returns the fields of t provided by a generated upsert: the
+// primary key fields, and the fields not generated by the database.
+func (a *ArgType) upsertfields(t *Type) []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if !f.IsGenerated || f.Col.IsPrimaryKey {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
 }
-`, shortName, field.Name, option.Type.Name, s, typ, shortName, field.Name, strings.TrimSuffix(typ, "Value"))
-		} else {
-			log.Printf("WARN: %s.%s could be null, skipping!", option.Type.Name, field.Name)
+
+// returningfields returns the fields of t returned by a generated INSERT
+// statement: the fields generated by the database, or the primary key when
+// there are none.
+func (a *ArgType) returningfields(t *Type) []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.IsGenerated {
+			fields = append(fields, f)
 		}
-		body = body + fa
+	}
+	if len(fields) == 0 {
+		return []*Field{t.PrimaryKey}
 	}
 
-	body = body + fmt.Sprintf("\nreturn proto%s, nil", option.Type.Name)
-	return body
+	return fields
 }
 
-func (a *ArgType) PBToModel(option *MethodsOption) string {
-	if !option.ModelToPB {
+// versionset returns the SET clause item incrementing the version field of t
+// prefixed with ", ", or an empty string when t has no version field.
+func (a *ArgType) versionset(t *Type) string {
+	if t.VersionField == nil {
 		return ""
 	}
-	var body, shortName string
 
-	fieldsAssignment := make([]string, 0, len(option.Type.Fields))
+	col := a.colname(t.VersionField.Col)
+	return ", " + col + " = " + col + " + 1"
+}
 
-	shortName = a.shortname(option.Type.Name)
+// updatedset returns the SET clause item bumping the updated at field of t to
+// the current time prefixed with ", ", or an empty string when t has no
+// updated at field.
+func (a *ArgType) updatedset(t *Type) string {
+	if t.UpdatedAtField == nil {
+		return ""
+	}
 
-	for _, field := range option.Type.Fields {
-		if _, ok := option.ModelToPBConfig.SkipFields[field.Col.ColumnName]; ok {
-			continue
-		}
-		if !field.Col.NotNull && field.Type != "[]byte" {
-			continue
+	return ", " + a.colname(t.UpdatedAtField.Col) + " = CURRENT_TIMESTAMP"
+}
+
+// nullTimeTypes are the nullable time types with a Valid field, including
+// the pgtype time types of --pgx.
+var nullTimeTypes = map[string]bool{
+	"sql.NullTime":       true,
+	"mysql.NullTime":     true,
+	"pq.NullTime":        true,
+	"pgtype.Date":        true,
+	"pgtype.Timestamp":   true,
+	"pgtype.Timestamptz": true,
+}
+
+// nowvalue returns the Go expression setting the time field f to the time
+// value now (ie, now, sql.NullTime{Time: now, Valid: true}, or
+// xoutil.SqTime{Time: now} for the other types embedding a time.Time).
+func (a *ArgType) nowvalue(f *Field, now string) string {
+	switch {
+	case f.Type == "time.Time":
+		return now
+	case nullTimeTypes[a.basenulltype(f.Type)]:
+		field := a.nullvaluefield(f.Type)
+		if field == "" {
+			field = "Time"
 		}
-		var f, fa string
-		if field.Type == "time.Time" {
-			f = snaker.ForceLowerCamelIdentifier(field.Col.ColumnName)
-			body = body + fmt.Sprintf(
-				`%s, err := ptypes.Timestamp(proto%s.%s)
-	if err != nil {
-		return nil, err
+		return f.Type + "{" + field + ": " + now + ", Valid: true}"
 	}
-`, f, option.Type.Name, field.Name)
-			fa = fmt.Sprintf(`%s:%s,`, field.Name, f)
 
-		} else if t, ok := a.ToPBTypeMap[field.Type]; ok && !a.IncompatilbePBType[t] {
-			fa = fmt.Sprintf(`%s:%s(proto%s.%s),`, field.Name, field.Type, option.Type.Name,
-				SnakeToCamelWithoutInitialisms(field.Col.ColumnName))
-		} else {
-			fa = fmt.Sprintf(`%s:proto%s.%s,`, field.Name, option.Type.Name,
-				SnakeToCamelWithoutInitialisms(field.Col.ColumnName))
-		}
-		fieldsAssignment = append(fieldsAssignment, fa)
+	return f.Type + "{Time: " + now + "}"
+}
+
+// versioncond returns the WHERE condition matching the version field of t
+// against the 0-based param i prefixed with " AND ", or an empty string when t
+// has no version field.
+func (a *ArgType) versioncond(t *Type, i int) string {
+	if t.VersionField == nil {
+		return ""
 	}
 
-	body = body + fmt.Sprintf(
-		`%s := &%s{
-	%s
+	return " AND " + a.colname(t.VersionField.Col) + " = " + a.Loader.NthParam(i)
 }
-`, shortName, option.Type.Name, strings.Join(fieldsAssignment, "\n"))
 
-	for _, field := range option.Type.Fields {
-		if _, ok := option.ModelToPBConfig.SkipFields[field.Col.ColumnName]; ok {
-			continue
-		}
-		if field.Col.NotNull || field.Type == "[]byte" {
-			continue
-		}
-		var s, fa string
-		s = SnakeToCamelWithoutInitialisms(field.Col.ColumnName)
-		if field.Type == "mysql.NullTime" {
-			f := snaker.ForceLowerCamelIdentifier(field.Col.ColumnName)
-			fa = fmt.Sprintf(
-				`if proto%s.%s != nil {
-	%s, err := ptypes.Timestamp(proto%s.%s)
-	if err != nil {
-		return nil, err
+// nthparam returns the loader's placeholder for the 0-based param i.
+func (a *ArgType) nthparam(i int) string {
+	return a.Loader.NthParam(i)
+}
+
+// limitclause returns the loader's LIMIT clause, using the 0-based param i for
+// the limit and, when offset is toggled, param i+1 for the offset.
+func (a *ArgType) limitclause(i int, offset bool) string {
+	if !offset {
+		return a.Loader.Limit(a.Loader.NthParam(i), "")
 	}
-	%s.%s = mysql.NullTime{Time:%s, Valid:true}
+
+	return a.Loader.Limit(a.Loader.NthParam(i), a.Loader.NthParam(i+1))
 }
-`, option.Type.Name, s,
-				f, option.Type.Name, s,
-				shortName, field.Name, f)
 
-		} else if typ, ok := a.WrapperTypeMap[field.Type]; ok {
-			fa = fmt.Sprintf(
-				`if proto%s.%s != nil {
-	%s.%s = %s{%s:proto%s.%s.Value, Valid:true}
+// limitclausego returns a Go expression evaluating to the loader's LIMIT
+// clause, using the 0-based param index given by the Go expression expr for the
+// limit, and the next param for the offset.
+//
+// Used where the number of params is only known at runtime.
+func (a *ArgType) limitclausego(expr string) string {
+	clause := a.Loader.Limit("\x00", "\x01")
+	s := "`" + strings.NewReplacer(
+		"\x00", "` + "+a.nthparamgo(expr)+" + `",
+		"\x01", "` + "+a.nthparamgo(expr+"+1")+" + `",
+	).Replace(clause) + "`"
+
+	return strings.TrimSuffix(strings.TrimPrefix(s, "`` + "), " + ``")
 }
-`, option.Type.Name, s, shortName, field.Name, field.Type, strings.TrimSuffix(typ, "Value"), option.Type.Name, s)
-		} else {
-			log.Printf("WARN: %s.%s could be null, skipping!", option.Type.Name, field.Name)
-		}
-		body = body + fa
+
+// softdeletemode returns how the soft delete field of t is set: "flag" for a
+// bool, "time" for a deletion timestamp, or "by" for any other type (ie,
+// deleted_by) that is set to a caller supplied value. Returns an empty string
+// when t has no soft delete field.
+func (a *ArgType) softdeletemode(t *Type) string {
+	f := t.SoftDeleteField
+	switch {
+	case f == nil:
+		return ""
+	case f.Type == "bool":
+		return "flag"
+	case strings.HasSuffix(f.Type, "Time"), strings.HasPrefix(f.Type, "pgtype.Timestamp"), f.Type == "pgtype.Date":
+		return "time"
 	}
 
-	body = body + fmt.Sprintf("\nreturn %s, nil", shortName)
-	return body
+	return "by"
 }
 
-func (a *ArgType) proto(pc ProtoConfig) string {
-	if len(pc) == 0 {
+// softdeletecond returns the condition matching rows of t that have not been
+// soft deleted (ie, "is_deleted = false" or "deleted_at IS NULL").
+func (a *ArgType) softdeletecond(t *Type) string {
+	switch a.softdeletemode(t) {
+	case "":
 		return ""
+	case "flag":
+		return a.colname(t.SoftDeleteField.Col) + " = " + a.sqlfalse()
 	}
-	sort.Stable(pc)
 
-	svc := pc[0].ModelToPBConfig.ImportService
-	var body string
+	return a.colname(t.SoftDeleteField.Col) + " IS NULL"
+}
 
-	imports := make([]string, 0)
-	m := make(map[string]struct{}, 0)
-	for _, p := range pc {
-		for _, f := range p.Type.Fields {
-			if _, ok := p.ModelToPBConfig.SkipFields[f.Col.ColumnName]; ok {
-				continue
-			}
-			if i, ok := a.ImportMap[f.Type]; ok {
-				if _, ok = m[i]; ok {
-					continue
-				}
-				m[i] = struct{}{}
-				imports = append(imports, fmt.Sprintf(`import "%s";`, i))
-			}
-		}
+// sqlfalse returns the SQL literal of a false boolean flag, which is 0 for
+// the databases without a boolean literal (ie, SQL Server's BIT, Oracle's
+// NUMBER(1)).
+func (a *ArgType) sqlfalse() string {
+	switch a.LoaderType {
+	case "mssql", "ora", "oci8":
+		return "0"
 	}
 
-	body = body + fmt.Sprintf(
-		`package proto.%s;
+	return "false"
+}
 
-%s
+// softdeletevalue returns the Go expression for the value the soft delete
+// field of t is set to when soft deleting a row. by is the name of the caller
+// supplied value.
+func (a *ArgType) softdeletevalue(t *Type, by string) string {
+	switch a.softdeletemode(t) {
+	case "flag":
+		return "true"
+	case "time":
+		return a.nowvalue(t.SoftDeleteField, "time.Now()")
+	}
 
-option go_package = "%s/service/%s";
-option java_multiple_files = true;
-option objc_class_prefix = "RPC";
+	return by
+}
 
-`, ProtoName(svc), strings.Join(imports, "\n"), a.ServerProtoPathPrefix, goPackageName(svc))
+// softdeletedefault determines if the generated Delete method of t soft
+// deletes rows.
+func (a *ArgType) softdeletedefault(t *Type) bool {
+	mode := a.softdeletemode(t)
+	return a.SoftDeleteDefault && (mode == "flag" || mode == "time")
+}
 
-	for _, p := range pc {
-		fieldsDef := make([]string, 0, len(p.Type.Fields))
-		count := 1
-		for _, f := range p.Type.Fields {
-			if _, ok := p.ModelToPBConfig.SkipFields[f.Col.ColumnName]; ok {
-				continue
-			}
-			def := fmt.Sprintf("\t%s %s = %d;", f.Type, f.Col.ColumnName, count)
-			if v, ok := a.ToPBTypeMap[f.Type]; ok {
-				def = fmt.Sprintf("\t%s %s = %d;", v, f.Col.ColumnName, count)
-			}
-			if v, ok := a.WrapperTypeMap[f.Type]; ok {
-				def = fmt.Sprintf("\tgoogle.protobuf.%s %s = %d;", v, f.Col.ColumnName, count)
-			}
-			if f.Type == "mysql.NullTime" || f.Type == "time.Time" {
-				def = fmt.Sprintf("\tgoogle.protobuf.Timestamp %s = %d;", f.Col.ColumnName, count)
-			}
-			if f.Comment != "" {
-				def = fmt.Sprintf("%s\n%s", f.Comment, def)
-			}
-			fieldsDef = append(fieldsDef, def)
-			count++
-		}
-		body = body + fmt.Sprintf(
-			`message %s {
-%s
+// existsquery wraps the subquery sub in a query returning a single boolean
+// value indicating if sub returns any rows.
+func (a *ArgType) existsquery(sub string) string {
+	switch a.LoaderType {
+	case "mssql":
+		return "SELECT CASE WHEN EXISTS (" + sub + ") THEN 1 ELSE 0 END"
+	case "ora":
+		return "SELECT CASE WHEN EXISTS (" + sub + ") THEN 1 ELSE 0 END FROM dual"
+	}
+
+	return "SELECT EXISTS (" + sub + ")"
 }
-`, p.Type.Name, strings.Join(fieldsDef, "\n"))
+
+// lockclause returns the loader's clause appended to a SELECT statement to
+// lock the rows it reads, for update or share mode, or an empty string when
+// the loader has none.
+func (a *ArgType) lockclause(mode string) string {
+	switch {
+	case mode == "update" && (a.LoaderType == "postgres" || a.LoaderType == "mysql" || a.LoaderType == "ora"):
+		return "FOR UPDATE"
+	case mode == "share" && a.LoaderType == "postgres":
+		return "FOR SHARE"
+	case mode == "share" && a.LoaderType == "mysql":
+		return "LOCK IN SHARE MODE"
 	}
-	return body
+
+	return ""
 }
 
-// proto 对于 id -> Id
-func SnakeToCamelWithoutInitialisms(str string) string {
-	var r string
+// insertignore returns the loader's start of an INSERT statement skipping the
+// rows failing to insert (ie, "INSERT IGNORE INTO").
+func (a *ArgType) insertignore() string {
+	if a.LoaderType == "sqlite3" {
+		return "INSERT OR IGNORE INTO"
+	}
 
-	for _, w := range strings.Split(str, "_") {
-		if w == "" {
+	return "INSERT IGNORE INTO"
+}
+
+// queryargs returns the args passed with the query of the custom query q,
+// prefixed with a comma: the args var when its query is assembled at
+// runtime, or its params. The interpolated params are skipped.
+func (a *ArgType) queryargs(q *Query) string {
+	if q.Segments != nil {
+		return ", args..."
+	}
+
+	var str string
+	for _, p := range q.QueryParams {
+		if !p.Interpolate {
+			str += ", " + a.queryarg(p)
+		}
+	}
+
+	return str
+}
+
+// queryarg returns the arg of the query param p, wrapping the slices passed as
+// arrays in pq.Array with database/sql on PostgreSQL.
+func (a *ArgType) queryarg(p *QueryParam) string {
+	if p.Slice && !p.Expand && !a.Pgx {
+		return "pq.Array(" + p.Name + ")"
+	}
+
+	return p.Name
+}
+
+// queryparamdefaults returns the statements declaring the params of the query
+// func of q with a default, set to the value of their XOOption when passed in
+// opts. Returns an empty string when no param has a default.
+//
+// Used after the first lines of a generated query func body (ie, "{{-
+// queryparamdefaults . }}").
+func (a *ArgType) queryparamdefaults(q *Query) string {
+	var s string
+	for _, p := range q.QueryParams {
+		if p.Default != "" {
+			s += fmt.Sprintf("\n\tvar %s %s = %s\n\txoParamOption(opts, %q, &%s)", p.Name, p.Type, p.Default, q.Name+"."+p.Name, p.Name)
+		}
+	}
+	if s == "" {
+		return ""
+	}
+
+	return "\n\t// params with a default" + s + "\n"
+}
+
+// interpolchecks returns the statements checking the interpolated params with
+// allowed values and the LIMIT and OFFSET params of the query func of q at
+// runtime, returning ret (ie, "nil") and the error of the first check
+// failing. Returns an empty string when there is nothing to check.
+//
+// Used after the first lines of a generated query func body (ie, "{{-
+// interpolchecks . "nil" }}").
+func (a *ArgType) interpolchecks(q *Query, ret string) string {
+	if ret != "" {
+		ret += ", "
+	}
+
+	var s string
+	for _, p := range q.QueryParams {
+		if p.Limit {
+			s += fmt.Sprintf("\n\tif err := xoCheckLimit(%q, %s); err != nil {\n\t\treturn %serr\n\t}", p.Name, p.Name, ret)
+			continue
+		}
+		if !p.Interpolate || p.Allow == nil {
+			continue
+		}
+
+		v := p.Name
+		if p.Type != "string" {
+			v = `fmt.Sprintf("%v", ` + p.Name + `)`
+		}
+		allow := ""
+		for _, x := range p.Allow {
+			allow += ", " + strconv.Quote(x)
+		}
+		s += fmt.Sprintf("\n\tif err := xoCheckInterpolated(%q, %s%s); err != nil {\n\t\treturn %serr\n\t}", p.Name, v, allow, ret)
+	}
+	if s == "" {
+		return ""
+	}
+
+	return "\n\t// check the params" + s + "\n"
+}
+
+// quoteident returns the Go expression quoting the identifier s with the
+// quotes of the loader, doubling its closing quotes (ie, "[" +
+// strings.ReplaceAll(s, "]", "]]") + "]" with SQL Server).
+func (a *ArgType) quoteident() string {
+	open, close := `"`, `"`
+	switch a.LoaderType {
+	case "mysql":
+		open, close = "`", "`"
+	case "mssql":
+		open, close = "[", "]"
+	}
+
+	return strconv.Quote(open) + " + strings.ReplaceAll(s, " + strconv.Quote(close) + ", " + strconv.Quote(close+close) + ") + " + strconv.Quote(close)
+}
+
+// gocomment returns the text of a Go doc comment, following a "// " prefix:
+// the whitespace of text is collapsed, the control characters dropped, and
+// the text wrapped at 80 columns into "// " prefixed lines.
+func (a *ArgType) gocomment(text string) string {
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, text)
+
+	var lines []string
+	var line string
+	for _, w := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(w) > 77 {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += w
+	}
+	lines = append(lines, line)
+
+	return strings.Join(lines, "\n// ")
+}
+
+// gocode returns the lines of code as a code block of a Go doc comment,
+// following a "//\t" prefix, dropping the empty lines and the common
+// indentation.
+func (a *ArgType) gocode(code string) string {
+	var lines []string
+	indent := -1
+	for _, l := range strings.Split(strings.ReplaceAll(code, "\t", "    "), "\n") {
+		l = strings.TrimRightFunc(l, unicode.IsSpace)
+		if l == "" {
+			continue
+		}
+		if n := len(l) - len(strings.TrimLeft(l, " ")); indent == -1 || n < indent {
+			indent = n
+		}
+		lines = append(lines, l)
+	}
+	for i, l := range lines {
+		lines[i] = l[indent:]
+	}
+
+	return strings.Join(lines, "\n//\t")
+}
+
+// add returns the sum of x and y.
+func (a *ArgType) add(x, y int) int {
+	return x + y
+}
+
+// nthparamgo returns a Go expression evaluating to the loader's placeholder
+// for the 0-based param index given by the Go expression expr.
+//
+// Used where the number of params is only known at runtime, such as when
+// expanding a slice (ie, "$" + strconv.Itoa(len(args)+1), or "?").
+func (a *ArgType) nthparamgo(expr string) string {
+	p := a.Loader.NthParam(0)
+	if p == a.Loader.NthParam(1) {
+		return strconv.Quote(p)
+	}
+
+	return strconv.Quote(strings.TrimSuffix(p, "1")) + " + strconv.Itoa(" + expr + "+1)"
+}
+
+// isgeo determines if f is a geo info type.
+func (a *ArgType) isgeo(f *Field) bool {
+	return a.GeoInfoTypeMap[f.Type]
+}
+
+// typekind returns the kind of the Go type of f, ignoring any nullable wrapper:
+// "time", "number", "string" or "bool", or an empty string for any other type.
+func (a *ArgType) typekind(f *Field) string {
+	t := strings.ToLower(f.Type)
+	if i := strings.LastIndex(t, "."); i != -1 && !strings.HasSuffix(t, "time") {
+		t = t[i+1:]
+	}
+	t = strings.TrimPrefix(t, "null")
+
+	switch {
+	case strings.HasSuffix(t, "time"), strings.HasPrefix(t, "timestamp"), t == "date":
+		return "time"
+	case strings.HasPrefix(t, "int"), strings.HasPrefix(t, "uint"), strings.HasPrefix(t, "float"):
+		return "number"
+	case t == "decimal":
+		return "number"
+	case t == "string", t == "text":
+		return "string"
+	case t == "bool":
+		return "bool"
+	}
+
+	return ""
+}
+
+// isnulltype determines if f is a nullable wrapper type having a Valid field
+// (ie, sql.NullInt64, pgtype.Int8).
+func (a *ArgType) isnulltype(f *Field) bool {
+	return a.nullvaluefield(f.Type) != ""
+}
+
+// nullimports returns the sorted import paths of the null types configured in
+// the null_types section of the methods config file.
+func (a *ArgType) nullimports() []string {
+	if a.Methods == nil {
+		return nil
+	}
+
+	m := map[string]bool{}
+	for _, c := range a.Methods.NullTypes {
+		if c.Import != "" {
+			m[c.Import] = true
+		}
+	}
+
+	var imports []string
+	for i := range m {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	return imports
+}
+
+// basenulltype returns the Go null type replaced by typ in the null_types
+// section of the methods config file, or typ when it does not replace one.
+func (a *ArgType) basenulltype(typ string) string {
+	if a.Methods != nil {
+		for base, c := range a.Methods.NullTypes {
+			if c.Type == typ {
+				return base
+			}
+		}
+	}
+
+	return typ
+}
+
+// nullvaluefield returns the field holding the value of the nullable wrapper
+// type typ (ie, String for sql.NullString), or an empty string when typ is not
+// one.
+func (a *ArgType) nullvaluefield(typ string) string {
+	base := a.basenulltype(typ)
+	if base != typ && a.Methods.NullTypes[base].Value != "" {
+		return a.Methods.NullTypes[base].Value
+	}
+
+	if v, ok := pgtypeValueFields[base]; ok {
+		return v
+	}
+	if base == "decimal.NullDecimal" {
+		return "Decimal"
+	}
+	if strings.HasPrefix(base, "sql.Null") {
+		return base[8:]
+	}
+
+	return ""
+}
+
+// upsertcols returns the columns of the primary key of t an upsert conflicts
+// on, and the columns it updates: all other fields except those maintained by
+// the database.
+func (a *ArgType) upsertcols(t *Type) ([]string, []string) {
+	ignore := map[string]bool{}
+	for _, f := range t.PrimaryKeyFields {
+		ignore[f.Name] = true
+	}
+	for _, f := range t.AutoUpdateFields {
+		ignore[f.Name] = true
+	}
+	for _, f := range a.generatedfields(t) {
+		ignore[f.Name] = true
+	}
+	if t.CreatedAtField != nil {
+		ignore[t.CreatedAtField.Name] = true
+	}
+
+	var conflict, update []string
+	for _, f := range t.PrimaryKeyFields {
+		conflict = append(conflict, a.colname(f.Col))
+	}
+	for _, f := range t.Fields {
+		if !ignore[f.Name] {
+			update = append(update, a.colname(f.Col))
+		}
+	}
+
+	return conflict, update
+}
+
+// upsertclause returns the loader specific clause that turns an INSERT of all
+// of t's fields into an upsert on t's primary key, updating all other fields
+// except those maintained by the database. Returns an empty string when the
+// loader does not support upserts.
+func (a *ArgType) upsertclause(t *Type) string {
+	conflict, update := a.upsertcols(t)
+	if len(conflict) == 0 {
+		return ""
+	}
+
+	return a.Loader.Upsert(conflict, update)
+}
+
+// mergeclause returns the clause following "MERGE INTO <table> t" that upserts
+// a row of t from the params of its upsertfields on t's primary key, for the
+// loaders without an INSERT upsert clause (ie, mssql and oracle). Returns an
+// empty string when t has no primary key.
+func (a *ArgType) mergeclause(t *Type) string {
+	conflict, update := a.upsertcols(t)
+	if len(conflict) == 0 {
+		return ""
+	}
+
+	fields := a.upsertfields(t)
+	sel := make([]string, len(fields))
+	cols := make([]string, len(fields))
+	vals := make([]string, len(fields))
+	for i, f := range fields {
+		cols[i] = a.colname(f.Col)
+		sel[i] = a.Loader.NthParam(i) + " AS " + cols[i]
+		vals[i] = "s." + cols[i]
+	}
+	from := ""
+	if a.LoaderType == "ora" {
+		from = " FROM dual"
+	}
+
+	on := make([]string, len(conflict))
+	for i, c := range conflict {
+		on[i] = "t." + c + " = s." + c
+	}
+
+	str := "USING (SELECT " + strings.Join(sel, ", ") + from + ") s ON (" + strings.Join(on, " AND ") + ")"
+	if len(update) != 0 {
+		set := make([]string, len(update))
+		for i, c := range update {
+			set[i] = "t." + c + " = s." + c
+		}
+		str += " WHEN MATCHED THEN UPDATE SET " + strings.Join(set, ", ")
+	}
+
+	return str + " WHEN NOT MATCHED THEN INSERT (" + strings.Join(cols, ", ") + ") VALUES (" + strings.Join(vals, ", ") + ")"
+}
+
+// ctxparam returns the leading context.Context parameter declaration for a
+// generated func, or an empty string when ArgType.NoContext is toggled.
+//
+// Used as the first entry in a Go func parameter list (ie, "func
+// Foo({{ ctxparam }}db XODB)").
+func (a *ArgType) ctxparam() string {
+	if a.NoContext {
+		return ""
+	}
+
+	return "ctx context.Context, "
+}
+
+// ctxarg returns the leading context.Context argument for a call to a
+// generated func or a XODB method, or an empty string when ArgType.NoContext
+// is toggled.
+func (a *ArgType) ctxarg() string {
+	if a.NoContext {
+		return ""
+	}
+
+	return "ctx, "
+}
+
+// dbfn returns the name of the XODB method to call for name (ie, "Exec",
+// "Query", "QueryRow"), using the Context variant unless ArgType.NoContext is
+// toggled. The pgx methods always take a context, and have no Context variant.
+func (a *ArgType) dbfn(name string) string {
+	if a.NoContext || a.Pgx {
+		return name
+	}
+
+	return name + "Context"
+}
+
+// structtaglist returns the struct tags added to the fields of the generated
+// types: the tags of the struct_tags config, or json by default, and db when
+// ArgType.Sqlx is toggled.
+func (a *ArgType) structtaglist() []string {
+	tags := []string{"json"}
+	if a.Methods != nil && a.Methods.StructTags != nil && len(a.Methods.StructTags.Tags) != 0 {
+		tags = a.Methods.StructTags.Tags
+	}
+
+	if a.Sqlx {
+		for _, tag := range tags {
+			if tag == "db" {
+				return tags
+			}
+		}
+		tags = append(tags, "db")
+	}
+
+	return tags
+}
+
+// structtags returns the struct tags of the field f of a generated type, as
+// configured by the struct_tags section of the methods config file.
+//
+// The db tag is always the column name, as expected by sqlx. The validate tag
+// marks the NOT NULL columns without a default as required, and is omitted
+// for the other columns. The tags of the xo directive of the column comment
+// (ie, xo:"json:-,validate:required") are added last, replacing the tags
+// with the same key.
+func (a *ArgType) structtags(f *Field) string {
+	var c StructTagsConfig
+	if a.Methods != nil && a.Methods.StructTags != nil {
+		c = *a.Methods.StructTags
+	}
+
+	// name used by the encoding tags
+	name := f.Col.ColumnName
+	switch c.Case {
+	case "snake":
+		name = strings.ToLower(snaker.CamelToSnake(f.Name))
+	case "camel":
+		name = snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+	}
+	if c.OmitEmpty {
+		name += ",omitempty"
+	}
+
+	var tags []StructTag
+	for _, tag := range a.structtaglist() {
+		switch tag {
+		case "db":
+			tags = append(tags, StructTag{"db", f.Col.ColumnName})
+		case "validate":
+			if f.Col.NotNull && !f.Col.DefaultValue.Valid && !f.Col.IsPrimaryKey {
+				tags = append(tags, StructTag{"validate", "required"})
+			}
+		default:
+			tags = append(tags, StructTag{tag, name})
+		}
+	}
+
+	// tags set in the column comment replace the configured ones
+	for _, t := range f.Tags {
+		i := 0
+		for i < len(tags) && tags[i].Key != t.Key {
+			i++
+		}
+		if i == len(tags) {
+			tags = append(tags, t)
+		} else {
+			tags[i] = t
+		}
+	}
+
+	s := make([]string, len(tags))
+	for i, t := range tags {
+		s[i] = fmt.Sprintf("%s:%q", t.Key, t.Value)
+	}
+
+	return strings.Join(s, " ")
+}
+
+// embedtags returns the struct tags of the table type t embedded in a query
+// type, naming it by its type name, so that the encoded fields of the embedded
+// types do not collide (ie, json:"author").
+func (a *ArgType) embedtags(t *Type) string {
+	var c StructTagsConfig
+	if a.Methods != nil && a.Methods.StructTags != nil {
+		c = *a.Methods.StructTags
+	}
+
+	name := strings.ToLower(snaker.CamelToSnake(t.Name))
+	if c.Case == "camel" {
+		name = snaker.ForceLowerCamelIdentifier(name)
+	}
+
+	var s []string
+	for _, tag := range a.structtaglist() {
+		switch tag {
+		case "db", "validate":
+		default:
+			s = append(s, fmt.Sprintf("%s:%q", tag, name))
+		}
+	}
+
+	return strings.Join(s, " ")
+}
+
+// skiptags returns the struct tags of a field of a generated type that is
+// neither encoded nor scanned.
+func (a *ArgType) skiptags() string {
+	var tags []string
+	for _, tag := range a.structtaglist() {
+		tags = append(tags, tag+`:"-"`)
+	}
+
+	return strings.Join(tags, " ")
+}
+
+// sqlx returns whether ArgType.Sqlx is toggled.
+func (a *ArgType) sqlx() bool {
+	return a.Sqlx
+}
+
+// pgx returns whether ArgType.Pgx is toggled.
+func (a *ArgType) pgx() bool {
+	return a.Pgx
+}
+
+// protovalidate returns whether ArgType.ProtoValidate is toggled.
+func (a *ArgType) protovalidate() bool {
+	return a.ProtoValidate
+}
+
+// generics returns whether ArgType.Generics is toggled.
+func (a *ArgType) generics() bool {
+	return a.Generics
+}
+
+// deletebyindex returns whether ArgType.DeleteByIndex is toggled.
+func (a *ArgType) deletebyindex() bool {
+	return a.DeleteByIndex
+}
+
+// otel returns whether ArgType.Otel is toggled.
+func (a *ArgType) otel() bool {
+	return a.Otel
+}
+
+// protoserver returns whether ArgType.ProtoServer is toggled.
+func (a *ArgType) protoserver() bool {
+	return a.ProtoServer
+}
+
+// xooptions returns the statements applying the XOOption opts param of a
+// generated func to its context and XODB.
+//
+// Used as the first line of a generated func body (ie, "{{- xooptions }}").
+func (a *ArgType) xooptions() string {
+	if a.NoContext {
+		return "\n\tdb = xoApplyOptions(db, opts)\n"
+	}
+
+	return "\n\tctx, db, cancel := xoApplyOptions(ctx, db, opts)\n\tdefer cancel()\n"
+}
+
+// metrics returns whether ArgType.Metrics is toggled.
+func (a *ArgType) metrics() bool {
+	return a.Metrics
+}
+
+// xoinstrument returns the statements instrumenting the generated func name,
+// running op (ie, "SELECT") on table: starting its OpenTelemetry span when
+// ArgType.Otel is toggled, routing the reads to the reader of db when
+// ArgType.ReadWriteSplit is toggled, and wrapping db to call XOHook when
+// ArgType.Metrics is toggled. Returns an empty string when none is toggled.
+//
+// Used as the first line of a generated func body (ie, "{{- xoinstrument
+// .FuncName .Type.Table.TableName "SELECT" }}").
+func (a *ArgType) xoinstrument(name, table, op string) string {
+	var s string
+	if a.Otel {
+		s += fmt.Sprintf("\n\tctx, span := xoStartSpan(ctx, %q, %q, %q)\n\tdefer span.End()", name, table, op)
+	}
+	if a.ReadWriteSplit && op == "SELECT" {
+		s += "\n\tdb = xoReader(db)"
+	}
+	if a.Metrics {
+		s += fmt.Sprintf("\n\tdb = xoHookDB(db, %q, %q)", table, op)
+	}
+	if s == "" {
+		return ""
+	}
+
+	return s + "\n"
+}
+
+func (a *ArgType) pluralize(name string) string {
+	return inflector.Pluralize(name)
+}
+
+// repomethod returns the name of the repository method calling the index func
+// of ix (ie, "GetByID" for "UserByID" and "ListByEmail" for "UsersByEmail").
+func (a *ArgType) repomethod(ix *Index) string {
+	prefix, name := "Get", ix.Type.Name
+	if !ix.Index.IsUnique {
+		prefix, name = "List", inflector.Pluralize(ix.Type.Name)
+	}
+
+	return prefix + strings.TrimPrefix(ix.FuncName, name)
+}
+
+func (a *ArgType) snaketocamel(name string) string {
+	return snaker.ForceLowerCamelIdentifier(name)
+}
+
+func (a *ArgType) modelToPB(option *MethodsOption) string {
+	if !option.ModelToPB {
+		return ""
+	}
+
+	shortName := a.shortname(option.Type.Name)
+	body := fmt.Sprintf("proto%s := &%s.%s{}\n", option.Type.Name, a.protogoname(option.ModelToPBConfig.ImportService), option.Type.Name)
+	for _, field := range option.Type.Fields {
+		if _, ok := option.ModelToPBConfig.SkipFields[field.Col.ColumnName]; ok {
+			continue
+		}
+		if name, ok := option.ModelToPBConfig.OneofNames[field.Col.ColumnName]; ok {
+			if fields := a.oneoffields(option, name); fields[0] == field {
+				body = body + a.oneoftopb(option, name, fields, shortName, "proto"+option.Type.Name)
+			}
+			continue
+		}
+		fa, ok := a.modeltopbfield(option.ModelToPBConfig, field, shortName+"."+field.Name, fmt.Sprintf("proto%s.%s", option.Type.Name, pbname(protoname(field))))
+		if !ok {
+			log.Printf("WARN: %s.%s could be null, skipping!", option.Type.Name, field.Name)
+			continue
+		}
+		body = body + fa
+	}
+
+	body = body + fmt.Sprintf("\nreturn proto%s, nil", option.Type.Name)
+	return body
+}
+
+// modeltopbfield returns the statements setting dst, the proto field of the
+// model field f, to the value of src. The nullable fields are only set when
+// valid, and the JSON fields of c are unmarshaled. Returns false when f has
+// no proto conversion.
+func (a *ArgType) modeltopbfield(c *ModelToPBConfig, f *Field, src, dst string) (string, bool) {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+	if kind, ok := c.JSONFields[f.Col.ColumnName]; ok {
+		return a.jsontopbfield(f, kind, src, dst), true
+	}
+	if cur, ok := c.MoneyFields[f.Col.ColumnName]; ok {
+		return a.moneytopbfield(f, cur, src, dst), true
+	}
+	if typ, ok := c.FieldTypes[f.Col.ColumnName]; ok {
+		return a.fieldtypetopbfield(f, typ, src, dst), true
+	}
+	if gt := a.googletype(f); gt != "" {
+		return a.googletypetopbfield(f, gt, src, dst), true
+	}
+	if strings.HasPrefix(f.Type, "*") {
+		return a.pointertopbfield(f, src, dst)
+	}
+	if f.Col.NotNull || f.Type == "[]byte" {
+		switch t, ok := a.ToPBTypeMap[f.Type]; {
+		case f.Type == "time.Time" && !a.ProtoPtypes:
+			return fmt.Sprintf("%s = timestamppb.New(%s)\n", dst, src), true
+		case f.Type == "time.Time":
+			return fmt.Sprintf(`%s, err := ptypes.TimestampProto(%s)
+if err != nil {
+	return nil, err
+}
+%s = %s
+`, v, src, dst, v), true
+		case f.Type == "uuid.UUID":
+			return fmt.Sprintf("%s = %s.String()\n", dst, src), true
+		case ok && !a.IncompatilbePBType[t]:
+			return fmt.Sprintf("%s = %s(%s)\n", dst, t, src), true
+		}
+		return fmt.Sprintf("%s = %s\n", dst, src), true
+	}
+
+	base := a.basenulltype(f.Type)
+	switch typ, ok := a.WrapperTypeMap[base]; {
+	case base == "mysql.NullTime" && !a.ProtoPtypes:
+		return fmt.Sprintf(`if %s.Valid {
+	%s = timestamppb.New(%s.Time)
+}
+`, src, dst, src), true
+	case base == "mysql.NullTime":
+		return fmt.Sprintf(`if %s.Valid {
+	%s, err := ptypes.TimestampProto(%s.Time)
+	if err != nil {
+		return nil, err
+	}
+	%s = %s
+}
+`, src, v, src, dst, v), true
+	case f.Type == "uuid.NullUUID" && a.ProtoOptional:
+		return fmt.Sprintf(`if %s.Valid {
+	%s := %s.UUID.String()
+	%s = &%s
+}
+`, src, v, src, dst, v), true
+	case f.Type == "uuid.NullUUID":
+		return fmt.Sprintf(`if %s.Valid {
+	%s = &%s.StringValue{Value: %s.UUID.String()}
+}
+`, src, dst, a.wrapperspkg(), src), true
+	case a.ProtoOptional && a.OptionalTypeMap[base] != "":
+		return fmt.Sprintf(`if %s.Valid {
+	%s := %s.%s
+	%s = &%s
+}
+`, src, v, src, strings.TrimPrefix(base, "sql.Null"), dst, v), true
+	case ok:
+		return fmt.Sprintf(`if %s.Valid {
+	%s = &%s.%s{Value: %s.%s}
+}
+`, src, dst, a.wrapperspkg(), typ, src, strings.TrimSuffix(typ, "Value")), true
+	}
+
+	return "", false
+}
+
+func (a *ArgType) PBToModel(option *MethodsOption) string {
+	if !option.ModelToPB {
+		return ""
+	}
+
+	shortName := a.shortname(option.Type.Name)
+	body := fmt.Sprintf("%s := &%s{}\n", shortName, option.Type.Name)
+	for _, field := range option.Type.Fields {
+		if _, ok := option.ModelToPBConfig.SkipFields[field.Col.ColumnName]; ok {
+			continue
+		}
+		if name, ok := option.ModelToPBConfig.OneofNames[field.Col.ColumnName]; ok {
+			if fields := a.oneoffields(option, name); fields[0] == field {
+				body = body + a.pbtooneof(option, name, fields, "proto"+option.Type.Name, shortName)
+			}
+			continue
+		}
+		fa, ok := a.pbtomodelfield(option.ModelToPBConfig, field, fmt.Sprintf("proto%s.%s", option.Type.Name, pbname(protoname(field))), shortName+"."+field.Name)
+		if !ok {
+			log.Printf("WARN: %s.%s could be null, skipping!", option.Type.Name, field.Name)
+			continue
+		}
+		body = body + fa
+	}
+
+	body = body + fmt.Sprintf("\nreturn %s, nil", shortName)
+	return body
+}
+
+// pbtomodelfield returns the statements setting dst, the model field f, to
+// the value of the proto field pb. The nullable fields are only set when pb
+// is not nil, and the JSON fields of c are marshaled. Returns false when f
+// has no proto conversion.
+func (a *ArgType) pbtomodelfield(c *ModelToPBConfig, f *Field, pb, dst string) (string, bool) {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+	if kind, ok := c.JSONFields[f.Col.ColumnName]; ok {
+		return a.pbtojsonfield(f, kind, pb, dst), true
+	}
+	if cur, ok := c.MoneyFields[f.Col.ColumnName]; ok {
+		return a.pbtomoneyfield(f, cur, pb, dst), true
+	}
+	if typ, ok := c.FieldTypes[f.Col.ColumnName]; ok {
+		return a.pbtofieldtypefield(f, typ, pb, dst), true
+	}
+	if gt := a.googletype(f); gt != "" {
+		return a.pbtogoogletypefield(f, gt, pb, dst), true
+	}
+	if strings.HasPrefix(f.Type, "*") {
+		return a.pbtopointerfield(f, pb, dst)
+	}
+	if f.Col.NotNull || f.Type == "[]byte" {
+		switch t, ok := a.ToPBTypeMap[f.Type]; {
+		case f.Type == "time.Time" && !a.ProtoPtypes:
+			return fmt.Sprintf(`if err := %s.CheckValid(); err != nil {
+	return nil, err
+}
+%s = %s.AsTime()
+`, pb, dst, pb), true
+		case f.Type == "time.Time":
+			return fmt.Sprintf(`%s, err := ptypes.Timestamp(%s)
+if err != nil {
+	return nil, err
+}
+%s = %s
+`, v, pb, dst, v), true
+		case f.Type == "uuid.UUID":
+			return fmt.Sprintf(`%s, err := uuid.Parse(%s)
+if err != nil {
+	return nil, err
+}
+%s = %s
+`, v, pb, dst, v), true
+		case ok && !a.IncompatilbePBType[t]:
+			return fmt.Sprintf("%s = %s(%s)\n", dst, f.Type, pb), true
+		}
+		return fmt.Sprintf("%s = %s\n", dst, pb), true
+	}
+
+	base := a.basenulltype(f.Type)
+	switch typ, ok := a.WrapperTypeMap[base]; {
+	case base == "mysql.NullTime" && !a.ProtoPtypes:
+		return fmt.Sprintf(`if %s != nil {
+	if err := %s.CheckValid(); err != nil {
+		return nil, err
+	}
+	%s = %s{Time: %s.AsTime(), Valid: true}
+}
+`, pb, pb, dst, f.Type, pb), true
+	case base == "mysql.NullTime":
+		return fmt.Sprintf(`if %s != nil {
+	%s, err := ptypes.Timestamp(%s)
+	if err != nil {
+		return nil, err
+	}
+	%s = %s{Time: %s, Valid: true}
+}
+`, pb, v, pb, dst, f.Type, v), true
+	case f.Type == "uuid.NullUUID" && a.ProtoOptional:
+		return fmt.Sprintf(`if %s != nil {
+	%s, err := uuid.Parse(*%s)
+	if err != nil {
+		return nil, err
+	}
+	%s = uuid.NullUUID{UUID: %s, Valid: true}
+}
+`, pb, v, pb, dst, v), true
+	case f.Type == "uuid.NullUUID":
+		return fmt.Sprintf(`if %s != nil {
+	%s, err := uuid.Parse(%s.Value)
+	if err != nil {
+		return nil, err
+	}
+	%s = uuid.NullUUID{UUID: %s, Valid: true}
+}
+`, pb, v, pb, dst, v), true
+	case a.ProtoOptional && a.OptionalTypeMap[base] != "":
+		return fmt.Sprintf(`if %s != nil {
+	%s = %s{%s: *%s, Valid: true}
+}
+`, pb, dst, f.Type, strings.TrimPrefix(base, "sql.Null"), pb), true
+	case ok:
+		return fmt.Sprintf(`if %s != nil {
+	%s = %s{%s: %s.Value, Valid: true}
+}
+`, pb, dst, f.Type, strings.TrimSuffix(typ, "Value"), pb), true
+	}
+
+	return "", false
+}
+
+// oneoffield returns the field of the value of the nullable model field f of
+// a oneof, a NOT NULL field of the Go type of its value (ie, int64 for an
+// sql.NullInt64), along with the condition of the model field src being set
+// and the expression of its value. Returns a nil field when the value of f
+// has no proto type.
+func (a *ArgType) oneoffield(f *Field, src string) (*Field, string, string) {
+	var typ, valid, value string
+	base := a.basenulltype(f.Type)
+	switch vf := a.nullvaluefield(f.Type); {
+	case a.pointerscalar(f.Type) != "" || f.Type == "*time.Time":
+		typ, valid, value = f.Type[1:], src+" != nil", "*"+src
+	case base == "mysql.NullTime" || base == "pq.NullTime" || base == "sql.NullTime":
+		typ, valid, value = "time.Time", src+".Valid", src+".Time"
+	case base == "uuid.NullUUID":
+		typ, valid, value = "uuid.UUID", src+".Valid", src+".UUID"
+	case vf != "" && f.Type != "[]byte":
+		typ, valid, value = strings.ToLower(vf), src+".Valid", src+"."+vf
+		if typ != "string" && typ != "bool" && !gonumber(typ) {
+			return nil, "", ""
+		}
+	default:
+		return nil, "", ""
+	}
+
+	col := *f.Col
+	col.NotNull = true
+	return &Field{Name: f.Name, Type: typ, Len: f.Len, Col: &col, Comment: f.Comment, PBName: f.PBName}, valid, value
+}
+
+// oneoffields returns the model fields of the columns of the oneof name of
+// the message of option, in the order of the fields of the model.
+func (a *ArgType) oneoffields(option *MethodsOption, name string) []*Field {
+	var fields []*Field
+	for _, f := range option.Type.Fields {
+		if option.ModelToPBConfig.OneofNames[f.Col.ColumnName] == name {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// oneofvariant returns the Go type of the variant of the field f of the
+// oneof of the message of option (ie, Media_PhotoId).
+func (a *ArgType) oneofvariant(option *MethodsOption, f *Field) string {
+	return fmt.Sprintf("%s.%s_%s", a.protogoname(option.ModelToPBConfig.ImportService), option.Type.Name, pbname(protoname(f)))
+}
+
+// oneoftopb returns the statements setting the oneof name of the proto
+// message pb to the variant of the set field of fields, the fields of the
+// model v in the oneof, returning an error when more than one is set.
+func (a *ArgType) oneoftopb(option *MethodsOption, name string, fields []*Field, v, pb string) string {
+	dst := pb + "." + pbname(name)
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = protoname(f)
+	}
+
+	var body string
+	for i, f := range fields {
+		vf, valid, value := a.oneoffield(f, v+"."+f.Name)
+		if strings.HasPrefix(value, "*") && (vf.Type == "uuid.UUID" || a.googletype(vf) != "") {
+			value = "(" + value + ")"
+		}
+		var check string
+		if i != 0 {
+			check = fmt.Sprintf(`if %s != nil {
+	return nil, fmt.Errorf("%s: only one of %s can be set")
+}
+`, dst, name, strings.Join(names, ", "))
+		}
+		fa, _ := a.modeltopbfield(option.ModelToPBConfig, vf, value, "oneof."+pbname(protoname(f)))
+		body = body + fmt.Sprintf("if %s {\n%soneof := &%s{}\n%s%s = oneof\n}\n", valid, check, a.oneofvariant(option, f), fa, dst)
+	}
+
+	return body
+}
+
+// pbtooneof returns the type switch on the oneof name of the proto message
+// pb setting the field of fields, the fields of the model v in the oneof, of
+// its variant.
+func (a *ArgType) pbtooneof(option *MethodsOption, name string, fields []*Field, pb, v string) string {
+	var body string
+	for _, f := range fields {
+		dst := v + "." + f.Name
+		vf, _, value := a.oneoffield(f, dst)
+		fa, _ := a.pbtomodelfield(option.ModelToPBConfig, vf, "oneof."+pbname(protoname(f)), value)
+		if strings.HasPrefix(f.Type, "*") {
+			fa = fmt.Sprintf("%s = new(%s)\n", dst, vf.Type) + fa
+		} else {
+			fa = fa + fmt.Sprintf("%s.Valid = true\n", dst)
+		}
+		body = body + fmt.Sprintf("case *%s:\n%s", a.oneofvariant(option, f), fa)
+	}
+
+	return fmt.Sprintf("switch oneof := %s.%s.(type) {\n%s}\n", pb, pbname(name), body)
+}
+
+// jsonProtoTypes are the proto types of the kinds of JSON columns.
+var jsonProtoTypes = map[string]string{
+	"map":    "map<string, string>",
+	"struct": "google.protobuf.Struct",
+}
+
+// jsontopbfield returns the statements unmarshaling src, the JSON model field
+// f, to dst, its proto field of the kind (map or struct). A []byte or
+// string field holds the JSON, other types are marshaled to JSON first.
+func (a *ArgType) jsontopbfield(f *Field, kind, src, dst string) string {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+
+	var cond, buf, body string
+	switch a.basenulltype(f.Type) {
+	case "[]byte":
+		cond, buf = fmt.Sprintf("len(%s) != 0", src), src
+	case "string":
+		buf = fmt.Sprintf("[]byte(%s)", src)
+	case "sql.NullString":
+		cond, buf = src+".Valid", fmt.Sprintf("[]byte(%s.%s)", src, a.nullvaluefield(f.Type))
+	case "*string":
+		cond, buf = src+" != nil", fmt.Sprintf("[]byte(*%s)", src)
+	default:
+		body = fmt.Sprintf(`%sJSON, err := json.Marshal(%s)
+if err != nil {
+	return nil, err
+}
+`, v, src)
+		buf = v + "JSON"
+	}
+
+	if kind == "map" {
+		body = body + fmt.Sprintf(`if err := json.Unmarshal(%s, &%s); err != nil {
+	return nil, err
+}
+`, buf, dst)
+	} else {
+		body = body + fmt.Sprintf(`var %sMap map[string]interface{}
+if err := json.Unmarshal(%s, &%sMap); err != nil {
+	return nil, err
+}
+%sStruct, err := structpb.NewStruct(%sMap)
+if err != nil {
+	return nil, err
+}
+%s = %sStruct
+`, v, buf, v, v, v, dst, v)
+	}
+
+	if cond == "" {
+		return body
+	}
+	return fmt.Sprintf("if %s {\n%s}\n", cond, body)
+}
+
+// pbtojsonfield returns the statements marshaling pb, the proto field of the
+// kind (map or struct) of the JSON model field f, to dst. The nullable fields
+// are only set when pb is set.
+func (a *ArgType) pbtojsonfield(f *Field, kind, pb, dst string) string {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+
+	src, cond := pb, fmt.Sprintf("len(%s) != 0", pb)
+	if kind != "map" {
+		src, cond = pb+".AsMap()", pb+" != nil"
+	}
+
+	body := fmt.Sprintf(`%sJSON, err := json.Marshal(%s)
+if err != nil {
+	return nil, err
+}
+`, v, src)
+	switch a.basenulltype(f.Type) {
+	case "[]byte":
+		body = body + fmt.Sprintf("%s = %sJSON\n", dst, v)
+	case "string":
+		body = body + fmt.Sprintf("%s = string(%sJSON)\n", dst, v)
+	case "sql.NullString":
+		body = body + fmt.Sprintf("%s = %s{%s: string(%sJSON), Valid: true}\n", dst, f.Type, a.nullvaluefield(f.Type), v)
+	case "*string":
+		body = body + fmt.Sprintf("%sString := string(%sJSON)\n%s = &%sString\n", v, v, dst, v)
+	default:
+		body = body + fmt.Sprintf(`if err := json.Unmarshal(%sJSON, &%s); err != nil {
+	return nil, err
+}
+`, v, dst)
+	}
+
+	if f.Col.NotNull {
+		return body
+	}
+	return fmt.Sprintf("if %s {\n%s}\n", cond, body)
+}
+
+// googletype returns the google.type message of the date or time field f,
+// Date for the DATE columns and TimeOfDay for the TIME ones, with
+// ProtoGoogleTypes. Returns an empty string for the other fields.
+func (a *ArgType) googletype(f *Field) string {
+	if !a.ProtoGoogleTypes {
+		return ""
+	}
+
+	dt := strings.ToLower(strings.SplitN(f.Col.DataType, "(", 2)[0])
+	switch base := a.basenulltype(f.Type); {
+	case dt == "date" && (f.Type == "time.Time" || base == "mysql.NullTime" || base == "pq.NullTime"):
+		return "Date"
+	case (dt == "time" || strings.HasPrefix(dt, "time with")) && (f.Type == "time.Time" || f.Type == "string" || base == "mysql.NullTime" || base == "pq.NullTime"):
+		return "TimeOfDay"
+	}
+
+	return ""
+}
+
+// googletypetopbfield returns the statements setting dst, the google.type
+// message gt (see googletype) of the model field f, to the value of src.
+func (a *ArgType) googletypetopbfield(f *Field, gt, src, dst string) string {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+
+	var body string
+	t := src
+	switch {
+	case f.Type == "string":
+		body = fmt.Sprintf(`%s, err := time.Parse("15:04:05.999999999", %s)
+if err != nil {
+	return nil, err
+}
+`, v, src)
+		t = v
+	case f.Type != "time.Time":
+		t = src + ".Time"
+	}
+
+	if gt == "Date" {
+		body = body + fmt.Sprintf("%s = &date.Date{Year: int32(%s.Year()), Month: int32(%s.Month()), Day: int32(%s.Day())}\n", dst, t, t, t)
+	} else {
+		body = body + fmt.Sprintf("%s = &timeofday.TimeOfDay{Hours: int32(%s.Hour()), Minutes: int32(%s.Minute()), Seconds: int32(%s.Second()), Nanos: int32(%s.Nanosecond())}\n", dst, t, t, t, t)
+	}
+
+	if f.Type == "string" || f.Type == "time.Time" {
+		return body
+	}
+	return fmt.Sprintf("if %s.Valid {\n%s}\n", src, body)
+}
+
+// pbtogoogletypefield returns the statements setting dst, the model field f,
+// to the value of pb, its google.type message gt (see googletype). The times
+// of day are on January 1st, year 0, in UTC.
+func (a *ArgType) pbtogoogletypefield(f *Field, gt, pb, dst string) string {
+	if f.Type == "string" {
+		return fmt.Sprintf("%s = fmt.Sprintf(\"%%02d:%%02d:%%02d.%%09d\", %s.GetHours(), %s.GetMinutes(), %s.GetSeconds(), %s.GetNanos())\n", dst, pb, pb, pb, pb)
+	}
+
+	t := fmt.Sprintf("time.Date(int(%s.GetYear()), time.Month(%s.GetMonth()), int(%s.GetDay()), 0, 0, 0, 0, time.UTC)", pb, pb, pb)
+	if gt == "TimeOfDay" {
+		t = fmt.Sprintf("time.Date(0, 1, 1, int(%s.GetHours()), int(%s.GetMinutes()), int(%s.GetSeconds()), int(%s.GetNanos()), time.UTC)", pb, pb, pb, pb)
+	}
+
+	if f.Type == "time.Time" {
+		return fmt.Sprintf("%s = %s\n", dst, t)
+	}
+	return fmt.Sprintf(`if %s != nil {
+	%s = %s{Time: %s, Valid: true}
+}
+`, pb, dst, f.Type, t)
+}
+
+// moneyamount returns the kind of the Go type typ of a money amount (float,
+// int or decimal), and its value field when nullable (ie, Float64 for a
+// sql.NullFloat64). Returns an empty kind for the other types.
+func (a *ArgType) moneyamount(typ string) (string, string) {
+	switch base := a.basenulltype(typ); {
+	case base == "sql.NullFloat64":
+		return "float", a.nullvaluefield(typ)
+	case base == "sql.NullInt64":
+		return "int", a.nullvaluefield(typ)
+	case base == "decimal.NullDecimal":
+		return "decimal", a.nullvaluefield(typ)
+	case typ == "decimal.Decimal":
+		return "decimal", ""
+	case typ == "float32" || typ == "float64":
+		return "float", ""
+	case strings.HasPrefix(strings.TrimPrefix(typ, "u"), "int"):
+		return "int", ""
+	}
+
+	return "", ""
+}
+
+// moneytopbfield returns the statements setting dst, the google.type.Money
+// of the money amount field f, to the amount src and its currency cur. The
+// nullable amounts are only set when valid.
+func (a *ArgType) moneytopbfield(f, cur *Field, src, dst string) string {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+	code := strings.TrimSuffix(src, f.Name) + cur.Name
+	if vf := a.nullvaluefield(cur.Type); vf != "" {
+		code = code + "." + vf
+	}
+
+	kind, vf := a.moneyamount(f.Type)
+	amount := src
+	if vf != "" {
+		amount = src + "." + vf
+	}
+
+	var body string
+	switch kind {
+	case "float":
+		body = fmt.Sprintf(`%sUnits, %sNanos := math.Modf(float64(%s))
+%s = &money.Money{CurrencyCode: %s, Units: int64(%sUnits), Nanos: int32(math.Round(%sNanos * 1e9))}
+`, v, v, amount, dst, code, v, v)
+	case "decimal":
+		body = fmt.Sprintf("%s = &money.Money{CurrencyCode: %s, Units: %s.IntPart(), Nanos: int32(%s.Sub(%s.Truncate(0)).Shift(9).IntPart())}\n", dst, code, amount, amount, amount)
+	default:
+		body = fmt.Sprintf("%s = &money.Money{CurrencyCode: %s, Units: int64(%s)}\n", dst, code, amount)
+	}
+
+	if vf == "" {
+		return body
+	}
+	return fmt.Sprintf("if %s.Valid {\n%s}\n", src, body)
+}
+
+// pbtomoneyfield returns the statements setting dst, the money amount field
+// f, and its currency cur to the value of pb, a google.type.Money. The
+// nullable amount and currency are only set when pb is not nil.
+func (a *ArgType) pbtomoneyfield(f, cur *Field, pb, dst string) string {
+	code := strings.TrimSuffix(dst, f.Name) + cur.Name
+
+	kind, vf := a.moneyamount(f.Type)
+	var amount string
+	switch kind {
+	case "float":
+		amount = fmt.Sprintf("float64(%s.GetUnits()) + float64(%s.GetNanos())/1e9", pb, pb)
+		if vf == "" && f.Type != "float64" {
+			amount = fmt.Sprintf("%s(%s)", f.Type, amount)
+		}
+	case "decimal":
+		amount = fmt.Sprintf("decimal.New(%s.GetUnits(), 0).Add(decimal.New(int64(%s.GetNanos()), -9))", pb, pb)
+	default:
+		amount = fmt.Sprintf("%s.GetUnits()", pb)
+		if vf == "" {
+			amount = fmt.Sprintf("%s(%s)", f.Type, amount)
+		}
+	}
+
+	var body string
+	if vf == "" {
+		body = fmt.Sprintf("%s = %s\n", dst, amount)
+	}
+	if cvf := a.nullvaluefield(cur.Type); cvf == "" {
+		body = body + fmt.Sprintf("%s = %s.GetCurrencyCode()\n", code, pb)
+	}
+
+	var nullable string
+	if vf != "" {
+		nullable = fmt.Sprintf("\t%s = %s{%s: %s, Valid: true}\n", dst, f.Type, vf, amount)
+	}
+	if cvf := a.nullvaluefield(cur.Type); cvf != "" {
+		nullable = nullable + fmt.Sprintf("\t%s = %s{%s: %s.CurrencyCode, Valid: true}\n", code, cur.Type, cvf, pb)
+	}
+	if nullable == "" {
+		return body
+	}
+	return body + fmt.Sprintf("if %s != nil {\n%s}\n", pb, nullable)
+}
+
+// protovaluetype returns the Go type of the value of the model field f, the
+// type of the value field of the nullable wrappers (ie, int64 for an
+// sql.NullInt64). Returns an empty string when f is not a Go scalar.
+func (a *ArgType) protovaluetype(f *Field) string {
+	typ := f.Type
+	if !f.Col.NotNull && typ != "[]byte" {
+		if _, ok := a.WrapperTypeMap[a.basenulltype(typ)]; !ok {
+			return ""
+		}
+		typ = strings.ToLower(a.nullvaluefield(typ))
+	}
+	if typ == "[]byte" || typ == "string" || typ == "bool" || gonumber(typ) {
+		return typ
+	}
+
+	return ""
+}
+
+// gonumber determines if the Go type typ is a number.
+func gonumber(typ string) bool {
+	return intTypeRE.MatchString(typ) || typ == "float32" || typ == "float64"
+}
+
+// protoconvertible determines if the values of the Go type typ can be
+// converted to the ones of the Go type of the proto scalar type scalar, and
+// back: numbers to numbers or strings, strings to bytes, and bools to
+// strings.
+func protoconvertible(typ, scalar string) bool {
+	pbtyp := protogotype(scalar)
+	switch {
+	case typ == pbtyp:
+	case gonumber(typ) && gonumber(pbtyp):
+	case (typ == "string" || typ == "[]byte") && (pbtyp == "string" || pbtyp == "[]byte"):
+	case pbtyp == "string" && (gonumber(typ) || typ == "bool"):
+	default:
+		return false
+	}
+
+	return true
+}
+
+// fieldtypevalue returns the expression converting the value v of the Go
+// type typ to the Go type of the proto scalar type scalar, formatting the
+// numbers and bools converted to strings.
+func fieldtypevalue(typ, scalar, v string) string {
+	pbtyp := protogotype(scalar)
+	switch {
+	case typ == pbtyp:
+		return v
+	case pbtyp != "string" || typ == "[]byte":
+	case typ == "bool":
+		return fmt.Sprintf("strconv.FormatBool(%s)", v)
+	case typ == "float32":
+		return fmt.Sprintf("strconv.FormatFloat(float64(%s), 'g', -1, 32)", v)
+	case typ == "float64":
+		return fmt.Sprintf("strconv.FormatFloat(%s, 'g', -1, 64)", v)
+	case strings.HasPrefix(typ, "u"):
+		return fmt.Sprintf("strconv.FormatUint(uint64(%s), 10)", v)
+	default:
+		return fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", v)
+	}
+
+	return fmt.Sprintf("%s(%s)", pbtyp, v)
+}
+
+// fieldtypeparse returns the statements and the expression converting the
+// value pb of the Go type of the proto scalar type scalar to the Go type typ,
+// parsing the numbers and bools converted to strings (see fieldtypevalue) to
+// name.
+func fieldtypeparse(typ, scalar, name, pb string) (string, string) {
+	pbtyp := protogotype(scalar)
+	var parse string
+	switch {
+	case typ == pbtyp:
+		return "", pb
+	case pbtyp != "string" || typ == "[]byte":
+		return "", fmt.Sprintf("%s(%s)", typ, pb)
+	case typ == "bool":
+		parse = fmt.Sprintf("strconv.ParseBool(%s)", pb)
+	case strings.HasPrefix(typ, "float"):
+		parse = fmt.Sprintf("strconv.ParseFloat(%s, %s)", pb, typ[5:])
+	case strings.HasPrefix(typ, "u"):
+		parse = fmt.Sprintf("strconv.ParseUint(%s, 10, %d)", pb, intbits(typ))
+	default:
+		parse = fmt.Sprintf("strconv.ParseInt(%s, 10, %d)", pb, intbits(typ))
+	}
+
+	value := name
+	if typ != "bool" && typ != "float64" && typ != "int64" && typ != "uint64" {
+		name, value = name+"Value", fmt.Sprintf("%s(%sValue)", typ, name)
+	}
+	return fmt.Sprintf(`%s, err := %s
+if err != nil {
+	return nil, err
+}
+`, name, parse), value
+}
+
+// intbits returns the bit size of the Go integer type typ, 64 for int and
+// uint.
+func intbits(typ string) int {
+	if n, err := strconv.Atoi(strings.TrimLeft(typ, "uint")); err == nil {
+		return n
+	}
+	return 64
+}
+
+// fieldtypetopbfield returns the statements setting dst, the proto field of
+// the scalar type overriding the one of the model field f (see
+// TableConfig.FieldTypes), to the converted value of src. The nullable
+// fields are only set when valid.
+func (a *ArgType) fieldtypetopbfield(f *Field, scalar, src, dst string) string {
+	typ := a.protovaluetype(f)
+	if f.Col.NotNull || f.Type == "[]byte" {
+		return fmt.Sprintf("%s = %s\n", dst, fieldtypevalue(typ, scalar, src))
+	}
+
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+	value := fieldtypevalue(typ, scalar, src+"."+a.nullvaluefield(f.Type))
+	if a.ProtoOptional {
+		return fmt.Sprintf("if %s.Valid {\n%s := %s\n%s = &%s\n}\n", src, v, value, dst, v)
+	}
+	return fmt.Sprintf("if %s.Valid {\n%s = &%s.%s{Value: %s}\n}\n", src, dst, a.wrapperspkg(), protoWrapperTypes[scalar], value)
+}
+
+// pbtofieldtypefield returns the statements setting dst, the model field f,
+// to the converted value of pb, its proto field of the scalar type
+// overriding its own (see TableConfig.FieldTypes). The nullable fields are
+// only set when pb is set.
+func (a *ArgType) pbtofieldtypefield(f *Field, scalar, pb, dst string) string {
+	typ := a.protovaluetype(f)
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+	if f.Col.NotNull || f.Type == "[]byte" {
+		parse, value := fieldtypeparse(typ, scalar, v, pb)
+		return parse + fmt.Sprintf("%s = %s\n", dst, value)
+	}
+
+	value := pb + ".Value"
+	if a.ProtoOptional {
+		value = "*" + pb
+	}
+	parse, value := fieldtypeparse(typ, scalar, v, value)
+	return fmt.Sprintf("if %s != nil {\n%s%s = %s{%s: %s, Valid: true}\n}\n", pb, parse, dst, f.Type, a.nullvaluefield(f.Type), value)
+}
+
+// pointertopbfield returns the statements setting dst, the proto field of the
+// pointer model field f, to the value src points to, when not nil. Returns
+// false when f has no proto conversion.
+func (a *ArgType) pointertopbfield(f *Field, src, dst string) (string, bool) {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+
+	var body string
+	switch scalar := a.pointerscalar(f.Type); {
+	case f.Type == "*time.Time" && a.ProtoPtypes:
+		body = fmt.Sprintf(`%s, err := ptypes.TimestampProto(*%s)
+if err != nil {
+	return nil, err
+}
+%s = %s
+`, v, src, dst, v)
+	case f.Type == "*time.Time":
+		body = fmt.Sprintf("%s = timestamppb.New(*%s)\n", dst, src)
+	case scalar == "":
+		return "", false
+	default:
+		value := fmt.Sprintf("*%s", src)
+		if f.Type == "*uuid.UUID" {
+			value = src + ".String()"
+		} else if typ := protogotype(scalar); typ != f.Type[1:] {
+			value = fmt.Sprintf("%s(%s)", typ, value)
+		}
+		if a.ProtoOptional {
+			body = fmt.Sprintf("%s := %s\n%s = &%s\n", v, value, dst, v)
+		} else {
+			body = fmt.Sprintf("%s = &%s.%s{Value: %s}\n", dst, a.wrapperspkg(), protoWrapperTypes[scalar], value)
+		}
+	}
+
+	return fmt.Sprintf("if %s != nil {\n%s}\n", src, body), true
+}
+
+// pbtopointerfield returns the statements setting dst, the pointer model
+// field f, to a pointer to the value of the proto field pb, when set. Returns
+// false when f has no proto conversion.
+func (a *ArgType) pbtopointerfield(f *Field, pb, dst string) (string, bool) {
+	v := snaker.ForceLowerCamelIdentifier(f.Col.ColumnName)
+
+	var body string
+	switch scalar := a.pointerscalar(f.Type); {
+	case f.Type == "*time.Time" && a.ProtoPtypes:
+		body = fmt.Sprintf(`%s, err := ptypes.Timestamp(%s)
+if err != nil {
+	return nil, err
+}
+`, v, pb)
+	case f.Type == "*time.Time":
+		body = fmt.Sprintf(`if err := %s.CheckValid(); err != nil {
+	return nil, err
+}
+%s := %s.AsTime()
+`, pb, v, pb)
+	case scalar == "":
+		return "", false
+	default:
+		value := "*" + pb
+		if !a.ProtoOptional {
+			value = pb + ".Value"
+		}
+		if f.Type == "*uuid.UUID" {
+			body = fmt.Sprintf(`%s, err := uuid.Parse(%s)
+if err != nil {
+	return nil, err
+}
+`, v, value)
+			break
+		}
+		if protogotype(scalar) != f.Type[1:] {
+			value = fmt.Sprintf("%s(%s)", f.Type[1:], value)
+		}
+		body = fmt.Sprintf("%s := %s\n", v, value)
+	}
+
+	return fmt.Sprintf("if %s != nil {\n%s%s = &%s\n}\n", pb, body, dst, v), true
+}
+
+// protogotype returns the Go type of the proto scalar type scalar.
+func protogotype(scalar string) string {
+	if typ, ok := protoGoTypes[scalar]; ok {
+		return typ
+	}
+	return scalar
+}
+
+// pbtestfields returns the fields of a composite literal of the model of
+// option, set to representative values, for the round trip tests of the proto
+// converters. The nullable fields are left null with nulls. The fields
+// without proto conversion, or of a Go type without a representative value,
+// are left unset, as are the fields of a oneof but its first one.
+func (a *ArgType) pbtestfields(option *MethodsOption, nulls bool) string {
+	c := option.ModelToPBConfig
+
+	var fields []string
+	set := func(f *Field, value string) {
+		fields = append(fields, fmt.Sprintf("%s: %s,", f.Name, value))
+	}
+	for _, f := range option.Type.Fields {
+		if _, ok := c.SkipFields[f.Col.ColumnName]; ok {
+			continue
+		}
+		if name, ok := c.OneofNames[f.Col.ColumnName]; ok && a.oneoffields(option, name)[0] != f {
+			continue
+		}
+		if _, ok := a.modeltopbfield(c, f, "", ""); !ok {
+			continue
+		}
+
+		var value string
+		switch _, isJSON := c.JSONFields[f.Col.ColumnName]; {
+		case isJSON:
+			value = a.pbtestvalue(f.Type, "`{\"key\":\"value\"}`", nulls && !f.Col.NotNull)
+		case c.MoneyFields[f.Col.ColumnName] != nil:
+			cur := c.MoneyFields[f.Col.ColumnName]
+			if v := a.pbtestvalue(cur.Type, `"USD"`, nulls && !cur.Col.NotNull); v != "" {
+				set(cur, v)
+			}
+			value = a.pbtestvalue(f.Type, "", nulls && !f.Col.NotNull)
+		case a.googletype(f) == "Date":
+			value = a.pbtestvalue(f.Type, "time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)", nulls && !f.Col.NotNull)
+		case a.googletype(f) == "TimeOfDay" && f.Type == "string":
+			value = `"15:04:05.000000000"`
+		case a.googletype(f) == "TimeOfDay":
+			value = a.pbtestvalue(f.Type, "time.Date(0, 1, 1, 15, 4, 5, 0, time.UTC)", nulls && !f.Col.NotNull)
+		default:
+			value = a.pbtestvalue(f.Type, "", nulls && !f.Col.NotNull)
+		}
+		if value != "" {
+			set(f, value)
+		}
+	}
+
+	return strings.Join(fields, "\n")
+}
+
+// pbtestvalue returns a representative Go value of the Go type typ, or
+// value when not empty (ie, the JSON of a string), or an empty string when
+// typ has none or when null.
+func (a *ArgType) pbtestvalue(typ, value string, null bool) string {
+	if null {
+		return ""
+	}
+
+	base := a.basenulltype(typ)
+	switch {
+	case strings.HasPrefix(typ, "*"):
+		if v := a.pbtestvalue(typ[1:], value, false); v != "" {
+			return fmt.Sprintf("func() %s { v := %s(%s); return &v }()", typ, typ[1:], v)
+		}
+		return ""
+	case base == "mysql.NullTime" || base == "pq.NullTime" || base == "sql.NullTime":
+		return fmt.Sprintf("%s{Time: %s, Valid: true}", typ, a.pbtestvalue("time.Time", value, false))
+	case base == "uuid.NullUUID":
+		return fmt.Sprintf("%s{UUID: %s, Valid: true}", typ, a.pbtestvalue("uuid.UUID", value, false))
+	case base == "decimal.NullDecimal":
+		return fmt.Sprintf("%s{Decimal: %s, Valid: true}", typ, a.pbtestvalue("decimal.Decimal", value, false))
+	case strings.HasPrefix(base, "sql.Null"):
+		if v := a.pbtestvalue(strings.ToLower(base[8:]), value, false); v != "" {
+			return fmt.Sprintf("%s{%s: %s, Valid: true}", typ, a.nullvaluefield(typ), v)
+		}
+		return ""
+	}
+
+	switch {
+	case value != "" && typ == "[]byte":
+		return fmt.Sprintf("[]byte(%s)", value)
+	case value != "" && (typ == "string" || typ == "time.Time"):
+		return value
+	case value != "":
+		return ""
+	case typ == "string":
+		return `"value"`
+	case typ == "[]byte":
+		return `[]byte("value")`
+	case typ == "bool":
+		return "true"
+	case typ == "float32" || typ == "float64":
+		return "1.5"
+	case strings.HasPrefix(strings.TrimPrefix(typ, "u"), "int"):
+		return "7"
+	case typ == "time.Time":
+		return "time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)"
+	case typ == "uuid.UUID":
+		return `uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")`
+	case typ == "decimal.Decimal":
+		// the exponent of the decimals converted from proto messages
+		return "decimal.New(1500000000, -9)"
+	}
+
+	return ""
+}
+
+// wrapperspkg returns the name of the Go package of the google.protobuf
+// wrapper types, wrappers with ProtoPtypes, or wrapperspb.
+func (a *ArgType) wrapperspkg() string {
+	if a.ProtoPtypes {
+		return "wrappers"
+	}
+	return "wrapperspb"
+}
+
+// maskfields returns the fields of the model of option that can be set
+// from a field mask, the ones converted from the proto message that can be
+// updated. Only the first field of a oneof is returned, setting all of them.
+func (a *ArgType) maskfields(option *MethodsOption) []*Field {
+	ignore := map[*Field]bool{}
+	for _, f := range a.updateignore(option.Type) {
+		ignore[f] = true
+	}
+
+	var fields []*Field
+	for _, f := range option.Type.Fields {
+		if _, ok := option.ModelToPBConfig.SkipFields[f.Col.ColumnName]; ok || ignore[f] {
+			continue
+		}
+		if name, ok := option.ModelToPBConfig.OneofNames[f.Col.ColumnName]; ok && a.oneoffields(option, name)[0] != f {
+			continue
+		}
+		if _, ok := a.pbtomodelfield(option.ModelToPBConfig, f, "", ""); ok {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// applyfieldmask returns the cases of the switch on the paths of a field
+// mask, setting the fields of the model of option (see maskfields) to the
+// fields of the proto message, the nullable ones being reset when not set in
+// the message. The currency columns of the money amounts, and the columns of
+// the fields renamed by xo:pb directives, are added to the columns to update.
+// The paths of the fields of a oneof set all of them, to the variant of the
+// message.
+func (a *ArgType) applyfieldmask(option *MethodsOption) string {
+	if !option.ModelToPB {
+		return ""
+	}
+
+	shortName := a.shortname(option.Type.Name)
+
+	var body string
+	for _, field := range a.maskfields(option) {
+		if name, ok := option.ModelToPBConfig.OneofNames[field.Col.ColumnName]; ok {
+			var paths, cols, reset []string
+			fields := a.oneoffields(option, name)
+			for _, f := range fields {
+				paths, cols = append(paths, strconv.Quote(protoname(f))), append(cols, strconv.Quote(f.Col.ColumnName))
+				if strings.HasPrefix(f.Type, "*") {
+					reset = append(reset, fmt.Sprintf("%s.%s = nil\n", shortName, f.Name))
+				} else {
+					reset = append(reset, fmt.Sprintf("%s.%s = %s{}\n", shortName, f.Name, f.Type))
+				}
+			}
+			body = body + fmt.Sprintf("case %s:\n%s%scols = append(cols, %s)\ncontinue\n", strings.Join(paths, ", "), strings.Join(reset, ""), a.pbtooneof(option, name, fields, "proto"+option.Type.Name, shortName), strings.Join(cols, ", "))
+			continue
+		}
+		dst := shortName + "." + field.Name
+		fa, _ := a.pbtomodelfield(option.ModelToPBConfig, field, fmt.Sprintf("proto%s.%s", option.Type.Name, pbname(protoname(field))), dst)
+		switch {
+		case strings.HasPrefix(field.Type, "*"):
+			fa = fmt.Sprintf("%s = nil\n", dst) + fa
+		case !field.Col.NotNull && field.Type != "[]byte":
+			fa = fmt.Sprintf("%s = %s{}\n", dst, field.Type) + fa
+		}
+		// the currency of a money amount is updated along with it
+		if cur, ok := option.ModelToPBConfig.MoneyFields[field.Col.ColumnName]; ok {
+			if !cur.Col.NotNull {
+				fa = fmt.Sprintf("%s.%s = %s{}\n", shortName, cur.Name, cur.Type) + fa
+			}
+			fa = fa + fmt.Sprintf("cols = append(cols, %q)\n", cur.Col.ColumnName)
+		}
+		// the paths of the renamed fields are not their columns
+		if name := protoname(field); name != field.Col.ColumnName {
+			fa = fa + fmt.Sprintf("cols = append(cols, %q)\ncontinue\n", field.Col.ColumnName)
+		}
+		body = body + fmt.Sprintf("case %q:\n%s", protoname(field), fa)
+	}
+
+	return body
+}
+
+func (a *ArgType) proto(pc ProtoConfig) string {
+	if len(pc) == 0 {
+		return ""
+	}
+	sort.Stable(pc)
+
+	svc := pc[0].ModelToPBConfig.ImportService
+	var body string
+
+	imports := make([]string, 0)
+	m := make(map[string]struct{}, 0)
+	var validate bool
+	for _, p := range pc {
+		for _, f := range p.Type.Fields {
+			if _, ok := p.ModelToPBConfig.SkipFields[f.Col.ColumnName]; ok {
+				continue
+			}
+			if a.protorules(p, f) != "" {
+				validate = true
+			}
+			if _, ok := p.ModelToPBConfig.OneofNames[f.Col.ColumnName]; ok {
+				f, _, _ = a.oneoffield(f, "")
+			}
+			i, ok := a.ImportMap[f.Type]
+			kind, isJSON := p.ModelToPBConfig.JSONFields[f.Col.ColumnName]
+			_, isMoney := p.ModelToPBConfig.MoneyFields[f.Col.ColumnName]
+			_, opt := a.OptionalTypeMap[a.basenulltype(f.Type)]
+			switch gt := a.googletype(f); {
+			case isJSON:
+				i, ok = "google/protobuf/struct.proto", kind == "struct"
+			case isMoney:
+				i, ok = "google/type/money.proto", true
+			case gt != "":
+				i, ok = "google/type/"+strings.ToLower(gt)+".proto", true
+			case f.Type == "*time.Time":
+				i, ok = "google/protobuf/timestamp.proto", true
+			case a.pointerscalar(f.Type) != "":
+				i, ok = "google/protobuf/wrappers.proto", !a.ProtoOptional
+			case (opt || f.Type == "uuid.NullUUID") && a.ProtoOptional:
+				continue
+			}
+			if ok {
+				if _, ok = m[i]; ok {
+					continue
+				}
+				m[i] = struct{}{}
+				imports = append(imports, fmt.Sprintf(`import "%s";`, i))
+			}
+		}
+	}
+	if a.ProtoServices {
+		imports = append(imports, `import "google/protobuf/field_mask.proto";`)
+	}
+	if validate {
+		imports = append(imports, `import "validate/validate.proto";`)
+	}
+	if a.ProtoServices && a.ProtoHTTP {
+		for _, p := range pc {
+			if p.Type.PrimaryKey != nil {
+				imports = append(imports, `import "google/api/annotations.proto";`)
+				break
+			}
+		}
+	}
+
+	h := a.protoheader(svc)
+	options := []string{fmt.Sprintf("go_package = %q", h.GoPackage)}
+	if h.JavaPackage != "" {
+		options = append(options, fmt.Sprintf("java_package = %q", h.JavaPackage))
+	}
+	options = append(options, fmt.Sprintf("java_multiple_files = %t", *h.JavaMultipleFiles))
+	if *h.ObjcClassPrefix != "" {
+		options = append(options, fmt.Sprintf("objc_class_prefix = %q", *h.ObjcClassPrefix))
+	}
+	for _, o := range append(options, h.Options...) {
+		o = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(o), "option "), ";")
+		body = body + fmt.Sprintf("option %s;\n", o)
+	}
+
+	body = fmt.Sprintf(
+		`syntax = "proto3";
+
+package %s;
+
+%s
+
+%s
+`, h.Package, strings.Join(imports, "\n"), body)
+
+	for _, p := range pc {
+		fields := make([]*Field, 0, len(p.Type.Fields))
+		names := make([]string, 0, len(p.Type.Fields))
+		for _, f := range p.Type.Fields {
+			if _, ok := p.ModelToPBConfig.SkipFields[f.Col.ColumnName]; ok {
+				continue
+			}
+			fields = append(fields, f)
+			names = append(names, protoname(f))
+		}
+		nums, reserved := a.protonumbers(p.Type.Name, names)
+
+		fieldsDef := make([]string, 0, len(fields)+2)
+		if len(reserved) != 0 {
+			var ns, quoted []string
+			for _, name := range reserved {
+				ns = append(ns, strconv.Itoa(a.ProtoLock[p.Type.Name][name]))
+				quoted = append(quoted, strconv.Quote(name))
+			}
+			fieldsDef = append(fieldsDef, "\treserved "+strings.Join(ns, ", ")+";", "\treserved "+strings.Join(quoted, ", ")+";")
+		}
+		fieldDef := func(f *Field, typ, indent string) string {
+			name := protoname(f)
+			def := fmt.Sprintf("%s%s %s = %d%s;", indent, typ, name, nums[name], protooptions(protojsonname(h.JSONName, name), a.protorules(p, f)))
+			comment := f.Comment
+			if comment == "" {
+				comment = f.Col.ColumnComment
+			}
+			if c := protocomment(comment, indent); c != "" {
+				def = c + "\n" + def
+			}
+			return def
+		}
+		for _, f := range fields {
+			name, ok := p.ModelToPBConfig.OneofNames[f.Col.ColumnName]
+			if !ok {
+				fieldsDef = append(fieldsDef, fieldDef(f, a.protofieldtype(p, f), "\t"))
+				continue
+			}
+			// the fields of a oneof are declared at the first one
+			oneof := a.oneoffields(p, name)
+			if oneof[0] != f {
+				continue
+			}
+			defs := []string{"\toneof " + name + " {"}
+			for _, f := range oneof {
+				vf, _, _ := a.oneoffield(f, "")
+				defs = append(defs, fieldDef(f, a.protofieldtype(p, vf), "\t\t"))
+			}
+			fieldsDef = append(fieldsDef, strings.Join(append(defs, "\t}"), "\n"))
+		}
+		if c := protocomment(p.Type.Table.TableComment, ""); c != "" {
+			body = body + c + "\n"
+		}
+		body = body + fmt.Sprintf(
+			`message %s {
+%s
+}
+`, p.Type.Name, strings.Join(fieldsDef, "\n"))
+	}
+
+	if a.ProtoServices {
+		for _, p := range pc {
+			body = body + a.protoservice(p, h)
+		}
+	}
+	return body
+}
+
+// protocomment returns the text of a table or column comment as the indented
+// "// " lines of a proto comment, with its xo directives and control
+// characters dropped, and its lines trimmed. Returns an empty string when
+// nothing is left.
+func protocomment(text, indent string) string {
+	text = tagDirectiveRE.ReplaceAllString(text, "")
+	text = counterDirectiveRE.ReplaceAllString(text, "")
+	text = pbDirectiveRE.ReplaceAllString(text, "")
+
+	var lines []string
+	for _, l := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		l = strings.TrimSpace(strings.Map(func(r rune) rune {
+			switch {
+			case r == '\t':
+				return ' '
+			case unicode.IsControl(r):
+				return -1
+			}
+			return r
+		}, l))
+		if l == "" && (len(lines) == 0 || lines[len(lines)-1] == indent+"//") {
+			continue
+		}
+		lines = append(lines, strings.TrimSuffix(indent+"// "+l, " "))
+	}
+	if len(lines) != 0 && lines[len(lines)-1] == indent+"//" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// protoSnakeRE matches the lower_snake_case names, which the buf lint rules
+// require of the proto packages and fields.
+var protoSnakeRE = regexp.MustCompile(`^[a-z][a-z0-9]*(?:_[a-z0-9]+)*$`)
+
+// protolint returns the warnings of the package of the service svc, and the
+// fields of the messages of pc, breaking the buf lint naming rules
+// (PACKAGE_LOWER_SNAKE_CASE and FIELD_LOWER_SNAKE_CASE).
+func (a *ArgType) protolint(svc string, pc ProtoConfig) []string {
+	var warnings []string
+	pkg := a.protoheader(svc).Package
+	for _, name := range strings.Split(pkg, ".") {
+		if !protoSnakeRE.MatchString(name) {
+			warnings = append(warnings, fmt.Sprintf("proto package %s is not lower_snake_case", pkg))
+			break
+		}
+	}
+	for _, p := range pc {
+		for _, f := range p.Type.Fields {
+			if _, ok := p.ModelToPBConfig.SkipFields[f.Col.ColumnName]; ok {
+				continue
+			}
+			if name := protoname(f); !protoSnakeRE.MatchString(name) {
+				warnings = append(warnings, fmt.Sprintf("proto field %s.%s is not lower_snake_case", p.Type.Name, name))
+			}
+		}
+		var oneofs []string
+		for name := range p.ModelToPBConfig.Oneofs {
+			if !protoSnakeRE.MatchString(name) {
+				oneofs = append(oneofs, name)
+			}
+		}
+		sort.Strings(oneofs)
+		for _, name := range oneofs {
+			warnings = append(warnings, fmt.Sprintf("proto oneof %s.%s is not lower_snake_case", p.Type.Name, name))
+		}
+	}
+
+	return warnings
+}
+
+// protonumbers returns the field numbers of the columns cols of the proto
+// message name, numbered in order. With the lock of the field numbers, the
+// locked columns keep their number, and the new columns get the numbers after
+// the last locked one, which are added to the lock. The locked columns no
+// longer in cols are also returned, in the order of their numbers, so that
+// their numbers and names are reserved.
+func (a *ArgType) protonumbers(name string, cols []string) (map[string]int, []string) {
+	nums := make(map[string]int, len(cols))
+	if a.ProtoLock == nil {
+		for i, col := range cols {
+			nums[col] = i + 1
+		}
+		return nums, nil
+	}
+
+	lock := a.ProtoLock[name]
+	if lock == nil {
+		lock = map[string]int{}
+		a.ProtoLock[name] = lock
+	}
+
+	next := 1
+	for _, n := range lock {
+		if n >= next {
+			next = n + 1
+		}
+	}
+	for _, col := range cols {
+		n, ok := lock[col]
+		if !ok {
+			n, lock[col] = next, next
+			next++
+		}
+		nums[col] = n
+	}
+
+	var reserved []string
+	for col := range lock {
+		if _, ok := nums[col]; !ok {
+			reserved = append(reserved, col)
+		}
+	}
+	sort.Slice(reserved, func(i, j int) bool {
+		return lock[reserved[i]] < lock[reserved[j]]
+	})
+
+	return nums, reserved
+}
+
+// prototype returns the proto type of the field f (ie, int32 for an int, or
+// google.protobuf.StringValue for a sql.NullString).
+func (a *ArgType) prototype(f *Field) string {
+	typ := f.Type
+	if v, ok := a.ToPBTypeMap[f.Type]; ok {
+		typ = v
+	}
+	if v, ok := a.WrapperTypeMap[a.basenulltype(f.Type)]; ok {
+		typ = "google.protobuf." + v
+	}
+
+	if v, ok := a.OptionalTypeMap[a.basenulltype(f.Type)]; ok && a.ProtoOptional {
+		typ = "optional " + v
+	}
+
+	switch {
+	case a.googletype(f) != "":
+		typ = "google.type." + a.googletype(f)
+	case f.Type == "uuid.UUID":
+		typ = "string"
+	case f.Type == "uuid.NullUUID" && a.ProtoOptional:
+		typ = "optional string"
+	case f.Type == "uuid.NullUUID":
+		typ = "google.protobuf.StringValue"
+	case a.basenulltype(f.Type) == "mysql.NullTime" || f.Type == "time.Time" || f.Type == "*time.Time":
+		typ = "google.protobuf.Timestamp"
+	case a.pointerscalar(f.Type) != "" && a.ProtoOptional:
+		typ = "optional " + a.pointerscalar(f.Type)
+	case a.pointerscalar(f.Type) != "":
+		typ = "google.protobuf." + protoWrapperTypes[a.pointerscalar(f.Type)]
+	}
+
+	return typ
+}
+
+// protofieldtype returns the proto type of the field f of the message of p,
+// the JSON, money and field type overrides of the columns replacing its
+// prototype.
+func (a *ArgType) protofieldtype(p *MethodsOption, f *Field) string {
+	if kind, ok := p.ModelToPBConfig.JSONFields[f.Col.ColumnName]; ok {
+		return jsonProtoTypes[kind]
+	}
+	if scalar, ok := p.ModelToPBConfig.FieldTypes[f.Col.ColumnName]; ok {
+		switch {
+		case f.Col.NotNull || f.Type == "[]byte":
+			return scalar
+		case a.ProtoOptional:
+			return "optional " + scalar
+		}
+		return "google.protobuf." + protoWrapperTypes[scalar]
+	}
+	if _, ok := p.ModelToPBConfig.MoneyFields[f.Col.ColumnName]; ok {
+		return "google.type.Money"
+	}
+
+	return a.prototype(f)
+}
+
+// charLenRE matches the length of the character column types (ie, 255 for
+// varchar(255), or character varying(255)).
+var charLenRE = regexp.MustCompile(`^n?(?:var)?char2?(?:acter)?(?: varying)?\((\d+)\)`)
+
+// checkRules are the protoc-gen-validate rules of the operators of the
+// bounds of a field.
+var checkRules = map[string]string{">": "gt", ">=": "gte", "<": "lt", "<=": "lte"}
+
+// protorules returns the protoc-gen-validate rules of the field f of the
+// message of p, with ProtoValidate: the NOT NULL well-known message fields,
+// not set by the database nor by the generated funcs, are required, the
+// strings are limited to the length of their column and to the values
+// allowed by the check constraints (see Field.In), and the numbers are
+// bounded by the check constraints (see Field.Bounds). Returns an empty
+// string when there are no rules.
+func (a *ArgType) protorules(p *MethodsOption, f *Field) string {
+	if !a.ProtoValidate {
+		return ""
+	}
+
+	typ := strings.TrimPrefix(a.protofieldtype(p, f), "optional ")
+	for scalar, wrapper := range protoWrapperTypes {
+		if typ == "google.protobuf."+wrapper {
+			typ = scalar
+		}
+	}
+
+	var rules []string
+	switch typ {
+	case "string":
+		if m := charLenRE.FindStringSubmatch(strings.ToLower(f.Col.DataType)); m != nil {
+			rules = append(rules, "max_len: "+m[1])
+		}
+		if len(f.In) != 0 {
+			in := make([]string, len(f.In))
+			for i, v := range f.In {
+				in[i] = strconv.Quote(v)
+			}
+			rules = append(rules, "in: ["+strings.Join(in, ", ")+"]")
+		}
+	case "int32", "int64", "uint32", "uint64", "sint32", "sint64", "fixed32", "fixed64", "sfixed32", "sfixed64", "float", "double":
+		for _, op := range []string{">", ">=", "<", "<="} {
+			v, ok := f.Bounds[op]
+			switch {
+			case !ok:
+			case strings.Contains(v, ".") && typ != "float" && typ != "double":
+			case strings.HasPrefix(v, "-") && (strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "fixed")):
+			default:
+				rules = append(rules, checkRules[op]+": "+v)
+			}
+		}
+	default:
+		t := p.Type
+		if !strings.HasPrefix(typ, "google.") || !f.Col.NotNull || f.Col.DefaultValue.Valid || f.IsGenerated || f.AutoUpdate || f == t.CreatedAtField || f == t.UpdatedAtField {
+			return ""
+		}
+		return "(validate.rules).message.required = true"
+	}
+	if len(rules) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("(validate.rules).%s = {%s}", typ, strings.Join(rules, ", "))
+}
+
+// protoWrapperTypes are the google.protobuf wrapper types of the proto scalar
+// types.
+var protoWrapperTypes = map[string]string{
+	"string": "StringValue",
+	"bytes":  "BytesValue",
+	"bool":   "BoolValue",
+	"int32":  "Int32Value",
+	"int64":  "Int64Value",
+	"uint32": "UInt32Value",
+	"uint64": "UInt64Value",
+	"float":  "FloatValue",
+	"double": "DoubleValue",
+}
+
+// protoGoTypes are the Go types of the proto scalar types, which are not
+// named the same.
+var protoGoTypes = map[string]string{
+	"bytes":    "[]byte",
+	"float":    "float32",
+	"double":   "float64",
+	"sint32":   "int32",
+	"sint64":   "int64",
+	"fixed32":  "uint32",
+	"fixed64":  "uint64",
+	"sfixed32": "int32",
+	"sfixed64": "int64",
+}
+
+// protoScalarTypes are the proto scalar types a column can be overridden to
+// with the field_types of the model_to_pb config.
+var protoScalarTypes = map[string]bool{
+	"double": true, "float": true, "int32": true, "int64": true,
+	"uint32": true, "uint64": true, "sint32": true, "sint64": true,
+	"fixed32": true, "fixed64": true, "sfixed32": true, "sfixed64": true,
+	"bool": true, "string": true, "bytes": true,
+}
+
+// pointerscalar returns the proto scalar type of the element type of the
+// pointer Go type typ (ie, int32 for an *int, or string for a *uuid.UUID).
+// Returns an empty string when typ is not a pointer to a Go scalar type.
+func (a *ArgType) pointerscalar(typ string) string {
+	elem := strings.TrimPrefix(typ, "*")
+	if elem == typ {
+		return ""
+	}
+	if v, ok := a.ToPBTypeMap[elem]; ok {
+		elem = v
+	}
+	if elem == "uuid.UUID" {
+		return "string"
+	}
+	if _, ok := protoWrapperTypes[elem]; !ok {
+		return ""
+	}
+
+	return elem
+}
+
+// protoservice returns the service of the table of p, with the Get, List,
+// Create, Update and Delete RPCs of its message, and their request and
+// response messages. The Get and Delete requests have the primary key fields
+// of the table. Returns an empty string when the table has no primary key.
+// The fields have the json_name option of the header h (see protojsonname),
+// and the methods the google.api.http annotations of their REST mapping
+// under its http_prefix with ProtoHTTP (ie, GET /v1/authors/{id}).
+func (a *ArgType) protoservice(p *MethodsOption, h ProtoHeaderConfig) string {
+	t := p.Type
+	if t.PrimaryKey == nil {
+		return ""
+	}
+
+	name, plural := t.Name, inflector.Pluralize(t.Name)
+	field, fields := snaker.CamelToSnake(name), snaker.CamelToSnake(plural)
+	jsonName := h.JSONName
+
+	var keys, keyPath, updatePath []string
+	for i, f := range t.PrimaryKeyFields {
+		name := protoname(f)
+		keys = append(keys, fmt.Sprintf("\t%s %s = %d%s;", a.prototype(f), name, i+1, protooptions(protojsonname(jsonName, name))))
+		keyPath = append(keyPath, "{"+name+"}")
+		updatePath = append(updatePath, "{"+field+"."+name+"}")
+	}
+	key := strings.Join(keys, "\n")
+
+	base := strings.TrimSuffix(h.HTTPPrefix, "/") + "/" + fields
+	methods := []struct {
+		name, verb, path, body string
+	}{
+		{"Get" + name, "get", base + "/" + strings.Join(keyPath, "/"), ""},
+		{"List" + plural, "get", base, ""},
+		{"Create" + name, "post", base, field},
+		{"Update" + name, "patch", base + "/" + strings.Join(updatePath, "/"), field},
+		{"Delete" + name, "delete", base + "/" + strings.Join(keyPath, "/"), ""},
+	}
+	var rpcs string
+	for _, m := range methods {
+		rpc := fmt.Sprintf("\trpc %[1]s(%[1]sRequest) returns (%[1]sResponse)", m.name)
+		if !a.ProtoHTTP {
+			rpcs = rpcs + rpc + ";\n"
+			continue
+		}
+		var body string
+		if m.body != "" {
+			body = fmt.Sprintf("\n\t\t\tbody: %q", m.body)
+		}
+		rpcs = rpcs + fmt.Sprintf("%s {\n\t\toption (google.api.http) = {\n\t\t\t%s: %q%s\n\t\t};\n\t}\n", rpc, m.verb, m.path, body)
+	}
+
+	return fmt.Sprintf(`
+// %[1]sService is the CRUD service of %[1]s.
+service %[1]sService {
+%[11]s}
+
+message Get%[1]sRequest {
+%[5]s
+}
+
+message Get%[1]sResponse {
+	%[1]s %[3]s = 1%[6]s;
+}
+
+message List%[2]sRequest {
+	int32 limit = 1%[8]s;
+	int32 offset = 2%[9]s;
+}
+
+message List%[2]sResponse {
+	repeated %[1]s %[4]s = 1%[7]s;
+}
+
+message Create%[1]sRequest {
+	%[1]s %[3]s = 1%[6]s;
+}
+
+message Create%[1]sResponse {
+	%[1]s %[3]s = 1%[6]s;
+}
+
+message Update%[1]sRequest {
+	%[1]s %[3]s = 1%[6]s;
+	google.protobuf.FieldMask update_mask = 2%[10]s;
+}
+
+message Update%[1]sResponse {
+	%[1]s %[3]s = 1%[6]s;
+}
+
+message Delete%[1]sRequest {
+%[5]s
+}
+
+message Delete%[1]sResponse {
+}
+`, name, plural, field, fields, key,
+		protooptions(protojsonname(jsonName, field)), protooptions(protojsonname(jsonName, fields)),
+		protooptions(protojsonname(jsonName, "limit")), protooptions(protojsonname(jsonName, "offset")),
+		protooptions(protojsonname(jsonName, "update_mask")), rpcs)
+}
+
+// protojsonname returns the json_name option of the proto field name, for
+// the json_name header config mode: name itself for snake, and the
+// lowerCamelCase name protoc derives for camel (ie, authorId for author_id).
+// Returns an empty string when mode is empty.
+func protojsonname(mode, name string) string {
+	switch mode {
+	case "snake":
+		return fmt.Sprintf("json_name = %q", name)
+	case "camel":
+		var r string
+		upper := false
+		for _, c := range name {
+			switch {
+			case c == '_':
+				upper = true
+			case upper:
+				r, upper = r+strings.ToUpper(string(c)), false
+			default:
+				r += string(c)
+			}
+		}
+		return fmt.Sprintf("json_name = %q", r)
+	}
+
+	return ""
+}
+
+// protooptions returns the options of a proto field, following its number,
+// skipping the empty ones. Returns an empty string when there are none.
+func protooptions(opts ...string) string {
+	var res []string
+	for _, o := range opts {
+		if o != "" {
+			res = append(res, o)
+		}
+	}
+	if len(res) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(res, ", ") + "]"
+}
+
+// pbname returns the Go name of the field or message name of a proto message
+// (ie, AuthorId for author_id, and BookTag for the BookTag type).
+func pbname(name string) string {
+	return SnakeToCamelWithoutInitialisms(snaker.CamelToSnake(name))
+}
+
+// protoname returns the name of the field f in the proto message, the one
+// set by the xo:pb directive of its column comment, or its column name.
+func protoname(f *Field) string {
+	if f.PBName != "" && f.PBName != "-" {
+		return f.PBName
+	}
+	return f.Col.ColumnName
+}
+
+// pbkeys returns the statements declaring the params of the fields of a
+// primary key index (see goparamlist) from the fields of the proto request v,
+// returning a codes.InvalidArgument error when they cannot be converted (ie,
+// an invalid UUID).
+func (a *ArgType) pbkeys(fields []*Field, v string) string {
+	var s string
+	for _, f := range fields {
+		name, pb := a.goparamlist([]*Field{f}, false, false), v+"."+pbname(protoname(f))
+		switch t, ok := a.ToPBTypeMap[f.Type]; {
+		case f.Type == "uuid.UUID":
+			s += fmt.Sprintf("\t%s, err := uuid.Parse(%s)\n\tif err != nil {\n\t\treturn nil, status.Error(codes.InvalidArgument, err.Error())\n\t}\n", name, pb)
+		case ok && !a.IncompatilbePBType[t]:
+			s += fmt.Sprintf("\t%s := %s(%s)\n", name, a.retype(f.Type), pb)
+		default:
+			s += fmt.Sprintf("\t%s := %s\n", name, pb)
+		}
+	}
+
+	return s
+}
+
+// pkindex returns the index of the primary key of the table t, or nil when
+// it has no index func.
+func pkindex(t *Type) *Index {
+	for _, ix := range t.Indexes {
+		if ix.Index.IsPrimary && ix.FuncName != "" {
+			return ix
+		}
+	}
+
+	return nil
+}
+
+// proto 对于 id -> Id
+func SnakeToCamelWithoutInitialisms(str string) string {
+	var r string
+
+	for _, w := range strings.Split(str, "_") {
+		if w == "" {
 			continue
 		}
 		r += strings.ToUpper(w[:1]) + strings.ToLower(w[1:])
@@ -1006,6 +3721,56 @@ func SnakeToCamelWithoutInitialisms(str string) string {
 	return r
 }
 
+// protoheader returns the header config of the proto output of the service
+// svc, with the defaults of the unset values, and {service} replaced.
+func (a *ArgType) protoheader(svc string) ProtoHeaderConfig {
+	var h ProtoHeaderConfig
+	if a.Methods != nil && a.Methods.Proto != nil {
+		h = *a.Methods.Proto
+	}
+
+	if h.Package == "" {
+		h.Package = "proto.{service}"
+	}
+	if h.GoPackage == "" {
+		h.GoPackage = a.ServerProtoPathPrefix + "/service/{service}"
+	}
+	if h.JavaMultipleFiles == nil {
+		multiple := true
+		h.JavaMultipleFiles = &multiple
+	}
+	if h.ObjcClassPrefix == nil {
+		prefix := "RPC"
+		h.ObjcClassPrefix = &prefix
+	}
+	if h.HTTPPrefix == "" {
+		h.HTTPPrefix = "/v1"
+	}
+
+	h.Package = strings.ReplaceAll(h.Package, "{service}", ProtoName(svc))
+	h.GoPackage = strings.ReplaceAll(h.GoPackage, "{service}", goPackageName(svc))
+	h.JavaPackage = strings.ReplaceAll(h.JavaPackage, "{service}", ProtoName(svc))
+	h.HTTPPrefix = strings.ReplaceAll(h.HTTPPrefix, "{service}", ProtoName(svc))
+	return h
+}
+
+// protogopackage returns the import path and the name of the Go package of
+// the proto messages of the service svc, from its go_package option.
+func (a *ArgType) protogopackage(svc string) (string, string) {
+	importPath := a.protoheader(svc).GoPackage
+	if i := strings.LastIndex(importPath, ";"); i != -1 {
+		return importPath[:i], importPath[i+1:]
+	}
+	return importPath, path.Base(importPath)
+}
+
+// protogoname returns the name of the Go package of the proto messages of
+// the service svc.
+func (a *ArgType) protogoname(svc string) string {
+	_, name := a.protogopackage(svc)
+	return name
+}
+
 // goPackageName public_story -> publicstory
 func goPackageName(name string) string {
 	name = strings.ReplaceAll(name, "-", "")
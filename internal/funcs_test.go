@@ -0,0 +1,501 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/sundayfun/xo/models"
+)
+
+func Test_NowValue(t *testing.T) {
+	tests := []struct {
+		desc string
+		typ  string
+		exp  string
+	}{
+		{
+			desc: "time.Time is now",
+			typ:  "time.Time",
+			exp:  "now",
+		},
+		{
+			desc: "sql.NullTime is valid",
+			typ:  "sql.NullTime",
+			exp:  "sql.NullTime{Time: now, Valid: true}",
+		},
+		{
+			desc: "mysql.NullTime is valid",
+			typ:  "mysql.NullTime",
+			exp:  "mysql.NullTime{Time: now, Valid: true}",
+		},
+		{
+			desc: "pq.NullTime is valid",
+			typ:  "pq.NullTime",
+			exp:  "pq.NullTime{Time: now, Valid: true}",
+		},
+		{
+			desc: "pgtype.Timestamptz is valid",
+			typ:  "pgtype.Timestamptz",
+			exp:  "pgtype.Timestamptz{Time: now, Valid: true}",
+		},
+		{
+			desc: "null type replacing sql.NullTime is valid",
+			typ:  "null.Time",
+			exp:  "null.Time{Time: now, Valid: true}",
+		},
+		{
+			desc: "sqlite time has no Valid field",
+			typ:  "xoutil.SqTime",
+			exp:  "xoutil.SqTime{Time: now}",
+		},
+	}
+
+	a := NewDefaultArgs()
+	a.Methods = &MethodsConfig{NullTypes: map[string]*NullTypeConfig{
+		"sql.NullTime": {Type: "null.Time"},
+	}}
+	for i, tt := range tests {
+		v := a.nowvalue(&Field{Type: tt.typ}, "now")
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_SoftDeleteValue(t *testing.T) {
+	tests := []struct {
+		desc string
+		typ  string
+		exp  string
+	}{
+		{
+			desc: "bool flag is true",
+			typ:  "bool",
+			exp:  "true",
+		},
+		{
+			desc: "mysql deletion time is valid",
+			typ:  "mysql.NullTime",
+			exp:  "mysql.NullTime{Time: time.Now(), Valid: true}",
+		},
+		{
+			desc: "sqlite deletion time has no Valid field",
+			typ:  "xoutil.SqTime",
+			exp:  "xoutil.SqTime{Time: time.Now()}",
+		},
+		{
+			desc: "deleted by is the caller supplied value",
+			typ:  "sql.NullInt64",
+			exp:  "by",
+		},
+	}
+
+	a := NewDefaultArgs()
+	for i, tt := range tests {
+		v := a.softdeletevalue(&Type{SoftDeleteField: &Field{Type: tt.typ}}, "by")
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_ScanField(t *testing.T) {
+	tests := []struct {
+		desc   string
+		loader string
+		typ    string
+		dt     string
+		exp    string
+	}{
+		{
+			desc:   "time without time zone keeps its wall clock",
+			loader: "postgres",
+			typ:    "time.Time",
+			dt:     "timestamp without time zone",
+			exp:    "xoTime{&t.CreatedAt, nil, true}",
+		},
+		{
+			desc:   "nullable time with time zone scans its validity",
+			loader: "postgres",
+			typ:    "sql.NullTime",
+			dt:     "timestamp with time zone",
+			exp:    "xoTime{&t.CreatedAt.Time, &t.CreatedAt.Valid, false}",
+		},
+		{
+			desc:   "mysql timestamp is converted",
+			loader: "mysql",
+			typ:    "mysql.NullTime",
+			dt:     "timestamp",
+			exp:    "xoTime{&t.CreatedAt.Time, &t.CreatedAt.Valid, false}",
+		},
+		{
+			desc:   "sqlite time has no Valid field",
+			loader: "sqlite3",
+			typ:    "xoutil.SqTime",
+			dt:     "datetime",
+			exp:    "xoTime{&t.CreatedAt.Time, nil, true}",
+		},
+		{
+			desc:   "other types are scanned directly",
+			loader: "postgres",
+			typ:    "string",
+			dt:     "text",
+			exp:    "&t.CreatedAt",
+		},
+	}
+
+	a := NewDefaultArgs()
+	a.TimeLocation = "UTC"
+	for i, tt := range tests {
+		a.LoaderType = tt.loader
+		f := &Field{Name: "CreatedAt", Type: tt.typ, Col: &models.Column{DataType: tt.dt}}
+		v := a.scanfield("t", f)
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_SoftDeleteCond(t *testing.T) {
+	tests := []struct {
+		desc   string
+		loader string
+		typ    string
+		exp    string
+	}{
+		{
+			desc:   "postgres flag is false",
+			loader: "postgres",
+			typ:    "bool",
+			exp:    "is_deleted = false",
+		},
+		{
+			desc:   "mssql flag is 0",
+			loader: "mssql",
+			typ:    "bool",
+			exp:    "is_deleted = 0",
+		},
+		{
+			desc:   "oracle flag is 0",
+			loader: "ora",
+			typ:    "bool",
+			exp:    "is_deleted = 0",
+		},
+		{
+			desc:   "mssql deletion time is NULL",
+			loader: "mssql",
+			typ:    "sql.NullTime",
+			exp:    "is_deleted IS NULL",
+		},
+	}
+
+	for i, tt := range tests {
+		a := NewDefaultArgs()
+		a.LoaderType = tt.loader
+		f := &Field{Type: tt.typ, Col: &models.Column{ColumnName: "is_deleted"}}
+		v := a.softdeletecond(&Type{SoftDeleteField: f})
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_SoftDeleteFilter(t *testing.T) {
+	tests := []struct {
+		desc   string
+		loader string
+		exp    string
+	}{
+		{
+			desc:   "mysql flag is false",
+			loader: "mysql",
+			exp:    "deleted = false",
+		},
+		{
+			desc:   "mssql flag is 0",
+			loader: "mssql",
+			exp:    "deleted = 0",
+		},
+		{
+			desc:   "oci8 flag is 0",
+			loader: "oci8",
+			exp:    "deleted = 0",
+		},
+	}
+
+	for i, tt := range tests {
+		a := NewDefaultArgs()
+		a.LoaderType = tt.loader
+		a.SoftDeleteColumn = "deleted"
+		_, v := a.softdeletefilter(true)
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_MapParam(t *testing.T) {
+	tests := []struct {
+		desc string
+		name string
+		elem bool
+		exp  string
+	}{
+		{
+			desc: "slice of emails",
+			name: "Email",
+			exp:  "emails",
+		},
+		{
+			desc: "element of the emails",
+			name: "Email",
+			elem: true,
+			exp:  "email",
+		},
+		{
+			desc: "slice of ids",
+			name: "ID",
+			exp:  "ids",
+		},
+		{
+			desc: "slice of user ids",
+			name: "UserID",
+			exp:  "userIDs",
+		},
+		{
+			desc: "slice conflicting with a local",
+			name: "Arg",
+			exp:  "argsVal",
+		},
+		{
+			desc: "element of a reserved name",
+			name: "Type",
+			elem: true,
+			exp:  "typ",
+		},
+	}
+
+	a := NewDefaultArgs()
+	for i, tt := range tests {
+		v := a.mapparam(&Index{MapField: &Field{Name: tt.name}}, tt.elem)
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_MergeClause(t *testing.T) {
+	id := &Field{Name: "ID", Col: &models.Column{ColumnName: "id", IsPrimaryKey: true}}
+	name := &Field{Name: "Name", Col: &models.Column{ColumnName: "name"}}
+	created := &Field{Name: "CreatedAt", Col: &models.Column{ColumnName: "created_at"}}
+
+	tests := []struct {
+		desc   string
+		loader string
+		typ    *Type
+		exp    string
+	}{
+		{
+			desc:   "no primary key",
+			loader: "mssql",
+			typ:    &Type{Fields: []*Field{name}},
+			exp:    "",
+		},
+		{
+			desc:   "mssql updates the non key fields",
+			loader: "mssql",
+			typ:    &Type{Fields: []*Field{id, name}, PrimaryKeyFields: []*Field{id}},
+			exp:    "USING (SELECT $1 AS id, $2 AS name) s ON (t.id = s.id) WHEN MATCHED THEN UPDATE SET t.name = s.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (s.id, s.name)",
+		},
+		{
+			desc:   "oracle selects from dual",
+			loader: "ora",
+			typ:    &Type{Fields: []*Field{id, name}, PrimaryKeyFields: []*Field{id}},
+			exp:    "USING (SELECT :1 AS id, :2 AS name FROM dual) s ON (t.id = s.id) WHEN MATCHED THEN UPDATE SET t.name = s.name WHEN NOT MATCHED THEN INSERT (id, name) VALUES (s.id, s.name)",
+		},
+		{
+			desc:   "created at is only inserted",
+			loader: "mssql",
+			typ:    &Type{Fields: []*Field{id, created}, PrimaryKeyFields: []*Field{id}, CreatedAtField: created},
+			exp:    "USING (SELECT $1 AS id, $2 AS created_at) s ON (t.id = s.id) WHEN NOT MATCHED THEN INSERT (id, created_at) VALUES (s.id, s.created_at)",
+		},
+	}
+
+	for i, tt := range tests {
+		a := NewDefaultArgs()
+		a.LoaderType = tt.loader
+		a.Loader = TypeLoader{}
+		if tt.loader == "ora" {
+			a.Loader = TypeLoader{ParamN: func(i int) string { return fmt.Sprintf(":%d", i+1) }}
+		}
+		v := a.mergeclause(tt.typ)
+		if v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
+
+func Test_ProtoNumbers(t *testing.T) {
+	tests := []struct {
+		desc     string
+		lock     map[string]map[string]int
+		cols     []string
+		exp      map[string]int
+		reserved []string
+		expLock  map[string]int
+	}{
+		{
+			desc: "numbered in order without a lock",
+			cols: []string{"id", "title", "summary"},
+			exp:  map[string]int{"id": 1, "title": 2, "summary": 3},
+		},
+		{
+			desc:    "numbered in order and locked",
+			lock:    map[string]map[string]int{},
+			cols:    []string{"id", "title"},
+			exp:     map[string]int{"id": 1, "title": 2},
+			expLock: map[string]int{"id": 1, "title": 2},
+		},
+		{
+			desc:    "column added in the middle is appended",
+			lock:    map[string]map[string]int{"Book": {"id": 1, "title": 2}},
+			cols:    []string{"id", "summary", "title"},
+			exp:     map[string]int{"id": 1, "summary": 3, "title": 2},
+			expLock: map[string]int{"id": 1, "title": 2, "summary": 3},
+		},
+		{
+			desc:     "dropped columns are reserved in order",
+			lock:     map[string]map[string]int{"Book": {"id": 1, "title": 2, "summary": 3, "isbn": 4}},
+			cols:     []string{"id", "title"},
+			exp:      map[string]int{"id": 1, "title": 2},
+			reserved: []string{"summary", "isbn"},
+			expLock:  map[string]int{"id": 1, "title": 2, "summary": 3, "isbn": 4},
+		},
+		{
+			desc:     "column added after the last dropped one",
+			lock:     map[string]map[string]int{"Book": {"id": 1, "title": 2, "summary": 3}},
+			cols:     []string{"id", "title", "isbn"},
+			exp:      map[string]int{"id": 1, "title": 2, "isbn": 4},
+			reserved: []string{"summary"},
+			expLock:  map[string]int{"id": 1, "title": 2, "summary": 3, "isbn": 4},
+		},
+	}
+
+	for i, tt := range tests {
+		a := NewDefaultArgs()
+		a.ProtoLock = tt.lock
+		nums, reserved := a.protonumbers("Book", tt.cols)
+		if !reflect.DeepEqual(nums, tt.exp) {
+			t.Fatalf("test #%d: %s\n\texp: %v\n\tgot: %v", i+1, tt.desc, tt.exp, nums)
+		}
+		if !reflect.DeepEqual(reserved, tt.reserved) {
+			t.Fatalf("test #%d: %s: reserved\n\texp: %v\n\tgot: %v", i+1, tt.desc, tt.reserved, reserved)
+		}
+		if tt.lock != nil && !reflect.DeepEqual(a.ProtoLock["Book"], tt.expLock) {
+			t.Fatalf("test #%d: %s: lock\n\texp: %v\n\tgot: %v", i+1, tt.desc, tt.expLock, a.ProtoLock["Book"])
+		}
+	}
+}
+
+func Test_FieldTypeConversions(t *testing.T) {
+	tests := []struct {
+		desc    string
+		typ     string
+		scalar  string
+		value   string
+		parse   string
+		parsed  string
+		invalid bool
+	}{
+		{
+			desc:   "same Go type",
+			typ:    "int64",
+			scalar: "sint64",
+			value:  "v",
+			parsed: "pb",
+		},
+		{
+			desc:   "number to number",
+			typ:    "int32",
+			scalar: "int64",
+			value:  "int64(v)",
+			parsed: "int32(pb)",
+		},
+		{
+			desc:   "bytes to string",
+			typ:    "[]byte",
+			scalar: "string",
+			value:  "string(v)",
+			parsed: "[]byte(pb)",
+		},
+		{
+			desc:   "int64 to string",
+			typ:    "int64",
+			scalar: "string",
+			value:  "strconv.FormatInt(int64(v), 10)",
+			parse:  "n, err := strconv.ParseInt(pb, 10, 64)\nif err != nil {\n\treturn nil, err\n}\n",
+			parsed: "n",
+		},
+		{
+			desc:   "int16 to string",
+			typ:    "int16",
+			scalar: "string",
+			value:  "strconv.FormatInt(int64(v), 10)",
+			parse:  "nValue, err := strconv.ParseInt(pb, 10, 16)\nif err != nil {\n\treturn nil, err\n}\n",
+			parsed: "int16(nValue)",
+		},
+		{
+			desc:   "uint32 to string",
+			typ:    "uint32",
+			scalar: "string",
+			value:  "strconv.FormatUint(uint64(v), 10)",
+			parse:  "nValue, err := strconv.ParseUint(pb, 10, 32)\nif err != nil {\n\treturn nil, err\n}\n",
+			parsed: "uint32(nValue)",
+		},
+		{
+			desc:   "float32 to string",
+			typ:    "float32",
+			scalar: "string",
+			value:  "strconv.FormatFloat(float64(v), 'g', -1, 32)",
+			parse:  "nValue, err := strconv.ParseFloat(pb, 32)\nif err != nil {\n\treturn nil, err\n}\n",
+			parsed: "float32(nValue)",
+		},
+		{
+			desc:   "bool to string",
+			typ:    "bool",
+			scalar: "string",
+			value:  "strconv.FormatBool(v)",
+			parse:  "n, err := strconv.ParseBool(pb)\nif err != nil {\n\treturn nil, err\n}\n",
+			parsed: "n",
+		},
+		{
+			desc:    "bool to number",
+			typ:     "bool",
+			scalar:  "int64",
+			invalid: true,
+		},
+		{
+			desc:    "time to string",
+			typ:     "time.Time",
+			scalar:  "string",
+			invalid: true,
+		},
+	}
+
+	for i, tt := range tests {
+		if ok := protoconvertible(tt.typ, tt.scalar); ok == tt.invalid {
+			t.Fatalf("test #%d: %s\n\texp convertible: %t\n\tgot: %t", i+1, tt.desc, !tt.invalid, ok)
+		}
+		if tt.invalid {
+			continue
+		}
+		if v := fieldtypevalue(tt.typ, tt.scalar, "v"); v != tt.value {
+			t.Fatalf("test #%d: %s: value\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.value, v)
+		}
+		parse, parsed := fieldtypeparse(tt.typ, tt.scalar, "n", "pb")
+		if parse != tt.parse || parsed != tt.parsed {
+			t.Fatalf("test #%d: %s: parse\n\texp: %q %s\n\tgot: %q %s", i+1, tt.desc, tt.parse, tt.parsed, parse, parsed)
+		}
+	}
+}
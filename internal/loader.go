@@ -3,7 +3,11 @@ package internal
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/gedex/inflector"
 	"github.com/kenshaw/snaker"
@@ -26,6 +30,15 @@ type Loader interface {
 	// Relkind returns the schema's relkind identifier (ie, TABLE, VIEW, BASE TABLE, etc).
 	Relkind(RelType) string
 
+	// Upsert returns the clause that turns an INSERT into an upsert that
+	// conflicts on the conflict columns and updates the update columns, or an
+	// empty string when upserts are not supported.
+	Upsert(conflict []string, update []string) string
+
+	// Limit returns the clause restricting a query's results to limit rows,
+	// skipping offset rows when offset is not empty. Both are params.
+	Limit(limit string, offset string) string
+
 	// SchemaName loads the active schema name from the database if not provided on the cli.
 	SchemaName(*ArgType) (string, error)
 
@@ -54,13 +67,19 @@ type TypeLoader struct {
 	EnumValueList   func(models.XODB, string, string) ([]*models.EnumValue, error)
 	ProcList        func(models.XODB, string) ([]*models.Proc, error)
 	ProcParamList   func(models.XODB, string, string) ([]*models.ProcParam, error)
+	ProcedureList   func(models.XODB, string) ([]*models.Proc, error)
+	ProcResultSets  func(*ArgType, *Proc) ([][]*models.Column, error)
 	TableList       func(models.XODB, string, string) ([]*models.Table, error)
 	ColumnList      func(models.XODB, string, string) ([]*models.Column, error)
 	ForeignKeyList  func(models.XODB, string, string) ([]*models.ForeignKey, error)
+	CheckList       func(models.XODB, string, string) ([]*models.CheckConstraint, error)
 	IndexList       func(models.XODB, string, string) ([]*models.Index, error)
 	IndexColumnList func(models.XODB, string, string, string) ([]*models.IndexColumn, error)
 	QueryStrip      func([]string, []string)
 	QueryColumnList func(*ArgType, []string) ([]*models.Column, error)
+	UpsertFunc      func([]string, []string) string
+	LimitFunc       func(string, string) string
+	QueryErrorPos   func(error) int
 }
 
 // NthParam satisifies Loader's NthParam.
@@ -99,6 +118,28 @@ func (tl TypeLoader) Relkind(rt RelType) string {
 	return rt.String()
 }
 
+// Upsert satisfies Loader's Upsert.
+func (tl TypeLoader) Upsert(conflict []string, update []string) string {
+	if tl.UpsertFunc != nil {
+		return tl.UpsertFunc(conflict, update)
+	}
+
+	return ""
+}
+
+// Limit satisfies Loader's Limit.
+func (tl TypeLoader) Limit(limit string, offset string) string {
+	if tl.LimitFunc != nil {
+		return tl.LimitFunc(limit, offset)
+	}
+
+	if offset == "" {
+		return "LIMIT " + limit
+	}
+
+	return "LIMIT " + limit + " OFFSET " + offset
+}
+
 // SchemaName returns the active schema name.
 func (tl TypeLoader) SchemaName(args *ArgType) (string, error) {
 	if tl.Schema != nil {
@@ -108,13 +149,237 @@ func (tl TypeLoader) SchemaName(args *ArgType) (string, error) {
 	return "", nil
 }
 
+// VerifyQuery prepares the query against the database, returning the
+// database error of an invalid query, prefixed with the line of the query
+// where it occurred when known.
+func (tl TypeLoader) VerifyQuery(args *ArgType, query string) error {
+	stmt, err := args.DB.Prepare(query)
+	if err != nil {
+		if tl.QueryErrorPos != nil {
+			r := []rune(query)
+			if pos := tl.QueryErrorPos(err); pos > 0 && pos <= len(r) {
+				return fmt.Errorf("line %d: %v", strings.Count(string(r[:pos-1]), "\n")+1, err)
+			}
+		}
+
+		return err
+	}
+
+	return stmt.Close()
+}
+
+// joinQueryLines joins the lines of the SQL s of a segment of a query with a
+// space, trimming the whitespace around them and stripping them if
+// applicable.
+func (tl TypeLoader) joinQueryLines(args *ArgType, s string) string {
+	lines := strings.Split(s, "\n")
+	for n, l := range lines {
+		if args.QueryTrim && n > 0 {
+			l = strings.TrimLeftFunc(l, unicode.IsSpace)
+		}
+		if args.QueryTrim && n < len(lines)-1 {
+			l = strings.TrimRightFunc(l, unicode.IsSpace)
+		}
+		lines[n] = l
+	}
+
+	if args.QueryStrip && tl.QueryStrip != nil {
+		tl.QueryStrip(lines, make([]string, len(lines)+1))
+	}
+
+	return strings.Join(lines, " ")
+}
+
+// queryTableRE matches the tables of a query (ie, "FROM authors a"),
+// capturing the table name and its alias, if any.
+var queryTableRE = regexp.MustCompile("(?i)\\b(?:FROM|JOIN|UPDATE|INTO)\\s+([\\w.`\"\\[\\]]+)(?:\\s+(?:AS\\s+)?(\\w+))?")
+
+// queryInsertRE matches the start of the INSERT statements of a query,
+// capturing the table name and the list of its inserted columns.
+var queryInsertRE = regexp.MustCompile("(?is)\\bINSERT\\s+INTO\\s+([\\w.`\"\\[\\]]+)\\s*\\(([^)]*)\\)\\s*VALUES\\s*\\(")
+
+// castTypeAliases are the names of the types of the casts of the params (ie,
+// "int8" for "@id::int8") that differ from the introspected ones.
+var castTypeAliases = map[string]string{
+	"int2":        "smallint",
+	"int":         "integer",
+	"int4":        "integer",
+	"int8":        "bigint",
+	"float4":      "real",
+	"float8":      "double precision",
+	"decimal":     "numeric",
+	"bool":        "boolean",
+	"char":        "character",
+	"bpchar":      "character",
+	"varchar":     "character varying",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+	"time":        "time without time zone",
+	"timetz":      "time with time zone",
+}
+
+// castType returns the SQL type of the cast type, without its alias.
+func castType(cast string) string {
+	typ, suffix := strings.ToLower(cast), ""
+	if i := strings.IndexAny(typ, "(["); i != -1 {
+		typ, suffix = typ[:i], typ[i:]
+	}
+	if t, ok := castTypeAliases[typ]; ok {
+		typ = t
+	}
+
+	return typ + suffix
+}
+
+// inferParamTypes sets the types of the ArgType.QueryUntypedParams of a sqlc
+// query file in ArgType.QueryParamTypes: the Go type of the SQL type they are
+// cast to, or of the column of a table of the query they are compared with
+// (ie, "a.author_id = @author_id") or inserted into. Returns an error when the
+// type of a param cannot be inferred.
+func (tl TypeLoader) inferParamTypes(args *ArgType) error {
+	if len(args.QueryUntypedParams) == 0 {
+		return nil
+	}
+
+	// the tables of the query, keyed by name and alias
+	tables := map[string]string{}
+	var names []string
+	for _, m := range queryTableRE.FindAllStringSubmatch(args.Query, -1) {
+		t := unquoteIdent(m[1])
+		if _, ok := tables[t]; !ok {
+			names = append(names, t)
+		}
+		tables[t] = t
+		if m[2] != "" {
+			tables[m[2]] = t
+		}
+	}
+
+	// the columns of the tables, loaded on demand
+	cols := map[string][]*models.Column{}
+	column := func(table, name string) (*models.Column, error) {
+		if _, ok := cols[table]; !ok {
+			colList, err := tl.ColumnList(args.DB, args.Schema, table)
+			if err != nil {
+				return nil, err
+			}
+			cols[table] = colList
+		}
+		for _, c := range cols[table] {
+			if c.ColumnName == name {
+				return c, nil
+			}
+		}
+		return nil, nil
+	}
+
+	// the columns the params are compared with or inserted into
+	dl := regexp.QuoteMeta(args.QueryParamDelimiter)
+	paramCols := map[string]string{}
+	compareRE := regexp.MustCompile(`(?i)([\w.]+)\s*(?:=|<>|!=|<=|>=|<|>|\bLIKE|\bIN\s*\()\s*` + dl + `(\w+)` + dl)
+	for _, m := range compareRE.FindAllStringSubmatch(args.Query, -1) {
+		paramCols[m[2]] = m[1]
+	}
+	compareRE = regexp.MustCompile(`(?i)` + dl + `(\w+)` + dl + `\s*(?:=|<>|!=|<=|>=|<|>|\bLIKE)\s*([\w.]+)`)
+	for _, m := range compareRE.FindAllStringSubmatch(args.Query, -1) {
+		if _, ok := paramCols[m[1]]; !ok {
+			paramCols[m[1]] = m[2]
+		}
+	}
+	paramRE := regexp.MustCompile(`^\s*` + dl + `(\w+)` + dl + `\s*$`)
+	for _, m := range queryInsertRE.FindAllStringSubmatchIndex(args.Query, -1) {
+		t := unquoteIdent(args.Query[m[2]:m[3]])
+		insertCols := strings.Split(args.Query[m[4]:m[5]], ",")
+		for i, v := range splitValues(args.Query[m[1]:]) {
+			if p := paramRE.FindStringSubmatch(v); p != nil && i < len(insertCols) {
+				paramCols[p[1]] = t + "." + unquoteIdent(strings.TrimSpace(insertCols[i]))
+			}
+		}
+	}
+
+	// sort the params, for a consistent error
+	var params []string
+	for name := range args.QueryUntypedParams {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+
+	for _, name := range params {
+		if cast := args.QueryUntypedParams[name]; cast != "" {
+			_, _, args.QueryParamTypes[name] = tl.ParseType(args, castType(cast), false)
+			continue
+		}
+
+		// the column, either qualified by its table, or of any table of the
+		// query
+		var c *models.Column
+		var err error
+		col, candidates := paramCols[name], names
+		if i := strings.LastIndex(col, "."); i != -1 {
+			candidates, col = []string{tables[unquoteIdent(col[:i])]}, col[i+1:]
+		}
+		for _, t := range candidates {
+			if t == "" || c != nil {
+				continue
+			}
+			if c, err = column(t, unquoteIdent(col)); err != nil {
+				return err
+			}
+		}
+		if col == "" || c == nil {
+			return fmt.Errorf("cannot infer the type of param %s, set it with an xo:param annotation or a cast", name)
+		}
+
+		f := &Field{}
+		f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, !c.NotNull)
+		args.renull(f)
+		args.QueryParamTypes[name] = f.Type
+	}
+
+	return nil
+}
+
 // ParseQuery satisfies Loader's ParseQuery.
 func (tl TypeLoader) ParseQuery(args *ArgType) error {
 	var err error
 
+	// the Go types annotated on the columns, which are removed from the query
+	// as its lines are joined
+	colTypes := args.QueryColumnTypes()
+
+	// the SQL comments starting the query document the func, unless it has
+	// a comment
+	funcComment := args.QueryLeadingComments()
+	sqlDoc := strings.TrimSpace(args.Query)
+	if args.QueryFuncComment != "" {
+		funcComment = args.QueryFuncComment
+	}
+
+	// infer the types of the untyped params of a sqlc query file
+	if err = tl.inferParamTypes(args); err != nil {
+		return err
+	}
+
 	// parse supplied query
-	queryStr, params := args.ParseQuery(tl.Mask(), true)
-	inspectStr, _ := args.ParseQuery("NULL", false)
+	queryStr, params, segs, err := args.ParseQuery(tl.Mask(), true)
+	if err != nil {
+		return err
+	}
+	inspectStr, _, _, err := args.ParseQuery("NULL", false)
+	if err != nil {
+		return err
+	}
+
+	// verify query
+	if args.VerifyQueries {
+		verifyStr, _, _, err := args.ParseQuery(tl.Mask(), false)
+		if err != nil {
+			return err
+		}
+		if err = tl.VerifyQuery(args, verifyStr); err != nil {
+			return fmt.Errorf("invalid query %s: %v", args.QueryType, err)
+		}
+	}
 
 	// split up query and inspect based on lines
 	query := strings.Split(queryStr, "\n")
@@ -123,15 +388,20 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 	// query comment placeholder
 	queryComments := make([]string, len(query)+1)
 
-	// trim whitespace if applicable
-	if args.QueryTrim {
-		for n, l := range query {
-			query[n] = strings.TrimSpace(l)
-			if n < len(query)-1 {
-				query[n] = query[n] + " "
-			}
+	// separate the lines, as they are concatenated in the generated code,
+	// trimming whitespace if applicable
+	for n, l := range query {
+		if args.QueryTrim {
+			l = strings.TrimSpace(l)
+		}
+		if n < len(query)-1 {
+			l = l + " "
 		}
+		query[n] = l
+	}
 
+	// trim whitespace if applicable
+	if args.QueryTrim {
 		for n, l := range inspect {
 			inspect[n] = strings.TrimSpace(l)
 			if n < len(inspect)-1 {
@@ -145,6 +415,15 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 		tl.QueryStrip(query, queryComments)
 	}
 
+	// join the lines of the SQL of the segments the same way
+	for _, seg := range segs {
+		for _, p := range seg.Parts {
+			if p.Param == nil {
+				p.SQL = tl.joinQueryLines(args, p.SQL)
+			}
+		}
+	}
+
 	// create template for query type
 	typeTpl := &Type{
 		Name:    GroupCompatible(args, args.QueryType),
@@ -156,23 +435,92 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 		Comment: args.QueryTypeComment,
 	}
 
-	if args.QueryFields == "" {
+	// a statement with no result set (ie, UPDATE) has no columns to inspect,
+	// and returns the number of affected rows
+	exec := execQuery(args.Query, args.QueryExec)
+
+	switch {
+	case exec != "":
+	case args.QueryMap:
+		// the results are maps, whose columns are known at runtime
+		if args.QueryOnlyOne {
+			return fmt.Errorf("query %s cannot return only one result as a map", args.QueryType)
+		}
+	case args.QueryEmbed != "":
+		// the table types are not in the query sub-package
+		if args.QueryPackage != "" {
+			return fmt.Errorf("query %s cannot embed table types in package %s", args.QueryType, args.QueryPackage)
+		}
+
+		// embed the types of the tables, scanning their columns in order
+		for _, t := range strings.Split(args.QueryEmbed, ",") {
+			t = strings.TrimSpace(t)
+			embedTpl := &Type{
+				Name:    GroupCompatible(args, SingularizeIdentifier(t)),
+				Schema:  args.Schema,
+				RelType: Table,
+				Table:   &models.Table{TableName: t},
+			}
+			if err = tl.LoadColumns(args, embedTpl); err != nil {
+				return err
+			}
+			if len(embedTpl.Fields) == 0 {
+				return fmt.Errorf("query embedded table %s has no columns", t)
+			}
+			typeTpl.Embeds = append(typeTpl.Embeds, embedTpl)
+		}
+	case args.QueryFields == "":
 		// if no query fields specified, then pass to inspector
 		colList, err := tl.QueryColumnList(args, inspect)
 		if err != nil {
 			return err
 		}
 
+		// columns that can be NULL whatever their inspected NULL state
+		nullFields := map[string]bool{}
+		if args.QueryNullFields != "" {
+			cols := map[string]bool{}
+			for _, c := range colList {
+				cols[c.ColumnName] = true
+			}
+			for _, n := range strings.Split(args.QueryNullFields, ",") {
+				n = strings.TrimSpace(n)
+				if !cols[n] {
+					return fmt.Errorf("query null field %s is not a result column", n)
+				}
+				nullFields[n] = true
+			}
+		}
+
 		// process columns
+		windowCols, aggCols := WindowColumns(args.Query), AggregateColumns(args.Query)
 		for _, c := range colList {
 			f := &Field{
 				Name: snaker.SnakeToCamelIdentifier(c.ColumnName),
 				Col:  c,
 			}
-			f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, args.QueryAllowNulls && !c.NotNull)
+			untyped := c.DataType == ""
+			if untyped && windowCols[c.ColumnName] {
+				c.DataType = "bigint"
+			}
+			nullable := args.QueryAllowNulls && !c.NotNull || nullFields[c.ColumnName]
+			f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, nullable)
+			args.renull(f)
+			if typ, ok := aggCols[c.ColumnName]; ok && untyped && !windowCols[c.ColumnName] {
+				if typ == "float64" && nullable {
+					typ = "sql.NullFloat64"
+				}
+				f.Type, f.NilType = typ, zeroValue(typ)
+			}
+			if typ, ok := colTypes[c.ColumnName]; ok {
+				f.Type, f.NilType = typ, zeroValue(typ)
+			}
 			typeTpl.Fields = append(typeTpl.Fields, f)
+
+			// import the package of its type
+			args.addTypeImport(typeTpl.Name, f.Type)
 		}
-	} else {
+	default:
 		// extract fields from query fields
 		for _, qf := range strings.Split(args.QueryFields, ",") {
 			qf = strings.TrimSpace(qf)
@@ -195,31 +543,87 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 		}
 	}
 
-	// generate query type template
-	err = args.ExecuteTemplate(QueryTypeTemplate, args.QueryType, "", typeTpl, false)
-	if err != nil {
-		return err
+	// a query selecting a single introspected column returns its values
+	// directly, without a query type
+	scalar := args.QueryFields == "" && args.QueryEmbed == "" && len(typeTpl.Fields) == 1
+
+	// a query selecting the columns of a table returns the table's type
+	var tableTpl *Type
+	if !scalar && exec == "" && !args.QueryMap && args.QueryFields == "" && args.QueryEmbed == "" && !args.QueryNewType && args.QueryPackage == "" {
+		tableTpl, err = tl.QueryTableType(args, typeTpl.Fields)
+		if err != nil {
+			return err
+		}
+	}
+	if tableTpl != nil {
+		typeTpl = tableTpl
+	} else if !scalar && exec == "" && !args.QueryMap {
+		// generate query type template
+		err = args.ExecuteTemplate(QueryTypeTemplate, args.QueryType, "", typeTpl, false)
+		if err != nil {
+			return err
+		}
 	}
 
 	// build func name
 	funcName := args.QueryFunc
 	if funcName == "" {
 		// no func name specified, so generate based on type
-		if args.QueryOnlyOne {
+		if args.QueryOnlyOne || exec != "" {
 			funcName = args.QueryType
 		} else {
 			funcName = inflector.Pluralize(args.QueryType)
 		}
 
-		// affix any params
-		if len(params) == 0 {
-			funcName = "Get" + funcName
-		} else {
-			funcName = funcName + "By"
-			for _, p := range params {
-				funcName = funcName + strings.ToUpper(p.Name[:1]) + p.Name[1:]
+		// affix any params, but the ones with a default
+		var names []string
+		for _, p := range params {
+			if p.Default == "" {
+				names = append(names, strings.ToUpper(p.Name[:1])+p.Name[1:])
 			}
 		}
+		switch {
+		case len(names) == 0 && exec != "":
+		case len(names) == 0:
+			funcName = "Get" + funcName
+		default:
+			funcName = funcName + "By" + strings.Join(names, "")
+		}
+	}
+
+	// report the params interpolated into the query
+	for _, p := range params {
+		if !p.Interpolate || p.Limit {
+			continue
+		}
+		check := "unchecked, as its type is " + p.Type
+		switch {
+		case p.Allow != nil:
+			check = "checked against " + strings.Join(p.Allow, ", ")
+		case p.Ident:
+			check = "escaped as an identifier"
+		}
+		args.Warnings = append(args.Warnings, fmt.Sprintf("%s interpolates %s into its query, %s", funcName, p.Name, check))
+	}
+
+	// params with a default are set by options of the func
+	for _, p := range params {
+		if p.Default != "" {
+			p.Option = funcName + "With" + strings.ToUpper(p.Name[:1]) + p.Name[1:]
+		}
+	}
+
+	// slice params are passed as arrays with postgres
+	for _, p := range params {
+		if p.Slice && !p.Expand && !args.Pgx {
+			args.addImport(args.QueryType, "github.com/lib/pq")
+		}
+	}
+
+	// the query interpolating params is not a constant
+	interpolate := false
+	for _, p := range params {
+		interpolate = interpolate || p.Interpolate
 	}
 
 	// create func template
@@ -229,9 +633,16 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 		QueryComments: queryComments,
 		QueryParams:   params,
 		OnlyOne:       args.QueryOnlyOne,
-		Interpolate:   args.QueryInterpolate,
+		Interpolate:   interpolate,
 		Type:          typeTpl,
-		Comment:       args.QueryFuncComment,
+		Comment:       funcComment,
+		Segments:      segs,
+		Scalar:        scalar,
+		Exec:          exec,
+		Map:           args.QueryMap && exec == "",
+	}
+	if args.QueryDocSQL {
+		queryTpl.SQL = sqlDoc
 	}
 
 	// generate template
@@ -243,6 +654,43 @@ func (tl TypeLoader) ParseQuery(args *ArgType) error {
 	return nil
 }
 
+// QueryTableType returns the type of the table whose columns are the columns
+// of the fields of a query, in order and of the same data type, or nil. The
+// tables are loaded once when not already loaded.
+func (tl TypeLoader) QueryTableType(args *ArgType, fields []*Field) (*Type, error) {
+	if args.TableMap == nil {
+		tableMap, err := tl.LoadRelkind(args, Table)
+		if err != nil {
+			return nil, err
+		}
+		args.TableMap = tableMap
+	}
+
+	// the first matching table by name
+	var names []string
+	for name := range args.TableMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+tables:
+	for _, name := range names {
+		t := args.TableMap[name]
+		if t.RelType != Table || len(t.Fields) != len(fields) {
+			continue
+		}
+		for i, f := range t.Fields {
+			if f.Col.ColumnName != fields[i].Col.ColumnName || f.Col.DataType != fields[i].Col.DataType {
+				continue tables
+			}
+		}
+
+		return t, nil
+	}
+
+	return nil, nil
+}
+
 // LoadSchema loads schema definitions.
 func (tl TypeLoader) LoadSchema(args *ArgType) error {
 	var err error
@@ -277,11 +725,30 @@ func (tl TypeLoader) LoadSchema(args *ArgType) error {
 	}
 
 	// load foreign keys
-	_, err = tl.LoadForeignKeys(args, tableMap)
+	fkMap, err := tl.LoadForeignKeys(args, tableMap)
+	if err != nil {
+		return err
+	}
+
+	// load many-to-many relationships
+	_, err = tl.LoadManyToMany(args, tableMap, fkMap)
 	if err != nil {
 		return err
 	}
 
+	// generate type templates, now that their relations are known
+	for _, t := range tableMap {
+		err = args.ExecuteTemplate(TypeTemplate, t.Name, "", t, false)
+		if err != nil {
+			return err
+		}
+
+		err = args.ExecuteTemplate(WhereTemplate, t.Name, t.Name+"Where", t, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	// load indexes
 	_, err = tl.LoadIndexes(args, tableMap)
 	if err != nil {
@@ -298,6 +765,12 @@ func (tl TypeLoader) LoadSchema(args *ArgType) error {
 		return err
 	}
 
+	// load repositories
+	err = tl.LoadRepositories(args, tableMap)
+	if err != nil {
+		return err
+	}
+
 	args.TableMap = tableMap
 	return nil
 }
@@ -331,6 +804,10 @@ func (tl TypeLoader) LoadEnums(args *ArgType) (map[string]*Enum, error) {
 			Values:            []*EnumValue{},
 			Enum:              e,
 			ReverseConstNames: args.UseReversedEnumConstNames,
+			Backing:           "uint16",
+		}
+		if args.stringEnum(e.EnumName) {
+			enumTpl.Backing = "string"
 		}
 
 		err = tl.LoadEnumValues(args, enumTpl)
@@ -374,9 +851,15 @@ func (tl TypeLoader) LoadEnumValues(args *ArgType, enumTpl *Enum) error {
 			}
 		}
 
+		backing := strconv.Itoa(ev.ConstValue)
+		if enumTpl.Backing == "string" {
+			backing = strconv.Quote(ev.EnumValue)
+		}
+
 		enumTpl.Values = append(enumTpl.Values, &EnumValue{
-			Name: name,
-			Val:  ev,
+			Name:    name,
+			Val:     ev,
+			Backing: backing,
 		})
 	}
 
@@ -398,6 +881,19 @@ func (tl TypeLoader) LoadProcs(args *ArgType) (map[string]*Proc, error) {
 		return nil, err
 	}
 
+	// stored procedures run with CALL, returning result sets
+	call := map[*models.Proc]bool{}
+	if args.ProcResultSets && tl.ProcedureList != nil {
+		procedures, err := tl.ProcedureList(args.DB, args.Schema)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range procedures {
+			call[p] = true
+		}
+		procList = append(procList, procedures...)
+	}
+
 	// process procs
 	procMap := map[string]*Proc{}
 	for _, p := range procList {
@@ -414,6 +910,7 @@ func (tl TypeLoader) LoadProcs(args *ArgType) (map[string]*Proc, error) {
 			Params: []*Field{},
 			Return: &Field{},
 			Proc:   p,
+			Call:   call[p],
 		}
 
 		// parse return type into template
@@ -426,6 +923,14 @@ func (tl TypeLoader) LoadProcs(args *ArgType) (map[string]*Proc, error) {
 			return nil, err
 		}
 
+		// load proc result sets
+		if procTpl.Call && tl.ProcResultSets != nil {
+			err = tl.LoadProcResultSets(args, procTpl)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		procMap[p.ProcName] = procTpl
 	}
 
@@ -472,6 +977,49 @@ func (tl TypeLoader) LoadProcParams(args *ArgType, procTpl *Proc) error {
 	return nil
 }
 
+// LoadProcResultSets loads the result sets of a stored procedure.
+func (tl TypeLoader) LoadProcResultSets(args *ArgType, procTpl *Proc) error {
+	// load result sets
+	sets, err := tl.ProcResultSets(args, procTpl)
+	if err != nil {
+		return fmt.Errorf("%s: %v", procTpl.Proc.ProcName, err)
+	}
+
+	// process result sets
+	for i, cols := range sets {
+		name := fmt.Sprintf("Result%d", i+1)
+		typeTpl := &Type{
+			Name:    procTpl.Name + name,
+			Schema:  args.Schema,
+			RelType: Table,
+			Fields:  []*Field{},
+			Table: &models.Table{
+				TableName: procTpl.Proc.ProcName,
+			},
+		}
+
+		for _, c := range cols {
+			f := &Field{
+				Name: snaker.SnakeToCamelIdentifier(c.ColumnName),
+				Col:  c,
+			}
+			f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, !c.NotNull)
+			args.renull(f)
+			typeTpl.Fields = append(typeTpl.Fields, f)
+
+			// import the package of its type
+			args.addTypeImport("sp_"+procTpl.Name, f.Type)
+		}
+
+		procTpl.ResultSets = append(procTpl.ResultSets, &ProcResultSet{
+			Name: name,
+			Type: typeTpl,
+		})
+	}
+
+	return nil
+}
+
 // LoadRelkind loads a schema table/view definition.
 func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Type, error) {
 	var err error
@@ -485,6 +1033,10 @@ func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Ty
 	// tables
 	tableMap := make(map[string]*Type)
 	for _, ti := range tableList {
+		if !args.TableIncluded(ti.TableName) {
+			continue
+		}
+
 		if args.OnlyConfigTable {
 			if _, exists := args.ConfigTables[ti.TableName]; !exists {
 				continue
@@ -507,18 +1059,34 @@ func (tl TypeLoader) LoadRelkind(args *ArgType, relType RelType) (map[string]*Ty
 			return nil, err
 		}
 
+		// the check constraints are only used by the validation rules of
+		// the proto messages
+		if args.ProtoValidate && tl.CheckList != nil {
+			err = tl.LoadChecks(args, typeTpl)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		tableMap[ti.TableName] = typeTpl
 	}
 
-	// generate table templates
-	for _, t := range tableMap {
-		err = args.ExecuteTemplate(TypeTemplate, t.Name, "", t, false)
-		if err != nil {
-			return nil, err
-		}
+	return tableMap, nil
+}
+
+// LoadChecks loads the check constraints of a table, setting the bounds and
+// the allowed values of its fields (see parseCheck).
+func (tl TypeLoader) LoadChecks(args *ArgType, typeTpl *Type) error {
+	checkList, err := tl.CheckList(args.DB, args.Schema, typeTpl.Table.TableName)
+	if err != nil {
+		return err
 	}
 
-	return tableMap, nil
+	for _, c := range checkList {
+		parseCheck(c.CheckClause, typeTpl.Fields)
+	}
+
+	return nil
 }
 
 // LoadColumns loads schema table/view columns.
@@ -557,6 +1125,22 @@ func (tl TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 			Col:  c,
 		}
 		f.Len, f.NilType, f.Type = tl.ParseType(args, c.DataType, !c.NotNull)
+		args.renull(f)
+		if err = args.reuuid(typeTpl.Table.TableName, f); err != nil {
+			return err
+		}
+
+		// struct tags set in the column comment
+		f.Tags, err = parseTagDirective(c.ColumnComment)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", typeTpl.Table.TableName, c.ColumnName, err)
+		}
+
+		// proto field name set in the column comment
+		f.PBName, err = parsePBDirective(c.ColumnComment)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", typeTpl.Table.TableName, c.ColumnName, err)
+		}
 
 		// set primary key
 		if c.IsPrimaryKey {
@@ -565,12 +1149,70 @@ func (tl TypeLoader) LoadColumns(args *ArgType, typeTpl *Type) error {
 			typeTpl.PrimaryKey = f
 		}
 
-		if c.ColumnName == "is_deleted" && f.Type == "bool" {
+		if c.ColumnName == args.SoftDeleteColumn && (f.Type == "bool" || !c.NotNull) {
 			typeTpl.HasDeletedField = true
+			typeTpl.SoftDeleteField = f
+		}
+
+		// optimistic locking column
+		if c.ColumnName == args.VersionColumn && !c.IsPrimaryKey && intTypeRE.MatchString(f.Type) {
+			typeTpl.VersionField = f
+		}
+
+		// columns maintained by the database on update are excluded from
+		// generated update statements and reloaded afterwards
+		if onUpdateRE.MatchString(c.Extra) {
+			f.AutoUpdate = true
+			typeTpl.AutoUpdateFields = append(typeTpl.AutoUpdateFields, f)
+		}
+
+		// columns generated by the database, or set by a default expression,
+		// are excluded from generated insert statements
+		if isGenerated(c) {
+			f.IsGenerated = true
+		}
+
+		// counter columns get funcs atomically incrementing them
+		if !c.IsPrimaryKey && args.counter(typeTpl.Table.TableName, f) {
+			if !c.NotNull || !intTypeRE.MatchString(f.Type) {
+				return fmt.Errorf("%s.%s: cannot use %s column as a counter", typeTpl.Table.TableName, c.ColumnName, c.DataType)
+			}
+			typeTpl.CounterFields = append(typeTpl.CounterFields, f)
+		}
+
+		// audit columns set by the generated insert and update statements
+		if !c.IsPrimaryKey && args.typekind(f) == "time" {
+			switch {
+			case c.ColumnName == args.CreatedAtColumn:
+				typeTpl.CreatedAtField = f
+			case c.ColumnName == args.UpdatedAtColumn && !f.AutoUpdate:
+				typeTpl.UpdatedAtField = f
+			}
 		}
 
 		// append col to template fields
 		typeTpl.Fields = append(typeTpl.Fields, f)
+
+		// import the package of its type
+		args.addTypeImport(typeTpl.Name, f.Type)
+	}
+
+	typeTpl.ShardKeyField = args.shardKeyField(typeTpl)
+	typeTpl.GuardField = args.guardField(typeTpl)
+
+	// a composite primary key cannot be generated by a single sequence or
+	// identity, so it must be provided on insert
+	if len(typeTpl.PrimaryKeyFields) > 1 {
+		typeTpl.Table.ManualPk = true
+	}
+
+	// a primary key not provided on insert is generated by the database
+	switch {
+	case len(typeTpl.PrimaryKeyFields) != 1:
+	case !typeTpl.Table.ManualPk:
+		typeTpl.PrimaryKey.IsGenerated = true
+	case typeTpl.PrimaryKey.IsGenerated:
+		typeTpl.Table.ManualPk = false
 	}
 
 	return nil
@@ -594,8 +1236,34 @@ func (tl TypeLoader) LoadForeignKeys(args *ArgType, tableMap map[string]*Type) (
 		fk.Name = args.ForeignKeyName(fkMap, fk)
 	}
 
+	// the shard key is the first param of the funcs of the ref type
+	for _, fk := range fkMap {
+		for i, f := range fk.RefFields {
+			if i != 0 && f == fk.RefType.ShardKeyField {
+				fk.Fields, fk.RefFields = moveFieldFirst(fk.Fields, i), moveFieldFirst(fk.RefFields, i)
+			}
+		}
+	}
+
+	// add preloadable (single column) keys to their types
+	for _, fk := range fkMap {
+		if len(fk.Fields) == 1 && !args.crossShard(fk.RefType, fk.RefFields, "") && !guarded(fk.RefType, fk.RefFields) {
+			fk.Type.Preloads = append(fk.Type.Preloads, fk)
+		}
+	}
+	for _, t := range tableMap {
+		sort.Slice(t.Preloads, func(i, j int) bool {
+			return t.Preloads[i].Name < t.Preloads[j].Name
+		})
+	}
+
 	// generate templates
 	for _, fk := range fkMap {
+		// the ref rows may be on another shard, or need a guard value
+		if args.crossShard(fk.RefType, fk.RefFields, "") || guarded(fk.RefType, fk.RefFields) {
+			continue
+		}
+
 		err = args.ExecuteTemplate(ForeignKeyTemplate, fk.Type.Name, fk.ForeignKey.ForeignKeyName, fk, false)
 		if err != nil {
 			return nil, err
@@ -615,6 +1283,9 @@ func (tl TypeLoader) LoadTableForeignKeys(args *ArgType, tableMap map[string]*Ty
 		return err
 	}
 
+	// keys by name (sqlite does not name its keys, only numbers them)
+	keyMap := map[string]*ForeignKey{}
+
 	// loop over foreign keys for table
 	for _, fk := range foreignKeyList {
 		var refTpl *Type
@@ -638,6 +1309,11 @@ func (tl TypeLoader) LoadTableForeignKeys(args *ArgType, tableMap map[string]*Ty
 			}
 		}
 
+		// ref table was not loaded (ie, filtered out), so skip the key
+		if refTpl == nil {
+			continue
+		}
+
 	refColLoop:
 		// find ref column
 		for _, f := range refTpl.Fields {
@@ -647,14 +1323,31 @@ func (tl TypeLoader) LoadTableForeignKeys(args *ArgType, tableMap map[string]*Ty
 			}
 		}
 
-		// no ref col, but have ref tpl, so use primary key
-		if refTpl != nil && refCol == nil {
+		// composite keys are reported one row per column
+		key := fk.ForeignKeyName
+		if key == "" {
+			key = fmt.Sprintf("%s#%d", fk.RefTableName, fk.KeyID)
+		}
+		fkTpl, seen := keyMap[key]
+
+		// no ref col, so use (the matching column of) the primary key
+		if refCol == nil {
 			refCol = refTpl.PrimaryKey
+			if seen && len(fkTpl.RefFields) < len(refTpl.PrimaryKeyFields) {
+				refCol = refTpl.PrimaryKeyFields[len(fkTpl.RefFields)]
+			}
 		}
 
 		// check everything was found
-		if col == nil || refTpl == nil || refCol == nil {
-			return errors.New("could not find col, refTpl, or refCol")
+		if col == nil || refCol == nil {
+			return errors.New("could not find col or refCol")
+		}
+
+		// add column to the already seen key
+		if seen {
+			fkTpl.Fields = append(fkTpl.Fields, col)
+			fkTpl.RefFields = append(fkTpl.RefFields, refCol)
+			continue
 		}
 
 		// foreign key name
@@ -663,19 +1356,142 @@ func (tl TypeLoader) LoadTableForeignKeys(args *ArgType, tableMap map[string]*Ty
 		}
 
 		// create foreign key template
-		fkMap[fk.ForeignKeyName] = &ForeignKey{
+		fkTpl = &ForeignKey{
 			Schema:     args.Schema,
 			Type:       typeTpl,
 			Field:      col,
 			RefType:    refTpl,
 			RefField:   refCol,
+			Fields:     []*Field{col},
+			RefFields:  []*Field{refCol},
 			ForeignKey: fk,
+			OnDelete:   fkAction(fk.OnDelete),
+			OnUpdate:   fkAction(fk.OnUpdate),
 		}
+		keyMap[key] = fkTpl
+		fkMap[fk.ForeignKeyName] = fkTpl
 	}
 
 	return nil
 }
 
+// LoadManyToMany detects the pure join tables in tableMap (ie, tables having
+// only the columns of two foreign keys, which together form the primary key)
+// and generates the relationship methods on the types on both sides.
+func (tl TypeLoader) LoadManyToMany(args *ArgType, tableMap map[string]*Type, fkMap map[string]*ForeignKey) ([]*ManyToMany, error) {
+	var err error
+
+	// group keys per table
+	tableFks := map[*Type][]*ForeignKey{}
+	for _, fk := range fkMap {
+		tableFks[fk.Type] = append(tableFks[fk.Type], fk)
+	}
+
+	m2ms := []*ManyToMany{}
+	for t, fks := range tableFks {
+		if !isJoinTable(t, fks) {
+			continue
+		}
+
+		// keep the sides in a stable order
+		if fks[0].ForeignKey.ForeignKeyName > fks[1].ForeignKey.ForeignKeyName {
+			fks[0], fks[1] = fks[1], fks[0]
+		}
+
+		for i, fk := range fks {
+			ref := fks[1-i]
+			m2ms = append(m2ms, &ManyToMany{
+				Name:          ref.RefType.Name,
+				PluralName:    inflector.Pluralize(ref.RefType.Name),
+				Type:          fk.RefType,
+				Fields:        fk.RefFields,
+				JoinType:      t,
+				JoinFields:    fk.Fields,
+				JoinRefFields: ref.Fields,
+				RefType:       ref.RefType,
+				RefFields:     ref.RefFields,
+			})
+		}
+	}
+
+	// the same two types joined through more than one table, so name the
+	// methods after the join table
+	for _, m := range m2ms {
+		for _, o := range m2ms {
+			if m != o && m.Type == o.Type && m.RefType == o.RefType {
+				m.Name = m.RefType.Name + "By" + m.JoinType.Name
+				m.PluralName = inflector.Pluralize(m.RefType.Name) + "By" + m.JoinType.Name
+				break
+			}
+		}
+	}
+
+	// a field of the type has the name of a method, so name them after the
+	// join table, or prefix them with XO as a last resort
+	for _, m := range m2ms {
+		if !args.m2mconflict(m) {
+			continue
+		}
+		m.Name = m.RefType.Name + "By" + m.JoinType.Name
+		m.PluralName = inflector.Pluralize(m.RefType.Name) + "By" + m.JoinType.Name
+		if args.m2mconflict(m) {
+			m.Name, m.PluralName = "XO"+m.Name, "XO"+m.PluralName
+		}
+	}
+
+	// generate templates
+	for _, m := range m2ms {
+		// the join or ref rows may be on another shard, or need a guard value
+		if args.crossShard(m.JoinType, m.JoinFields, "") || args.crossShard(m.RefType, m.RefFields, "") ||
+			guarded(m.JoinType, m.JoinFields) || guarded(m.RefType, m.RefFields) {
+			continue
+		}
+
+		err = args.ExecuteTemplate(ManyToManyTemplate, m.Type.Name, m.JoinType.Name+m.PluralName, m, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m2ms, nil
+}
+
+// m2mconflict determines if a field of the type of m has the name of one of
+// the methods of m.
+func (a *ArgType) m2mconflict(m *ManyToMany) bool {
+	return a.hasfield(m.Type.Fields, m.PluralName) ||
+		a.hasfield(m.Type.Fields, "Add"+m.Name) ||
+		a.hasfield(m.Type.Fields, "Remove"+m.Name)
+}
+
+// isJoinTable determines if t is a pure join table for the foreign keys fks:
+// t has exactly two foreign keys to two different tables, and every column of
+// t is part of both the primary key and one of the foreign keys.
+func isJoinTable(t *Type, fks []*ForeignKey) bool {
+	if t.RelType != Table || len(fks) != 2 || fks[0].RefType == fks[1].RefType {
+		return false
+	}
+
+	if len(t.PrimaryKeyFields) != len(t.Fields) || len(t.Fields) != len(fks[0].Fields)+len(fks[1].Fields) {
+		return false
+	}
+
+	// check every column belongs to one of the keys
+	fkFields := map[*Field]bool{}
+	for _, fk := range fks {
+		for _, f := range fk.Fields {
+			fkFields[f] = true
+		}
+	}
+	for _, f := range t.Fields {
+		if !fkFields[f] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // LoadIndexes loads schema index definitions.
 func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[string]*Index, error) {
 	var err error
@@ -700,6 +1516,141 @@ func (tl TypeLoader) LoadIndexes(args *ArgType, tableMap map[string]*Type) (map[
 	return ixMap, nil
 }
 
+// loadMoneyFields resolves the currency fields of the money amount columns
+// of the table t, skipping the currency columns.
+func (a *ArgType) loadMoneyFields(t *Type, c *ModelToPBConfig) error {
+	fields := make(map[string]*Field, len(t.Fields))
+	for _, f := range t.Fields {
+		fields[f.Col.ColumnName] = f
+	}
+
+	c.MoneyFields = make(map[string]*Field, len(c.MoneyColumns))
+	for amount, currency := range c.MoneyColumns {
+		f, cur := fields[amount], fields[currency]
+		switch {
+		case f == nil:
+			return fmt.Errorf("unknown money amount column %s.%s", t.Table.TableName, amount)
+		case cur == nil:
+			return fmt.Errorf("unknown money currency column %s.%s", t.Table.TableName, currency)
+		}
+		if kind, _ := a.moneyamount(f.Type); kind == "" {
+			return fmt.Errorf("money amount column %s.%s must be a number, not %s", t.Table.TableName, amount, f.Type)
+		}
+		if a.basenulltype(cur.Type) != "string" && a.basenulltype(cur.Type) != "sql.NullString" {
+			return fmt.Errorf("money currency column %s.%s must be a string, not %s", t.Table.TableName, currency, cur.Type)
+		}
+
+		c.MoneyFields[amount] = cur
+		c.SkipFields[currency] = struct{}{}
+	}
+
+	return nil
+}
+
+// checkFieldTypes checks the proto scalar types overriding the ones of the
+// columns of the table t can be converted from and to their Go type.
+func (a *ArgType) checkFieldTypes(t *Type, c *ModelToPBConfig) error {
+	fields := make(map[string]*Field, len(t.Fields))
+	for _, f := range t.Fields {
+		fields[f.Col.ColumnName] = f
+	}
+
+	for col, scalar := range c.FieldTypes {
+		f := fields[col]
+		if f == nil {
+			return fmt.Errorf("unknown field type column %s.%s", t.Table.TableName, col)
+		}
+		_, isJSON := c.JSONFields[col]
+		_, isMoney := c.MoneyFields[col]
+		switch typ := a.protovaluetype(f); {
+		case !protoScalarTypes[scalar]:
+			return fmt.Errorf("invalid field type %q of %s.%s, must be a proto scalar type", scalar, t.Table.TableName, col)
+		case f.Col.IsPrimaryKey || isJSON || isMoney:
+			return fmt.Errorf("cannot override the field type of the primary key, json or money column %s.%s", t.Table.TableName, col)
+		case typ == "" || !protoconvertible(typ, scalar):
+			return fmt.Errorf("cannot convert %s.%s from %s to %s", t.Table.TableName, col, f.Type, scalar)
+		case !f.Col.NotNull && f.Type != "[]byte" && !a.ProtoOptional && protoWrapperTypes[scalar] == "":
+			return fmt.Errorf("nullable column %s.%s has no %s wrapper type, requires --proto-optional", t.Table.TableName, col, scalar)
+		}
+	}
+
+	return nil
+}
+
+// loadPBNames skips the fields of the table t whose column comment has the
+// xo:pb:"-" directive, and checks the names of the other fields in the proto
+// message (see protoname) are unique.
+func (a *ArgType) loadPBNames(t *Type, c *ModelToPBConfig) error {
+	for _, f := range t.Fields {
+		if f.PBName != "-" {
+			continue
+		}
+		if f.Col.IsPrimaryKey {
+			return fmt.Errorf("cannot skip the primary key column %s.%s", t.Table.TableName, f.Col.ColumnName)
+		}
+		c.SkipFields[f.Col.ColumnName] = struct{}{}
+	}
+
+	names := make(map[string]string, len(t.Fields))
+	for _, f := range t.Fields {
+		if _, ok := c.SkipFields[f.Col.ColumnName]; ok {
+			continue
+		}
+		name := protoname(f)
+		if col, ok := names[name]; ok {
+			return fmt.Errorf("proto field %s of %s.%s is already the one of %s", name, t.Table.TableName, f.Col.ColumnName, col)
+		}
+		names[name] = f.Col.ColumnName
+	}
+
+	return nil
+}
+
+// loadOneofs checks the columns of the oneofs of the table t are nullable
+// columns of the proto message with a Go value (see oneoffield), each in a
+// single oneof, and maps them to the name of their oneof.
+func (a *ArgType) loadOneofs(t *Type, c *ModelToPBConfig) error {
+	fields := make(map[string]*Field, len(t.Fields))
+	names := make(map[string]bool, len(t.Fields))
+	for _, f := range t.Fields {
+		if _, ok := c.SkipFields[f.Col.ColumnName]; !ok {
+			fields[f.Col.ColumnName] = f
+			names[protoname(f)] = true
+		}
+	}
+
+	c.OneofNames = make(map[string]string)
+	for name, cols := range c.Oneofs {
+		switch {
+		case !pbNameRE.MatchString(name):
+			return fmt.Errorf("invalid oneof name %q of %s", name, t.Table.TableName)
+		case names[name]:
+			return fmt.Errorf("oneof %s of %s has the name of a field", name, t.Table.TableName)
+		case len(cols) < 2:
+			return fmt.Errorf("oneof %s of %s must have at least two columns", name, t.Table.TableName)
+		}
+		for _, col := range cols {
+			f := fields[col]
+			if f == nil {
+				return fmt.Errorf("unknown oneof column %s.%s", t.Table.TableName, col)
+			}
+			_, isJSON := c.JSONFields[col]
+			_, isMoney := c.MoneyFields[col]
+			switch vf, _, _ := a.oneoffield(f, ""); {
+			case c.OneofNames[col] != "":
+				return fmt.Errorf("column %s.%s is in both oneofs %s and %s", t.Table.TableName, col, c.OneofNames[col], name)
+			case f.Col.NotNull || f.Col.IsPrimaryKey || isJSON || isMoney:
+				return fmt.Errorf("oneof column %s.%s must be nullable, and not a json or money column", t.Table.TableName, col)
+			case vf == nil:
+				return fmt.Errorf("oneof column %s.%s has no value of a proto type, not %s", t.Table.TableName, col, f.Type)
+			}
+			c.OneofNames[col] = name
+		}
+	}
+
+	return nil
+}
+
 // LoadIndexes loads schema index definitions.
 func (tl TypeLoader) LoadOptionalMethods(args *ArgType, tableMap map[string]*Type) error {
 	if args.Methods == nil {
@@ -712,9 +1663,18 @@ func (tl TypeLoader) LoadOptionalMethods(args *ArgType, tableMap map[string]*Typ
 			for _, skip := range m.Skips {
 				skips[skip] = struct{}{}
 			}
+			for col, kind := range m.JSON {
+				if _, ok := jsonProtoTypes[kind]; !ok {
+					return fmt.Errorf("invalid json kind %q of %s.%s, must be map or struct", kind, m.Name, col)
+				}
+			}
 			modelToPBMap[m.Name] = &ModelToPBConfig{
 				ImportService: s,
 				SkipFields:    skips,
+				JSONFields:    m.JSON,
+				MoneyColumns:  m.Money,
+				FieldTypes:    m.FieldTypes,
+				Oneofs:        m.Oneofs,
 			}
 		}
 	}
@@ -731,13 +1691,36 @@ func (tl TypeLoader) LoadOptionalMethods(args *ArgType, tableMap map[string]*Typ
 			option.ModelToPB = true
 			option.ModelToPBConfig = s
 
-			importStr := fmt.Sprintf("%s/service/%s", args.ServerProtoPathPrefix, goPackageName(s.ImportService))
-			if _, ok = args.Imports[t.Name]; ok {
-				args.Imports[t.Name] = append(args.Imports[t.Name], importStr)
-			} else {
-				args.Imports[t.Name] = []string{importStr}
+			err := args.loadMoneyFields(t, s)
+			if err != nil {
+				return err
+			}
+			err = args.loadPBNames(t, s)
+			if err != nil {
+				return err
+			}
+			err = args.checkFieldTypes(t, s)
+			if err != nil {
+				return err
+			}
+			err = args.loadOneofs(t, s)
+			if err != nil {
+				return err
+			}
+			for _, f := range t.Fields {
+				if _, ok := s.SkipFields[f.Col.ColumnName]; ok {
+					continue
+				}
+				if _, ok := s.MoneyFields[f.Col.ColumnName]; ok {
+					args.addImport(t.Name, "google.golang.org/genproto/googleapis/type/money")
+				} else if gt := args.googletype(f); gt != "" {
+					args.addImport(t.Name, "google.golang.org/genproto/googleapis/type/"+strings.ToLower(gt))
+				}
 			}
 
+			importPath, _ := args.protogopackage(s.ImportService)
+			args.addImport(t.Name, importPath)
+
 			if _, ok := pcs[s.ImportService]; ok {
 				pcs[s.ImportService] = append(pcs[s.ImportService], option)
 			} else {
@@ -755,13 +1738,82 @@ func (tl TypeLoader) LoadOptionalMethods(args *ArgType, tableMap map[string]*Typ
 		if err != nil {
 			return err
 		}
+
+		// the tests are written to their own _test.go file, importing the
+		// packages of the Go types of the model
+		if args.ProtoTests && m.ModelToPB {
+			for _, path := range args.Imports[m.Type.Name] {
+				args.addImport(m.Type.Name+"_pb", path)
+			}
+			err = args.ExecuteTemplate(ProtoTestTemplate, m.Type.Name+"_pb", "Test"+m.Type.Name+"PBRoundTrip", m, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the servers are written to their own file, and are only generated
+		// for the tables that can be queried by their primary key alone
+		if !args.ProtoServer || !m.ModelToPB || m.Type.GuardField != nil || m.Type.ShardKeyField != nil || pkindex(m.Type) == nil {
+			continue
+		}
+		importPath, _ := args.protogopackage(m.ModelToPBConfig.ImportService)
+		args.addImport(m.Type.Name+"_server", importPath)
+		err = args.ExecuteTemplate(ServerTemplate, m.Type.Name+"_server", m.Type.Name+"Server", m, false)
+		if err != nil {
+			return err
+		}
 	}
 
 	for svc, pc := range pcs {
-		err := args.ExecuteTemplate(TypeProtoTemplate, svc, svc, pc, true)
+		if args.ProtoSplit {
+			// the files of a service share its proto package, and each only
+			// imports the well-known types its message uses
+			for _, p := range pc {
+				err := args.ExecuteTemplate(TypeProtoTemplate, svc+"_"+snaker.CamelToSnake(p.Type.Name), svc, ProtoConfig{p}, true)
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			err := args.ExecuteTemplate(TypeProtoTemplate, svc, svc, pc, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		if args.ProtoBuf {
+			args.Warnings = append(args.Warnings, args.protolint(svc, pc)...)
+		}
+	}
+
+	return nil
+}
+
+// LoadRepositories generates the store interfaces and repositories, and their
+// mocks when enabled, for the tables in tableMap.
+func (tl TypeLoader) LoadRepositories(args *ArgType, tableMap map[string]*Type) error {
+	if !args.Repository {
+		return nil
+	}
+
+	for _, t := range tableMap {
+		// only tables with a primary key have the generated methods
+		if t.RelType != Table || t.PrimaryKey == nil {
+			continue
+		}
+
+		err := args.ExecuteTemplate(RepositoryTemplate, t.Name, t.Name+"Repository", t, false)
 		if err != nil {
 			return err
 		}
+
+		// mocks are written to their own file
+		if args.Mocks {
+			err = args.ExecuteTemplate(MockTemplate, t.Name+"_mock", t.Name+"StoreMock", t, false)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -838,18 +1890,22 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 			ixTplNew := &Index{
 				Schema: args.Schema,
 				Type:   typeTpl,
-				Fields: ixTpl.Fields[:l-i],
+				Fields: shardKeyFirst(typeTpl, ixTpl.Fields[:l-i]),
 				Index:  index,
 			}
+			if args.crossShard(typeTpl, ixTplNew.Fields, ix.IndexName) {
+				continue
+			}
 			if loadType == LoadQueryFunc {
-				// build func name
+				// build func name, before adding the guard param
 				args.BuildIndexFuncName(ixTplNew)
+				ixTplNew.Fields = guardFirst(typeTpl, ixTplNew.Fields)
 				// distinct func name
 				ixMap[ixTplNew.FuncName] = ixTplNew
 				typeTpl.Indexes[ixTplNew.FuncName] = ixTplNew
 			}
 		}
-		if loadType == LoadMapFunc && len(ixTpl.Fields) == 1 && ix.IsUnique {
+		if loadType == LoadMapFunc && len(ixTpl.Fields) == 1 && ix.IsUnique && !args.crossShard(typeTpl, ixTpl.Fields, ix.IndexName) && !guarded(typeTpl, ixTpl.Fields) {
 			args.BuildIndexMapFuncName(ixTpl)
 			ixMap[ixTpl.MapFuncName] = ixTpl
 			typeTpl.Indexes[ixTpl.MapFuncName] = ixTpl
@@ -871,8 +1927,23 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 	// the type, then create the definition here. this is needed for sqlite, as
 	// sqlite doesn't define primary keys in its index list
 	if args.LoaderType != "ora" && !priIxLoaded && pk != nil {
-		ixName := typeTpl.Table.TableName + "_" + pk.Col.ColumnName + "_pkey"
-		funcName := typeTpl.Name + "By" + pk.Name
+		// use all the columns of a composite primary key
+		pkFields := typeTpl.PrimaryKeyFields
+		if len(pkFields) == 0 {
+			pkFields = []*Field{pk}
+		}
+		pkFields = shardKeyFirst(typeTpl, pkFields)
+
+		ixName, funcName := typeTpl.Table.TableName, typeTpl.Name+"By"
+		for _, f := range pkFields {
+			ixName += "_" + f.Col.ColumnName
+			funcName += f.Name
+		}
+		ixName += "_pkey"
+		if args.crossShard(typeTpl, pkFields, ixName) {
+			return nil
+		}
+
 		mapFuncName := inflector.Pluralize(typeTpl.Name) + "MapBy" + inflector.Pluralize(pk.Name)
 		idx := &Index{
 			FuncName:    funcName,
@@ -880,7 +1951,7 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 			MapField:    pk,
 			Schema:      args.Schema,
 			Type:        typeTpl,
-			Fields:      []*Field{pk},
+			Fields:      pkFields,
 			Index: &models.Index{
 				IndexName: ixName,
 				IsUnique:  true,
@@ -891,13 +1962,17 @@ func (tl TypeLoader) LoadTableIndexes(args *ArgType, typeTpl *Type, ixMap map[st
 		case LoadQueryFunc:
 			// xo_db 依赖 为空来判断类型
 			idx.MapFuncName = ""
+			idx.Fields = guardFirst(typeTpl, pkFields)
 			ixMap[funcName] = idx
 			typeTpl.Indexes[funcName] = idx
 
 		case LoadMapFunc:
-			idx.FuncName = ""
-			ixMap[mapFuncName] = idx
-			typeTpl.Indexes[mapFuncName] = idx
+			// the map funcs are keyed by a single column
+			if len(pkFields) == 1 && !guarded(typeTpl, pkFields) {
+				idx.FuncName = ""
+				ixMap[mapFuncName] = idx
+				typeTpl.Indexes[mapFuncName] = idx
+			}
 		}
 	}
 
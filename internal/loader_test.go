@@ -0,0 +1,313 @@
+package internal
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sundayfun/xo/models"
+)
+
+func Test_InferParamTypes(t *testing.T) {
+	tests := []struct {
+		desc   string
+		query  string
+		params map[string]string
+		exp    map[string]string
+		err    string
+	}{
+		{
+			desc:   "compared with a column of the table",
+			query:  "SELECT title FROM books WHERE id = %%id%%",
+			params: map[string]string{"id": ""},
+			exp:    map[string]string{"id": "int64"},
+		},
+		{
+			desc:   "compared with a column qualified by the table alias",
+			query:  "SELECT b.title FROM books b JOIN authors a ON a.id = b.author_id WHERE a.name LIKE %%name%%",
+			params: map[string]string{"name": ""},
+			exp:    map[string]string{"name": "string"},
+		},
+		{
+			desc:   "compared with a nullable column",
+			query:  "SELECT title FROM books WHERE %%summary%% = summary",
+			params: map[string]string{"summary": ""},
+			exp:    map[string]string{"summary": "sql.NullString"},
+		},
+		{
+			desc:   "inserted into a column",
+			query:  "INSERT INTO books (author_id, title) VALUES (%%authorID%%, lower(%%title%%))",
+			params: map[string]string{"authorID": "", "title": ""},
+			err:    "cannot infer the type of param title",
+		},
+		{
+			desc:   "inserted into the columns",
+			query:  "INSERT INTO books (author_id, title) VALUES (%%authorID%%, %%title%%)",
+			params: map[string]string{"authorID": "", "title": ""},
+			exp:    map[string]string{"authorID": "int", "title": "string"},
+		},
+		{
+			desc:   "cast to an alias",
+			query:  "UPDATE books SET title = %%title%% WHERE id = %%id%%::int4",
+			params: map[string]string{"title": "", "id": "int4"},
+			exp:    map[string]string{"title": "string", "id": "int"},
+		},
+		{
+			desc:   "neither compared nor cast",
+			query:  "SELECT %%n%% FROM books",
+			params: map[string]string{"n": ""},
+			err:    "cannot infer the type of param n",
+		},
+	}
+
+	cols := map[string][]*models.Column{
+		"books": {
+			{ColumnName: "id", DataType: "bigint", NotNull: true},
+			{ColumnName: "author_id", DataType: "integer", NotNull: true},
+			{ColumnName: "title", DataType: "text", NotNull: true},
+			{ColumnName: "summary", DataType: "text"},
+		},
+		"authors": {
+			{ColumnName: "id", DataType: "integer", NotNull: true},
+			{ColumnName: "name", DataType: "text", NotNull: true},
+		},
+	}
+	tl := TypeLoader{
+		ColumnList: func(db models.XODB, schema, table string) ([]*models.Column, error) {
+			return cols[table], nil
+		},
+		ParseType: func(a *ArgType, dt string, nullable bool) (int, string, string) {
+			typ := map[string]string{"bigint": "int64", "integer": "int", "text": "string"}[dt]
+			if nullable {
+				typ = "sql.NullString"
+			}
+			return 0, "", typ
+		},
+	}
+
+	for i, tt := range tests {
+		a := NewDefaultArgs()
+		a.Query = tt.query
+		a.QueryParamTypes, a.QueryUntypedParams = map[string]string{}, tt.params
+		err := tl.inferParamTypes(a)
+		switch {
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Fatalf("test #%d: %s\n\texp error: %s\n\tgot: %v", i+1, tt.desc, tt.err, err)
+		case tt.err != "":
+			continue
+		case err != nil:
+			t.Fatalf("test #%d: %s\n\texpected no error, got: %v", i+1, tt.desc, err)
+		}
+		for name, exp := range tt.exp {
+			if typ := a.QueryParamTypes[name]; typ != exp {
+				t.Fatalf("test #%d: %s: param %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, name, exp, typ)
+			}
+		}
+	}
+}
+
+func Test_ParseQueryExpand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the build of the generated code")
+	}
+	if _, err := exec.LookPath("goimports"); err != nil {
+		t.Skip("goimports is not installed")
+	}
+
+	tests := []struct {
+		desc   string
+		loader string
+		query  string
+		params string
+		exp    string
+	}{
+		{
+			desc:   "slice expanded into a placeholder per element",
+			loader: "mysql",
+			query:  "SELECT id FROM books WHERE id IN (%%ids []int64%%) AND title = %%title string%%",
+			params: `[]int64{1, 2, 3}, "x"`,
+			exp:    "SELECT id FROM books WHERE id IN (?, ?, ?) AND title = ? [1 2 3 x]",
+		},
+		{
+			desc:   "empty slice is NULL",
+			loader: "mysql",
+			query:  "SELECT id FROM books WHERE id IN (%%ids []int64%%) AND title = %%title string%%",
+			params: `nil, "x"`,
+			exp:    "SELECT id FROM books WHERE id IN (NULL) AND title = ? [x]",
+		},
+		{
+			desc:   "placeholders after an expanded slice are shifted",
+			loader: "mssql",
+			query:  "SELECT id FROM books WHERE id IN (%%ids []int64%%) AND title = %%title string%%",
+			params: `[]int64{1, 2, 3}, "x"`,
+			exp:    "SELECT id FROM books WHERE id IN ($1, $2, $3) AND title = $4 [1 2 3 x]",
+		},
+		{
+			desc:   "placeholders after an empty slice are not shifted",
+			loader: "ora",
+			query:  "SELECT id FROM books WHERE id IN (%%ids []int64%%) AND title = %%title string%%",
+			params: `nil, "x"`,
+			exp:    "SELECT id FROM books WHERE id IN (NULL) AND title = :1 [x]",
+		},
+		{
+			desc:   "slice after an included section",
+			loader: "ora",
+			query:  "SELECT id FROM books WHERE 1 = 1 [[AND author_id = %%authorID int64%%]] AND id IN (%%ids []int64%%)",
+			params: `7, []int64{1, 2}`,
+			exp:    "SELECT id FROM books WHERE 1 = 1 AND author_id = :1 AND id IN (:2, :3) [7 1 2]",
+		},
+		{
+			desc:   "slice after an excluded section",
+			loader: "ora",
+			query:  "SELECT id FROM books WHERE 1 = 1 [[AND author_id = %%authorID int64%%]] AND id IN (%%ids []int64%%)",
+			params: `0, []int64{1, 2}`,
+			exp:    "SELECT id FROM books WHERE 1 = 1  AND id IN (:1, :2) [1 2]",
+		},
+	}
+
+	for i, tt := range tests {
+		sqlstr := runQuery(t, tt.loader, tt.query, tt.params)
+		if sqlstr != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %s", i+1, tt.desc, tt.exp, sqlstr)
+		}
+	}
+}
+
+// runQuery generates the func of the query for the loader, and returns the
+// SQL and the args it logs when called with params.
+func runQuery(t *testing.T, loader, query, params string) string {
+	tl := TypeLoader{}
+	switch loader {
+	case "mysql":
+		tl.ParamN, tl.MaskFunc = func(int) string { return "?" }, func() string { return "?" }
+	case "ora":
+		tl.ParamN, tl.MaskFunc = func(i int) string { return fmt.Sprintf(":%d", i+1) }, func() string { return ":%d" }
+	}
+
+	a := NewDefaultArgs()
+	a.Loader, a.LoaderType, a.TemplatePath, a.Package = tl, loader, "../templates", "main"
+	a.Query, a.QueryType, a.QueryFunc, a.QueryFields = query, "Book", "Books", "ID int64"
+	if err := tl.ParseQuery(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.ExecuteTemplate(XOTemplate, "xo_db", "", a, false); err != nil {
+		t.Fatal(err)
+	}
+
+	src := "package main\n"
+	for _, g := range a.Generated {
+		src += g.Buf.String() + "\n"
+	}
+	src += `
+func main() {
+	XOLog = func(sqlstr string, args ...interface{}) {
+		fmt.Println(sqlstr, args)
+	}
+	defer func() {
+		// the nil db panics once the query is logged
+		recover()
+	}()
+	Books(context.Background(), nil, ` + params + `)
+}
+`
+
+	dir, err := ioutil.TempDir("", "xo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module xo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("goimports", "-w", filepath.Join(dir, "main.go")).CombinedOutput(); err != nil {
+		t.Fatalf("goimports: %v\n%s", err, out)
+	}
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run: %v\n%s", err, out)
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+func Test_LoadColumnsGenerated(t *testing.T) {
+	tests := []struct {
+		desc string
+		col  *models.Column
+		exp  bool
+	}{
+		{
+			desc: "identity",
+			col:  &models.Column{ColumnName: "id", Extra: "identity"},
+			exp:  true,
+		},
+		{
+			desc: "serial",
+			col:  &models.Column{ColumnName: "id", DefaultValue: sql.NullString{String: "nextval('books_id_seq'::regclass)", Valid: true}},
+			exp:  true,
+		},
+		{
+			desc: "function call default",
+			col:  &models.Column{ColumnName: "created_at", DefaultValue: sql.NullString{String: "now()", Valid: true}},
+			exp:  true,
+		},
+		{
+			desc: "parenthesized function call default",
+			col:  &models.Column{ColumnName: "created_at", DefaultValue: sql.NullString{String: "(getdate())", Valid: true}},
+			exp:  true,
+		},
+		{
+			desc: "current time default",
+			col:  &models.Column{ColumnName: "created_at", DefaultValue: sql.NullString{String: "CURRENT_TIMESTAMP", Valid: true}},
+			exp:  true,
+		},
+		{
+			desc: "mysql expression default",
+			col:  &models.Column{ColumnName: "uuid", DefaultValue: sql.NullString{String: "uuid()", Valid: true}, Extra: "DEFAULT_GENERATED"},
+			exp:  true,
+		},
+		{
+			desc: "string literal default",
+			col:  &models.Column{ColumnName: "title", DefaultValue: sql.NullString{String: "'now()'::text", Valid: true}},
+			exp:  false,
+		},
+		{
+			desc: "number literal default",
+			col:  &models.Column{ColumnName: "count", DefaultValue: sql.NullString{String: "((0))", Valid: true}},
+			exp:  false,
+		},
+		{
+			desc: "no default",
+			col:  &models.Column{ColumnName: "title"},
+			exp:  false,
+		},
+	}
+
+	for i, tt := range tests {
+		tl := TypeLoader{
+			ColumnList: func(models.XODB, string, string) ([]*models.Column, error) {
+				return []*models.Column{tt.col}, nil
+			},
+			ParseType: func(*ArgType, string, bool) (int, string, string) {
+				return 0, "", "string"
+			},
+		}
+		typ := &Type{Name: "Book", Table: &models.Table{TableName: "books"}}
+		if err := tl.LoadColumns(NewDefaultArgs(), typ); err != nil {
+			t.Fatalf("test #%d: %s\n\texpected no error, got: %v", i+1, tt.desc, err)
+		}
+		if v := typ.Fields[0].IsGenerated; v != tt.exp {
+			t.Fatalf("test #%d: %s\n\texp: %t\n\tgot: %t", i+1, tt.desc, tt.exp, v)
+		}
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"path"
 	"text/template"
 
-	templates "github.com/xo/xo/tplbin"
+	templates "github.com/sundayfun/xo/tplbin"
 )
 
 // TemplateLoader loads templates from the specified name.
@@ -52,6 +52,12 @@ func (a *ArgType) ExecuteTemplate(tt TemplateType, name string, sub string, obj
 		Buf:          new(bytes.Buffer),
 	}
 
+	// the queries, and the xo code they use, are generated into the query
+	// sub-package
+	if tt == QueryTemplate || tt == QueryTypeTemplate || tt == XOTemplate {
+		v.Package = a.QueryPackage
+	}
+
 	// build template name
 	loaderType := ""
 	if tt != XOTemplate {
@@ -14,12 +14,18 @@ const (
 	EnumTemplate TemplateType = iota
 	ProcTemplate
 	TypeTemplate
+	WhereTemplate
 	ForeignKeyTemplate
+	ManyToManyTemplate
 	IndexTemplate
 	MapTemplate
 	QueryTypeTemplate
 	QueryTemplate
 	OptionalTemplate
+	RepositoryTemplate
+	MockTemplate
+	ServerTemplate
+	ProtoTestTemplate
 	TypeProtoTemplate
 
 	// always last
@@ -38,8 +44,12 @@ func (tt TemplateType) String() string {
 		s = "proc"
 	case TypeTemplate:
 		s = "type"
+	case WhereTemplate:
+		s = "where"
 	case ForeignKeyTemplate:
 		s = "foreignkey"
+	case ManyToManyTemplate:
+		s = "manytomany"
 	case IndexTemplate:
 		s = "index"
 	case MapTemplate:
@@ -50,6 +60,14 @@ func (tt TemplateType) String() string {
 		s = "query"
 	case OptionalTemplate:
 		s = "optional"
+	case RepositoryTemplate:
+		s = "repository"
+	case MockTemplate:
+		s = "mock"
+	case ServerTemplate:
+		s = "server"
+	case ProtoTestTemplate:
+		s = "prototest"
 	case TypeProtoTemplate:
 		s = "type"
 	default:
@@ -100,13 +118,141 @@ func (rt RelType) String() string {
 }
 
 type MethodsConfig struct {
-	ListFields []string                  `yaml:"list_fields"`
-	ModelToPB  map[string][]*TableConfig `yaml:"model_to_pb"`
+	ListFields []string                   `yaml:"list_fields"`
+	ModelToPB  map[string][]*TableConfig  `yaml:"model_to_pb"`
+	StructTags *StructTagsConfig          `yaml:"struct_tags"`
+	NullTypes  map[string]*NullTypeConfig `yaml:"null_types"`
+
+	// StringEnums are the enums backed by their string values instead of
+	// their ordinal (see ArgType.StringEnums).
+	StringEnums []string `yaml:"string_enums"`
+
+	// UUIDColumns are the CHAR(36) and BINARY(16) columns mapped to
+	// github.com/google/uuid's UUID (ie, users.id, or *.uuid for the
+	// columns of all tables).
+	UUIDColumns []string `yaml:"uuid_columns"`
+
+	// Counters are the integer columns getting funcs atomically incrementing
+	// them (ie, posts.like_count, or *.like_count for the columns of all
+	// tables). A column is also a counter when its comment has the xo:counter
+	// directive.
+	Counters []string `yaml:"counters"`
+
+	// Guards are the guard columns (ie, orders.org_id, or *.org_id for the
+	// tables having an org_id column) scoping the generated funcs of their
+	// table to the rows having the guard value passed as their first param.
+	Guards []string `yaml:"guards"`
+
+	Sharding *ShardingConfig `yaml:"sharding"`
+
+	Proto *ProtoHeaderConfig `yaml:"proto"`
+}
+
+// ProtoHeaderConfig configures the package and the options of the proto
+// output. The {service} in the values is replaced by the service name.
+type ProtoHeaderConfig struct {
+	// Package is the proto package (default: proto.{service}).
+	Package string `yaml:"package"`
+
+	// GoPackage is the go_package option, also the path the generated Go
+	// code imports the proto messages from. It may end with ;name when the
+	// Go package name is not the last path element (default:
+	// <server-proto-path-prefix>/service/{service}, with the - and _ of the
+	// service name removed).
+	GoPackage string `yaml:"go_package"`
+
+	// JavaPackage is the java_package option, omitted when empty.
+	JavaPackage string `yaml:"java_package"`
+
+	// JavaMultipleFiles is the java_multiple_files option (default: true).
+	JavaMultipleFiles *bool `yaml:"java_multiple_files"`
+
+	// ObjcClassPrefix is the objc_class_prefix option, omitted when empty
+	// (default: RPC).
+	ObjcClassPrefix *string `yaml:"objc_class_prefix"`
+
+	// Options are the other option lines (ie, csharp_namespace = "Rpc").
+	Options []string `yaml:"options"`
+
+	// JSONName sets an explicit json_name on the proto fields, snake for the
+	// field names (ie, author_id), camel for the lowerCamelCase names protoc
+	// defaults to (ie, authorId). The fields have no json_name when empty.
+	JSONName string `yaml:"json_name"`
+
+	// HTTPPrefix is the path prefix of the google.api.http annotations of
+	// the services, with ProtoHTTP (default: /v1).
+	HTTPPrefix string `yaml:"http_prefix"`
+}
+
+// ShardingConfig configures the shard keys of the sharded tables. The
+// generated funcs querying a sharded table take its shard key as their first
+// param, and the ones that cannot filter by it are not generated.
+type ShardingConfig struct {
+	// Keys are the shard key columns (ie, orders.user_id, or *.user_id for
+	// the tables having a user_id column).
+	Keys []string `yaml:"keys"`
+
+	// CrossShard are the tables (ie, users) or the indexes (ie,
+	// users.users_email_idx) whose funcs are generated without the shard key.
+	CrossShard []string `yaml:"cross_shard"`
+}
+
+// NullTypeConfig configures the Go type replacing a Go null type (ie,
+// sql.NullString) for the nullable columns.
+type NullTypeConfig struct {
+	// Type is the replacing Go type (ie, null.String). It must have a Valid
+	// field, and a field holding its value.
+	Type string `yaml:"type"`
+
+	// Nil is the Go nil value of Type, Type{} by default.
+	Nil string `yaml:"nil"`
+
+	// Value is the field of Type holding its value, the same as the replaced
+	// type's by default (ie, String for sql.NullString).
+	Value string `yaml:"value"`
+
+	// Import is the import path of Type's package.
+	Import string `yaml:"import"`
+}
+
+// StructTagsConfig configures the struct tags of the fields of the generated
+// types.
+type StructTagsConfig struct {
+	// Tags are the tags to emit, among json, db, yaml, validate and bson.
+	Tags []string `yaml:"tags"`
+
+	// Case is the naming convention of the json, yaml and bson tag names
+	// (snake or camel). The column name is used as is when empty.
+	Case string `yaml:"case"`
+
+	// OmitEmpty toggles adding omitempty to the json, yaml and bson tags.
+	OmitEmpty bool `yaml:"omitempty"`
 }
 
 type TableConfig struct {
 	Name  string   `yaml:"name"`
 	Skips []string `yaml:"skips"`
+
+	// JSON are the JSON columns of the table emitted in the proto message,
+	// as a map<string, string> (map) or a google.protobuf.Struct (struct),
+	// by column name (ie, settings: map).
+	JSON map[string]string `yaml:"json"`
+
+	// Money are the amount columns of the table emitted in the proto message
+	// as a google.type.Money, along with their currency code column, by
+	// amount column name (ie, price: currency).
+	Money map[string]string `yaml:"money"`
+
+	// FieldTypes are the proto scalar types of the columns of the table
+	// overriding their default one, by column name (ie, id: string, or
+	// count: sint64). The numbers and bools converted to strings are
+	// formatted, and parsed back.
+	FieldTypes map[string]string `yaml:"field_types"`
+
+	// Oneofs are the groups of mutually exclusive nullable columns of the
+	// table emitted in a oneof of the proto message, by oneof name (ie,
+	// media: [photo_id, video_id]). At most one column of a group is set.
+	Oneofs map[string][]string `yaml:"oneofs"`
 }
 
 // EnumValue holds data for a single enum value.
@@ -114,6 +260,10 @@ type EnumValue struct {
 	Name    string
 	Val     *models.EnumValue
 	Comment string
+
+	// Backing is the Go value of the enum value in the backing type of its
+	// enum (ie, 1, or "fiction" for string backed enums).
+	Backing string
 }
 
 // Enum is a template item for a enum.
@@ -124,6 +274,10 @@ type Enum struct {
 	Enum              *models.Enum
 	Comment           string
 	ReverseConstNames bool
+
+	// Backing is the Go type backing the enum, uint16 (the ordinal of the
+	// values) or string (the values).
+	Backing string
 }
 
 // Proc is a template item for a stored procedure.
@@ -135,6 +289,24 @@ type Proc struct {
 	Return     *Field
 	Proc       *models.Proc
 	Comment    string
+
+	// Call indicates the stored procedure is run with CALL, returning its
+	// result sets if any.
+	Call bool
+
+	// ResultSets are the result sets returned by the stored procedure, in
+	// order.
+	ResultSets []*ProcResultSet
+}
+
+// ProcResultSet is a template item for a result set of a stored procedure.
+type ProcResultSet struct {
+	// Name is the name of the field holding the rows of the result set (ie,
+	// Result1).
+	Name string
+
+	// Type is the type of the rows of the result set.
+	Type *Type
 }
 
 // Field contains field information.
@@ -145,6 +317,41 @@ type Field struct {
 	Len     int
 	Col     *models.Column
 	Comment string
+
+	// AutoUpdate indicates the column is maintained by the database on
+	// update (ie, MySQL's ON UPDATE CURRENT_TIMESTAMP).
+	AutoUpdate bool
+
+	// IsGenerated indicates the column is generated by the database (ie, an
+	// identity, serial, or computed column, or a column with a default
+	// expression such as now()), and is excluded from generated insert
+	// statements and reloaded after them.
+	IsGenerated bool
+
+	// Tags are the struct tags set by the xo directive of the column comment,
+	// overriding the configured struct tags with the same key.
+	Tags []StructTag
+
+	// PBName is the name of the field in the proto message set by the xo:pb
+	// directive of the column comment (ie, xo:pb:"display_name"), or - when
+	// the field is skipped. Empty for the column name (see protoname).
+	PBName string
+
+	// Bounds are the bounds of the values of the column set by the check
+	// constraints of its table, keyed by their operator (<, <=, > or >=), ie
+	// >= 0 for CHECK (price >= 0). Only loaded with ProtoValidate.
+	Bounds map[string]string
+
+	// In are the values of the column allowed by the check constraints of
+	// its table, ie draft and published for CHECK (status IN ('draft',
+	// 'published')). Only loaded with ProtoValidate.
+	In []string
+}
+
+// StructTag is a struct tag of a field.
+type StructTag struct {
+	Key   string
+	Value string
 }
 
 // Type is a template item for a type (ie, table/view/custom query).
@@ -159,6 +366,19 @@ type Type struct {
 	Table            *models.Table
 	Comment          string
 	HasDeletedField  bool
+	SoftDeleteField  *Field
+	VersionField     *Field
+	CreatedAtField   *Field
+	UpdatedAtField   *Field
+	AutoUpdateFields []*Field
+	ShardKeyField    *Field
+	GuardField       *Field
+	CounterFields    []*Field
+	Preloads         []*ForeignKey
+
+	// Embeds are the table types embedded in a query type, whose fields are
+	// scanned in order.
+	Embeds []*Type
 }
 
 // ForeignKey is a template item for a foreign relationship on a table.
@@ -169,10 +389,28 @@ type ForeignKey struct {
 	Field      *Field
 	RefType    *Type
 	RefField   *Field
+	Fields     []*Field
+	RefFields  []*Field
 	ForeignKey *models.ForeignKey
+	OnDelete   string
+	OnUpdate   string
 	Comment    string
 }
 
+// ManyToMany is a template item for one side of a many-to-many relationship
+// between two tables through a join table.
+type ManyToMany struct {
+	Name          string
+	PluralName    string
+	Type          *Type
+	Fields        []*Field
+	JoinType      *Type
+	JoinFields    []*Field
+	JoinRefFields []*Field
+	RefType       *Type
+	RefFields     []*Field
+}
+
 // Index is a template item for a index into a table.
 type Index struct {
 	FuncName    string
@@ -196,6 +434,18 @@ type MethodsOption struct {
 type ModelToPBConfig struct {
 	ImportService string
 	SkipFields    map[string]struct{}
+	JSONFields    map[string]string
+	MoneyColumns  map[string]string
+	FieldTypes    map[string]string
+	Oneofs        map[string][]string
+
+	// OneofNames are the names of the oneofs of the columns of Oneofs, by
+	// column name.
+	OneofNames map[string]string
+
+	// MoneyFields are the currency fields of the money amount columns, which
+	// are skipped themselves, by amount column name.
+	MoneyFields map[string]*Field
 }
 
 type ProtoConfig []*MethodsOption
@@ -217,6 +467,57 @@ type QueryParam struct {
 	Name        string
 	Type        string
 	Interpolate bool
+
+	// Slice indicates the param is a slice (ie, []int64), expanded into one
+	// placeholder per element, or passed as an array with PostgreSQL.
+	Slice bool
+
+	// Expand indicates the slice param is expanded into one placeholder per
+	// element.
+	Expand bool
+
+	// Default is the Go expression of the default value of the param (ie,
+	// 100 for "%%limit int 100%%"). A param with a default is not a param of
+	// the query func, and is set with the Option XOOption instead.
+	Default string
+
+	// Option is the name of the generated func returning the XOOption
+	// setting the param with a default (ie, AuthorsByNameWithLimit).
+	Option string
+
+	// Allow are the values the interpolated param is checked against at
+	// runtime (ie, ASC and DESC for "%%dir string,interpolate,allow=ASC|DESC%%").
+	Allow []string
+
+	// Ident indicates the interpolated param is escaped as an identifier
+	// (ie, "%%col string,interpolate,ident%%").
+	Ident bool
+
+	// Limit indicates the param is in a LIMIT or OFFSET position (ie, "LIMIT
+	// %%n int%%"), typed uint64 and checked to be non-negative at runtime.
+	Limit bool
+}
+
+// QueryPart is a part of a QuerySegment, either SQL or a param.
+type QueryPart struct {
+	SQL   string
+	Param *QueryParam
+}
+
+// QuerySegment is a segment of a query assembled at runtime, included in the
+// query when Cond is true, or always when empty.
+type QuerySegment struct {
+	Cond  string
+	Parts []*QueryPart
+}
+
+// add adds the part p to the segment, skipping empty SQL.
+func (s *QuerySegment) add(p *QueryPart) {
+	if p.Param == nil && p.SQL == "" {
+		return
+	}
+
+	s.Parts = append(s.Parts, p)
 }
 
 // Query is a template item for a custom query.
@@ -230,6 +531,26 @@ type Query struct {
 	Interpolate   bool
 	Type          *Type
 	Comment       string
+
+	// Segments are the segments of the query assembled at runtime, when it
+	// has optional sections or expanded slice params.
+	Segments []*QuerySegment
+
+	// Scalar indicates the query selects a single column, whose values are
+	// returned directly instead of as Type (ie, int64).
+	Scalar bool
+
+	// Exec is the kind (ie, UPDATE) of the statement with no result set of
+	// the query, run returning the number of affected rows.
+	Exec string
+
+	// Map indicates the results are returned as maps keyed by column name,
+	// along with the columns in order, without a query type.
+	Map bool
+
+	// SQL is the query as supplied, added to the doc comment of the func
+	// when ArgType.QueryDocSQL is toggled.
+	SQL string
 }
 
 type Imports struct {
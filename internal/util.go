@@ -3,7 +3,9 @@ package internal
 import (
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -11,18 +13,43 @@ import (
 
 	"github.com/gedex/inflector"
 	"github.com/kenshaw/snaker"
+
+	"github.com/sundayfun/xo/models"
 )
 
 // ParseQuery takes the query in args and looks for strings in the form of
-// "%%<name> <type>[,<option>,...]%%", replacing them with the supplied mask.
-// mask can contain "%d" to indicate current position. The modified query is
-// returned, and the slice of extracted QueryParam's.
-func (a *ArgType) ParseQuery(mask string, interpol bool) (string, []*QueryParam) {
+// "%%<name> <type>[,<option>,...][ <default>]%%", replacing them with the
+// supplied mask. mask can contain "%d" to indicate current position. The
+// modified query is returned, and the slice of extracted QueryParam's.
+//
+// A param with a default (ie, "%%limit int 100%%") is set with an XOOption of
+// the query func instead of being a param of the func.
+//
+// An interpolated param is checked at runtime against the values of its allow
+// option (ie, "%%dir string,interpolate,allow=ASC|DESC%%"), or escaped as an
+// identifier with its ident option (ie, "%%col string,interpolate,ident%%").
+// One of them is required, except for the numbers and bools.
+//
+// An integer param in a LIMIT or OFFSET position (ie, "LIMIT %%n int%%") is a
+// uint64, checked at runtime to be in the range of the int64 bound by the
+// drivers, and interpolated into the query with --query-inline-limit.
+//
+// The query can have optional sections in the form of "[[ ... ]]" (ie, "WHERE
+// 1 = 1 [[AND name = %%name string%%]]"), included only when their params are
+// not the zero value of their type. A slice param (ie, []int64) is expanded
+// into one placeholder per element, except with PostgreSQL where the slice is
+// passed as an array (ie, "id = ANY(%%ids []int64%%)"). When interpol is true
+// and the query has either, the segments of the query assembled at runtime
+// are also returned.
+//
+// The errors of a malformed query are prefixed by their line (see
+// ArgType.QueryLines).
+func (a *ArgType) ParseQuery(mask string, interpol bool) (string, []*QueryParam, []*QuerySegment, error) {
 	dl := a.QueryParamDelimiter
 
-	// create the regexp for the delimiter
+	// create the regexp for the delimiter and the optional sections
 	placeholderRE := regexp.MustCompile(
-		dl + `[^` + dl[:1] + `]+` + dl,
+		`\[\[|\]\]|` + dl + `[^` + dl[:1] + `]+` + dl,
 	)
 
 	// grab matches from query string
@@ -34,8 +61,40 @@ func (a *ArgType) ParseQuery(mask string, interpol bool) (string, []*QueryParam)
 	i := 1
 	last := 0
 
+	// segments of the query, and the optional section being parsed
+	segs := []*QuerySegment{{}}
+	var section *QuerySegment
+	var conds []string
+	var open int
+	dynamic := false
+
 	// loop over matches, extracting each placeholder and splitting to name/type
 	for _, m := range matches {
+		// add to string
+		s := a.Query[last:m[0]]
+		str = str + s
+		segs[len(segs)-1].add(&QueryPart{SQL: s})
+		last = m[1]
+
+		// handle optional sections
+		switch a.Query[m[0]:m[1]] {
+		case "[[":
+			if section != nil {
+				return "", nil, nil, fmt.Errorf("line %d: optional query sections cannot be nested", a.queryLine(m[0]))
+			}
+			section, conds, open, dynamic = &QuerySegment{}, nil, m[0], true
+			segs = append(segs, section)
+			continue
+
+		case "]]":
+			if section == nil || len(conds) == 0 {
+				return "", nil, nil, fmt.Errorf("line %d: optional query sections must be opened, and have params", a.queryLine(m[0]))
+			}
+			section.Cond, section = strings.Join(conds, " && "), nil
+			segs = append(segs, &QuerySegment{})
+			continue
+		}
+
 		// generate place holder value
 		pstr := mask
 		if strings.Contains(mask, "%d") {
@@ -45,51 +104,599 @@ func (a *ArgType) ParseQuery(mask string, interpol bool) (string, []*QueryParam)
 		// extract parameter info
 		paramStr := a.Query[m[0]+len(dl) : m[1]-len(dl)]
 		p := strings.SplitN(paramStr, " ", 2)
+		if len(p) == 1 {
+			typ, ok := a.QueryParamTypes[p[0]]
+			if !ok {
+				return "", nil, nil, fmt.Errorf("line %d: no type for query parameter '%s'", a.queryLine(m[0]), paramStr)
+			}
+			p = append(p, typ)
+		}
 		param := &QueryParam{
 			Name: p[0],
 			Type: p[1],
 		}
 
+		// split the default value of the param if present
+		if i := strings.Index(param.Type, " "); i != -1 {
+			param.Type, param.Default = param.Type[:i], strings.TrimSpace(param.Type[i+1:])
+		}
+
 		// parse parameter options if present
 		if strings.Contains(param.Type, ",") {
 			opts := strings.Split(param.Type, ",")
 			param.Type = opts[0]
 			for _, opt := range opts[1:] {
-				switch opt {
-				case "interpolate":
+				switch {
+				case opt == "interpolate":
 					if !a.QueryInterpolate {
-						panic("query interpolate is not enabled")
+						return "", nil, nil, fmt.Errorf("line %d: query interpolate is not enabled", a.queryLine(m[0]))
 					}
 					param.Interpolate = true
 
+				case opt == "ident":
+					param.Ident = true
+
+				case strings.HasPrefix(opt, "allow="):
+					param.Allow = strings.Split(strings.TrimPrefix(opt, "allow="), "|")
+
 				default:
-					panic(fmt.Errorf("unknown option encountered on query parameter '%s'", paramStr))
+					return "", nil, nil, fmt.Errorf("line %d: unknown option encountered on query parameter '%s'", a.queryLine(m[0]), paramStr)
 				}
 			}
+			if (param.Ident || param.Allow != nil) && !param.Interpolate {
+				return "", nil, nil, fmt.Errorf("line %d: ident and allow options require the interpolate option on query parameter '%s'", a.queryLine(m[0]), paramStr)
+			}
+		}
+
+		if param.Type == "string" && param.Default != "" && !strings.ContainsAny(param.Default[:1], "\"`") {
+			param.Default = strconv.Quote(param.Default)
+		}
+
+		// a param counting rows is a uint64, interpolated into the query
+		// for the databases not binding them
+		if limitRE.MatchString(str) && (intTypeRE.MatchString(param.Type) || param.Type == "interface{}") {
+			param.Type, param.Limit = "uint64", true
+			param.Interpolate = param.Interpolate || a.QueryInlineLimit
+		}
+
+		// an interpolated value is only safe when it is checked, escaped,
+		// or formatted from a number or a bool
+		if param.Interpolate && !param.Limit && !param.Ident && param.Allow == nil && !interpolSafe(param.Type) {
+			return "", nil, nil, fmt.Errorf("line %d: interpolated query parameter '%s' requires the allow or ident option", a.queryLine(m[0]), paramStr)
+		}
+
+		param.Slice = strings.HasPrefix(param.Type, "[]") && param.Type != "[]byte" && !param.Interpolate
+		param.Expand = param.Slice && a.LoaderType != "postgres"
+		dynamic = dynamic || param.Expand
+		if section != nil {
+			conds = append(conds, nonzero(param))
 		}
 
-		// add to string
-		str = str + a.Query[last:m[0]]
 		if interpol && param.Interpolate {
 			// handle interpolation case
 			xstr := `fmt.Sprintf("%v", ` + param.Name + `)`
 			if param.Type == "string" {
 				xstr = param.Name
 			}
+			if param.Ident {
+				xstr = `xoQuoteIdent(` + xstr + `)`
+			}
 			str = str + "` + " + xstr + " + `"
+			segs[len(segs)-1].add(&QueryPart{SQL: "` + " + xstr + " + `"})
 		} else {
 			str = str + pstr
+			segs[len(segs)-1].add(&QueryPart{Param: param})
 		}
 
 		params = append(params, param)
-		last = m[1]
 		i++
 	}
 
+	if section != nil {
+		return "", nil, nil, fmt.Errorf("line %d: optional query section is not closed", a.queryLine(open))
+	}
+
 	// add part of query remains
 	str = str + a.Query[last:]
+	segs[len(segs)-1].add(&QueryPart{SQL: a.Query[last:]})
+
+	if !interpol || !dynamic {
+		return str, params, nil, nil
+	}
+
+	return str, params, segs, nil
+}
+
+// queryLine returns the line of the position pos of the query of the ArgType:
+// its line in the .sql file it was read from (see ArgType.QueryLines), or in
+// the query.
+func (a *ArgType) queryLine(pos int) int {
+	n := strings.Count(a.Query[:pos], "\n")
+	if n < len(a.QueryLines) {
+		return a.QueryLines[n]
+	}
+
+	return n + 1
+}
+
+// nonzero returns the Go condition of the query param p not being the zero
+// value of its type.
+func nonzero(p *QueryParam) string {
+	switch t := p.Type; {
+	case p.Slice:
+		return "len(" + p.Name + ") != 0"
+	case strings.HasPrefix(t, "*"), strings.HasPrefix(t, "[]"), strings.HasPrefix(t, "map["), t == "interface{}":
+		return p.Name + " != nil"
+	case t == "string":
+		return p.Name + ` != ""`
+	case t == "bool":
+		return p.Name
+	case intTypeRE.MatchString(t), strings.HasPrefix(t, "float"):
+		return p.Name + " != 0"
+	case strings.HasPrefix(t, "sql.Null"):
+		return p.Name + ".Valid"
+	case t == "time.Time":
+		return "!" + p.Name + ".IsZero()"
+	}
+
+	return "!xoIsZero(" + p.Name + ")"
+}
+
+// queryAnnotationRE matches the annotations of the .sql files of the query
+// directory (ie, "-- xo:returns one"), capturing their key and value.
+var queryAnnotationRE = regexp.MustCompile(`^\s*--\s*xo:([a-z-]+)\b\s*(.*?)\s*$`)
+
+// sqlcNameRE matches the sqlc name annotations starting the queries of a
+// sqlc query file (ie, "-- name: GetAuthor :one"), capturing the name and
+// the mode of the query.
+var sqlcNameRE = regexp.MustCompile(`^\s*--\s*name:\s*(\w+)\s*:(\w+)\s*$`)
+
+// sqlcParamRE matches the params of the queries of a sqlc query file (ie,
+// "sqlc.arg(name)", "sqlc.narg('name')", "@name", "$1" and "?"), skipping
+// the quoted strings, capturing the name of the named params, or the number
+// of the numbered ones.
+var sqlcParamRE = regexp.MustCompile(`'(?:[^']|'')*'|sqlc\.n?arg\(\s*'?(\w+)'?\s*\)|@(\w+)|\$(\d+)|\?`)
+
+// sqlcCastRE matches the cast following a param of a sqlc query file (ie,
+// "::int8" or "::varchar(32)[]"), capturing the SQL type.
+var sqlcCastRE = regexp.MustCompile(`^::\s*([\w.]+(?:\([\d\s,]*\))?(?:\[\])?)`)
+
+// ParseQueryFile reads the queries of the .sql file, configuring the query
+// settings of the ArgType with the annotations of each query before calling
+// parse with it. The annotations are comments of the form
+// "-- xo:<key> <value>":
+//
+//	-- xo:type <name>           query's Go type (the file name in camel case by default)
+//	-- xo:func <name>           query's Go func
+//	-- xo:returns one|many|map  whether the func returns one or many results (many by default), or maps
+//	-- xo:param <name> <type>   type of the %%<name>%% param of the query
+//	-- xo:fields <fields>       same as --query-fields
+//	-- xo:null-fields <fields>  same as --query-null-fields
+//	-- xo:embed <tables>        same as --query-embed
+//	-- xo:package <name>        same as --query-package
+//	-- xo:comment <comment>     same as --query-func-comment
+//	-- xo:type-comment <text>   same as --query-type-comment
+//
+// A file can also be a sqlc query file, holding several queries each starting
+// with a sqlc name annotation (ie, "-- name: GetAuthor :one", where the mode
+// is one of :one, :many, :exec, :execrows or :execresult). The name is the
+// query's Go func, and the name suffixed with Row its Go type. The sqlc params
+// become query params named in camel case (ie, authorID for "@author_id"),
+// whose type is set by an xo:param annotation (ie, "-- xo:param arg1 int64"
+// for "$1", or the first "?" when not using PostgreSQL), or otherwise inferred
+// from the SQL type they are cast to (ie, "@author_id::int8"), or from the
+// column they are compared with or inserted into.
+//
+// The annotation lines are removed from the query.
+func (a *ArgType) ParseQueryFile(file string, parse func(*ArgType) error) error {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(buf), "\n")
+
+	// split the sqlc queries, dropping the lines before the first one
+	var start []int
+	for n, l := range lines {
+		if sqlcNameRE.MatchString(l) {
+			start = append(start, n)
+		}
+	}
+	if len(start) == 0 {
+		start = []int{0}
+	}
+
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	// the package annotation only applies to its query
+	queryPackage := a.QueryPackage
+	defer func() { a.QueryPackage = queryPackage }()
+
+	for i, s := range start {
+		end := len(lines)
+		if i+1 < len(start) {
+			end = start[i+1]
+		}
+
+		// reset the settings of the previous query
+		a.QueryType, a.QueryFunc, a.QueryOnlyOne, a.QueryExec = snaker.SnakeToCamelIdentifier(name), "", false, false
+		a.QueryFields, a.QueryNullFields, a.QueryEmbed, a.QueryMap = "", "", "", false
+		a.QueryPackage = queryPackage
+		a.QueryFuncComment, a.QueryTypeComment = "", ""
+		a.QueryParamTypes, a.QueryUntypedParams = map[string]string{}, map[string]string{}
+
+		var sqlc bool
+		var query []string
+		var lineNos []int
+		for n := s; n < end; n++ {
+			l := lines[n]
+			if m := sqlcNameRE.FindStringSubmatch(l); m != nil {
+				sqlc = true
+				a.QueryType, a.QueryFunc = m[1]+"Row", m[1]
+				switch m[2] {
+				case "one":
+					a.QueryOnlyOne = true
+				case "many":
+				case "exec", "execrows", "execresult":
+					a.QueryType, a.QueryExec = m[1], true
+				default:
+					return fmt.Errorf("line %d: unsupported sqlc mode :%s", n+1, m[2])
+				}
+				continue
+			}
+
+			m := queryAnnotationRE.FindStringSubmatch(l)
+			if m == nil {
+				query, lineNos = append(query, l), append(lineNos, n+1)
+				continue
+			}
+
+			switch key, val := m[1], m[2]; key {
+			case "type":
+				a.QueryType = val
+			case "func":
+				a.QueryFunc = val
+			case "returns":
+				switch val {
+				case "one":
+					a.QueryOnlyOne, a.QueryMap = true, false
+				case "many":
+					a.QueryOnlyOne, a.QueryMap = false, false
+				case "map":
+					a.QueryOnlyOne, a.QueryMap = false, true
+				default:
+					return fmt.Errorf("line %d: invalid xo:returns %q", n+1, val)
+				}
+			case "param":
+				p := strings.SplitN(val, " ", 2)
+				if len(p) != 2 {
+					return fmt.Errorf("line %d: xo:param must have a name and a type", n+1)
+				}
+				a.QueryParamTypes[p[0]] = strings.TrimSpace(p[1])
+			case "fields":
+				a.QueryFields = val
+			case "null-fields":
+				a.QueryNullFields = val
+			case "embed":
+				a.QueryEmbed = val
+			case "package":
+				a.QueryPackage = val
+			case "comment":
+				a.QueryFuncComment = val
+			case "type-comment":
+				a.QueryTypeComment = val
+			default:
+				return fmt.Errorf("line %d: unknown annotation xo:%s", n+1, key)
+			}
+		}
+
+		q := strings.Join(query, "\n")
+		a.Query = strings.TrimSpace(q)
+		a.QueryLines = lineNos[strings.Count(q[:strings.Index(q, a.Query)], "\n"):]
+		if sqlc {
+			a.Query = a.sqlcParams(a.Query)
+		}
+
+		if err = parse(a); err != nil {
+			return fmt.Errorf("%s: %v", a.QueryType, err)
+		}
+	}
+
+	return nil
+}
+
+// sqlcParams returns the query of a sqlc query file with its params replaced
+// by query params, whose types are in ArgType.QueryParamTypes, or otherwise
+// added to ArgType.QueryUntypedParams. The sqlc query files terminate their
+// queries with a semicolon, which is removed.
+func (a *ArgType) sqlcParams(query string) string {
+	dl := a.QueryParamDelimiter
+	query = strings.TrimSuffix(query, ";")
+
+	var i, last int
+	str := ""
+	for _, m := range sqlcParamRE.FindAllStringSubmatchIndex(query, -1) {
+		s := query[m[0]:m[1]]
+		str, last = str+query[last:m[0]], m[1]
+
+		var name string
+		switch {
+		case strings.HasPrefix(s, "'"):
+			str += s
+			continue
+		case m[2] != -1:
+			name = query[m[2]:m[3]]
+		case m[4] != -1:
+			name = query[m[4]:m[5]]
+		case m[6] != -1:
+			name = "arg" + query[m[6]:m[7]]
+		case a.LoaderType == "postgres":
+			// the ? of postgres are jsonb operators
+			str += s
+			continue
+		default:
+			i++
+			name = "arg" + strconv.Itoa(i)
+		}
+
+		// sqlc names the params in snake case
+		typ, ok := a.QueryParamTypes[name]
+		name = snaker.ForceLowerCamelIdentifier(name)
+		switch {
+		case ok:
+			a.QueryParamTypes[name] = typ
+		case a.QueryParamTypes[name] != "":
+		default:
+			// inferred by the loader, from the cast of the param if any
+			if c := sqlcCastRE.FindStringSubmatch(query[m[1]:]); c != nil {
+				a.QueryUntypedParams[name] = c[1]
+			} else if _, ok := a.QueryUntypedParams[name]; !ok {
+				a.QueryUntypedParams[name] = ""
+			}
+		}
+
+		str += dl + name + dl
+	}
+
+	return str + query[last:]
+}
+
+// execQueryRE matches the statements with no result set (ie, "UPDATE ...",
+// "INSERT ... SELECT ..."), capturing their kind.
+var execQueryRE = regexp.MustCompile(`(?i)^\s*(UPDATE|DELETE|INSERT|REPLACE|MERGE)\b`)
+
+// returningRE matches the clauses returning the rows of a statement (ie,
+// PostgreSQL's RETURNING and SQL Server's OUTPUT).
+var returningRE = regexp.MustCompile(`(?i)\b(RETURNING|OUTPUT)\b`)
+
+// cteRE matches the start of the queries with CTEs (common table
+// expressions).
+var cteRE = regexp.MustCompile(`(?i)^\s*WITH\b`)
+
+// SplitCTE splits the query into its WITH clause defining its CTEs (common
+// table expressions), and the statement using them (ie, "WITH t AS (SELECT
+// ...) " and "SELECT * FROM t"). The WITH clause is empty when the query has
+// no CTE.
+func SplitCTE(query string) (string, string) {
+	if !cteRE.MatchString(query) {
+		return "", query
+	}
+
+	var depth int
+	var quote byte
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth == 0 && isIdentChar(c) && (i == 0 || !isIdentChar(query[i-1])):
+			// the statement starts with the first keyword of a statement
+			// outside of the CTEs
+			j := i
+			for j < len(query) && isIdentChar(query[j]) {
+				j++
+			}
+			switch strings.ToUpper(query[i:j]) {
+			case "SELECT", "VALUES", "TABLE", "INSERT", "UPDATE", "DELETE", "REPLACE", "MERGE":
+				return query[:i], query[i:]
+			}
+			i = j - 1
+		}
+	}
+
+	return "", query
+}
+
+// unquoteIdent returns the SQL identifier s without its quotes (ie, "name",
+// `name` or [name]) and its qualifier (ie, the schema of a table).
+func unquoteIdent(s string) string {
+	if i := strings.LastIndex(s, "."); i != -1 {
+		s = s[i+1:]
+	}
+
+	return strings.Trim(s, "`\"[]")
+}
+
+// splitValues splits the values of a VALUES list of a query, from the text
+// following its opening parenthesis to its closing one, on their commas.
+func splitValues(s string) []string {
+	var vals []string
+	var depth, last int
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')' && depth == 0:
+			return append(vals, s[last:i])
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			vals, last = append(vals, s[last:i]), i+1
+		}
+	}
+
+	return append(vals, s[last:])
+}
+
+// isIdentChar returns whether c is a character of a SQL identifier.
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// execQuery returns the kind of the statement query (ie, UPDATE) when it has
+// no result set, or an empty string. When force is true, the query is a
+// statement whose kind is its first keyword, whatever its result set. The
+// CTEs of the query are skipped.
+func execQuery(query string, force bool) string {
+	_, query = SplitCTE(query)
+	m := execQueryRE.FindStringSubmatch(query)
+	switch {
+	case m != nil && (force || !returningRE.MatchString(query)):
+		return strings.ToUpper(m[1])
+	case force:
+		if f := strings.Fields(query); len(f) != 0 {
+			return strings.ToUpper(f[0])
+		}
+	}
+
+	return ""
+}
+
+// leadingCommentsRE matches the SQL comment lines starting a query.
+var leadingCommentsRE = regexp.MustCompile(`\A(?:\s*--[^\n]*(?:\n|\z))+`)
+
+// QueryLeadingComments returns the text of the SQL comment lines starting the
+// query of the ArgType (ie, "-- AuthorsByName retrieves ..."), joined with a
+// space, removing them from the query.
+func (a *ArgType) QueryLeadingComments() string {
+	m := leadingCommentsRE.FindString(a.Query)
+	if m == "" {
+		return ""
+	}
+	a.Query = a.Query[len(m):]
+	if n := strings.Count(m, "\n"); n <= len(a.QueryLines) {
+		a.QueryLines = a.QueryLines[n:]
+	}
+
+	var lines []string
+	for _, l := range strings.Split(m, "\n") {
+		if l = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(l), "--")); l != "" {
+			lines = append(lines, l)
+		}
+	}
+
+	return strings.Join(lines, " ")
+}
+
+// columnTypeRE matches the lines of a query annotated with the Go type of the
+// column aliased on the line (ie, "rank() OVER w AS pos, -- xo:int64"),
+// capturing the alias and the type.
+var columnTypeRE = regexp.MustCompile("(?im)^.*\\bAS\\s+[\"`]?(\\w+)[\"`]?.*?--\\s*xo:(\\S+)[ \\t]*$")
+
+// columnTypeAnnotationRE matches the Go type annotations of the columns of a
+// query.
+var columnTypeAnnotationRE = regexp.MustCompile(`(?m)[ \t]*--\s*xo:\S+[ \t]*$`)
+
+// QueryColumnTypes returns the Go types annotated on the columns of the query
+// of the ArgType (ie, "row_number() OVER w AS pos -- xo:int64"), keyed by
+// column name, removing the annotations from the query.
+func (a *ArgType) QueryColumnTypes() map[string]string {
+	types := map[string]string{}
+	for _, m := range columnTypeRE.FindAllStringSubmatch(a.Query, -1) {
+		types[m[1]] = m[2]
+	}
+	a.Query = columnTypeAnnotationRE.ReplaceAllString(a.Query, "")
+
+	return types
+}
+
+// windowColumnRE matches the columns of the window functions returning a
+// row number or a count (ie, "row_number() OVER (ORDER BY id) AS pos"),
+// capturing their alias.
+var windowColumnRE = regexp.MustCompile("(?i)\\b(?:row_number|rank|dense_rank|ntile|count)\\s*\\([^)]*\\)\\s*OVER\\s*(?:\\w+|\\((?:[^()]|\\([^()]*\\))*\\))\\s*(?:AS\\s+)?[\"`]?(\\w+)")
+
+// WindowColumns returns the names of the columns of query computed by window
+// functions returning a row number or a count, which are typed bigint when
+// the database does not type them (ie, SQLite).
+func WindowColumns(query string) map[string]bool {
+	cols := map[string]bool{}
+	for _, m := range windowColumnRE.FindAllStringSubmatch(query, -1) {
+		cols[m[1]] = true
+	}
 
-	return str, params
+	return cols
+}
+
+// aggregateColumnRE matches the columns of the aggregate functions returning
+// a number (ie, "count(*)" or "avg(price) AS price"), capturing the call, the
+// function and the alias, if any.
+var aggregateColumnRE = regexp.MustCompile("(?i)\\b((count|sum|avg|total)\\s*\\((?:[^()]|\\([^()]*\\))*\\))(?:\\s+(?:AS\\s+)?[\"`]?(\\w+))?")
+
+// AggregateColumns returns the Go types of the columns of query computed by
+// aggregate functions returning a number, keyed by their alias and by their
+// call (ie, "count(*)", naming the column without an alias): int64 for
+// count, and float64 for the others. They are used when the database does
+// not type them (ie, SQLite).
+func AggregateColumns(query string) map[string]string {
+	cols := map[string]string{}
+	for _, m := range aggregateColumnRE.FindAllStringSubmatch(query, -1) {
+		typ := "float64"
+		if strings.EqualFold(m[2], "count") {
+			typ = "int64"
+		}
+		cols[m[1]] = typ
+		if m[3] != "" && !aliasKeywords[strings.ToUpper(m[3])] {
+			cols[m[3]] = typ
+		}
+	}
+
+	return cols
+}
+
+// aliasKeywords are the SQL keywords following a column without an alias.
+var aliasKeywords = map[string]bool{
+	"FROM":   true,
+	"WHERE":  true,
+	"GROUP":  true,
+	"HAVING": true,
+	"ORDER":  true,
+	"LIMIT":  true,
+	"OVER":   true,
+	"FILTER": true,
+	"UNION":  true,
+	"AND":    true,
+	"OR":     true,
+}
+
+// zeroValue returns the zero value of the Go type typ.
+func zeroValue(typ string) string {
+	switch {
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case intTypeRE.MatchString(typ), typ == "float32", typ == "float64", typ == "byte", typ == "rune":
+		return "0"
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "map["), typ == "interface{}":
+		return "nil"
+	}
+
+	return typ + "{}"
 }
 
 // IntRE matches Go int types.
@@ -130,6 +737,417 @@ func (a *ArgType) ParsePrecision(dt string) (string, int, int) {
 	return dt, precision, scale
 }
 
+// limitRE matches the end of the SQL preceding the params in a LIMIT or
+// OFFSET position (ie, "LIMIT ", "LIMIT ?, " or "FETCH NEXT ").
+var limitRE = regexp.MustCompile(`(?i)\b(?:LIMIT|OFFSET|FETCH\s+(?:NEXT|FIRST))\s*$|\bLIMIT\s+\S+\s*,\s*$`)
+
+// intTypeRE matches the Go integer types.
+var intTypeRE = regexp.MustCompile(`^u?int(8|16|32|64)?$`)
+
+// interpolSafe determines if the values of the Go type typ are safe to
+// interpolate into a query unchecked (ie, the numbers and bools).
+func interpolSafe(typ string) bool {
+	return typ == "bool" || intTypeRE.MatchString(typ) || strings.HasPrefix(typ, "float")
+}
+
+// onUpdateRE matches column extra definitions for columns that are set by the
+// database on update (ie, "on update CURRENT_TIMESTAMP", "DEFAULT_GENERATED on
+// update current_timestamp(3)").
+var onUpdateRE = regexp.MustCompile(`(?i)\bon update current_timestamp\b`)
+
+// generatedRE matches column extra definitions for columns that are generated
+// by the database (ie, "auto_increment", "identity", "VIRTUAL GENERATED",
+// "computed"), but not "DEFAULT_GENERATED".
+var generatedRE = regexp.MustCompile(`(?i)\b(auto_increment|identity|computed|(virtual|stored) generated)\b`)
+
+// defaultGeneratedRE matches column extra definitions for columns whose
+// default is an expression (ie, MySQL's "DEFAULT_GENERATED").
+var defaultGeneratedRE = regexp.MustCompile(`(?i)\bdefault_generated\b`)
+
+// defaultExprRE matches column defaults that are evaluated by the database on
+// insert, rather than literals: function calls (ie, "now()", "(getdate())",
+// "nextval('books_id_seq'::regclass)") and the SQL current time and date
+// keywords (ie, "CURRENT_TIMESTAMP", "SYSDATE").
+var defaultExprRE = regexp.MustCompile(`(?i)^\(*\s*([a-z_][\w.]*\s*\(|(current_(timestamp|date|time)|localtime(stamp)?|sysdate|systimestamp)\b)`)
+
+// isGenerated determines if the column c is generated by the database (ie, an
+// identity or computed column), or has a default evaluated by the database on
+// insert (ie, "DEFAULT now()").
+func isGenerated(c *models.Column) bool {
+	return generatedRE.MatchString(c.Extra) ||
+		defaultGeneratedRE.MatchString(c.Extra) ||
+		defaultExprRE.MatchString(c.DefaultValue.String)
+}
+
+// DecimalType returns the nil value and Go type of a NUMERIC or DECIMAL
+// column when ArgType.Decimal is toggled.
+func (a *ArgType) DecimalType(nullable bool) (string, string) {
+	if nullable {
+		return "decimal.NullDecimal{}", "decimal.NullDecimal"
+	}
+
+	return "decimal.Decimal{}", "decimal.Decimal"
+}
+
+// typeImports are the import paths of the packages of the Go types of the
+// columns, by package name.
+var typeImports = map[string]string{
+	"decimal": "github.com/shopspring/decimal",
+	"uuid":    "github.com/google/uuid",
+}
+
+// addTypeImport adds the import path of the package of typ, if any, to the
+// imports of the file of the type named name.
+func (a *ArgType) addTypeImport(name, typ string) {
+	if i := strings.Index(typ, "."); i != -1 {
+		if path, ok := typeImports[strings.TrimPrefix(typ[:i], "[]")]; ok {
+			a.addImport(name, path)
+		}
+	}
+}
+
+// reuuid maps the field f of the table to uuid.UUID when its column is one of
+// the uuid_columns of the methods config file.
+func (a *ArgType) reuuid(table string, f *Field) error {
+	if a.Methods == nil {
+		return nil
+	}
+
+	var ok bool
+	for _, s := range a.Methods.UUIDColumns {
+		if s == table+"."+f.Col.ColumnName || s == "*."+f.Col.ColumnName {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return nil
+	}
+
+	switch dt := strings.ToLower(f.Col.DataType); dt {
+	case "binary(16)":
+		a.BinaryUUID = true
+		a.addImport("xo_db", typeImports["uuid"])
+	case "char(36)", "uuid":
+	default:
+		return fmt.Errorf("%s.%s: cannot map %s column to uuid", table, f.Col.ColumnName, dt)
+	}
+
+	f.NilType, f.Type = "uuid.Nil", "uuid.UUID"
+	if !f.Col.NotNull {
+		f.NilType, f.Type = "uuid.NullUUID{}", "uuid.NullUUID"
+	}
+
+	return nil
+}
+
+// shardKeyField returns the field of t that is its shard key in the sharding
+// section of the methods config file, or nil when t is not sharded. A key of
+// the table takes precedence over a key of all tables (ie, *.user_id).
+func (a *ArgType) shardKeyField(t *Type) *Field {
+	if a.Methods == nil || a.Methods.Sharding == nil {
+		return nil
+	}
+
+	return tableField(t, a.Methods.Sharding.Keys)
+}
+
+// guardField returns the field of the guard column of t configured in the
+// guards of the methods config file, or nil when t has none.
+func (a *ArgType) guardField(t *Type) *Field {
+	if a.Methods == nil {
+		return nil
+	}
+
+	return tableField(t, a.Methods.Guards)
+}
+
+// tableField returns the field of t whose column is one of cols (ie,
+// users.org_id, or *.org_id), a column of the table taking precedence over
+// the columns of all tables.
+func tableField(t *Type, cols []string) *Field {
+	for _, prefix := range []string{t.Table.TableName + ".", "*."} {
+		for _, s := range cols {
+			if !strings.HasPrefix(s, prefix) {
+				continue
+			}
+			for _, f := range t.Fields {
+				if f.Col.ColumnName == s[len(prefix):] {
+					return f
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// crossShard determines if a query of t filtered by fields (ie, the columns of
+// the index named index) runs across the shards of t: t has a shard key that
+// is not one of fields, and neither t nor the index are cross_shard in the
+// sharding section of the methods config file.
+func (a *ArgType) crossShard(t *Type, fields []*Field, index string) bool {
+	if t.ShardKeyField == nil {
+		return false
+	}
+
+	for _, f := range fields {
+		if f == t.ShardKeyField {
+			return false
+		}
+	}
+	for _, s := range a.Methods.Sharding.CrossShard {
+		if s == t.Table.TableName || (index != "" && s == t.Table.TableName+"."+index) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// guarded determines if a query of t filtered by fields must also filter by
+// the guard column of t, which is not one of fields.
+func guarded(t *Type, fields []*Field) bool {
+	if t.GuardField == nil {
+		return false
+	}
+
+	for _, f := range fields {
+		if f == t.GuardField {
+			return false
+		}
+	}
+
+	return true
+}
+
+// guardFirst returns fields preceded by the guard field of t when it is not
+// one of them, making it the first param of the generated funcs.
+func guardFirst(t *Type, fields []*Field) []*Field {
+	if !guarded(t, fields) {
+		return fields
+	}
+
+	return append([]*Field{t.GuardField}, fields...)
+}
+
+// shardKeyFirst returns fields with the shard key of t moved first, making it
+// the first param of the generated funcs.
+func shardKeyFirst(t *Type, fields []*Field) []*Field {
+	for i, f := range fields {
+		if i != 0 && f == t.ShardKeyField {
+			return moveFieldFirst(fields, i)
+		}
+	}
+
+	return fields
+}
+
+// moveFieldFirst returns a copy of fields with the field at i moved first.
+func moveFieldFirst(fields []*Field, i int) []*Field {
+	res := append([]*Field{fields[i]}, fields[:i]...)
+	return append(res, fields[i+1:]...)
+}
+
+// addImport adds the import path to the imports of the file of the type
+// named name, if not already present.
+func (a *ArgType) addImport(name, path string) {
+	for _, s := range a.Imports[name] {
+		if s == path {
+			return
+		}
+	}
+
+	a.Imports[name] = append(a.Imports[name], path)
+}
+
+// stringEnum determines if the enum named name is backed by its string
+// values, either with ArgType.StringEnums or the string_enums of the methods
+// config file.
+func (a *ArgType) stringEnum(name string) bool {
+	if a.StringEnums {
+		return true
+	}
+
+	if a.Methods != nil {
+		for _, s := range a.Methods.StringEnums {
+			if s == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// renull replaces the Go null type of f with the type configured in the
+// null_types section of the methods config file, if any.
+func (a *ArgType) renull(f *Field) {
+	if a.Methods == nil {
+		return
+	}
+
+	if c, ok := a.Methods.NullTypes[f.Type]; ok {
+		f.Type, f.NilType = c.Type, c.Nil
+	}
+}
+
+// tagDirectiveRE matches the xo directive of a column comment setting the
+// struct tags of its field (ie, xo:"json:-,validate:required").
+var tagDirectiveRE = regexp.MustCompile(`\bxo:"([^"]*)"`)
+
+// tagKeyRE matches the key of a struct tag in a xo directive.
+var tagKeyRE = regexp.MustCompile(`^\s*([A-Za-z_][A-Za-z0-9_]*):`)
+
+// parseTagDirective parses the struct tags of the xo directive in the column
+// comment, if any. The items without a key continue the value of the previous
+// tag (ie, xo:"json:name,omitempty,validate:required,len=13").
+func parseTagDirective(comment string) ([]StructTag, error) {
+	m := tagDirectiveRE.FindStringSubmatch(comment)
+	if m == nil {
+		return nil, nil
+	}
+
+	var tags []StructTag
+	for _, s := range strings.Split(m[1], ",") {
+		if strings.Contains(s, "`") {
+			return nil, fmt.Errorf("invalid struct tag %q", s)
+		}
+		k := tagKeyRE.FindStringSubmatch(s)
+		switch {
+		case k != nil:
+			tags = append(tags, StructTag{Key: k[1], Value: s[len(k[0]):]})
+		case len(tags) != 0:
+			tags[len(tags)-1].Value += "," + s
+		default:
+			return nil, fmt.Errorf("invalid struct tag %q", s)
+		}
+	}
+
+	return tags, nil
+}
+
+// pbDirectiveRE matches the xo directive of a column comment renaming its
+// field in the proto message, or skipping it (ie, xo:pb:"display_name", or
+// xo:pb:"-").
+var pbDirectiveRE = regexp.MustCompile(`\bxo:pb:"([^"]*)"`)
+
+// pbNameRE matches the valid names of the proto fields.
+var pbNameRE = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// parsePBDirective parses the proto field name of the xo:pb directive in the
+// column comment, if any.
+func parsePBDirective(comment string) (string, error) {
+	m := pbDirectiveRE.FindStringSubmatch(comment)
+	if m == nil {
+		return "", nil
+	}
+	if m[1] != "-" && !pbNameRE.MatchString(m[1]) {
+		return "", fmt.Errorf("invalid proto field name %q", m[1])
+	}
+
+	return m[1], nil
+}
+
+// checkCastRE matches the casts and the charset introducers of a check
+// constraint clause (ie, ::numeric, or _utf8mb4 before a string).
+var checkCastRE = regexp.MustCompile(`::[a-z ]+(?:\[\])?|\b_[a-z0-9]+'`)
+
+// checkBetweenRE matches a BETWEEN condition of a check constraint clause.
+var checkBetweenRE = regexp.MustCompile(`(?i)(\w+)\)?\s+between\s+\(?(-?[0-9.]+)\)?\s+and\s+\(?(-?[0-9.]+)`)
+
+// checkAndRE and checkOrRE match the AND and OR of a check constraint clause.
+var (
+	checkAndRE = regexp.MustCompile(`(?i)\s+and\s+`)
+	checkOrRE  = regexp.MustCompile(`(?i)\bor\b`)
+)
+
+// checkCompareRE matches the comparison of a column to a number, in either
+// order.
+var checkCompareRE = regexp.MustCompile(`^(?:(\w+)\s*(<=|>=|<|>)\s*(-?[0-9.]+)|(-?[0-9.]+)\s*(<=|>=|<|>)\s*(\w+))$`)
+
+// checkInRE matches the IN list (or the = ANY array of postgres) of a column.
+var checkInRE = regexp.MustCompile(`(?i)^\(*(\w+)\)*\s*(?:in\s*\(|=\s*any\s*\(+array\[)(.*?)[\])]+$`)
+
+// checkValueRE matches the quoted values of an IN list.
+var checkValueRE = regexp.MustCompile(`'((?:[^']|'')*)'`)
+
+// checkFlip are the operators comparing a number to a column, flipped to
+// compare the column to the number.
+var checkFlip = map[string]string{"<": ">", "<=": ">=", ">": "<", ">=": "<="}
+
+// parseCheck sets the bounds and the allowed values of the fields from the
+// check constraint clause, when it is a conjunction of comparisons of a
+// column to a number (ie, CHECK (rating >= 1 AND rating <= 5), or rating
+// BETWEEN 1 AND 5), and IN lists of strings. The other clauses are ignored.
+func parseCheck(clause string, fields []*Field) {
+	clause = strings.NewReplacer("`", "", `"`, "").Replace(clause)
+	clause = checkCastRE.ReplaceAllStringFunc(clause, func(s string) string {
+		return strings.TrimLeft(s, "_abcdefghijklmnopqrstuvwxyz0123456789:[] ")
+	})
+	clause = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(clause), "CHECK"))
+	if checkOrRE.MatchString(checkValueRE.ReplaceAllString(clause, "''")) {
+		return
+	}
+	clause = checkBetweenRE.ReplaceAllString(clause, "$1 >= $2 and $1 <= $3")
+
+	byName := make(map[string]*Field, len(fields))
+	for _, f := range fields {
+		byName[f.Col.ColumnName] = f
+	}
+
+	for _, cond := range checkAndRE.Split(clause, -1) {
+		if m := checkInRE.FindStringSubmatch(strings.TrimSpace(cond)); m != nil {
+			if f, ok := byName[m[1]]; ok {
+				f.In = nil
+				for _, v := range checkValueRE.FindAllStringSubmatch(m[2], -1) {
+					f.In = append(f.In, strings.ReplaceAll(v[1], "''", "'"))
+				}
+			}
+			continue
+		}
+
+		m := checkCompareRE.FindStringSubmatch(strings.NewReplacer("(", "", ")", "").Replace(strings.TrimSpace(cond)))
+		if m == nil {
+			continue
+		}
+		col, op, v := m[1], m[2], m[3]
+		if col == "" {
+			col, op, v = m[6], checkFlip[m[5]], m[4]
+		}
+		if f, ok := byName[col]; ok {
+			if f.Bounds == nil {
+				f.Bounds = make(map[string]string)
+			}
+			f.Bounds[op] = v
+		}
+	}
+}
+
+// counterDirectiveRE matches the xo directive of a column comment marking the
+// column as a counter.
+var counterDirectiveRE = regexp.MustCompile(`\bxo:counter\b`)
+
+// counter determines if the column of the field f of the table is a counter,
+// either with the counters of the methods config file or the xo:counter
+// directive of its comment.
+func (a *ArgType) counter(table string, f *Field) bool {
+	c := f.Col
+	if counterDirectiveRE.MatchString(c.ColumnComment) {
+		return true
+	}
+
+	if a.Methods != nil {
+		for _, s := range a.Methods.Counters {
+			if s == table+"."+c.ColumnName || s == "*."+c.ColumnName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // IndexChopSuffixRE is the regexp of index name suffixes that will be chopped off.
 var IndexChopSuffixRE = regexp.MustCompile(`(?i)_(ix|idx|index|pkey|ukey|key)$`)
 
@@ -210,6 +1228,39 @@ func GenRandomID() string {
 	return string(b)
 }
 
+// QueryNullable sets the NOT NULL state of the columns cols of the inspected
+// query to the nullability of its result columns reported by the driver (ie,
+// MySQL's), leaving the columns whose nullability is not reported as is.
+func QueryNullable(args *ArgType, inspect []string, cols []*models.Column) error {
+	with, body := SplitCTE(strings.Join(inspect, "\n"))
+	q := with + `SELECT * FROM (` + body + `) xo_nulls WHERE 1 = 0`
+	models.XOLog(q)
+	rows, err := args.DB.Query(q)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	nullable := map[string]bool{}
+	for _, t := range types {
+		if n, ok := t.Nullable(); ok {
+			nullable[t.Name()] = n
+		}
+	}
+	for _, c := range cols {
+		if n, ok := nullable[c.ColumnName]; ok {
+			c.NotNull = !n
+		}
+	}
+
+	return nil
+}
+
 // reverseIndexRune finds the last rune r in s, returning -1 if not present.
 func reverseIndexRune(s string, r rune) int {
 	if s == "" {
@@ -252,6 +1303,10 @@ type TBuf struct {
 	Name         string
 	Subname      string
 	Buf          *bytes.Buffer
+
+	// Package is the sub-package of the output path the template is
+	// written to, or empty for the output path.
+	Package string
 }
 
 // TBufSlice is a slice of TBuf compatible with sort.Interface.
@@ -266,6 +1321,10 @@ func (t TBufSlice) Swap(i, j int) {
 }
 
 func (t TBufSlice) Less(i, j int) bool {
+	if t[i].Package != t[j].Package {
+		return t[i].Package < t[j].Package
+	}
+
 	if t[i].TemplateType < t[j].TemplateType {
 		return true
 	} else if t[j].TemplateType < t[i].TemplateType {
@@ -281,6 +1340,18 @@ func (t TBufSlice) Less(i, j int) bool {
 	return strings.Compare(t[i].Subname, t[j].Subname) < 0
 }
 
+// pgtypeValueFields are the value fields of the pgtype types used for
+// nullable columns with --pgx.
+var pgtypeValueFields = map[string]string{
+	"pgtype.Bool":   "Bool",
+	"pgtype.Text":   "String",
+	"pgtype.Int2":   "Int16",
+	"pgtype.Int4":   "Int32",
+	"pgtype.Int8":   "Int64",
+	"pgtype.Float4": "Float32",
+	"pgtype.Float8": "Float64",
+}
+
 var sqlReservedNames = map[string]bool{
 	"order":  true,
 	"by":     true,
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_AggregateColumns(t *testing.T) {
+	tests := []struct {
+		desc  string
+		query string
+		exp   map[string]string
+	}{
+		{
+			desc:  "count is keyed by its call",
+			query: "SELECT count(*) FROM books",
+			exp:   map[string]string{"count(*)": "int64"},
+		},
+		{
+			desc:  "average is keyed by its alias",
+			query: "SELECT avg(price) AS avg_price FROM books",
+			exp:   map[string]string{"avg(price)": "float64", "avg_price": "float64"},
+		},
+		{
+			desc:  "sum of an expression",
+			query: "SELECT SUM(coalesce(price, 0)) total_price FROM books",
+			exp:   map[string]string{"SUM(coalesce(price, 0))": "float64", "total_price": "float64"},
+		},
+		{
+			desc:  "window count is not an aggregate alias",
+			query: "SELECT count(*) OVER () FROM books",
+			exp:   map[string]string{"count(*)": "int64"},
+		},
+		{
+			desc:  "no aggregate",
+			query: "SELECT max(price) FROM books",
+			exp:   map[string]string{},
+		},
+	}
+
+	for i, tt := range tests {
+		cols := AggregateColumns(tt.query)
+		if !reflect.DeepEqual(cols, tt.exp) {
+			t.Fatalf("test #%d: %s\n\texp: %v\n\tgot: %v", i+1, tt.desc, tt.exp, cols)
+		}
+	}
+}
+
+func Test_ParseQueryErrors(t *testing.T) {
+	tests := []struct {
+		desc  string
+		query string
+		lines []int
+		err   string
+	}{
+		{
+			desc:  "nested sections",
+			query: "SELECT * FROM books WHERE 1 = 1\n[[AND id = %%id int64%% [[AND title = %%title string%%]]]]",
+			err:   "line 2: optional query sections cannot be nested",
+		},
+		{
+			desc:  "section not opened",
+			query: "SELECT * FROM books WHERE id = %%id int64%%]]",
+			err:   "line 1: optional query sections must be opened, and have params",
+		},
+		{
+			desc:  "section without params",
+			query: "SELECT * FROM books [[WHERE 1 = 1]]",
+			err:   "line 1: optional query sections must be opened, and have params",
+		},
+		{
+			desc:  "section not closed",
+			query: "SELECT *\nFROM books\nWHERE 1 = 1 [[AND id = %%id int64%%\nORDER BY id",
+			err:   "line 3: optional query section is not closed",
+		},
+		{
+			desc:  "section not closed, at its line in the file",
+			query: "SELECT *\nFROM books\nWHERE 1 = 1 [[AND id = %%id int64%%",
+			lines: []int{4, 5, 7},
+			err:   "line 7: optional query section is not closed",
+		},
+		{
+			desc:  "param without a type",
+			query: "SELECT *\nFROM books WHERE id = %%id%%",
+			err:   "line 2: no type for query parameter 'id'",
+		},
+		{
+			desc:  "interpolated string neither allowed nor escaped",
+			query: "SELECT *\nFROM books\nWHERE %%cond string,interpolate%%",
+			err:   "line 3: interpolated query parameter 'cond string,interpolate' requires the allow or ident option",
+		},
+		{
+			desc:  "interpolated time neither allowed nor escaped",
+			query: "SELECT * FROM books WHERE published_at > '%%t time.Time,interpolate%%'",
+			err:   "line 1: interpolated query parameter 't time.Time,interpolate' requires the allow or ident option",
+		},
+	}
+
+	for i, tt := range tests {
+		a := NewDefaultArgs()
+		a.Query, a.QueryLines, a.QueryInterpolate = tt.query, tt.lines, true
+		_, _, _, err := a.ParseQuery("$%d", true)
+		if err == nil || err.Error() != tt.err {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %v", i+1, tt.desc, tt.err, err)
+		}
+	}
+}
+
+func Test_ParseQueryFileErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		file string
+		err  string
+	}{
+		{
+			desc: "section not closed, after the annotations",
+			file: "-- xo:returns one\n\nSELECT *\nFROM books\n-- xo:param id int64\nWHERE 1 = 1 [[AND id = %%id%%\n",
+			err:  "Books: line 6: optional query section is not closed",
+		},
+		{
+			desc: "param without a type, in the first sqlc query",
+			file: "-- name: GetBook :one\nSELECT * FROM books WHERE id = %%id%%;\n\n-- name: ListBooks :many\nSELECT * FROM books;\n",
+			err:  "GetBookRow: line 2: no type for query parameter 'id'",
+		},
+		{
+			desc: "param without a type, in the second sqlc query",
+			file: "-- name: GetBook :one\n-- xo:param id int64\nSELECT * FROM books WHERE id = %%id%%;\n\n-- name: ListBooks :many\n-- a comment\nSELECT *\nFROM books WHERE title = %%title%%;\n",
+			err:  "ListBooksRow: line 8: no type for query parameter 'title'",
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "xo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i, tt := range tests {
+		file := filepath.Join(dir, "books.sql")
+		if err := ioutil.WriteFile(file, []byte(tt.file), 0644); err != nil {
+			t.Fatal(err)
+		}
+		a := NewDefaultArgs()
+		err := a.ParseQueryFile(file, func(a *ArgType) error {
+			_, _, _, err := a.ParseQuery("$%d", true)
+			return err
+		})
+		if err == nil || err.Error() != tt.err {
+			t.Fatalf("test #%d: %s\n\texp: %s\n\tgot: %v", i+1, tt.desc, tt.err, err)
+		}
+	}
+}
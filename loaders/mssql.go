@@ -27,9 +27,20 @@ func init() {
 		IndexList:       models.MsTableIndexes,
 		IndexColumnList: models.MsIndexColumns,
 		QueryColumnList: MsQueryColumns,
+		LimitFunc:       MsLimit,
 	}
 }
 
+// MsLimit returns the OFFSET/FETCH clause for a query. The clause must follow
+// an ORDER BY.
+func MsLimit(limit string, offset string) string {
+	if offset == "" {
+		offset = "0"
+	}
+
+	return "OFFSET " + offset + " ROWS FETCH NEXT " + limit + " ROWS ONLY"
+}
+
 // MsSchema retrieves the name of the current schema.
 func MsSchema(args *internal.ArgType) (string, error) {
 	var err error
@@ -148,6 +159,9 @@ func MsParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 			nilVal = "sql.NullFloat64{}"
 			typ = "sql.NullFloat64"
 		}
+		if args.Decimal {
+			nilVal, typ = args.DecimalType(nullable)
+		}
 
 	case "binary", "varbinary":
 		typ = "[]byte"
@@ -205,6 +219,11 @@ func MsQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 	// load columns
 	cols, err := models.MsTableColumns(args.DB, args.Schema, xoid)
 
+	// use the NULL state of the result columns reported by the driver
+	if err == nil && args.QueryAllowNulls {
+		err = internal.QueryNullable(args, ins, cols)
+	}
+
 	// drop inspect view
 	dropq := `DROP VIEW ` + xoid
 	models.XOLog(dropq)
@@ -1,6 +1,8 @@
 package loaders
 
 import (
+	"database/sql"
+	"fmt"
 	"strings"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -22,15 +24,34 @@ func init() {
 		EnumValueList:   MyEnumValues,
 		ProcList:        models.MyProcs,
 		ProcParamList:   models.MyProcParams,
+		ProcedureList:   models.MyProcedures,
+		ProcResultSets:  MyProcResultSets,
 		TableList:       MyTables,
 		ColumnList:      models.MyTableColumns,
 		ForeignKeyList:  models.MyTableForeignKeys,
+		CheckList:       models.MyTableCheckConstraints,
 		IndexList:       models.MyTableIndexes,
 		IndexColumnList: models.MyIndexColumns,
 		QueryColumnList: MyQueryColumns,
+		UpsertFunc:      MyUpsert,
 	}
 }
 
+// MyUpsert returns the ON DUPLICATE KEY UPDATE clause for an upsert.
+func MyUpsert(conflict []string, update []string) string {
+	// no-op update when there is nothing else to set
+	if len(update) == 0 {
+		return "ON DUPLICATE KEY UPDATE " + conflict[0] + " = " + conflict[0]
+	}
+
+	set := make([]string, len(update))
+	for i, c := range update {
+		set[i] = c + " = VALUES(" + c + ")"
+	}
+
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(set, ", ")
+}
+
 // MySchema retrieves the name of the current schema.
 func MySchema(args *internal.ArgType) (string, error) {
 	var err error
@@ -181,6 +202,9 @@ switchDT:
 			nilVal = "sql.NullFloat64{}"
 			typ = "sql.NullFloat64"
 		}
+		if dt == "decimal" && args.Decimal {
+			nilVal, typ = args.DecimalType(nullable)
+		}
 
 	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
 		typ = "[]byte"
@@ -283,7 +307,12 @@ func MyQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 
 	// create temporary view xoid
 	xoid := "_xo_" + internal.GenRandomID()
-	viewq := `CREATE VIEW ` + xoid + ` AS (` + strings.Join(inspect, "\n") + `)`
+	// the query is parenthesized, unless it has CTEs
+	q := strings.Join(inspect, "\n")
+	if with, _ := internal.SplitCTE(q); with == "" {
+		q = `(` + q + `)`
+	}
+	viewq := `CREATE VIEW ` + xoid + ` AS ` + q
 	models.XOLog(viewq)
 	_, err = args.DB.Exec(viewq)
 	if err != nil {
@@ -293,6 +322,11 @@ func MyQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 	// load columns
 	cols, err := models.MyTableColumns(args.DB, args.Schema, xoid)
 
+	// use the NULL state of the result columns reported by the driver
+	if err == nil && args.QueryAllowNulls {
+		err = internal.QueryNullable(args, inspect, cols)
+	}
+
 	// drop inspect view
 	dropq := `DROP VIEW ` + xoid
 	models.XOLog(dropq)
@@ -301,3 +335,79 @@ func MyQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 	// load column information
 	return cols, err
 }
+
+// MyProcResultSets retrieves the columns of the result sets of a stored
+// procedure, calling it with NULL params in a transaction that is rolled back.
+func MyProcResultSets(args *internal.ArgType, p *internal.Proc) ([][]*models.Column, error) {
+	var err error
+
+	tx, err := args.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// sql query
+	params := make([]string, len(p.Params))
+	for i := range params {
+		params[i] = "NULL"
+	}
+	sqlstr := `CALL ` + p.Proc.ProcName + `(` + strings.Join(params, ", ") + `)`
+
+	// run query
+	models.XOLog(sqlstr)
+	q, err := tx.Query(sqlstr)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load the columns of each result set, skipping the status of the call
+	var sets [][]*models.Column
+	for {
+		types, err := q.ColumnTypes()
+		if err != nil {
+			return nil, err
+		}
+
+		var cols []*models.Column
+		for i, t := range types {
+			c := &models.Column{
+				FieldOrdinal: i + 1,
+				ColumnName:   t.Name(),
+				DataType:     myResultType(t),
+				NotNull:      true,
+			}
+			if nullable, ok := t.Nullable(); ok {
+				c.NotNull = !nullable
+			}
+			cols = append(cols, c)
+		}
+		if len(cols) != 0 {
+			sets = append(sets, cols)
+		}
+
+		if !q.NextResultSet() {
+			break
+		}
+	}
+
+	return sets, q.Err()
+}
+
+// myResultType returns the MySQL data type of a result column (ie, "int
+// unsigned" for "UNSIGNED INT", "decimal(10,2)" for "DECIMAL").
+func myResultType(t *sql.ColumnType) string {
+	dt := strings.ToLower(t.DatabaseTypeName())
+
+	unsigned := strings.HasPrefix(dt, "unsigned ")
+	dt = strings.TrimPrefix(dt, "unsigned ")
+	if prec, scale, ok := t.DecimalSize(); ok {
+		dt = fmt.Sprintf("%s(%d,%d)", dt, prec, scale)
+	}
+	if unsigned {
+		dt += " unsigned"
+	}
+
+	return dt
+}
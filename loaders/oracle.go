@@ -31,6 +31,8 @@ func init() {
 		IndexList:       models.OrTableIndexes,
 		IndexColumnList: models.OrIndexColumns,
 		QueryColumnList: OrQueryColumns,
+		// oracle 12c+ shares the mssql OFFSET/FETCH syntax
+		LimitFunc: MsLimit,
 	}
 }
 
@@ -128,12 +130,17 @@ func OrParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 				nilVal = "sql.NullFloat64{}"
 				typ = "sql.NullFloat64"
 			}
+			if args.Decimal {
+				nilVal, typ = args.DecimalType(nullable)
+			}
 		} else if 0 < precision && precision <= 19 && scale == 0 {
 			typ = "int64"
 			if nullable {
 				nilVal = "sql.NullInt64{}"
 				typ = "sql.NullInt64"
 			}
+		} else if args.Decimal {
+			nilVal, typ = args.DecimalType(nullable)
 		} else {
 			nilVal = `""`
 			typ = "string"
@@ -6,7 +6,7 @@ import (
 	"strconv"
 	"strings"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 
 	"github.com/kenshaw/snaker"
 
@@ -28,13 +28,42 @@ func init() {
 			return models.PgTableColumns(db, schema, table, internal.Args.EnablePostgresOIDs)
 		},
 		ForeignKeyList:  models.PgTableForeignKeys,
+		CheckList:       models.PgTableCheckConstraints,
 		IndexList:       models.PgTableIndexes,
 		IndexColumnList: PgIndexColumns,
 		QueryStrip:      PgQueryStrip,
 		QueryColumnList: PgQueryColumns,
+		UpsertFunc:      PgUpsert,
+		QueryErrorPos:   PgQueryErrorPos,
 	}
 }
 
+// PgQueryErrorPos returns the 1-based character position in the query of the
+// error err, or 0 when unknown.
+func PgQueryErrorPos(err error) int {
+	e, ok := err.(*pq.Error)
+	if !ok {
+		return 0
+	}
+
+	pos, _ := strconv.Atoi(e.Position)
+	return pos
+}
+
+// PgUpsert returns the ON CONFLICT clause for an upsert.
+func PgUpsert(conflict []string, update []string) string {
+	if len(update) == 0 {
+		return "ON CONFLICT (" + strings.Join(conflict, ", ") + ") DO NOTHING"
+	}
+
+	set := make([]string, len(update))
+	for i, c := range update {
+		set[i] = c + " = EXCLUDED." + c
+	}
+
+	return "ON CONFLICT (" + strings.Join(conflict, ", ") + ") DO UPDATE SET " + strings.Join(set, ", ")
+}
+
 // PgRelkind returns the postgres string representation for RelType.
 func PgRelkind(relType internal.RelType) string {
 	var s string
@@ -147,6 +176,9 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 			nilVal = "sql.NullFloat64{}"
 			typ = "sql.NullFloat64"
 		}
+		if dt == "numeric" && args.Decimal {
+			nilVal, typ = args.DecimalType(nullable)
+		}
 
 	case "bytea":
 		asSlice = true
@@ -182,8 +214,12 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 		typ = "hstore.Hstore"
 
 	case "uuid":
-		nilVal = "uuid.New()"
+		nilVal = "uuid.Nil"
 		typ = "uuid.UUID"
+		if nullable {
+			nilVal = "uuid.NullUUID{}"
+			typ = "uuid.NullUUID"
+		}
 
 	default:
 		if strings.HasPrefix(dt, args.Schema+".") {
@@ -196,6 +232,13 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 		}
 	}
 
+	// use the native pgtype types for nullable columns with pgx
+	if args.Pgx && nullable && !asSlice {
+		if t, ok := pgxNullTypes[dt]; ok && !strings.HasPrefix(typ, "decimal.") {
+			typ, nilVal = t, t+"{}"
+		}
+	}
+
 	// special case for []slice
 	if typ == "string" && asSlice {
 		return precision, "StringSlice{}", "StringSlice"
@@ -210,6 +253,30 @@ func PgParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 	return precision, nilVal, typ
 }
 
+// pgxNullTypes are the pgtype types for nullable columns of the postgres
+// types, used with --pgx.
+var pgxNullTypes = map[string]string{
+	"boolean":                     "pgtype.Bool",
+	"character":                   "pgtype.Text",
+	"character varying":           "pgtype.Text",
+	"text":                        "pgtype.Text",
+	"money":                       "pgtype.Text",
+	"inet":                        "pgtype.Text",
+	"smallint":                    "pgtype.Int2",
+	"integer":                     "pgtype.Int4",
+	"bigint":                      "pgtype.Int8",
+	"smallserial":                 "pgtype.Int2",
+	"serial":                      "pgtype.Int4",
+	"bigserial":                   "pgtype.Int8",
+	"real":                        "pgtype.Float4",
+	"double precision":            "pgtype.Float8",
+	"numeric":                     "pgtype.Float8",
+	"date":                        "pgtype.Date",
+	"timestamp with time zone":    "pgtype.Timestamptz",
+	"timestamp without time zone": "pgtype.Timestamp",
+	"time without time zone":      "pgtype.Time",
+}
+
 // pgQueryStripRE is the regexp to match the '::type AS name' portion in a query,
 // which is a quirk/requirement of generating queries as is done in this
 // package.
@@ -272,7 +339,9 @@ func PgQueryColumns(args *internal.ArgType, inspect []string) ([]*models.Column,
 
 	// create temporary view xoid
 	xoid := "_xo_" + internal.GenRandomID()
-	viewq := `CREATE TEMPORARY VIEW ` + xoid + ` AS (` + strings.Join(inspect, "\n") + `)`
+	// the CTEs of the query come before its parenthesized statement
+	with, body := internal.SplitCTE(strings.Join(inspect, "\n"))
+	viewq := `CREATE TEMPORARY VIEW ` + xoid + ` AS ` + with + `(` + body + `)`
 	models.XOLog(viewq)
 	_, err = args.DB.Exec(viewq)
 	if err != nil {
@@ -28,6 +28,8 @@ func init() {
 			return models.SqIndexColumns(db, index)
 		},
 		QueryColumnList: SqQueryColumns,
+		// sqlite 3.24+ shares the postgres upsert syntax
+		UpsertFunc: PgUpsert,
 	}
 }
 
@@ -89,6 +91,9 @@ func SqParseType(args *internal.ArgType, dt string, nullable bool) (int, string,
 			nilVal = "sql.NullFloat64{}"
 			typ = "sql.NullFloat64"
 		}
+		if (dt == "numeric" || dt == "decimal") && args.Decimal {
+			nilVal, typ = args.DecimalType(nullable)
+		}
 
 	case "blob":
 		typ = "[]byte"
@@ -11,8 +11,11 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/alexflint/go-arg"
 	"github.com/go-yaml/yaml"
@@ -78,7 +81,9 @@ func main() {
 	}
 
 	// load defs into type map
-	if args.QueryMode {
+	if args.QueryDir != "" {
+		err = parseQueryDir(args)
+	} else if args.QueryMode {
 		err = args.Loader.ParseQuery(args)
 	} else {
 		err = args.Loader.LoadSchema(args)
@@ -88,13 +93,26 @@ func main() {
 		os.Exit(1)
 	}
 
-	// add xo
-	err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args, false)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+	// report warnings
+	for _, w := range args.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
 	}
 
+	// add xo, along with a copy for each query sub-package
+	pkgs := map[string]bool{"": true}
+	for _, t := range args.Generated {
+		pkgs[t.Package] = true
+	}
+	for pkg := range pkgs {
+		args.QueryPackage = pkg
+		err = args.ExecuteTemplate(internal.XOTemplate, "xo_db", "", args, false)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	args.QueryPackage = ""
+
 	// output
 	err = writeTypes(args)
 	if err != nil {
@@ -107,6 +125,18 @@ func main() {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
 	}
+
+	err = writeProtoLockFile(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	err = runBuf(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
 }
 
 // processArgs processs cli args.
@@ -176,6 +206,21 @@ func processArgs(args *internal.ArgType) error {
 		args.Filename = args.Package + args.Suffix
 	}
 
+	// the queries of the query dir are read from its files
+	if args.QueryDir != "" {
+		if args.Query != "" {
+			return errors.New("--query-dir cannot be used with a query")
+		}
+		fi, err := os.Stat(args.QueryDir)
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() {
+			return errors.New("query dir is not directory")
+		}
+		args.QueryMode = false
+	}
+
 	// if query mode toggled, but no query, read Stdin.
 	if args.QueryMode && args.Query == "" {
 		buf, err := ioutil.ReadAll(os.Stdin)
@@ -200,6 +245,64 @@ func processArgs(args *internal.ArgType) error {
 		args.Query = strings.TrimSpace(args.Query)
 	}
 
+	// compile table filters
+	if args.Include != "" {
+		args.IncludeRE, err = regexp.Compile(args.Include)
+		if err != nil {
+			return fmt.Errorf("invalid --include: %v", err)
+		}
+	}
+	if args.Exclude != "" {
+		args.ExcludeRE, err = regexp.Compile(args.Exclude)
+		if err != nil {
+			return fmt.Errorf("invalid --exclude: %v", err)
+		}
+	}
+
+	// mocks are generated for the store interfaces
+	if args.Mocks {
+		args.Repository = true
+	}
+
+	// the servers implement the proto services, which the http annotations
+	// map to REST
+	if args.ProtoServer || args.ProtoHTTP {
+		args.ProtoServices = true
+	}
+
+	// pgx always uses a context, and replaces database/sql
+	if args.Pgx && args.NoContext {
+		return errors.New("--pgx cannot be used with --no-context")
+	}
+	if args.Pgx && args.Sqlx {
+		return errors.New("--pgx cannot be used with --sqlx")
+	}
+
+	// the statement cache wraps database/sql (pgx caches statements itself)
+	if args.StmtCache && (args.Pgx || args.Sqlx) {
+		return errors.New("--stmt-cache cannot be used with --pgx or --sqlx")
+	}
+
+	// spans are started from the context of the generated funcs
+	if args.Otel && args.NoContext {
+		return errors.New("--otel cannot be used with --no-context")
+	}
+
+	// check time location
+	if args.TimeLocation != "" {
+		if _, err := time.LoadLocation(args.TimeLocation); err != nil {
+			return fmt.Errorf("invalid --time-location: %v", err)
+		}
+		if args.Sqlx {
+			return errors.New("--time-location cannot be used with --sqlx")
+		}
+	}
+
+	// check batch size
+	if args.BatchSize < 1 {
+		return errors.New("batch size must be greater than 0")
+	}
+
 	// escape all
 	if args.EscapeAll {
 		args.EscapeSchemaName = true
@@ -221,6 +324,163 @@ func processArgs(args *internal.ArgType) error {
 		}
 	}
 
+	if args.ProtoLockFile != "" {
+		err = parseProtoLockFile(args)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseProtoLockFile reads the field numbers of the proto messages from the
+// proto lock file, which is created when it does not exist.
+func parseProtoLockFile(args *internal.ArgType) error {
+	args.ProtoLock = map[string]map[string]int{}
+
+	data, err := ioutil.ReadFile(args.ProtoLockFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(data, &args.ProtoLock)
+}
+
+// writeProtoLockFile writes the field numbers of the proto messages, along
+// with the ones of the new columns, to the proto lock file.
+func writeProtoLockFile(args *internal.ArgType) error {
+	if args.ProtoLockFile == "" {
+		return nil
+	}
+
+	data, err := yaml.Marshal(args.ProtoLock)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(args.ProtoLockFile, data, 0666)
+}
+
+// bufYAML is the buf.yaml config of the proto output. The proto packages do
+// not match their directory, nor have a version suffix.
+const bufYAML = `version: v1
+lint:
+  use:
+    - DEFAULT
+  except:
+    - PACKAGE_DIRECTORY_MATCH
+    - PACKAGE_VERSION_SUFFIX
+breaking:
+  use:
+    - FILE
+`
+
+// bufGenYAML is the buf.gen.yaml config of the proto output, generating the
+// Go code of the messages along the proto files.
+const bufGenYAML = `version: v1
+plugins:
+  - plugin: go
+    out: .
+    opt: paths=source_relative
+`
+
+// bufGenGRPCYAML is the plugin generating the gRPC Go code of the services.
+const bufGenGRPCYAML = `  - plugin: go-grpc
+    out: .
+    opt: paths=source_relative
+`
+
+// bufGenValidateYAML is the plugin generating the Validate methods of the
+// messages from their protoc-gen-validate rules.
+const bufGenValidateYAML = `  - plugin: validate
+    out: .
+    opt: lang=go,paths=source_relative
+`
+
+// bufGenGatewayYAML is the plugin generating the grpc-gateway reverse proxy
+// of the services from their google.api.http annotations.
+const bufGenGatewayYAML = `  - plugin: grpc-gateway
+    out: .
+    opt: paths=source_relative
+`
+
+// runBuf writes the buf.yaml and buf.gen.yaml configs of the proto output,
+// unless they exist, then runs buf lint and buf generate in its directory,
+// updating its dependencies first, if any. The commands are printed instead
+// when buf is not installed.
+func runBuf(args *internal.ArgType) error {
+	if !args.ProtoBuf || len(args.GeneratedProto) == 0 {
+		return nil
+	}
+
+	dir := args.RpcProtoPathPrefix
+	if dir == "" {
+		dir = "."
+	}
+
+	conf, gen := bufYAML, bufGenYAML
+	if args.ProtoServices {
+		gen = gen + bufGenGRPCYAML
+	}
+	var deps []string
+	if args.ProtoValidate {
+		deps, gen = append(deps, "buf.build/envoyproxy/protoc-gen-validate"), gen+bufGenValidateYAML
+	}
+	if args.ProtoHTTP {
+		deps, gen = append(deps, "buf.build/googleapis/googleapis"), gen+bufGenGatewayYAML
+	}
+	cmds := []string{"lint", "generate"}
+	if len(deps) != 0 {
+		conf = conf + "deps:\n  - " + strings.Join(deps, "\n  - ") + "\n"
+		cmds = append([]string{"mod update"}, cmds...)
+	}
+	for name, data := range map[string]string{"buf.yaml": conf, "buf.gen.yaml": gen} {
+		filename := path.Join(dir, name)
+		if _, err := os.Stat(filename); err == nil {
+			continue
+		}
+		if err := ioutil.WriteFile(filename, []byte(data), 0666); err != nil {
+			return err
+		}
+	}
+
+	if _, err := exec.LookPath("buf"); err != nil {
+		fmt.Fprintf(os.Stderr, "buf is not installed, run in %s:\n\tbuf %s\n", dir, strings.Join(cmds, "\n\tbuf "))
+		return nil
+	}
+	for _, cmd := range cmds {
+		c := exec.Command("buf", strings.Fields(cmd)...)
+		c.Dir = dir
+		output, err := c.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("buf %s: %s with error message: %s", cmd, output, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// parseQueryDir generates the query types and funcs of the .sql files of the
+// query dir, in the order of their names.
+func parseQueryDir(args *internal.ArgType) error {
+	files, err := filepath.Glob(filepath.Join(args.QueryDir, "*.sql"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no .sql files in %s", args.QueryDir)
+	}
+
+	for _, file := range files {
+		err = args.ParseQueryFile(file, args.Loader.ParseQuery)
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+	}
+
 	return nil
 }
 
@@ -235,6 +495,47 @@ func parseMethodsConfigFile(args *internal.ArgType) error {
 		return err
 	}
 	args.Methods = m
+	if c := m.StructTags; c != nil {
+		for _, tag := range c.Tags {
+			switch tag {
+			case "json", "db", "yaml", "validate", "bson":
+			default:
+				return fmt.Errorf("invalid struct tag %q", tag)
+			}
+		}
+		switch c.Case {
+		case "", "snake", "camel":
+		default:
+			return fmt.Errorf("invalid struct tag case %q", c.Case)
+		}
+	}
+	for base, c := range m.NullTypes {
+		if c == nil || c.Type == "" {
+			return fmt.Errorf("null type %s: missing type", base)
+		}
+		if c.Nil == "" {
+			c.Nil = c.Type + "{}"
+		}
+	}
+	for _, g := range m.Guards {
+		if !strings.Contains(g, ".") {
+			return fmt.Errorf("invalid guard %q, expected table.column", g)
+		}
+	}
+	if c := m.Sharding; c != nil {
+		for _, k := range c.Keys {
+			if !strings.Contains(k, ".") {
+				return fmt.Errorf("invalid shard key %q, expected table.column", k)
+			}
+		}
+	}
+	if c := m.Proto; c != nil {
+		switch c.JSONName {
+		case "", "snake", "camel":
+		default:
+			return fmt.Errorf("invalid proto json_name %q", c.JSONName)
+		}
+	}
 	for _, v := range m.ModelToPB {
 		for _, table := range v {
 			args.ConfigTables[table.Name] = struct{}{}
@@ -263,6 +564,11 @@ func openDB(args *internal.ArgType) error {
 		return errors.New("unsupported database type")
 	}
 
+	// pgx is only available for postgres
+	if args.Pgx && u.Driver != "postgres" {
+		return errors.New("--pgx requires a postgres database")
+	}
+
 	// open database connection
 	args.DB, err = sql.Open(u.Driver, u.DSN)
 	if err != nil {
@@ -288,11 +594,23 @@ func getFile(args *internal.ArgType, t *internal.TBuf, isProto bool) (*os.File,
 		filename = fmt.Sprintf("%s_model.proto", internal.ProtoName(filename))
 		filename = path.Join(args.RpcProtoPathPrefix, filename)
 	} else {
-		filename = filename + args.Suffix
-		if args.SingleFile {
+		switch {
+		case t.TemplateType == internal.ProtoTestTemplate:
+			// go test only builds the files ending with _test.go
+			filename = filename + strings.TrimSuffix(args.Suffix, ".go") + "_test.go"
+		case args.SingleFile:
 			filename = args.Filename
+		default:
+			filename = filename + args.Suffix
+		}
+		filename = path.Join(args.Path, t.Package, filename)
+
+		// create the query sub-package
+		if t.Package != "" {
+			if err = os.MkdirAll(path.Join(args.Path, t.Package), 0777); err != nil {
+				return nil, err
+			}
 		}
-		filename = path.Join(args.Path, filename)
 	}
 
 	// lookup file
@@ -333,8 +651,13 @@ func getFile(args *internal.ArgType, t *internal.TBuf, isProto bool) (*os.File,
 			f.WriteString(`// +build ` + args.Tags + "\n\n")
 		}
 
+		pkg := args.Package
+		if t.Package != "" {
+			pkg = path.Base(t.Package)
+		}
+
 		imports := &internal.Imports{
-			Package: args.Package,
+			Package: pkg,
 			Imports: args.Imports[t.Name],
 			Schema:  args.Schema,
 		}
@@ -16,6 +16,7 @@ type Column struct {
 	NotNull       bool           // not_null
 	DefaultValue  sql.NullString // default_value
 	IsPrimaryKey  bool           // is_primary_key
+	Extra         string         // extra
 }
 
 // PgTableColumns runs a custom query, returning results as Column.
@@ -26,15 +27,18 @@ func PgTableColumns(db XODB, schema string, table string, sys bool) ([]*Column,
 	const sqlstr = `SELECT ` +
 		`a.attnum, ` + // ::integer AS field_ordinal
 		`a.attname, ` + // ::varchar AS column_name
+		`COALESCE(col_description(c.oid, a.attnum), ''), ` + // ::varchar AS column_comment
 		`format_type(a.atttypid, a.atttypmod), ` + // ::varchar AS data_type
 		`a.attnotnull, ` + // ::boolean AS not_null
 		`COALESCE(pg_get_expr(ad.adbin, ad.adrelid), ''), ` + // ::varchar AS default_value
-		`COALESCE(ct.contype = 'p', false) ` + // ::boolean AS is_primary_key
+		`COALESCE(ct.contype = 'p', false), ` + // ::boolean AS is_primary_key
+		`(CASE WHEN ic.is_identity = 'YES' THEN 'identity' WHEN ic.is_generated = 'ALWAYS' THEN 'STORED GENERATED' ELSE '' END) ` + // ::varchar AS extra
 		`FROM pg_attribute a ` +
 		`JOIN ONLY pg_class c ON c.oid = a.attrelid ` +
 		`JOIN ONLY pg_namespace n ON n.oid = c.relnamespace ` +
 		`LEFT JOIN pg_constraint ct ON ct.conrelid = c.oid AND a.attnum = ANY(ct.conkey) AND ct.contype = 'p' ` +
 		`LEFT JOIN pg_attrdef ad ON ad.adrelid = c.oid AND ad.adnum = a.attnum ` +
+		`LEFT JOIN information_schema.columns ic ON ic.table_schema = n.nspname AND ic.table_name = c.relname AND ic.column_name = a.attname ` +
 		`WHERE a.attisdropped = false AND n.nspname = $1 AND c.relname = $2 AND ($3 OR a.attnum > 0) ` +
 		`ORDER BY a.attnum`
 
@@ -52,7 +56,7 @@ func PgTableColumns(db XODB, schema string, table string, sys bool) ([]*Column,
 		c := Column{}
 
 		// scan
-		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey)
+		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.ColumnComment, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey, &c.Extra)
 		if err != nil {
 			return nil, err
 		}
@@ -75,7 +79,8 @@ func MyTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 		`IF(data_type = 'enum', column_name, column_type) AS data_type, ` +
 		`IF(is_nullable = 'YES', false, true) AS not_null, ` +
 		`column_default AS default_value, ` +
-		`IF(column_key = 'PRI', true, false) AS is_primary_key ` +
+		`IF(column_key = 'PRI', true, false) AS is_primary_key, ` +
+		`extra ` +
 		`FROM information_schema.columns ` +
 		`WHERE table_schema = ? AND table_name = ? ` +
 		`ORDER BY ordinal_position`
@@ -94,7 +99,7 @@ func MyTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 		c := Column{}
 
 		// scan
-		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.ColumnComment, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey)
+		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.ColumnComment, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey, &c.Extra)
 		if err != nil {
 			return nil, err
 		}
@@ -113,6 +118,7 @@ func MsTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 	const sqlstr = `SELECT ` +
 		`c.colid AS field_ordinal, ` +
 		`c.name AS column_name, ` +
+		`COALESCE(CAST(p.value AS nvarchar(4000)), '') AS column_comment, ` +
 		`TYPE_NAME(c.xtype)+IIF(c.prec > 0, '('+CAST(c.prec AS varchar)+IIF(c.scale > 0,','+CAST(c.scale AS varchar),'')+')', '') as data_type, ` +
 		`IIF(c.isnullable=1, 0, 1) AS not_null, ` +
 		`x.text AS default_value, ` +
@@ -121,11 +127,13 @@ func MsTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 		`FROM sysindexes i ` +
 		`INNER JOIN sysindexkeys z ON i.id = z.id AND i.indid = z.indid AND z.colid = c.colid ` +
 		`WHERE i.id = o.id AND i.name = k.name ` +
-		`), 0) > 0, 1, 0) AS is_primary_key ` +
+		`), 0) > 0, 1, 0) AS is_primary_key, ` +
+		`(CASE WHEN COLUMNPROPERTY(c.id, c.name, 'IsIdentity') = 1 THEN 'identity' WHEN c.iscomputed = 1 THEN 'computed' ELSE '' END) AS extra ` +
 		`FROM syscolumns c ` +
 		`JOIN sysobjects o ON o.id = c.id ` +
 		`LEFT JOIN sysobjects k ON k.xtype='PK' AND k.parent_obj = o.id ` +
 		`LEFT JOIN syscomments x ON x.id = c.cdefault ` +
+		`LEFT JOIN sys.extended_properties p ON p.major_id = c.id AND p.minor_id = c.colid AND p.name = 'MS_Description' ` +
 		`WHERE o.type IN('U', 'V') AND SCHEMA_NAME(o.uid) = $1 AND o.name = $2 ` +
 		`ORDER BY c.colid`
 
@@ -143,7 +151,7 @@ func MsTableColumns(db XODB, schema string, table string) ([]*Column, error) {
 		c := Column{}
 
 		// scan
-		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey)
+		err = q.Scan(&c.FieldOrdinal, &c.ColumnName, &c.ColumnComment, &c.DataType, &c.NotNull, &c.DefaultValue, &c.IsPrimaryKey, &c.Extra)
 		if err != nil {
 			return nil, err
 		}
@@ -29,19 +29,19 @@ func PgTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		`c.relname, ` + // ::varchar AS ref_table_name
 		`d.attname, ` + // ::varchar AS ref_column_name
 		`0, ` + // ::integer AS key_id
-		`0, ` + // ::integer AS seq_no
-		`'', ` + // ::varchar AS on_update
-		`'', ` + // ::varchar AS on_delete
+		`array_position(r.conkey, b.attnum), ` + // ::integer AS seq_no
+		`(CASE r.confupdtype WHEN 'r' THEN 'RESTRICT' WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' ELSE 'NO ACTION' END), ` + // ::varchar AS on_update
+		`(CASE r.confdeltype WHEN 'r' THEN 'RESTRICT' WHEN 'c' THEN 'CASCADE' WHEN 'n' THEN 'SET NULL' WHEN 'd' THEN 'SET DEFAULT' ELSE 'NO ACTION' END), ` + // ::varchar AS on_delete
 		`'' ` + // ::varchar AS match
 		`FROM pg_constraint r ` +
 		`JOIN ONLY pg_class a ON a.oid = r.conrelid ` +
 		`JOIN ONLY pg_attribute b ON b.attisdropped = false AND b.attnum = ANY(r.conkey) AND b.attrelid = r.conrelid ` +
 		`JOIN ONLY pg_class i on i.oid = r.conindid ` +
 		`JOIN ONLY pg_class c on c.oid = r.confrelid ` +
-		`JOIN ONLY pg_attribute d ON d.attisdropped = false AND d.attnum = ANY(r.confkey) AND d.attrelid = r.confrelid ` +
+		`JOIN ONLY pg_attribute d ON d.attisdropped = false AND d.attnum = r.confkey[array_position(r.conkey, b.attnum)] AND d.attrelid = r.confrelid ` +
 		`JOIN ONLY pg_namespace n ON n.oid = r.connamespace ` +
 		`WHERE r.contype = 'f' AND n.nspname = $1 AND a.relname = $2 ` +
-		`ORDER BY r.conname, b.attname`
+		`ORDER BY r.conname, seq_no`
 
 	// run query
 	XOLog(sqlstr, schema, table)
@@ -74,12 +74,16 @@ func MyTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`constraint_name AS foreign_key_name, ` +
-		`column_name AS column_name, ` +
-		`referenced_table_name AS ref_table_name, ` +
-		`referenced_column_name AS ref_column_name ` +
-		`FROM information_schema.key_column_usage ` +
-		`WHERE referenced_table_name IS NOT NULL AND table_schema = ? AND table_name = ?`
+		`k.constraint_name AS foreign_key_name, ` +
+		`k.column_name AS column_name, ` +
+		`k.referenced_table_name AS ref_table_name, ` +
+		`k.referenced_column_name AS ref_column_name, ` +
+		`rc.update_rule AS on_update, ` +
+		`rc.delete_rule AS on_delete ` +
+		`FROM information_schema.key_column_usage k ` +
+		`JOIN information_schema.referential_constraints rc ON rc.constraint_schema = k.table_schema AND rc.constraint_name = k.constraint_name ` +
+		`WHERE k.referenced_table_name IS NOT NULL AND k.table_schema = ? AND k.table_name = ? ` +
+		`ORDER BY k.constraint_name, k.ordinal_position`
 
 	// run query
 	XOLog(sqlstr, schema, table)
@@ -95,7 +99,7 @@ func MyTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefTableName, &fk.RefColumnName)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefTableName, &fk.RefColumnName, &fk.OnUpdate, &fk.OnDelete)
 		if err != nil {
 			return nil, err
 		}
@@ -147,8 +151,11 @@ func MsTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		`f.name AS foreign_key_name, ` +
 		`c.name AS column_name, ` +
 		`o.name AS ref_table_name, ` +
-		`x.name AS ref_column_name ` +
+		`x.name AS ref_column_name, ` +
+		`REPLACE(k.update_referential_action_desc, '_', ' ') AS on_update, ` +
+		`REPLACE(k.delete_referential_action_desc, '_', ' ') AS on_delete ` +
 		`FROM sysobjects f ` +
+		`INNER JOIN sys.foreign_keys k ON f.id = k.object_id ` +
 		`INNER JOIN sysobjects t ON f.parent_obj = t.id ` +
 		`INNER JOIN sysreferences r ON f.id = r.constid ` +
 		`INNER JOIN sysobjects o ON r.rkeyid = o.id ` +
@@ -170,7 +177,7 @@ func MsTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefTableName, &fk.RefColumnName)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefTableName, &fk.RefColumnName, &fk.OnUpdate, &fk.OnDelete)
 		if err != nil {
 			return nil, err
 		}
@@ -190,7 +197,9 @@ func OrTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		`LOWER(a.constraint_name) AS foreign_key_name, ` +
 		`LOWER(a.column_name) AS column_name, ` +
 		`LOWER(r.constraint_name) AS ref_index_name, ` +
-		`LOWER(r.table_name) AS ref_table_name ` +
+		`LOWER(r.table_name) AS ref_table_name, ` +
+		`'NO ACTION' AS on_update, ` +
+		`c.delete_rule AS on_delete ` +
 		`FROM all_cons_columns a ` +
 		`JOIN all_constraints c ON a.owner = c.owner AND a.constraint_name = c.constraint_name ` +
 		`JOIN all_constraints r ON c.r_owner = r.owner AND c.r_constraint_name = r.constraint_name ` +
@@ -210,7 +219,7 @@ func OrTableForeignKeys(db XODB, schema string, table string) ([]*ForeignKey, er
 		fk := ForeignKey{}
 
 		// scan
-		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefIndexName, &fk.RefTableName)
+		err = q.Scan(&fk.ForeignKeyName, &fk.ColumnName, &fk.RefIndexName, &fk.RefTableName, &fk.OnUpdate, &fk.OnDelete)
 		if err != nil {
 			return nil, err
 		}
@@ -83,3 +83,39 @@ func MyProcs(db XODB, schema string) ([]*Proc, error) {
 
 	return res, nil
 }
+
+// MyProcedures runs a custom query, returning results as Proc.
+func MyProcedures(db XODB, schema string) ([]*Proc, error) {
+	var err error
+
+	// sql query
+	const sqlstr = `SELECT ` +
+		`routine_name AS proc_name, ` +
+		`'void' AS return_type ` +
+		`FROM information_schema.routines ` +
+		`WHERE routine_schema = ? AND routine_type = 'PROCEDURE'`
+
+	// run query
+	XOLog(sqlstr, schema)
+	q, err := db.Query(sqlstr, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*Proc{}
+	for q.Next() {
+		p := Proc{}
+
+		// scan
+		err = q.Scan(&p.ProcName, &p.ReturnType)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &p)
+	}
+
+	return res, nil
+}
@@ -5,9 +5,10 @@ package models
 
 // Table represents table info.
 type Table struct {
-	Type      string // type
-	TableName string // table_name
-	ManualPk  bool   // manual_pk
+	Type         string // type
+	TableName    string // table_name
+	TableComment string // table_comment
+	ManualPk     bool   // manual_pk
 }
 
 // PgTables runs a custom query, returning results as Table.
@@ -18,6 +19,7 @@ func PgTables(db XODB, schema string, relkind string) ([]*Table, error) {
 	const sqlstr = `SELECT ` +
 		`c.relkind, ` + // ::varchar AS type
 		`c.relname, ` + // ::varchar AS table_name
+		`COALESCE(obj_description(c.oid, 'pg_class'), ''), ` + // ::varchar AS table_comment
 		`false ` + // ::boolean AS manual_pk
 		`FROM pg_class c ` +
 		`JOIN ONLY pg_namespace n ON n.oid = c.relnamespace ` +
@@ -37,7 +39,7 @@ func PgTables(db XODB, schema string, relkind string) ([]*Table, error) {
 		t := Table{}
 
 		// scan
-		err = q.Scan(&t.Type, &t.TableName, &t.ManualPk)
+		err = q.Scan(&t.Type, &t.TableName, &t.TableComment, &t.ManualPk)
 		if err != nil {
 			return nil, err
 		}
@@ -54,7 +56,8 @@ func MyTables(db XODB, schema string, relkind string) ([]*Table, error) {
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`table_name ` +
+		`table_name, ` +
+		`IF(table_type = 'VIEW', '', COALESCE(table_comment, '')) AS table_comment ` +
 		`FROM information_schema.tables ` +
 		`WHERE table_schema = ? AND table_type = ?`
 
@@ -72,7 +75,7 @@ func MyTables(db XODB, schema string, relkind string) ([]*Table, error) {
 		t := Table{}
 
 		// scan
-		err = q.Scan(&t.TableName)
+		err = q.Scan(&t.TableName, &t.TableComment)
 		if err != nil {
 			return nil, err
 		}
@@ -124,10 +127,12 @@ func MsTables(db XODB, schema string, relkind string) ([]*Table, error) {
 
 	// sql query
 	const sqlstr = `SELECT ` +
-		`xtype AS type, ` +
-		`name AS table_name ` +
-		`FROM sysobjects ` +
-		`WHERE SCHEMA_NAME(uid) = $1 AND xtype = $2`
+		`o.xtype AS type, ` +
+		`o.name AS table_name, ` +
+		`COALESCE(CAST(p.value AS nvarchar(4000)), '') AS table_comment ` +
+		`FROM sysobjects o ` +
+		`LEFT JOIN sys.extended_properties p ON p.major_id = o.id AND p.minor_id = 0 AND p.name = 'MS_Description' ` +
+		`WHERE SCHEMA_NAME(o.uid) = $1 AND o.xtype = $2`
 
 	// run query
 	XOLog(sqlstr, schema, relkind)
@@ -143,7 +148,7 @@ func MsTables(db XODB, schema string, relkind string) ([]*Table, error) {
 		t := Table{}
 
 		// scan
-		err = q.Scan(&t.Type, &t.TableName)
+		err = q.Scan(&t.Type, &t.TableName, &t.TableComment)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1 @@
+postgres.mock.go.tpl
\ No newline at end of file
@@ -0,0 +1 @@
+postgres.optional.go.tpl
\ No newline at end of file
@@ -0,0 +1 @@
+postgres.prototest.go.tpl
\ No newline at end of file
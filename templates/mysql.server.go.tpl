@@ -0,0 +1 @@
+postgres.server.go.tpl
\ No newline at end of file
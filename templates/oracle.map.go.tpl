@@ -0,0 +1 @@
+postgres.map.go.tpl
\ No newline at end of file
@@ -1,5 +1,6 @@
 {{- $short := (shortname .Name "err" "res" "sqlstr" "db" "XOLog") -}}
 {{- $table := (schema .Schema .Table.TableName) -}}
+{{- $sqltable := (sqltable .Schema .Table.TableName) -}}
 {{- if .Comment -}}
 // {{ .Comment }}
 {{- else -}}
@@ -7,7 +8,12 @@
 {{- end }}
 type {{ .Name }} struct {
 {{- range .Fields }}
-	{{ .Name }} {{ retype .Type }} `json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }}
+	{{ .Name }} {{ retype .Type }}{{ with structtags . }} `{{ . }}`{{ end }} // {{ .Col.ColumnName }}
+{{- end }}
+{{- if .Preloads }}
+
+	// related rows, set by the Load{{ .Name }}* funcs
+	Rel {{ .Name }}Rel `{{ skiptags }}`
 {{- end }}
 {{- if .PrimaryKey }}
 
@@ -15,6 +21,89 @@ type {{ .Name }} struct {
 	_exists, _deleted bool
 {{ end }}
 }
+{{- if .Preloads }}
+
+// {{ .Name }}Rel holds the rows related to a {{ .Name }} by its foreign keys.
+type {{ .Name }}Rel struct {
+{{- range .Preloads }}
+	{{ .Name }} *{{ .RefType.Name }} // {{ .Field.Col.ColumnName }}
+{{- end }}
+}
+{{- end }}
+
+// The column names of '{{ $table }}'.
+const (
+{{- range .Fields }}
+	{{ $.Name }}Col{{ .Name }} = {{ printf "%q" .Col.ColumnName }}
+{{- end }}
+)
+{{- if generics }}
+
+// xoScan scans row into the {{ .Name }}.
+func ({{ $short }} *{{ .Name }}) xoScan(row xoRow) error {
+	err := row.Scan({{ fieldnames .Fields (print "&" $short) }})
+	if err != nil {
+		return err
+	}
+{{- if .PrimaryKey }}
+
+	// set existence
+	{{ $short }}._exists = true
+{{- end }}
+
+	return nil
+}
+{{- end }}
+
+// TableName returns the name of the table the {{ .Name }} is a row of.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "TableName" }}XO{{ end }}TableName() string {
+	return {{ printf "%q" $table }}
+}
+
+// Columns returns the names of the {{ .Name }}'s columns, in field order.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "Columns" }}XO{{ end }}Columns() []string {
+	return []string{ {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{ $.Name }}Col{{ $f.Name }}{{ end -}} }
+}
+
+// PrimaryKeyColumns returns the names of the {{ .Name }}'s primary key columns.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "PrimaryKeyColumns" }}XO{{ end }}PrimaryKeyColumns() []string {
+	return []string{ {{- range $i, $f := (wherefields .) }}{{ if $i }}, {{ end }}{{ $.Name }}Col{{ $f.Name }}{{ end -}} }
+}
+
+// Values returns the values of the {{ .Name }}'s fields, in Columns order.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "Values" }}XO{{ end }}Values() []interface{} {
+	return []interface{}{ {{- fieldnames .Fields $short -}} }
+}
+
+// Pointers returns pointers to the {{ .Name }}'s fields, in Columns order (ie,
+// to scan a row into).
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "Pointers" }}XO{{ end }}Pointers() []interface{} {
+	return []interface{}{ {{- fieldnames .Fields (print "&" $short) -}} }
+}
+
+{{ $sshort := (shortname .Name "cols" "names" "dest" "i" "c") -}}
+// xoSelect returns the selected columns cols, or all columns when cols is
+// empty, and the pointers to the {{ .Name }}'s fields they are scanned into.
+func ({{ $sshort }} *{{ .Name }}) xoSelect(cols []string) (string, []interface{}, error) {
+	if len(cols) == 0 {
+		return `{{ colnames .Fields }}`, []interface{}{ {{- fieldnames .Fields (print "&" $sshort) -}} }, nil
+	}
+
+	names := make([]string, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i, c := range cols {
+		switch c {
+	{{- range .Fields }}
+		case {{ $.Name }}Col{{ .Name }}:
+			names[i], dest[i] = {{ printf "%q" (colname .Col) }}, {{ scanfield $sshort . }}
+	{{- end }}
+		default:
+			return "", nil, fmt.Errorf("select failed: unknown column %q", c)
+		}
+	}
+
+	return strings.Join(names, ", "), dest, nil
+}
 
 {{ if .PrimaryKey }}
 // Exists determines if the {{ .Name }} exists in the database.
@@ -28,7 +117,9 @@ func ({{ $short }} *{{ .Name }}) Deleted() bool {
 }
 
 // Insert inserts the {{ .Name }} to the database.
-func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Insert({{ ctxparam }}db XODB, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name ".Insert") .Table.TableName "INSERT" }}
 	var err error
 
 	// if already exist, bail
@@ -36,16 +127,34 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 		return errors.New("insert failed: already exists")
 	}
 
-	// sql query
-	const sqlstr = `INSERT INTO {{ $table }} (` +
-		`{{ colnames .Fields .PrimaryKey.Name }}` +
+{{ if .Table.ManualPk  }}
+	// sql insert query, primary key must be provided
+	{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqltable }} (` +
+		`{{ colnames (insertfields .) }}` +
+		`) VALUES (` +
+		`{{ colvals (insertfields .) }}` +
+		`)`
+
+	// run query
+	XOLog(sqlstr, {{ fieldnames (insertfields .) $short }})
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (insertfields .) $short }})
+	if err != nil {
+		return err
+	}
+
+	// set existence
+	{{ $short }}._exists = true
+{{ else }}
+	// sql insert query, primary key provided by sequence
+	{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqltable }} (` +
+		`{{ colnames (insertfields .) }}` +
 		`) VALUES (` +
-		`{{ colvals .Fields .PrimaryKey.Name }}` +
+		`{{ colvals (insertfields .) }}` +
 		`) RETURNING {{ colname .PrimaryKey.Col }} /*lastInsertId*/ INTO :pk`
 
 	// run query
-	XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, nil)
-	res, err := db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, nil)
+	XOLog(sqlstr, {{ fieldnames (insertfields .) $short }}, nil)
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (insertfields .) $short }}, nil)
 	if err != nil {
 		return err
 	}
@@ -59,13 +168,109 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 	// set primary key and existence
 	{{ $short }}.{{ .PrimaryKey.Name }} = {{ .PrimaryKey.Type }}(id)
 	{{ $short }}._exists = true
+{{ end }}
+{{- if generatedfields . }}
+	// reload columns generated by the database
+	{{ sqldecl "rsqlstr" }} `SELECT {{ colnames (generatedfields .) }} ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .PrimaryKeyFields false " AND " }}`
 
+	XOLog(rsqlstr, {{ fieldnames .PrimaryKeyFields $short }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}rsqlstr, {{ fieldnames .PrimaryKeyFields $short }}).Scan({{ fieldnames (generatedfields .) (print "&" $short) }})
+	if err != nil {
+		return err
+	}
+{{ end }}
 	return nil
 }
 
-{{ if ne (fieldnames .Fields $short .PrimaryKey.Name) "" }}
+{{ $rshort := (shortname .Name "err" "sqlstr" "db" "XOLog" "rows" "n" "args" "vals" "start" "p" "i" "j") -}}
+{{- if .Table.ManualPk }}
+// Insert{{ pluralize .Name }} inserts rows to the database using INSERT ALL
+// statements of at most XOBatchSize rows each.
+func Insert{{ pluralize .Name }}({{ ctxparam }}db XODB, rows []*{{ .Name }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print "Insert" (pluralize .Name)) .Table.TableName "INSERT" }}
+	// if already exist, bail
+	for _, {{ $rshort }} := range rows {
+		if {{ $rshort }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+
+	for len(rows) > 0 {
+		n := len(rows)
+		if n > XOBatchSize {
+			n = XOBatchSize
+		}
+
+		// build into clauses and args
+		vals := make([]string, n)
+		args := make([]interface{}, 0, n*{{ len .Fields }})
+		for i, {{ $rshort }} := range rows[:n] {
+			start := len(args)
+			args = append(args, {{ fieldnames (insertfields .) $rshort }})
+			p := make([]string, 0, len(args)-start)
+			for j := start; j < len(args); j++ {
+				p = append(p, {{ nthparamgo "j" }})
+			}
+			vals[i] = `INTO {{ $sqltable }} ({{ colnames (insertfields .) }}) VALUES (` + strings.Join(p, ", ") + `)`
+		}
+
+		// sql insert query, primary key must be provided
+		sqlstr := `INSERT ALL ` + strings.Join(vals, " ") + ` SELECT 1 FROM dual`
+
+		// run query
+		XOLog(sqlstr, args...)
+		_, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// set existence
+		for _, {{ $rshort }} := range rows[:n] {
+			{{ $rshort }}._exists = true
+		}
+
+		rows = rows[n:]
+	}
+
+	return nil
+}
+{{- else }}
+// Insert{{ pluralize .Name }} inserts rows to the database one at a time, as
+// the primary keys provided by sequence cannot be returned by a multi-row
+// insert.
+func Insert{{ pluralize .Name }}({{ ctxparam }}db XODB, rows []*{{ .Name }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print "Insert" (pluralize .Name)) .Table.TableName "INSERT" }}
+	// if already exist, bail
+	for _, {{ $rshort }} := range rows {
+		if {{ $rshort }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+
+	for _, {{ $rshort }} := range rows {
+		if err := {{ $rshort }}.Insert({{ ctxarg }}db); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+{{- end }}
+
+{{ if ne (fieldnamesmulti .Fields $short (updateignore .)) "" }}
 	// Update updates the {{ .Name }} in the database.
-	func ({{ $short }} *{{ .Name }}) Update(db XODB) error {
+	{{- if .VersionField }}
+	//
+	// Returns ErrStaleRow when the row was changed or deleted since it was
+	// loaded.
+	{{- end }}
+	func ({{ $short }} *{{ .Name }}) Update({{ ctxparam }}db XODB, opts ...XOOption) error {
+		{{- xooptions }}
+		{{- xoinstrument (print .Name ".Update") .Table.TableName "UPDATE" }}
 		var err error
 
 		// if doesn't exist, bail
@@ -79,30 +284,269 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 		}
 
 		// sql query
-		const sqlstr = `UPDATE {{ $table }} SET ` +
-			`{{ colnamesquery .Fields ", " .PrimaryKey.Name }}` +
-			` WHERE {{ colname .PrimaryKey.Col }} = :{{ colcount .Fields .PrimaryKey.Name }}`
+		{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET ` +
+			`{{ colnamesquerymulti .Fields false ", " 0 (updateignore .) }}{{ versionset . }}{{ updatedset . }}` +
+			` WHERE {{ colnamesquerymulti (wherefields .) false " AND " (getstartcount .Fields (updateignore .)) nil }}{{ versioncond . (add (getstartcount .Fields (updateignore .)) (len (wherefields .))) }}`
 
 		// run query
-		XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
+		XOLog(sqlstr, {{ fieldnamesmulti .Fields $short (updateignore .) }}, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }})
+		{{- if .VersionField }}
+		res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short (updateignore .) }}, {{ fieldnames (wherefields .) $short }}, {{ $short }}.{{ .VersionField.Name }})
+		if err != nil {
+			return err
+		}
+
+		// check for a stale row
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrStaleRow
+		}
+
+		// increment version
+		{{ $short }}.{{ .VersionField.Name }}++
+
+		return nil
+		{{- else }}
+		_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short (updateignore .) }}, {{ fieldnames (wherefields .) $short }})
 		return err
+		{{- end }}
+	}
+
+	{{ $cshort := (shortname .Name "err" "res" "n" "sqlstr" "db" "XOLog" "cols" "set" "args" "where" "i" "c") -}}
+	// UpdateColumns updates only the columns in cols (the {{ .Name }}Col
+	// constants) of the {{ .Name }} in the database, setting them to the values
+	// of its fields. The columns changed by the database are not reloaded.
+	{{- if .VersionField }}
+	//
+	// Returns ErrStaleRow when the row was changed or deleted since it was
+	// loaded.
+	{{- end }}
+	func ({{ $cshort }} *{{ .Name }}) UpdateColumns({{ ctxparam }}db XODB, cols []string, opts ...XOOption) error {
+		{{- xooptions }}
+		{{- xoinstrument (print .Name ".UpdateColumns") .Table.TableName "UPDATE" }}
+		var err error
+
+		// if doesn't exist, bail
+		if !{{ $cshort }}._exists {
+			return errors.New("update failed: does not exist")
+		}
+
+		// if deleted, bail
+		if {{ $cshort }}._deleted {
+			return errors.New("update failed: marked for deletion")
+		}
+
+		// if no columns, bail
+		if len(cols) == 0 {
+			return nil
+		}
+
+		// build set clause
+		set := make([]string, len(cols))
+		args := make([]interface{}, 0, len(cols)+{{ add (len (wherefields .)) 1 }})
+		for i, c := range cols {
+			switch c {
+		{{- range .Fields }}{{ if not (hasfield (updateignore $) .Name) }}
+			case {{ $.Name }}Col{{ .Name }}:
+				set[i] = `{{ colname .Col }} = ` + {{ nthparamgo "len(args)" }}
+				args = append(args, {{ sqlarg (print $cshort "." .Name) . }})
+		{{- end }}{{ end }}
+			default:
+				return fmt.Errorf("update failed: unknown column %q", c)
+			}
+		}
+
+		// build where clause
+		where := make([]string, 0, {{ add (len (wherefields .)) 1 }})
+	{{- range (wherefields .) }}
+		where = append(where, `{{ colname .Col }} = ` + {{ nthparamgo "len(args)" }})
+		args = append(args, {{ $cshort }}.{{ .Name }})
+	{{- end }}
+	{{- if .VersionField }}
+		where = append(where, `{{ colname .VersionField.Col }} = ` + {{ nthparamgo "len(args)" }})
+		args = append(args, {{ $cshort }}.{{ .VersionField.Name }})
+	{{- end }}
+
+		// sql query
+		sqlstr := `UPDATE {{ $sqltable }} SET ` +
+			strings.Join(set, ", ") + `{{ versionset . }}{{ updatedset . }}` +
+			` WHERE ` + strings.Join(where, " AND ")
+
+		// run query
+		XOLog(sqlstr, args...)
+		{{- if .VersionField }}
+		res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// check for a stale row
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrStaleRow
+		}
+
+		// increment version
+		{{ $cshort }}.{{ .VersionField.Name }}++
+
+		return nil
+		{{- else }}
+		_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		return err
+		{{- end }}
 	}
 
 	// Save saves the {{ .Name }} to the database.
-	func ({{ $short }} *{{ .Name }}) Save(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Save({{ ctxparam }}db XODB, opts ...XOOption) error {
 		if {{ $short }}.Exists() {
-			return {{ $short }}.Update(db)
+			return {{ $short }}.Update({{ ctxarg }}db, opts...)
 		}
 
-		return {{ $short }}.Insert(db)
+		return {{ $short }}.Insert({{ ctxarg }}db, opts...)
 	}
+{{- if mergeclause . }}
+
+	// Upsert performs an upsert for {{ .Name }}.
+	func ({{ $short }} *{{ .Name }}) Upsert({{ ctxparam }}db XODB, opts ...XOOption) error {
+		{{- xooptions }}
+		{{- xoinstrument (print .Name ".Upsert") .Table.TableName "INSERT" }}
+		var err error
+
+		// if already exist, bail
+		if {{ $short }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+
+		// sql query
+		{{ sqldecl "sqlstr" }} `MERGE INTO {{ $sqltable }} t ` +
+			`{{ mergeclause . }}`
+
+		// run query
+		XOLog(sqlstr, {{ fieldnames (upsertfields .) $short }})
+		_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (upsertfields .) $short }})
+		if err != nil {
+			return err
+		}
+
+		// set existence
+		{{ $short }}._exists = true
+
+		return nil
+	}
+{{- end }}
 {{ else }}
 	// Update statements omitted due to lack of fields other than primary key
 {{ end }}
 
-// Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
+{{- if .PrimaryKey }}
+{{- $keys := (pkfields .) }}
+{{- range .CounterFields }}
+
+// Increment{{ $.Name }}{{ .Name }} atomically adds delta to the {{ .Col.ColumnName }} of the
+// row from '{{ $table }}' with the primary key (ie, with a negative delta to
+// decrement it).
+func Increment{{ $.Name }}{{ .Name }}({{ ctxparam }}db XODB{{ goparamlist $keys true true }}, delta {{ retype .Type }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print "Increment" $.Name .Name) $.Table.TableName "UPDATE" }}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET {{ colname .Col }} = {{ colname .Col }} + {{ nthparam 0 }}{{ versionset $ }}{{ updatedset $ }} ` +
+		`WHERE {{ colnamesquerymulti $keys false " AND " 1 nil }}`
+
+	// run query
+	XOLog(sqlstr, delta{{ sqlparamlist $keys true }})
+	_, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, delta{{ sqlparamlist $keys true }})
+	return err
+}
+{{- end }}
+{{- end }}
+{{- $delete := "Delete" }}
+{{- if softdeletedefault . }}{{ $delete = "HardDelete" }}{{ end }}
+{{- if softdeletemode . }}
+{{ $sshort := (shortname .Name "err" "res" "n" "sqlstr" "db" "XOLog" "v" "by") }}
+// SoftDelete marks the {{ .Name }} as deleted in the database by setting {{ .SoftDeleteField.Col.ColumnName }}.
+{{- if .VersionField }}
+//
+// Returns ErrStaleRow when the row was changed or deleted since it was
+// loaded.
+{{- end }}
+func ({{ $sshort }} *{{ .Name }}) SoftDelete({{ ctxparam }}db XODB{{ if eq (softdeletemode .) "by" }}, by {{ retype .SoftDeleteField.Type }}{{ end }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name ".SoftDelete") .Table.TableName "UPDATE" }}
+	var err error
+
+	// if doesn't exist, bail
+	if !{{ $sshort }}._exists {
+		return nil
+	}
+
+	// if deleted, bail
+	if {{ $sshort }}._deleted {
+		return nil
+	}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET {{ colname .SoftDeleteField.Col }} = {{ nthparam 0 }}{{ versionset . }} ` +
+		`WHERE {{ colnamesquerymulti (wherefields .) false " AND " 1 nil }}{{ versioncond . (add 1 (len (wherefields .))) }}`
+
+	// run query
+	v := {{ softdeletevalue . "by" }}
+	XOLog(sqlstr, v, {{ fieldnames (wherefields .) $sshort }}{{ if .VersionField }}, {{ $sshort }}.{{ .VersionField.Name }}{{ end }})
+	{{- if .VersionField }}
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, v, {{ fieldnames (wherefields .) $sshort }}, {{ $sshort }}.{{ .VersionField.Name }})
+	if err != nil {
+		return err
+	}
+
+	// check for a stale row
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrStaleRow
+	}
+{{- else }}
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, v, {{ fieldnames (wherefields .) $sshort }})
+	if err != nil {
+		return err
+	}
+{{- end }}
+
+	// set soft delete field and deleted
+	{{ $sshort }}.{{ .SoftDeleteField.Name }} = v
+	{{ $sshort }}._deleted = true
+{{- if .VersionField }}
+	{{ $sshort }}.{{ .VersionField.Name }}++
+{{- end }}
+
+	return nil
+}
+{{- end }}
+{{- if softdeletedefault . }}
+
+// Delete soft deletes the {{ .Name }} from the database. Use HardDelete to
+// delete the row.
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB, opts ...XOOption) error {
+	return {{ $short }}.SoftDelete({{ ctxarg }}db, opts...)
+}
+{{- end }}
+
+// {{ $delete }} deletes the {{ .Name }} from the database.
+{{- if .VersionField }}
+//
+// Returns ErrStaleRow when the row was changed or deleted since it was
+// loaded.
+{{- end }}
+func ({{ $short }} *{{ .Name }}) {{ $delete }}({{ ctxparam }}db XODB, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name "." $delete) .Table.TableName "DELETE" }}
 	var err error
 
 	// if doesn't exist, bail
@@ -116,19 +560,34 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 	}
 
 	// sql query
-	const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colname .PrimaryKey.Col }} = :1`
+	{{ sqldecl "sqlstr" }} `DELETE FROM {{ $sqltable }} WHERE {{ colnamesquery (wherefields .) false " AND " }}{{ versioncond . (len (wherefields .)) }}`
 
 	// run query
-	XOLog(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-	_, err = db.Exec(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
+	XOLog(sqlstr, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }})
+	{{- if .VersionField }}
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (wherefields .) $short }}, {{ $short }}.{{ .VersionField.Name }})
 	if err != nil {
 		return err
 	}
 
+	// check for a stale row
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrStaleRow
+	}
+{{- else }}
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (wherefields .) $short }})
+	if err != nil {
+		return err
+	}
+{{- end }}
+
 	// set deleted
 	{{ $short }}._deleted = true
 
 	return nil
 }
 {{- end }}
-
@@ -1,18 +1,30 @@
 {{- $type := .Name -}}
-{{- $short := (shortname $type "enumVal" "text" "buf" "ok" "src") -}}
+{{- $short := (shortname $type "enumVal" "text" "buf" "ok" "src" "data" "s" "err") -}}
 {{- $reverseNames := .ReverseConstNames -}}
 // {{ $type }} is the '{{ .Enum.EnumName }}' enum type from schema '{{ .Schema  }}'.
-type {{ $type }} uint16
+type {{ $type }} {{ .Backing }}
 
 const (
 {{- range .Values }}
 	// {{ if $reverseNames }}{{ .Name }}{{ $type }}{{ else }}{{ $type }}{{ .Name }}{{ end }} is the '{{ .Val.EnumValue }}' {{ $type }}.
-	{{ if $reverseNames }}{{ .Name }}{{ $type }}{{ else }}{{ $type }}{{ .Name }}{{ end }} = {{ $type }}({{ .Val.ConstValue }})
+	{{ if $reverseNames }}{{ .Name }}{{ $type }}{{ else }}{{ $type }}{{ .Name }}{{ end }} = {{ $type }}({{ .Backing }})
 {{ end -}}
 )
 
+// {{ $type }}Values returns all the values of the {{ $type }}.
+func {{ $type }}Values() []{{ $type }} {
+	return []{{ $type }}{
+{{- range .Values }}
+		{{ if $reverseNames }}{{ .Name }}{{ $type }}{{ else }}{{ $type }}{{ .Name }}{{ end }},
+{{- end }}
+	}
+}
+
 // String returns the string value of the {{ $type }}.
 func ({{ $short }} {{ $type }}) String() string {
+{{- if eq .Backing "string" }}
+	return string({{ $short }})
+{{- else }}
 	var enumVal string
 
 	switch {{ $short }} {
@@ -23,6 +35,17 @@ func ({{ $short }} {{ $type }}) String() string {
 	}
 
 	return enumVal
+{{- end }}
+}
+
+// IsValid determines if the {{ $type }} is one of its values.
+func ({{ $short }} {{ $type }}) IsValid() bool {
+	switch {{ $short }} {
+	case {{ range $i, $v := .Values }}{{ if $i }}, {{ end }}{{ if $reverseNames }}{{ $v.Name }}{{ $type }}{{ else }}{{ $type }}{{ $v.Name }}{{ end }}{{ end }}:
+		return true
+	}
+
+	return false
 }
 
 // MarshalText marshals {{ $type }} into text.
@@ -45,6 +68,21 @@ func ({{ $short }} *{{ $type }}) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// MarshalJSON marshals {{ $type }} into a JSON string.
+func ({{ $short }} {{ $type }}) MarshalJSON() ([]byte, error) {
+	return json.Marshal({{ $short }}.String())
+}
+
+// UnmarshalJSON unmarshals {{ $type }} from a JSON string.
+func ({{ $short }} *{{ $type }}) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return {{ $short }}.UnmarshalText([]byte(s))
+}
+
 // Value satisfies the sql/driver.Valuer interface for {{ $type }}.
 func ({{ $short }} {{ $type }}) Value() (driver.Value, error) {
 	return {{ $short }}.String(), nil
@@ -52,11 +90,13 @@ func ({{ $short }} {{ $type }}) Value() (driver.Value, error) {
 
 // Scan satisfies the database/sql.Scanner interface for {{ $type }}.
 func ({{ $short }} *{{ $type }}) Scan(src interface{}) error {
-	buf, ok := src.([]byte)
-	if !ok {
-	   return errors.New("invalid {{ $type }}")
+	switch buf := src.(type) {
+	case []byte:
+		return {{ $short }}.UnmarshalText(buf)
+	case string:
+		return {{ $short }}.UnmarshalText([]byte(buf))
 	}
 
-	return {{ $short }}.UnmarshalText(buf)
+	return errors.New("invalid {{ $type }}")
 }
 
@@ -1,8 +1,119 @@
 {{- $short := (shortname .Type.Name) -}}
+{{- if gt (len .Fields) 1 -}}
+// {{ .Name }} returns the {{ .RefType.Name }} associated with the {{ .Type.Name }}'s {{ range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{ $f.Name }}{{ end }} ({{ range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{ $f.Col.ColumnName }}{{ end }}).
+{{- else -}}
 // {{ .Name }} returns the {{ .RefType.Name }} associated with the {{ .Type.Name }}'s {{ .Field.Name }} ({{ .Field.Col.ColumnName }}).
+{{- end }}
 //
-// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}'.
-func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}(db XODB) (*{{ .RefType.Name }}, error) {
-	return {{ .RefType.Name }}By{{ .RefField.Name }}(db, {{ convext $short .Field .RefField }})
+// Generated from foreign key '{{ .ForeignKey.ForeignKeyName }}' (ON DELETE {{ .OnDelete }}, ON UPDATE {{ .OnUpdate }}).
+func ({{ $short }} *{{ .Type.Name }}) {{ .Name }}({{ ctxparam }}db XODB, opts ...XOOption) (*{{ .RefType.Name }}, error) {
+{{- if gt (len .Fields) 1 }}
+	{{- $refFields := .RefFields }}
+	return {{ .RefType.Name }}By{{ range .RefFields }}{{ .Name }}{{ end }}({{ ctxarg }}db, {{ range $i, $f := .Fields }}{{ convext $short $f (index $refFields $i) }}, {{ end }}opts...)
+{{- else }}
+	return {{ .RefType.Name }}By{{ .RefField.Name }}({{ ctxarg }}db, {{ convext $short .Field .RefField }}, opts...)
+{{- end }}
 }
+{{- if eq (len .Fields) 1 }}
+{{- $lshort := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" "rows" "refs" "in" "args" "id" "opts") }}
+{{- $rshort := (shortname .RefType.Name "err" "sqlstr" "db" "q" "res" "XOLog" "rows" "refs" "in" "args" "id" "opts" $lshort) }}
+{{- $reftable := (schema .RefType.Schema .RefType.Table.TableName) }}
+{{- $sqlreftable := (sqltable .RefType.Schema .RefType.Table.TableName) }}
 
+// Load{{ .Type.Name }}{{ .Name }} loads the {{ pluralize .RefType.Name }} associated with the rows' {{ .Field.Name }} ({{ .Field.Col.ColumnName }})
+// in a single query, and sets them as each row's Rel.{{ .Name }}.
+func Load{{ .Type.Name }}{{ .Name }}({{ ctxparam }}db XODB, rows []*{{ .Type.Name }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print "Load" .Type.Name .Name) .RefType.Table.TableName "SELECT" }}
+	// collect distinct keys
+	refs := map[{{ retype .RefField.Type }}]*{{ .RefType.Name }}{}
+	in := make([]string, 0, len(rows))
+	args := make([]interface{}, 0, len(rows))
+	for _, {{ $lshort }} := range rows {
+	{{- if isnulltype .Field }}
+		if !{{ $lshort }}.{{ .Field.Name }}.Valid {
+			continue
+		}
+	{{- end }}
+		id := {{ convext $lshort .Field .RefField }}
+		if _, ok := refs[id]; ok {
+			continue
+		}
+		refs[id] = nil
+		in = append(in, {{ nthparamgo "len(args)" }})
+		args = append(args, {{ sqlarg "id" .RefField }})
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	// sql query
+	sqlstr := `SELECT ` +
+		`{{ colnames .RefType.Fields }} ` +
+		`FROM {{ $sqlreftable }} ` +
+		`WHERE {{ colname .RefField.Col }} IN (` + strings.Join(in, ", ") + `){{ if .RefType.SoftDeleteField }} AND {{ softdeletecond .RefType }}{{ end }}`
+
+	// run query
+	XOLog(sqlstr, args...)
+{{- if sqlx }}
+	res := []*{{ .RefType.Name }}{}
+	err := sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &res, sqlstr, args...)
+	if err != nil {
+		return err
+	}
+	for _, {{ $rshort }} := range res {
+	{{- if .RefType.PrimaryKey }}
+		{{ $rshort }}._exists = true
+	{{- end }}
+		refs[{{ $rshort }}.{{ .RefField.Name }}] = {{ $rshort }}
+	}
+{{- else if generics }}
+	res, err := xoQueryAll[{{ .RefType.Name }}]({{ ctxarg }}db, sqlstr, args...)
+	if err != nil {
+		return err
+	}
+	for _, {{ $rshort }} := range res {
+		refs[{{ $rshort }}.{{ .RefField.Name }}] = {{ $rshort }}
+	}
+{{- else }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+
+	// load results
+	for q.Next() {
+		{{ $rshort }} := {{ .RefType.Name }}{
+		{{- if .RefType.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .RefType.Fields (print "&" $rshort) }})
+		if err != nil {
+			return err
+		}
+
+		refs[{{ $rshort }}.{{ .RefField.Name }}] = &{{ $rshort }}
+	}
+	if err = q.Err(); err != nil {
+		return err
+	}
+{{- end }}
+
+	// attach to rows
+	for _, {{ $lshort }} := range rows {
+	{{- if isnulltype .Field }}
+		if !{{ $lshort }}.{{ .Field.Name }}.Valid {
+			{{ $lshort }}.Rel.{{ .Name }} = nil
+			continue
+		}
+	{{- end }}
+		{{ $lshort }}.Rel.{{ .Name }} = refs[{{ convext $lshort .Field .RefField }}]
+	}
+
+	return nil
+}
+{{- end }}
@@ -1,34 +1,125 @@
-{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" .Fields) -}}
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" "args" "o" "opts" "fn" "cols" "dest" .Fields) -}}
 {{- $table := (schema .Schema .Type.Table.TableName) -}}
+{{- $sqltable := (sqltable .Schema .Type.Table.TableName) -}}
 // {{ .FuncName }} retrieves a row from '{{ $table }}' as a {{ .Type.Name }}.
 //
 // Generated from index '{{ .Index.IndexName }}'.
-func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Index.IsUnique }}[]{{ end }}*{{ .Type.Name }}, error) {
-	var err error
-
-	// sql query
-	const sqlstr = `SELECT ` +
-		`{{ colnames .Type.Fields }} ` +
-		`FROM {{ $table }} ` +
-		`WHERE {{ colnamesquery .Fields " AND " }}`
-
-	// run query
-	XOLog(sqlstr{{ goparamlist .Fields true false }})
 {{- if .Index.IsUnique }}
+func {{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOOption) (*{{ .Type.Name }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument .FuncName .Type.Table.TableName "SELECT" }}
 	{{ $short }} := {{ .Type.Name }}{
 	{{- if .Type.PrimaryKey }}
 		_exists: true,
 	{{ end -}}
 	}
 
-	err = db.QueryRow(sqlstr{{ goparamlist .Fields true false }}).Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+	// selected columns
+	cols, {{ if sqlx }}_{{ else }}dest{{ end }}, err := {{ $short }}.xoSelect(xoListOptions(opts).Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// sql query
+	sqlstr := `SELECT ` + cols + ` ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields .Type " AND " }}`
+
+	// run query
+	XOLog(sqlstr{{ sqlparamlist .Fields true }})
+	{{ if sqlx -}}
+	err = sqlx.{{ dbfn "Get" }}({{ ctxarg }}db, &{{ $short }}, sqlstr{{ sqlparamlist .Fields true }})
+	{{- else -}}
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr{{ sqlparamlist .Fields true }}).Scan(dest...)
+	{{- end }}
 	if err != nil {
 		return nil, err
 	}
 
 	return &{{ $short }}, nil
+}
+{{- if lockclause "update" }}
+
+// {{ .FuncName }}ForUpdate retrieves a row from '{{ $table }}' as a {{ .Type.Name }}
+// like {{ .FuncName }}, locking it until the end of the transaction of db (ie,
+// in XOWithTx).
+func {{ .FuncName }}ForUpdate({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOOption) (*{{ .Type.Name }}, error) {
+	return {{ .FuncName }}({{ ctxarg }}db{{ goparamlist .Fields true false }}, append(opts, XOForUpdate())...)
+}
+{{- end }}
+
+// Exists{{ .FuncName }} determines if a row retrieved by {{ .FuncName }}
+// exists in '{{ $table }}'.
+func Exists{{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOOption) (bool, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print "Exists" .FuncName) .Type.Table.TableName "SELECT" }}
+	var err error
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `{{ existsquery (print "SELECT 1 FROM " $sqltable " WHERE " (colnamesquery .Fields .Type " AND ")) }}`
+
+	// run query
+	var ok bool
+	XOLog(sqlstr{{ sqlparamlist .Fields true }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr{{ sqlparamlist .Fields true }}).Scan(&ok)
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
 {{- else }}
-	q, err := db.Query(sqlstr{{ goparamlist .Fields true false }})
+//
+// Results are ordered with the XOOrder option, and limited with the XOLimit
+// and XOOffset options.
+func {{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOListOption) ([]*{{ .Type.Name }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument .FuncName .Type.Table.TableName "SELECT" }}
+	o := xoListOptions(opts)
+
+	// selected columns
+	cols, _, err := (&{{ .Type.Name }}{}).xoSelect(o.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// sql query
+	sqlstr := `SELECT ` + cols + ` ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields .Type " AND " }}`
+	args := []interface{}{ {{- sqlparamlist .Fields false -}} }
+
+	// apply options
+	order, err := xoOrderClause(o.OrderBy, xo{{ .Type.Name }}OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	if order == "" && o.Limit > 0 {
+		order = ` ORDER BY {{ if .Type.PrimaryKeyFields }}{{ colnames .Type.PrimaryKeyFields }}{{ else }}{{ colnames .Fields }}{{ end }}`
+	}
+	sqlstr += order
+	if o.Limit > 0 {
+		sqlstr += ` {{ limitclause (len .Fields) true }}`
+		args = append(args, o.Limit, o.Offset)
+	}
+
+	// run query
+	XOLog(sqlstr, args...)
+{{- if sqlx }}
+	res := []*{{ .Type.Name }}{}
+	err = sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &res, sqlstr, args...)
+	if err != nil {
+		return nil, err
+	}
+{{- if .Type.PrimaryKey }}
+
+	// set existence
+	for _, {{ $short }} := range res {
+		{{ $short }}._exists = true
+	}
+{{- end }}
+{{- else }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,15 +135,266 @@ func {{ .FuncName }}(db XODB{{ goparamlist .Fields true true }}) ({{ if not .Ind
 		}
 
 		// scan
-		err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+		_, dest, _ := {{ $short }}.xoSelect(o.Columns)
+		err = q.Scan(dest...)
 		if err != nil {
 			return nil, err
 		}
 
 		res = append(res, &{{ $short }})
 	}
+{{- end }}
 
 	return res, nil
+}
+
+// Count{{ .FuncName }} counts the rows from '{{ $table }}' retrieved by
+// {{ .FuncName }}.
+func Count{{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOOption) (int64, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print "Count" .FuncName) .Type.Table.TableName "SELECT" }}
+	var err error
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `SELECT COUNT(*) ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields .Type " AND " }}`
+
+	// run query
+	var n int64
+	XOLog(sqlstr{{ sqlparamlist .Fields true }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr{{ sqlparamlist .Fields true }}).Scan(&n)
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// {{ .FuncName }}Each calls fn with each row from '{{ $table }}' retrieved by
+// {{ .FuncName }}, without loading all rows into memory. Iteration stops at the
+// first error returned by fn, which is returned.
+func {{ .FuncName }}Each({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, fn func(*{{ .Type.Name }}) error, opts ...XOListOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .FuncName "Each") .Type.Table.TableName "SELECT" }}
+	// sql query
+	sqlstr := `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields .Type " AND " }}`
+	args := []interface{}{ {{- sqlparamlist .Fields false -}} }
+
+	// apply options
+	o := xoListOptions(opts)
+	order, err := xoOrderClause(o.OrderBy, xo{{ .Type.Name }}OrderBy)
+	if err != nil {
+		return err
+	}
+	if order == "" && o.Limit > 0 {
+		order = ` ORDER BY {{ if .Type.PrimaryKeyFields }}{{ colnames .Type.PrimaryKeyFields }}{{ else }}{{ colnames .Fields }}{{ end }}`
+	}
+	sqlstr += order
+	if o.Limit > 0 {
+		sqlstr += ` {{ limitclause (len .Fields) true }}`
+		args = append(args, o.Limit, o.Offset)
+	}
+
+	// run query
+	XOLog(sqlstr, args...)
+{{- if generics }}
+	return xoQueryEach({{ ctxarg }}db, fn, sqlstr, args...)
+{{- else }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+
+	// process results
+	for q.Next() {
+		{{ $short }} := {{ .Type.Name }}{
+		{{- if .Type.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+		if err != nil {
+			return err
+		}
+
+		err = fn(&{{ $short }})
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.Err()
 {{- end }}
 }
+{{- end }}
+
+{{- if and (not .Index.IsUnique) (eq (len .Type.PrimaryKeyFields) 1) }}
+{{- $pk := .Type.PrimaryKey }}
+{{- $pshort := (shortname .Type.Name "err" "sqlstr" "csqlstr" "db" "q" "res" "XOLog" "cursor" "limit" "next" "id" .Fields) }}
+
+// {{ .FuncName }}Page retrieves a page of at most limit rows from '{{ $table }}' as
+// a {{ .Type.Name }}, ordered by the index columns and primary key, starting after
+// the row with primary key cursor, or from the first row when cursor is nil.
+//
+// The returned cursor is nil when there are no more rows.
+func {{ .FuncName }}Page({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, cursor *{{ retype $pk.Type }}, limit int, opts ...XOOption) ([]*{{ .Type.Name }}, *{{ retype $pk.Type }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print .FuncName "Page") .Type.Table.TableName "SELECT" }}
+	var err error
 
+	if limit < 1 {
+		return nil, nil, errors.New("limit must be greater than 0")
+	}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields .Type " AND " }} ` +
+		`ORDER BY {{ colnames .Fields }}{{ if not (hasfield .Fields $pk.Name) }}, {{ colname $pk.Col }}{{ end }} ` +
+		`{{ limitclause (len .Fields) false }}`
+
+	// sql query starting after cursor
+	{{ sqldecl "csqlstr" }} `SELECT ` +
+		`{{ colnames .Type.Fields }} ` +
+		`FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields .Type " AND " }} AND {{ colname $pk.Col }} > {{ nthparam (len .Fields) }} ` +
+		`ORDER BY {{ colnames .Fields }}{{ if not (hasfield .Fields $pk.Name) }}, {{ colname $pk.Col }}{{ end }} ` +
+		`{{ limitclause (add (len .Fields) 1) false }}`
+
+	// run query, fetching an extra row to determine if there is a next page
+{{- if sqlx }}
+	res := []*{{ .Type.Name }}{}
+	if cursor == nil {
+		XOLog(sqlstr{{ sqlparamlist .Fields true }}, limit+1)
+		err = sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &res, sqlstr{{ sqlparamlist .Fields true }}, limit+1)
+	} else {
+		XOLog(csqlstr{{ sqlparamlist .Fields true }}, {{ sqlarg "*cursor" $pk }}, limit+1)
+		err = sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &res, csqlstr{{ sqlparamlist .Fields true }}, {{ sqlarg "*cursor" $pk }}, limit+1)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// set existence
+	for _, {{ $pshort }} := range res {
+		{{ $pshort }}._exists = true
+	}
+{{- else if generics }}
+	var res []*{{ .Type.Name }}
+	if cursor == nil {
+		XOLog(sqlstr{{ sqlparamlist .Fields true }}, limit+1)
+		res, err = xoQueryAll[{{ .Type.Name }}]({{ ctxarg }}db, sqlstr{{ sqlparamlist .Fields true }}, limit+1)
+	} else {
+		XOLog(csqlstr{{ sqlparamlist .Fields true }}, {{ sqlarg "*cursor" $pk }}, limit+1)
+		res, err = xoQueryAll[{{ .Type.Name }}]({{ ctxarg }}db, csqlstr{{ sqlparamlist .Fields true }}, {{ sqlarg "*cursor" $pk }}, limit+1)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+{{- else }}
+	var q {{ if pgx }}pgx.Rows{{ else }}*sql.Rows{{ end }}
+	if cursor == nil {
+		XOLog(sqlstr{{ sqlparamlist .Fields true }}, limit+1)
+		q, err = db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr{{ sqlparamlist .Fields true }}, limit+1)
+	} else {
+		XOLog(csqlstr{{ sqlparamlist .Fields true }}, {{ sqlarg "*cursor" $pk }}, limit+1)
+		q, err = db.{{ dbfn "Query" }}({{ ctxarg }}csqlstr{{ sqlparamlist .Fields true }}, {{ sqlarg "*cursor" $pk }}, limit+1)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .Type.Name }}{}
+	for q.Next() {
+		{{ $pshort }} := {{ .Type.Name }}{
+			_exists: true,
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .Type.Fields (print "&" $pshort) }})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res = append(res, &{{ $pshort }})
+	}
+	if err = q.Err(); err != nil {
+		return nil, nil, err
+	}
+{{- end }}
+
+	// drop the extra row and build the next cursor
+	var next *{{ retype $pk.Type }}
+	if len(res) > limit {
+		res = res[:limit]
+		id := res[limit-1].{{ $pk.Name }}
+		next = &id
+	}
+
+	return res, next, nil
+}
+{{- end }}
+{{- if deletebyindex }}
+{{- $delete := "Delete" }}
+{{- if softdeletedefault .Type }}{{ $delete = "HardDelete" }}
+
+// Delete{{ .FuncName }} soft deletes the rows from '{{ $table }}' retrieved by
+// {{ .FuncName }}, returning the number of deleted rows. Use
+// HardDelete{{ .FuncName }} to delete the rows.
+//
+// The before and after delete hooks are not called.
+func Delete{{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOOption) (int64, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print "Delete" .FuncName) .Type.Table.TableName "UPDATE" }}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET {{ colname .Type.SoftDeleteField.Col }} = {{ nthparam 0 }}{{ versionset .Type }} ` +
+		`WHERE {{ colnamesquerymulti .Fields .Type " AND " 1 nil }}`
+
+	// run query
+	v := {{ softdeletevalue .Type "by" }}
+	XOLog(sqlstr, v{{ sqlparamlist .Fields true }})
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, v{{ sqlparamlist .Fields true }})
+	if err != nil {
+		return 0, err
+	}
+
+	return xoRowsAffected(res)
+}
+{{- end }}
+
+// {{ $delete }}{{ .FuncName }} deletes the rows from '{{ $table }}' matching
+// the {{ .FuncName }} params, returning the number of deleted rows.
+{{- if softdeletedefault .Type }} The soft
+// deleted rows are deleted too.
+{{- end }}
+//
+// The before and after delete hooks are not called.
+func {{ $delete }}{{ .FuncName }}({{ ctxparam }}db XODB{{ goparamlist .Fields true true }}, opts ...XOOption) (int64, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print $delete .FuncName) .Type.Table.TableName "DELETE" }}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `DELETE FROM {{ $sqltable }} ` +
+		`WHERE {{ colnamesquery .Fields false " AND " }}`
+
+	// run query
+	XOLog(sqlstr{{ sqlparamlist .Fields true }})
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr{{ sqlparamlist .Fields true }})
+	if err != nil {
+		return 0, err
+	}
+
+	return xoRowsAffected(res)
+}
+{{- end }}
@@ -0,0 +1,107 @@
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" "opts") -}}
+{{- $rshort := (shortname .RefType.Name "err" "sqlstr" "db" "q" "res" "XOLog" "opts" $short) -}}
+{{- $reftable := (schema .RefType.Schema .RefType.Table.TableName) -}}
+{{- $sqlreftable := (sqltable .RefType.Schema .RefType.Table.TableName) -}}
+{{- $jointable := (schema .JoinType.Schema .JoinType.Table.TableName) -}}
+{{- $sqljointable := (sqltable .JoinType.Schema .JoinType.Table.TableName) -}}
+{{- $fields := .Fields -}}
+{{- $refFields := .RefFields -}}
+// {{ .PluralName }} returns the {{ pluralize .RefType.Name }} associated with the {{ .Type.Name }} through '{{ $jointable }}'.
+func ({{ $short }} *{{ .Type.Name }}) {{ .PluralName }}({{ ctxparam }}db XODB, opts ...XOOption) ([]*{{ .RefType.Name }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print .Type.Name "." .PluralName) .RefType.Table.TableName "SELECT" }}
+	var err error
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `SELECT ` +
+		`{{ colprefixnames .RefType.Fields "r" }} ` +
+		`FROM {{ $sqlreftable }} r ` +
+		`JOIN {{ $sqljointable }} j ON {{ colprefixjoin .JoinRefFields "j" .RefFields "r" }} ` +
+		`WHERE {{ colprefixquery .JoinFields "j" " AND " }}{{ if .RefType.SoftDeleteField }} AND r.{{ softdeletecond .RefType }}{{ end }}`
+
+	// run query
+	XOLog(sqlstr, {{ range $i, $f := .JoinFields }}{{ if $i }}, {{ end }}{{ convext $short (index $fields $i) $f }}{{ end }})
+{{- if sqlx }}
+	res := []*{{ .RefType.Name }}{}
+	err = sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &res, sqlstr, {{ range $i, $f := .JoinFields }}{{ if $i }}, {{ end }}{{ convext $short (index $fields $i) $f }}{{ end }})
+	if err != nil {
+		return nil, err
+	}
+{{- if .RefType.PrimaryKey }}
+
+	// set existence
+	for _, {{ $rshort }} := range res {
+		{{ $rshort }}._exists = true
+	}
+{{- end }}
+{{- else if generics }}
+	res, err := xoQueryAll[{{ .RefType.Name }}]({{ ctxarg }}db, sqlstr, {{ range $i, $f := .JoinFields }}{{ if $i }}, {{ end }}{{ convext $short (index $fields $i) $f }}{{ end }})
+	if err != nil {
+		return nil, err
+	}
+{{- else }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, {{ range $i, $f := .JoinFields }}{{ if $i }}, {{ end }}{{ convext $short (index $fields $i) $f }}{{ end }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .RefType.Name }}{}
+	for q.Next() {
+		{{ $rshort }} := {{ .RefType.Name }}{
+		{{- if .RefType.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ fieldnames .RefType.Fields (print "&" $rshort) }})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &{{ $rshort }})
+	}
+	if err = q.Err(); err != nil {
+		return nil, err
+	}
+{{- end }}
+
+	return res, nil
+}
+
+// Add{{ .Name }} associates the {{ .RefType.Name }} with the {{ .Type.Name }} by inserting a row into '{{ $jointable }}'.
+func ({{ $short }} *{{ .Type.Name }}) Add{{ .Name }}({{ ctxparam }}db XODB, {{ $rshort }} *{{ .RefType.Name }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Type.Name ".Add" .Name) .JoinType.Table.TableName "INSERT" }}
+	var err error
+
+	// sql insert query
+	{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqljointable }} (` +
+		`{{ colnames .JoinFields }}, {{ colnames .JoinRefFields }}` +
+		`) VALUES (` +
+		`{{ colvals .JoinType.Fields }}` +
+		`)`
+
+	// run query
+	XOLog(sqlstr, {{ range $i, $f := .JoinFields }}{{ convext $short (index $fields $i) $f }}, {{ end }}{{ range $i, $f := .JoinRefFields }}{{ if $i }}, {{ end }}{{ convext $rshort (index $refFields $i) $f }}{{ end }})
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ range $i, $f := .JoinFields }}{{ convext $short (index $fields $i) $f }}, {{ end }}{{ range $i, $f := .JoinRefFields }}{{ if $i }}, {{ end }}{{ convext $rshort (index $refFields $i) $f }}{{ end }})
+	return err
+}
+
+// Remove{{ .Name }} dissociates the {{ .RefType.Name }} from the {{ .Type.Name }} by deleting its row from '{{ $jointable }}'.
+func ({{ $short }} *{{ .Type.Name }}) Remove{{ .Name }}({{ ctxparam }}db XODB, {{ $rshort }} *{{ .RefType.Name }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Type.Name ".Remove" .Name) .JoinType.Table.TableName "DELETE" }}
+	var err error
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `DELETE FROM {{ $sqljointable }} ` +
+		`WHERE {{ colnamesquery .JoinFields false " AND " }} AND {{ colnamesquerymulti .JoinRefFields false " AND " (len .JoinFields) nil }}`
+
+	// run query
+	XOLog(sqlstr, {{ range $i, $f := .JoinFields }}{{ convext $short (index $fields $i) $f }}, {{ end }}{{ range $i, $f := .JoinRefFields }}{{ if $i }}, {{ end }}{{ convext $rshort (index $refFields $i) $f }}{{ end }})
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ range $i, $f := .JoinFields }}{{ convext $short (index $fields $i) $f }}, {{ end }}{{ range $i, $f := .JoinRefFields }}{{ if $i }}, {{ end }}{{ convext $rshort (index $refFields $i) $f }}{{ end }})
+	return err
+}
@@ -0,0 +1,91 @@
+{{- $ids := (mapparam . false) -}}
+{{- $id := (mapparam . true) -}}
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" $ids "in" "args" "n" "i" $id "opts") -}}
+{{- $table := (schema .Schema .Type.Table.TableName) -}}
+{{- $sqltable := (sqltable .Schema .Type.Table.TableName) -}}
+// {{ .MapFuncName }} retrieves the rows from '{{ $table }}' whose {{ .MapField.Name }} is in
+// {{ $ids }}, keyed by {{ .MapField.Name }}. {{ $ids }} without a row are missing from the map.
+//
+// Generated from index '{{ .Index.IndexName }}'.
+func {{ .MapFuncName }}({{ ctxparam }}db XODB, {{ $ids }} []{{ retype .MapField.Type }}, opts ...XOOption) (map[{{ retype .MapField.Type }}]*{{ .Type.Name }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument .MapFuncName .Type.Table.TableName "SELECT" }}
+	res := make(map[{{ retype .MapField.Type }}]*{{ .Type.Name }}, len({{ $ids }}))
+
+	// query at most XOBatchSize {{ $ids }} at a time
+	for len({{ $ids }}) > 0 {
+		n := len({{ $ids }})
+		if n > XOBatchSize {
+			n = XOBatchSize
+		}
+
+		// build placeholders and args
+		in := make([]string, n)
+		args := make([]interface{}, n)
+		for i, {{ $id }} := range {{ $ids }}[:n] {
+			in[i] = {{ nthparamgo "i" }}
+			args[i] = {{ sqlarg $id .MapField }}
+		}
+		{{ $ids }} = {{ $ids }}[n:]
+
+		// sql query
+		sqlstr := `SELECT ` +
+			`{{ colnames .Type.Fields }} ` +
+			`FROM {{ $sqltable }} ` +
+			`WHERE {{ colname .MapField.Col }} IN (` + strings.Join(in, ", ") + `){{ if .Type.SoftDeleteField }} AND {{ softdeletecond .Type }}{{ end }}`
+
+		// run query
+		XOLog(sqlstr, args...)
+{{- if sqlx }}
+		rows := []*{{ .Type.Name }}{}
+		err := sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &rows, sqlstr, args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, {{ $short }} := range rows {
+		{{- if .Type.PrimaryKey }}
+			{{ $short }}._exists = true
+		{{- end }}
+			res[{{ $short }}.{{ .MapField.Name }}] = {{ $short }}
+		}
+{{- else if generics }}
+		rows, err := xoQueryAll[{{ .Type.Name }}]({{ ctxarg }}db, sqlstr, args...)
+		if err != nil {
+			return nil, err
+		}
+		for _, {{ $short }} := range rows {
+			res[{{ $short }}.{{ .MapField.Name }}] = {{ $short }}
+		}
+{{- else }}
+		q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		// load results
+		for q.Next() {
+			{{ $short }} := {{ .Type.Name }}{
+			{{- if .Type.PrimaryKey }}
+				_exists: true,
+			{{ end -}}
+			}
+
+			// scan
+			err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+			if err != nil {
+				q.Close()
+				return nil, err
+			}
+
+			res[{{ $short }}.{{ .MapField.Name }}] = &{{ $short }}
+		}
+		err = q.Err()
+		q.Close()
+		if err != nil {
+			return nil, err
+		}
+{{- end }}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,134 @@
+{{- $short := (shortname .Name "err" "db" "opts" "tx") -}}
+{{- $mshort := (shortname (print .Name "StoreMock") "err" "db" "opts" "tx" "method" "args" "calls" "c" $short .Fields) -}}
+{{- $update := ne (fieldnamesmulti .Fields $short (updateignore .)) "" -}}
+// {{ .Name }}StoreMock is a mock {{ .Name }}Store recording its calls.
+//
+// Each method calls the matching Func field when set, and otherwise returns
+// zero values.
+type {{ .Name }}StoreMock struct {
+	InsertFunc func({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+{{- if $update }}
+	UpdateFunc func({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+	SaveFunc   func({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+{{- end }}
+	DeleteFunc func({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+{{- range .Indexes }}{{ if .FuncName }}
+{{- if .Index.IsUnique }}
+	{{ repomethod . }}Func func({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOOption) (*{{ .Type.Name }}, error)
+{{- else }}
+	{{ repomethod . }}Func func({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOListOption) ([]*{{ .Type.Name }}, error)
+{{- end }}
+{{- end }}{{ end }}
+
+	mu    sync.Mutex
+	calls []{{ .Name }}StoreMockCall
+}
+
+// {{ .Name }}StoreMockCall is a call recorded by {{ .Name }}StoreMock.
+type {{ .Name }}StoreMockCall struct {
+	// Method is the name of the called method.
+	Method string
+
+	// Args are the arguments of the call, excluding the context.
+	Args []interface{}
+}
+
+// record records a call to method.
+func ({{ $mshort }} *{{ .Name }}StoreMock) record(method string, args ...interface{}) {
+	{{ $mshort }}.mu.Lock()
+	defer {{ $mshort }}.mu.Unlock()
+
+	{{ $mshort }}.calls = append({{ $mshort }}.calls, {{ .Name }}StoreMockCall{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls to method, or all recorded calls when
+// method is empty.
+func ({{ $mshort }} *{{ .Name }}StoreMock) Calls(method string) []{{ .Name }}StoreMockCall {
+	{{ $mshort }}.mu.Lock()
+	defer {{ $mshort }}.mu.Unlock()
+
+	calls := []{{ .Name }}StoreMockCall{}
+	for _, c := range {{ $mshort }}.calls {
+		if method == "" || c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// WithTx records the call and returns the {{ .Name }}StoreMock, so the calls
+// made with tx are recorded together with the other calls.
+func ({{ $mshort }} *{{ .Name }}StoreMock) WithTx(tx XODB) {{ .Name }}Store {
+	{{ $mshort }}.record("WithTx", tx)
+	return {{ $mshort }}
+}
+
+// Insert records the call and calls InsertFunc.
+func ({{ $mshort }} *{{ .Name }}StoreMock) Insert({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	{{ $mshort }}.record("Insert", {{ $short }}, opts)
+	if {{ $mshort }}.InsertFunc != nil {
+		return {{ $mshort }}.InsertFunc({{ ctxarg }}{{ $short }}, opts...)
+	}
+
+	return nil
+}
+{{- if $update }}
+
+// Update records the call and calls UpdateFunc.
+func ({{ $mshort }} *{{ .Name }}StoreMock) Update({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	{{ $mshort }}.record("Update", {{ $short }}, opts)
+	if {{ $mshort }}.UpdateFunc != nil {
+		return {{ $mshort }}.UpdateFunc({{ ctxarg }}{{ $short }}, opts...)
+	}
+
+	return nil
+}
+
+// Save records the call and calls SaveFunc.
+func ({{ $mshort }} *{{ .Name }}StoreMock) Save({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	{{ $mshort }}.record("Save", {{ $short }}, opts)
+	if {{ $mshort }}.SaveFunc != nil {
+		return {{ $mshort }}.SaveFunc({{ ctxarg }}{{ $short }}, opts...)
+	}
+
+	return nil
+}
+{{- end }}
+
+// Delete records the call and calls DeleteFunc.
+func ({{ $mshort }} *{{ .Name }}StoreMock) Delete({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	{{ $mshort }}.record("Delete", {{ $short }}, opts)
+	if {{ $mshort }}.DeleteFunc != nil {
+		return {{ $mshort }}.DeleteFunc({{ ctxarg }}{{ $short }}, opts...)
+	}
+
+	return nil
+}
+{{- range .Indexes }}{{ if .FuncName }}
+{{- $method := repomethod . }}
+
+// {{ $method }} records the call and calls {{ $method }}Func.
+{{- if .Index.IsUnique }}
+func ({{ $mshort }} *{{ .Type.Name }}StoreMock) {{ $method }}({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOOption) (*{{ .Type.Name }}, error) {
+	{{ $mshort }}.record("{{ $method }}"{{ goparamlist .Fields true false }}, opts)
+	if {{ $mshort }}.{{ $method }}Func != nil {
+		return {{ $mshort }}.{{ $method }}Func({{ ctxarg }}{{ goparamlist .Fields false false }}, opts...)
+	}
+
+	return nil, nil
+}
+{{- else }}
+func ({{ $mshort }} *{{ .Type.Name }}StoreMock) {{ $method }}({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOListOption) ([]*{{ .Type.Name }}, error) {
+	{{ $mshort }}.record("{{ $method }}"{{ goparamlist .Fields true false }}, opts)
+	if {{ $mshort }}.{{ $method }}Func != nil {
+		return {{ $mshort }}.{{ $method }}Func({{ ctxarg }}{{ goparamlist .Fields false false }}, opts...)
+	}
+
+	return nil, nil
+}
+{{- end }}
+{{- end }}{{ end }}
+
+// verify {{ .Name }}StoreMock implements {{ .Name }}Store
+var _ {{ .Name }}Store = (*{{ .Name }}StoreMock)(nil)
@@ -0,0 +1,66 @@
+{{- if .ModelToPB }}
+{{- $pkg := GoPackageName .ModelToPBConfig.ImportService -}}
+{{- $short := shortname .Type.Name -}}
+// {{ .Type.Name }}ModelToPB converts the {{ .Type.Name }} to its proto message.
+func {{ .Type.Name }}ModelToPB({{ $short }} *{{ .Type.Name }}) (*{{ $pkg }}.{{ .Type.Name }}, error) {
+	{{ modelToPB . }}
+}
+
+// {{ .Type.Name }}PBToModel converts the proto message to a {{ .Type.Name }}.
+func {{ .Type.Name }}PBToModel(proto{{ .Type.Name }} *{{ $pkg }}.{{ .Type.Name }}) (*{{ .Type.Name }}, error) {
+	{{ PBToModel . }}
+}
+
+// {{ .Type.Name }}ModelsToPBs converts the {{ .Type.Name }}s to their proto messages.
+func {{ .Type.Name }}ModelsToPBs(rows []*{{ .Type.Name }}) ([]*{{ $pkg }}.{{ .Type.Name }}, error) {
+	res := make([]*{{ $pkg }}.{{ .Type.Name }}, len(rows))
+	for i, row := range rows {
+		pb, err := {{ .Type.Name }}ModelToPB(row)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = pb
+	}
+
+	return res, nil
+}
+
+// {{ .Type.Name }}PBsToModels converts the proto messages to {{ .Type.Name }}s.
+func {{ .Type.Name }}PBsToModels(pbs []*{{ $pkg }}.{{ .Type.Name }}) ([]*{{ .Type.Name }}, error) {
+	res := make([]*{{ .Type.Name }}, len(pbs))
+	for i, pb := range pbs {
+		row, err := {{ .Type.Name }}PBToModel(pb)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = row
+	}
+
+	return res, nil
+}
+{{- if maskfields . }}
+
+// {{ .Type.Name }}ApplyFieldMask sets the fields of {{ $short }} in the paths of mask to
+// the fields of the proto message, returning the columns to update with
+// UpdateColumns. All the fields that can be updated are set when mask has no
+// paths.
+func {{ .Type.Name }}ApplyFieldMask({{ $short }} *{{ .Type.Name }}, proto{{ .Type.Name }} *{{ $pkg }}.{{ .Type.Name }}, mask *fieldmaskpb.FieldMask) ([]string, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = []string{ {{- range $i, $f := maskfields . }}{{ if $i }}, {{ end }}"{{ protoname $f }}"{{ end -}} }
+	}
+
+	cols := make([]string, 0, len(paths))
+	for _, path := range paths {
+		switch path {
+		{{ applyfieldmask . -}}
+		default:
+			return nil, fmt.Errorf("field %q cannot be updated", path)
+		}
+		cols = append(cols, path)
+	}
+
+	return cols, nil
+}
+{{- end }}
+{{- end }}
@@ -1,8 +1,85 @@
 {{- $notVoid := (ne .Proc.ReturnType "void") -}}
 {{- $proc := (schema .Schema .Proc.ProcName) -}}
-{{- if ne .Proc.ReturnType "trigger" -}}
+{{- if .Call -}}
+{{- range .ResultSets }}
+// {{ .Type.Name }} is a row of the result set {{ .Name }} of the stored procedure '{{ $proc }}'.
+type {{ .Type.Name }} struct {
+{{- range .Type.Fields }}
+	{{ .Name }} {{ retype .Type }} // {{ .Col.ColumnName }}
+{{- end }}
+}
+
+{{ end -}}
+{{- if .ResultSets }}
+// {{ .Name }}Results holds the result sets returned by the stored procedure '{{ $proc }}'.
+type {{ .Name }}Results struct {
+{{- range .ResultSets }}
+	{{ .Name }} []*{{ .Type.Name }}
+{{- end }}
+}
+
+// {{ .Name }} calls the stored procedure '{{ $proc }}({{ .ProcParams }})' on db, returning its result sets.
+func {{ .Name }}({{ ctxparam }}db XODB{{ goparamlist .Params true true }}, opts ...XOOption) (*{{ .Name }}Results, error) {
+	{{- xooptions }}
+	{{- xoinstrument .Name "" "CALL" }}
+	// sql query
+	const sqlstr = `CALL {{ $proc }}({{ colvals .Params }})`
+
+	// run query
+	XOLog(sqlstr{{ goparamlist .Params true false }})
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr{{ goparamlist .Params true false }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	res := &{{ .Name }}Results{}
+{{- range $i, $set := .ResultSets }}
+{{- if $i }}
+
+	if !q.NextResultSet() {
+		return nil, fmt.Errorf("{{ $proc }}: missing result set {{ $set.Name }}")
+	}
+{{- end }}
+
+	// load result set {{ $set.Name }}
+	for q.Next() {
+		r := {{ $set.Type.Name }}{}
+
+		// scan
+		err = q.Scan({{ fieldnames $set.Type.Fields "&r" }})
+		if err != nil {
+			return nil, err
+		}
+
+		res.{{ $set.Name }} = append(res.{{ $set.Name }}, &r)
+	}
+	if err = q.Err(); err != nil {
+		return nil, err
+	}
+{{- end }}
+
+	return res, nil
+}
+{{- else }}
+// {{ .Name }} calls the stored procedure '{{ $proc }}({{ .ProcParams }})' on db.
+func {{ .Name }}({{ ctxparam }}db XODB{{ goparamlist .Params true true }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument .Name "" "CALL" }}
+	// sql query
+	const sqlstr = `CALL {{ $proc }}({{ colvals .Params }})`
+
+	// run query
+	XOLog(sqlstr{{ goparamlist .Params true false }})
+	_, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr{{ goparamlist .Params true false }})
+	return err
+}
+{{- end }}
+{{- else if ne .Proc.ReturnType "trigger" -}}
 // {{ .Name }} calls the stored procedure '{{ $proc }}({{ .ProcParams }}) {{ .Proc.ReturnType }}' on db.
-func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{{ retype .Return.Type }}, {{ end }}error) {
+func {{ .Name }}({{ ctxparam }}db XODB{{ goparamlist .Params true true }}, opts ...XOOption) ({{ if $notVoid }}{{ retype .Return.Type }}, {{ end }}error) {
+	{{- xooptions }}
+	{{- xoinstrument .Name "" "SELECT" }}
 	var err error
 
 	// sql query
@@ -12,7 +89,7 @@ func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{
 {{- if $notVoid }}
 	var ret {{ retype .Return.Type }}
 	XOLog(sqlstr{{ goparamlist .Params true false }})
-	err = db.QueryRow(sqlstr{{ goparamlist .Params true false }}).Scan(&ret)
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr{{ goparamlist .Params true false }}).Scan(&ret)
 	if err != nil {
 		return {{ reniltype .Return.NilType }}, err
 	}
@@ -20,9 +97,8 @@ func {{ .Name }}(db XODB{{ goparamlist .Params true true }}) ({{ if $notVoid }}{
 	return ret, nil
 {{- else }}
 	XOLog(sqlstr)
-	_, err = db.Exec(sqlstr)
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr)
 	return err
 {{- end }}
 }
 {{- end }}
-
@@ -0,0 +1,30 @@
+{{- $t := .Type -}}
+// Test{{ $t.Name }}PBRoundTrip tests converting {{ $t.Name }}s to their proto
+// messages and back, with all their fields set, and with their nullable
+// fields null.
+func Test{{ $t.Name }}PBRoundTrip(t *testing.T) {
+	tests := map[string]*{{ $t.Name }}{
+		"values": {
+			{{ pbtestfields . false }}
+		},
+		"nulls": {
+			{{ pbtestfields . true }}
+		},
+	}
+
+	for name, want := range tests {
+		pb, err := {{ $t.Name }}ModelToPB(want)
+		if err != nil {
+			t.Fatalf("%s: ModelToPB: %v", name, err)
+		}
+
+		got, err := {{ $t.Name }}PBToModel(pb)
+		if err != nil {
+			t.Fatalf("%s: PBToModel: %v", name, err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %+v, want %+v", name, got, want)
+		}
+	}
+}
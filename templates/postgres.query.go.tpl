@@ -1,29 +1,234 @@
-{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" .QueryParams) -}}
+{{- $short := (shortname .Type.Name "err" "sqlstr" "db" "q" "res" "XOLog" "fn" "args" .QueryParams) -}}
 {{- $queryComments := .QueryComments -}}
+{{- $args := (queryargs .) -}}
+{{- $res := (print "*" .Type.Name) -}}
+{{- $nil := "nil" -}}
+{{- if .Scalar }}{{ $res = (retype (index .Type.Fields 0).Type) }}{{ $nil = (reniltype (index .Type.Fields 0).NilType) }}{{ end -}}
+{{- $ret := "nil" }}{{ if and .OnlyOne .Scalar }}{{ $ret = $nil }}{{ end -}}
+{{- if .Exec -}}
 {{- if .Comment -}}
-// {{ .Comment }}
+// {{ gocomment .Comment }}
 {{- else -}}
-// {{ .Name }} runs a custom query, returning results as {{ .Type.Name }}.
+// {{ .Name }} runs a custom statement, returning the number of affected rows.
 {{- end }}
-func {{ .Name }} (db XODB{{ range .QueryParams }}, {{ .Name }} {{ .Type }}{{ end }}) ({{ if not .OnlyOne }}[]{{ end }}*{{ .Type.Name }}, error) {
+{{- with .SQL }}
+//
+// The query is:
+//
+//	{{ gocode . }}
+{{- end }}
+func {{ .Name }}({{ ctxparam }}db XODB{{ range .QueryParams }}{{ if not .Default }}, {{ .Name }} {{ .Type }}{{ end }}{{ end }}, opts ...XOOption) (int64, error) {
+	{{- xooptions }}
+	{{- xoinstrument .Name "" .Exec }}
+	{{- queryparamdefaults . }}
+	{{- interpolchecks . "0" }}
+	// sql query
+{{- if .Segments }}
+	var sqlstr string
+	var args []interface{}
+{{- range $seg := .Segments }}
+{{- $ind := "" }}{{ if $seg.Cond }}{{ $ind = "\t" }}
+	if {{ $seg.Cond }} {
+{{- end }}
+{{- range $seg.Parts }}
+{{- if not .Param }}
+	{{ $ind }}sqlstr += `{{ .SQL }}`
+{{- else if .Param.Expand }}
+	{{ $ind }}sqlstr += xoParams(len(args), len({{ .Param.Name }}))
+	{{ $ind }}for _, v := range {{ .Param.Name }} {
+	{{ $ind }}	args = append(args, v)
+	{{ $ind }}}
+{{- else }}
+	{{ $ind }}sqlstr += {{ nthparamgo "len(args)" }}
+	{{ $ind }}args = append(args, {{ queryarg .Param }})
+{{- end }}
+{{- end }}
+{{- if $seg.Cond }}
+	}
+{{- end }}
+{{- end }}
+{{- else }}
+	{{ if .Interpolate }}var{{ else }}const{{ end }} sqlstr = {{ range $i, $l := .Query }}{{ if $i }} +{{ end }}{{ if (index $queryComments $i) }} // {{ index $queryComments $i }}{{ end }}{{ if $i }}
+	{{end -}}`{{ $l }}`{{ end }}
+{{- end }}
+
+	// run query
+	XOLog(sqlstr{{ $args }})
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr{{ $args }})
+	if err != nil {
+		return 0, err
+	}
+
+	return xoRowsAffected(res)
+}
+{{- else if .Map -}}
+{{- if .Comment -}}
+// {{ gocomment .Comment }}
+{{- else -}}
+// {{ .Name }} runs a custom query, returning the columns of the results in
+// order, and the results as maps keyed by column name.
+{{- end }}
+{{- with .SQL }}
+//
+// The query is:
+//
+//	{{ gocode . }}
+{{- end }}
+func {{ .Name }}({{ ctxparam }}db XODB{{ range .QueryParams }}{{ if not .Default }}, {{ .Name }} {{ .Type }}{{ end }}{{ end }}, opts ...XOOption) ([]string, []map[string]interface{}, error) {
+	{{- xooptions }}
+	{{- xoinstrument .Name "" "SELECT" }}
+	{{- queryparamdefaults . }}
+	{{- interpolchecks . "nil, nil" }}
+	// sql query
+{{- if .Segments }}
+	var sqlstr string
+	var args []interface{}
+{{- range $seg := .Segments }}
+{{- $ind := "" }}{{ if $seg.Cond }}{{ $ind = "\t" }}
+	if {{ $seg.Cond }} {
+{{- end }}
+{{- range $seg.Parts }}
+{{- if not .Param }}
+	{{ $ind }}sqlstr += `{{ .SQL }}`
+{{- else if .Param.Expand }}
+	{{ $ind }}sqlstr += xoParams(len(args), len({{ .Param.Name }}))
+	{{ $ind }}for _, v := range {{ .Param.Name }} {
+	{{ $ind }}	args = append(args, v)
+	{{ $ind }}}
+{{- else }}
+	{{ $ind }}sqlstr += {{ nthparamgo "len(args)" }}
+	{{ $ind }}args = append(args, {{ queryarg .Param }})
+{{- end }}
+{{- end }}
+{{- if $seg.Cond }}
+	}
+{{- end }}
+{{- end }}
+{{- else }}
+	{{ if .Interpolate }}var{{ else }}const{{ end }} sqlstr = {{ range $i, $l := .Query }}{{ if $i }} +{{ end }}{{ if (index $queryComments $i) }} // {{ index $queryComments $i }}{{ end }}{{ if $i }}
+	{{end -}}`{{ $l }}`{{ end }}
+{{- end }}
+
+	// run query
+	XOLog(sqlstr{{ $args }})
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr{{ $args }})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer q.Close()
+
+	return xoScanMaps(q)
+}
+{{- else -}}
+{{- if .Comment -}}
+// {{ gocomment .Comment }}
+{{- else -}}
+// {{ .Name }} runs a custom query, returning results as {{ if .Scalar }}{{ $res }}{{ else }}{{ .Type.Name }}{{ end }}.
+{{- end }}
+{{- with .SQL }}
+//
+// The query is:
+//
+//	{{ gocode . }}
+{{- end }}
+func {{ .Name }} ({{ ctxparam }}db XODB{{ range .QueryParams }}{{ if not .Default }}, {{ .Name }} {{ .Type }}{{ end }}{{ end }}, opts ...XOOption) ({{ if not .OnlyOne }}[]{{ end }}{{ $res }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument .Name "" "SELECT" }}
+	{{- queryparamdefaults . }}
+	{{- interpolchecks . $ret }}
 	var err error
 
 	// sql query
+{{- if .Segments }}
+	var sqlstr string
+	var args []interface{}
+{{- range $seg := .Segments }}
+{{- $ind := "" }}{{ if $seg.Cond }}{{ $ind = "\t" }}
+	if {{ $seg.Cond }} {
+{{- end }}
+{{- range $seg.Parts }}
+{{- if not .Param }}
+	{{ $ind }}sqlstr += `{{ .SQL }}`
+{{- else if .Param.Expand }}
+	{{ $ind }}sqlstr += xoParams(len(args), len({{ .Param.Name }}))
+	{{ $ind }}for _, v := range {{ .Param.Name }} {
+	{{ $ind }}	args = append(args, v)
+	{{ $ind }}}
+{{- else }}
+	{{ $ind }}sqlstr += {{ nthparamgo "len(args)" }}
+	{{ $ind }}args = append(args, {{ queryarg .Param }})
+{{- end }}
+{{- end }}
+{{- if $seg.Cond }}
+	}
+{{- end }}
+{{- end }}
+{{- else }}
 	{{ if .Interpolate }}var{{ else }}const{{ end }} sqlstr = {{ range $i, $l := .Query }}{{ if $i }} +{{ end }}{{ if (index $queryComments $i) }} // {{ index $queryComments $i }}{{ end }}{{ if $i }}
 	{{end -}}`{{ $l }}`{{ end }}
+{{- end }}
 
 	// run query
-	XOLog(sqlstr{{ range .QueryParams }}{{ if not .Interpolate }}, {{ .Name }}{{ end }}{{ end }})
-{{- if .OnlyOne }}
+	XOLog(sqlstr{{ $args }})
+{{- if and .OnlyOne .Scalar }}
+	var {{ $short }} {{ $res }}
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr{{ $args }}).Scan(&{{ $short }})
+	if err != nil {
+		return {{ $nil }}, err
+	}
+
+	return {{ $short }}, nil
+{{- else if .Scalar }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr{{ $args }})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []{{ $res }}{}
+	for q.Next() {
+		var {{ $short }} {{ $res }}
+
+		// scan
+		err = q.Scan(&{{ $short }})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, {{ $short }})
+	}
+
+	return res, nil
+{{- else if and .OnlyOne generics }}
+	{{ $short }}, err := xoQueryOne[{{ .Type.Name }}]({{ ctxarg }}db, sqlstr{{ $args }})
+	if err != nil {
+		return nil, err
+	}
+
+	return {{ $short }}, nil
+{{- else if generics }}
+	res, err := xoQueryAll[{{ .Type.Name }}]({{ ctxarg }}db, sqlstr{{ $args }})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+{{- else if .OnlyOne }}
 	var {{ $short }} {{ .Type.Name }}
-	err = db.QueryRow(sqlstr{{ range .QueryParams }}, {{ .Name }}{{ end }}).Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr{{ $args }}).Scan({{ queryfields .Type $short }})
 	if err != nil {
 		return nil, err
 	}
+{{- if .Type.PrimaryKey }}
+
+	// set existence
+	{{ $short }}._exists = true
+{{- end }}
 
 	return &{{ $short }}, nil
 {{- else }}
-	q, err := db.Query(sqlstr{{ range .QueryParams }}, {{ .Name }}{{ end }})
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr{{ $args }})
 	if err != nil {
 		return nil, err
 	}
@@ -32,10 +237,14 @@ func {{ .Name }} (db XODB{{ range .QueryParams }}, {{ .Name }} {{ .Type }}{{ end
 	// load results
 	res := []*{{ .Type.Name }}{}
 	for q.Next() {
-		{{ $short }} := {{ .Type.Name }}{}
+		{{ $short }} := {{ .Type.Name }}{
+		{{- if .Type.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
 
 		// scan
-		err = q.Scan({{ fieldnames .Type.Fields (print "&" $short) }})
+		err = q.Scan({{ queryfields .Type $short }})
 		if err != nil {
 			return nil, err
 		}
@@ -46,4 +255,100 @@ func {{ .Name }} (db XODB{{ range .QueryParams }}, {{ .Name }} {{ .Type }}{{ end
 	return res, nil
 {{- end }}
 }
+{{- if not .OnlyOne }}
 
+// {{ .Name }}Each runs the custom query, calling fn with each result as a
+// {{ if .Scalar }}{{ $res }}{{ else }}{{ .Type.Name }}{{ end }}, without loading all results into memory. Iteration stops at the
+// first error returned by fn, which is returned.
+func {{ .Name }}Each({{ ctxparam }}db XODB{{ range .QueryParams }}{{ if not .Default }}, {{ .Name }} {{ .Type }}{{ end }}{{ end }}, fn func({{ $res }}) error, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name "Each") "" "SELECT" }}
+	{{- queryparamdefaults . }}
+	{{- interpolchecks . "" }}
+	// sql query
+{{- if .Segments }}
+	var sqlstr string
+	var args []interface{}
+{{- range $seg := .Segments }}
+{{- $ind := "" }}{{ if $seg.Cond }}{{ $ind = "\t" }}
+	if {{ $seg.Cond }} {
+{{- end }}
+{{- range $seg.Parts }}
+{{- if not .Param }}
+	{{ $ind }}sqlstr += `{{ .SQL }}`
+{{- else if .Param.Expand }}
+	{{ $ind }}sqlstr += xoParams(len(args), len({{ .Param.Name }}))
+	{{ $ind }}for _, v := range {{ .Param.Name }} {
+	{{ $ind }}	args = append(args, v)
+	{{ $ind }}}
+{{- else }}
+	{{ $ind }}sqlstr += {{ nthparamgo "len(args)" }}
+	{{ $ind }}args = append(args, {{ queryarg .Param }})
+{{- end }}
+{{- end }}
+{{- if $seg.Cond }}
+	}
+{{- end }}
+{{- end }}
+{{- else }}
+	{{ if .Interpolate }}var{{ else }}const{{ end }} sqlstr = {{ range $i, $l := .Query }}{{ if $i }} +{{ end }}{{ if (index $queryComments $i) }} // {{ index $queryComments $i }}{{ end }}{{ if $i }}
+	{{end -}}`{{ $l }}`{{ end }}
+{{- end }}
+
+	// run query
+	XOLog(sqlstr{{ $args }})
+{{- if and generics (not .Scalar) }}
+	return xoQueryEach({{ ctxarg }}db, fn, sqlstr{{ $args }})
+{{- else }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr{{ $args }})
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+
+	// process results
+	for q.Next() {
+	{{- if .Scalar }}
+		var {{ $short }} {{ $res }}
+
+		// scan
+		err = q.Scan(&{{ $short }})
+		if err != nil {
+			return err
+		}
+
+		err = fn({{ $short }})
+	{{- else }}
+		{{ $short }} := {{ .Type.Name }}{
+		{{- if .Type.PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		err = q.Scan({{ queryfields .Type $short }})
+		if err != nil {
+			return err
+		}
+
+		err = fn(&{{ $short }})
+	{{- end }}
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.Err()
+{{- end }}
+}
+{{- end }}
+{{- end }}
+{{- range .QueryParams }}
+{{- if .Default }}
+
+// {{ .Option }} sets the {{ .Name }} param of {{ $.Name }}{{ if not (or $.OnlyOne $.Exec $.Map) }} and {{ $.Name }}Each{{ end }}, {{ .Default }} by default.
+func {{ .Option }}({{ .Name }} {{ .Type }}) XOOption {
+	return xoParam("{{ $.Name }}.{{ .Name }}", {{ .Name }})
+}
+{{- end }}
+{{- end }}
@@ -1,12 +1,27 @@
+{{- $short := (shortname .Name "err" "row") -}}
 {{- $table := (schema .Schema .Table.TableName) -}}
 {{- if .Comment -}}
-// {{ .Comment }}
+// {{ gocomment .Comment }}
 {{- else -}}
 // {{ .Name }} represents a row from '{{ $table }}'.
 {{- end }}
 type {{ .Name }} struct {
+{{- range .Embeds }}
+	{{ .Name }}{{ with embedtags . }} `{{ . }}`{{ end }}
+{{- end }}
 {{- range .Fields }}
 	{{ .Name }} {{ retype .Type }} // {{ .Col.ColumnName }}
 {{- end }}
 }
+{{- if generics }}
+
+// xoScan scans row into the {{ .Name }}.
+func ({{ $short }} *{{ .Name }}) xoScan(row xoRow) error {
+	err := row.Scan({{ queryfields . $short }})
+	if err != nil {
+		return err
+	}
 
+	return nil
+}
+{{- end }}
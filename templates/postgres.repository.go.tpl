@@ -0,0 +1,77 @@
+{{- $short := (shortname .Name "err" "db" "opts" "tx") -}}
+{{- $rshort := (shortname (print .Name "Repository") "err" "db" "opts" "tx" $short .Fields) -}}
+{{- $update := ne (fieldnamesmulti .Fields $short (updateignore .)) "" -}}
+// {{ .Name }}Store is the interface for storing and retrieving {{ .Name }} rows.
+type {{ .Name }}Store interface {
+	// WithTx returns a {{ .Name }}Store using tx, such as the transaction of
+	// XOWithTx.
+	WithTx(tx XODB) {{ .Name }}Store
+
+	Insert({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+{{- if $update }}
+	Update({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+	Save({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+{{- end }}
+	Delete({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error
+{{- range .Indexes }}{{ if .FuncName }}
+{{- if .Index.IsUnique }}
+	{{ repomethod . }}({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOOption) (*{{ .Type.Name }}, error)
+{{- else }}
+	{{ repomethod . }}({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOListOption) ([]*{{ .Type.Name }}, error)
+{{- end }}
+{{- end }}{{ end }}
+}
+
+// {{ .Name }}Repository is a {{ .Name }}Store using the generated funcs and
+// methods for {{ .Name }} with DB.
+type {{ .Name }}Repository struct {
+	DB XODB
+}
+
+// New{{ .Name }}Repository creates a {{ .Name }}Repository using db.
+func New{{ .Name }}Repository(db XODB) *{{ .Name }}Repository {
+	return &{{ .Name }}Repository{DB: db}
+}
+
+// WithTx returns a {{ .Name }}Repository using tx.
+func ({{ $rshort }} *{{ .Name }}Repository) WithTx(tx XODB) {{ .Name }}Store {
+	return New{{ .Name }}Repository(tx)
+}
+
+// Insert inserts the {{ .Name }} to the database.
+func ({{ $rshort }} *{{ .Name }}Repository) Insert({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	return {{ $short }}.Insert({{ ctxarg }}{{ $rshort }}.DB, opts...)
+}
+{{- if $update }}
+
+// Update updates the {{ .Name }} in the database.
+func ({{ $rshort }} *{{ .Name }}Repository) Update({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	return {{ $short }}.Update({{ ctxarg }}{{ $rshort }}.DB, opts...)
+}
+
+// Save saves the {{ .Name }} to the database.
+func ({{ $rshort }} *{{ .Name }}Repository) Save({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	return {{ $short }}.Save({{ ctxarg }}{{ $rshort }}.DB, opts...)
+}
+{{- end }}
+
+// Delete deletes the {{ .Name }} from the database.
+func ({{ $rshort }} *{{ .Name }}Repository) Delete({{ ctxparam }}{{ $short }} *{{ .Name }}, opts ...XOOption) error {
+	return {{ $short }}.Delete({{ ctxarg }}{{ $rshort }}.DB, opts...)
+}
+{{- range .Indexes }}{{ if .FuncName }}
+
+// {{ repomethod . }} calls {{ .FuncName }}.
+{{- if .Index.IsUnique }}
+func ({{ $rshort }} *{{ .Type.Name }}Repository) {{ repomethod . }}({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOOption) (*{{ .Type.Name }}, error) {
+	return {{ .FuncName }}({{ ctxarg }}{{ $rshort }}.DB{{ goparamlist .Fields true false }}, opts...)
+}
+{{- else }}
+func ({{ $rshort }} *{{ .Type.Name }}Repository) {{ repomethod . }}({{ ctxparam }}{{ goparamlist .Fields false true }}, opts ...XOListOption) ([]*{{ .Type.Name }}, error) {
+	return {{ .FuncName }}({{ ctxarg }}{{ $rshort }}.DB{{ goparamlist .Fields true false }}, opts...)
+}
+{{- end }}
+{{- end }}{{ end }}
+
+// verify {{ .Name }}Repository implements {{ .Name }}Store
+var _ {{ .Name }}Store = (*{{ .Name }}Repository)(nil)
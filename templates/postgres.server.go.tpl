@@ -0,0 +1,159 @@
+{{- $t := .Type -}}
+{{- $pkg := GoPackageName .ModelToPBConfig.ImportService -}}
+{{- $pk := pkindex .Type -}}
+{{- $short := (shortname $t.Name "err" "db" "ctx" "req" "res" "pb" "cols") -}}
+{{- $sshort := (shortname (print $t.Name "Server") "err" "db" "ctx" "req" "res" "pb" "cols" $short $pk.Fields) -}}
+{{- $plural := pluralize $t.Name -}}
+// {{ $t.Name }}Server is the {{ $t.Name }}Service of the proto package {{ $pkg }},
+// using the generated funcs for {{ $t.Name }} with DB.
+type {{ $t.Name }}Server struct {
+	{{ $pkg }}.Unimplemented{{ $t.Name }}ServiceServer
+
+	DB XODB
+
+	// Authorize authorizes the calls of the RPC methods with their request
+	// before they run. All the calls are authorized when nil.
+	Authorize func(ctx context.Context, method string, req interface{}) error
+}
+
+// New{{ $t.Name }}Server creates a {{ $t.Name }}Server using db.
+func New{{ $t.Name }}Server(db XODB) *{{ $t.Name }}Server {
+	return &{{ $t.Name }}Server{DB: db}
+}
+
+// authorize authorizes the call of the RPC method with req.
+func ({{ $sshort }} *{{ $t.Name }}Server) authorize(ctx context.Context, method string, req interface{}) error {
+	if {{ $sshort }}.Authorize == nil {
+		// TODO: authorize the calls of the {{ $t.Name }}Service
+		return nil
+	}
+
+	return {{ $sshort }}.Authorize(ctx, method, req)
+}
+
+// Get{{ $t.Name }} gets the {{ $t.Name }} by its primary key.
+func ({{ $sshort }} *{{ $t.Name }}Server) Get{{ $t.Name }}(ctx context.Context, req *{{ $pkg }}.Get{{ $t.Name }}Request) (*{{ $pkg }}.Get{{ $t.Name }}Response, error) {
+	if err := {{ $sshort }}.authorize(ctx, "Get{{ $t.Name }}", req); err != nil {
+		return nil, err
+	}
+
+{{ pbkeys $pk.Fields "req" }}
+	{{ $short }}, err := {{ $pk.FuncName }}({{ ctxarg }}{{ $sshort }}.DB{{ goparamlist $pk.Fields true false }})
+	if err != nil {
+		return nil, xoStatus(err)
+	}
+
+	pb, err := {{ $t.Name }}ModelToPB({{ $short }})
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $pkg }}.Get{{ $t.Name }}Response{ {{- pbname $t.Name }}: pb}, nil
+}
+
+// List{{ $plural }} lists the {{ $plural }}, limited and offset by the request.
+func ({{ $sshort }} *{{ $t.Name }}Server) List{{ $plural }}(ctx context.Context, req *{{ $pkg }}.List{{ $plural }}Request) (*{{ $pkg }}.List{{ $plural }}Response, error) {
+	if err := {{ $sshort }}.authorize(ctx, "List{{ $plural }}", req); err != nil {
+		return nil, err
+	}
+
+	rows, err := {{ $plural }}Where({{ ctxarg }}{{ $sshort }}.DB, XOLimit(int(req.Limit)), XOOffset(int(req.Offset)))
+	if err != nil {
+		return nil, err
+	}
+
+	pbs, err := {{ $t.Name }}ModelsToPBs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $pkg }}.List{{ $plural }}Response{ {{- pbname $plural }}: pbs}, nil
+}
+
+// Create{{ $t.Name }} inserts the {{ $t.Name }} of the request.
+func ({{ $sshort }} *{{ $t.Name }}Server) Create{{ $t.Name }}(ctx context.Context, req *{{ $pkg }}.Create{{ $t.Name }}Request) (*{{ $pkg }}.Create{{ $t.Name }}Response, error) {
+	if err := {{ $sshort }}.authorize(ctx, "Create{{ $t.Name }}", req); err != nil {
+		return nil, err
+	}
+
+	if req.{{ pbname $t.Name }} == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing {{ $t.Name }}")
+	}
+{{- if protovalidate }}
+	if err := req.{{ pbname $t.Name }}.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+{{- end }}
+	{{ $short }}, err := {{ $t.Name }}PBToModel(req.{{ pbname $t.Name }})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = {{ $short }}.Insert({{ ctxarg }}{{ $sshort }}.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	pb, err := {{ $t.Name }}ModelToPB({{ $short }})
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $pkg }}.Create{{ $t.Name }}Response{ {{- pbname $t.Name }}: pb}, nil
+}
+{{- if maskfields . }}
+
+// Update{{ $t.Name }} updates the fields of the existing {{ $t.Name }} in the update mask of
+// the request, or all of them when the mask has no paths.
+func ({{ $sshort }} *{{ $t.Name }}Server) Update{{ $t.Name }}(ctx context.Context, req *{{ $pkg }}.Update{{ $t.Name }}Request) (*{{ $pkg }}.Update{{ $t.Name }}Response, error) {
+	if err := {{ $sshort }}.authorize(ctx, "Update{{ $t.Name }}", req); err != nil {
+		return nil, err
+	}
+
+	if req.{{ pbname $t.Name }} == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing {{ $t.Name }}")
+	}
+{{ pbkeys $pk.Fields (print "req." (pbname $t.Name)) }}
+	{{ $short }}, err := {{ $pk.FuncName }}({{ ctxarg }}{{ $sshort }}.DB{{ goparamlist $pk.Fields true false }})
+	if err != nil {
+		return nil, xoStatus(err)
+	}
+
+	cols, err := {{ $t.Name }}ApplyFieldMask({{ $short }}, req.{{ pbname $t.Name }}, req.UpdateMask)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = {{ $short }}.UpdateColumns({{ ctxarg }}{{ $sshort }}.DB, cols)
+	if err != nil {
+		return nil, err
+	}
+
+	pb, err := {{ $t.Name }}ModelToPB({{ $short }})
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $pkg }}.Update{{ $t.Name }}Response{ {{- pbname $t.Name }}: pb}, nil
+}
+{{- end }}
+
+// Delete{{ $t.Name }} deletes the {{ $t.Name }} by its primary key.
+func ({{ $sshort }} *{{ $t.Name }}Server) Delete{{ $t.Name }}(ctx context.Context, req *{{ $pkg }}.Delete{{ $t.Name }}Request) (*{{ $pkg }}.Delete{{ $t.Name }}Response, error) {
+	if err := {{ $sshort }}.authorize(ctx, "Delete{{ $t.Name }}", req); err != nil {
+		return nil, err
+	}
+
+{{ pbkeys $pk.Fields "req" }}
+	{{ $short }}, err := {{ $pk.FuncName }}({{ ctxarg }}{{ $sshort }}.DB{{ goparamlist $pk.Fields true false }})
+	if err != nil {
+		return nil, xoStatus(err)
+	}
+
+	err = {{ $short }}.Delete({{ ctxarg }}{{ $sshort }}.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{ $pkg }}.Delete{{ $t.Name }}Response{}, nil
+}
@@ -1,5 +1,6 @@
-{{- $short := (shortname .Name "err" "res" "sqlstr" "db" "XOLog") -}}
+{{- $short := (shortname .Name "err" "res" "n" "sqlstr" "db" "XOLog" "now") -}}
 {{- $table := (schema .Schema .Table.TableName) -}}
+{{- $sqltable := (sqltable .Schema .Table.TableName) -}}
 {{- if .Comment -}}
 // {{ .Comment }}
 {{- else -}}
@@ -7,7 +8,12 @@
 {{- end }}
 type {{ .Name }} struct {
 {{- range .Fields }}
-	{{ .Name }} {{ retype .Type }} `json:"{{ .Col.ColumnName }}"` // {{ .Col.ColumnName }}
+	{{ .Name }} {{ retype .Type }}{{ with structtags . }} `{{ . }}`{{ end }} // {{ .Col.ColumnName }}
+{{- end }}
+{{- if .Preloads }}
+
+	// related rows, set by the Load{{ .Name }}* funcs
+	Rel {{ .Name }}Rel `{{ skiptags }}`
 {{- end }}
 {{- if .PrimaryKey }}
 
@@ -15,6 +21,89 @@ type {{ .Name }} struct {
 	_exists, _deleted bool
 {{ end }}
 }
+{{- if .Preloads }}
+
+// {{ .Name }}Rel holds the rows related to a {{ .Name }} by its foreign keys.
+type {{ .Name }}Rel struct {
+{{- range .Preloads }}
+	{{ .Name }} *{{ .RefType.Name }} // {{ .Field.Col.ColumnName }}
+{{- end }}
+}
+{{- end }}
+
+// The column names of '{{ $table }}'.
+const (
+{{- range .Fields }}
+	{{ $.Name }}Col{{ .Name }} = {{ printf "%q" .Col.ColumnName }}
+{{- end }}
+)
+{{- if generics }}
+
+// xoScan scans row into the {{ .Name }}.
+func ({{ $short }} *{{ .Name }}) xoScan(row xoRow) error {
+	err := row.Scan({{ fieldnames .Fields (print "&" $short) }})
+	if err != nil {
+		return err
+	}
+{{- if .PrimaryKey }}
+
+	// set existence
+	{{ $short }}._exists = true
+{{- end }}
+
+	return nil
+}
+{{- end }}
+
+// TableName returns the name of the table the {{ .Name }} is a row of.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "TableName" }}XO{{ end }}TableName() string {
+	return {{ printf "%q" $table }}
+}
+
+// Columns returns the names of the {{ .Name }}'s columns, in field order.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "Columns" }}XO{{ end }}Columns() []string {
+	return []string{ {{- range $i, $f := .Fields }}{{ if $i }}, {{ end }}{{ $.Name }}Col{{ $f.Name }}{{ end -}} }
+}
+
+// PrimaryKeyColumns returns the names of the {{ .Name }}'s primary key columns.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "PrimaryKeyColumns" }}XO{{ end }}PrimaryKeyColumns() []string {
+	return []string{ {{- range $i, $f := (wherefields .) }}{{ if $i }}, {{ end }}{{ $.Name }}Col{{ $f.Name }}{{ end -}} }
+}
+
+// Values returns the values of the {{ .Name }}'s fields, in Columns order.
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "Values" }}XO{{ end }}Values() []interface{} {
+	return []interface{}{ {{- fieldnames .Fields $short -}} }
+}
+
+// Pointers returns pointers to the {{ .Name }}'s fields, in Columns order (ie,
+// to scan a row into).
+func ({{ $short }} *{{ .Name }}) {{ if hasfield .Fields "Pointers" }}XO{{ end }}Pointers() []interface{} {
+	return []interface{}{ {{- fieldnames .Fields (print "&" $short) -}} }
+}
+
+{{ $sshort := (shortname .Name "cols" "names" "dest" "i" "c") -}}
+// xoSelect returns the selected columns cols, or all columns when cols is
+// empty, and the pointers to the {{ .Name }}'s fields they are scanned into.
+func ({{ $sshort }} *{{ .Name }}) xoSelect(cols []string) (string, []interface{}, error) {
+	if len(cols) == 0 {
+		return `{{ colnames .Fields }}`, []interface{}{ {{- fieldnames .Fields (print "&" $sshort) -}} }, nil
+	}
+
+	names := make([]string, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i, c := range cols {
+		switch c {
+	{{- range .Fields }}
+		case {{ $.Name }}Col{{ .Name }}:
+			names[i], dest[i] = {{ printf "%q" (colname .Col) }}, {{ scanfield $sshort . }}
+	{{- end }}
+		default:
+			return "", nil, fmt.Errorf("select failed: unknown column %q", c)
+		}
+	}
+
+	return strings.Join(names, ", "), dest, nil
+}
 
 {{ if .PrimaryKey }}
 // Exists determines if the {{ .Name }} exists in the database.
@@ -28,39 +117,57 @@ func ({{ $short }} *{{ .Name }}) Deleted() bool {
 }
 
 // Insert inserts the {{ .Name }} to the database.
-func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
+func ({{ $short }} *{{ .Name }}) Insert({{ ctxparam }}db XODB, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name ".Insert") .Table.TableName "INSERT" }}
 	var err error
 
 	// if already exist, bail
 	if {{ $short }}._exists {
 		return errors.New("insert failed: already exists")
 	}
+{{- if or .CreatedAtField .UpdatedAtField }}
+
+	// set audit times
+	now := time.Now()
+{{- with .CreatedAtField }}
+	{{ $short }}.{{ .Name }} = {{ nowvalue . "now" }}
+{{- end }}
+{{- with .UpdatedAtField }}
+	{{ $short }}.{{ .Name }} = {{ nowvalue . "now" }}
+{{- end }}
+{{- end }}
+
+	// call before insert hook
+	if err = xoBeforeInsert({{ ctxarg }}db, {{ $short }}); err != nil {
+		return err
+	}
 
 {{ if .Table.ManualPk }}
 	// sql insert query, primary key must be provided
-	const sqlstr = `INSERT INTO {{ $table }} (` +
-		`{{ colnames .Fields }}` +
+	{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqltable }} (` +
+		`{{ colnames (insertfields .) }}` +
 		`) VALUES (` +
-		`{{ colvals .Fields }}` +
-		`)`
+		`{{ colvals (insertfields .) }}` +
+		`) RETURNING {{ colnames (returningfields .) }}`
 
 	// run query
-	XOLog(sqlstr, {{ fieldnames .Fields $short }})
-	err = db.QueryRow(sqlstr, {{ fieldnames .Fields $short }}).Scan(&{{ $short }}.{{ .PrimaryKey.Name }})
+	XOLog(sqlstr, {{ fieldnames (insertfields .) $short }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr, {{ fieldnames (insertfields .) $short }}).Scan({{ fieldnames (returningfields .) (print "&" $short) }})
 	if err != nil {
 		return err
 	}
 {{ else }}
 	// sql insert query, primary key provided by sequence
-	const sqlstr = `INSERT INTO {{ $table }} (` +
-		`{{ colnames .Fields .PrimaryKey.Name }}` +
+	{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqltable }} (` +
+		`{{ colnames (insertfields .) }}` +
 		`) VALUES (` +
-		`{{ colvals .Fields .PrimaryKey.Name }}` +
-		`) RETURNING {{ colname .PrimaryKey.Col }}`
+		`{{ colvals (insertfields .) }}` +
+		`) RETURNING {{ colnames (returningfields .) }}`
 
 	// run query
-	XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }})
-	err = db.QueryRow(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}).Scan(&{{ $short }}.{{ .PrimaryKey.Name }})
+	XOLog(sqlstr, {{ fieldnames (insertfields .) $short }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr, {{ fieldnames (insertfields .) $short }}).Scan({{ fieldnames (returningfields .) (print "&" $short) }})
 	if err != nil {
 		return err
 	}
@@ -69,12 +176,194 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 	// set existence
 	{{ $short }}._exists = true
 
+	// call after insert hook
+	return xoAfterInsert({{ ctxarg }}db, {{ $short }})
+}
+
+{{ $ishort := (shortname .Name "err" "sqlstr" "db" "XOLog" "now") -}}
+// InsertIgnore inserts the {{ .Name }} to the database unless it conflicts with
+// an existing row (ON CONFLICT DO NOTHING), returning whether it was inserted.
+func ({{ $ishort }} *{{ .Name }}) InsertIgnore({{ ctxparam }}db XODB, opts ...XOOption) (bool, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name ".InsertIgnore") .Table.TableName "INSERT" }}
+	var err error
+
+	// if already exist, bail
+	if {{ $ishort }}._exists {
+		return false, errors.New("insert failed: already exists")
+	}
+{{- if or .CreatedAtField .UpdatedAtField }}
+
+	// set audit times
+	now := time.Now()
+{{- with .CreatedAtField }}
+	{{ $ishort }}.{{ .Name }} = {{ nowvalue . "now" }}
+{{- end }}
+{{- with .UpdatedAtField }}
+	{{ $ishort }}.{{ .Name }} = {{ nowvalue . "now" }}
+{{- end }}
+{{- end }}
+
+	// call before insert hook
+	if err = xoBeforeInsert({{ ctxarg }}db, {{ $ishort }}); err != nil {
+		return false, err
+	}
+
+	// sql insert query, skipped on conflict
+	{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqltable }} (` +
+		`{{ colnames (insertfields .) }}` +
+		`) VALUES (` +
+		`{{ colvals (insertfields .) }}` +
+		`) ON CONFLICT DO NOTHING RETURNING {{ colnames (returningfields .) }}`
+
+	// run query
+	XOLog(sqlstr, {{ fieldnames (insertfields .) $ishort }})
+	err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr, {{ fieldnames (insertfields .) $ishort }}).Scan({{ fieldnames (returningfields .) (print "&" $ishort) }})
+	if err == {{ if pgx }}pgx{{ else }}sql{{ end }}.ErrNoRows {
+		// the row conflicts
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// set existence
+	{{ $ishort }}._exists = true
+
+	// call after insert hook
+	return true, xoAfterInsert({{ ctxarg }}db, {{ $ishort }})
+}
+
+{{ $rshort := (shortname .Name "err" "sqlstr" "db" "q" "XOLog" "rows" "n" "now" "args" "vals" "start" "p" "i" "j") -}}
+// Insert{{ pluralize .Name }} inserts rows to the database using multi-row
+// INSERT statements of at most XOBatchSize rows each.
+func Insert{{ pluralize .Name }}({{ ctxparam }}db XODB, rows []*{{ .Name }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print "Insert" (pluralize .Name)) .Table.TableName "INSERT" }}
+	// if already exist, bail
+	for _, {{ $rshort }} := range rows {
+		if {{ $rshort }}._exists {
+			return errors.New("insert failed: already exists")
+		}
+	}
+{{- if or .CreatedAtField .UpdatedAtField }}
+
+	// set audit times
+	now := time.Now()
+	for _, {{ $rshort }} := range rows {
+	{{- with .CreatedAtField }}
+		{{ $rshort }}.{{ .Name }} = {{ nowvalue . "now" }}
+	{{- end }}
+	{{- with .UpdatedAtField }}
+		{{ $rshort }}.{{ .Name }} = {{ nowvalue . "now" }}
+	{{- end }}
+	}
+{{- end }}
+
+	// call before insert hooks
+	for _, {{ $rshort }} := range rows {
+		if err := xoBeforeInsert({{ ctxarg }}db, {{ $rshort }}); err != nil {
+			return err
+		}
+	}
+
+	for len(rows) > 0 {
+		n := len(rows)
+		if n > XOBatchSize {
+			n = XOBatchSize
+		}
+
+		// build placeholders and args
+		vals := make([]string, n)
+		args := make([]interface{}, 0, n*{{ len .Fields }})
+		for i, {{ $rshort }} := range rows[:n] {
+			start := len(args)
+{{- if .Table.ManualPk }}
+			args = append(args, {{ fieldnames (insertfields .) $rshort }})
+{{- else }}
+			args = append(args, {{ fieldnames (insertfields .) $rshort }})
+{{- end }}
+			p := make([]string, 0, len(args)-start)
+			for j := start; j < len(args); j++ {
+				p = append(p, {{ nthparamgo "j" }})
+			}
+			vals[i] = "(" + strings.Join(p, ", ") + ")"
+		}
+{{- if .Table.ManualPk }}
+
+		// sql insert query, primary key must be provided
+		sqlstr := `INSERT INTO {{ $sqltable }} (` +
+			`{{ colnames (insertfields .) }}` +
+			`) VALUES ` + strings.Join(vals, ", ")
+
+		// run query
+		XOLog(sqlstr, args...)
+		_, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// set existence
+		for _, {{ $rshort }} := range rows[:n] {
+			{{ $rshort }}._exists = true
+		}
+{{- else }}
+
+		// sql insert query, primary key provided by sequence
+		sqlstr := `INSERT INTO {{ $sqltable }} (` +
+			`{{ colnames (insertfields .) }}` +
+			`) VALUES ` + strings.Join(vals, ", ") +
+			` RETURNING {{ colnames (returningfields .) }}`
+
+		// run query
+		XOLog(sqlstr, args...)
+		q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// set primary keys and existence
+		i := 0
+		for ; i < n && q.Next(); i++ {
+			err = q.Scan({{ fieldnames (returningfields .) "&rows[i]" }})
+			if err != nil {
+				q.Close()
+				return err
+			}
+			rows[i]._exists = true
+		}
+		q.Close()
+		if err = q.Err(); err != nil {
+			return err
+		}
+		if i != n {
+			return errors.New("insert failed: missing returned primary keys")
+		}
+{{- end }}
+
+		// call after insert hooks
+		for _, {{ $rshort }} := range rows[:n] {
+			if err := xoAfterInsert({{ ctxarg }}db, {{ $rshort }}); err != nil {
+				return err
+			}
+		}
+
+		rows = rows[n:]
+	}
+
 	return nil
 }
 
-{{ if ne (fieldnamesmulti .Fields $short .PrimaryKeyFields) "" }}
+{{ if ne (fieldnamesmulti .Fields $short (updateignore .)) "" }}
 	// Update updates the {{ .Name }} in the database.
-	func ({{ $short }} *{{ .Name }}) Update(db XODB) error {
+	{{- if .VersionField }}
+	//
+	// Returns ErrStaleRow when the row was changed or deleted since it was
+	// loaded.
+	{{- end }}
+	func ({{ $short }} *{{ .Name }}) Update({{ ctxparam }}db XODB, opts ...XOOption) error {
+		{{- xooptions }}
+		{{- xoinstrument (print .Name ".Update") .Table.TableName "UPDATE" }}
 		var err error
 
 		// if doesn't exist, bail
@@ -87,67 +376,178 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 			return errors.New("update failed: marked for deletion")
 		}
 
-		{{ if gt ( len .PrimaryKeyFields ) 1 }}
-			// sql query with composite primary key
-			const sqlstr = `UPDATE {{ $table }} SET (` +
-				`{{ colnamesmulti .Fields .PrimaryKeyFields }}` +
-				`) = ( ` +
-				`{{ colvalsmulti .Fields .PrimaryKeyFields }}` +
-				`) WHERE {{ colnamesquerymulti .PrimaryKeyFields " AND " (getstartcount .Fields .PrimaryKeyFields) nil }}`
+		// call before update hook
+		if err = xoBeforeUpdate({{ ctxarg }}db, {{ $short }}); err != nil {
+			return err
+		}
+
+		// sql query, returning the updated row
+		{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET (` +
+			`{{ colnamesmulti .Fields (updateignore .) }}` +
+			`) = ( ` +
+			`{{ colvalsmulti .Fields (updateignore .) }}` +
+			`){{ versionset . }}{{ updatedset . }} WHERE {{ colnamesquerymulti (wherefields .) false " AND " (getstartcount .Fields (updateignore .)) nil }}{{ versioncond . (add (getstartcount .Fields (updateignore .)) (len (wherefields .))) }}` +
+			` RETURNING {{ colnamesgeo .Fields }}`
 
-			// run query
-			XOLog(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short}})
-			_, err = db.Exec(sqlstr, {{ fieldnamesmulti .Fields $short .PrimaryKeyFields }}, {{ fieldnames .PrimaryKeyFields $short}})
-		return err
+		// run query
+		XOLog(sqlstr, {{ fieldnamesmulti .Fields $short (updateignore .) }}, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }})
+		err = db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr, {{ fieldnamesmulti .Fields $short (updateignore .) }}, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }}).Scan({{ fieldnames .Fields (print "&" $short) }})
+		{{- if .VersionField }}
+		if err == {{ if pgx }}pgx{{ else }}sql{{ end }}.ErrNoRows {
+			// the row is stale
+			return ErrStaleRow
+		}
+		{{- end }}
+		if err != nil {
+			return err
+		}
+
+		// call after update hook
+		return xoAfterUpdate({{ ctxarg }}db, {{ $short }})
+	}
+
+	{{ $cshort := (shortname .Name "err" "res" "n" "sqlstr" "db" "XOLog" "cols" "set" "args" "where" "i" "c") -}}
+	// UpdateColumns updates only the columns in cols (the {{ .Name }}Col
+	// constants) of the {{ .Name }} in the database, setting them to the values
+	// of its fields. The columns changed by the database are not reloaded.
+	{{- if .VersionField }}
+	//
+	// Returns ErrStaleRow when the row was changed or deleted since it was
+	// loaded.
+	{{- end }}
+	func ({{ $cshort }} *{{ .Name }}) UpdateColumns({{ ctxparam }}db XODB, cols []string, opts ...XOOption) error {
+		{{- xooptions }}
+		{{- xoinstrument (print .Name ".UpdateColumns") .Table.TableName "UPDATE" }}
+		var err error
+
+		// if doesn't exist, bail
+		if !{{ $cshort }}._exists {
+			return errors.New("update failed: does not exist")
+		}
+
+		// if deleted, bail
+		if {{ $cshort }}._deleted {
+			return errors.New("update failed: marked for deletion")
+		}
+
+		// if no columns, bail
+		if len(cols) == 0 {
+			return nil
+		}
+
+		// call before update hook
+		if err = xoBeforeUpdate({{ ctxarg }}db, {{ $cshort }}); err != nil {
+			return err
+		}
+
+		// build set clause
+		set := make([]string, len(cols))
+		args := make([]interface{}, 0, len(cols)+{{ add (len (wherefields .)) 1 }})
+		for i, c := range cols {
+			switch c {
+		{{- range .Fields }}{{ if not (hasfield (updateignore $) .Name) }}
+			case {{ $.Name }}Col{{ .Name }}:
+			{{- if isgeo . }}
+				set[i] = `{{ colname .Col }} = ST_GeomFromWKB(` + {{ nthparamgo "len(args)" }} + `)`
+			{{- else }}
+				set[i] = `{{ colname .Col }} = ` + {{ nthparamgo "len(args)" }}
+			{{- end }}
+				args = append(args, {{ sqlarg (print $cshort "." .Name) . }})
+		{{- end }}{{ end }}
+			default:
+				return fmt.Errorf("update failed: unknown column %q", c)
+			}
+		}
+
+		// build where clause
+		where := make([]string, 0, {{ add (len (wherefields .)) 1 }})
+	{{- range (wherefields .) }}
+		where = append(where, `{{ colname .Col }} = ` + {{ nthparamgo "len(args)" }})
+		args = append(args, {{ $cshort }}.{{ .Name }})
+	{{- end }}
+	{{- if .VersionField }}
+		where = append(where, `{{ colname .VersionField.Col }} = ` + {{ nthparamgo "len(args)" }})
+		args = append(args, {{ $cshort }}.{{ .VersionField.Name }})
+	{{- end }}
+
+		// sql query
+		sqlstr := `UPDATE {{ $sqltable }} SET ` +
+			strings.Join(set, ", ") + `{{ versionset . }}{{ updatedset . }}` +
+			` WHERE ` + strings.Join(where, " AND ")
+
+		// run query
+		XOLog(sqlstr, args...)
+		{{- if .VersionField }}
+		res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
+			return err
+		}
+
+		// check for a stale row
+		n, err := xoRowsAffected(res)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrStaleRow
+		}
+
+		// increment version
+		{{ $cshort }}.{{ .VersionField.Name }}++
 		{{- else }}
-			// sql query
-			const sqlstr = `UPDATE {{ $table }} SET (` +
-				`{{ colnames .Fields .PrimaryKey.Name }}` +
-				`) = ( ` +
-				`{{ colvals .Fields .PrimaryKey.Name }}` +
-				`) WHERE {{ colname .PrimaryKey.Col }} = ${{ colcount .Fields .PrimaryKey.Name }}`
-
-			// run query
-			XOLog(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
-			_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short .PrimaryKey.Name }}, {{ $short }}.{{ .PrimaryKey.Name }})
+		_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		if err != nil {
 			return err
+		}
 		{{- end }}
+
+		// call after update hook
+		return xoAfterUpdate({{ ctxarg }}db, {{ $cshort }})
 	}
 
 	// Save saves the {{ .Name }} to the database.
-	func ({{ $short }} *{{ .Name }}) Save(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Save({{ ctxparam }}db XODB, opts ...XOOption) error {
 		if {{ $short }}.Exists() {
-			return {{ $short }}.Update(db)
+			return {{ $short }}.Update({{ ctxarg }}db, opts...)
 		}
 
-		return {{ $short }}.Insert(db)
+		return {{ $short }}.Insert({{ ctxarg }}db, opts...)
 	}
 
 	// Upsert performs an upsert for {{ .Name }}.
 	//
 	// NOTE: PostgreSQL 9.5+ only
-	func ({{ $short }} *{{ .Name }}) Upsert(db XODB) error {
+	func ({{ $short }} *{{ .Name }}) Upsert({{ ctxparam }}db XODB, opts ...XOOption) error {
+		{{- xooptions }}
+		{{- xoinstrument (print .Name ".Upsert") .Table.TableName "INSERT" }}
 		var err error
 
 		// if already exist, bail
 		if {{ $short }}._exists {
 			return errors.New("insert failed: already exists")
 		}
+	{{- if or .CreatedAtField .UpdatedAtField }}
+
+		// set audit times
+		now := time.Now()
+	{{- with .CreatedAtField }}
+		{{ $short }}.{{ .Name }} = {{ nowvalue . "now" }}
+	{{- end }}
+	{{- with .UpdatedAtField }}
+		{{ $short }}.{{ .Name }} = {{ nowvalue . "now" }}
+	{{- end }}
+	{{- end }}
 
 		// sql query
-		const sqlstr = `INSERT INTO {{ $table }} (` +
-			`{{ colnames .Fields }}` +
+		{{ sqldecl "sqlstr" }} `INSERT INTO {{ $sqltable }} (` +
+			`{{ colnames (upsertfields .) }}` +
 			`) VALUES (` +
-			`{{ colvals .Fields }}` +
-			`) ON CONFLICT ({{ colnames .PrimaryKeyFields }}) DO UPDATE SET (` +
-			`{{ colnames .Fields }}` +
-			`) = (` +
-			`{{ colprefixnames .Fields "EXCLUDED" }}` +
-			`)`
+			`{{ colvals (upsertfields .) }}` +
+			`) {{ upsertclause . }}`
 
 		// run query
-		XOLog(sqlstr, {{ fieldnames .Fields $short }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .Fields $short }})
+		XOLog(sqlstr, {{ fieldnames (upsertfields .) $short }})
+		_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (upsertfields .) $short }})
 		if err != nil {
 			return err
 		}
@@ -157,12 +557,177 @@ func ({{ $short }} *{{ .Name }}) Insert(db XODB) error {
 
 		return nil
 }
+
+{{- if eq (len .PrimaryKeyFields) 1 }}
+{{ $ushort := (shortname .Name "err" "sqlstr" "db" "XOLog" "cols" "ids" "set" "args" "in" "i" "c" "pk" "guard") }}
+	// Update{{ pluralize .Name }} updates the columns in cols of the rows whose
+	// primary key is in ids, setting them to the values in {{ $ushort }}.
+	{{- with .GuardField }} Only
+	// the rows whose {{ .Col.ColumnName }} is the one of {{ $ushort }} are updated.
+	{{- end }}
+	func Update{{ pluralize .Name }}({{ ctxparam }}db XODB, {{ $ushort }} *{{ .Name }}, cols []string, ids ...{{ .PrimaryKey.Type }}) error {
+		{{- xoinstrument (print "Update" (pluralize .Name)) .Table.TableName "UPDATE" }}
+		if len(cols) == 0 || len(ids) == 0 {
+			return nil
+		}
+
+		// build set clause
+		set := make([]string, len(cols))
+		args := make([]interface{}, 0, len(cols)+len(ids))
+		for i, c := range cols {
+			switch c {
+		{{- range .Fields }}{{ if not (hasfield (updateignore $) .Name) }}
+			case "{{ .Col.ColumnName }}":
+			{{- if isgeo . }}
+				set[i] = `{{ colname .Col }} = ST_GeomFromWKB(` + {{ nthparamgo "len(args)" }} + `)`
+			{{- else }}
+				set[i] = `{{ colname .Col }} = ` + {{ nthparamgo "len(args)" }}
+			{{- end }}
+				args = append(args, {{ sqlarg (print $ushort "." .Name) . }})
+		{{- end }}{{ end }}
+			default:
+				return fmt.Errorf("update failed: unknown column %q", c)
+			}
+		}
+
+		// build primary key list
+		in := make([]string, len(ids))
+		for i, pk := range ids {
+			in[i] = {{ nthparamgo "len(args)" }}
+			args = append(args, {{ sqlarg "pk" .PrimaryKey }})
+		}
+	{{- with .GuardField }}
+
+		// build guard condition
+		guard := `{{ colname .Col }} = ` + {{ nthparamgo "len(args)" }}
+		args = append(args, {{ sqlarg (print $ushort "." .Name) . }})
+	{{- end }}
+
+		// sql query
+		sqlstr := `UPDATE {{ $sqltable }} SET ` +
+			strings.Join(set, ", ") + `{{ versionset . }}{{ updatedset . }}` +
+			` WHERE {{ colname .PrimaryKey.Col }} IN (` + strings.Join(in, ", ") + `)`{{ if .GuardField }} +
+			` AND ` + guard{{ end }}
+
+		// run query
+		XOLog(sqlstr, args...)
+		_, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+		return err
+	}
+{{- end }}
 {{ else }}
 	// Update statements omitted due to lack of fields other than primary key
 {{ end }}
 
-// Delete deletes the {{ .Name }} from the database.
-func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
+{{- if .PrimaryKey }}
+{{- $keys := (pkfields .) }}
+{{- range .CounterFields }}
+
+// Increment{{ $.Name }}{{ .Name }} atomically adds delta to the {{ .Col.ColumnName }} of the
+// row from '{{ $table }}' with the primary key (ie, with a negative delta to
+// decrement it).
+func Increment{{ $.Name }}{{ .Name }}({{ ctxparam }}db XODB{{ goparamlist $keys true true }}, delta {{ retype .Type }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print "Increment" $.Name .Name) $.Table.TableName "UPDATE" }}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET {{ colname .Col }} = {{ colname .Col }} + {{ nthparam 0 }}{{ versionset $ }}{{ updatedset $ }} ` +
+		`WHERE {{ colnamesquerymulti $keys false " AND " 1 nil }}`
+
+	// run query
+	XOLog(sqlstr, delta{{ sqlparamlist $keys true }})
+	_, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, delta{{ sqlparamlist $keys true }})
+	return err
+}
+{{- end }}
+{{- end }}
+{{- $delete := "Delete" }}
+{{- if softdeletedefault . }}{{ $delete = "HardDelete" }}{{ end }}
+{{- if softdeletemode . }}
+{{ $sshort := (shortname .Name "err" "res" "n" "sqlstr" "db" "XOLog" "v" "by") }}
+// SoftDelete marks the {{ .Name }} as deleted in the database by setting {{ .SoftDeleteField.Col.ColumnName }}.
+{{- if .VersionField }}
+//
+// Returns ErrStaleRow when the row was changed or deleted since it was
+// loaded.
+{{- end }}
+func ({{ $sshort }} *{{ .Name }}) SoftDelete({{ ctxparam }}db XODB{{ if eq (softdeletemode .) "by" }}, by {{ retype .SoftDeleteField.Type }}{{ end }}, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name ".SoftDelete") .Table.TableName "UPDATE" }}
+	var err error
+
+	// if doesn't exist, bail
+	if !{{ $sshort }}._exists {
+		return nil
+	}
+
+	// if deleted, bail
+	if {{ $sshort }}._deleted {
+		return nil
+	}
+
+	// call before delete hook
+	if err = xoBeforeDelete({{ ctxarg }}db, {{ $sshort }}); err != nil {
+		return err
+	}
+
+	// sql query
+	{{ sqldecl "sqlstr" }} `UPDATE {{ $sqltable }} SET {{ colname .SoftDeleteField.Col }} = {{ nthparam 0 }}{{ versionset . }} ` +
+		`WHERE {{ colnamesquerymulti (wherefields .) false " AND " 1 nil }}{{ versioncond . (add 1 (len (wherefields .))) }}`
+
+	// run query
+	v := {{ softdeletevalue . "by" }}
+	XOLog(sqlstr, v, {{ fieldnames (wherefields .) $sshort }}{{ if .VersionField }}, {{ $sshort }}.{{ .VersionField.Name }}{{ end }})
+	{{- if .VersionField }}
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, v, {{ fieldnames (wherefields .) $sshort }}{{ if .VersionField }}, {{ $sshort }}.{{ .VersionField.Name }}{{ end }})
+	if err != nil {
+		return err
+	}
+
+	// check for a stale row
+	n, err := xoRowsAffected(res)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrStaleRow
+	}
+{{- else }}
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, v, {{ fieldnames (wherefields .) $sshort }}{{ if .VersionField }}, {{ $sshort }}.{{ .VersionField.Name }}{{ end }})
+	if err != nil {
+		return err
+	}
+{{- end }}
+
+	// set soft delete field and deleted
+	{{ $sshort }}.{{ .SoftDeleteField.Name }} = v
+	{{ $sshort }}._deleted = true
+{{- if .VersionField }}
+	{{ $sshort }}.{{ .VersionField.Name }}++
+{{- end }}
+
+	// call after delete hook
+	return xoAfterDelete({{ ctxarg }}db, {{ $sshort }})
+}
+{{- end }}
+{{- if softdeletedefault . }}
+
+// Delete soft deletes the {{ .Name }} from the database. Use HardDelete to
+// delete the row.
+func ({{ $short }} *{{ .Name }}) Delete({{ ctxparam }}db XODB, opts ...XOOption) error {
+	return {{ $short }}.SoftDelete({{ ctxarg }}db, opts...)
+}
+{{- end }}
+
+// {{ $delete }} deletes the {{ .Name }} from the database.
+{{- if .VersionField }}
+//
+// Returns ErrStaleRow when the row was changed or deleted since it was
+// loaded.
+{{- end }}
+func ({{ $short }} *{{ .Name }}) {{ $delete }}({{ ctxparam }}db XODB, opts ...XOOption) error {
+	{{- xooptions }}
+	{{- xoinstrument (print .Name "." $delete) .Table.TableName "DELETE" }}
 	var err error
 
 	// if doesn't exist, bail
@@ -175,32 +740,42 @@ func ({{ $short }} *{{ .Name }}) Delete(db XODB) error {
 		return nil
 	}
 
-	{{ if gt ( len .PrimaryKeyFields ) 1 }}
-		// sql query with composite primary key
-		const sqlstr = `DELETE FROM {{ $table }}  WHERE {{ colnamesquery .PrimaryKeyFields " AND " }}`
+	// call before delete hook
+	if err = xoBeforeDelete({{ ctxarg }}db, {{ $short }}); err != nil {
+		return err
+	}
 
-		// run query
-		XOLog(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
-		_, err = db.Exec(sqlstr, {{ fieldnames .PrimaryKeyFields $short }})
-		if err != nil {
-			return err
-		}
-	{{- else }}
-		// sql query
-		const sqlstr = `DELETE FROM {{ $table }} WHERE {{ colname .PrimaryKey.Col }} = $1`
+	// sql query
+	{{ sqldecl "sqlstr" }} `DELETE FROM {{ $sqltable }} WHERE {{ colnamesquery (wherefields .) false " AND " }}{{ versioncond . (len (wherefields .)) }}`
 
-		// run query
-		XOLog(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-		_, err = db.Exec(sqlstr, {{ $short }}.{{ .PrimaryKey.Name }})
-		if err != nil {
-			return err
-		}
-	{{- end }}
+	// run query
+	XOLog(sqlstr, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }})
+	{{- if .VersionField }}
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }})
+	if err != nil {
+		return err
+	}
+
+	// check for a stale row
+	n, err := xoRowsAffected(res)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrStaleRow
+	}
+{{- else }}
+	_, err = db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, {{ fieldnames (wherefields .) $short }}{{ if .VersionField }}, {{ $short }}.{{ .VersionField.Name }}{{ end }})
+	if err != nil {
+		return err
+	}
+{{- end }}
 
 	// set deleted
 	{{ $short }}._deleted = true
 
-	return nil
+	// call after delete hook
+	return xoAfterDelete({{ ctxarg }}db, {{ $short }})
 }
 {{- end }}
 
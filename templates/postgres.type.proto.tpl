@@ -0,0 +1 @@
+{{ proto . }}
@@ -0,0 +1,211 @@
+{{- $short := (shortname .Name "err" "sqlstr" "db" "q" "res" "XOLog" "o" "opts" "cols" "dest" "where" "args") -}}
+{{- $table := (schema .Schema .Table.TableName) -}}
+{{- $sqltable := (sqltable .Schema .Table.TableName) -}}
+{{- $shard := (shardkeyfields .) -}}
+{{- $guard := (guardfields .) -}}
+// {{ .Name }}Conds builds the conditions on the columns of '{{ $table }}' passed
+// to {{ pluralize .Name }}Where. Use {{ .Name }}Where.
+type {{ .Name }}Conds struct{}
+
+// {{ .Name }}Where builds the conditions passed to {{ pluralize .Name }}Where (ie,
+// {{ .Name }}Where.{{ (index .Fields 0).Name }}Eq(v)).
+var {{ .Name }}Where {{ .Name }}Conds
+
+// The ORDER BY terms of the list funcs of {{ .Name }}, passed with XOOrder.
+const (
+{{- range .Fields }}
+	{{ $.Name }}OrderBy{{ .Name }}Asc  XOOrderBy = {{ colconst $ . }} + " ASC"
+	{{ $.Name }}OrderBy{{ .Name }}Desc XOOrderBy = {{ colconst $ . }} + " DESC"
+{{- end }}
+)
+
+// xo{{ .Name }}OrderBy are the ORDER BY terms accepted by the list funcs of
+// {{ .Name }}.
+var xo{{ .Name }}OrderBy = map[XOOrderBy]bool{
+{{- range .Fields }}
+	{{ $.Name }}OrderBy{{ .Name }}Asc:  true,
+	{{ $.Name }}OrderBy{{ .Name }}Desc: true,
+{{- end }}
+}
+{{- range .Fields }}
+{{- if not (isgeo .) }}
+{{- $col := (colconst $ .) }}
+{{- $kind := (typekind .) }}
+
+// {{ .Name }}Eq matches the rows whose {{ .Col.ColumnName }} equals v.
+func ({{ $.Name }}Conds) {{ .Name }}Eq(v {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, "=", {{ sqlarg "v" . }})
+}
+
+// {{ .Name }}Neq matches the rows whose {{ .Col.ColumnName }} does not equal v.
+func ({{ $.Name }}Conds) {{ .Name }}Neq(v {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, "<>", {{ sqlarg "v" . }})
+}
+
+// {{ .Name }}In matches the rows whose {{ .Col.ColumnName }} is one of vs.
+func ({{ $.Name }}Conds) {{ .Name }}In(vs ...{{ retype .Type }}) XOOption {
+	args := make([]interface{}, len(vs))
+	for i, v := range vs {
+		args[i] = {{ sqlarg "v" . }}
+	}
+
+	return xoWhere({{ $col }}, "IN", args...)
+}
+{{- if eq $kind "number" }}
+
+// {{ .Name }}Lt matches the rows whose {{ .Col.ColumnName }} is less than v.
+func ({{ $.Name }}Conds) {{ .Name }}Lt(v {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, "<", v)
+}
+
+// {{ .Name }}Lte matches the rows whose {{ .Col.ColumnName }} is less than or equal to v.
+func ({{ $.Name }}Conds) {{ .Name }}Lte(v {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, "<=", v)
+}
+
+// {{ .Name }}Gt matches the rows whose {{ .Col.ColumnName }} is greater than v.
+func ({{ $.Name }}Conds) {{ .Name }}Gt(v {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, ">", v)
+}
+
+// {{ .Name }}Gte matches the rows whose {{ .Col.ColumnName }} is greater than or equal to v.
+func ({{ $.Name }}Conds) {{ .Name }}Gte(v {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, ">=", v)
+}
+{{- else if eq $kind "time" }}
+
+// {{ .Name }}Before matches the rows whose {{ .Col.ColumnName }} is before t.
+func ({{ $.Name }}Conds) {{ .Name }}Before(t {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, "<", t)
+}
+
+// {{ .Name }}After matches the rows whose {{ .Col.ColumnName }} is after t.
+func ({{ $.Name }}Conds) {{ .Name }}After(t {{ retype .Type }}) XOOption {
+	return xoWhere({{ $col }}, ">", t)
+}
+{{- else if eq $kind "string" }}
+
+// {{ .Name }}Like matches the rows whose {{ .Col.ColumnName }} matches the LIKE pattern.
+func ({{ $.Name }}Conds) {{ .Name }}Like(pattern string) XOOption {
+	return xoWhere({{ $col }}, "LIKE", pattern)
+}
+{{- end }}
+{{- if not .Col.NotNull }}
+
+// {{ .Name }}IsNull matches the rows whose {{ .Col.ColumnName }} is NULL.
+func ({{ $.Name }}Conds) {{ .Name }}IsNull() XOOption {
+	return xoWhere({{ $col }}, "IS NULL")
+}
+
+// {{ .Name }}IsNotNull matches the rows whose {{ .Col.ColumnName }} is not NULL.
+func ({{ $.Name }}Conds) {{ .Name }}IsNotNull() XOOption {
+	return xoWhere({{ $col }}, "IS NOT NULL")
+}
+{{- end }}
+{{- end }}
+{{- end }}
+
+// {{ pluralize .Name }}Where retrieves the rows from '{{ $table }}' matching all of
+// the {{ .Name }}Where conditions in opts, or all rows when there are none.
+{{- range $guard }}
+// Only the rows whose {{ .Col.ColumnName }} (the guard) is {{ goparamlist $guard false false }} are retrieved.
+{{- end }}
+{{- range $shard }}
+// Only the rows whose {{ .Col.ColumnName }} (the shard key) is {{ goparamlist $shard false false }} are retrieved.
+{{- end }}
+//
+// Results are ordered with the XOOrder option, and limited with the XOLimit
+// and XOOffset options.
+func {{ pluralize .Name }}Where({{ ctxparam }}db XODB{{ goparamlist $guard true true }}{{ goparamlist $shard true true }}, opts ...XOListOption) ([]*{{ .Name }}, error) {
+	{{- xooptions }}
+	{{- xoinstrument (print (pluralize .Name) "Where") .Table.TableName "SELECT" }}
+	o := xoListOptions(opts)
+
+	// selected columns
+	cols, _, err := (&{{ .Name }}{}).xoSelect(o.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// conditions
+	where, args := xoWhereConds(o.where)
+{{- range $guard }}
+	where = append(where, {{ colconst $ . }} + " = " + {{ nthparamgo "len(args)" }})
+	args = append(args, {{ sqlarg (goparamlist $guard false false) . }})
+{{- end }}
+{{- range $shard }}
+	where = append(where, {{ colconst $ . }} + " = " + {{ nthparamgo "len(args)" }})
+	args = append(args, {{ sqlarg (goparamlist $shard false false) . }})
+{{- end }}
+{{- if .SoftDeleteField }}
+	where = append(where, `{{ softdeletecond . }}`)
+{{- end }}
+
+	// sql query
+	sqlstr := `SELECT ` + cols + ` ` +
+		`FROM {{ $sqltable }}`
+	if len(where) != 0 {
+		sqlstr += ` WHERE ` + strings.Join(where, " AND ")
+	}
+
+	// apply options
+	order, err := xoOrderClause(o.OrderBy, xo{{ .Name }}OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	if order == "" && o.Limit > 0 {
+		order = ` ORDER BY {{ if .PrimaryKeyFields }}{{ colnames .PrimaryKeyFields }}{{ else }}{{ colnames .Fields }}{{ end }}`
+	}
+	sqlstr += order
+	if o.Limit > 0 {
+		sqlstr += ` ` + {{ limitclausego "len(args)" }}
+		args = append(args, o.Limit, o.Offset)
+	}
+
+	// run query
+	XOLog(sqlstr, args...)
+{{- if sqlx }}
+	res := []*{{ .Name }}{}
+	err = sqlx.{{ dbfn "Select" }}({{ ctxarg }}db, &res, sqlstr, args...)
+	if err != nil {
+		return nil, err
+	}
+{{- if .PrimaryKey }}
+
+	// set existence
+	for _, {{ $short }} := range res {
+		{{ $short }}._exists = true
+	}
+{{- end }}
+{{- else }}
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*{{ .Name }}{}
+	for q.Next() {
+		{{ $short }} := {{ .Name }}{
+		{{- if .PrimaryKey }}
+			_exists: true,
+		{{ end -}}
+		}
+
+		// scan
+		_, dest, _ := {{ $short }}.xoSelect(o.Columns)
+		err = q.Scan(dest...)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &{{ $short }})
+	}
+	if err = q.Err(); err != nil {
+		return nil, err
+	}
+{{- end }}
+
+	return res, nil
+}
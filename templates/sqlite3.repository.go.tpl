@@ -0,0 +1 @@
+postgres.repository.go.tpl
\ No newline at end of file
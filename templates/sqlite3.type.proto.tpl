@@ -0,0 +1 @@
+postgres.type.proto.tpl
\ No newline at end of file
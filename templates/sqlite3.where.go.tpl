@@ -0,0 +1 @@
+postgres.where.go.tpl
\ No newline at end of file
@@ -1,16 +1,1508 @@
+{{- $res := "sql.Result" }}{{ $rows := "*sql.Rows" }}{{ $row := "*sql.Row" }}
+{{- if .Pgx }}{{ $res = "pgconn.CommandTag" }}{{ $rows = "pgx.Rows" }}{{ $row = "pgx.Row" }}{{ end -}}
 // XODB is the common interface for database operations that can be used with
 // types from schema '{{ schema .Schema }}'.
 //
+{{- if .Pgx }}
+// This should work with github.com/jackc/pgx/v5.Conn, pgx.Tx and
+// github.com/jackc/pgx/v5/pgxpool.Pool.
+type XODB interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+}
+{{- else if .NoContext }}
+{{- if .Sqlx }}
+// This should work with github.com/jmoiron/sqlx.DB and sqlx.Tx.
+{{- else }}
 // This should work with database/sql.DB and database/sql.Tx.
+{{- end }}
 type XODB interface {
 	Exec(string, ...interface{}) (sql.Result, error)
 	Query(string, ...interface{}) (*sql.Rows, error)
 	QueryRow(string, ...interface{}) *sql.Row
+{{- if .Sqlx }}
+	Queryx(string, ...interface{}) (*sqlx.Rows, error)
+	QueryRowx(string, ...interface{}) *sqlx.Row
+{{- end }}
+}
+{{- else }}
+{{- if .Sqlx }}
+// This should work with github.com/jmoiron/sqlx.DB, sqlx.Tx and sqlx.Conn.
+{{- else }}
+// This should work with database/sql.DB, database/sql.Tx and database/sql.Conn,
+// as well as any wrapper providing the same methods (ie, for instrumentation).
+{{- end }}
+type XODB interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+{{- if .Sqlx }}
+	QueryxContext(context.Context, string, ...interface{}) (*sqlx.Rows, error)
+	QueryRowxContext(context.Context, string, ...interface{}) *sqlx.Row
+{{- end }}
+}
+{{- end }}
+{{- if not (or .Pgx .Sqlx) }}
+
+// verify the database/sql types implement XODB
+var (
+	_ XODB = (*sql.DB)(nil)
+	_ XODB = (*sql.Tx)(nil)
+{{- if not .NoContext }}
+	_ XODB = (*sql.Conn)(nil)
+{{- end }}
+)
+{{- end }}
+
+// XOTxBeginner is the interface for beginning the transactions used by
+// XOWithTx.
+{{- if .Pgx }}
+//
+// This should work with github.com/jackc/pgx/v5.Conn and
+// github.com/jackc/pgx/v5/pgxpool.Pool.
+type XOTxBeginner interface {
+	Begin(context.Context) (pgx.Tx, error)
+}
+{{- else if .Sqlx }}
+//
+// This should work with github.com/jmoiron/sqlx.DB{{ if not .NoContext }} and sqlx.Conn{{ end }}.
+type XOTxBeginner interface {
+{{- if .NoContext }}
+	Beginx() (*sqlx.Tx, error)
+{{- else }}
+	BeginTxx(context.Context, *sql.TxOptions) (*sqlx.Tx, error)
+{{- end }}
+}
+{{- else }}
+//
+// This should work with database/sql.DB{{ if not .NoContext }} and database/sql.Conn{{ end }}.
+type XOTxBeginner interface {
+{{- if .NoContext }}
+	Begin() (*sql.Tx, error)
+{{- else }}
+	BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+{{- end }}
+}
+{{- end }}
+
+// XOWithTx runs fn in a transaction begun with db. The transaction is committed
+// when fn returns nil, and is rolled back when fn returns an error or panics.
+func XOWithTx({{ ctxparam }}db XOTxBeginner, fn func(tx XODB) error) (err error) {
+{{- if .Pgx }}
+	tx, err := db.Begin(ctx)
+{{- else if .Sqlx }}
+	tx, err := db.{{ if .NoContext }}Beginx(){{ else }}BeginTxx(ctx, nil){{ end }}
+{{- else }}
+	tx, err := db.{{ if .NoContext }}Begin(){{ else }}BeginTx(ctx, nil){{ end }}
+{{- end }}
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback({{ if .Pgx }}ctx{{ end }})
+			panic(p)
+		}
+		if err != nil {
+			tx.Rollback({{ if .Pgx }}ctx{{ end }})
+			return
+		}
+		err = tx.Commit({{ if .Pgx }}ctx{{ end }})
+	}()
+
+	return fn(tx)
+}
+
+// xoRowsAffected returns the number of rows affected by a statement.
+{{- if .Pgx }}
+func xoRowsAffected(res pgconn.CommandTag) (int64, error) {
+	return res.RowsAffected(), nil
+}
+{{- else }}
+func xoRowsAffected(res sql.Result) (int64, error) {
+	return res.RowsAffected()
+}
+{{- end }}
+
+{{ if .Retry }}
+// XORetryable reports whether err is a transient failure after which the
+// statement or transaction can be retried: a MySQL deadlock (1213) or lock
+// wait timeout (1205), or a PostgreSQL serialization failure (40001) or
+// deadlock (40P01).
+var XORetryable = func(err error) bool {
+	var se interface{ SQLState() string }
+	if errors.As(err, &se) {
+		switch se.SQLState() {
+		case "40001", "40P01":
+			return true
+		}
+	}
+
+	// github.com/go-sql-driver/mysql.MySQLError has no method returning its
+	// number, so check the message
+	msg := err.Error()
+	return strings.Contains(msg, "Error 1213") || strings.Contains(msg, "Error 1205")
+}
+
+// XORetryPolicy is the policy for retrying the statements and transactions
+// failing with an error for which XORetryable returns true.
+type XORetryPolicy struct {
+	// Attempts is the maximum number of attempts, including the first.
+	// Values lower than 2 disable retrying.
+	Attempts int
+
+	// MinBackoff is the delay before the first retry, doubled for each
+	// following retry. A random jitter of up to half the delay is applied.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay before a retry. Zero means no maximum.
+	MaxBackoff time.Duration
+}
+
+// XODefaultRetryPolicy is the default XORetryPolicy.
+var XODefaultRetryPolicy = XORetryPolicy{
+	Attempts:   3,
+	MinBackoff: 10 * time.Millisecond,
+	MaxBackoff: time.Second,
+}
+
+// backoff returns the delay before the nth retry, starting at 0.
+func (p XORetryPolicy) backoff(n int) time.Duration {
+	d := p.MinBackoff << uint(n)
+	if p.MaxBackoff > 0 && (d <= 0 || d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	return d - time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// Do calls fn, retrying it with a backoff as long as it fails with an error
+// for which XORetryable returns true, up to p.Attempts times. The last error
+// is returned.
+func (p XORetryPolicy) Do({{ ctxparam }}fn func() error) error {
+	for i := 0; ; i++ {
+		err := fn()
+		if err == nil || i+1 >= p.Attempts || !XORetryable(err) {
+			return err
+		}
+{{- if .NoContext }}
+
+		time.Sleep(p.backoff(i))
+{{- else }}
+
+		t := time.NewTimer(p.backoff(i))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+{{- end }}
+	}
+}
+
+// XOWithTxRetry runs fn in a transaction with XOWithTx, retrying the whole
+// transaction according to p. As fn may be called more than once, it should
+// not have effects outside of the transaction.
+func XOWithTxRetry({{ ctxparam }}db XOTxBeginner, p XORetryPolicy, fn func(tx XODB) error) error {
+	return p.Do({{ ctxarg }}func() error {
+		return XOWithTx({{ ctxarg }}db, fn)
+	})
+}
+
+// XORetryDB is a XODB retrying the statements run with DB according to
+// Policy.
+//
+// A failed statement aborts its transaction, so XORetryDB should not wrap a
+// transaction; use XOWithTxRetry instead. {{ dbfn "QueryRow" }} is not retried,
+// as its error is only returned when scanning the row: the statements run with
+// it (ie, the PostgreSQL Insert, Update and Upsert methods, RETURNING their
+// columns) must be retried with XOWithTxRetry.
+type XORetryDB struct {
+	DB     XODB
+	Policy XORetryPolicy
+}
+
+// NewXORetryDB creates a XORetryDB retrying the statements run with db
+// according to p.
+func NewXORetryDB(db XODB, p XORetryPolicy) *XORetryDB {
+	return &XORetryDB{DB: db, Policy: p}
+}
+
+// {{ dbfn "Exec" }} executes query, retrying it according to the policy.
+func (r *XORetryDB) {{ dbfn "Exec" }}({{ ctxparam }}query string, args ...interface{}) (res {{ $res }}, err error) {
+	err = r.Policy.Do({{ ctxarg }}func() error {
+		res, err = r.DB.{{ dbfn "Exec" }}({{ ctxarg }}query, args...)
+		return err
+	})
+
+	return res, err
+}
+
+// {{ dbfn "Query" }} runs query, retrying it according to the policy.
+func (r *XORetryDB) {{ dbfn "Query" }}({{ ctxparam }}query string, args ...interface{}) (q {{ $rows }}, err error) {
+	err = r.Policy.Do({{ ctxarg }}func() error {
+		q, err = r.DB.{{ dbfn "Query" }}({{ ctxarg }}query, args...)
+		return err
+	})
+
+	return q, err
+}
+
+// {{ dbfn "QueryRow" }} runs query.
+func (r *XORetryDB) {{ dbfn "QueryRow" }}({{ ctxparam }}query string, args ...interface{}) {{ $row }} {
+	return r.DB.{{ dbfn "QueryRow" }}({{ ctxarg }}query, args...)
+}
+{{- if .Sqlx }}
+
+// {{ dbfn "Queryx" }} runs query, retrying it according to the policy.
+func (r *XORetryDB) {{ dbfn "Queryx" }}({{ ctxparam }}query string, args ...interface{}) (q *sqlx.Rows, err error) {
+	err = r.Policy.Do({{ ctxarg }}func() error {
+		q, err = r.DB.{{ dbfn "Queryx" }}({{ ctxarg }}query, args...)
+		return err
+	})
+
+	return q, err
+}
+
+// {{ dbfn "QueryRowx" }} runs query.
+func (r *XORetryDB) {{ dbfn "QueryRowx" }}({{ ctxparam }}query string, args ...interface{}) *sqlx.Row {
+	return r.DB.{{ dbfn "QueryRowx" }}({{ ctxarg }}query, args...)
+}
+{{- end }}
+
+// verify XORetryDB implements XODB
+var _ XODB = (*XORetryDB)(nil)
+
+{{ end -}}
+{{- if .ReadWriteSplit }}
+// XOReadWriter is a XODB having separate handles for reads and writes. Its
+// XODB methods run the statements with the writer.
+type XOReadWriter interface {
+	XODB
+
+	// Reader returns the handle of the generated read funcs (ie, the GetBy
+	// and ListBy funcs).
+	Reader() XODB
+
+	// Writer returns the handle of all other statements (ie, the inserts,
+	// updates and deletes).
+	Writer() XODB
+}
+
+// XOReadWriteDB is a XOReadWriter running the statements of the generated read
+// funcs with Read (ie, a read replica), and all other statements with Write.
+//
+// Reads may not see the writes not yet replicated to Read; pass Write to the
+// read funcs needing them, or use a transaction. Wrappers (ie, XOLogDB) should
+// wrap Read and Write, as the reads of a wrapped XOReadWriteDB go to Write.
+type XOReadWriteDB struct {
+	Read  XODB
+	Write XODB
+}
+
+// Reader returns Read.
+func (rw *XOReadWriteDB) Reader() XODB {
+	return rw.Read
+}
+
+// Writer returns Write.
+func (rw *XOReadWriteDB) Writer() XODB {
+	return rw.Write
+}
+
+// {{ dbfn "Exec" }} executes query with Write.
+func (rw *XOReadWriteDB) {{ dbfn "Exec" }}({{ ctxparam }}query string, args ...interface{}) ({{ $res }}, error) {
+	return rw.Write.{{ dbfn "Exec" }}({{ ctxarg }}query, args...)
+}
+
+// {{ dbfn "Query" }} runs query with Write.
+func (rw *XOReadWriteDB) {{ dbfn "Query" }}({{ ctxparam }}query string, args ...interface{}) ({{ $rows }}, error) {
+	return rw.Write.{{ dbfn "Query" }}({{ ctxarg }}query, args...)
+}
+
+// {{ dbfn "QueryRow" }} runs query with Write.
+func (rw *XOReadWriteDB) {{ dbfn "QueryRow" }}({{ ctxparam }}query string, args ...interface{}) {{ $row }} {
+	return rw.Write.{{ dbfn "QueryRow" }}({{ ctxarg }}query, args...)
+}
+{{- if .Sqlx }}
+
+// {{ dbfn "Queryx" }} runs query with Write.
+func (rw *XOReadWriteDB) {{ dbfn "Queryx" }}({{ ctxparam }}query string, args ...interface{}) (*sqlx.Rows, error) {
+	return rw.Write.{{ dbfn "Queryx" }}({{ ctxarg }}query, args...)
+}
+
+// {{ dbfn "QueryRowx" }} runs query with Write.
+func (rw *XOReadWriteDB) {{ dbfn "QueryRowx" }}({{ ctxparam }}query string, args ...interface{}) *sqlx.Row {
+	return rw.Write.{{ dbfn "QueryRowx" }}({{ ctxarg }}query, args...)
+}
+{{- end }}
+
+// verify XOReadWriteDB implements XOReadWriter
+var _ XOReadWriter = (*XOReadWriteDB)(nil)
+
+// xoReader returns the handle running the statements of a generated read func
+// with db: the reader of a XOReadWriter, or db itself. The locking reads (ie,
+// with the Lock option) run with the writer.
+func xoReader(db XODB) XODB {
+	switch d := db.(type) {
+	case XOReadWriter:
+		return d.Reader()
+	case *xoOptionsDB:
+		if d.o.Lock == "" {
+			return &xoOptionsDB{db: xoReader(d.db), o: d.o}
+		}
+	}
+
+	return db
+}
+
+{{ end -}}
+{{- if .TableNameHook }}
+// XOTableName returns the name of table used in the statements run by the
+// generated funcs (ie, "tenant_1_users" for "users"). The name is used as is,
+// and must not come from untrusted input.
+{{- if .NoContext }}
+var XOTableName = func(table string) string {
+{{- else }}
+var XOTableName = func(ctx context.Context, table string) string {
+{{- end }}
+	return table
+}
+
+{{ end -}}
+{{- if .SchemaHook }}
+{{- if .NoContext }}
+// XOSchemaName returns the schema of the tables used in the statements run by
+// the generated funcs (ie, "tenant_1" for "public"). The name is used as is,
+// and must not come from untrusted input.
+var XOSchemaName = func(schema string) string {
+	return schema
+}
+{{- else }}
+// xoSchemaKey is the context key of the schema set with XOWithSchema.
+type xoSchemaKey struct{}
+
+// XOWithSchema returns a copy of ctx with which the generated funcs use the
+// tables of schema.
+func XOWithSchema(ctx context.Context, schema string) context.Context {
+	return context.WithValue(ctx, xoSchemaKey{}, schema)
+}
+
+// XOSchemaName returns the schema of the tables used in the statements run by
+// the generated funcs with ctx: the schema set with XOWithSchema, or schema
+// when none is set. The name is used as is, and must not come from untrusted
+// input.
+var XOSchemaName = func(ctx context.Context, schema string) string {
+	if s, ok := ctx.Value(xoSchemaKey{}).(string); ok && s != "" {
+		return s
+	}
+
+	return schema
+}
+{{- end }}
+
+{{ end -}}
+{{- if .Otel }}
+// XOTracer is the tracer of the spans started by the generated funcs.
+var XOTracer trace.Tracer = otel.Tracer("xo")
+
+// xoStartSpan starts the span of the generated func name, running op (ie,
+// "SELECT") on table.
+func xoStartSpan(ctx context.Context, name, table, op string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.operation", op),
+	}
+	if table != "" {
+		attrs = append(attrs, attribute.String("db.sql.table", table))
+	}
+
+	return XOTracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+{{ end -}}
+{{- if .Metrics }}
+// XOQueryHook is the instrumentation hook called around the statements run by
+// the generated funcs, with the table and the operation (ie, "SELECT") of the
+// func. The table is empty for custom queries and stored procedures.
+type XOQueryHook interface {
+	// OnQueryStart is called before running a statement.
+	OnQueryStart(table, op string)
+
+	// OnQueryEnd is called after running a statement, with its duration and
+	// error.{{ if .Pgx }} The errors of QueryRow are only returned when scanning
+	// the row, and are not reported.{{ end }}
+	OnQueryEnd(table, op string, d time.Duration, err error)
+}
+
+// XOHook is the XOQueryHook called by the generated funcs, or nil for none.
+var XOHook XOQueryHook
+
+// xoHookedDB is a XODB calling a XOQueryHook around the statements run with
+// db.
+type xoHookedDB struct {
+	db        XODB
+	hook      XOQueryHook
+	table, op string
 }
 
+// xoHookDB wraps db to call XOHook around its statements, when set.
+func xoHookDB(db XODB, table, op string) XODB {
+	if XOHook == nil {
+		return db
+	}
+
+	return &xoHookedDB{db: db, hook: XOHook, table: table, op: op}
+}
+
+// {{ dbfn "Exec" }} executes query, calling the hook.
+func (h *xoHookedDB) {{ dbfn "Exec" }}({{ ctxparam }}query string, args ...interface{}) ({{ $res }}, error) {
+	h.hook.OnQueryStart(h.table, h.op)
+	start := time.Now()
+	res, err := h.db.{{ dbfn "Exec" }}({{ ctxarg }}query, args...)
+	h.hook.OnQueryEnd(h.table, h.op, time.Since(start), err)
+
+	return res, err
+}
+
+// {{ dbfn "Query" }} runs query, calling the hook.
+func (h *xoHookedDB) {{ dbfn "Query" }}({{ ctxparam }}query string, args ...interface{}) ({{ $rows }}, error) {
+	h.hook.OnQueryStart(h.table, h.op)
+	start := time.Now()
+	q, err := h.db.{{ dbfn "Query" }}({{ ctxarg }}query, args...)
+	h.hook.OnQueryEnd(h.table, h.op, time.Since(start), err)
+
+	return q, err
+}
+
+// {{ dbfn "QueryRow" }} runs query, calling the hook.
+func (h *xoHookedDB) {{ dbfn "QueryRow" }}({{ ctxparam }}query string, args ...interface{}) {{ $row }} {
+	h.hook.OnQueryStart(h.table, h.op)
+	start := time.Now()
+	row := h.db.{{ dbfn "QueryRow" }}({{ ctxarg }}query, args...)
+	h.hook.OnQueryEnd(h.table, h.op, time.Since(start), {{ if .Pgx }}nil{{ else }}row.Err(){{ end }})
+
+	return row
+}
+{{- if .Sqlx }}
+
+// {{ dbfn "Queryx" }} runs query, calling the hook.
+func (h *xoHookedDB) {{ dbfn "Queryx" }}({{ ctxparam }}query string, args ...interface{}) (*sqlx.Rows, error) {
+	h.hook.OnQueryStart(h.table, h.op)
+	start := time.Now()
+	q, err := h.db.{{ dbfn "Queryx" }}({{ ctxarg }}query, args...)
+	h.hook.OnQueryEnd(h.table, h.op, time.Since(start), err)
+
+	return q, err
+}
+
+// {{ dbfn "QueryRowx" }} runs query, calling the hook.
+func (h *xoHookedDB) {{ dbfn "QueryRowx" }}({{ ctxparam }}query string, args ...interface{}) *sqlx.Row {
+	h.hook.OnQueryStart(h.table, h.op)
+	start := time.Now()
+	row := h.db.{{ dbfn "QueryRowx" }}({{ ctxarg }}query, args...)
+	h.hook.OnQueryEnd(h.table, h.op, time.Since(start), row.Err())
+
+	return row
+}
+{{- end }}
+
+// XOPrometheusHook is a XOQueryHook recording the statements run by the
+// generated funcs with Prometheus metrics, labeled by table and op.
+type XOPrometheusHook struct {
+	// InFlight is the number of running statements.
+	InFlight *prometheus.GaugeVec
+
+	// Duration is the duration of the statements, in seconds.
+	Duration *prometheus.HistogramVec
+
+	// Errors is the number of failed statements.
+	Errors *prometheus.CounterVec
+}
+
+// NewXOPrometheusHook creates a XOPrometheusHook, registering its metrics
+// with reg. Set XOHook to the returned hook to record the metrics.
+func NewXOPrometheusHook(reg prometheus.Registerer) (*XOPrometheusHook, error) {
+	labels := []string{"table", "op"}
+	h := &XOPrometheusHook{
+		InFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "xo_queries_in_flight",
+			Help: "Number of running statements.",
+		}, labels),
+		Duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "xo_query_duration_seconds",
+			Help:    "Duration of the statements.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+		Errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "xo_query_errors_total",
+			Help: "Number of failed statements.",
+		}, labels),
+	}
+
+	for _, c := range []prometheus.Collector{h.InFlight, h.Duration, h.Errors} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// OnQueryStart satisfies the XOQueryHook interface.
+func (h *XOPrometheusHook) OnQueryStart(table, op string) {
+	h.InFlight.WithLabelValues(table, op).Inc()
+}
+
+// OnQueryEnd satisfies the XOQueryHook interface.
+func (h *XOPrometheusHook) OnQueryEnd(table, op string, d time.Duration, err error) {
+	h.InFlight.WithLabelValues(table, op).Dec()
+	h.Duration.WithLabelValues(table, op).Observe(d.Seconds())
+	if err != nil {
+		h.Errors.WithLabelValues(table, op).Inc()
+	}
+}
+
+// verify XOPrometheusHook implements XOQueryHook
+var _ XOQueryHook = (*XOPrometheusHook)(nil)
+
+{{ end -}}
+{{- if .StmtCache }}
+// XOPreparer is the interface for the database handles used by XOStmtCache.
+//
+// This should work with database/sql.DB{{ if not .NoContext }} and database/sql.Conn{{ end }}.
+type XOPreparer interface {
+	XODB
+	{{ dbfn "Prepare" }}({{ if not .NoContext }}context.Context, {{ end }}string) (*sql.Stmt, error)
+}
+
+// XOStmtCache is a XODB preparing each query once, and reusing the prepared
+// statement for later calls with the same query. Statements prepared with a
+// sql.DB are reused across the connections of its pool.
+//
+// Queries built at runtime (ie, by the batch funcs) are cached once per
+// distinct query. XOStmtCache is safe for concurrent use.
+type XOStmtCache struct {
+	db XOPreparer
+
+	mu    sync.RWMutex
+	stmts map[string]*sql.Stmt
+
+	hits, misses int64
+}
+
+// NewXOStmtCache creates a XOStmtCache preparing statements with db.
+func NewXOStmtCache(db XOPreparer) *XOStmtCache {
+	return &XOStmtCache{
+		db:    db,
+		stmts: map[string]*sql.Stmt{},
+	}
+}
+
+// stmt returns the prepared statement for query, preparing it when not
+// cached.
+func (c *XOStmtCache) stmt({{ ctxparam }}query string) (*sql.Stmt, error) {
+	c.mu.RLock()
+	s, ok := c.stmts[query]
+	c.mu.RUnlock()
+	if ok {
+		atomic.AddInt64(&c.hits, 1)
+		return s, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// check again, another call may have prepared it
+	if s, ok = c.stmts[query]; ok {
+		atomic.AddInt64(&c.hits, 1)
+		return s, nil
+	}
+
+	s, err := c.db.{{ dbfn "Prepare" }}({{ ctxarg }}query)
+	if err != nil {
+		return nil, err
+	}
+
+	atomic.AddInt64(&c.misses, 1)
+	c.stmts[query] = s
+
+	return s, nil
+}
+
+// {{ dbfn "Exec" }} executes query using its prepared statement.
+func (c *XOStmtCache) {{ dbfn "Exec" }}({{ ctxparam }}query string, args ...interface{}) (sql.Result, error) {
+	s, err := c.stmt({{ ctxarg }}query)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.{{ dbfn "Exec" }}({{ ctxarg }}args...)
+}
+
+// {{ dbfn "Query" }} runs query using its prepared statement.
+func (c *XOStmtCache) {{ dbfn "Query" }}({{ ctxparam }}query string, args ...interface{}) (*sql.Rows, error) {
+	s, err := c.stmt({{ ctxarg }}query)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.{{ dbfn "Query" }}({{ ctxarg }}args...)
+}
+
+// {{ dbfn "QueryRow" }} runs query using its prepared statement. When the
+// statement cannot be prepared, query is run without it, so that the error is
+// returned by the row.
+func (c *XOStmtCache) {{ dbfn "QueryRow" }}({{ ctxparam }}query string, args ...interface{}) *sql.Row {
+	s, err := c.stmt({{ ctxarg }}query)
+	if err != nil {
+		return c.db.{{ dbfn "QueryRow" }}({{ ctxarg }}query, args...)
+	}
+
+	return s.{{ dbfn "QueryRow" }}({{ ctxarg }}args...)
+}
+
+// Stats returns the number of calls that reused a cached statement (hits),
+// and that prepared a statement (misses).
+func (c *XOStmtCache) Stats() (hits, misses int64) {
+	return atomic.LoadInt64(&c.hits), atomic.LoadInt64(&c.misses)
+}
+
+// Close closes and removes the cached statements.
+func (c *XOStmtCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var err error
+	for query, s := range c.stmts {
+		if e := s.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(c.stmts, query)
+	}
+
+	return err
+}
+
+// verify XOStmtCache implements XODB
+var _ XODB = (*XOStmtCache)(nil)
+
+{{ end -}}
+{{- if .Generics }}
+// xoRow is the interface for scanning a row.
+type xoRow interface {
+	Scan(...interface{}) error
+}
+
+// xoScanner is the constraint for the generated types scanned by the generic
+// query funcs.
+type xoScanner[T any] interface {
+	*T
+	xoScan(xoRow) error
+}
+
+// xoQueryOne runs the query, returning the first row as a T.
+func xoQueryOne[T any, P xoScanner[T]]({{ ctxparam }}db XODB, sqlstr string, args ...interface{}) (*T, error) {
+	v := P(new(T))
+	err := v.xoScan(db.{{ dbfn "QueryRow" }}({{ ctxarg }}sqlstr, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	return (*T)(v), nil
+}
+
+// xoQueryAll runs the query, returning the rows as T.
+func xoQueryAll[T any, P xoScanner[T]]({{ ctxparam }}db XODB, sqlstr string, args ...interface{}) ([]*T, error) {
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+
+	// load results
+	res := []*T{}
+	for q.Next() {
+		v := P(new(T))
+		err = v.xoScan(q)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, (*T)(v))
+	}
+
+	return res, q.Err()
+}
+
+// xoQueryEach runs the query, calling fn with each row as a T. Iteration stops
+// at the first error returned by fn, which is returned.
+func xoQueryEach[T any, P xoScanner[T]]({{ ctxparam }}db XODB, fn func(*T) error, sqlstr string, args ...interface{}) error {
+	q, err := db.{{ dbfn "Query" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+
+	// process results
+	for q.Next() {
+		v := P(new(T))
+		err = v.xoScan(q)
+		if err != nil {
+			return err
+		}
+
+		err = fn((*T)(v))
+		if err != nil {
+			return err
+		}
+	}
+
+	return q.Err()
+}
+{{- if not .Pgx }}
+
+// xoExecLastInsertID executes the query, returning the id generated by the
+// database for the inserted row.
+func xoExecLastInsertID({{ ctxparam }}db XODB, sqlstr string, args ...interface{}) (int64, error) {
+	res, err := db.{{ dbfn "Exec" }}({{ ctxarg }}sqlstr, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+{{- end }}
+
+{{ end -}}
+{{- if .BinaryUUID }}
+// xoNullUUIDBytes returns the query argument of the nullable uuid of a
+// BINARY(16) column.
+func xoNullUUIDBytes(u uuid.NullUUID) interface{} {
+	if !u.Valid {
+		return nil
+	}
+
+	return u.UUID[:]
+}
+
+{{ end -}}
+{{- if .TimeLocation }}
+// XOLocation is the location the times scanned by the generated funcs are
+// normalized to.
+var XOLocation = xoLoadLocation({{ printf "%q" .TimeLocation }})
+
+// xoLoadLocation loads the location named name.
+func xoLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return loc
+}
+
+// xoTime scans a time into t, and its validity into valid for the nullable
+// columns, normalized to XOLocation. The wall clock of the times of the
+// columns without a time zone (ie, DATETIME) is kept when wall is true, and
+// the times of the columns with one (ie, TIMESTAMP) are converted otherwise.
+type xoTime struct {
+	t     *time.Time
+	valid *bool
+	wall  bool
+}
+
+// Scan satisfies the sql.Scanner interface.
+func (x xoTime) Scan(v interface{}) error {
+	var t time.Time
+	switch v := v.(type) {
+	case nil:
+		if x.valid == nil {
+			return errors.New("cannot scan NULL into a time")
+		}
+		*x.t, *x.valid = time.Time{}, false
+		return nil
+	case time.Time:
+		t = v
+		if x.wall {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), XOLocation)
+		}
+	case []byte:
+		return x.Scan(string(v))
+	case string:
+		// times are returned as text without a time zone when the driver
+		// does not parse them (ie, MySQL without parseTime)
+		var err error
+		for _, layout := range []string{"2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999", "2006-01-02"} {
+			if t, err = time.ParseInLocation(layout, v, XOLocation); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("cannot scan %q into a time", v)
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into a time", v)
+	}
+
+	*x.t = t.In(XOLocation)
+	if x.valid != nil {
+		*x.valid = true
+	}
+
+	return nil
+}
+
+{{ end -}}
 // XOLog provides the log func used by generated queries.
+//
+// XOLog is global, and is not passed the duration and error of the queries;
+// see XOLogDB for logging the queries run with a database handle.
 var XOLog = func(string, ...interface{}) { }
 
+// XOLogger is the interface for logging the statements run with a XOLogDB.
+type XOLogger interface {
+	// LogQuery logs the statement query run with args, its duration and its
+	// error.{{ if .Pgx }} The errors of QueryRow are only returned when
+	// scanning the row, and are not logged.{{ end }}
+	LogQuery({{ ctxparam }}query string, args []interface{}, d time.Duration, err error)
+}
+
+// XOLoggerFunc is a func implementing XOLogger.
+type XOLoggerFunc func({{ ctxparam }}query string, args []interface{}, d time.Duration, err error)
+
+// LogQuery satisfies the XOLogger interface.
+func (f XOLoggerFunc) LogQuery({{ ctxparam }}query string, args []interface{}, d time.Duration, err error) {
+	f({{ ctxarg }}query, args, d, err)
+}
+
+// XOLogDB is a XODB logging the statements run with DB to Logger.
+//
+// As the logger is set per database handle, each handle (ie, of a tenant) can
+// use its own logger, such as one adding attributes to the logged statements.
+type XOLogDB struct {
+	DB     XODB
+	Logger XOLogger
+}
+
+// NewXOLogDB creates a XOLogDB logging the statements run with db to l.
+func NewXOLogDB(db XODB, l XOLogger) *XOLogDB {
+	return &XOLogDB{DB: db, Logger: l}
+}
+
+// {{ dbfn "Exec" }} executes query, logging it.
+func (l *XOLogDB) {{ dbfn "Exec" }}({{ ctxparam }}query string, args ...interface{}) ({{ $res }}, error) {
+	start := time.Now()
+	res, err := l.DB.{{ dbfn "Exec" }}({{ ctxarg }}query, args...)
+	l.Logger.LogQuery({{ ctxarg }}query, args, time.Since(start), err)
+
+	return res, err
+}
+
+// {{ dbfn "Query" }} runs query, logging it.
+func (l *XOLogDB) {{ dbfn "Query" }}({{ ctxparam }}query string, args ...interface{}) ({{ $rows }}, error) {
+	start := time.Now()
+	q, err := l.DB.{{ dbfn "Query" }}({{ ctxarg }}query, args...)
+	l.Logger.LogQuery({{ ctxarg }}query, args, time.Since(start), err)
+
+	return q, err
+}
+
+// {{ dbfn "QueryRow" }} runs query, logging it.
+func (l *XOLogDB) {{ dbfn "QueryRow" }}({{ ctxparam }}query string, args ...interface{}) {{ $row }} {
+	start := time.Now()
+	row := l.DB.{{ dbfn "QueryRow" }}({{ ctxarg }}query, args...)
+	l.Logger.LogQuery({{ ctxarg }}query, args, time.Since(start), {{ if .Pgx }}nil{{ else }}row.Err(){{ end }})
+
+	return row
+}
+{{- if .Sqlx }}
+
+// {{ dbfn "Queryx" }} runs query, logging it.
+func (l *XOLogDB) {{ dbfn "Queryx" }}({{ ctxparam }}query string, args ...interface{}) (*sqlx.Rows, error) {
+	start := time.Now()
+	q, err := l.DB.{{ dbfn "Queryx" }}({{ ctxarg }}query, args...)
+	l.Logger.LogQuery({{ ctxarg }}query, args, time.Since(start), err)
+
+	return q, err
+}
+
+// {{ dbfn "QueryRowx" }} runs query, logging it.
+func (l *XOLogDB) {{ dbfn "QueryRowx" }}({{ ctxparam }}query string, args ...interface{}) *sqlx.Row {
+	start := time.Now()
+	row := l.DB.{{ dbfn "QueryRowx" }}({{ ctxarg }}query, args...)
+	l.Logger.LogQuery({{ ctxarg }}query, args, time.Since(start), row.Err())
+
+	return row
+}
+{{- end }}
+
+// verify XOLogDB implements XODB
+var _ XODB = (*XOLogDB)(nil)
+
+// ErrStaleRow is returned by the generated Update and Delete methods of types
+// with a version field when the row was changed or deleted since it was
+// loaded.
+var ErrStaleRow = errors.New("stale row")
+
+// XOBatchSize is the maximum number of rows written by a single statement in
+// the generated batch funcs.
+var XOBatchSize = {{ .BatchSize }}
+
+// The XO*Hook interfaces are optional lifecycle hooks called by the generated
+// Insert, Update and Delete methods when implemented by a type (ie, in a
+// separate, non-generated file). An error returned by a hook is returned by the
+// calling method; errors from Before hooks abort the operation.
+
+// XOBeforeInsertHook is implemented by types to be called before being inserted.
+type XOBeforeInsertHook interface {
+	BeforeInsert({{ ctxparam }}db XODB) error
+}
+
+// XOAfterInsertHook is implemented by types to be called after being inserted.
+type XOAfterInsertHook interface {
+	AfterInsert({{ ctxparam }}db XODB) error
+}
+
+// XOBeforeUpdateHook is implemented by types to be called before being updated.
+type XOBeforeUpdateHook interface {
+	BeforeUpdate({{ ctxparam }}db XODB) error
+}
+
+// XOAfterUpdateHook is implemented by types to be called after being updated.
+type XOAfterUpdateHook interface {
+	AfterUpdate({{ ctxparam }}db XODB) error
+}
+
+// XOBeforeDeleteHook is implemented by types to be called before being deleted.
+type XOBeforeDeleteHook interface {
+	BeforeDelete({{ ctxparam }}db XODB) error
+}
+
+// XOAfterDeleteHook is implemented by types to be called after being deleted.
+type XOAfterDeleteHook interface {
+	AfterDelete({{ ctxparam }}db XODB) error
+}
+
+// xoBeforeInsert calls the BeforeInsert hook of v, if implemented.
+func xoBeforeInsert({{ ctxparam }}db XODB, v interface{}) error {
+	if h, ok := v.(XOBeforeInsertHook); ok {
+		return h.BeforeInsert({{ ctxarg }}db)
+	}
+
+	return nil
+}
+
+// xoAfterInsert calls the AfterInsert hook of v, if implemented.
+func xoAfterInsert({{ ctxparam }}db XODB, v interface{}) error {
+	if h, ok := v.(XOAfterInsertHook); ok {
+		return h.AfterInsert({{ ctxarg }}db)
+	}
+
+	return nil
+}
+
+// xoBeforeUpdate calls the BeforeUpdate hook of v, if implemented.
+func xoBeforeUpdate({{ ctxparam }}db XODB, v interface{}) error {
+	if h, ok := v.(XOBeforeUpdateHook); ok {
+		return h.BeforeUpdate({{ ctxarg }}db)
+	}
+
+	return nil
+}
+
+// xoAfterUpdate calls the AfterUpdate hook of v, if implemented.
+func xoAfterUpdate({{ ctxparam }}db XODB, v interface{}) error {
+	if h, ok := v.(XOAfterUpdateHook); ok {
+		return h.AfterUpdate({{ ctxarg }}db)
+	}
+
+	return nil
+}
+
+// xoBeforeDelete calls the BeforeDelete hook of v, if implemented.
+func xoBeforeDelete({{ ctxparam }}db XODB, v interface{}) error {
+	if h, ok := v.(XOBeforeDeleteHook); ok {
+		return h.BeforeDelete({{ ctxarg }}db)
+	}
+
+	return nil
+}
+
+// xoAfterDelete calls the AfterDelete hook of v, if implemented.
+func xoAfterDelete({{ ctxparam }}db XODB, v interface{}) error {
+	if h, ok := v.(XOAfterDeleteHook); ok {
+		return h.AfterDelete({{ ctxarg }}db)
+	}
+
+	return nil
+}
+
+// XOTable is the interface implemented by the generated types, describing the
+// table they are a row of (ie, for bulk loaders, exporters or admin UIs).
+type XOTable interface {
+	TableName() string
+	Columns() []string
+	PrimaryKeyColumns() []string
+	Values() []interface{}
+	Pointers() []interface{}
+}
+
+// XOOptions are the per-call options of the generated funcs.
+type XOOptions struct {
+	// Limit is the maximum number of rows to return. Zero means no limit.
+	// Only applied by the list funcs.
+	Limit int
+
+	// Offset is the number of rows to skip. It is only applied when Limit is
+	// set. Only applied by the list funcs.
+	Offset int
+{{- if not .NoContext }}
+
+	// Timeout is the timeout of the call. Zero means no timeout.
+	Timeout time.Duration
+{{- end }}
+
+	// Comment is a comment prepended to the statements (ie, to identify them
+	// in the database logs).
+	Comment string
+
+	// Lock is the locking clause appended as is to the SELECT statements
+	// (ie, "FOR UPDATE"). The database may reject it for some statements,
+	// such as the ones with aggregates.
+	Lock string
+
+	// Hint are the optimizer hints put in a /*+ */ comment after the first
+	// keyword of the statements (ie, "MAX_EXECUTION_TIME(1000)").
+	Hint string
+
+	// IndexHint is the index hint put after the table name of the FROM
+	// clause of the SELECT statements (ie, MySQL's "USE INDEX (books_isbn_idx)").
+	IndexHint string
+
+	// Columns are the columns selected by the index funcs, leaving the
+	// fields of the other columns zero. Empty means all columns.
+	Columns []string
+
+	// OrderBy are the terms ordering the rows, as the generated *OrderBy*
+	// constants. Empty means the primary key when Limit is set, or no
+	// particular order. Only applied by the list funcs.
+	OrderBy []XOOrderBy
+
+	// where are the conditions set by the generated *Where values. Only
+	// applied by the *Where list funcs.
+	where []xoCondition
+	// params are the values of the custom query params with a default, set
+	// by the generated *With* options, keyed by query func and param name.
+	params map[string]interface{}
+}
+
+// XOOption sets a per-call option for the generated funcs.
+type XOOption func(*XOOptions)
+
+// XOListOptions are the options for the generated list funcs.
+type XOListOptions = XOOptions
+
+// XOListOption sets an option for the generated list funcs.
+type XOListOption = XOOption
+
+// XOLimit limits the rows returned by a list func to n.
+func XOLimit(n int) XOListOption {
+	return func(o *XOListOptions) {
+		o.Limit = n
+	}
+}
+
+// XOOffset skips the first n rows returned by a list func.
+func XOOffset(n int) XOListOption {
+	return func(o *XOListOptions) {
+		o.Offset = n
+	}
+}
+
+{{- if not .NoContext }}
+
+// XOTimeout sets the timeout of a call to d.
+func XOTimeout(d time.Duration) XOOption {
+	return func(o *XOOptions) {
+		o.Timeout = d
+	}
+}
+{{- end }}
+
+// XOComment prepends the comment c to the statements of a call.
+func XOComment(c string) XOOption {
+	return func(o *XOOptions) {
+		o.Comment = c
+	}
+}
+
+// XOLock appends the locking clause (ie, "FOR UPDATE") to the SELECT
+// statements of a call.
+func XOLock(clause string) XOOption {
+	return func(o *XOOptions) {
+		o.Lock = clause
+	}
+}
+
+{{- if lockclause "update" }}
+
+// XOForUpdate locks the rows read by a call until the end of the transaction
+// of its XODB (ie, in XOWithTx), appending {{ lockclause "update" }} to its SELECT
+// statements.
+func XOForUpdate() XOOption {
+	return XOLock({{ printf "%q" (lockclause "update") }})
+}
+{{- end }}
+{{- if lockclause "share" }}
+
+// XOForShare locks the rows read by a call against writes until the end of
+// the transaction of its XODB, appending {{ lockclause "share" }} to its SELECT
+// statements.
+func XOForShare() XOOption {
+	return XOLock({{ printf "%q" (lockclause "share") }})
+}
+{{- end }}
+
+// XOHint puts the optimizer hints hint (ie, "MAX_EXECUTION_TIME(1000)") in a
+// /*+ */ comment after the first keyword of the statements of a call.
+func XOHint(hint string) XOOption {
+	return func(o *XOOptions) {
+		o.Hint = hint
+	}
+}
+
+// XOIndexHint puts the index hint hint (ie, "USE INDEX (books_isbn_idx)")
+// after the table name of the FROM clause of the SELECT statements of a call.
+func XOIndexHint(hint string) XOOption {
+	return func(o *XOOptions) {
+		o.IndexHint = hint
+	}
+}
+
+// XOSelectColumns selects only the columns cols (ie, "id", "email") in an
+// index func, leaving the fields of the other columns zero.
+func XOSelectColumns(cols ...string) XOOption {
+	return func(o *XOOptions) {
+		o.Columns = cols
+	}
+}
+
+// XOOrderBy is an ORDER BY term of the list funcs, one of the generated
+// *OrderBy* constants (ie, BookOrderByTitleDesc).
+type XOOrderBy string
+
+// XOOrder orders the rows returned by a list func by terms.
+func XOOrder(terms ...XOOrderBy) XOListOption {
+	return func(o *XOListOptions) {
+		o.OrderBy = terms
+	}
+}
+
+// xoOrderClause returns the ORDER BY clause of terms, or an empty string when
+// terms is empty. Only the terms in allowed (the constants of the queried
+// table) are accepted.
+func xoOrderClause(terms []XOOrderBy, allowed map[XOOrderBy]bool) (string, error) {
+	if len(terms) == 0 {
+		return "", nil
+	}
+
+	s := make([]string, len(terms))
+	for i, t := range terms {
+		if !allowed[t] {
+			return "", fmt.Errorf("unknown order by %q", string(t))
+		}
+		s[i] = string(t)
+	}
+
+	return " ORDER BY " + strings.Join(s, ", "), nil
+}
+
+// xoCondition is a condition on a column of the rows returned by a generated
+// *Where list func.
+type xoCondition struct {
+	col  string
+	op   string
+	args []interface{}
+}
+
+// xoWhere returns the option adding the condition on col to a generated
+// *Where list func.
+func xoWhere(col, op string, args ...interface{}) XOOption {
+	return func(o *XOOptions) {
+		o.where = append(o.where, xoCondition{col: col, op: op, args: args})
+	}
+}
+
+// xoWhereConds returns the SQL of the conditions conds, and their args.
+func xoWhereConds(conds []xoCondition) ([]string, []interface{}) {
+	where := make([]string, 0, len(conds))
+	args := make([]interface{}, 0, len(conds))
+	for _, c := range conds {
+		switch {
+		case c.op == "IN" && len(c.args) == 0:
+			where = append(where, "1 = 0")
+		case c.op == "IN":
+			p := make([]string, len(c.args))
+			for i := range c.args {
+				p[i] = {{ nthparamgo "len(args)+i" }}
+			}
+			where = append(where, c.col+" IN ("+strings.Join(p, ", ")+")")
+			args = append(args, c.args...)
+		case len(c.args) == 0:
+			where = append(where, c.col+" "+c.op)
+		default:
+			where = append(where, c.col+" "+c.op+" "+{{ nthparamgo "len(args)" }})
+			args = append(args, c.args...)
+		}
+	}
+
+	return where, args
+}
+
+// xoParams returns the n comma separated placeholders of the elements of a
+// slice param of a custom query, starting at position i, or NULL when n is 0
+// (ie, matching no rows with "id IN (NULL)").
+func xoParams(i, n int) string {
+	if n == 0 {
+		return "NULL"
+	}
+
+	p := make([]string, n)
+	for j := range p {
+		p[j] = {{ nthparamgo "i+j" }}
+	}
+
+	return strings.Join(p, ", ")
+}
+
+// xoScanMaps scans the rows of a custom query as maps keyed by column name,
+// returning the columns in order along with the maps. The []byte values are
+// returned as strings.
+func xoScanMaps(q {{ if .Pgx }}pgx.Rows{{ else }}*sql.Rows{{ end }}) ([]string, []map[string]interface{}, error) {
+{{- if .Pgx }}
+	var cols []string
+	for _, fd := range q.FieldDescriptions() {
+		cols = append(cols, fd.Name)
+	}
+{{- else }}
+	cols, err := q.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+{{- end }}
+
+	// load results
+	res := []map[string]interface{}{}
+	for q.Next() {
+{{- if .Pgx }}
+		vals, err := q.Values()
+		if err != nil {
+			return nil, nil, err
+		}
+{{- else }}
+		vals := make([]interface{}, len(cols))
+		dest := make([]interface{}, len(cols))
+		for i := range vals {
+			dest[i] = &vals[i]
+		}
+
+		// scan
+		err = q.Scan(dest...)
+		if err != nil {
+			return nil, nil, err
+		}
+{{- end }}
+
+		m := make(map[string]interface{}, len(cols))
+		for i, c := range cols {
+			if b, ok := vals[i].([]byte); ok {
+				vals[i] = string(b)
+			}
+			m[c] = vals[i]
+		}
+		res = append(res, m)
+	}
+	if err := q.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return cols, res, nil
+}
+
+// xoIsZero returns whether v is the zero value of its type, excluding an
+// optional section of a custom query.
+func xoIsZero(v interface{}) bool {
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+// xoParam returns the XOOption setting the value v of the param key (ie,
+// "AuthorsByName.limit") of a custom query with a default.
+func xoParam(key string, v interface{}) XOOption {
+	return func(o *XOOptions) {
+		if o.params == nil {
+			o.params = map[string]interface{}{}
+		}
+		o.params[key] = v
+	}
+}
+
+// xoParamOption sets dest, the pointer to a param of a custom query with a
+// default, to the value of the param key set in opts, if any.
+func xoParamOption(opts []XOOption, key string, dest interface{}) {
+	if v, ok := xoListOptions(opts).params[key]; ok {
+		reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(v))
+	}
+}
+
+// xoCheckInterpolated checks the value v of the interpolated param name of a
+// custom query, returning an error when v is not one of the allowed values.
+func xoCheckInterpolated(name, v string, allowed ...string) error {
+	for _, a := range allowed {
+		if v == a {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("interpolated param %s: %q is not allowed", name, v)
+}
+
+// xoCheckLimit checks the value v of the LIMIT or OFFSET param name of a custom
+// query is non-negative once bound as an int64.
+func xoCheckLimit(name string, v uint64) error {
+	if int64(v) < 0 {
+		return fmt.Errorf("limit param %s: %d is out of range", name, v)
+	}
+
+	return nil
+}
+
+// xoQuoteIdent quotes the identifier s of an interpolated param of a custom
+// query, escaping its quotes.
+func xoQuoteIdent(s string) string {
+	return {{ quoteident }}
+}
+
+{{ if .ProtoServer }}
+// xoStatus returns the gRPC status error of err returned by a generated func
+// to the servers, with the NotFound code when no row was found.
+func xoStatus(err error) error {
+{{- if .Pgx }}
+	if errors.Is(err, pgx.ErrNoRows) {
+{{- else }}
+	if errors.Is(err, sql.ErrNoRows) {
+{{- end }}
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
+
+{{ end -}}
+// xoListOptions builds the XOListOptions from opts.
+func xoListOptions(opts []XOListOption) XOListOptions {
+	var o XOListOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// xoApplyOptions applies opts to a call of a generated func, returning the
+{{- if .NoContext }}
+// XODB to use.
+func xoApplyOptions(db XODB, opts []XOOption) XODB {
+	o := xoListOptions(opts)
+	if o.Comment != "" || o.Lock != "" || o.Hint != "" || o.IndexHint != "" {
+		db = &xoOptionsDB{db: db, o: o}
+	}
+
+	return db
+}
+{{- else }}
+// context and XODB to use, and the func releasing the context.
+func xoApplyOptions(ctx context.Context, db XODB, opts []XOOption) (context.Context, XODB, context.CancelFunc) {
+	o := xoListOptions(opts)
+	cancel := func() {}
+	if o.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+	}
+	if o.Comment != "" || o.Lock != "" || o.Hint != "" || o.IndexHint != "" {
+		db = &xoOptionsDB{db: db, o: o}
+	}
+
+	return ctx, db, cancel
+}
+{{- end }}
+
+// xoOptionsDB is a XODB applying the Comment, Lock, Hint and IndexHint options
+// to the statements run with db.
+type xoOptionsDB struct {
+	db XODB
+	o  XOOptions
+}
+
+// query returns query with the options applied.
+func (d *xoOptionsDB) query(query string) string {
+	if d.o.Lock != "" && strings.HasPrefix(query, "SELECT") {
+		query += " " + d.o.Lock
+	}
+	if d.o.IndexHint != "" && strings.HasPrefix(query, "SELECT") {
+		if i := strings.Index(query, " FROM "); i != -1 {
+			i += len(" FROM ")
+			if n := strings.IndexByte(query[i:], ' '); n != -1 {
+				i += n
+			} else {
+				i = len(query)
+			}
+			query = query[:i] + " " + d.o.IndexHint + query[i:]
+		}
+	}
+	if d.o.Hint != "" {
+		if i := strings.IndexByte(query, ' '); i != -1 {
+			query = query[:i] + " /*+ " + strings.Replace(d.o.Hint, "*/", "* /", -1) + " */" + query[i:]
+		}
+	}
+	if d.o.Comment != "" {
+		query = "/* " + strings.Replace(d.o.Comment, "*/", "* /", -1) + " */ " + query
+	}
+
+	return query
+}
+
+// {{ dbfn "Exec" }} executes query with the options applied.
+func (d *xoOptionsDB) {{ dbfn "Exec" }}({{ ctxparam }}query string, args ...interface{}) ({{ $res }}, error) {
+	return d.db.{{ dbfn "Exec" }}({{ ctxarg }}d.query(query), args...)
+}
+
+// {{ dbfn "Query" }} runs query with the options applied.
+func (d *xoOptionsDB) {{ dbfn "Query" }}({{ ctxparam }}query string, args ...interface{}) ({{ $rows }}, error) {
+	return d.db.{{ dbfn "Query" }}({{ ctxarg }}d.query(query), args...)
+}
+
+// {{ dbfn "QueryRow" }} runs query with the options applied.
+func (d *xoOptionsDB) {{ dbfn "QueryRow" }}({{ ctxparam }}query string, args ...interface{}) {{ $row }} {
+	return d.db.{{ dbfn "QueryRow" }}({{ ctxarg }}d.query(query), args...)
+}
+{{- if .Sqlx }}
+
+// {{ dbfn "Queryx" }} runs query with the options applied.
+func (d *xoOptionsDB) {{ dbfn "Queryx" }}({{ ctxparam }}query string, args ...interface{}) (*sqlx.Rows, error) {
+	return d.db.{{ dbfn "Queryx" }}({{ ctxarg }}d.query(query), args...)
+}
+
+// {{ dbfn "QueryRowx" }} runs query with the options applied.
+func (d *xoOptionsDB) {{ dbfn "QueryRowx" }}({{ ctxparam }}query string, args ...interface{}) *sqlx.Row {
+	return d.db.{{ dbfn "QueryRowx" }}({{ ctxarg }}d.query(query), args...)
+}
+{{- end }}
+
 // ScannerValuer is the common interface for types that implement both the
 // database/sql.Scanner and sql/driver.Valuer interfaces.
 type ScannerValuer interface {
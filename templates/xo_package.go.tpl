@@ -4,13 +4,55 @@ package {{ .Package }}
 // Code generated by xo. DO NOT EDIT.
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
+	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+{{- if sqlx }}
+
+	"github.com/jmoiron/sqlx"
+{{- end }}
+{{- if pgx }}
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+{{- end }}
+{{- if otel }}
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+{{- end }}
+{{- if metrics }}
+
+	"github.com/prometheus/client_golang/prometheus"
+{{- end }}
+{{- if protoserver }}
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+{{- end }}
+{{- with .Imports }}
+{{ range . }}
+	"{{ . }}"
+{{- end }}
+{{- end }}
+{{- with nullimports }}
+{{ range . }}
+	"{{ . }}"
+{{- end }}
+{{- end }}
 )
 
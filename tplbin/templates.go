@@ -2,33 +2,78 @@
 // sources:
 // templates/mssql.foreignkey.go.tpl
 // templates/mssql.index.go.tpl
+// templates/mssql.manytomany.go.tpl
+// templates/mssql.map.go.tpl
+// templates/mssql.mock.go.tpl
+// templates/mssql.optional.go.tpl
+// templates/mssql.prototest.go.tpl
 // templates/mssql.query.go.tpl
 // templates/mssql.querytype.go.tpl
+// templates/mssql.repository.go.tpl
+// templates/mssql.server.go.tpl
 // templates/mssql.type.go.tpl
+// templates/mssql.type.proto.tpl
+// templates/mssql.where.go.tpl
 // templates/mysql.enum.go.tpl
 // templates/mysql.foreignkey.go.tpl
 // templates/mysql.index.go.tpl
+// templates/mysql.manytomany.go.tpl
+// templates/mysql.map.go.tpl
+// templates/mysql.mock.go.tpl
+// templates/mysql.optional.go.tpl
 // templates/mysql.proc.go.tpl
+// templates/mysql.prototest.go.tpl
 // templates/mysql.query.go.tpl
 // templates/mysql.querytype.go.tpl
+// templates/mysql.repository.go.tpl
+// templates/mysql.server.go.tpl
 // templates/mysql.type.go.tpl
+// templates/mysql.type.proto.tpl
+// templates/mysql.where.go.tpl
 // templates/oracle.foreignkey.go.tpl
 // templates/oracle.index.go.tpl
+// templates/oracle.manytomany.go.tpl
+// templates/oracle.map.go.tpl
+// templates/oracle.mock.go.tpl
+// templates/oracle.optional.go.tpl
+// templates/oracle.prototest.go.tpl
 // templates/oracle.query.go.tpl
 // templates/oracle.querytype.go.tpl
+// templates/oracle.repository.go.tpl
+// templates/oracle.server.go.tpl
 // templates/oracle.type.go.tpl
+// templates/oracle.type.proto.tpl
+// templates/oracle.where.go.tpl
 // templates/postgres.enum.go.tpl
 // templates/postgres.foreignkey.go.tpl
 // templates/postgres.index.go.tpl
+// templates/postgres.manytomany.go.tpl
+// templates/postgres.map.go.tpl
+// templates/postgres.mock.go.tpl
+// templates/postgres.optional.go.tpl
 // templates/postgres.proc.go.tpl
+// templates/postgres.prototest.go.tpl
 // templates/postgres.query.go.tpl
 // templates/postgres.querytype.go.tpl
+// templates/postgres.repository.go.tpl
+// templates/postgres.server.go.tpl
 // templates/postgres.type.go.tpl
+// templates/postgres.type.proto.tpl
+// templates/postgres.where.go.tpl
 // templates/sqlite3.foreignkey.go.tpl
 // templates/sqlite3.index.go.tpl
+// templates/sqlite3.manytomany.go.tpl
+// templates/sqlite3.map.go.tpl
+// templates/sqlite3.mock.go.tpl
+// templates/sqlite3.optional.go.tpl
+// templates/sqlite3.prototest.go.tpl
 // templates/sqlite3.query.go.tpl
 // templates/sqlite3.querytype.go.tpl
+// templates/sqlite3.repository.go.tpl
+// templates/sqlite3.server.go.tpl
 // templates/sqlite3.type.go.tpl
+// templates/sqlite3.type.proto.tpl
+// templates/sqlite3.where.go.tpl
 // templates/xo_db.go.tpl
 // templates/xo_package.go.tpl
 // DO NOT EDIT!
@@ -98,7 +143,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _mssqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _mssqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\x6d\x4f\xdb\x48\x10\xfe\x1c\x7e\xc5\xd4\x8a\xc0\xee\x05\xb7\xf7\xb5\x15\x1f\x28\xa4\xf7\x52\x8e\xf4\x80\xde\x21\xa1\xa8\x98\x78\x9c\x58\x6c\xd6\xc9\x7a\x73\x0d\x17\xf1\xdf\x6f\x66\xd6\x76\xec\xc4\x40\xa9\x5a\x9d\x04\xce\xbe\xcc\xce\x3e\xf3\xec\xcc\xb3\xbb\x5a\xed\x43\x37\x9f\x64\xc6\xc2\x9b\x03\xf0\xa5\xa5\xa3\x29\x42\x78\x71\x37\xc3\xf0\x94\x9a\x01\xec\xdf\xdf\xef\xac\xc8\x30\x4d\x60\x6c\xc1\x57\xa8\x21\x7c\x9f\xa2\x8a\xf3\x00\x7e\x96\xd9\x57\xaf\x60\xb5\x02\x31\x87\xfb\x7b\x30\x68\x17\x46\xe7\x60\x27\x28\xe3\x67\x98\x54\xee\x78\x3e\xca\xf3\x6c\x94\x46\x16\x63\xf8\x92\xda\x49\x65\x57\x37\xda\xcb\x79\xc8\x44\x7a\x8c\xd0\x4d\x7b\xd0\x4d\x18\x61\xb1\x2f\xcd\xd3\x24\xe1\xe9\xa6\xd4\xec\xb1\x25\xea\xd8\x8d\x76\x93\xd2\x45\x35\x0a\xfe\x37\xbb\x3a\xca\x14\xff\x2f\xa6\x7a\xd3\x69\x10\x0a\x29\xa8\x72\xfc\xb1\x1c\x38\xa0\xd5\x42\x7f\x3d\xb4\x05\xae\xc4\x24\x00\x09\x11\x83\xfa\x05\x35\x1a\xd9\x27\x31\xd9\x14\x92\xcc\x60\x3a\xd6\x70\x8b\x77\xb0\x27\xae\xdc\xc0\x07\xbc\xab\x35\x4b\x00\xe0\x0f\x4e\xe1\xb8\x7f\xd2\xbf\xe8\x0b\x94\x81\x3e\x46\x85\x16\x85\x2a\x9a\xfa\xf4\xf1\xf8\xb0\x9a\xfa\x34\x8b\x23\x5b\xc0\x48\x16\x7a\x24\x50\x8b\xec\x22\xe0\x2f\x37\xc3\x0b\xea\x84\xb1\xed\xc8\x2e\x67\x91\x89\xa6\xd4\x8d\x6f\xe0\x72\x70\xfc\xae\x07\xd9\xcc\xe6\x10\x86\xe1\xe5\x60\x30\xb3\x69\xa6\x03\xf0\x5f\xb6\xf0\xd9\x03\x34\x26\x33\xe4\xf2\x91\x54\x25\x4e\x3a\x3c\xdb\x35\x98\x14\xa7\xcf\x89\x70\x56\xf5\xd8\xc0\x1d\x5c\xdb\x99\xbd\xbb\xab\xd2\xa8\xb1\xa6\x16\x45\x95\x1d\x45\x38\x91\x19\x4b\x30\xbd\x27\x93\x79\x94\xe9\x7f\x70\x69\x4b\xbe\xc8\xc2\x4f\x75\x8c\xcb\x3a\xd8\x6e\x1a\x34\x73\x94\xc9\x21\x6e\x82\x75\x26\x7e\x45\x04\x15\xf6\x0d\xea\x1b\x58\x37\xe0\x38\xa8\xeb\xa5\x02\xa3\xb9\xbb\xcb\xb9\x4a\x29\x70\xde\x46\xbf\xb0\xaf\x1e\x17\x1c\xf0\xe8\x28\x3d\xf0\xf2\xb9\xca\x2d\x37\xe2\x1b\xfa\xcc\xe9\xdf\x60\x4e\xdf\xcb\xc1\x49\x36\xe6\x5e\xf6\x25\x97\xc1\x84\x7f\x52\x4d\x1f\x0a\x41\xda\x31\x7d\x18\x9d\x17\x54\x9b\x9a\xd6\x4d\x1b\xfc\x7c\xc7\x7d\xcb\x20\x6b\xfb\x63\x62\xa3\x1b\x85\x0e\xc1\x68\x82\xd3\x68\xbd\xfd\xf9\x46\xff\x82\x2d\xdd\xd7\x49\x70\xe9\x85\xb0\x35\x1d\xcd\x95\xeb\x3c\xcb\x15\xcb\xc2\x49\x16\xc5\x9b\x05\x59\xd7\x2f\x45\xf3\x95\x7a\xcd\xd4\xc2\x44\x2a\xfd\x77\x93\xb1\x07\x74\x8c\x19\xda\x7b\xae\x74\x31\xa8\x54\x43\x04\x79\xaa\xc7\x14\xd1\x7c\x81\xe6\xae\x07\x11\xe5\x55\x8e\x56\xa0\x4c\x69\x37\xc0\x68\x34\xe1\x1d\x48\x1c\xcf\x50\x85\x35\xcc\x85\xea\x3c\x11\xd9\x43\x42\xc3\xa0\xe1\x6a\xb8\xa5\x52\x6d\x12\x24\x5a\x43\x52\x23\x6a\xb2\xcc\x32\x19\xce\x2b\x7d\x59\x66\xa9\xa6\x14\x5a\x4c\x51\x93\x08\xcd\x4c\x4a\x3f\x1e\xc3\xf2\xea\xae\x8b\xdb\xf5\xa1\x93\x02\xef\x9c\x74\xf7\xe8\xc2\x13\xb7\x44\xce\x28\x53\x0a\x47\x16\xe2\x34\xb7\xa9\xa6\x06\x49\x78\xce\xd5\x9e\x88\x8c\x4d\xa3\xd9\x15\x8b\x0c\x5a\x72\x56\x2b\x72\xf6\x4d\x2e\x86\x6d\xaa\xb9\x22\xcf\xc4\xb9\xac\xbe\x45\xff\x6a\x48\xa8\x89\xfd\x1e\xbc\xee\x01\x15\xaf\xcf\x9c\x04\xc1\x4e\x87\xf3\xbb\x66\x45\xf1\xa0\x49\xa2\x11\xae\xee\xb7\x4c\xe9\x7e\x81\xcf\x22\x21\x65\x9d\xd3\xc1\xd3\x52\x27\x7e\x42\x72\xc1\x1b\x89\x44\x9a\xeb\x85\x52\x0e\x70\xa9\x2b\x3b\x9d\x0e\xcd\xbc\x68\x38\x08\xb7\x72\x29\xfc\x8b\xf2\x31\x66\x57\x9d\x0e\x69\x15\x11\xb2\x40\x6a\x17\x07\x50\x88\x11\x79\x8a\x79\xef\xba\x9e\xa9\x07\x05\xcd\x6d\x4c\xd8\xb3\x5b\x01\x4c\xbc\x5e\xa5\xf1\xf0\x2d\xf7\x5b\xf6\xe9\x94\x06\x70\x00\x3a\x55\xbc\x5a\x53\x33\x9a\xcd\x68\x77\xd2\x6e\xe1\x40\xdb\x89\x24\xda\x38\x03\x8f\x59\x62\x22\x03\x4f\xd2\xbd\xe3\x58\xad\x56\x70\x4f\xd6\x50\x4d\xb3\x14\x8b\x9a\xd4\xf1\xd1\x12\x3e\xae\x04\x2a\x47\x70\x70\x00\xaf\x05\x5b\x21\xf9\x82\x83\xea\x9b\xd3\x85\xdc\xb8\x1a\xda\xe9\x38\x45\xe3\xa0\xae\x5d\x4e\xc1\x35\xfc\x44\xab\xae\x85\x18\xc5\x52\x98\xaf\x73\xa3\xba\x95\x4a\xab\xf7\x67\x83\x3f\xe4\x40\xeb\xea\xb3\x9e\xfe\xfb\xd7\xfe\x59\x1f\xd6\xae\x6a\xb9\x47\x15\xce\x86\xbf\x9d\x82\x4f\xc6\xe0\xb2\x2b\x0f\x7f\xa7\xfa\x10\x86\x3c\xfa\x0b\x68\xe2\x3a\x70\x6f\xb0\xb5\x8a\x65\x89\x75\x2f\x8d\x32\x7a\x38\x3c\x3d\x16\x76\x68\x26\x96\x19\x3a\x8e\xb8\x5a\x51\xbf\x7b\xaf\x1d\x01\x66\xa1\x4b\x02\x44\xba\x7d\x47\x03\x49\x0a\x71\x57\xdd\x5b\xb4\x2b\x8d\x2f\x8b\x8b\x53\xb2\xfc\xea\xc1\x5a\xa1\xba\x67\x03\x5e\xc0\x29\x19\xdf\x24\x9a\xca\x14\xb9\x2c\xbd\xb6\x8b\x74\x97\x3c\xf6\x60\x6b\x5f\x3e\x44\x76\xf5\x42\x32\xa7\x7e\x80\x34\x2a\xa7\x5c\xab\x22\xd3\x52\x45\x58\x2f\xa2\x0a\xe8\x47\x93\x4e\x23\x73\x47\xcf\x37\x97\xcd\x8d\xd5\xe1\x67\x5c\x92\x70\x70\xc2\x91\x32\xe1\x46\x9d\x48\x2e\x37\xed\xdb\x5e\x0a\x9c\xeb\x0d\x2b\x06\x5b\xbd\x3d\xf8\xd1\xc5\x8f\xcd\x74\x54\xbe\xa4\x72\x79\x96\x31\xf0\x65\xf6\x27\x1f\xc5\xa1\x52\x57\x2d\xdc\x0e\xb7\x98\xfb\x51\x9c\x7d\x9f\x48\xb9\x3b\xaf\x62\x8b\x6f\xd6\xc9\x20\x51\x6e\xe5\xc2\x37\x44\x13\x63\x82\x06\xe6\xe1\x91\xca\x72\xf4\x03\x97\xd2\x7c\x29\x73\x24\x0b\x65\x73\x17\xf0\x3c\x3c\x25\x59\xf3\x03\x71\xb1\x15\x7a\x5b\x1a\x8b\xdd\xe3\x89\xd3\x29\x72\xe5\x8d\xa4\x4a\xcf\x79\xe6\x5c\xd9\x97\x69\x16\x18\x51\x98\x51\xa4\xa9\xc5\x71\x1c\x10\x90\x73\xea\x72\xd4\x09\xf3\xd8\xae\x29\xe5\x5d\xb8\xeb\x95\x40\x83\x42\x0b\xb7\xf9\x68\x10\xe2\xf6\x7c\xc6\xd9\xed\x6e\x1d\x5e\xb9\x05\x43\xed\x1b\xe3\x07\x6f\x1f\x3f\x81\x5a\x7d\x08\xf7\x91\xb5\xfc\xf2\xb0\x99\x5c\x62\xff\xcb\x45\xd7\x34\xdd\x78\xfd\x54\xd7\xd0\x63\xf7\xe1\x53\x1e\x4a\x86\xbf\xe2\xba\x1c\xba\x9b\xa6\x76\xf3\x34\x38\xfb\x0f\x9e\xe5\xdc\xee\x5a\x10\x00\x00"
 
 func mssqlForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -118,7 +163,7 @@ func mssqlForeignkeyGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _mssqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xeb\x6f\xdb\x38\x12\xff\x6c\xff\x15\x3c\x61\x91\x5a\xad\xab\x6d\x80\xc5\x7d\xe8\x9d\x0f\x68\x13\x77\x77\x71\xb9\xa6\x97\x07\xb6\x87\x22\x68\x64\x89\x8a\x85\xc8\xa2\x4c\xca\x89\x0d\xc3\xff\xfb\xce\x0c\xa9\x87\x25\xf9\x95\x38\x69\x16\xb8\x0f\xb6\x25\x3e\x86\xc3\x79\xfe\x86\xf4\x7c\xfe\x96\xfd\xa4\x86\x42\xa6\xec\x7d\x8f\x75\xe8\x29\x76\x47\x9c\x39\x17\xb3\x84\x3b\x9f\xf1\xd1\xe2\x52\x5a\xcc\x52\xe3\x48\xa5\xf8\xe0\x0f\xe0\x6b\x0c\x1f\xc9\x15\x7c\x7f\x3d\x3d\x11\x37\xf0\xeb\xca\x1b\x7c\x15\xf8\x49\x52\x7c\x0c\x62\xf8\xf2\x44\x84\xcf\x3e\x57\xa9\xc5\x9c\x4f\x21\x8f\x7c\x65\xb3\xb7\x8b\x45\x7b\x8e\x6b\xa7\xee\x20\xe2\x7a\x6d\x6f\xc8\x47\x2e\x73\xce\xcd\x2f\x31\x70\x81\xdd\xfa\x1b\x79\x29\x4d\x04\x76\x4a\x73\xb3\x97\x2d\x66\xff\xfc\x33\x9b\xcf\x81\x93\x49\xec\xd1\xf6\x16\x0b\x26\x79\x2a\x43\x7e\xc7\x15\x73\x99\x14\xf7\x2c\x90\x62\xc4\x5e\xc1\x28\xc3\xde\x62\xf1\x8a\xb9\xd8\x89\x13\x0b\xc1\x2c\x16\x0e\x50\x43\x82\xbf\xf2\x98\x4b\x37\xe5\xbe\x9e\x1a\xc6\x3e\x9f\x12\x01\xe7\x77\x7c\xd4\xdf\x66\xce\x2b\x87\x36\x10\x06\x79\xa7\xba\x8c\xc3\xf1\x04\xfb\xda\x01\x70\x55\x65\xaf\x03\xef\x5e\x3a\x4d\x5c\xe9\x8e\xe0\xd5\x1f\xb0\xaf\xa7\xc7\x1f\xa1\xf1\x46\x50\x5b\x14\xaa\x34\x93\x2c\x4b\x25\x10\xa2\xaf\xc5\xa2\xcb\x50\x11\xcc\x71\x9c\xaf\xa7\xa7\x49\x1a\x8a\xd8\x66\x9d\xd7\xd5\x3d\x74\x19\xe8\x57\x48\x9b\xcd\xdb\x2d\x64\x6c\x2a\x04\x8d\x55\xc8\x8f\x69\x09\x63\x50\xfd\x64\xc4\xe3\xb4\xc4\x59\xa3\x8c\x99\x75\xde\x3f\xe9\x1f\x5d\x58\x66\x76\x66\x5d\x20\x65\x50\x54\x75\x6d\xb3\x24\xca\x82\x9a\xbf\xc8\x70\xe4\xca\xd9\xbf\xf9\x8c\xa6\xb7\xbe\xf3\x29\x6c\x4e\xbd\xa7\x1d\x75\x89\x1e\x8f\x7d\x52\x63\x6b\xd1\x6e\xb7\x40\xf4\x8a\x47\xdc\x43\xc9\x83\xa1\x4d\x46\xb1\x6a\xb7\xd0\xe2\xba\xb8\x14\x90\x05\xc3\x98\x02\xa9\xef\x38\x31\x52\xb8\x24\x1a\xa2\x21\x63\xf6\x6e\x18\xcb\x19\x75\xa6\xe2\x9c\x88\x76\xa6\xe2\x04\x96\xd7\xa2\x53\x1d\x14\xa6\xed\x1c\xe9\x65\xec\x76\x0b\xc8\xe3\xec\xbf\xf5\x58\x1c\x46\x28\xbd\x16\xd8\xd1\x44\xc6\xf8\x4a\x84\x0b\x1e\xc7\x11\x03\x05\xcb\x59\xbb\xa5\xbd\x08\x97\xbc\xd6\x82\x62\xd7\xec\x0d\xf2\xae\xe0\xe7\x1a\x5f\x80\xce\xf5\xa7\xb3\xd3\xff\x68\x9e\x32\xc3\x06\xf9\x99\xbe\x3f\x7e\xeb\x9f\xf5\xb1\x13\x26\xa1\xab\x2a\xa2\x9c\x1b\x00\x09\x92\x59\xec\xc3\xe7\x63\x86\x4a\xb8\xd6\x2c\xc8\x49\x9c\xb1\x40\x0e\xdb\xd1\x8c\x00\x19\x78\x58\x61\x46\x8b\x85\x4d\x22\xcf\xe4\x48\x62\xc7\x2d\xf7\xe8\xdd\x81\x2e\x7f\x10\xc4\xcc\xfa\x95\xa7\x56\x61\xa8\x10\x08\xc8\x4c\xbb\xec\xa0\x2c\xd6\x2e\xdb\x7e\xc9\xb7\x5a\x5b\xa5\x05\xfd\x41\xb1\xdc\x7f\x71\x1f\x67\xe2\xbe\xb6\xe6\x76\x0b\x40\x8c\x70\xe3\x0e\xda\x01\x78\x46\xb6\x1c\x99\xc3\xd6\x3a\x35\x8d\x95\xfd\xc1\x98\xf6\x22\x73\xee\x48\x78\xb7\x5e\xe4\x4e\x60\x1b\xd6\x24\xf1\x21\x38\x90\x4b\x34\x84\x9f\x4f\x42\x5e\xd2\x80\x87\xc7\x21\xa4\x1a\x85\xb7\xbc\x4a\xba\x4b\x6c\x84\xf1\x0d\x0b\x53\x36\x89\x53\xd8\x53\x3a\xe4\xb4\x5b\x11\xd0\x63\x2a\xdd\x58\xb9\x1e\x5a\x38\x36\x41\x70\xe9\x84\xe0\x69\x40\x2f\x8c\x21\xce\xfc\x11\xa6\xc3\x8b\xa9\xed\x34\x06\xa6\x9c\xf1\x67\x8c\x50\x46\xf2\xcd\x21\x32\xb3\xbc\x75\x2b\x07\xae\x8e\x03\x5d\xe6\x26\x09\x08\x82\xdc\xba\x0b\x0c\x17\xbb\xb1\x6d\x32\x0c\xad\x4a\x63\x19\x28\x91\x3e\x05\xa3\x6a\xf2\xf0\x79\xca\xe5\x28\x8c\x41\x6b\xa0\x76\xad\xb8\x4c\x91\x3e\x1b\xcc\xaa\xbc\x22\x25\x1d\xd6\x50\xc4\xcb\xda\x35\x72\x6e\x5c\x68\xbf\x42\x1e\x08\x11\xed\x18\xf9\x3b\x89\x0c\xe1\xc7\xd2\xdc\x59\x05\x73\xf6\x36\xa9\xe0\xce\x95\xe4\x5a\xb4\x64\x2d\x2c\x6a\x9f\xf5\xb9\x17\x15\x40\x03\x43\x1e\x46\x6a\x5a\x4e\x07\xb9\x8c\x05\x13\x3a\x0f\x19\x05\x4a\xab\x14\x27\x2d\xa6\xe3\xa3\xc5\x3a\x5b\xc4\x47\xdb\x6e\x8c\x90\xc8\xab\xb8\x65\x28\xa3\x5d\xc3\xe5\x13\x45\xab\x03\x71\xbb\x2e\xe3\x90\x51\xd7\xe3\x93\xb8\x2d\x07\x25\x93\x00\x0d\x64\x39\xe3\x6a\x12\x81\x59\xb8\x92\x33\x21\x7d\x2e\xc1\x58\xef\xc1\xdb\x29\x2a\x80\xa1\x60\x13\xd3\xf6\x00\xae\x02\x4e\x10\x85\xa3\x30\x5d\x1e\x74\x82\x4d\x48\x0c\xfb\x61\x4e\x10\x28\x9e\x9a\x49\xaa\x39\x66\xec\xc5\x8a\x8b\xac\x0c\x96\xfc\xed\xea\x59\x21\x8d\xc0\xdc\xdd\x00\x0c\xd6\xa3\x91\xef\x39\xd2\xe8\x1c\xd4\x40\x10\x28\x39\x87\x1c\xe2\x2f\x08\x30\x5a\x08\xfd\x71\xc5\x6f\x57\xe0\x9d\x5c\x06\xae\xc7\xe7\x8b\x39\x43\x41\x37\xda\xb6\x8e\xc1\x90\xdd\x99\xe1\x1f\x42\x71\x34\xcb\x0c\x07\x64\x8c\xc6\x97\x4b\x6c\x2a\xc8\x18\x8f\x28\x99\x82\x80\xe8\xed\xe3\xac\x0b\x1d\x55\x51\x9a\xae\x6d\x65\x87\xa3\x68\x2d\xd6\xeb\x31\xcb\x62\x07\x07\x4c\x38\x64\xd4\xec\x5f\xec\x1d\xcd\x32\xdd\x20\x9b\xd3\xb3\xe3\xfe\x19\xfb\xf8\x3f\x83\x2c\xab\x80\xd5\x6c\x0d\xd4\x59\x08\x6e\xed\x20\xe3\x8e\x4b\xc3\x97\xfa\x29\xf1\x5c\x13\x9f\x46\xa9\x6f\x7a\x9a\x5d\xcd\x78\x85\xd3\x62\xcc\x35\xb2\x48\xee\x6a\x00\x48\x27\xe2\x71\x51\x7b\x19\x87\x02\xca\x5a\x71\xbd\x2c\x13\xe2\x5b\x37\xa3\x8b\x0f\xda\xa1\xed\xdc\xcc\x56\x80\x48\x88\x0f\x30\x93\x72\xa6\x01\x3f\x06\x76\x63\x20\x32\x86\x51\xf3\xd1\xf9\x0a\x2c\xa9\xfd\xa0\x19\x4e\x02\xb5\x0c\x45\x96\xd6\xdc\x4e\xd7\x6b\xea\x0c\xe3\xb9\xa9\x4e\x35\x3c\xf6\x78\xbb\x15\x08\x89\x4e\x5b\x2d\x60\x00\x2d\xdd\x20\x4e\x53\xb4\xcc\x52\xd5\x60\x6a\x15\xd8\x10\x0a\x38\x5b\xd2\xc0\x87\x72\xfc\x6d\x8d\x73\xd3\xae\xe5\x89\x15\x49\x62\xe7\xdd\xb6\x7c\x1e\x80\xdd\x8e\x9d\xa3\x48\x80\xd3\x98\xe8\x14\x09\xd7\x47\xe6\x31\xf0\x6f\xd2\x0d\x0a\x60\xec\x7c\xe6\xd3\xb4\x63\xd7\x36\xbb\xa2\x96\x5b\x5f\xcc\xd5\xaa\xb9\xa5\x72\x8e\x6c\x8c\x14\x01\xf9\x0e\x4b\xbf\x2e\x43\x88\x0e\xa1\x73\x75\x7d\x56\x0e\x96\xc6\x98\xc6\x55\x74\xdf\x20\xaf\xba\xc0\xf4\xe2\x28\x90\xdc\x19\xc8\xd6\x96\x00\xbe\x5d\xd1\x69\x9e\x66\x69\xa8\xce\xb3\x98\x0c\x8f\x04\x20\xed\x2a\x4a\xf4\xb0\x51\x51\xde\x04\x80\xa8\x1a\xa1\x7d\x19\x35\x36\xd4\x09\x26\xa1\x36\x91\xdf\x2f\x36\x84\x20\xfe\xf7\x5f\x1e\x08\x0e\x89\xbb\xe7\xc1\x86\x26\xbd\x1d\x9d\x5e\x7e\xbe\xe8\xbc\xb6\x9f\xa5\x76\x46\x4e\x63\x46\x02\x7a\x29\xc8\x30\x5e\x17\x13\xde\xd5\x41\x61\x5c\xb6\xd5\x8a\x1d\xf5\x5d\x6f\xc8\x3c\x37\x02\xb0\x00\x0c\x12\xd2\xe3\xd8\xb4\xaa\x18\xdd\x60\xb1\x5d\x22\x21\x26\x29\x45\x1e\x2c\x43\x81\xb4\xb6\x7f\x10\xa1\x60\x23\x3e\x12\x72\xe6\xb0\xdf\x53\x3c\x3d\xc3\x12\x54\xa5\x22\x01\x4c\x9a\xa2\xa3\x20\xc1\x20\x94\xb0\x73\xb2\x0b\xa6\xf9\xd7\x35\x55\x00\xbb\xb8\x1f\x86\xc0\x5a\xa8\xf2\x8e\x66\xc4\x89\x7b\x7a\x84\x7f\x80\x1c\x90\x6a\xbd\x2a\xb5\x35\x5b\xab\x70\xa9\xe6\x79\x27\xe7\x29\xb8\xb6\x90\x69\x6b\x2b\xdf\xd9\x02\xfd\xa1\xe5\xd7\x51\x49\x8e\x35\xfe\x22\x98\x70\x15\xee\x7e\x52\xb4\xf8\x7f\xa0\xf8\xb4\x40\xf1\x06\x0f\xce\x43\x4f\x19\xb0\x48\x32\x9f\x0a\x0a\x8c\x25\xbf\x2d\x20\x20\xfa\x7d\x23\xad\xa7\x06\x57\x6b\x71\x55\x22\x85\xc7\x95\x2a\xa0\xd5\x8f\x06\x4f\x4b\x58\x08\x06\x06\xa8\xd1\x06\xe7\xcf\xb2\xf6\x81\x65\xd8\xb3\x75\xae\x5a\x03\x9a\x4a\x78\x49\xaf\x12\xc4\x9d\x2a\x4c\xda\x62\x7a\x39\x23\x8d\x9d\xbe\x94\x1d\xbb\x8c\xad\x96\x81\x96\x91\x0c\x9e\x32\x74\x62\x91\x56\x2f\x4e\x00\xb2\xf0\xb1\xb1\xdd\x46\x3f\xb2\xd9\xa1\x9d\xa1\xf0\x9f\x92\x5b\x54\x40\x93\x94\x75\xf7\x8e\xb7\x61\xde\xa6\x7b\x31\x6f\x22\x95\xc0\x7e\x72\x34\xf8\x8d\xc1\x2c\xe0\x27\xf4\x4b\xf7\x61\xcd\x27\xc3\x5f\xdc\x9b\xe5\x43\xe1\x04\x1b\x44\x80\x09\x72\x24\x20\x78\x12\xc9\xd5\x80\xd2\x55\x74\x38\x53\xb3\xb6\x6e\x7e\xe2\x03\xa9\x14\x21\xa9\xbe\xb0\x32\x67\x16\x24\xe7\x44\x4b\x86\xdd\x72\x08\x9d\x2a\x75\x65\x4a\xe9\x3b\x80\x50\x8e\x34\x0d\x8e\xd5\x10\xa1\x34\x96\xe9\xdd\xe2\x02\x9a\x23\x1c\xa8\x93\x38\x0d\x1f\x82\x8e\xf4\x10\x4c\xdc\x60\x1c\xd9\x0d\xda\xc5\x90\x17\x09\x7e\x69\x84\x9e\x04\x74\x24\xa7\xc3\xaa\x18\x70\x83\x90\x1a\x46\x37\x67\x7c\x14\xdb\x23\x32\xbe\x59\x1d\x13\x3e\x70\x84\x89\x0d\x6c\x46\x67\x38\x3a\x51\x27\x99\x83\xdb\x34\x62\xe7\xc6\xd3\xa8\x55\xa4\x1e\x82\xb0\x4b\x20\x01\xf7\xb9\x1d\x48\xa8\x02\x6c\xbc\x9f\xa0\x6d\xfc\x93\x1d\xd6\x4a\xc8\xac\x2c\x12\x52\x41\x08\xbb\xef\x68\xc3\x65\xa3\x09\x48\x6c\xc0\xd9\x8d\xe4\x2e\x58\x01\x68\xc4\x05\x7c\x69\xd9\x4d\x87\x50\x1b\x20\xfb\x0f\xc1\x24\xd9\xb4\x72\x7a\x6e\x4e\xa8\x20\x1d\x8c\x32\x9d\xa1\xab\x28\x70\xe6\xbd\xa8\x3c\x5d\xd4\xa0\xf6\x8a\xf9\xd4\x01\xa5\x68\x39\x1f\x97\x36\xb9\x3a\xc3\x66\x97\x11\xd7\x15\x09\x56\x3c\xce\x98\xe4\xb2\x5c\xbd\x17\x23\x58\x7c\x68\x14\x06\x40\x0e\x68\x8f\xd3\xa1\x76\xc3\xe5\xbd\xbf\x18\x8d\xb8\xbe\x5f\x61\xed\xb0\xa6\x99\x1c\xd0\x00\x04\xe1\xa9\x37\x24\xd5\x40\x36\x9b\xa6\x52\xdf\xf8\x40\x35\x93\x5f\x04\x21\xbb\x3a\x5e\x85\x18\xb4\x31\xde\x53\xe4\xde\xf1\x74\x0c\x46\x9a\x50\xd4\x2b\xf2\xe8\x0e\x35\xa7\x09\x55\x6f\x0e\x8b\xd3\x91\x07\x1d\xb5\xed\xb0\xcc\x42\xe3\xb0\x82\x51\x6f\x4b\x12\x7a\x00\xae\x6f\xbd\xce\x12\x26\x66\xea\x7d\xec\x62\xbf\x3c\x6c\xbc\x24\xae\x1f\xce\xaf\x3c\x59\x4c\xd6\x1f\x2d\x26\x9b\xce\x16\x51\xd6\x55\x10\x8d\xa1\x1e\x89\x34\x18\xd5\x9e\x4d\x8a\x84\xab\x35\x62\x20\xfb\x87\x28\xfa\x56\x5d\xf4\xaa\xa6\x95\x17\x67\x55\x0f\xdd\xc8\x0f\x34\xac\xa5\x92\x07\x55\x3e\x36\xd5\x66\x72\x83\xa1\x05\xbe\x9d\x33\xc0\x47\x45\xf5\xf8\x1a\x38\xc8\x9b\x8a\x3f\x3b\xec\xcf\x1a\xc6\x99\x08\xb7\x2d\xbb\x5e\x90\x01\xec\xc0\xfb\x0f\xd4\xf9\x13\x9d\xe9\x27\x9b\xea\xd2\x7a\xe9\xb9\x87\x6a\x33\xd9\xb2\xdc\xac\x48\x61\xed\x41\x7d\xb2\x74\x52\x9f\x11\xed\x65\xf5\xe5\x3f\x76\x72\xae\xec\x8c\x1f\xb6\xe9\x4b\x91\xe8\xff\xcd\xe4\xe9\x1e\x4b\xa4\xc1\x24\x04\x24\x82\xed\x94\xe1\x33\x8c\x46\x47\xc4\xd8\xd0\x8c\xfa\x35\xf8\xe6\x31\xf2\x6d\x03\x40\xd2\xe0\x7a\x9e\xef\x0a\xbe\xbf\xbd\xa7\xc6\x2b\x14\x8c\x4f\xa9\x01\xda\xa8\xe9\xed\xe1\x95\x43\x3b\xbd\x2d\x62\x7a\x8b\x16\xeb\xb1\x83\xd0\x5f\xaa\xaa\xf5\xad\x04\xf4\x2d\xfd\x07\xa0\xb8\x8e\x02\x36\x7c\x48\x9d\x29\x1f\xcc\x74\xf9\x97\x55\xc0\xba\x15\xd7\xb5\x8e\xe9\xd1\x2a\x4d\x51\x22\x48\xf5\x00\xb0\x46\x17\x8c\x8e\x65\x1b\x43\xbe\xcc\x54\x98\xf9\x9b\x2b\xfd\xd2\x6c\x2c\xf1\xf4\x6b\xf5\x62\x04\x09\x1a\x46\x1e\x77\x3d\xd2\x35\xf5\x23\x42\x33\x52\xca\x64\x34\xc0\xbf\x30\x64\xdb\xf4\x75\xc5\xc8\x2e\x15\x9d\x2c\x17\x0c\x56\x39\x22\x28\x47\xfb\xc8\xd8\x29\x17\xa9\x03\x1e\x60\xf5\x89\x06\xa0\xb1\xb9\x19\x3b\x14\xe2\x56\x99\x02\x35\xa5\x33\xf4\xfc\x40\xba\x71\x99\x97\x73\x65\x93\xa9\x69\xf3\x9d\xcd\xe5\x97\xe3\x0f\x17\x7d\x2b\x77\x8c\x2d\x2a\x3e\x3d\xa5\x56\x69\x9c\xf7\x2f\xca\x68\x5d\xaf\x77\x0e\xa6\xa0\x99\xa1\x6d\x67\x45\x44\x6f\xa9\x88\x78\xa7\x4d\xed\x8e\x4b\x85\x5b\xe3\x85\x01\xae\x2f\x5f\x46\x60\xab\xe1\x8a\x1a\xe6\x90\x42\x42\xe3\x7d\x8f\x09\x8a\x85\xd9\xdf\xb9\xd1\x84\x67\xf3\x07\x33\x5d\x5f\x2f\x1f\x72\xde\x6d\xbe\x0c\xca\x21\x47\xe5\x9c\xb2\x3f\xe5\xde\xca\x63\xca\x2d\xe8\xee\x72\x11\x34\x15\x88\x07\x3e\x04\x01\xfd\x63\x85\xe2\x51\xfd\x3f\x6f\x25\xaf\x26\xb9\x57\xfe\xfa\xb6\x85\xdb\x8e\x5c\x5d\x2f\x65\xc7\x46\x55\x22\xb4\x1d\xb5\xa5\xf7\x6e\x0e\x43\xe4\xa4\xd8\x8d\xeb\x95\xe7\x92\x6f\x66\x0d\xa9\x10\x4e\x79\xab\x8f\xf2\xef\x75\x32\x7a\x31\x6e\x9e\x71\xb8\xd9\xcb\x8f\xfb\x27\xfd\x1d\xbd\x5c\x4f\x61\x8f\x38\x54\xd0\xf5\xf6\xfe\xfe\xb6\xfc\x00\x07\x7b\x66\xef\xfa\x13\x04\x77\xe3\x68\x7b\x31\x00\x00"
 
 func mssqlIndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -138,487 +183,1307 @@ func mssqlIndexGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _mssqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _mssqlManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x9b\x10\xa4\xd2\xe6\xa8\xef\x2d\xf2\x90\x35\x0e\x96\x2e\xb3\x3b\x27\xdb\x02\x14\x45\x22\x4b\x94\xcd\x40\x26\x6d\x8a\x4e\xed\x19\xfe\xdf\x7b\x47\x5a\xb2\x64\xc9\x49\x90\x14\x58\x0a\xec\x21\x0e\x29\x1e\xbf\xfb\xc1\xef\x8e\xc7\xd5\xea\x08\x0e\xf2\xb1\x54\x1a\xde\x1d\x83\x6f\x46\x22\x9a\x30\x08\xaf\x96\x53\x16\xf6\x68\xe8\x31\xa5\x3c\xf0\xf2\x59\x96\x6b\x1a\x24\x43\xfc\x99\xe1\x9f\x62\x39\xfe\x5e\xf7\x2f\xe4\x08\xff\xcb\xa9\xce\xbd\x00\x8e\xd6\x6b\x77\x45\xa8\xaa\x15\x76\xc0\xd2\xe7\x21\x6f\xcc\xac\x2a\x60\xa9\x8e\x86\x19\xb3\x2a\xe2\x31\x9b\x44\x5b\xfc\xcb\x9d\xf9\x15\x49\xda\x5f\x52\x5d\x81\x41\xed\x75\xa4\x59\x66\x27\xcf\xc0\xba\x93\x5c\x34\x6d\xfa\x88\x5f\x6b\x40\xe5\x87\x07\xad\xda\x01\x2b\xcd\x7a\x0e\x5c\xca\x59\x96\xe4\x04\x14\x9e\xd9\x61\x35\x8c\x67\xdb\xd5\x41\x39\x23\x81\xb7\x6f\x61\xb5\x82\xf0\x53\x36\x57\x51\x66\x8e\x6c\xbd\x06\xc5\xf4\x5c\x89\x1c\xf4\x98\xd1\xea\xd4\x2c\xf2\x7f\x77\x0f\x17\x25\xa3\x3c\x97\x31\x8f\x34\x4b\xe0\x2b\xd7\xe3\x62\x47\x58\x13\xd2\x63\x25\xe7\xa3\x31\xbc\xc1\x95\x4a\x00\xd7\xeb\x37\xa1\x9b\xce\x45\x0c\x3e\x2d\x58\x32\xa1\xf8\xcf\xbb\x00\x41\xd3\x44\xda\x11\xeb\xc5\x34\x52\xd1\x04\xa7\xc9\x10\xae\xfb\xa7\xbf\x76\x80\x88\x04\x61\x18\x5e\xf7\xfb\x53\xcd\xa5\x08\xc0\xff\xfc\xc5\x00\xee\x58\xde\x01\x64\xa6\x54\x08\xed\x3a\x14\xa4\x85\x94\x66\x43\x8e\x6b\xc5\x17\x2e\x90\xb4\xf3\x09\x13\x1a\xfc\xa9\x42\xb3\x6b\x39\x13\x7a\x55\x9b\x82\xbd\xe4\x01\xef\xb2\x7b\xd1\xfd\x70\xe5\x19\xe4\xfb\x48\x91\x66\xab\xdd\x75\x1d\x8c\x3f\x9e\x3b\xcc\xe6\x4c\x2d\x49\x2f\xcd\x12\x16\x67\xdb\x9c\xc1\x88\xdc\x5a\x04\xb8\x85\x5f\x5c\xc7\xb9\x25\xdf\x65\x36\xc5\x63\xe5\x0b\xca\xb9\x7c\xab\x7b\x73\xb2\xde\x66\x9f\x95\x3f\x1b\xf4\xff\x00\x13\xe2\x4a\x22\xd0\x31\x17\x02\x1f\xfb\xe7\xbd\x42\xa0\x7a\x3e\x70\x07\x7d\xb3\x50\xaa\xa3\x55\x4b\xc7\x2d\x8d\xbc\x3b\xaf\xca\xaa\xba\xee\x7f\x7e\xeb\x0e\xba\x35\x08\xe3\xaa\xc5\xa8\x00\x78\x70\xd2\x3b\x05\xda\x89\xb2\x3c\xad\xa4\xa6\x4c\xf5\x29\xcb\x98\x66\x46\x9a\xa0\x49\x52\x85\x14\x2b\x5c\x4b\xcc\x5a\x2c\x45\x52\xee\xb1\x20\x4c\x90\xf0\xad\x8d\xb1\x9a\x8b\x22\xc6\xa6\xe6\xf8\x36\xba\x1d\xb2\x4c\x45\x62\xc4\xe0\x80\x77\x30\x89\x4c\x86\x54\x4c\x2b\xcc\x39\xe0\x86\x33\x25\xac\x71\x48\xdc\xb3\x85\x2e\x88\xeb\x73\x91\xb0\x45\x99\x87\x07\x3c\x20\xb8\x8a\x25\x81\x49\x46\xc4\x42\xd5\x0b\xc3\x05\xac\x81\xa4\xaf\x9d\xa0\x2b\x14\x20\xa2\x1c\x1b\x79\xf2\x36\x19\xa6\x02\xc9\x84\xfe\xc6\xda\xdb\xe6\x40\xa4\x46\x26\x03\x3a\x70\x88\x80\x1d\xf8\x2f\x3c\x73\x10\x88\x8c\xfd\xe9\x18\x04\xcf\x28\xa7\x1c\x5b\x43\x68\x6a\x52\xcd\x75\xd6\x85\xff\xa5\xa7\x9f\x14\x9f\x44\x6a\xf9\x3b\x5b\x52\x38\x6c\x2e\x30\x0d\x6c\xc1\x73\xcd\x44\xcc\x5c\x27\x95\x0a\x6e\x8c\x71\xc5\x65\x83\xc7\x8f\x8e\x58\xc7\x28\x7e\xa4\xaa\xb6\x1c\xde\x98\xfd\x39\x06\x0e\x93\x97\x15\x7a\xad\xa5\x76\x98\xe5\x8c\xec\x18\x31\xc1\x14\x8f\xf3\xe2\x2c\x8c\x9d\x84\xbe\x90\x7f\x12\x55\x4e\xb2\xec\x73\xcb\xc1\x7c\x69\xc4\xfd\x75\x47\xdc\xb8\x4b\x2e\xce\x4a\x07\x93\xe1\x96\x4f\xc6\xd5\x06\x9d\x5e\xaf\x4b\x4e\xc2\x52\xa6\x60\x16\x7e\xc8\x64\xce\xfc\xc0\xf2\x26\x93\x51\x42\x7c\x98\x67\x3a\x7f\x42\x62\x11\xaf\x66\x61\x0f\xed\xf3\x83\x26\x85\x68\x6f\xdb\x46\x23\xf7\x10\x85\x1d\xc7\xd9\xb0\xef\x9d\x21\x5f\xc7\x22\x93\x83\x47\x66\x99\x58\x6e\x68\x1e\x47\x02\x47\x36\xbf\x67\x78\xcd\x47\x82\xc2\x6f\x82\xd3\x5e\xd1\x37\x17\x90\x77\xe8\x15\x86\x06\x36\x68\x2d\x51\x6b\x86\xcd\x2a\xa6\xb0\x1c\x43\x34\x9d\xa2\x41\xbe\x21\xfc\x61\xcd\xef\xc0\xc4\x77\x83\x47\x76\x75\x95\xf2\x83\xf7\x4f\xe5\x99\xcd\x30\xb7\x58\x37\x0a\x50\xc8\xc5\x6f\xe8\xf2\x49\x92\x50\x4c\x1b\x8d\x43\xd9\x65\x34\x7a\x8b\xfd\x0d\xc5\x70\x09\x78\x37\x33\xa5\xb9\x18\x41\x04\x4a\x7e\xc5\xb9\x96\x2f\x68\x30\xea\xd6\xed\xeb\x2e\xea\x2c\x69\x6f\x2b\x9a\x1d\x88\xb9\xea\x5f\xd0\x67\xa0\x6d\x9e\x35\x2d\xd8\xdf\x09\x82\x77\xde\xbb\xec\x0e\x1e\xee\x32\x6c\xd0\x1e\x6b\x36\x2c\x10\x9c\xf7\xae\xfa\xad\x4d\x81\x5f\x6b\x43\x36\x74\xad\x15\x85\x0e\x34\xd6\xb6\xfd\x01\xde\xc9\x76\x7f\x00\x7f\x9f\x5c\xfc\xd5\xbd\xdc\x01\xbc\x8f\xb2\xbc\xe2\x67\x63\xd7\xf7\xb8\xd1\x9f\x58\x9c\xea\x15\xad\x15\xb5\xea\xd7\xe3\xb5\x50\xd5\xf5\x6d\x1b\xf3\xd6\x7a\x78\xd3\xd9\x64\x62\xb5\x5e\x77\x17\x2c\x7e\x51\xb9\xfe\x31\x7c\xdf\x14\x11\xaa\x2f\xb6\x7e\x0c\xd8\x44\xde\xb3\x6a\x09\x49\xf8\xe3\x35\x24\x55\x72\xb2\xb7\x86\x98\xd6\x91\x4a\x08\xc7\x94\xa5\x22\x62\xa4\x9f\x5f\x44\x1a\x26\xbe\xae\x3a\x62\xcd\x7b\x4a\x29\x39\xc5\xe7\xc6\x55\xf7\x25\x0f\x16\x8b\x00\xd5\x97\x47\xad\x86\xb4\xbc\x0e\x4c\xb1\x68\x3e\x0e\xd2\x88\xfa\x97\xed\xf3\xc0\x0c\x76\x77\x4c\xf0\xde\xe7\xbb\xa4\xac\xef\xf4\x33\x26\xaa\xc0\x81\xb9\xcf\xbe\xcf\x03\xe1\xc7\x48\xa9\xff\xcb\xc9\xa6\x9c\x7c\x03\x2f\x5b\x7c\x0b\x13\x13\x00\x00"
+
+func mssqlManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlManytomanyGoTpl,
+		"mssql.manytomany.go.tpl",
+	)
+}
+
+func mssqlManytomanyGoTpl() (*asset, error) {
+	bytes, err := mssqlManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlMapGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x61\x6f\x1a\x39\x10\xfd\x0c\xbf\x62\x6e\x75\x4a\xd8\x1e\xd9\xf6\x73\x24\x4e\x6a\x13\x7a\xd7\xbb\x36\xb4\x25\xd2\x45\x42\xa8\x18\xd6\x80\xd5\x5d\x1b\x6c\x73\x81\x43\xfb\xdf\x3b\x33\x5e\xc0\x01\x54\x45\xd5\x55\x0a\xcb\xda\xf3\x3c\xf3\x66\xe6\x79\xc8\x76\x7b\x05\xbf\xaa\xdc\xc1\x75\x07\x5a\xa5\x58\x2c\x84\x15\x25\x64\x30\x15\x85\x93\x29\x5c\x55\x55\x73\x1b\x20\xc7\x08\x6f\x57\x31\xc0\xcd\x8d\xf5\x8c\xe1\x37\x2d\x4a\x09\xd9\xfd\x66\x21\xb3\x3b\x7a\x4d\xa4\xb5\x09\x24\x6e\x59\x38\x4f\x2f\xf9\x18\x1f\x4b\xfc\x58\xe9\xf0\xf9\xd0\x7b\x6f\x66\x49\x60\x92\x28\x8d\x3b\xc2\xce\xc8\x40\xaf\x8a\x0d\x90\x98\x85\x77\x49\x14\xd2\x8b\x71\x21\x43\xc8\xc9\x5c\x96\x02\xb2\x7e\xfd\xcd\x71\xef\xc9\x1c\x9e\x44\x21\xe6\xba\x2c\xa2\xb3\xbb\xc5\x33\x4e\xbf\x7c\x09\xdb\x2d\x64\x1f\xc4\xe2\xed\x4a\x4f\x38\xb1\xaa\x02\x2b\xbd\x55\xf2\x5f\xe9\xc0\xcf\x25\x58\xf3\xe8\x60\x6a\x4d\x09\x97\x88\xad\x49\x56\xd5\x25\x3c\xce\x8d\x93\xfb\xf3\x4a\x16\x79\xb6\xf3\xa0\x1c\x28\x5d\x7b\xe7\x1a\x54\x55\x1b\xbe\xca\x8d\xcc\x61\xbc\x39\x7b\x24\x8b\xa0\xf0\xa8\xfc\xdc\xac\x3c\x08\x0a\x0e\xc2\x4a\x28\x95\x73\x4a\xcf\x02\x0f\x62\x85\x8d\xcb\x30\x00\xc5\xf8\x43\x6a\x69\x85\x47\xdf\x6c\x55\x3a\x97\x6b\xe6\x9a\xbd\xa3\xd7\xf0\xac\xc3\x5c\x66\xcd\x29\x66\x7a\x26\xeb\x16\x6e\x4d\xfc\x3a\xa8\xa1\xaa\xf2\x31\x3c\xf4\x6e\xdf\xb4\x63\x5a\x83\x21\x2e\xb0\x38\x58\xce\x28\x01\xaa\x2e\xe7\x47\xed\x84\x2c\xcb\x1e\x7a\xbd\x85\x57\x46\xa7\x2c\xaf\xc1\x77\xce\x0c\x5f\x10\x8f\x83\xa8\xc8\x0b\xea\xca\xd8\x14\xb6\xcd\x06\xb5\x76\x6d\x0c\xfb\xa2\xf8\xbb\x1d\xa5\x51\x72\xab\x52\x6a\xff\x34\x87\xb3\x7d\x86\xa4\xdf\x7d\xdf\xbd\xb9\x4f\xd8\x01\x8a\x93\x34\x52\x8a\xaf\xf2\x47\xb8\x15\x52\xb7\x0e\xf5\x48\xd3\x66\xb3\x81\xf5\x5f\xae\xa4\xdd\x80\xf0\x50\x1a\xe7\xb1\x6a\x6f\x84\x9f\xcc\xfb\xea\x3f\x19\xd7\x4e\x50\x37\xbd\x2a\x65\xb3\x31\x35\xf6\xd8\x13\xfc\x0e\xaf\x28\xe5\x86\x26\x7a\x47\x46\xdc\x56\x53\xd0\x88\x79\xe2\x1b\xb7\x11\xde\x89\x37\x71\xab\x42\x4e\x44\x6a\xbc\x52\x45\x0e\x8b\x42\x4c\xe4\xdc\x14\xb9\xb4\x0e\x84\xce\x81\x6e\x21\xf9\xd3\xfb\x32\x0c\x86\x58\x4d\x94\x56\x1b\x34\x45\x22\x40\x64\x53\xda\x4b\x3b\x45\x27\xdb\xaa\x06\x10\x7b\xb5\x93\x05\x65\x86\x60\x2b\xf4\x2c\xce\x76\x70\xad\x87\x81\xa0\xd2\x03\x35\x44\x92\x68\xd3\x7e\xce\xe2\x9a\x19\x9e\x00\xd4\x8e\x10\x6e\x8f\xc0\x9b\x8b\x6b\xf6\xbb\x6f\x47\xc0\xd1\x27\x2a\x66\x27\x8e\xa5\xaf\x87\x75\xce\x78\x3e\x34\x03\x97\x61\x30\x11\xb9\x51\x10\x00\x8c\xe0\x37\x8a\x38\x22\x9d\x9b\x82\xe6\x99\xab\xfb\xcb\x81\xd8\xf1\x0e\xf3\xf6\x73\xef\x03\xc7\xd8\x0f\x93\xc8\xf8\xcf\x9f\xdd\xcf\x5d\x38\xb8\x89\xb4\x73\x63\x0a\x42\xbe\xbb\x83\x16\xa2\x21\x54\xd6\x65\x7f\xa1\x66\x5b\x4a\xb7\x21\xc1\xbf\x14\x0d\xa3\x14\x8f\x63\x53\x43\xfc\xbe\x99\xfa\x5b\x59\x48\x2f\x77\x29\xc3\xeb\xbb\x5b\xae\x08\x5a\x72\xb6\x4c\x0c\x76\x6f\xa7\x4d\xb4\x48\x4d\xb8\x51\x9d\xb9\x5d\xe9\x7d\xe6\x3c\x7a\x5b\x21\xff\x36\x37\x1c\xef\x64\xca\x83\x12\x23\xe2\xfe\x3a\xd4\x94\xe7\x1a\xd6\x67\x70\xaa\xf4\x2d\xd9\xf1\x22\x92\x99\x0e\x64\x08\xc8\xc7\x53\x8d\x97\x09\xc9\x4c\x7c\x72\x98\x17\xd4\x30\x9a\x16\x6d\xb8\x20\x87\x6d\x38\x09\xcc\xea\x25\x67\xbf\x74\x40\xab\x22\xe8\x02\x6f\xdd\xca\x6a\x5a\xf3\x8d\xaf\x5b\x4c\xda\xfa\x12\xb4\x15\x7e\x7e\x62\x79\x31\xdf\x2d\x0b\xe1\xea\x50\xbb\x8f\x56\x95\xc2\x6e\xfe\x96\x9b\x5a\x51\xf1\xe1\xec\x8b\x5c\x2b\x87\x53\xa9\xc3\xbf\x6e\xf5\xd9\x50\xba\xc0\xc2\x0d\x9e\xe0\xcf\x4d\xe6\x5a\x9c\x7b\x10\x73\x65\x3f\xf8\x8b\x4a\x44\x66\x34\x7f\xd5\xc4\x1d\xca\xca\x39\x11\xf3\xb5\xf9\x44\x5d\x79\x5d\x14\x83\xe3\x1a\x0f\x4f\x0a\xf8\xf3\x2b\xf7\x7f\x24\xcc\xeb\xe5\x3e\xc3\x7c\x7c\x10\x07\xe7\x7a\xa2\x8d\x1f\x4f\x2b\x68\xbb\x30\x22\xc7\x29\xed\x56\x85\x77\x75\xa6\xcb\xec\x4e\xae\x7d\x2b\x0d\x47\x8f\x93\x3e\x51\x73\x00\x7d\x4f\x34\x8d\x5a\x28\xd7\xac\x93\x76\xed\x95\x84\x72\x15\x00\x4c\x86\x67\xcc\x44\x68\x7a\x25\xfe\x1d\x24\xd2\xc7\x35\xa5\x3b\xa5\x0a\x9e\x19\x2a\xad\x05\x8e\x00\x0f\xc9\x45\x52\x93\x4c\xeb\x91\x7e\xae\x08\x8d\x65\x76\x53\xe0\x3f\x15\x2d\x06\x9c\xa9\x49\xcd\xe3\xd9\x6d\xbc\x38\xe9\xe3\x81\x79\xd7\x5a\x8e\x13\xc7\x7c\x66\x63\xe2\x5b\x44\x8c\x6a\x0c\xd2\x6a\x13\xb0\x59\x35\xbf\x01\x9b\xa2\xf7\x09\x87\x0a\x00\x00"
+
+func mssqlMapGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlMapGoTpl,
+		"mssql.map.go.tpl",
+	)
+}
+
+func mssqlMapGoTpl() (*asset, error) {
+	bytes, err := mssqlMapGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.map.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlMockGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xcd\x8f\x9b\x46\x14\x3f\xc3\x5f\xf1\x8a\x72\x80\x8a\x90\xfb\x4a\x3e\xb4\x49\x23\xad\x94\xed\x1e\x92\x55\x23\xad\x56\xd5\x04\xc6\x36\x2a\x30\x74\x18\x76\xbd\x75\xf8\xdf\xfb\x66\xde\x80\xc1\x06\x6c\x6f\xd6\xea\xa5\x17\x83\x99\xf7\xf1\x9b\xdf\xfb\x98\x37\xdb\xed\x5b\x78\x53\xad\x85\x54\x70\xb5\x00\xdf\xbc\x15\x2c\xe7\x10\xfd\xae\x7f\x3d\x2e\xa5\x07\x5e\xf2\x0d\x7f\x44\xa9\x2a\x7c\xa8\x8d\x17\xc0\xdb\xa6\x71\xb7\x5a\x35\x1f\xd3\xf5\x4b\x99\x16\xaa\x35\xf1\x59\x09\xc9\x6f\x44\xfc\x17\xea\x8d\xdb\x03\x2f\xe7\x6a\x2d\x12\x7c\x61\x72\xa5\x3f\xc6\x2c\xcb\xcc\xd3\x6b\xd1\x45\x1f\x53\x9e\x25\x55\xcf\x75\x5d\x26\x4c\x71\xed\xba\x40\x97\x4b\xbd\xac\xbd\x57\x79\x9d\xa9\xb4\x95\x6f\xd5\x7d\x92\x4e\x57\x05\x82\x81\x28\x40\x28\x9e\x31\xf5\xee\x1d\x6c\xb7\x16\x6a\xd3\x74\x58\x21\xad\x80\x41\xae\xdf\xf6\x97\x41\xf2\x58\xc8\x24\x2d\x56\x90\xaa\x0a\x0c\xd4\x08\xed\x68\x53\xbf\xb1\x78\x0d\xb4\x19\x5a\x00\xb5\xe6\x90\x33\x15\xaf\xb5\xfc\xc7\xba\x88\xc1\x20\x85\xa7\x35\x2f\xa0\xe2\x2a\x04\x56\x24\x20\x50\x4c\x3e\xa5\x95\x36\xae\x6a\x59\x54\xda\xd8\x3f\x5c\x0a\x78\x64\x59\xcd\xd1\xbe\x7a\x2e\xf9\x38\xd2\x4a\xc9\x3a\x56\xb0\x75\x9d\xeb\xa2\xe2\x52\x91\x13\xfc\xf1\x51\x3c\x56\x9b\x92\x49\x96\xa3\x06\xfe\xb3\x64\x34\x0d\xfc\xdc\x33\x15\x82\x0e\x05\x44\x51\xf4\xf5\xf6\xb6\x54\xa9\x28\x02\xc0\x38\x09\x69\x78\x4e\x97\x1d\xd5\x48\x97\x73\x67\x5e\x5f\xd1\x87\xf3\x99\x3d\x92\x3d\x78\x45\xd4\x1c\x49\xd5\x70\x3f\xf0\x8c\xbf\x2a\x5c\x6d\x5c\xb2\x62\x85\x49\x74\x5d\x24\x7c\xc3\x2b\xb2\x83\x34\x45\xda\x8d\x35\xd0\x52\x47\x42\xd1\x75\x75\x57\xa4\x7f\xd7\x44\x21\x4a\x4b\x5e\x0a\x9b\x26\x11\x7e\x9b\xc1\xb7\x12\xe6\x4f\x96\x56\x5d\x0d\xc0\x92\x65\x98\x29\x18\xf6\x29\xa8\xbe\xd9\xcb\x17\x4c\x99\xdd\x86\x0c\xfc\x80\xc8\xd1\xea\x97\x45\xf2\x09\xa5\x3a\x34\xf7\x0f\x47\xf0\x50\xb0\x76\xaf\x28\x6d\xbf\xb9\x4e\x5e\x63\x62\x40\xf5\x5c\xc4\xd1\x4d\xad\xf8\xc6\x75\xa8\xb0\xee\x1f\xc6\xaa\xe1\x3d\xae\xb9\xa8\x36\x51\xd6\x7a\x99\x4a\x5b\x1b\xb1\x95\xcc\x13\xf8\xf6\x3c\x2a\x3e\x53\x76\xc6\xd2\xae\xf4\xd0\xdf\x0d\xb1\x98\x52\xcd\x9b\x46\x28\x96\xe6\x5d\xfb\x42\x27\x44\x73\xe4\x3a\x56\x12\xb5\xb1\x29\xb8\x46\xf9\x17\xec\x7b\xc0\xb0\xb9\x68\x79\x6c\x82\x75\xce\x0b\xe4\xb2\x67\x00\x19\xdb\xc4\x59\x6d\xfa\x8e\xf9\x26\x0a\x64\x43\xa1\x39\xa3\x7b\xff\x80\x2d\x97\xcb\x25\x8b\xf9\xb6\xb1\x0c\xd0\xf6\xec\xa3\xdb\xb4\x12\x1d\x12\x1d\x68\xd0\x91\x6e\xfb\xf8\x5e\x19\x74\xbb\x0d\xac\x11\x3f\xef\x43\x0f\x35\x52\x13\xf0\x9e\xef\x40\xd3\x31\x30\x19\xe5\x75\xf4\x09\x8d\xf8\x81\xeb\x24\x7c\xc9\x25\x1c\x2c\xdf\x15\x19\x09\xec\xab\x52\xac\x17\xc0\xca\x12\x33\xc2\x1f\x59\x0c\x27\xc3\xb3\x25\x9e\xaf\xec\x76\x43\x43\xf2\x95\xc1\xdc\x04\x96\xa2\xf7\xc6\xbe\x6d\xba\x86\xd7\x2e\x27\x6c\xff\x16\x9d\xba\x90\x30\x48\x1a\x12\xd0\x8d\x5c\x5b\xca\xbb\xf0\xf3\xbc\x54\xcf\x67\x91\x6b\x50\x0c\xb9\x0d\x66\x12\xfc\x07\x19\x26\xdc\x78\x6e\x4e\x7b\xc0\x14\x72\x96\xb8\xdf\x3f\x43\x88\xb5\x24\x75\xbc\xb1\xd0\x20\x14\x07\xfb\x9c\xc5\xbe\x58\xe8\x73\xf5\xfb\x77\xc0\x62\xed\xbe\xd8\x35\x2d\xe9\xec\xc5\xd3\x46\x30\x46\xdc\x0e\xba\xd4\xf5\x4e\xb1\x20\x72\x6d\x90\xfe\x48\xd5\xfa\xcb\xa6\xcb\xe3\xb6\x22\xcc\xc9\xd9\x0f\xdd\xd8\x6e\x42\xa8\x44\xa7\x61\x8e\xd5\x9c\x25\x1c\x9e\xd0\x24\xa8\x8d\x29\xb9\x2e\xa0\x4a\xac\xb8\x3e\x88\xed\x2a\x2a\x99\x73\xb9\x3d\xe2\xcf\x08\x28\x21\xf6\xd1\xc1\xd7\xdb\x0f\xbf\x06\x87\x33\xc4\x41\x04\x6d\x7d\x79\xa4\xe9\x85\x08\x2e\xe8\xc8\x18\x88\x5a\x52\xe8\xb0\x1f\x27\x85\x58\xde\x8d\x03\x67\x61\x27\xb5\x1f\x3e\x29\xa7\xb7\x48\x0e\x3c\x53\xb8\x9d\x51\xb2\x83\x5b\xc6\x64\x1a\xaa\xf5\xa6\x9a\x9f\x70\xd6\x4b\x4d\xfa\x8f\x32\xd3\x13\xb5\xf0\xb1\xd6\xf7\xc0\x93\x1f\x84\x1b\x0c\xd2\x0d\xcd\xba\xcd\xc8\xbc\xa3\x99\xa6\x91\x67\x8e\xe9\xdd\x50\x74\x16\xd3\xa4\x76\x41\xa6\xc9\xc1\xc9\x4c\xf7\x66\xbb\x63\x4c\xef\x44\x5f\xc6\xb4\xe6\x55\x0f\x7e\x73\xac\xb6\x83\xe1\x59\x9c\x6a\xa5\x0b\x32\xaa\xcd\x9f\xcc\x67\x37\xd9\x1e\x63\xb3\x15\x7c\x79\xd6\xb6\xe3\x12\xd2\x4a\x23\xef\x1c\xb1\xbb\xa1\xf8\x2c\x6a\x49\xed\x82\xe4\x92\x83\x93\xe9\xed\xcd\xf6\xc7\x08\xde\x89\xbe\x9c\xe2\x53\xa7\xfe\x37\xf6\xbc\xd3\xc7\xe6\x70\xb8\x6e\xc7\xd2\x56\x02\x19\x9b\x89\xd2\x40\x90\x82\x35\x7d\xa9\x98\x0c\x63\x7f\xe8\xee\xc5\x72\x60\xfc\xe2\xb7\x8e\xe9\x90\x0f\x70\x78\x13\x9e\x8d\x4f\x72\x3f\x97\x0f\x07\x84\x1d\x4d\x8b\x03\x8d\xfd\xec\x98\xe6\x61\x08\x67\x2c\x6d\xc2\x7e\x79\xda\x1b\xd7\x7f\x19\xa6\x53\xaf\x64\xff\x07\x6b\xe6\x3a\x8a\x05\xfc\xc8\x65\xba\x1c\xbf\x2f\x42\x9a\x97\x19\xa7\xab\xdb\xfe\xba\xfb\xc8\x70\x9e\x3e\x9c\x04\x17\xb6\x6e\x0e\x82\xef\x23\xa2\xc0\xfd\x17\x7f\x9e\xed\x58\xa1\x13\x00\x00"
+
+func mssqlMockGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlMockGoTpl,
+		"mssql.mock.go.tpl",
+	)
+}
+
+func mssqlMockGoTpl() (*asset, error) {
+	bytes, err := mssqlMockGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.mock.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlOptionalGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x55\x4d\x6f\x9b\x40\x10\x3d\x9b\x5f\x31\x8d\x5c\x09\x47\x84\xe4\x1c\xc9\x87\x24\x6a\xa3\x1e\x52\x59\x6a\x7a\x8a\xa2\x6a\xc1\x8b\x41\x86\x5d\xba\xbb\x4e\x14\x21\xfe\x7b\x67\x66\xb1\x8d\x63\x43\xda\xde\x76\xe7\x6b\xdf\xcc\x7b\x03\x4d\x73\x01\x45\x06\xf1\x83\x5e\xca\xf2\x51\x2f\x6e\xa1\x6d\x83\x06\x8d\xd3\x7a\xbd\x82\xeb\x39\xdc\xeb\x85\x48\xd7\x62\x25\xbf\x8b\x4a\xf6\xe2\xee\xb4\xca\x8a\x55\xfc\xad\xaa\xb5\x71\x3f\xa4\x79\x29\x52\x09\x17\xdb\x64\x9b\xa3\x95\xd2\xf9\xa0\x38\xf5\xf1\xad\x96\x31\x57\xa1\xb0\xcb\x4b\x68\x9a\xbe\xb1\x6d\xf7\x18\x52\xad\x5e\xa4\x71\x16\x5c\x2e\x8f\xc2\xc0\x69\x28\xd0\x57\x1b\x8d\xa7\x4a\x5a\x8b\xe8\xe2\x20\xdb\xa8\x74\xb8\x64\x88\x9e\x0e\x15\x56\x38\x7f\x1f\x37\x83\x90\x6c\xdc\x74\xdb\xc6\xef\xdd\x11\x48\x63\xb4\x99\x41\x13\x4c\xd0\x57\xed\x80\xc6\x34\xae\x36\x38\xd5\xcd\xe2\xf6\x51\xf3\xf3\x87\xdd\x1c\xa0\xa6\x56\xc4\x51\xe6\x40\x2f\xbb\x82\x21\xd7\x38\x1a\xcb\x58\x03\x5d\x7f\x63\x4d\xed\xf1\x8e\x34\xc5\x01\x96\x5a\xb7\xe3\x24\x59\x6a\x0d\x1d\x85\x39\xec\xd8\x8e\x11\xe5\x0b\x87\x46\xbf\x5a\x78\x7a\x3e\x45\x92\xb7\xfe\x0d\x4d\x46\x5a\xd2\x5f\x25\xd6\xf2\xc3\xac\x52\x2a\x7e\x74\x36\x0b\x26\x99\x36\x50\x44\x80\x57\x4a\x37\x42\x21\x4b\x0c\x08\x6b\x4e\xea\x84\x9f\x20\xcf\xb0\xd2\x30\x1a\xeb\x4c\x70\xab\x28\xf4\xd3\x1c\x54\x51\x72\x36\x62\x72\x1b\xa3\xe8\xce\x65\xd0\xd4\x06\x64\xb5\x4f\xc5\x33\xcc\xa1\x4e\x02\x34\x04\xdb\x30\xb4\x47\x14\x3b\xa8\x2f\xdb\x11\x66\x47\x14\xc6\x3c\x1c\x91\x33\xa8\xb0\x5d\xc9\xb0\x4e\xb6\x1c\x8c\x68\xea\x04\x49\xe3\x1c\x9c\x18\x3c\x3e\xd4\x9b\x7b\x9d\xec\xc7\x4e\x10\x68\x6e\x38\xd0\xc1\xb1\xf7\x96\x22\xf9\xbf\xa9\x63\xf5\xa1\xb1\x37\xfe\xe3\x58\x09\xbb\xce\x0a\x59\x2e\xad\x5f\x8d\x53\x6c\xdc\xd4\x75\xf9\xf6\x95\x82\x1e\x30\x1a\xac\xec\xc8\xe8\xf2\x74\x06\x07\x1f\xa0\x42\x79\xaa\x84\xcb\xd9\x49\x4f\x20\x53\x54\xf9\x30\xeb\x88\x50\x94\x26\xe3\x2c\xd4\x8a\x9d\xa9\x2e\x37\x95\x62\x9a\x37\xf5\x52\x38\x09\xaf\x85\xcb\xa9\xd0\x4f\xbe\xde\x79\x7f\x0c\x37\x65\xd9\xaf\xed\x72\xe1\x20\x15\x0a\x12\xd9\x25\x2e\x41\x18\x49\xc8\xe1\x35\x97\xca\x43\xca\x85\x05\xc5\xb0\x18\xea\x80\x6e\x0e\x9b\xff\xe0\x53\x1b\xc1\xbf\x7f\xbe\x22\x8f\xe6\x9c\xb1\xd3\xb1\x4e\xe2\xdd\x7b\xac\x43\xeb\x0c\x4e\xa4\x2f\x3e\x3f\x5b\x96\x9f\x5d\xc7\xf7\xd2\x2d\xc8\x10\xa2\x48\x90\x52\x16\x1e\xdd\x67\x30\x9f\xc3\x95\x5f\x6e\x4e\x98\xc3\xb6\x58\x03\xc4\xbf\x97\xe2\x14\xa5\x39\xcd\xb6\xd5\x7a\x62\x40\xa4\x58\x6e\x5a\x30\x48\xbc\x48\xb5\xc4\xe3\x19\x9e\xb8\x4b\xfe\xf3\x61\xa2\x37\x91\x13\xff\x7d\xd0\x7a\xc1\x21\x75\xfd\xfd\xd8\xb6\x70\x15\xf5\xe0\x75\x9b\xf1\x2b\x62\x02\x7a\xbb\xc1\x60\x09\xb6\x45\xbe\xd3\xdc\xbb\xe9\x8e\xef\x08\xe2\x63\x37\x2b\xc4\x49\x3f\xdc\xc9\x64\x29\x33\xb1\x29\xdd\xf5\xfb\xa5\xc8\x2a\x17\x7f\xa1\xb9\x65\xe1\x19\x67\xc1\xe7\xdf\x24\x0d\xa5\x5d\x4f\x1d\x67\x1e\xc2\xac\xdb\x1f\x06\x3f\xa7\xa7\xb0\xab\x90\x6e\x3b\x7f\x6f\x99\xbc\x7d\xbf\x4d\x7e\x3c\xfd\xe3\x1f\x3f\x69\xd6\x53\x81\x08\x00\x00"
+
+func mssqlOptionalGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlOptionalGoTpl,
+		"mssql.optional.go.tpl",
+	)
+}
+
+func mssqlOptionalGoTpl() (*asset, error) {
+	bytes, err := mssqlOptionalGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.optional.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlPrototestGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x41\x4f\x83\x40\x10\x85\xcf\xf0\x2b\xa6\xc4\x26\xb4\x52\x7a\x27\xe9\xa5\xb1\xde\x34\x8d\xe1\x66\x3c\x2c\x30\x50\xe2\x76\x77\xdd\x5d\x30\x86\xf0\xdf\x9d\xdd\x62\xb5\x89\x36\x26\x1c\x36\xbc\xf7\xbd\x79\x33\xc3\xb0\x82\x1b\x0b\xd9\x06\xd2\xfc\x43\x21\xac\xc6\x31\x5c\xaf\x21\x47\x63\x87\x81\x94\xf4\x91\x1d\x11\xc6\x71\xbf\x7d\x92\x9d\xa8\x72\xdd\x2a\xb0\x24\x1a\x28\xa5\xe8\x51\xdb\x56\x34\x70\xe1\x34\x60\x25\xd8\x03\xb6\x1a\x94\x96\x56\xba\xb8\x23\x1a\xc3\x1a\x34\xc0\x44\x05\x05\x2b\x5f\x13\x78\x6f\xed\x01\x18\xe7\x93\xb5\x6e\x91\x57\x06\x0c\xda\xc4\x9b\xbc\x7c\x92\x44\xc7\x39\x2b\x38\xba\xa0\xc9\xe6\x7e\xa5\x61\xdd\x89\xf2\x6a\xd3\xd8\xc2\xd2\x95\xa5\x8e\x69\xbe\x80\x21\x0c\x4e\xd5\x69\xdb\x23\x53\xcf\xc6\x6a\x52\x5e\x96\x17\x38\x99\x82\xa8\x67\xbc\x43\x13\x65\x0e\x09\x02\xd2\x55\xe1\xc8\x69\x7a\x0a\x35\xe3\xc6\x99\x49\x1d\x13\x07\xb8\x42\x57\xfc\x56\x77\x3f\xec\x63\x18\x06\xb5\xa4\xc5\x68\x22\x1d\x82\x09\x7f\x7f\xcd\x44\x83\xd3\x6d\x5d\x8c\x2a\x12\x40\xad\x9d\x74\x51\xf0\x41\x56\xc8\x73\xb9\xdf\xc6\x8e\x5c\x90\xb3\xad\xbd\x71\xb6\x01\xd1\xf2\x53\x05\x9b\xde\x33\xcb\x78\x1d\x47\x73\x93\xc1\x19\xc9\x60\xde\x47\xc9\x34\x98\x18\x47\xbb\x36\x41\x23\xed\xef\xd3\xf6\xdb\x5c\x7a\x3c\x56\xc5\xbf\x66\x9d\x81\x3f\x67\x51\xc4\x4c\x63\xcd\xb1\xb4\xe9\x1d\xa2\xda\xbd\x75\x8c\xc7\xbe\x81\xdf\xe8\x2b\x75\xa7\xb5\xd4\x53\x2a\xa9\x30\xbf\xed\xa7\x6b\xd1\xeb\x9c\xfc\xcd\xf9\x7c\xfa\xc6\xf0\x13\xe9\x4d\x8e\xd5\xd5\x02\x00\x00"
+
+func mssqlPrototestGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlPrototestGoTpl,
+		"mssql.prototest.go.tpl",
+	)
+}
+
+func mssqlPrototestGoTpl() (*asset, error) {
+	bytes, err := mssqlPrototestGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.prototest.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x6f\xd3\x48\x14\x7e\x4e\x7e\xc5\xac\x15\x21\x1b\x8c\xe1\x61\xb5\x0f\x95\xfa\xc0\x42\x57\x42\xcb\x52\x58\x78\x40\xea\x56\xe0\xd8\x93\xc4\xc2\x99\x49\xc6\x0e\x24\x8a\xf2\xdf\x39\x97\xb1\x3d\x76\x9c\x6e\x55\x68\x97\x6a\xf3\xd0\xd6\x99\xcb\xb9\x9f\xf3\x9d\xe3\x74\xbb\x7d\x2c\x46\xc5\x4c\x9b\x52\x9c\x9c\x0a\x9f\x9e\x54\x3c\x97\x22\x7a\xbf\x59\xc8\xe8\x35\x3e\x7a\xd2\x18\x4f\x78\xc5\x32\x2f\x4a\x7c\x48\xc7\xf0\x6b\x09\x3f\x46\x16\xf0\xfb\xc3\xf9\x2b\x3d\x85\xbf\x13\x05\xbf\x62\x33\x85\xb5\xe8\xed\x4a\x9a\xcd\x9b\xd8\xc4\xf3\x22\x10\x8f\x77\xbb\xe1\x16\xf9\x2c\x71\xf5\xb9\x9e\xcf\xa5\x2a\x0b\xe4\xc7\xe7\xea\x95\xfa\x20\x52\x21\x79\xe8\x06\x7d\x8a\x1c\x3a\xc0\x97\x76\x17\x26\x53\xa5\xf0\x1e\x7a\x8e\xb4\xce\x31\x95\xe5\x78\xcc\x83\xbf\x5e\xbd\x9a\x4d\x44\xf4\x2e\x89\xf3\xd8\x88\xdd\x6e\xbb\x65\x62\x40\xcb\xc8\x12\x48\x08\x3f\x53\xa9\x5c\x5b\x7a\x7f\x64\x32\x4f\x0b\xf1\x34\xa0\x8f\x81\xbd\x80\x64\xe9\x02\x3c\x5c\x75\xe7\x75\x96\x3b\xd7\xa4\x4a\x5d\x05\xca\x46\x32\xda\x06\xb1\x62\x38\x11\x9d\xab\x7c\x73\xae\xe4\x9e\x8c\x25\xb0\x24\xce\x7b\xc4\x50\xa1\xb3\xb5\x4c\x5a\x0b\xd6\xa4\xb4\xf6\xe4\x89\x80\x2b\x53\x9d\xd8\xb5\x7a\xd3\x9e\x97\x79\x21\x9d\x83\xec\xf3\xdd\x4e\x98\x95\x2a\x44\x2c\x92\x55\x51\xea\xb9\x28\xca\xb8\x94\x78\x2d\x14\x20\xcd\xca\xa8\x4c\x4d\x45\x39\x93\x42\xad\xe6\x63\x69\x84\x06\x05\x26\x13\x99\x94\x32\x15\x46\x7f\x2d\x22\xa6\x0d\x82\x5a\x36\x5f\xb3\x72\x06\x6a\xbd\x7d\x25\x88\x15\x72\x7b\x0f\xd7\xc9\xc3\x22\x2b\x4e\x78\x6d\x60\x45\x4d\xc1\x04\xb5\x80\x4c\x64\xb2\x52\x89\x2b\xa0\x0f\xcf\x49\xb9\x5e\x60\x94\xc1\xc7\x74\x2c\x3e\x9c\xbf\xf8\x1d\x16\x4d\xac\xa6\xb2\x15\x83\xb5\x8d\x95\x06\xfd\x5f\xc8\x49\xbc\xca\x51\xff\xb0\xa5\x30\x3e\xa3\xc7\x1a\x1b\x3b\x0f\xa1\xd0\x0b\x08\xd1\x28\x8a\x3e\x9c\x9f\x2f\xca\x4c\xab\x00\x1d\x5f\xfe\xf6\x6b\x28\x20\x3f\xb4\x09\xc4\x76\x38\x40\x71\xd7\x5a\xd3\x3e\x72\xad\x56\x32\x05\xa9\xb3\x62\xf3\x73\x4e\x79\xd6\x6b\xd5\x19\xb2\x03\xe9\x92\xb2\x78\x05\x1b\x80\x36\x81\x8f\x34\x0b\x9d\x27\x33\x99\x7c\xc6\x0d\xef\xa9\x47\x9b\x60\x44\x48\x4b\xbe\xdc\x84\xb7\x9c\x72\x3e\xe1\x89\x2f\x10\x44\x9c\xb9\xe0\x42\xc8\x97\x29\x2f\x51\x4e\x5d\x5c\x12\xe1\x49\x9c\xc8\x2d\x9b\x9a\x4d\x37\x2a\xe4\x94\xd2\xd3\xa5\x44\x81\x0b\x91\x4e\x81\xdb\x44\x2d\x9e\x85\x88\xaa\x8c\x45\x27\xe0\xc0\x3f\x25\x0b\x08\x27\x70\xd5\x39\x04\x66\xea\x44\x46\xc3\x34\x02\x77\x35\xdc\x2a\x7f\xbd\xb1\x1e\x46\x5b\x30\x83\xdd\xce\xaa\xf4\xe8\x54\x7c\x42\xb7\x71\x58\x7d\x6a\xe2\x19\xed\x40\xf7\xc0\xca\x8b\x98\x79\xf5\x5e\x5f\x6b\x0e\x11\x3f\x97\xca\x47\xab\x04\xa1\xc0\x47\xa4\xca\x04\x6c\x78\x04\x81\x4b\x60\xa2\x8d\xf8\x18\x8a\x2f\x68\x0d\x96\x7f\xef\x02\xc7\x43\x75\x61\x40\x16\x3f\x15\xf1\x62\x01\xaa\x13\x27\xb8\xde\xa2\xe9\xa4\xe3\x21\x69\x61\x4d\x95\x33\x0a\x93\xa9\x16\x5e\x2d\xb3\xd7\xb9\xd1\xc7\x0c\x76\xab\x72\xda\xd8\x34\xe8\x3a\xc3\x79\xec\x78\x77\x38\xd8\x3b\xe1\x3e\x3a\x62\xa3\xf1\x5f\xda\x90\x85\xaa\x01\xcb\x10\x72\x98\x49\x7c\x26\x81\xdc\x28\xeb\xc4\xaa\xa2\x93\x94\xb3\xa1\x90\x85\x62\x94\x37\x00\xd1\x04\x5b\x86\x17\x1e\xb9\xd9\x09\xab\xb6\xfe\x76\xe0\x65\x94\x61\xe5\x15\x5c\xd4\x0e\x9c\xe8\x64\x7a\xc5\x01\x95\xb0\x25\x16\xa3\x6b\x84\x55\xf7\x53\x7d\xd0\xd5\x9c\x32\x10\x0a\xa5\xcd\xc0\x01\x61\xa1\xcf\x1a\xe1\x4d\xf2\x03\x5a\x79\x00\x30\x43\x85\x02\xb5\x4a\xc7\x11\x6c\xa6\xe3\x89\x12\x1e\x16\x01\xaf\xa9\x66\xe8\x9c\xca\xe1\x6d\x02\x20\x1c\x5e\xff\xe5\x54\x20\x0c\x40\x6c\x0d\xb8\x0e\x8b\xa7\x44\x17\xbd\x33\xac\x96\xd6\xfa\x6f\x28\xc1\xcf\x6c\x3d\x06\xa8\x2a\x82\xe1\xae\x9d\x1c\x7f\xc5\x8b\x5b\x46\x0c\x32\x49\x17\x2d\x12\x9d\xaf\xe6\x70\x0a\xe0\x02\x3f\x82\x64\x54\xea\x32\x85\xb4\xb4\x49\xa5\x09\x09\x08\xdd\xcd\xb8\x10\xf3\x78\x51\x88\xcf\x72\x03\xe0\x32\xde\x58\x22\x02\xfb\x94\xff\x05\xcc\x5c\x5c\x72\xe9\x0e\xa1\x62\x83\x25\x2e\xf8\x93\x5b\xbc\x6f\x8a\x41\xde\xbb\xb3\x57\x67\xcf\xdf\x7b\xe2\x86\x30\x04\xa1\x18\x0a\xdb\xc5\x1c\xd1\xe8\x88\x46\x47\x34\xba\x1f\x68\xb4\xec\xc5\x22\x52\xef\xfb\xc0\xa8\x2a\x08\x35\x26\x0d\xa0\x8e\xc0\x78\xb0\x8c\x9e\xe7\xba\x90\x7e\xe0\x82\x14\xcc\x38\x0a\x70\xa8\xf0\x97\x2d\x78\xba\x63\x58\x72\x60\xc6\x46\xcb\xde\x7c\xc8\x8e\xe1\x78\xa9\xca\x77\x45\xbe\xf6\xc4\xed\x43\x91\xf8\x09\xb0\xc8\x21\x5a\xcd\xaa\xbb\xdd\xc5\xa5\x7b\xdb\x9a\xec\x3f\xc2\x24\x1a\x96\x2b\xac\xc1\x20\x25\x29\x86\x47\x74\x3a\xa2\xd3\x11\x9d\xee\x05\x3a\x59\x7b\x1e\x78\x1f\xc6\x39\x49\x89\x42\xaf\x2d\xb9\x8a\xd9\x9a\x33\x1c\x60\xc6\xf7\xa0\x1a\xcc\x43\xd7\x00\x36\xe4\xa2\xfc\x07\x2e\xf1\xab\xc0\x6e\xbb\xad\xde\xc9\xed\x8f\x60\x2e\x0d\x42\xc4\x76\xbe\x39\xea\xdc\x2e\x16\x1f\x86\x61\xf0\x46\xae\xe3\xb4\xc2\x3f\x1a\x51\x51\x0a\x2a\xe6\x15\xec\xc1\x4d\x4c\xdf\x65\xf4\x5a\xae\x4b\x9f\x6a\xf9\x95\xf6\x87\x6d\xac\xb3\x60\x46\x78\x62\x5f\x2c\xfb\xad\xda\x23\xf7\xbe\xe0\x64\xd1\x01\xbf\xa2\xb5\x09\x4a\x83\x74\x87\x96\x63\x77\xda\xee\x9a\xbb\x15\x4a\x53\xa9\xa4\xc9\x92\x0a\x86\x5c\x37\x59\x3f\xac\x35\x59\x1f\x0e\x5f\x74\xd1\xfe\xb2\xe5\x8e\x74\x1c\x8a\x1b\xbb\xe4\x9a\xa1\xd2\x12\xd7\x7d\x8b\x60\xa5\x7c\x96\xe7\x77\x22\x65\xaf\x61\x9d\x1e\xa0\x3f\x2f\x5b\x62\xfd\x90\xec\xac\x4a\xf3\x84\x5f\xb4\x73\xfb\x72\xad\x74\x75\xd5\xaa\xf0\x9f\xc4\x7b\x63\xb2\x79\x6c\x36\x7f\xca\x4d\x5d\xa7\x0a\x68\x22\xe4\x3a\x2b\x4a\xa9\x12\xd9\x0e\x93\xe8\x23\x6d\x60\x48\x42\xef\x22\xdb\x25\xce\xb2\x7a\x70\x85\x4f\x7f\xce\x9c\x7f\xb8\xd7\xd7\xf6\xa5\x7e\xcb\xbd\x27\xa7\x7b\x1e\xe6\x33\x07\x4d\x0b\x29\x6e\x8d\x77\x42\xb6\x0b\x99\x64\xf5\x75\x86\x4d\xf7\x03\x05\xe4\x5f\x1d\xff\x5d\x15\xa5\x5b\x9e\x0e\x46\x3e\x7b\xba\xdd\x75\x39\x59\xd0\x19\x3e\xce\xe2\x64\xc6\x03\x08\xbd\xfd\x6a\x8d\x20\x00\x01\x39\x0e\x20\xe0\x7a\x1a\x15\x24\x9d\x25\xcf\xe0\x30\x12\x5b\x52\x37\x9f\x48\x42\xa2\xab\x57\x25\x39\x1d\x59\x01\x47\xe7\x9d\x5b\xa9\xc5\x5c\xce\xb5\xd9\x44\xe2\x25\x74\x20\x31\x76\xe7\xd0\xf9\xea\x05\x30\x2f\x51\x60\x94\x60\x92\x99\xa2\xe4\x26\xda\x0e\x4d\xfc\x12\x6e\xa2\x80\xfc\x2c\x03\x91\xb3\xa2\xde\x88\xf6\x66\x16\x34\xc0\x5d\x8f\x2d\x60\x50\x14\xc3\x6f\x8c\x15\xb0\x02\x7d\x13\x0d\x6b\x76\xbd\x19\xc5\x7e\xe3\x68\x47\x15\x54\xcd\x0b\x7e\xc4\x6b\xb4\xe3\xeb\xb3\xe3\x80\x72\x1c\x50\xee\xe3\x80\x52\x77\x67\x3e\x45\x37\xd7\xe9\xc0\xf6\x6a\xf6\x35\x17\xa9\xed\xd4\xc1\xa6\x2b\xc3\x1a\x7a\x80\xfe\xed\xb7\x0a\x57\x76\x09\x0b\xa3\x13\x59\x14\x4d\xa3\xd0\x6d\x05\xf6\xfe\x97\xe2\x2e\x06\x03\x07\xc1\x99\xc4\x84\x72\xdb\xbd\xdd\xb2\xdd\xbd\xed\x57\xfa\x14\xed\xda\xc9\x89\xdc\xeb\x90\x72\x3b\x9a\x65\x74\x66\x8c\x1f\xec\x37\x34\xfd\xe5\xa0\x17\xa6\x6b\x84\x6a\x40\xba\x6e\x7e\x18\x5b\xa9\x1e\xc8\x92\x5b\x1f\x17\xbe\xb9\x11\xd0\x8c\x26\x4e\xd7\x62\x31\xc2\x87\x50\x1b\xd5\x3d\xd5\x88\xff\x4f\x62\x84\xdf\x58\x52\x11\xc0\xb4\x73\x2f\x62\x66\x39\xe0\x8f\x8c\x1a\x91\xb0\x53\xb1\x10\xec\xf4\x26\xb5\x7c\xfe\x81\xae\x22\x10\x55\x83\x80\xb6\xac\x33\x99\x94\xf7\x3d\x97\x7b\xe4\x50\xf0\x5a\x5d\x4a\x70\xd0\xa6\xdf\x00\x4e\x85\x3a\x23\x75\x25\x00\x00"
 
 func mssqlQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mssqlQueryGoTpl,
-		"mssql.query.go.tpl",
+		_mssqlQueryGoTpl,
+		"mssql.query.go.tpl",
+	)
+}
+
+func mssqlQueryGoTpl() (*asset, error) {
+	bytes, err := mssqlQueryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x91\xcd\x6e\xc2\x30\x0c\xc7\xcf\xed\x53\x78\xd5\x24\xe8\x24\xc2\x7d\x12\x27\xc4\x8e\x3b\x0c\x1e\x60\xa1\xb8\x1f\x52\x93\x30\x27\x15\xa0\xaa\xef\x3e\xbb\xcd\xa0\x68\x87\x3a\xae\xfd\xf3\x3f\xb6\xd3\xf7\x2b\x78\xf5\xb5\xa3\x00\xef\x1b\x58\x8e\x9e\xd5\x06\x41\x7d\x8a\xcd\x90\x28\x83\x8c\xdc\x25\xcb\x61\x35\x0c\x69\x2f\x7c\xd0\xc7\x16\x27\xbe\xa8\xd1\x68\x50\xfb\x78\x1e\x24\x33\x59\xa9\x7f\xd4\x34\x25\xa8\xad\x33\x06\x6d\x18\x63\xeb\x35\xf4\x3d\x54\xae\x88\xb1\x7b\x32\xf2\xd8\x7a\x9c\x81\x53\x37\xc3\x00\x84\x67\x42\xcf\xa0\x07\x0d\xdc\x16\x94\xe4\x0c\x2c\x18\x89\x5d\x0d\xc3\x42\x4d\x0a\xf6\x24\x62\xe1\x76\xc6\x27\x05\x1f\xa8\x2b\x02\xf4\x23\x44\xda\x56\x3c\xeb\xce\x1c\xf1\xe4\x05\x4f\x66\x28\xbb\x97\x26\xd4\x80\x92\x0d\xba\xf2\xa0\x44\xe0\x5b\x10\x76\xe4\x8c\x97\xcc\xee\x9b\xa9\x7e\x34\xd8\xfe\x57\x95\x66\x08\xc7\xb6\xd4\x41\x2c\x87\xe2\x90\x5b\xd7\xca\xd7\x19\x1b\xd9\xb9\xf0\x7d\x8f\x15\x5a\xa4\xa6\x18\x85\x65\x3d\x57\xb7\x2f\xb4\x05\xcf\xc6\x8f\x2b\x69\x6c\x70\x10\xea\xa7\xb1\x55\x5a\x76\xb6\x80\xa5\x2c\x6a\x7a\x6e\xbe\xf6\x6d\x06\xe4\x51\x67\x29\x0a\x57\xf7\xe5\x2e\x39\xf0\xe3\x3b\xe2\x4d\x25\xec\xc8\x73\x73\x4a\x8d\x0c\xd7\xfd\x74\x48\xb7\x72\x9a\x50\x3d\x34\xf3\x34\xe1\x16\x85\x7f\xd9\x80\x6d\x5a\xa9\x4e\x78\xdc\x8e\xac\x44\xd3\x84\x7b\xfe\xfb\xe7\x74\xfa\x34\xe2\x2f\x01\x76\xbc\x6d\x8d\x02\x00\x00"
+
+func mssqlQuerytypeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlQuerytypeGoTpl,
+		"mssql.querytype.go.tpl",
+	)
+}
+
+func mssqlQuerytypeGoTpl() (*asset, error) {
+	bytes, err := mssqlQuerytypeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlRepositoryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\xdf\x6b\xdb\x30\x10\x7e\x8e\xff\x8a\x23\x8c\x61\x97\xd6\x7d\x2f\xec\x25\x84\x42\x61\xb4\xb0\xb6\xac\x30\xc6\x50\x6c\x39\x11\xd8\xb2\x27\xc9\xa9\x8b\xc9\xff\xbe\x3b\xcb\x4e\x94\x38\x6e\x33\xea\xad\x2f\xfe\x21\xe9\x3e\x7d\xf7\x9d\xee\x4e\x75\x7d\x01\x9f\xf4\x2a\x57\x06\xae\xbe\x80\xdf\x7c\x49\x96\x71\x08\x6f\xe9\x39\xe5\x4a\x4d\x61\x1a\x2f\xf0\x91\x17\x46\xe3\xcb\x54\xd3\x00\x2e\x36\x1b\xaf\x26\x53\x75\xcc\xd6\x2f\x94\x90\xa6\x83\xf8\xc6\x8b\x5c\x0b\x93\xab\x17\x34\x3c\x0e\xd8\x51\x08\xaf\x05\x4f\x63\xed\xe0\x97\x45\xcc\x0c\x27\x7c\x89\xb8\x09\x4d\xd3\x16\x3a\x2b\x53\x23\xba\xf5\x9d\xb9\x6f\x57\x8b\xa5\xcc\x15\x7a\x10\xe0\x76\xd3\x06\xea\xf2\x12\xea\xba\xe5\xb3\xd9\xdc\x1b\x9a\x16\x1a\xcc\x0a\x5f\xd2\x70\x95\xb0\x88\x43\x92\x2b\xd0\x38\x25\xe4\x12\x98\x8c\x41\x71\xa3\x04\x5f\xd3\xaf\x63\x0c\x2a\x7f\xd6\xa1\x67\x5e\x0a\x7e\x04\x73\x0b\x56\x7b\x13\xdc\xf4\xbb\x30\xab\x87\x8a\x90\x4a\x25\x35\xb0\xbe\x45\xa9\x09\xdf\x54\xe7\xa0\xcb\x68\x05\xcc\x92\x32\x8a\x49\xcd\x22\x23\x72\x09\x79\xd2\x40\x3d\xdd\x59\xb0\xd0\x9b\xd8\x0f\xdf\x54\x38\x38\x9f\x05\x3d\x50\xcf\x9b\xdc\x48\xcd\x95\xf1\x71\x26\x32\x55\xc1\x14\xcb\x70\x12\xff\x5a\xa1\xd0\x8d\x33\xc7\xea\x1c\x28\x14\x10\x86\xe1\xd3\xdd\x5d\x41\xbb\x06\x80\x71\xca\x55\x13\x03\x91\x6c\xc3\x80\x52\x4e\x1e\x9b\xcf\xf7\x43\x4f\xee\xd9\x7a\x04\x18\x62\xc8\x31\x5a\x44\x6d\xce\x53\x3e\x06\x35\xc2\xc4\x08\x2c\xf1\x0c\xdd\xc8\x98\x57\x5c\x5b\x1c\x54\x22\xbc\x2e\x65\xd4\x02\x74\xea\xd8\x45\xe1\x8d\x7e\x94\xe2\x77\x69\x55\xc2\xd5\x0a\xcf\x7d\xc6\xcd\x2a\x8f\x21\xc4\xb1\x3e\xad\x65\xde\xfc\xa4\x42\x6f\x4f\x3e\x24\x2c\xd5\x14\xff\x72\x88\xa1\xdf\xb8\xf0\x80\xc7\x6f\xe7\x47\xc3\x3a\xb0\x52\x90\xf9\x3f\x21\xf0\x15\x57\x6d\x49\xfc\xf8\xf9\x06\x0d\x1b\x91\xdd\x27\xae\x6e\xc7\x36\xde\x41\x3a\xee\xea\x03\xe5\xe4\x70\x8e\x60\x5e\x2c\xb9\xe4\x0a\x4f\x5f\x0c\x09\x86\x41\x53\x9a\x12\x98\x75\x52\x37\x19\xec\xa6\xea\x33\x26\x0a\xcc\x67\xfd\x6c\x75\xb6\xd4\xe8\x6a\x64\x28\x5f\xe7\xb3\x26\x9f\x5a\x82\xb7\xfc\xf9\xb8\x41\xa4\x38\x32\x38\x20\xea\xcc\x5b\xb6\xf1\x22\xf4\x88\xe3\x20\x8e\x1f\x2f\xda\xf4\x3d\x3b\x8e\x83\x8c\x6c\xdd\x80\xcf\x47\x17\xd4\xf3\xd9\x15\x6e\xd3\x09\xfa\x5a\xa9\xe9\xb1\x33\x55\xcb\x8e\xce\x44\x57\xc3\x0f\xd2\x63\x67\x14\xc0\x5b\x15\xc7\x21\x3b\xe8\xaf\xa9\x82\x96\xaa\x2d\x4e\x58\x2c\xe9\x65\x0b\x9e\x1b\x35\x93\x37\x43\x58\x65\xd8\x82\x69\xfe\x77\x4c\x47\x2a\x7c\x8e\x43\xae\x71\xb8\x07\xcf\xd4\xb2\x05\xdf\xf2\x0a\xe7\x33\x8b\x89\x90\xe4\x6e\xbf\x7e\x92\x00\xb6\x84\x82\x1d\xec\x0b\x20\xe4\x3b\x04\x18\xa9\x3c\x0f\x09\xb0\x07\x7f\x82\x00\xe4\x2e\x15\x7a\xd0\xf8\x18\x39\xd6\xa3\x34\x90\x21\x47\x1d\xf0\x13\xe3\xdc\xd6\x37\xf2\xd8\x36\x22\x88\x9b\x57\xdf\xeb\x44\xe5\xd9\x3b\xfc\x1e\xa9\xcd\x0d\x79\xbe\x07\x7f\xa2\xef\xa7\x74\xcb\xb6\xec\x1f\x74\x25\x88\x58\x9a\xea\x46\x1e\x67\x71\xf8\x4a\x6f\x1d\x94\xca\xed\x46\xae\x5e\x1f\xd0\x8b\xf7\xb5\x75\x3d\x7b\x55\xd7\x01\x22\x0d\x05\xcb\xa6\x23\xe2\x9e\xbb\xb6\xe5\x7f\xbc\x2e\xa7\x5e\x11\xfe\xab\x3a\xc3\x37\x11\x3a\x90\x6b\xae\x44\xf2\x32\x74\x1d\xc9\x8a\x94\x67\x5c\x1a\xdd\xbf\x63\xaf\x99\x82\x5f\xfd\x46\xf8\xa5\x3d\x14\x7d\xb9\x7d\x29\xd2\xc0\xfb\x03\x3f\x5e\x2e\xa2\x70\x0d\x00\x00"
+
+func mssqlRepositoryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlRepositoryGoTpl,
+		"mssql.repository.go.tpl",
+	)
+}
+
+func mssqlRepositoryGoTpl() (*asset, error) {
+	bytes, err := mssqlRepositoryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.repository.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlServerGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x58\x5b\x8f\xd3\x38\x14\x7e\x6e\x7e\x85\xa9\xd0\x2a\x41\x25\xbc\x0f\x9a\x87\x69\xbb\xa0\x91\x80\x19\xc1\xc0\xee\xab\x9b\xb8\x6d\xd4\x5c\x8c\xed\x30\xed\x46\xf9\xef\x9c\x63\xe7\xda\xba\x99\xb6\x20\x04\xda\x97\x4c\x63\xfb\xdc\xbe\xf3\xf9\xb3\x33\x45\xf1\x92\x3c\x57\xe4\xea\x9a\xf8\x0f\x3b\xce\xc8\xcb\xb2\x74\x0a\x1c\xe3\x9b\x15\x8e\xbe\xcd\xee\x69\xb0\xa1\x2b\xf6\x81\x26\x8c\xf8\xef\xb3\x90\xc5\x0f\xd9\xfd\x74\x96\xa5\xcb\x68\xe5\xdf\x26\x3c\x13\xea\x13\x13\xdf\xa2\xa0\x67\x8c\xb6\x7c\x13\xa5\x21\xdb\xee\x7b\x96\x6b\x30\xc1\x79\x57\xff\x4a\xd1\xf1\x73\xe5\xeb\x00\x63\x26\xc4\x98\x8c\xc3\x05\x3c\x02\xb5\x85\xa7\x60\x5f\xf5\x53\xc2\x93\xeb\xe1\x2c\x96\x63\xaf\xe3\xce\xe6\xcf\xe5\x22\x4a\x55\xeb\x16\x33\x64\x02\xcc\x4e\x0d\x50\xa7\x09\xa5\xf8\x6f\x22\x16\x87\xb2\x13\x92\xc7\xb9\xa0\xb1\x2e\x51\xff\x8a\xfe\x6b\x2b\xc0\x45\xaf\x5e\x91\xa2\x68\x46\xca\xd2\x44\x27\x91\x24\x6a\xcd\x0e\xa7\x10\xba\x6c\xa9\xe7\xb8\xc8\x54\x46\xb8\x81\x5c\xaf\xc4\x3e\x94\xe5\x04\x7d\xe6\x32\x4a\x57\x7a\xd9\x8a\xa5\x4c\x50\xc5\x42\xb2\xcc\xd3\x40\x92\x65\x26\xfa\x6e\xc9\x63\xa4\xd6\x64\x3e\xf5\x1d\x85\xd8\xdb\xb2\x91\x4a\xe4\x81\x22\x85\x33\x6a\xc3\xf8\x9f\xd3\x28\xe1\x31\x4b\x58\x0a\xce\x6d\x89\x1a\x63\xc7\x19\xcd\xa7\xe4\xdf\xbb\xf9\x14\x7e\x41\x66\x37\xb9\x02\xb4\x10\x06\x5a\xff\x32\xb5\x06\x34\x8e\x65\x5d\xdc\xc7\xfb\x19\x49\x18\xcc\x87\xd2\xe4\x07\x83\x91\x20\xd0\x80\x9c\x49\xa5\x1d\x2d\x18\x94\xc2\x70\x62\x47\x44\x9e\xfa\xe4\x26\x8e\x3b\x8e\xa8\xe8\x44\x08\xc9\xe3\x9a\xa5\x24\x8d\x62\xdf\x19\xb5\x19\x20\x22\x2e\xb4\x96\x04\x19\x14\xb1\x55\xfe\xcc\xfc\x9d\x54\xb1\xb1\x70\xc0\x71\x82\x71\x09\x90\x84\x89\x25\x0d\x58\x51\x7a\x04\xa8\x91\x09\xa7\x74\x10\xeb\x0f\xec\xd1\x06\x5a\x20\x18\xc0\x0e\x89\x58\x21\x35\x0d\x0a\x17\xbe\x83\x49\x1c\xf1\xe1\x86\x0b\x8d\x9c\x47\x5e\xd8\x7c\x40\x3f\x04\x53\xb9\x48\xc9\x5f\x96\xe9\x62\x3e\xbd\x82\x00\x65\x95\x25\x1d\xc2\xfd\x10\x76\x83\x3a\xd4\x5d\x25\xe8\x62\x84\x6a\xff\x00\x67\x6c\xf9\x78\xad\xe7\x1f\x00\x15\xab\x8a\x96\xa4\x17\xce\x6f\x5b\x76\x7d\x8d\x5d\xc4\x45\xc8\x81\x87\xbb\xf9\xdd\x55\xa7\xb4\x03\x1e\xd9\x78\x09\xa6\x15\x6c\xe0\xc9\x19\x01\x3c\xf5\xfb\x91\xa0\x58\x4d\x9d\xbd\x4e\xdb\xab\x30\x7d\xcb\x54\x7f\x2b\xad\x98\xb2\x6c\x5c\xb2\xd8\x91\x08\x26\x40\x68\x12\x2a\x76\x64\xc3\x76\xe7\xa0\xba\x1f\xc5\x0e\x2e\xa2\xf9\xa2\xb3\x3d\xf7\xad\x3e\x9a\xad\xe3\x11\x77\x78\x95\xe4\x59\x2a\xd9\xc4\x34\xc3\xab\xba\x01\x2f\x28\x61\x7d\x7c\x68\x1f\x9f\xf1\xbe\xaf\xb1\xc1\xea\xb5\xb6\x7e\xd6\xf6\xad\x05\x5f\x47\xd1\x1d\x00\x43\xbe\x00\x5c\x64\x47\x45\x2b\xbd\x05\x91\xd4\xb2\x53\xc7\x9d\x74\xb3\xc1\xc5\x00\x64\x8d\x0c\x0c\x41\x2e\x54\x60\x6d\xfd\x64\xe7\x53\x78\x5f\x65\x9c\x0a\x9a\xc4\x91\xec\xaa\x35\x01\x75\x03\x2d\xa0\xb1\x44\x1f\x5e\x53\xf0\x91\x94\xb7\xd9\x27\x45\x55\x2e\x5d\x58\xe3\x19\xfa\xf0\x45\x2f\xa9\x06\x81\xe6\x08\x74\xbb\x05\x3c\x19\xa1\x06\xa5\xb7\xbb\x9f\x68\x58\x41\xf0\xb8\xe1\x8b\xde\x11\x59\x96\x57\x30\x04\x88\x21\xd1\x0d\x65\xdf\x41\xed\xda\x9d\x39\x97\x80\x74\x88\x46\x4b\xda\x66\x7c\x02\x13\x49\x84\xe7\x06\x4d\x43\xd8\x4e\x4b\xc9\x14\x12\x19\x17\x56\x32\x7c\x0e\x89\x0f\xe2\x9e\xc6\xe2\x03\x33\x3b\x8d\x2d\xcb\x2e\xe7\xf1\x81\xb3\x73\x88\x3c\x12\xd9\xa3\xec\x53\xb4\x76\xf3\xcf\x9a\x09\x36\x48\xd1\x09\xa8\xfd\x3b\x44\xdd\x05\x5d\x74\x51\x7c\xf5\x9b\xe7\xe1\xc4\x9d\x6e\x41\x33\x63\x5e\x3d\xef\x74\x36\xf1\x85\x1c\xa0\xa9\x44\x9e\x4a\x17\xd3\xff\x21\x82\x1e\x6d\x45\x9f\xa1\xcd\x34\x32\x54\xf6\x28\x3a\xd3\x07\x67\x5f\x41\x23\x70\x20\xac\xda\x5a\x09\xfd\x05\x94\xb4\xc4\x39\x8d\x94\x16\x43\x3b\x2d\xad\x0b\x2f\x27\xa6\xc5\xdd\x59\xd4\x84\x38\xc8\x1c\xad\xb5\x7b\x3a\xd1\x3d\x57\xbb\xb6\x52\x4b\x9d\xff\x37\xe6\xea\x06\xc0\x13\xe9\xdf\xa6\xdf\xe0\x1a\x1b\xde\x88\x55\x8e\x77\x3f\xc8\x2b\x89\xa4\xbe\xcd\xf4\x33\xd3\xda\x88\x2d\x87\xb0\xfa\xae\xaa\xcd\xa0\x00\xad\xe8\x6d\xcd\xc7\x52\xf2\xbf\x54\xeb\xdd\xe1\xf2\x4e\x49\x11\xcc\xab\x05\x5e\x93\x16\x03\x59\x1b\x3c\x5b\x9a\x4c\xee\xa7\x0f\x99\xde\x23\xee\xb1\x5c\x9f\xdc\x31\x97\x24\xe9\x8c\xd0\x61\x45\x8a\x9a\x13\xb7\x7a\x23\x0c\xaa\xc8\x39\x8a\xf0\xcb\xce\xad\x81\xad\x70\xca\xd1\x55\xf1\x28\xa1\x72\xb3\x34\x87\xb6\x8f\xcd\x43\xb9\xf8\xcc\xc3\x03\xb9\xc8\xf5\x98\x91\x8b\x6a\x7d\xa5\x13\x6c\x0b\xfa\x74\x40\x56\xd0\x17\x3d\x6b\xec\x74\x18\x30\x40\xef\x1d\x6d\x99\x10\xb8\x9d\xb6\x97\xe5\xc4\x7c\x53\xe0\x02\xbd\x7e\x4d\x25\x49\xf1\x83\x4c\xad\xe5\x39\x32\x64\xc9\xff\x34\x19\xb2\x18\xda\x65\xc8\xba\xf0\x72\x19\xb2\xb8\xfb\xfd\x65\xc8\x72\xbd\xac\x3e\xfc\xf1\x96\xe9\x8f\xe1\xad\x97\x8c\xe7\xfd\x09\x17\x4f\xfc\xff\x83\x7d\x0b\xdf\x70\x1e\xef\x74\x98\xf7\x40\x4e\xb7\x5f\xc7\x31\xf8\xcd\x8c\xe9\x2f\x9a\xfd\x3a\x59\x33\x31\x67\x59\x9c\x27\xa9\x7c\xe2\x8e\x84\x45\xff\x96\x1a\x37\xb0\xcf\x4e\xd5\xb8\xea\x50\x42\xe5\x99\xb3\x98\xed\xeb\x5a\xa8\xc7\x7e\xfe\x27\xa6\x25\xd6\x69\x1a\x64\x31\xb4\x6b\x90\x75\xe1\xe5\x1a\x64\x71\xf7\xff\xf9\xdc\xb4\xec\x1f\x83\xc7\x4f\xba\x16\x58\xa8\x3d\xd0\xbe\xa2\x21\xf0\x77\x10\x39\x49\xb3\x21\x16\x00\x00"
+
+func mssqlServerGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlServerGoTpl,
+		"mssql.server.go.tpl",
+	)
+}
+
+func mssqlServerGoTpl() (*asset, error) {
+	bytes, err := mssqlServerGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.server.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x5b\xeb\x73\xdb\xb8\x11\xff\x2c\xfd\x15\x38\x8e\x9b\x50\x67\x85\x97\xf4\x63\xae\xee\x4c\x2e\xd1\xf5\xd2\x26\x71\x6a\x3b\xd7\xcc\x64\x32\x09\x4d\x41\x36\xcf\x14\x29\x93\x94\x1f\xf5\xf8\x7f\xef\x3e\x00\x10\xe0\x43\xa2\x64\xe5\x7a\xd3\x7e\xb0\x2c\x91\xe0\x62\x5f\xd8\xfd\xed\x02\xbc\xbb\x7b\x22\xf6\x8a\xf3\x2c\x2f\xc5\xf3\x03\xe1\xd3\xb7\x34\x9c\x4b\x11\xbc\xc3\x4f\x4f\xe6\xb9\x27\xbc\x5c\x16\xf0\x59\x5c\x26\x45\x89\x3f\xa7\xa7\xf0\xf1\xf1\xf0\x4d\x76\xe6\x8d\xc4\x93\xfb\xfb\xe1\x1d\x52\x29\xc3\xd3\x44\x32\x95\xe8\x5c\xce\x43\x11\x1c\xab\xff\x27\x78\x87\x3f\x91\xaa\xf5\x0c\x90\xb4\x1e\xd3\x3f\xd6\x3f\x18\xcf\x44\xf0\x32\x9b\xcf\x65\x5a\xd2\xb5\x1f\x7e\x10\x77\x77\xd5\x25\x35\x4a\x26\x85\xb4\x6f\x93\x48\xf7\xf7\x22\x97\x0b\x90\x08\x06\x16\x22\x14\x79\x76\x2d\x66\x79\x36\x17\x8f\x61\x88\x12\xe2\xfe\xfe\x71\xc0\x14\xd2\x29\x12\x2b\x6f\x17\xd2\xa1\x00\x7a\x58\x46\xa5\xb8\xa3\x41\x79\x98\x9e\x01\xd3\x3f\xc7\x32\x99\x16\x38\x7c\x60\x0f\x85\xef\xb9\x24\x02\xc1\x09\x7e\xde\xdf\xc3\x95\xeb\xb8\x3c\x57\x44\xca\xf0\xac\x10\x01\x8e\xfc\x8a\x8f\xc1\x17\xfc\xcf\x13\x0b\x23\x57\x82\x7f\xcb\x79\xaa\xa8\xda\xcc\x69\x7d\xbc\xcf\x65\x92\x85\xcc\xc1\x70\x00\x4f\xc2\xef\xb0\x94\x53\x94\xb0\x18\x8b\x42\x96\xe2\xf4\x56\x94\xe7\x52\xbc\x81\x61\x16\x8b\xdf\x8b\xd9\x32\x8d\x8a\xe1\xe0\x48\x26\xb6\x94\xf8\x13\x79\x29\x2e\xe2\x05\x71\x09\xac\xb5\x4f\x1c\xcf\xc3\xfc\xf6\x1f\xf2\xd6\x4c\x7d\x93\x89\x19\xa9\x63\x38\xf8\x22\x6f\xe2\xa2\x04\x06\xbe\x4c\x65\x22\x91\x9f\xd3\x2c\x4b\x86\x46\xc6\x61\x87\x04\xae\xcd\x90\x97\xf3\x0c\xf5\x8b\x02\xa0\x44\x46\xbc\x32\x03\x2b\xda\x1a\x07\x29\x63\x30\xed\x2c\xcb\x65\x7c\x96\x8a\x0b\x79\x5b\x04\x0d\x13\x22\xc1\x36\x2b\xda\x3c\x38\x76\xfc\x1e\x7f\x1c\xc9\x19\x1a\xd1\x5c\x54\x4c\x92\xe9\x57\x5b\xc9\xf9\x81\xc2\x9d\x80\x1c\x11\x8d\x16\xb8\xe0\x0a\x91\xcd\x1a\x2e\x18\x65\x69\x51\x0a\xbf\xdb\xcb\xf6\x34\x27\x30\xaf\xcd\xec\x01\xb2\xb5\xc8\xe3\xb4\x9c\x09\xef\x4f\x97\xde\x1a\x17\x1a\x69\x13\x9c\xc9\x54\xe6\x71\x64\x2c\x70\x93\x1d\x47\x61\x2a\x0a\xf8\x28\x68\xa5\x00\xc5\x8c\x4c\x60\xcd\x16\x0c\xd1\x7f\x84\x8f\xfc\x70\x28\xd1\xea\x52\x03\x46\x8a\x8e\x8f\x14\x6e\xb2\xa3\xec\x7a\x24\x20\xb0\x64\x39\xa8\x7e\x00\x5f\x70\xf5\xc3\xad\x80\xc6\xc0\x73\xe4\x3a\xac\x14\x2d\xaf\x4f\xc2\x08\xef\x91\xa7\xe6\x18\x21\xdd\xe1\x00\x78\x46\x02\xdf\x1d\x88\x34\x4e\x90\xdc\x00\x16\xdb\x32\x4f\xf1\xea\x70\xb0\xd2\x47\x71\x41\x90\x6f\xca\x34\x92\xac\x4d\xcd\x7d\xa0\x9c\x16\xf4\x08\x2e\x22\x1d\xd3\xe9\x09\x60\xbe\x16\xa3\xea\x50\x25\x78\x14\xbb\x2b\x05\x54\x30\x2f\x7e\x67\xeb\xd6\x34\x28\x62\x1d\x89\xb2\x59\x0f\x6d\xc2\x0f\x90\xe9\x3c\x2c\x48\x51\x46\x47\x9e\x99\xdd\x83\x61\x1f\x0f\xcd\x12\x33\xd7\xfd\x11\xfa\x7c\x9c\x9e\xa1\xa6\x94\x1c\x35\x47\x31\xee\x37\x64\x89\xd8\x67\x8a\x86\x3c\x85\x16\xc8\xe2\xec\x71\xa1\x3c\x1a\x56\x7b\x9c\xb2\x19\x45\x96\x4f\x65\xfe\x00\xa1\x14\x03\x35\x91\xd4\x55\x10\xe8\xd3\xe7\x86\x48\xfa\xd2\x9d\xa8\x16\xce\x5e\x3c\x16\x7b\x33\xf4\xb4\x6a\x09\xf1\x94\x7b\x31\x7c\x1d\x0b\x43\xba\xb9\xac\xf6\x66\xfa\xb7\x1a\x04\x39\x45\x68\x05\x55\x9e\xb5\xa1\xaa\x16\xfc\x20\xc6\x27\xad\xb6\x07\xa8\xa9\xc1\x46\x4d\x61\x8d\xfb\xdb\xa8\xce\xbf\x3e\x97\xb9\xe4\xc8\x2e\x82\xd1\xae\x54\xf8\x6b\x98\x2c\xa5\xab\xb7\x2b\xbe\xd4\xaa\x38\x9e\x9f\x5c\x4c\xab\xfc\xa1\x4e\xc6\x1c\xd4\x54\xc6\x17\x49\x4f\xb0\x3e\x64\x3e\x0b\x23\x79\x77\xef\x28\xcb\xba\xce\x1a\x6b\x09\x5d\x8a\x17\xc7\x67\x32\x7a\xb0\x12\x79\xa1\x2f\x34\xa3\xeb\x0a\x81\x85\x1f\xcb\x31\xd2\x83\xa7\x30\x44\xab\x18\x82\x31\x7a\xf4\x10\x57\x52\xcc\xd4\x3d\x48\x5d\x7e\xb0\x42\x5a\x62\xb9\x51\x0e\xb1\xbb\x02\x8f\xc2\x42\x41\x28\x4a\x04\x11\x89\xca\xa2\x84\x7f\x31\xfc\x45\x0a\x8b\x72\xd6\x02\xa8\x01\x99\xdd\xf6\xa8\x82\x2e\x01\x5e\x50\x6b\x0d\xff\x83\x4e\x21\x09\x85\x49\x62\x2e\x82\x83\xa7\x74\x07\x42\x32\x92\x92\xf3\x45\x79\x3b\x16\x21\x68\x00\x89\xf4\xb1\x13\xde\xb8\x15\x61\x2e\xc9\x26\x29\xcc\x88\x06\x71\xec\xd1\x9d\x25\x89\x49\x9f\x18\xd0\x4b\x71\x04\x6a\xa0\x2f\x63\x57\xbf\x63\xce\xa1\x23\xd4\x3f\xd8\x31\x91\x29\x3d\x37\x12\x07\x07\xe2\xa9\x9d\x0a\x11\xc3\xc1\x1d\xd7\x08\x80\xe5\xc6\xdb\xd8\xcb\x36\xd8\x98\x92\xe0\x00\x93\x22\x3f\x01\x26\x9b\x87\x17\xd2\xd7\xac\x8f\x2b\xae\x20\x57\xa3\xb1\xac\x21\x8e\x28\xf6\x38\x00\x6e\x02\x42\x4e\x44\xb0\x80\x22\x10\xe9\x03\x25\x2a\x00\x38\x47\xe7\x70\xeb\x0e\x13\x76\x1b\x28\x1a\x44\x61\x41\x76\xe9\x80\x46\xcf\x61\x08\x73\xfb\x29\xfe\x3c\x16\xc8\x13\x7c\x69\x02\x26\x5f\x69\x8c\x90\xd3\x48\x87\x37\xb4\x28\xaf\x16\x6d\xc4\x40\x41\x31\x03\x03\x06\x20\xe7\x2c\x5c\x26\x25\xcd\xa4\x4c\xe0\x79\xa4\xab\xb1\x98\xcd\xcb\x60\x82\x66\x9b\xf9\x1e\x7b\xa4\x98\x85\x71\x22\xa7\xcf\xc5\x32\xbd\x48\xb3\xeb\x54\x83\x42\x60\x02\x74\x00\xea\x00\xfd\x0e\x2c\xdc\xc1\x9a\x2d\x82\xbf\x83\x2b\xfa\x24\xc8\x58\xc0\x48\x6f\xc4\xc2\x8c\x15\x30\x19\xf2\xea\xae\x01\x1f\xf0\xe8\x09\x23\x9b\x29\x40\xf1\x7c\x1e\xa7\x60\xb5\xb8\x11\x64\x85\x82\x3f\x10\x70\xf0\xce\x34\x04\x50\x00\x6a\xed\x11\x53\x98\x3a\x84\x08\x04\xf9\x2e\xca\x68\xa0\x2b\x15\x0c\x5f\xa9\xb2\x60\x91\x67\x57\xf1\x14\xf9\x49\xc1\x03\xe6\x61\x19\x67\x69\x1b\x6f\x10\xb0\xc4\xa9\x84\x65\xaa\xeb\x09\xaa\xde\x36\xe4\x53\x4d\xba\x8e\x51\x35\x85\xe2\xf4\x75\x5a\x48\xb8\x11\xd3\xbf\xa2\xc1\x98\x8a\x09\x1b\x70\xc1\x04\x71\x44\x54\xde\x2c\xc2\x3c\x9c\xc3\xe5\xe9\xa9\xf8\x78\xf8\xea\x27\x08\x4d\x0b\x98\x24\x08\x82\x8f\x87\x87\x0b\x54\x86\x05\x9a\xd1\xdf\x6e\xb2\x8c\x2e\x17\xc6\x03\x6f\xc0\x25\xb0\xa6\xa1\x1a\x58\xad\x5a\x15\x37\x03\x9e\x0a\x62\x64\xbd\xa8\x16\xde\xeb\x77\xc7\x93\xa3\x13\x8f\xc8\x5c\x85\x39\x01\x6a\x9a\x89\x71\x32\x98\x20\x4c\x72\x19\x4e\x6f\xd9\x2d\xc6\xe2\x34\xc4\x65\x0f\xd7\x5b\x31\xb3\x0b\xc2\xb3\xbc\x08\xde\xc9\x6b\xdf\x63\xad\x19\x6f\x77\x48\x16\xde\x88\x7c\x5c\xf9\x2c\x73\xf8\x36\x4c\x97\x61\xf2\xfe\x42\x10\x63\x08\xd8\x2f\x13\xa5\x7b\x71\xb9\x94\x39\x84\x65\x1b\x42\xcd\x97\x10\x5d\x4e\xa5\x76\xa3\x29\x21\x7a\x78\x64\x2a\xa3\xa4\xea\x5d\x60\x99\xcd\xf2\x8a\xd7\xef\x4e\x0e\x59\x02\xdd\x77\x80\x9b\xfe\x57\xb1\x0f\xfc\x3b\x21\xd3\xe7\x49\x6d\xd8\xa3\x46\x8d\xc4\xaf\x2f\xde\x7c\x98\x1c\xd7\x1e\x03\xf0\xb2\xf2\xa9\xaf\xaa\x3e\x5f\xa6\x2c\xc8\x70\x40\xcd\x14\x9f\x99\xa4\x40\x63\x85\xe1\x06\x21\xa3\x72\x50\xda\x17\xca\x02\x10\xbe\xa6\xa7\x01\x3c\x36\x3d\x9d\x41\xb0\x99\xdc\xc8\x08\x45\x55\x8e\x15\xe6\x67\xf0\x63\x0b\xe2\xeb\x8a\xab\xcd\xcb\x28\x6e\xc9\xf4\xb2\xa7\xb6\x23\x95\xf3\x53\xf0\xe8\xb8\xbc\xfd\xff\xb0\x69\x8e\x21\x5d\x95\xc5\xff\x35\xb3\xc2\xa5\x3c\x96\x57\x12\x74\x0f\x4f\x4c\x0d\x43\xc0\x5c\xf0\x26\x2c\x4a\x8e\x27\xaf\x21\x82\x6e\xe0\x27\xb6\x7d\x11\x52\x75\xf9\x0d\x06\xc9\x2a\x71\xb9\x5d\x0d\xfb\x86\x6a\xa8\xf9\xf1\x74\xb4\xde\xf3\x9c\xa6\x15\x35\x3a\xb0\x7d\xa4\x55\xa4\x9d\x92\xdb\x3f\x06\x0f\x9a\x71\xba\x77\xa6\xa3\xbb\xeb\x8a\xb9\xed\x8b\xc7\x93\x37\x93\x97\x27\xc2\x71\xb7\xc6\x7c\x23\x1a\xca\xce\xf3\xf3\xd1\xe1\xdb\x86\xd7\xaa\x7b\xff\xfa\x65\x72\x34\xb1\x69\x91\x77\xd9\x5a\x50\xe0\x67\x16\xe2\xd2\xf2\xc4\x8b\x77\xaf\x84\x47\xad\x3a\xed\x82\x79\xbb\x8f\x34\x49\xd8\x4e\xd2\x8c\x2a\xff\xc4\x89\x8f\xb2\xeb\x86\x0b\x6e\x41\xbf\xad\xd5\xd3\xa6\xa3\xed\xdb\x3e\x06\x8e\x39\xed\x1a\xaa\x2f\xf2\xb5\xfd\x6e\xb7\xd3\x7d\x69\xba\xdd\x60\xe8\xec\x9a\xea\x0f\xf8\x03\xe1\xf1\x2b\x06\x06\x7c\xa4\x0c\x73\x2c\x45\x16\xaa\x1c\xf9\xad\x2a\x47\x78\xa9\x20\xbe\x4c\x96\x79\x98\xc4\xff\x96\x56\xe3\x47\x61\x09\xea\x68\xd6\x00\x84\x58\x16\x58\x9c\xcf\x01\x4b\xc6\x4f\x60\x00\xd1\xe2\x38\x07\xb3\x95\x72\x4e\x1d\x6c\x28\x91\xc3\x52\xcc\x33\xc8\x7e\x1f\x0f\x7f\x0a\x01\x1e\x1f\xe3\x0c\x44\x50\x86\xd1\x79\xa0\x5d\x3e\xcd\xca\x46\x6a\x25\x06\xad\x2e\x06\x35\x4b\xa9\x76\x09\x8b\x22\x3e\x4b\x6d\x74\xa5\x83\xb0\xa9\xcd\x97\xe5\x62\x49\x3d\x65\x9c\x06\x89\x18\xae\xc6\x1a\x39\x72\x99\x0a\x2c\xc6\x4a\x46\xa7\xad\x4e\xf8\x68\x85\x76\xba\x80\x11\xc9\xf6\xe9\xb3\x8d\xa5\x76\x84\x96\x3c\x05\x93\xe0\xb7\xcb\xcd\x68\x1d\x70\x5a\x01\x94\xb0\x9e\xf9\x42\xab\x43\xbb\x1e\x18\xde\xd4\x36\x24\x0c\x7a\xb0\xc2\x53\x79\x2b\xa0\xda\x0a\x51\xe9\xca\x01\x19\xc0\x02\x0b\xa7\x1a\x89\xbf\xaa\xea\x30\x45\x1e\xcc\x65\x66\x20\x85\xbb\xb6\x17\xd1\xd4\x29\x04\x02\xeb\x22\xd1\x85\x0f\x36\xf8\x2d\xd4\x2d\x68\x63\xb4\xf6\xb3\xa7\x4f\x9f\xb2\x3c\x18\xdc\xff\x0c\x3f\x05\xd9\xae\x10\x49\x3c\x8f\x95\xaf\x56\x5e\x52\x4d\x49\x0f\x9a\xb9\xf0\x17\x4d\x82\x56\xa2\x9d\x12\x1f\xd8\x6c\xe4\xb4\x11\x57\x5b\x48\xe2\x7b\xb5\x73\x02\xa4\x68\x56\x43\x8a\x7e\x71\x8f\x9e\x47\xbb\x1d\x5b\x12\xe2\x74\x19\x43\x3d\xb7\x48\xa0\x12\xc5\x1d\x06\xac\xee\x91\x7d\x5c\xde\x30\x80\xf2\x7e\xb3\xae\x4d\x51\x61\x38\xa4\xab\xa0\x7d\x3a\x66\xb6\x90\xf3\xaa\x3e\xc5\xa7\x54\x79\xbb\xc2\x1d\x3e\x3d\x4f\x3f\xb3\x0c\x14\x55\xb4\x9d\x70\x3a\x24\xc0\xf3\x1e\x88\x70\xb1\x00\x41\xe8\xf2\xfa\xfc\x9f\x5b\xb1\x7d\x30\x58\xb4\x88\xf4\x74\x5c\xcd\xf2\x84\x26\xa6\xa1\xc8\xee\x6f\x38\x9c\x2e\xfd\x08\xdf\xff\x52\x8d\x83\x9f\xfb\xfb\xcc\x2a\xd0\x34\x2c\x2d\x88\x9f\xb4\x3c\x27\xf3\x9f\x65\x18\x0e\xf5\xd4\x68\x05\xd2\x2a\x97\xdd\x9e\xef\x89\x7d\xb7\xa8\x5d\xa8\x82\x16\xae\x7b\x23\xcf\x18\xad\xa5\x32\x30\x36\xdc\xb4\x34\x18\x70\x84\x47\xb1\xfa\x40\xc7\x9e\xd8\xd1\x02\x8f\x5f\xeb\x42\xa1\xc4\x4a\x2e\xc5\xb3\x05\x15\x6b\x58\x11\x55\x0b\x91\x0c\xd5\xf5\x65\x73\x24\x68\x3d\xdd\x4c\x93\x4e\x9e\xac\xd6\xb1\x8b\xe1\x7b\x44\xac\xca\x45\xdb\x63\x96\xc2\x5d\x66\xc1\x29\xd8\xdf\xc7\x5a\xed\xc0\xff\xdb\x59\xec\xf0\xc3\xc9\xfb\x0f\x27\x2a\xb3\x4e\x5e\x05\xd5\x83\x0e\xd6\x7c\x99\x25\x48\x7f\xc7\xf6\xbd\x6c\xb5\x2f\x41\xad\x5d\x1b\x78\xe1\xa4\x78\x17\x7d\x0f\x62\x64\xe1\xa9\x32\xfd\x8f\x22\x86\x45\x9e\x8a\x47\x8f\xc4\x25\xa4\x9a\x9b\xd2\x87\x85\x1e\xeb\x85\xce\xc8\xf0\x92\x21\xdc\x23\x72\x86\xf8\x73\x07\x64\xa7\x15\xdf\xc2\xe4\xe0\x32\x78\x99\x64\x85\xf4\x69\x80\xcb\x33\x47\x08\x4d\xb7\xc5\xa1\xdc\xa7\x15\x75\xe4\x68\x92\xe7\xc8\xe9\x1a\x8d\xd0\x23\x31\x8d\xe8\x9b\x5a\xe7\x71\x41\x50\x8c\x47\x52\xaf\xaa\xd2\xa5\xca\xb4\x6e\x5e\xa1\x2c\x78\xc0\x4b\x25\x7d\xfe\xd9\xe9\xe0\x39\x0d\xba\x54\x0a\xbf\x0a\xdc\x84\xf5\xea\x3b\x07\xfe\x72\x01\x90\x10\xf7\xb2\x33\x00\x66\x98\xf8\x3c\x83\x39\x3e\xd0\x2d\xc1\x23\x9a\x2d\xa9\x46\x03\x6f\xa0\x23\xe9\xaf\x90\xe7\x00\x0e\xd1\x4c\x8a\x18\x11\x3c\x52\x2d\x73\xd0\xe5\x71\x19\x26\x12\x00\x3f\x37\xc5\xd5\xbe\xbb\xb8\x0e\x0b\x11\x9d\x63\x20\xc0\xbd\x3d\xd3\x84\x03\xfd\x44\x80\x11\x4b\xbc\x4f\x84\xb0\x8c\x92\xd3\xc0\xed\x8d\xae\xed\x88\xb1\x3c\x5b\x74\xc4\x5a\x40\xde\xda\x9e\x18\x4f\xd6\xda\x13\xfb\xf0\xfe\xd5\x8b\x93\x09\xab\xb9\xd1\x14\x53\x60\x6f\x9a\xc9\x22\x7d\x5c\xba\x60\x0f\x9d\xeb\xbb\xce\xbe\x58\x9b\xaf\xb1\xed\x8c\xaf\x21\x55\xc2\xea\xf4\x98\x72\xae\x6a\x4e\x56\xb7\x3d\x5b\x6b\xc7\xb2\xef\x6c\xe0\xc5\x17\x08\xf2\xb5\x25\x41\x79\xce\x94\x18\xaa\x75\x10\xeb\xea\xbd\xb0\xae\x1a\x91\xf8\x78\x72\x22\x5a\x82\x31\x51\x73\xfd\x5c\x95\xae\x10\x3b\x01\x9a\xd6\xbd\x9d\xb7\x0c\xaf\xd8\x5d\x31\x8e\x05\x7c\x85\x87\x4d\xf5\x15\x3d\x93\x68\x2f\x98\x79\xc2\xfa\xfe\xa5\x5b\x33\x43\x01\x5a\x12\xc8\x89\xb2\x25\x7a\x89\xde\xfe\x68\x2c\x3f\x8c\x2c\x36\x57\x51\x06\xee\x1d\x08\x3f\x9c\x4e\xfb\x13\x61\x4c\xeb\x32\x44\x98\xf6\xeb\xda\xfc\xe1\x60\xbd\x5e\x21\x43\xef\x5f\xd8\x10\xb1\xa6\x0b\xe3\x43\xaa\x09\x5b\x0b\x10\x63\xd7\xcf\x70\xd1\xda\x23\x6a\xbb\xbb\x1c\xf9\x3b\x63\xcd\x0e\x5a\x5c\x3b\x17\xbb\xa7\x80\x9b\x64\xdd\xe8\x5c\x46\x17\xb4\xb6\xa8\xea\x49\x28\x80\x62\xe5\xe5\x74\xd3\x20\xc2\x16\x2f\x66\x33\xda\x9c\xf4\xfb\x91\x57\x85\x93\xd9\xe8\xd3\xf7\xad\xa0\x6d\x87\x8d\x34\xca\xa9\xe0\xd2\xfe\xca\x6b\x79\xbd\xac\xfb\xfb\xc3\xaa\xb3\x42\x5b\x7d\x03\x1b\xcc\x0d\x1e\xda\x7d\xde\xb9\x0d\x47\xb5\x46\x90\x93\x7b\x50\x1d\x28\x76\xd4\xf3\xb8\x63\xda\x71\xe4\xd1\x6c\x42\x43\xe8\xa9\xda\x40\xc4\x52\x6d\x2b\xba\x4a\xd0\x7a\xdb\x5e\xe7\xe9\x2c\x4d\xb8\x9d\xa8\xfb\x8c\xb1\xda\x75\xf6\x6b\x19\x1c\x1e\x24\x32\x74\x12\x2c\x4c\x4b\xa8\xdf\x9b\x67\x22\xea\x69\x9e\x0e\xfb\x95\x08\x58\xe0\xea\x5c\xb7\x95\xb8\x6d\x43\xd4\x54\x43\x86\x35\x18\x58\x47\xd1\xaa\xd4\x5e\x6b\x77\x52\x4f\x08\xb3\x12\xf7\x47\x4d\x62\xff\x23\x40\x89\x68\x25\x96\xd0\xc7\x5d\x3a\x20\x85\xb3\xd5\xfe\x8d\x10\x86\x3e\x91\xf3\x6d\x80\x46\xf4\xbb\x22\x8d\xe8\x9b\x41\x0d\x6a\x51\x56\x07\xc8\xaa\x69\x5b\x4e\x36\xb8\x41\xc9\xed\xe4\x20\x22\x88\x92\x70\x89\x3d\xfa\x01\xfe\x58\x7d\x2a\x61\x5d\x17\xc7\x8c\xdd\x07\xf1\x29\xc3\xb7\x25\x6e\xf1\xcc\xed\xee\xb4\x1d\x5e\x70\x4e\x2f\xb4\x1e\x5f\x50\x25\x01\x98\xc4\x37\xc7\x72\xdc\x78\xb8\x37\x52\xfd\x48\x76\x98\x5e\xa7\x1d\x50\x09\xdc\x6c\xb1\x70\x98\xd0\x05\xed\x01\xd5\xb2\xb5\x76\x8d\x69\xf0\x28\xc7\xec\xec\x39\x41\x80\xc4\x00\xaf\xdc\x5e\x7b\x87\x17\x78\x9a\xcd\xc0\xc2\x02\xe6\x70\x98\x39\x2a\xe1\x9c\x95\xd0\x46\xb5\xcf\x48\xd4\x7c\xa8\xf3\x8c\xc4\xe0\xbe\xe1\x07\x64\xa1\xca\x13\xf8\x67\x6b\xdb\xab\x8f\x61\x2b\x73\x35\x0f\xc1\x19\xea\x46\x3f\xf4\x73\xbc\xa5\xbe\x8d\x53\x36\xd5\x6d\x2d\x3f\x3b\xd4\xb9\x91\xb1\x1b\x76\xf5\xe0\xd2\xc1\x01\xdf\x80\xe5\x0e\x4c\xd5\xe8\xca\xda\x85\x87\xd5\xfa\x59\x5f\x6b\x38\x5d\x19\xf0\xfc\xaa\x99\xf8\x75\x8b\x3a\xa2\xd1\xe7\x51\x3a\x53\x35\xc3\x46\xbd\x9e\x1d\xc3\xe1\x2d\xda\x40\x7f\x70\x40\xba\xde\x53\xbe\x01\x22\xb5\xd4\xb8\x12\x3a\x02\xdf\xc7\xe1\x95\x14\x05\x7c\xf4\x38\xfb\xb3\xbe\xd5\x81\xd4\xb6\x69\x74\xd4\x4b\x7e\x73\xe4\xca\x56\xbc\x33\xc2\x69\xaa\xb0\xf4\xd3\x53\x9e\x44\x49\x7e\x6f\xa9\xd5\x79\xd4\x39\xa1\xd4\xf6\xa8\xe9\xcd\x63\x47\xb1\xde\x9f\xf7\xe7\x32\x3f\x93\x1c\x82\x55\xa8\x54\xa0\x98\xda\x6b\x0b\x48\xb4\x59\x3e\xc7\x6e\x24\x2c\x43\xee\xb8\xa1\x90\xf6\xab\x05\x7d\x5a\x46\x5b\x1e\xa2\xda\x0e\xd0\xf5\x3a\x46\xd5\x85\xe4\x5a\xf7\x07\x57\x9e\xa4\xda\x76\xe3\xaf\x7f\xfb\xe6\xed\xe4\xe8\x6f\x93\xf6\x66\x7a\x69\x37\x70\x1c\x5b\xc2\xdd\x1f\x37\x6c\x54\x20\x92\xe9\x3e\x94\xf2\xf0\x93\x4c\x2b\xa9\x6f\xbb\x13\xb2\xea\x50\x49\x2d\x12\xd5\x5e\xca\x72\x8e\x3a\xa9\x36\xad\xbd\x6d\x3f\x8f\x4b\x04\xcf\xd3\xa5\xc4\xe0\x91\x84\x10\x98\xa1\x2c\x53\xec\x67\x10\x4c\x70\x4f\x15\x16\x86\xd5\x6a\xb6\x8e\x35\x74\xbc\xd8\x42\x2f\xd5\x51\x83\x1f\xcb\xdb\xc5\x85\x83\x52\x2c\xc4\xf9\x12\x9b\x53\x32\xaf\xce\xcd\xf2\x39\x05\x15\x96\x6d\x3c\x69\x07\xb9\xb0\x04\xae\xa3\x30\x81\xda\x15\xf0\x12\x9e\x21\x05\x57\xb1\x8f\x42\x37\x5e\x32\x52\xc5\x2a\x52\xef\x78\xcf\x8e\x37\x78\xe9\x6c\xb5\xb5\x07\x84\x07\xdb\xf9\x4e\x28\x52\x79\x16\x96\x31\x44\x5e\x3d\x1d\x52\x03\x37\x56\x29\x24\x2e\xf5\x59\xf7\x75\xfc\xb7\x87\x08\xb8\x78\x96\xd1\xb5\x04\x8c\xab\xb4\x87\xf6\xe5\x0f\xec\x3e\xf0\xc4\x77\x8d\x17\xf9\x76\x77\xe8\x40\x31\xee\x69\xbe\x15\x78\xde\x5b\x59\x2f\x0e\x6b\x2b\x7c\x8b\xfe\x6c\x2b\x3e\x6d\xb9\xe8\xa0\x3f\xc8\xf1\xf5\x9e\xec\x5e\x03\x4b\xed\x89\x75\x67\x98\xb8\xfb\xc3\xfa\x76\x1b\xb1\xcf\x54\x87\x75\xdd\x99\x3a\xb2\x0b\x4b\xdd\x6a\x40\xfb\x84\xe4\x26\xc8\xaa\x17\x5d\x2b\x7c\x34\x5e\xc7\xb4\xbe\xee\x71\x95\x8c\xf3\x7b\x7c\xe8\xd8\xd3\xb7\x60\xf9\x16\xd9\xac\x54\x65\x34\x17\x42\x1a\x95\xea\xc7\xe0\xa9\x5f\xc2\x7c\x5a\x3d\x79\x57\x3f\xc9\x56\x91\x98\x67\x53\x8e\xcc\x6b\xdf\xa2\xe8\xd7\xe6\xba\x82\xbf\xd3\x5b\x8f\x62\x07\xa2\x1f\x98\x88\xf9\xa0\x52\xbe\x89\x81\xc2\xc2\xb4\x70\x6a\x0d\x29\xec\x26\xe9\x96\x14\x3e\x51\x91\xea\x78\x73\xd2\x1c\x5a\x6a\x80\x65\x3e\xae\xb4\x93\xd6\x92\xd5\x59\xaa\x1f\x4a\x5a\xf9\x9a\x46\xc5\x7d\x67\x44\xc1\x74\x73\x09\x8a\xaf\xd9\x66\x44\x0a\xa5\xc8\x01\x1a\xb1\x02\x4a\x5d\x21\xd5\x9b\xc2\xcc\xd5\x8e\x0f\x83\x57\xd3\xad\xed\x49\xb5\x1f\x08\x6f\xed\x48\x99\x9d\xaf\x55\x47\xc2\xcd\x1b\x23\xad\x5d\x26\x8d\x84\xda\x9b\x4c\x2d\x24\x76\x17\xfe\x5a\x7c\xd2\x84\xc3\x15\x91\x2f\xe8\x19\xe7\x56\x6f\x3d\x3d\x5b\xb9\xa7\xf4\x6c\xf5\x66\x91\x1b\x22\xaf\x70\xc9\xa3\x2e\x8c\xef\x51\xe3\x17\x68\x29\xdf\xab\x47\xd1\xab\xf5\xed\xf5\x5e\x5b\x43\x1b\xed\x0d\x75\xd6\xc2\x5b\x95\xc2\x1b\x88\xd0\x97\xd9\xbe\xc7\x9a\x3b\x4a\xea\xd5\x15\xf5\x3a\xca\xb5\x6a\xba\xad\x98\x76\xcf\xd5\x6c\x81\x9e\x37\xd0\x59\xcf\xf7\xac\x4d\xff\x46\xe1\x68\xf4\x40\xb5\xc0\xd5\xbb\xc0\x58\x23\xea\x57\x6a\x06\x4d\x3b\xd4\x97\x60\x75\xee\xfb\xaa\x3e\xdc\xc4\x05\xeb\x0d\xed\x56\x7f\xea\x67\xed\xfd\xfd\x7e\xef\x78\xaf\x4c\xdb\xd6\xdb\x4c\xb6\xec\xcd\x44\xd9\x7c\x61\x49\x7c\x00\x3b\x56\x89\xde\x80\x5c\xfe\xc1\x39\xad\xf7\x5b\x4d\x5b\x94\xc2\x6d\xa5\x7f\x23\xcd\xb5\x94\xff\x8d\x17\xe0\x2d\xe8\x02\xdc\xf5\x57\xc0\x1f\x22\xdf\x77\xbe\x26\x5b\x89\xf4\xbb\xbc\xab\xe5\xe9\x09\xdb\x92\xf3\xab\xc9\x9b\xc9\x43\x92\xf3\x83\x73\xf3\x0e\x53\x33\xcb\x22\x5a\xdf\x7f\xe8\x78\xf1\x61\x75\x1e\x6d\xcb\xa0\xad\x5d\xfd\xf5\xc5\xc5\xba\xe0\xb8\xeb\xd3\x12\xbb\xcd\x88\x3d\xb9\xef\x7f\xe8\xe1\x7f\x3b\x19\xf6\x54\xd7\x96\x89\xd0\x4d\x79\x5d\x29\xac\x3b\xeb\xfc\x07\xdc\xce\xe3\x52\xa5\x49\x00\x00"
+
+func mssqlTypeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlTypeGoTpl,
+		"mssql.type.go.tpl",
+	)
+}
+
+func mssqlTypeGoTpl() (*asset, error) {
+	bytes, err := mssqlTypeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlTypeProtoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xab\xae\x56\x28\x28\xca\x2f\xc9\x57\xd0\x53\xa8\xad\xe5\x02\x00\x3e\x2d\x42\x14\x0e\x00\x00\x00"
+
+func mssqlTypeProtoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlTypeProtoTpl,
+		"mssql.type.proto.tpl",
+	)
+}
+
+func mssqlTypeProtoTpl() (*asset, error) {
+	bytes, err := mssqlTypeProtoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.type.proto.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mssqlWhereGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x59\xdd\x93\xda\x36\x10\x7f\xb6\xff\x0a\xd5\x93\xb9\xd8\x0d\xf1\xe5\x39\x2d\x37\x73\x1f\x34\xbd\x86\x42\x7b\x77\x99\xa4\x93\xc9\x04\x83\x05\x78\xce\x58\x20\xcb\x1c\x94\xe1\x7f\xef\xee\x4a\x06\x83\xcd\xc5\x1c\x0f\x7d\xc0\x5f\x5a\xed\xfe\xf6\x53\x5a\xb1\x5a\xbd\x65\xaf\xd2\xb1\x90\x8a\xbd\x6f\x32\x97\x9e\x92\x60\xc2\x99\xdf\xc1\xab\xc3\xa5\x74\x98\x93\xce\xe2\x54\xe1\x43\xd8\x87\xcb\x0c\x7e\x92\xa7\x70\xfd\xd2\x6d\x8b\x11\xdc\x05\xfe\xa6\x0a\x3f\x0d\x44\x8c\xb7\x90\xa7\x0a\x6e\x4f\x63\x2e\x39\xdc\x03\x39\x4a\x1d\x8f\xbd\x5d\xaf\xed\x15\x4a\x54\x41\x3f\xe6\x5a\xe2\x60\xcc\x27\x01\xf3\xef\xcd\xfd\x01\x47\xf4\x15\x11\x14\xe6\x00\x88\xc2\xb4\xfc\xa5\xce\xc4\x71\x20\x43\xa3\x1e\x3c\x3d\xf2\xe5\x30\xe2\x71\x98\x32\xbf\x40\x34\xca\x72\x22\x7a\xda\xa5\x38\x3f\x67\xab\x95\x31\xc9\x7a\x7d\x2d\x12\x18\xea\x67\x11\x52\xa8\x31\x67\x03\xf8\x10\xa9\x48\x24\x29\x13\x89\xf9\x12\x67\x13\x7c\x1d\xb2\xd7\x30\xd3\xe8\xbb\x5e\xbf\x66\xd3\x20\x4d\x79\x88\x1c\x95\x40\xa6\xd3\x38\x93\x41\x1c\xfd\xcb\x37\xec\x3f\xa3\xcd\x7c\xf6\x29\xe5\x45\xa1\xfa\xab\xad\x96\x53\x5e\xc6\x02\xce\xc9\x06\x6a\xb5\xb6\xf7\x90\xd2\xa4\x03\x48\x35\x90\xe7\x51\x30\x37\xe2\x8d\x2a\x9e\x3e\x7c\x70\xa3\x24\xe4\x0b\xe6\xff\xa6\x4d\xf5\xce\xcb\x29\x5a\x33\x77\xee\x79\xbe\x3d\x0f\x64\x19\xcc\x3e\x76\x82\xfc\x00\xd0\xba\x77\x37\xad\x3b\x76\xf5\x0f\x53\x5c\x4e\xc8\x72\x08\x38\x8e\x52\xc5\x86\x59\x32\xa0\x2f\x85\xc9\x8d\x5c\x81\xa7\x48\x8d\xd9\x97\x6e\x57\x86\x5c\xfa\x36\x28\x08\x13\x5c\xf2\xa9\x0c\x92\x11\xdf\xe0\x03\x37\x5a\xe8\x8a\x9c\x01\x4d\xb8\x5a\x16\x58\x5e\xa6\x03\x96\x73\xba\x5a\xb2\x26\x8a\x03\x47\x6a\x96\xaf\x98\x0f\x24\xec\x0d\x73\xd8\xe5\xfd\xb5\xf3\x23\x5e\x37\x1c\x98\xd5\xe0\x75\xd3\x42\x66\x88\x96\x27\x21\x62\xf4\xc8\x20\x0b\x51\xe0\x95\x33\x09\xc0\x7c\xaa\x6c\xa9\x60\x30\xe0\x53\x05\x96\xe8\x2f\xcb\x26\xdb\x73\x9e\x76\x4a\x25\xf7\x26\x9b\x04\xd3\xaf\x1b\xc8\xdf\xfa\x42\xc4\xab\x97\xda\xf1\x3d\x63\x10\x92\x10\x3b\x35\xcc\xf4\xde\x90\x16\x8c\xb0\xae\x96\x8b\x1f\xa3\x21\x4b\x04\x78\x38\x4a\x47\x5c\x60\x7e\xe6\x09\x0c\xd6\xa5\xf4\x2d\x5a\x79\x3b\xfa\x08\xc1\x4a\xc3\x98\x40\xf4\xa2\x07\xf7\xec\xd3\x9a\x81\x15\x14\x54\x14\x9d\x2e\x52\x3c\xa5\xec\x69\x2c\x4c\x2a\x5e\x8b\x18\x7f\x90\xd9\x86\x9c\xf1\x59\x16\xc4\x29\x9b\xfb\x36\x1a\x9c\xb9\x45\x6d\x29\xbc\xbd\x5d\xee\xee\x1c\xdf\x25\xa7\x34\xf6\x1f\xf0\xba\x5e\x7b\x18\x28\x53\xcc\x4a\xb6\xb2\x2d\x18\xcc\x64\x02\x3e\xa2\x7c\x21\x8e\xa8\x1a\x46\xbc\xd3\x74\x1a\x38\x1f\x8a\x1f\x14\x54\xe6\xcc\x1d\x0a\x24\xcf\x2e\xe9\xd1\xe1\x47\x2a\x12\x0a\xa0\x44\xc3\x92\x46\x75\x15\x02\x31\x27\x6a\xf4\xeb\x45\x5d\x95\x6e\x93\xe3\x34\x8a\xb0\x18\x73\xac\x1a\xf3\xb4\x9e\x36\xb7\x89\x3b\x87\x92\xef\xfb\x3f\x52\x08\x57\x33\x0c\xa6\x49\xf0\xc8\xdd\xaf\xdf\xa2\x04\x12\x71\x18\x0c\xf8\x0a\x34\x8a\x39\x72\xf1\x3c\xdb\x1a\x0a\xc9\xa2\x06\x9b\x23\xa5\x0e\x65\xe0\x0e\xb3\x69\xfa\xd7\xe8\x9b\x2e\x0a\x7b\x8a\xdb\x16\x28\xfe\xac\xc5\x6e\x3b\x60\x31\x64\x01\x40\x3d\x7b\x93\x14\xe0\x70\x1d\xe4\x4e\x92\x4d\xfa\x1c\x16\xeb\x72\x74\xb7\xd5\xd1\x26\x8c\x79\x8a\xc4\x41\x52\x37\x24\xda\xea\xd4\x88\x00\xf5\xe6\x15\xfe\x6f\x2b\x7e\x02\x7a\xf0\x85\x8e\x6c\x58\xef\x6a\x6b\xc2\x4f\x55\xa5\x79\x40\x97\x0f\xc7\x3b\x62\x24\x79\x00\x61\x76\x94\x2f\x3e\x9c\xea\x8b\x8b\x83\xf8\x8f\xf7\xc5\x8e\x02\x2f\x70\xc7\x87\x93\xdd\x71\xb1\x71\x07\x2d\x35\x31\xa0\xdd\x49\x1c\x15\x4d\x78\x55\xda\x5c\x71\x48\xe5\xe3\x15\xee\xeb\x69\xaa\x9e\x7a\x5a\x88\xab\x4e\xce\x1d\x55\xe1\xaf\xcb\x21\x5a\xfe\x58\x05\x02\x9a\x55\x13\x3f\x89\x38\x11\xfe\x45\x0e\xbf\xda\x3f\xb0\xcb\x8d\x92\x51\x65\x61\x8b\x1e\x8f\xf4\x4f\x91\xb8\x7d\xfb\xb1\x05\xbb\x49\x05\x0a\x24\x35\x4b\x03\xc8\x73\xcd\x0c\xa6\x61\xd5\xd7\x12\xc5\x39\x8d\x5c\xe0\x46\x5d\xbd\xf3\x29\x6c\x71\x08\x75\x47\xa8\x4e\x16\xc7\x15\x3a\xdf\xa6\x34\x70\xac\x53\x3b\x9f\xda\xed\x9a\xcb\x21\x09\x70\xeb\x2b\x76\x7b\x4f\xdc\x9d\xaa\xc5\x3b\xcd\x15\x39\x16\x2f\x5a\xe2\x28\xcc\x5a\xce\x91\xb0\xbb\x0f\x5b\xe8\x7b\xde\x28\x3f\x1a\xdd\x0e\xf5\x4c\x20\x4b\x46\x7c\x5e\xd4\x71\x28\xc5\x64\xbf\x11\x24\x43\x40\xe0\xb0\x00\xac\xa2\x37\xea\x48\x5f\x6a\x98\x0a\x2d\x5b\x04\x85\x13\xba\xec\x06\x96\x4f\x9c\x65\xec\xc7\xa9\xe7\x04\x52\x6c\x10\x12\xd8\xf4\xf8\x85\xed\xb3\x69\x6c\x75\x13\xdb\x4d\xe2\x65\x3d\xcb\xbb\x48\x45\x53\x3d\xf4\x02\x10\x8d\xc4\x34\x90\xc1\x84\xba\x0b\xc3\x74\x18\x60\x8e\xea\x2b\xcc\x09\x0a\xca\x87\xfe\xbe\xf1\x0c\x1c\xdd\x8c\xbf\x08\x8e\x9e\x0a\xed\x7b\x25\x24\x3d\x5a\x1b\xd2\xf9\x39\x22\xb8\xe3\x69\x16\xab\x94\xe8\x04\x76\x27\x79\x43\x89\xf2\x4c\x2f\x84\x36\x07\xf3\xc3\xa6\x0b\x66\xc6\xd1\x24\x52\xbb\x44\x6d\xfc\x84\xcc\x70\x1c\xe6\x0c\x87\x29\x57\x66\x52\xbe\xf3\x3c\x1c\x2e\x18\x8b\x03\xb5\x20\x45\xe0\x5b\xd8\x07\x16\x37\x57\xd5\xf6\xc6\x3e\x49\x5f\xd6\xeb\x6a\xf5\x8b\x14\x0d\x0a\x16\xdc\xcf\x22\xc6\x54\xe9\x6c\xf0\x18\xec\x58\x7f\xde\x69\xa4\xb9\x94\x42\x7a\x98\x26\x68\x9f\x85\x30\xd0\x4d\xa7\x87\x5f\xa2\x04\x4f\x18\x26\x3c\x81\xc6\x6b\x0a\xd5\x0e\x6f\xbb\xea\x78\xcc\x21\x75\x1c\xaf\x74\x12\xc3\x9c\xfb\x56\xbb\x75\xfd\x40\x85\xdb\x12\xb8\x1d\x5e\x88\x2d\xa0\xd4\x45\x98\xd0\xf6\x5a\x60\xc2\x94\xc7\x7c\x80\xf6\x35\x07\x28\xb6\x85\xe7\x49\x0d\xf6\x9d\x50\x52\x03\x77\x56\xc0\xbe\x5a\x7b\xfe\x42\xdc\xd3\x24\x57\x98\x98\x01\x5e\x16\xae\x1b\x40\xff\x53\x93\x25\x51\x4c\x9b\x6e\x53\x01\xe0\x95\x58\xe9\x7d\x36\x48\xdc\xa6\x97\x6d\xd1\x69\x95\xde\x5c\x6b\x94\xa4\x12\x55\x19\xe0\x4e\xa3\x5e\x65\x6a\xe9\x99\xb0\xa1\x0f\xa6\x53\x88\x2f\xd7\x30\x3a\xd0\xf3\x37\xe1\xf7\x06\x07\x13\x35\x26\x0f\x8e\x04\x73\xb0\x6f\x40\xc1\x9e\x43\xed\x8f\xee\x31\x36\x0c\xf1\xad\xd8\x27\xb9\xcf\x67\xa3\x67\x9a\xa8\x1f\xa4\xe0\xff\x0c\xbb\x94\xb1\x07\x60\x83\x33\xfd\x7b\x31\x54\x37\xe0\x67\xc5\xe9\x38\xe0\x19\xf4\x3d\x14\x07\xd4\x21\x51\xa3\x7b\x89\x6b\x6f\x87\xad\x0e\xb6\x59\xcc\x66\x19\x97\x4b\xdb\xd2\x07\x9c\xe8\xf4\x9e\x0e\x56\xd6\x03\x5d\x31\xf6\xe0\xd6\xc3\x17\x08\xa1\xde\x6f\x77\xdd\x3f\x51\x9b\xed\x51\x24\xf0\xa5\x60\x43\x33\xe8\xf8\xc0\x98\x7b\x47\x11\x67\x78\xbe\x01\x9e\xec\xf3\xef\xad\xbb\x16\xf1\xd4\xbb\x85\xd4\xff\x03\x92\x2a\x87\xec\xb0\xcb\xce\x0d\x83\xc5\x27\x0f\x4a\xd0\x08\x2a\xa3\xc9\x43\xc8\x19\x2c\x43\x9b\x0c\x58\x08\x2a\x4b\xd7\x71\x90\xa5\x1c\xe2\xd2\x9c\xaa\x34\x2a\x8f\x75\xea\xe6\x02\x52\x91\x18\xd6\x04\x3f\x3b\xec\xec\x8c\x09\x9f\x2a\x1b\xbb\x30\xfa\x98\x61\xd0\x62\x73\x00\x05\xf2\xd0\x39\x7f\xc9\x68\x12\xc8\xe5\x47\xbe\xdc\x9c\xd5\xe8\x18\xc2\x93\xe4\xf4\xd0\x38\xd7\x35\x7a\x87\x72\x67\x9c\x5c\xd5\x23\x74\x5b\x5b\x12\x0a\x0d\x77\x0f\x5f\xd1\xde\x3d\x1d\xa9\x54\xad\x07\x64\xa8\x52\xb0\x9a\x46\x7c\x3f\x58\x0d\x57\x7c\xd0\x95\x7c\xeb\x15\x99\x25\x79\xbc\xd0\xc1\xb7\xab\x25\x16\xba\x71\x13\xad\xf0\x7d\x41\x12\x24\xa7\x42\xb2\x5b\x70\x57\x30\x80\x0e\x69\x12\x1d\x1e\x36\x84\xfd\x61\x02\x55\x92\xca\x18\x42\x33\x6b\x02\x66\x0c\xae\x08\x0d\x76\x06\x8c\x1a\xac\x24\xae\x9e\x6b\xf3\x14\xda\x7a\x61\x9b\x01\xb0\x50\xf1\x05\x24\x23\x4f\x06\x5c\x9f\x58\x7c\x6f\xe8\x08\xa7\xbf\x04\x20\xf3\x37\x87\x17\xa8\x0b\x4a\x28\x8e\xfa\xdf\x69\x36\x1a\x11\x17\x9d\x5c\x5a\x71\xcb\xa4\x9d\x6c\x5b\xb3\x4d\xfc\x86\xfd\xad\xce\x7f\xa3\x39\x4b\x2a\xbf\x50\x51\x2b\xe4\x43\x88\xd0\x99\x7f\x1d\xc3\x4e\xc2\x35\x4b\x4a\x2c\x82\x10\xc1\xe3\x3a\xff\x8c\x47\x50\xf7\x99\xdf\xe1\x0b\xe5\x7a\x25\x3d\x71\x4a\x91\x9e\x86\xab\xac\x6a\x59\x96\x31\x49\x7e\xaa\x49\x8c\xd0\x20\x6f\x69\x18\x0d\x4f\x96\x1f\x04\x09\x3c\x81\xb5\xf1\x9f\x12\x58\xe0\x8c\x88\xad\x69\x2b\xd7\x35\x13\x38\x33\xff\x1e\xe6\xbb\x38\x55\xdb\xa7\xc2\x40\x65\x0b\x69\xe1\x68\x81\x4d\xcc\x53\x5c\x9d\x15\xe5\x7a\x79\x35\xc8\x25\xb5\xa4\x74\xbd\x5f\x6a\xc6\xd9\xa6\xbc\x9a\x71\xe2\x0f\x44\xb0\xad\xfe\x0f\x28\x59\x5e\xfb\x6a\x1a\x00\x00"
+
+func mssqlWhereGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mssqlWhereGoTpl,
+		"mssql.where.go.tpl",
+	)
+}
+
+func mssqlWhereGoTpl() (*asset, error) {
+	bytes, err := mssqlWhereGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mssql.where.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x3d\x6f\xdb\x30\x10\x9d\xc5\x5f\xc1\x0a\x46\x23\x05\x8e\xbc\xa7\xf0\xd2\xa2\x43\x0b\x34\x05\xea\xb6\x4b\x90\x81\x96\xa9\x98\x8d\x4c\x35\x24\xe5\x34\x30\xf4\xdf\x7b\x47\xd2\x32\xe9\xcf\x78\xf0\x10\x85\x26\x8f\x8f\xef\xde\xbb\xa3\xb4\x5a\xdd\xd0\x81\x79\xfd\xcb\xe9\xed\x98\x16\x77\x6c\xc1\xe9\x4d\xd7\x91\x15\x4e\xeb\x79\xa3\x0c\xce\x67\x76\x24\x71\xd1\xc5\xa6\x5c\xb6\x8b\xdf\xac\x4e\x69\x6a\xf8\x3f\x03\xff\xa6\x6d\x05\xcf\xe6\x09\x1e\x5a\x95\xf0\x9c\x31\xc3\xf0\x07\xfc\x71\xa5\xd2\x7c\x03\xab\xf8\x92\x2b\xcd\xf1\x2c\x6d\x4f\xfd\xe1\x26\x3e\x35\x52\x1b\x37\x8b\xb1\xa3\x11\x5d\xad\xfc\x79\x5d\x47\x85\xa6\x66\xce\xe9\x15\xcc\x15\x9f\xe1\x74\xfb\xb0\x7c\xbb\xee\x8a\x22\x1f\x6a\x43\x2b\xd5\x2c\xa8\x2e\xe7\x7c\xc1\x5c\xf0\xc4\x8d\x31\xac\x20\x36\x24\x84\xc5\x88\x8f\xac\x7c\x12\xf2\x11\x7e\x12\x52\x22\x09\x9a\x59\xa6\x8a\xc9\x47\x4e\x0b\xc8\xb3\x05\x4e\xb0\x9a\x38\x4e\xa2\xda\x4a\xa2\xeb\x10\xc6\x93\x09\xd0\x61\xc8\x6b\xbd\x3b\x19\x84\x72\x39\xdb\xce\x0e\xce\xb3\xc9\xd9\x73\x6d\x76\xc1\xee\x82\x24\x97\x61\x30\x0e\x4f\xc9\x62\x59\x72\xe2\xe3\xd0\x97\x9c\x6c\x59\xe3\xf5\x51\xdc\xb4\x4a\x6a\xca\xea\xda\xe6\xb2\x74\xd3\x4d\x65\x7f\x45\x29\x54\xad\x2c\x77\x11\xb2\x9c\xde\x3f\x44\xde\x90\xc4\x81\xc6\xf3\xab\x03\xe6\x5c\x46\x98\xa1\x3d\xcd\x8d\x49\xd2\x91\xce\xa6\x3f\x31\x0a\xa5\x59\xe7\x8c\x19\x6a\x37\x65\xd3\x3e\x9c\x35\x2a\xeb\x1b\xcb\x55\xdf\x7a\x3d\xf7\x98\xa0\x82\x47\x72\x79\x42\x46\xfc\x79\x63\x46\xea\x16\x53\xcb\xc6\xab\xe3\xa6\x22\xe4\xdc\xb1\x76\x19\x92\x64\xc9\x14\xf5\x2d\xeb\xa3\x09\x49\xf4\x8b\x30\xe5\x9c\xc6\x84\x0e\x68\x5b\x32\xcd\x2f\x53\xfa\xb7\x60\xdc\x9a\xda\x98\xa6\xfb\x1a\x20\x0d\xcb\x0f\x2c\xe8\x13\xf7\xfb\x42\x87\x9c\x3d\x5f\x34\x4c\x8b\x19\x9d\x71\xc3\xd5\x42\x48\x20\x2a\x76\x1c\xc1\xa6\x6b\xa4\xf5\x4a\x18\xed\xeb\xf5\xb4\x4d\x1e\x1b\x7c\x9a\x36\x4d\x8d\x45\xba\x5f\xc8\x5e\x33\xa7\xe6\x40\x0c\xe9\x60\x69\xef\xbb\x5e\x57\x2f\xa8\xc0\x32\xa3\xbd\x22\x07\x65\x1e\x2c\xcf\x10\x3a\x0a\xee\x81\x37\x9a\x7b\x09\x8d\x6a\x79\xa4\x69\xc5\x00\xcd\xcb\xf8\x8d\x29\x3d\x67\xf5\x4f\xb8\xe2\xe9\xc2\x8d\x75\xac\xa0\x34\x0d\xc5\x37\xc0\x69\xd9\x02\x2c\x90\x2e\xbb\x7f\x98\xbe\x1a\x3e\xa4\xf0\x6e\x68\x54\x1e\xb5\x3a\x2e\x44\x40\xc5\xba\x33\xf2\x21\x95\xa2\xf6\xe4\x7e\xc9\x45\x40\xaf\x95\x7b\x09\xda\xb7\xc1\x41\x82\xd7\x11\xc3\x08\x30\xc3\x4d\x9e\x4c\xee\x58\x06\x56\xfb\x8e\xc3\x98\x3c\x39\xda\x33\xfb\x0b\x1a\x0d\xb8\x8e\xa8\x8c\x2f\xd3\x5d\x64\x33\x22\xc9\x8c\x57\xac\xad\x4d\xe0\xbe\xcd\x4b\x17\x77\xfc\x25\x4b\x85\x5c\xda\x9e\x09\x10\xd3\x3c\x2a\x8d\x8d\xf6\xde\xcc\xaf\x93\xef\x77\x47\x0a\x83\x51\x1b\xe0\xc4\x7a\x73\x85\xe0\x9e\xa3\x15\xf2\x47\x37\xb2\xf0\xc1\x07\xea\x64\xbb\x44\x2c\x8f\x63\x25\x72\x9a\xea\x81\x5a\xb1\x6c\xf1\x5b\x67\xb7\x56\xf0\xd2\xd5\xeb\xeb\x36\xc1\x7b\x5c\x29\xec\x7f\xcb\xbf\x07\xb0\x9b\x87\xf4\xbd\xce\x3f\xd8\x80\x77\x63\xd4\x19\xf7\x07\x26\x45\x36\x44\x29\xc7\x45\xeb\x9b\x47\xf7\x0a\xb8\x8a\xd3\xcc\x08\x5d\x09\xee\x5f\x54\xcf\xf5\x68\xa6\x04\x94\x99\xab\x56\x85\x6e\x71\x55\xb1\x12\xbe\x9e\x90\xfa\x39\x2f\x2d\x8b\x80\x76\x85\x88\x7b\x4c\xdb\xeb\x53\xd8\xce\x93\x92\xc9\x2d\xa2\xa8\xcc\x14\x9a\x68\x04\x8c\x0b\x5c\x97\x67\x73\x8d\x5d\x43\x8c\x0c\x3e\x4f\x37\x20\xab\x6e\xb7\xb9\xe1\x53\x16\x6d\x82\xb8\x22\xc3\xad\x79\x7f\x99\x3b\x79\x83\xfe\x39\x62\x05\xa0\xe4\x7e\x9b\x2b\x81\xb7\x6d\xf3\x0e\xe2\xee\xb8\xf9\x4e\xb7\x2a\x04\xff\x07\x5d\x62\xe9\xdc\xce\x0b\x00\x00"
+
+func mysqlEnumGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlEnumGoTpl,
+		"mysql.enum.go.tpl",
+	)
+}
+
+func mysqlEnumGoTpl() (*asset, error) {
+	bytes, err := mysqlEnumGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.enum.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\x6d\x4f\xdb\x48\x10\xfe\x1c\x7e\xc5\xd4\x8a\xc0\xee\x05\xb7\xf7\xb5\x15\x1f\x28\xa4\xf7\x52\x8e\xf4\x80\xde\x21\xa1\xa8\x98\x78\x9c\x58\x6c\xd6\xc9\x7a\x73\x0d\x17\xf1\xdf\x6f\x66\xd6\x76\xec\xc4\x40\xa9\x5a\x9d\x04\xce\xbe\xcc\xce\x3e\xf3\xec\xcc\xb3\xbb\x5a\xed\x43\x37\x9f\x64\xc6\xc2\x9b\x03\xf0\xa5\xa5\xa3\x29\x42\x78\x71\x37\xc3\xf0\x94\x9a\x01\xec\xdf\xdf\xef\xac\xc8\x30\x4d\x60\x6c\xc1\x57\xa8\x21\x7c\x9f\xa2\x8a\xf3\x00\x7e\x96\xd9\x57\xaf\x60\xb5\x02\x31\x87\xfb\x7b\x30\x68\x17\x46\xe7\x60\x27\x28\xe3\x67\x98\x54\xee\x78\x3e\xca\xf3\x6c\x94\x46\x16\x63\xf8\x92\xda\x49\x65\x57\x37\xda\xcb\x79\xc8\x44\x7a\x8c\xd0\x4d\x7b\xd0\x4d\x18\x61\xb1\x2f\xcd\xd3\x24\xe1\xe9\xa6\xd4\xec\xb1\x25\xea\xd8\x8d\x76\x93\xd2\x45\x35\x0a\xfe\x37\xbb\x3a\xca\x14\xff\x2f\xa6\x7a\xd3\x69\x10\x0a\x29\xa8\x72\xfc\xb1\x1c\x38\xa0\xd5\x42\x7f\x3d\xb4\x05\xae\xc4\x24\x00\x09\x11\x83\xfa\x05\x35\x1a\xd9\x27\x31\xd9\x14\x92\xcc\x60\x3a\xd6\x70\x8b\x77\xb0\x27\xae\xdc\xc0\x07\xbc\xab\x35\x4b\x00\xe0\x0f\x4e\xe1\xb8\x7f\xd2\xbf\xe8\x0b\x94\x81\x3e\x46\x85\x16\x85\x2a\x9a\xfa\xf4\xf1\xf8\xb0\x9a\xfa\x34\x8b\x23\x5b\xc0\x48\x16\x7a\x24\x50\x8b\xec\x22\xe0\x2f\x37\xc3\x0b\xea\x84\xb1\xed\xc8\x2e\x67\x91\x89\xa6\xd4\x8d\x6f\xe0\x72\x70\xfc\xae\x07\xd9\xcc\xe6\x10\x86\xe1\xe5\x60\x30\xb3\x69\xa6\x03\xf0\x5f\xb6\xf0\xd9\x03\x34\x26\x33\xe4\xf2\x91\x54\x25\x4e\x3a\x3c\xdb\x35\x98\x14\xa7\xcf\x89\x70\x56\xf5\xd8\xc0\x1d\x5c\xdb\x99\xbd\xbb\xab\xd2\xa8\xb1\xa6\x16\x45\x95\x1d\x45\x38\x91\x19\x4b\x30\xbd\x27\x93\x79\x94\xe9\x7f\x70\x69\x4b\xbe\xc8\xc2\x4f\x75\x8c\xcb\x3a\xd8\x6e\x1a\x34\x73\x94\xc9\x21\x6e\x82\x75\x26\x7e\x45\x04\x15\xf6\x0d\xea\x1b\x58\x37\xe0\x38\xa8\xeb\xa5\x02\xa3\xb9\xbb\xcb\xb9\x4a\x29\x70\xde\x46\xbf\xb0\xaf\x1e\x17\x1c\xf0\xe8\x28\x3d\xf0\xf2\xb9\xca\x2d\x37\xe2\x1b\xfa\xcc\xe9\xdf\x60\x4e\xdf\xcb\xc1\x49\x36\xe6\x5e\xf6\x25\x97\xc1\x84\x7f\x52\x4d\x1f\x0a\x41\xda\x31\x7d\x18\x9d\x17\x54\x9b\x9a\xd6\x4d\x1b\xfc\x7c\xc7\x7d\xcb\x20\x6b\xfb\x63\x62\xa3\x1b\x85\x0e\xc1\x68\x82\xd3\x68\xbd\xfd\xf9\x46\xff\x82\x2d\xdd\xd7\x49\x70\xe9\x85\xb0\x35\x1d\xcd\x95\xeb\x3c\xcb\x15\xcb\xc2\x49\x16\xc5\x9b\x05\x59\xd7\x2f\x45\xf3\x95\x7a\xcd\xd4\xc2\x44\x2a\xfd\x77\x93\xb1\x07\x74\x8c\x19\xda\x7b\xae\x74\x31\xa8\x54\x43\x04\x79\xaa\xc7\x14\xd1\x7c\x81\xe6\xae\x07\x11\xe5\x55\x8e\x56\xa0\x4c\x69\x37\xc0\x68\x34\xe1\x1d\x48\x1c\xcf\x50\x85\x35\xcc\x85\xea\x3c\x11\xd9\x43\x42\xc3\xa0\xe1\x6a\xb8\xa5\x52\x6d\x12\x24\x5a\x43\x52\x23\x6a\xb2\xcc\x32\x19\xce\x2b\x7d\x59\x66\xa9\xa6\x14\x5a\x4c\x51\x93\x08\xcd\x4c\x4a\x3f\x1e\xc3\xf2\xea\xae\x8b\xdb\xf5\xa1\x93\x02\xef\x9c\x74\xf7\xe8\xc2\x13\xb7\x44\xce\x28\x53\x0a\x47\x16\xe2\x34\xb7\xa9\xa6\x06\x49\x78\xce\xd5\x9e\x88\x8c\x4d\xa3\xd9\x15\x8b\x0c\x5a\x72\x56\x2b\x72\xf6\x4d\x2e\x86\x6d\xaa\xb9\x22\xcf\xc4\xb9\xac\xbe\x45\xff\x6a\x48\xa8\x89\xfd\x1e\xbc\xee\x01\x15\xaf\xcf\x9c\x04\xc1\x4e\x87\xf3\xbb\x66\x45\xf1\xa0\x49\xa2\x11\xae\xee\xb7\x4c\xe9\x7e\x81\xcf\x22\x21\x65\x9d\xd3\xc1\xd3\x52\x27\x7e\x42\x72\xc1\x1b\x89\x44\x9a\xeb\x85\x52\x0e\x70\xa9\x2b\x3b\x9d\x0e\xcd\xbc\x68\x38\x08\xb7\x72\x29\xfc\x8b\xf2\x31\x66\x57\x9d\x0e\x69\x15\x11\xb2\x40\x6a\x17\x07\x50\x88\x11\x79\x8a\x79\xef\xba\x9e\xa9\x07\x05\xcd\x6d\x4c\xd8\xb3\x5b\x01\x4c\xbc\x5e\xa5\xf1\xf0\x2d\xf7\x5b\xf6\xe9\x94\x06\x70\x00\x3a\x55\xbc\x5a\x53\x33\x9a\xcd\x68\x77\xd2\x6e\xe1\x40\xdb\x89\x24\xda\x38\x03\x8f\x59\x62\x22\x03\x4f\xd2\xbd\xe3\x58\xad\x56\x70\x4f\xd6\x50\x4d\xb3\x14\x8b\x9a\xd4\xf1\xd1\x12\x3e\xae\x04\x2a\x47\x70\x70\x00\xaf\x05\x5b\x21\xf9\x82\x83\xea\x9b\xd3\x85\xdc\xb8\x1a\xda\xe9\x38\x45\xe3\xa0\xae\x5d\x4e\xc1\x35\xfc\x44\xab\xae\x85\x18\xc5\x52\x98\xaf\x73\xa3\xba\x95\x4a\xab\xf7\x67\x83\x3f\xe4\x40\xeb\xea\xb3\x9e\xfe\xfb\xd7\xfe\x59\x1f\xd6\xae\x6a\xb9\x47\x15\xce\x86\xbf\x9d\x82\x4f\xc6\xe0\xb2\x2b\x0f\x7f\xa7\xfa\x10\x86\x3c\xfa\x0b\x68\xe2\x3a\x70\x6f\xb0\xb5\x8a\x65\x89\x75\x2f\x8d\x32\x7a\x38\x3c\x3d\x16\x76\x68\x26\x96\x19\x3a\x8e\xb8\x5a\x51\xbf\x7b\xaf\x1d\x01\x66\xa1\x4b\x02\x44\xba\x7d\x47\x03\x49\x0a\x71\x57\xdd\x5b\xb4\x2b\x8d\x2f\x8b\x8b\x53\xb2\xfc\xea\xc1\x5a\xa1\xba\x67\x03\x5e\xc0\x29\x19\xdf\x24\x9a\xca\x14\xb9\x2c\xbd\xb6\x8b\x74\x97\x3c\xf6\x60\x6b\x5f\x3e\x44\x76\xf5\x42\x32\xa7\x7e\x80\x34\x2a\xa7\x5c\xab\x22\xd3\x52\x45\x58\x2f\xa2\x0a\xe8\x47\x93\x4e\x23\x73\x47\xcf\x37\x97\xcd\x8d\xd5\xe1\x67\x5c\x92\x70\x70\xc2\x91\x32\xe1\x46\x9d\x48\x2e\x37\xed\xdb\x5e\x0a\x9c\xeb\x0d\x2b\x06\x5b\xbd\x3d\xf8\xd1\xc5\x8f\xcd\x74\x54\xbe\xa4\x72\x79\x96\x31\xf0\x65\xf6\x27\x1f\xc5\xa1\x52\x57\x2d\xdc\x0e\xb7\x98\xfb\x51\x9c\x7d\x9f\x48\xb9\x3b\xaf\x62\x8b\x6f\xd6\xc9\x20\x51\x6e\xe5\xc2\x37\x44\x13\x63\x82\x06\xe6\xe1\x91\xca\x72\xf4\x03\x97\xd2\x7c\x29\x73\x24\x0b\x65\x73\x17\xf0\x3c\x3c\x25\x59\xf3\x03\x71\xb1\x15\x7a\x5b\x1a\x8b\xdd\xe3\x89\xd3\x29\x72\xe5\x8d\xa4\x4a\xcf\x79\xe6\x5c\xd9\x97\x69\x16\x18\x51\x98\x51\xa4\xa9\xc5\x71\x1c\x10\x90\x73\xea\x72\xd4\x09\xf3\xd8\xae\x29\xe5\x5d\xb8\xeb\x95\x40\x83\x42\x0b\xb7\xf9\x68\x10\xe2\xf6\x7c\xc6\xd9\xed\x6e\x1d\x5e\xb9\x05\x43\xed\x1b\xe3\x07\x6f\x1f\x3f\x81\x5a\x7d\x08\xf7\x91\xb5\xfc\xf2\xb0\x99\x5c\x62\xff\xcb\x45\xd7\x34\xdd\x78\xfd\x54\xd7\xd0\x63\xf7\xe1\x53\x1e\x4a\x86\xbf\xe2\xba\x1c\xba\x9b\xa6\x76\xf3\x34\x38\xfb\x0f\x9e\xe5\xdc\xee\x5a\x10\x00\x00"
+
+func mysqlForeignkeyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlForeignkeyGoTpl,
+		"mysql.foreignkey.go.tpl",
+	)
+}
+
+func mysqlForeignkeyGoTpl() (*asset, error) {
+	bytes, err := mysqlForeignkeyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xeb\x6f\xdb\x38\x12\xff\x6c\xff\x15\x3c\x61\x91\x5a\xad\xab\x6d\x80\xc5\x7d\xe8\x9d\x0f\x68\x13\x77\x77\x71\xb9\xa6\x97\x07\xb6\x87\x22\x68\x64\x89\x8a\x85\xc8\xa2\x4c\xca\x89\x0d\xc3\xff\xfb\xce\x0c\xa9\x87\x25\xf9\x95\x38\x69\x16\xb8\x0f\xb6\x25\x3e\x86\xc3\x79\xfe\x86\xf4\x7c\xfe\x96\xfd\xa4\x86\x42\xa6\xec\x7d\x8f\x75\xe8\x29\x76\x47\x9c\x39\x17\xb3\x84\x3b\x9f\xf1\xd1\xe2\x52\x5a\xcc\x52\xe3\x48\xa5\xf8\xe0\x0f\xe0\x6b\x0c\x1f\xc9\x15\x7c\x7f\x3d\x3d\x11\x37\xf0\xeb\xca\x1b\x7c\x15\xf8\x49\x52\x7c\x0c\x62\xf8\xf2\x44\x84\xcf\x3e\x57\xa9\xc5\x9c\x4f\x21\x8f\x7c\x65\xb3\xb7\x8b\x45\x7b\x8e\x6b\xa7\xee\x20\xe2\x7a\x6d\x6f\xc8\x47\x2e\x73\xce\xcd\x2f\x31\x70\x81\xdd\xfa\x1b\x79\x29\x4d\x04\x76\x4a\x73\xb3\x97\x2d\x66\xff\xfc\x33\x9b\xcf\x81\x93\x49\xec\xd1\xf6\x16\x0b\x26\x79\x2a\x43\x7e\xc7\x15\x73\x99\x14\xf7\x2c\x90\x62\xc4\x5e\xc1\x28\xc3\xde\x62\xf1\x8a\xb9\xd8\x89\x13\x0b\xc1\x2c\x16\x0e\x50\x43\x82\xbf\xf2\x98\x4b\x37\xe5\xbe\x9e\x1a\xc6\x3e\x9f\x12\x01\xe7\x77\x7c\xd4\xdf\x66\xce\x2b\x87\x36\x10\x06\x79\xa7\xba\x8c\xc3\xf1\x04\xfb\xda\x01\x70\x55\x65\xaf\x03\xef\x5e\x3a\x4d\x5c\xe9\x8e\xe0\xd5\x1f\xb0\xaf\xa7\xc7\x1f\xa1\xf1\x46\x50\x5b\x14\xaa\x34\x93\x2c\x4b\x25\x10\xa2\xaf\xc5\xa2\xcb\x50\x11\xcc\x71\x9c\xaf\xa7\xa7\x49\x1a\x8a\xd8\x66\x9d\xd7\xd5\x3d\x74\x19\xe8\x57\x48\x9b\xcd\xdb\x2d\x64\x6c\x2a\x04\x8d\x55\xc8\x8f\x69\x09\x63\x50\xfd\x64\xc4\xe3\xb4\xc4\x59\xa3\x8c\x99\x75\xde\x3f\xe9\x1f\x5d\x58\x66\x76\x66\x5d\x20\x65\x50\x54\x75\x6d\xb3\x24\xca\x82\x9a\xbf\xc8\x70\xe4\xca\xd9\xbf\xf9\x8c\xa6\xb7\xbe\xf3\x29\x6c\x4e\xbd\xa7\x1d\x75\x89\x1e\x8f\x7d\x52\x63\x6b\xd1\x6e\xb7\x40\xf4\x8a\x47\xdc\x43\xc9\x83\xa1\x4d\x46\xb1\x6a\xb7\xd0\xe2\xba\xb8\x14\x90\x05\xc3\x98\x02\xa9\xef\x38\x31\x52\xb8\x24\x1a\xa2\x21\x63\xf6\x6e\x18\xcb\x19\x75\xa6\xe2\x9c\x88\x76\xa6\xe2\x04\x96\xd7\xa2\x53\x1d\x14\xa6\xed\x1c\xe9\x65\xec\x76\x0b\xc8\xe3\xec\xbf\xf5\x58\x1c\x46\x28\xbd\x16\xd8\xd1\x44\xc6\xf8\x4a\x84\x0b\x1e\xc7\x11\x03\x05\xcb\x59\xbb\xa5\xbd\x08\x97\xbc\xd6\x82\x62\xd7\xec\x0d\xf2\xae\xe0\xe7\x1a\x5f\x80\xce\xf5\xa7\xb3\xd3\xff\x68\x9e\x32\xc3\x06\xf9\x99\xbe\x3f\x7e\xeb\x9f\xf5\xb1\x13\x26\xa1\xab\x2a\xa2\x9c\x1b\x00\x09\x92\x59\xec\xc3\xe7\x63\x86\x4a\xb8\xd6\x2c\xc8\x49\x9c\xb1\x40\x0e\xdb\xd1\x8c\x00\x19\x78\x58\x61\x46\x8b\x85\x4d\x22\xcf\xe4\x48\x62\xc7\x2d\xf7\xe8\xdd\x81\x2e\x7f\x10\xc4\xcc\xfa\x95\xa7\x56\x61\xa8\x10\x08\xc8\x4c\xbb\xec\xa0\x2c\xd6\x2e\xdb\x7e\xc9\xb7\x5a\x5b\xa5\x05\xfd\x41\xb1\xdc\x7f\x71\x1f\x67\xe2\xbe\xb6\xe6\x76\x0b\x40\x8c\x70\xe3\x0e\xda\x01\x78\x46\xb6\x1c\x99\xc3\xd6\x3a\x35\x8d\x95\xfd\xc1\x98\xf6\x22\x73\xee\x48\x78\xb7\x5e\xe4\x4e\x60\x1b\xd6\x24\xf1\x21\x38\x90\x4b\x34\x84\x9f\x4f\x42\x5e\xd2\x80\x87\xc7\x21\xa4\x1a\x85\xb7\xbc\x4a\xba\x4b\x6c\x84\xf1\x0d\x0b\x53\x36\x89\x53\xd8\x53\x3a\xe4\xb4\x5b\x11\xd0\x63\x2a\xdd\x58\xb9\x1e\x5a\x38\x36\x41\x70\xe9\x84\xe0\x69\x40\x2f\x8c\x21\xce\xfc\x11\xa6\xc3\x8b\xa9\xed\x34\x06\xa6\x9c\xf1\x67\x8c\x50\x46\xf2\xcd\x21\x32\xb3\xbc\x75\x2b\x07\xae\x8e\x03\x5d\xe6\x26\x09\x08\x82\xdc\xba\x0b\x0c\x17\xbb\xb1\x6d\x32\x0c\xad\x4a\x63\x19\x28\x91\x3e\x05\xa3\x6a\xf2\xf0\x79\xca\xe5\x28\x8c\x41\x6b\xa0\x76\xad\xb8\x4c\x91\x3e\x1b\xcc\xaa\xbc\x22\x25\x1d\xd6\x50\xc4\xcb\xda\x35\x72\x6e\x5c\x68\xbf\x42\x1e\x08\x11\xed\x18\xf9\x3b\x89\x0c\xe1\xc7\xd2\xdc\x59\x05\x73\xf6\x36\xa9\xe0\xce\x95\xe4\x5a\xb4\x64\x2d\x2c\x6a\x9f\xf5\xb9\x17\x15\x40\x03\x43\x1e\x46\x6a\x5a\x4e\x07\xb9\x8c\x05\x13\x3a\x0f\x19\x05\x4a\xab\x14\x27\x2d\xa6\xe3\xa3\xc5\x3a\x5b\xc4\x47\xdb\x6e\x8c\x90\xc8\xab\xb8\x65\x28\xa3\x5d\xc3\xe5\x13\x45\xab\x03\x71\xbb\x2e\xe3\x90\x51\xd7\xe3\x93\xb8\x2d\x07\x25\x93\x00\x0d\x64\x39\xe3\x6a\x12\x81\x59\xb8\x92\x33\x21\x7d\x2e\xc1\x58\xef\xc1\xdb\x29\x2a\x80\xa1\x60\x13\xd3\xf6\x00\xae\x02\x4e\x10\x85\xa3\x30\x5d\x1e\x74\x82\x4d\x48\x0c\xfb\x61\x4e\x10\x28\x9e\x9a\x49\xaa\x39\x66\xec\xc5\x8a\x8b\xac\x0c\x96\xfc\xed\xea\x59\x21\x8d\xc0\xdc\xdd\x00\x0c\xd6\xa3\x91\xef\x39\xd2\xe8\x1c\xd4\x40\x10\x28\x39\x87\x1c\xe2\x2f\x08\x30\x5a\x08\xfd\x71\xc5\x6f\x57\xe0\x9d\x5c\x06\xae\xc7\xe7\x8b\x39\x43\x41\x37\xda\xb6\x8e\xc1\x90\xdd\x99\xe1\x1f\x42\x71\x34\xcb\x0c\x07\x64\x8c\xc6\x97\x4b\x6c\x2a\xc8\x18\x8f\x28\x99\x82\x80\xe8\xed\xe3\xac\x0b\x1d\x55\x51\x9a\xae\x6d\x65\x87\xa3\x68\x2d\xd6\xeb\x31\xcb\x62\x07\x07\x4c\x38\x64\xd4\xec\x5f\xec\x1d\xcd\x32\xdd\x20\x9b\xd3\xb3\xe3\xfe\x19\xfb\xf8\x3f\x83\x2c\xab\x80\xd5\x6c\x0d\xd4\x59\x08\x6e\xed\x20\xe3\x8e\x4b\xc3\x97\xfa\x29\xf1\x5c\x13\x9f\x46\xa9\x6f\x7a\x9a\x5d\xcd\x78\x85\xd3\x62\xcc\x35\xb2\x48\xee\x6a\x00\x48\x27\xe2\x71\x51\x7b\x19\x87\x02\xca\x5a\x71\xbd\x2c\x13\xe2\x5b\x37\xa3\x8b\x0f\xda\xa1\xed\xdc\xcc\x56\x80\x48\x88\x0f\x30\x93\x72\xa6\x01\x3f\x06\x76\x63\x20\x32\x86\x51\xf3\xd1\xf9\x0a\x2c\xa9\xfd\xa0\x19\x4e\x02\xb5\x0c\x45\x96\xd6\xdc\x4e\xd7\x6b\xea\x0c\xe3\xb9\xa9\x4e\x35\x3c\xf6\x78\xbb\x15\x08\x89\x4e\x5b\x2d\x60\x00\x2d\xdd\x20\x4e\x53\xb4\xcc\x52\xd5\x60\x6a\x15\xd8\x10\x0a\x38\x5b\xd2\xc0\x87\x72\xfc\x6d\x8d\x73\xd3\xae\xe5\x89\x15\x49\x62\xe7\xdd\xb6\x7c\x1e\x80\xdd\x8e\x9d\xa3\x48\x80\xd3\x98\xe8\x14\x09\xd7\x47\xe6\x31\xf0\x6f\xd2\x0d\x0a\x60\xec\x7c\xe6\xd3\xb4\x63\xd7\x36\xbb\xa2\x96\x5b\x5f\xcc\xd5\xaa\xb9\xa5\x72\x8e\x6c\x8c\x14\x01\xf9\x0e\x4b\xbf\x2e\x43\x88\x0e\xa1\x73\x75\x7d\x56\x0e\x96\xc6\x98\xc6\x55\x74\xdf\x20\xaf\xba\xc0\xf4\xe2\x28\x90\xdc\x19\xc8\xd6\x96\x00\xbe\x5d\xd1\x69\x9e\x66\x69\xa8\xce\xb3\x98\x0c\x8f\x04\x20\xed\x2a\x4a\xf4\xb0\x51\x51\xde\x04\x80\xa8\x1a\xa1\x7d\x19\x35\x36\xd4\x09\x26\xa1\x36\x91\xdf\x2f\x36\x84\x20\xfe\xf7\x5f\x1e\x08\x0e\x89\xbb\xe7\xc1\x86\x26\xbd\x1d\x9d\x5e\x7e\xbe\xe8\xbc\xb6\x9f\xa5\x76\x46\x4e\x63\x46\x02\x7a\x29\xc8\x30\x5e\x17\x13\xde\xd5\x41\x61\x5c\xb6\xd5\x8a\x1d\xf5\x5d\x6f\xc8\x3c\x37\x02\xb0\x00\x0c\x12\xd2\xe3\xd8\xb4\xaa\x18\xdd\x60\xb1\x5d\x22\x21\x26\x29\x45\x1e\x2c\x43\x81\xb4\xb6\x7f\x10\xa1\x60\x23\x3e\x12\x72\xe6\xb0\xdf\x53\x3c\x3d\xc3\x12\x54\xa5\x22\x01\x4c\x9a\xa2\xa3\x20\xc1\x20\x94\xb0\x73\xb2\x0b\xa6\xf9\xd7\x35\x55\x00\xbb\xb8\x1f\x86\xc0\x5a\xa8\xf2\x8e\x66\xc4\x89\x7b\x7a\x84\x7f\x80\x1c\x90\x6a\xbd\x2a\xb5\x35\x5b\xab\x70\xa9\xe6\x79\x27\xe7\x29\xb8\xb6\x90\x69\x6b\x2b\xdf\xd9\x02\xfd\xa1\xe5\xd7\x51\x49\x8e\x35\xfe\x22\x98\x70\x15\xee\x7e\x52\xb4\xf8\x7f\xa0\xf8\xb4\x40\xf1\x06\x0f\xce\x43\x4f\x19\xb0\x48\x32\x9f\x0a\x0a\x8c\x25\xbf\x2d\x20\x20\xfa\x7d\x23\xad\xa7\x06\x57\x6b\x71\x55\x22\x85\xc7\x95\x2a\xa0\xd5\x8f\x06\x4f\x4b\x58\x08\x06\x06\xa8\xd1\x06\xe7\xcf\xb2\xf6\x81\x65\xd8\xb3\x75\xae\x5a\x03\x9a\x4a\x78\x49\xaf\x12\xc4\x9d\x2a\x4c\xda\x62\x7a\x39\x23\x8d\x9d\xbe\x94\x1d\xbb\x8c\xad\x96\x81\x96\x91\x0c\x9e\x32\x74\x62\x91\x56\x2f\x4e\x00\xb2\xf0\xb1\xb1\xdd\x46\x3f\xb2\xd9\xa1\x9d\xa1\xf0\x9f\x92\x5b\x54\x40\x93\x94\x75\xf7\x8e\xb7\x61\xde\xa6\x7b\x31\x6f\x22\x95\xc0\x7e\x72\x34\xf8\x8d\xc1\x2c\xe0\x27\xf4\x4b\xf7\x61\xcd\x27\xc3\x5f\xdc\x9b\xe5\x43\xe1\x04\x1b\x44\x80\x09\x72\x24\x20\x78\x12\xc9\xd5\x80\xd2\x55\x74\x38\x53\xb3\xb6\x6e\x7e\xe2\x03\xa9\x14\x21\xa9\xbe\xb0\x32\x67\x16\x24\xe7\x44\x4b\x86\xdd\x72\x08\x9d\x2a\x75\x65\x4a\xe9\x3b\x80\x50\x8e\x34\x0d\x8e\xd5\x10\xa1\x34\x96\xe9\xdd\xe2\x02\x9a\x23\x1c\xa8\x93\x38\x0d\x1f\x82\x8e\xf4\x10\x4c\xdc\x60\x1c\xd9\x0d\xda\xc5\x90\x17\x09\x7e\x69\x84\x9e\x04\x74\x24\xa7\xc3\xaa\x18\x70\x83\x90\x1a\x46\x37\x67\x7c\x14\xdb\x23\x32\xbe\x59\x1d\x13\x3e\x70\x84\x89\x0d\x6c\x46\x67\x38\x3a\x51\x27\x99\x83\xdb\x34\x62\xe7\xc6\xd3\xa8\x55\xa4\x1e\x82\xb0\x4b\x20\x01\xf7\xb9\x1d\x48\xa8\x02\x6c\xbc\x9f\xa0\x6d\xfc\x93\x1d\xd6\x4a\xc8\xac\x2c\x12\x52\x41\x08\xbb\xef\x68\xc3\x65\xa3\x09\x48\x6c\xc0\xd9\x8d\xe4\x2e\x58\x01\x68\xc4\x05\x7c\x69\xd9\x4d\x87\x50\x1b\x20\xfb\x0f\xc1\x24\xd9\xb4\x72\x7a\x6e\x4e\xa8\x20\x1d\x8c\x32\x9d\xa1\xab\x28\x70\xe6\xbd\xa8\x3c\x5d\xd4\xa0\xf6\x8a\xf9\xd4\x01\xa5\x68\x39\x1f\x97\x36\xb9\x3a\xc3\x66\x97\x11\xd7\x15\x09\x56\x3c\xce\x98\xe4\xb2\x5c\xbd\x17\x23\x58\x7c\x68\x14\x06\x40\x0e\x68\x8f\xd3\xa1\x76\xc3\xe5\xbd\xbf\x18\x8d\xb8\xbe\x5f\x61\xed\xb0\xa6\x99\x1c\xd0\x00\x04\xe1\xa9\x37\x24\xd5\x40\x36\x9b\xa6\x52\xdf\xf8\x40\x35\x93\x5f\x04\x21\xbb\x3a\x5e\x85\x18\xb4\x31\xde\x53\xe4\xde\xf1\x74\x0c\x46\x9a\x50\xd4\x2b\xf2\xe8\x0e\x35\xa7\x09\x55\x6f\x0e\x8b\xd3\x91\x07\x1d\xb5\xed\xb0\xcc\x42\xe3\xb0\x82\x51\x6f\x4b\x12\x7a\x00\xae\x6f\xbd\xce\x12\x26\x66\xea\x7d\xec\x62\xbf\x3c\x6c\xbc\x24\xae\x1f\xce\xaf\x3c\x59\x4c\xd6\x1f\x2d\x26\x9b\xce\x16\x51\xd6\x55\x10\x8d\xa1\x1e\x89\x34\x18\xd5\x9e\x4d\x8a\x84\xab\x35\x62\x20\xfb\x87\x28\xfa\x56\x5d\xf4\xaa\xa6\x95\x17\x67\x55\x0f\xdd\xc8\x0f\x34\xac\xa5\x92\x07\x55\x3e\x36\xd5\x66\x72\x83\xa1\x05\xbe\x9d\x33\xc0\x47\x45\xf5\xf8\x1a\x38\xc8\x9b\x8a\x3f\x3b\xec\xcf\x1a\xc6\x99\x08\xb7\x2d\xbb\x5e\x90\x01\xec\xc0\xfb\x0f\xd4\xf9\x13\x9d\xe9\x27\x9b\xea\xd2\x7a\xe9\xb9\x87\x6a\x33\xd9\xb2\xdc\xac\x48\x61\xed\x41\x7d\xb2\x74\x52\x9f\x11\xed\x65\xf5\xe5\x3f\x76\x72\xae\xec\x8c\x1f\xb6\xe9\x4b\x91\xe8\xff\xcd\xe4\xe9\x1e\x4b\xa4\xc1\x24\x04\x24\x82\xed\x94\xe1\x33\x8c\x46\x47\xc4\xd8\xd0\x8c\xfa\x35\xf8\xe6\x31\xf2\x6d\x03\x40\xd2\xe0\x7a\x9e\xef\x0a\xbe\xbf\xbd\xa7\xc6\x2b\x14\x8c\x4f\xa9\x01\xda\xa8\xe9\xed\xe1\x95\x43\x3b\xbd\x2d\x62\x7a\x8b\x16\xeb\xb1\x83\xd0\x5f\xaa\xaa\xf5\xad\x04\xf4\x2d\xfd\x07\xa0\xb8\x8e\x02\x36\x7c\x48\x9d\x29\x1f\xcc\x74\xf9\x97\x55\xc0\xba\x15\xd7\xb5\x8e\xe9\xd1\x2a\x4d\x51\x22\x48\xf5\x00\xb0\x46\x17\x8c\x8e\x65\x1b\x43\xbe\xcc\x54\x98\xf9\x9b\x2b\xfd\xd2\x6c\x2c\xf1\xf4\x6b\xf5\x62\x04\x09\x1a\x46\x1e\x77\x3d\xd2\x35\xf5\x23\x42\x33\x52\xca\x64\x34\xc0\xbf\x30\x64\xdb\xf4\x75\xc5\xc8\x2e\x15\x9d\x2c\x17\x0c\x56\x39\x22\x28\x47\xfb\xc8\xd8\x29\x17\xa9\x03\x1e\x60\xf5\x89\x06\xa0\xb1\xb9\x19\x3b\x14\xe2\x56\x99\x02\x35\xa5\x33\xf4\xfc\x40\xba\x71\x99\x97\x73\x65\x93\xa9\x69\xf3\x9d\xcd\xe5\x97\xe3\x0f\x17\x7d\x2b\x77\x8c\x2d\x2a\x3e\x3d\xa5\x56\x69\x9c\xf7\x2f\xca\x68\x5d\xaf\x77\x0e\xa6\xa0\x99\xa1\x6d\x67\x45\x44\x6f\xa9\x88\x78\xa7\x4d\xed\x8e\x4b\x85\x5b\xe3\x85\x01\xae\x2f\x5f\x46\x60\xab\xe1\x8a\x1a\xe6\x90\x42\x42\xe3\x7d\x8f\x09\x8a\x85\xd9\xdf\xb9\xd1\x84\x67\xf3\x07\x33\x5d\x5f\x2f\x1f\x72\xde\x6d\xbe\x0c\xca\x21\x47\xe5\x9c\xb2\x3f\xe5\xde\xca\x63\xca\x2d\xe8\xee\x72\x11\x34\x15\x88\x07\x3e\x04\x01\xfd\x63\x85\xe2\x51\xfd\x3f\x6f\x25\xaf\x26\xb9\x57\xfe\xfa\xb6\x85\xdb\x8e\x5c\x5d\x2f\x65\xc7\x46\x55\x22\xb4\x1d\xb5\xa5\xf7\x6e\x0e\x43\xe4\xa4\xd8\x8d\xeb\x95\xe7\x92\x6f\x66\x0d\xa9\x10\x4e\x79\xab\x8f\xf2\xef\x75\x32\x7a\x31\x6e\x9e\x71\xb8\xd9\xcb\x8f\xfb\x27\xfd\x1d\xbd\x5c\x4f\x61\x8f\x38\x54\xd0\xf5\xf6\xfe\xfe\xb6\xfc\x00\x07\x7b\x66\xef\xfa\x13\x04\x77\xe3\x68\x7b\x31\x00\x00"
+
+func mysqlIndexGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlIndexGoTpl,
+		"mysql.index.go.tpl",
+	)
+}
+
+func mysqlIndexGoTpl() (*asset, error) {
+	bytes, err := mysqlIndexGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x9b\x10\xa4\xd2\xe6\xa8\xef\x2d\xf2\x90\x35\x0e\x96\x2e\xb3\x3b\x27\xdb\x02\x14\x45\x22\x4b\x94\xcd\x40\x26\x6d\x8a\x4e\xed\x19\xfe\xdf\x7b\x47\x5a\xb2\x64\xc9\x49\x90\x14\x58\x0a\xec\x21\x0e\x29\x1e\xbf\xfb\xc1\xef\x8e\xc7\xd5\xea\x08\x0e\xf2\xb1\x54\x1a\xde\x1d\x83\x6f\x46\x22\x9a\x30\x08\xaf\x96\x53\x16\xf6\x68\xe8\x31\xa5\x3c\xf0\xf2\x59\x96\x6b\x1a\x24\x43\xfc\x99\xe1\x9f\x62\x39\xfe\x5e\xf7\x2f\xe4\x08\xff\xcb\xa9\xce\xbd\x00\x8e\xd6\x6b\x77\x45\xa8\xaa\x15\x76\xc0\xd2\xe7\x21\x6f\xcc\xac\x2a\x60\xa9\x8e\x86\x19\xb3\x2a\xe2\x31\x9b\x44\x5b\xfc\xcb\x9d\xf9\x15\x49\xda\x5f\x52\x5d\x81\x41\xed\x75\xa4\x59\x66\x27\xcf\xc0\xba\x93\x5c\x34\x6d\xfa\x88\x5f\x6b\x40\xe5\x87\x07\xad\xda\x01\x2b\xcd\x7a\x0e\x5c\xca\x59\x96\xe4\x04\x14\x9e\xd9\x61\x35\x8c\x67\xdb\xd5\x41\x39\x23\x81\xb7\x6f\x61\xb5\x82\xf0\x53\x36\x57\x51\x66\x8e\x6c\xbd\x06\xc5\xf4\x5c\x89\x1c\xf4\x98\xd1\xea\xd4\x2c\xf2\x7f\x77\x0f\x17\x25\xa3\x3c\x97\x31\x8f\x34\x4b\xe0\x2b\xd7\xe3\x62\x47\x58\x13\xd2\x63\x25\xe7\xa3\x31\xbc\xc1\x95\x4a\x00\xd7\xeb\x37\xa1\x9b\xce\x45\x0c\x3e\x2d\x58\x32\xa1\xf8\xcf\xbb\x00\x41\xd3\x44\xda\x11\xeb\xc5\x34\x52\xd1\x04\xa7\xc9\x10\xae\xfb\xa7\xbf\x76\x80\x88\x04\x61\x18\x5e\xf7\xfb\x53\xcd\xa5\x08\xc0\xff\xfc\xc5\x00\xee\x58\xde\x01\x64\xa6\x54\x08\xed\x3a\x14\xa4\x85\x94\x66\x43\x8e\x6b\xc5\x17\x2e\x90\xb4\xf3\x09\x13\x1a\xfc\xa9\x42\xb3\x6b\x39\x13\x7a\x55\x9b\x82\xbd\xe4\x01\xef\xb2\x7b\xd1\xfd\x70\xe5\x19\xe4\xfb\x48\x91\x66\xab\xdd\x75\x1d\x8c\x3f\x9e\x3b\xcc\xe6\x4c\x2d\x49\x2f\xcd\x12\x16\x67\xdb\x9c\xc1\x88\xdc\x5a\x04\xb8\x85\x5f\x5c\xc7\xb9\x25\xdf\x65\x36\xc5\x63\xe5\x0b\xca\xb9\x7c\xab\x7b\x73\xb2\xde\x66\x9f\x95\x3f\x1b\xf4\xff\x00\x13\xe2\x4a\x22\xd0\x31\x17\x02\x1f\xfb\xe7\xbd\x42\xa0\x7a\x3e\x70\x07\x7d\xb3\x50\xaa\xa3\x55\x4b\xc7\x2d\x8d\xbc\x3b\xaf\xca\xaa\xba\xee\x7f\x7e\xeb\x0e\xba\x35\x08\xe3\xaa\xc5\xa8\x00\x78\x70\xd2\x3b\x05\xda\x89\xb2\x3c\xad\xa4\xa6\x4c\xf5\x29\xcb\x98\x66\x46\x9a\xa0\x49\x52\x85\x14\x2b\x5c\x4b\xcc\x5a\x2c\x45\x52\xee\xb1\x20\x4c\x90\xf0\xad\x8d\xb1\x9a\x8b\x22\xc6\xa6\xe6\xf8\x36\xba\x1d\xb2\x4c\x45\x62\xc4\xe0\x80\x77\x30\x89\x4c\x86\x54\x4c\x2b\xcc\x39\xe0\x86\x33\x25\xac\x71\x48\xdc\xb3\x85\x2e\x88\xeb\x73\x91\xb0\x45\x99\x87\x07\x3c\x20\xb8\x8a\x25\x81\x49\x46\xc4\x42\xd5\x0b\xc3\x05\xac\x81\xa4\xaf\x9d\xa0\x2b\x14\x20\xa2\x1c\x1b\x79\xf2\x36\x19\xa6\x02\xc9\x84\xfe\xc6\xda\xdb\xe6\x40\xa4\x46\x26\x03\x3a\x70\x88\x80\x1d\xf8\x2f\x3c\x73\x10\x88\x8c\xfd\xe9\x18\x04\xcf\x28\xa7\x1c\x5b\x43\x68\x6a\x52\xcd\x75\xd6\x85\xff\xa5\xa7\x9f\x14\x9f\x44\x6a\xf9\x3b\x5b\x52\x38\x6c\x2e\x30\x0d\x6c\xc1\x73\xcd\x44\xcc\x5c\x27\x95\x0a\x6e\x8c\x71\xc5\x65\x83\xc7\x8f\x8e\x58\xc7\x28\x7e\xa4\xaa\xb6\x1c\xde\x98\xfd\x39\x06\x0e\x93\x97\x15\x7a\xad\xa5\x76\x98\xe5\x8c\xec\x18\x31\xc1\x14\x8f\xf3\xe2\x2c\x8c\x9d\x84\xbe\x90\x7f\x12\x55\x4e\xb2\xec\x73\xcb\xc1\x7c\x69\xc4\xfd\x75\x47\xdc\xb8\x4b\x2e\xce\x4a\x07\x93\xe1\x96\x4f\xc6\xd5\x06\x9d\x5e\xaf\x4b\x4e\xc2\x52\xa6\x60\x16\x7e\xc8\x64\xce\xfc\xc0\xf2\x26\x93\x51\x42\x7c\x98\x67\x3a\x7f\x42\x62\x11\xaf\x66\x61\x0f\xed\xf3\x83\x26\x85\x68\x6f\xdb\x46\x23\xf7\x10\x85\x1d\xc7\xd9\xb0\xef\x9d\x21\x5f\xc7\x22\x93\x83\x47\x66\x99\x58\x6e\x68\x1e\x47\x02\x47\x36\xbf\x67\x78\xcd\x47\x82\xc2\x6f\x82\xd3\x5e\xd1\x37\x17\x90\x77\xe8\x15\x86\x06\x36\x68\x2d\x51\x6b\x86\xcd\x2a\xa6\xb0\x1c\x43\x34\x9d\xa2\x41\xbe\x21\xfc\x61\xcd\xef\xc0\xc4\x77\x83\x47\x76\x75\x95\xf2\x83\xf7\x4f\xe5\x99\xcd\x30\xb7\x58\x37\x0a\x50\xc8\xc5\x6f\xe8\xf2\x49\x92\x50\x4c\x1b\x8d\x43\xd9\x65\x34\x7a\x8b\xfd\x0d\xc5\x70\x09\x78\x37\x33\xa5\xb9\x18\x41\x04\x4a\x7e\xc5\xb9\x96\x2f\x68\x30\xea\xd6\xed\xeb\x2e\xea\x2c\x69\x6f\x2b\x9a\x1d\x88\xb9\xea\x5f\xd0\x67\xa0\x6d\x9e\x35\x2d\xd8\xdf\x09\x82\x77\xde\xbb\xec\x0e\x1e\xee\x32\x6c\xd0\x1e\x6b\x36\x2c\x10\x9c\xf7\xae\xfa\xad\x4d\x81\x5f\x6b\x43\x36\x74\xad\x15\x85\x0e\x34\xd6\xb6\xfd\x01\xde\xc9\x76\x7f\x00\x7f\x9f\x5c\xfc\xd5\xbd\xdc\x01\xbc\x8f\xb2\xbc\xe2\x67\x63\xd7\xf7\xb8\xd1\x9f\x58\x9c\xea\x15\xad\x15\xb5\xea\xd7\xe3\xb5\x50\xd5\xf5\x6d\x1b\xf3\xd6\x7a\x78\xd3\xd9\x64\x62\xb5\x5e\x77\x17\x2c\x7e\x51\xb9\xfe\x31\x7c\xdf\x14\x11\xaa\x2f\xb6\x7e\x0c\xd8\x44\xde\xb3\x6a\x09\x49\xf8\xe3\x35\x24\x55\x72\xb2\xb7\x86\x98\xd6\x91\x4a\x08\xc7\x94\xa5\x22\x62\xa4\x9f\x5f\x44\x1a\x26\xbe\xae\x3a\x62\xcd\x7b\x4a\x29\x39\xc5\xe7\xc6\x55\xf7\x25\x0f\x16\x8b\x00\xd5\x97\x47\xad\x86\xb4\xbc\x0e\x4c\xb1\x68\x3e\x0e\xd2\x88\xfa\x97\xed\xf3\xc0\x0c\x76\x77\x4c\xf0\xde\xe7\xbb\xa4\xac\xef\xf4\x33\x26\xaa\xc0\x81\xb9\xcf\xbe\xcf\x03\xe1\xc7\x48\xa9\xff\xcb\xc9\xa6\x9c\x7c\x03\x2f\x5b\x7c\x0b\x13\x13\x00\x00"
+
+func mysqlManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlManytomanyGoTpl,
+		"mysql.manytomany.go.tpl",
+	)
+}
+
+func mysqlManytomanyGoTpl() (*asset, error) {
+	bytes, err := mysqlManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlMapGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x61\x6f\x1a\x39\x10\xfd\x0c\xbf\x62\x6e\x75\x4a\xd8\x1e\xd9\xf6\x73\x24\x4e\x6a\x13\x7a\xd7\xbb\x36\xb4\x25\xd2\x45\x42\xa8\x18\xd6\x80\xd5\x5d\x1b\x6c\x73\x81\x43\xfb\xdf\x3b\x33\x5e\xc0\x01\x54\x45\xd5\x55\x0a\xcb\xda\xf3\x3c\xf3\x66\xe6\x79\xc8\x76\x7b\x05\xbf\xaa\xdc\xc1\x75\x07\x5a\xa5\x58\x2c\x84\x15\x25\x64\x30\x15\x85\x93\x29\x5c\x55\x55\x73\x1b\x20\xc7\x08\x6f\x57\x31\xc0\xcd\x8d\xf5\x8c\xe1\x37\x2d\x4a\x09\xd9\xfd\x66\x21\xb3\x3b\x7a\x4d\xa4\xb5\x09\x24\x6e\x59\x38\x4f\x2f\xf9\x18\x1f\x4b\xfc\x58\xe9\xf0\xf9\xd0\x7b\x6f\x66\x49\x60\x92\x28\x8d\x3b\xc2\xce\xc8\x40\xaf\x8a\x0d\x90\x98\x85\x77\x49\x14\xd2\x8b\x71\x21\x43\xc8\xc9\x5c\x96\x02\xb2\x7e\xfd\xcd\x71\xef\xc9\x1c\x9e\x44\x21\xe6\xba\x2c\xa2\xb3\xbb\xc5\x33\x4e\xbf\x7c\x09\xdb\x2d\x64\x1f\xc4\xe2\xed\x4a\x4f\x38\xb1\xaa\x02\x2b\xbd\x55\xf2\x5f\xe9\xc0\xcf\x25\x58\xf3\xe8\x60\x6a\x4d\x09\x97\x88\xad\x49\x56\xd5\x25\x3c\xce\x8d\x93\xfb\xf3\x4a\x16\x79\xb6\xf3\xa0\x1c\x28\x5d\x7b\xe7\x1a\x54\x55\x1b\xbe\xca\x8d\xcc\x61\xbc\x39\x7b\x24\x8b\xa0\xf0\xa8\xfc\xdc\xac\x3c\x08\x0a\x0e\xc2\x4a\x28\x95\x73\x4a\xcf\x02\x0f\x62\x85\x8d\xcb\x30\x00\xc5\xf8\x43\x6a\x69\x85\x47\xdf\x6c\x55\x3a\x97\x6b\xe6\x9a\xbd\xa3\xd7\xf0\xac\xc3\x5c\x66\xcd\x29\x66\x7a\x26\xeb\x16\x6e\x4d\xfc\x3a\xa8\xa1\xaa\xf2\x31\x3c\xf4\x6e\xdf\xb4\x63\x5a\x83\x21\x2e\xb0\x38\x58\xce\x28\x01\xaa\x2e\xe7\x47\xed\x84\x2c\xcb\x1e\x7a\xbd\x85\x57\x46\xa7\x2c\xaf\xc1\x77\xce\x0c\x5f\x10\x8f\x83\xa8\xc8\x0b\xea\xca\xd8\x14\xb6\xcd\x06\xb5\x76\x6d\x0c\xfb\xa2\xf8\xbb\x1d\xa5\x51\x72\xab\x52\x6a\xff\x34\x87\xb3\x7d\x86\xa4\xdf\x7d\xdf\xbd\xb9\x4f\xd8\x01\x8a\x93\x34\x52\x8a\xaf\xf2\x47\xb8\x15\x52\xb7\x0e\xf5\x48\xd3\x66\xb3\x81\xf5\x5f\xae\xa4\xdd\x80\xf0\x50\x1a\xe7\xb1\x6a\x6f\x84\x9f\xcc\xfb\xea\x3f\x19\xd7\x4e\x50\x37\xbd\x2a\x65\xb3\x31\x35\xf6\xd8\x13\xfc\x0e\xaf\x28\xe5\x86\x26\x7a\x47\x46\xdc\x56\x53\xd0\x88\x79\xe2\x1b\xb7\x11\xde\x89\x37\x71\xab\x42\x4e\x44\x6a\xbc\x52\x45\x0e\x8b\x42\x4c\xe4\xdc\x14\xb9\xb4\x0e\x84\xce\x81\x6e\x21\xf9\xd3\xfb\x32\x0c\x86\x58\x4d\x94\x56\x1b\x34\x45\x22\x40\x64\x53\xda\x4b\x3b\x45\x27\xdb\xaa\x06\x10\x7b\xb5\x93\x05\x65\x86\x60\x2b\xf4\x2c\xce\x76\x70\xad\x87\x81\xa0\xd2\x03\x35\x44\x92\x68\xd3\x7e\xce\xe2\x9a\x19\x9e\x00\xd4\x8e\x10\x6e\x8f\xc0\x9b\x8b\x6b\xf6\xbb\x6f\x47\xc0\xd1\x27\x2a\x66\x27\x8e\xa5\xaf\x87\x75\xce\x78\x3e\x34\x03\x97\x61\x30\x11\xb9\x51\x10\x00\x8c\xe0\x37\x8a\x38\x22\x9d\x9b\x82\xe6\x99\xab\xfb\xcb\x81\xd8\xf1\x0e\xf3\xf6\x73\xef\x03\xc7\xd8\x0f\x93\xc8\xf8\xcf\x9f\xdd\xcf\x5d\x38\xb8\x89\xb4\x73\x63\x0a\x42\xbe\xbb\x83\x16\xa2\x21\x54\xd6\x65\x7f\xa1\x66\x5b\x4a\xb7\x21\xc1\xbf\x14\x0d\xa3\x14\x8f\x63\x53\x43\xfc\xbe\x99\xfa\x5b\x59\x48\x2f\x77\x29\xc3\xeb\xbb\x5b\xae\x08\x5a\x72\xb6\x4c\x0c\x76\x6f\xa7\x4d\xb4\x48\x4d\xb8\x51\x9d\xb9\x5d\xe9\x7d\xe6\x3c\x7a\x5b\x21\xff\x36\x37\x1c\xef\x64\xca\x83\x12\x23\xe2\xfe\x3a\xd4\x94\xe7\x1a\xd6\x67\x70\xaa\xf4\x2d\xd9\xf1\x22\x92\x99\x0e\x64\x08\xc8\xc7\x53\x8d\x97\x09\xc9\x4c\x7c\x72\x98\x17\xd4\x30\x9a\x16\x6d\xb8\x20\x87\x6d\x38\x09\xcc\xea\x25\x67\xbf\x74\x40\xab\x22\xe8\x02\x6f\xdd\xca\x6a\x5a\xf3\x8d\xaf\x5b\x4c\xda\xfa\x12\xb4\x15\x7e\x7e\x62\x79\x31\xdf\x2d\x0b\xe1\xea\x50\xbb\x8f\x56\x95\xc2\x6e\xfe\x96\x9b\x5a\x51\xf1\xe1\xec\x8b\x5c\x2b\x87\x53\xa9\xc3\xbf\x6e\xf5\xd9\x50\xba\xc0\xc2\x0d\x9e\xe0\xcf\x4d\xe6\x5a\x9c\x7b\x10\x73\x65\x3f\xf8\x8b\x4a\x44\x66\x34\x7f\xd5\xc4\x1d\xca\xca\x39\x11\xf3\xb5\xf9\x44\x5d\x79\x5d\x14\x83\xe3\x1a\x0f\x4f\x0a\xf8\xf3\x2b\xf7\x7f\x24\xcc\xeb\xe5\x3e\xc3\x7c\x7c\x10\x07\xe7\x7a\xa2\x8d\x1f\x4f\x2b\x68\xbb\x30\x22\xc7\x29\xed\x56\x85\x77\x75\xa6\xcb\xec\x4e\xae\x7d\x2b\x0d\x47\x8f\x93\x3e\x51\x73\x00\x7d\x4f\x34\x8d\x5a\x28\xd7\xac\x93\x76\xed\x95\x84\x72\x15\x00\x4c\x86\x67\xcc\x44\x68\x7a\x25\xfe\x1d\x24\xd2\xc7\x35\xa5\x3b\xa5\x0a\x9e\x19\x2a\xad\x05\x8e\x00\x0f\xc9\x45\x52\x93\x4c\xeb\x91\x7e\xae\x08\x8d\x65\x76\x53\xe0\x3f\x15\x2d\x06\x9c\xa9\x49\xcd\xe3\xd9\x6d\xbc\x38\xe9\xe3\x81\x79\xd7\x5a\x8e\x13\xc7\x7c\x66\x63\xe2\x5b\x44\x8c\x6a\x0c\xd2\x6a\x13\xb0\x59\x35\xbf\x01\x9b\xa2\xf7\x09\x87\x0a\x00\x00"
+
+func mysqlMapGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlMapGoTpl,
+		"mysql.map.go.tpl",
+	)
+}
+
+func mysqlMapGoTpl() (*asset, error) {
+	bytes, err := mysqlMapGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.map.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlMockGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xcd\x8f\x9b\x46\x14\x3f\xc3\x5f\xf1\x8a\x72\x80\x8a\x90\xfb\x4a\x3e\xb4\x49\x23\xad\x94\xed\x1e\x92\x55\x23\xad\x56\xd5\x04\xc6\x36\x2a\x30\x74\x18\x76\xbd\x75\xf8\xdf\xfb\x66\xde\x80\xc1\x06\x6c\x6f\xd6\xea\xa5\x17\x83\x99\xf7\xf1\x9b\xdf\xfb\x98\x37\xdb\xed\x5b\x78\x53\xad\x85\x54\x70\xb5\x00\xdf\xbc\x15\x2c\xe7\x10\xfd\xae\x7f\x3d\x2e\xa5\x07\x5e\xf2\x0d\x7f\x44\xa9\x2a\x7c\xa8\x8d\x17\xc0\xdb\xa6\x71\xb7\x5a\x35\x1f\xd3\xf5\x4b\x99\x16\xaa\x35\xf1\x59\x09\xc9\x6f\x44\xfc\x17\xea\x8d\xdb\x03\x2f\xe7\x6a\x2d\x12\x7c\x61\x72\xa5\x3f\xc6\x2c\xcb\xcc\xd3\x6b\xd1\x45\x1f\x53\x9e\x25\x55\xcf\x75\x5d\x26\x4c\x71\xed\xba\x40\x97\x4b\xbd\xac\xbd\x57\x79\x9d\xa9\xb4\x95\x6f\xd5\x7d\x92\x4e\x57\x05\x82\x81\x28\x40\x28\x9e\x31\xf5\xee\x1d\x6c\xb7\x16\x6a\xd3\x74\x58\x21\xad\x80\x41\xae\xdf\xf6\x97\x41\xf2\x58\xc8\x24\x2d\x56\x90\xaa\x0a\x0c\xd4\x08\xed\x68\x53\xbf\xb1\x78\x0d\xb4\x19\x5a\x00\xb5\xe6\x90\x33\x15\xaf\xb5\xfc\xc7\xba\x88\xc1\x20\x85\xa7\x35\x2f\xa0\xe2\x2a\x04\x56\x24\x20\x50\x4c\x3e\xa5\x95\x36\xae\x6a\x59\x54\xda\xd8\x3f\x5c\x0a\x78\x64\x59\xcd\xd1\xbe\x7a\x2e\xf9\x38\xd2\x4a\xc9\x3a\x56\xb0\x75\x9d\xeb\xa2\xe2\x52\x91\x13\xfc\xf1\x51\x3c\x56\x9b\x92\x49\x96\xa3\x06\xfe\xb3\x64\x34\x0d\xfc\xdc\x33\x15\x82\x0e\x05\x44\x51\xf4\xf5\xf6\xb6\x54\xa9\x28\x02\xc0\x38\x09\x69\x78\x4e\x97\x1d\xd5\x48\x97\x73\x67\x5e\x5f\xd1\x87\xf3\x99\x3d\x92\x3d\x78\x45\xd4\x1c\x49\xd5\x70\x3f\xf0\x8c\xbf\x2a\x5c\x6d\x5c\xb2\x62\x85\x49\x74\x5d\x24\x7c\xc3\x2b\xb2\x83\x34\x45\xda\x8d\x35\xd0\x52\x47\x42\xd1\x75\x75\x57\xa4\x7f\xd7\x44\x21\x4a\x4b\x5e\x0a\x9b\x26\x11\x7e\x9b\xc1\xb7\x12\xe6\x4f\x96\x56\x5d\x0d\xc0\x92\x65\x98\x29\x18\xf6\x29\xa8\xbe\xd9\xcb\x17\x4c\x99\xdd\x86\x0c\xfc\x80\xc8\xd1\xea\x97\x45\xf2\x09\xa5\x3a\x34\xf7\x0f\x47\xf0\x50\xb0\x76\xaf\x28\x6d\xbf\xb9\x4e\x5e\x63\x62\x40\xf5\x5c\xc4\xd1\x4d\xad\xf8\xc6\x75\xa8\xb0\xee\x1f\xc6\xaa\xe1\x3d\xae\xb9\xa8\x36\x51\xd6\x7a\x99\x4a\x5b\x1b\xb1\x95\xcc\x13\xf8\xf6\x3c\x2a\x3e\x53\x76\xc6\xd2\xae\xf4\xd0\xdf\x0d\xb1\x98\x52\xcd\x9b\x46\x28\x96\xe6\x5d\xfb\x42\x27\x44\x73\xe4\x3a\x56\x12\xb5\xb1\x29\xb8\x46\xf9\x17\xec\x7b\xc0\xb0\xb9\x68\x79\x6c\x82\x75\xce\x0b\xe4\xb2\x67\x00\x19\xdb\xc4\x59\x6d\xfa\x8e\xf9\x26\x0a\x64\x43\xa1\x39\xa3\x7b\xff\x80\x2d\x97\xcb\x25\x8b\xf9\xb6\xb1\x0c\xd0\xf6\xec\xa3\xdb\xb4\x12\x1d\x12\x1d\x68\xd0\x91\x6e\xfb\xf8\x5e\x19\x74\xbb\x0d\xac\x11\x3f\xef\x43\x0f\x35\x52\x13\xf0\x9e\xef\x40\xd3\x31\x30\x19\xe5\x75\xf4\x09\x8d\xf8\x81\xeb\x24\x7c\xc9\x25\x1c\x2c\xdf\x15\x19\x09\xec\xab\x52\xac\x17\xc0\xca\x12\x33\xc2\x1f\x59\x0c\x27\xc3\xb3\x25\x9e\xaf\xec\x76\x43\x43\xf2\x95\xc1\xdc\x04\x96\xa2\xf7\xc6\xbe\x6d\xba\x86\xd7\x2e\x27\x6c\xff\x16\x9d\xba\x90\x30\x48\x1a\x12\xd0\x8d\x5c\x5b\xca\xbb\xf0\xf3\xbc\x54\xcf\x67\x91\x6b\x50\x0c\xb9\x0d\x66\x12\xfc\x07\x19\x26\xdc\x78\x6e\x4e\x7b\xc0\x14\x72\x96\xb8\xdf\x3f\x43\x88\xb5\x24\x75\xbc\xb1\xd0\x20\x14\x07\xfb\x9c\xc5\xbe\x58\xe8\x73\xf5\xfb\x77\xc0\x62\xed\xbe\xd8\x35\x2d\xe9\xec\xc5\xd3\x46\x30\x46\xdc\x0e\xba\xd4\xf5\x4e\xb1\x20\x72\x6d\x90\xfe\x48\xd5\xfa\xcb\xa6\xcb\xe3\xb6\x22\xcc\xc9\xd9\x0f\xdd\xd8\x6e\x42\xa8\x44\xa7\x61\x8e\xd5\x9c\x25\x1c\x9e\xd0\x24\xa8\x8d\x29\xb9\x2e\xa0\x4a\xac\xb8\x3e\x88\xed\x2a\x2a\x99\x73\xb9\x3d\xe2\xcf\x08\x28\x21\xf6\xd1\xc1\xd7\xdb\x0f\xbf\x06\x87\x33\xc4\x41\x04\x6d\x7d\x79\xa4\xe9\x85\x08\x2e\xe8\xc8\x18\x88\x5a\x52\xe8\xb0\x1f\x27\x85\x58\xde\x8d\x03\x67\x61\x27\xb5\x1f\x3e\x29\xa7\xb7\x48\x0e\x3c\x53\xb8\x9d\x51\xb2\x83\x5b\xc6\x64\x1a\xaa\xf5\xa6\x9a\x9f\x70\xd6\x4b\x4d\xfa\x8f\x32\xd3\x13\xb5\xf0\xb1\xd6\xf7\xc0\x93\x1f\x84\x1b\x0c\xd2\x0d\xcd\xba\xcd\xc8\xbc\xa3\x99\xa6\x91\x67\x8e\xe9\xdd\x50\x74\x16\xd3\xa4\x76\x41\xa6\xc9\xc1\xc9\x4c\xf7\x66\xbb\x63\x4c\xef\x44\x5f\xc6\xb4\xe6\x55\x0f\x7e\x73\xac\xb6\x83\xe1\x59\x9c\x6a\xa5\x0b\x32\xaa\xcd\x9f\xcc\x67\x37\xd9\x1e\x63\xb3\x15\x7c\x79\xd6\xb6\xe3\x12\xd2\x4a\x23\xef\x1c\xb1\xbb\xa1\xf8\x2c\x6a\x49\xed\x82\xe4\x92\x83\x93\xe9\xed\xcd\xf6\xc7\x08\xde\x89\xbe\x9c\xe2\x53\xa7\xfe\x37\xf6\xbc\xd3\xc7\xe6\x70\xb8\x6e\xc7\xd2\x56\x02\x19\x9b\x89\xd2\x40\x90\x82\x35\x7d\xa9\x98\x0c\x63\x7f\xe8\xee\xc5\x72\x60\xfc\xe2\xb7\x8e\xe9\x90\x0f\x70\x78\x13\x9e\x8d\x4f\x72\x3f\x97\x0f\x07\x84\x1d\x4d\x8b\x03\x8d\xfd\xec\x98\xe6\x61\x08\x67\x2c\x6d\xc2\x7e\x79\xda\x1b\xd7\x7f\x19\xa6\x53\xaf\x64\xff\x07\x6b\xe6\x3a\x8a\x05\xfc\xc8\x65\xba\x1c\xbf\x2f\x42\x9a\x97\x19\xa7\xab\xdb\xfe\xba\xfb\xc8\x70\x9e\x3e\x9c\x04\x17\xb6\x6e\x0e\x82\xef\x23\xa2\xc0\xfd\x17\x7f\x9e\xed\x58\xa1\x13\x00\x00"
+
+func mysqlMockGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlMockGoTpl,
+		"mysql.mock.go.tpl",
+	)
+}
+
+func mysqlMockGoTpl() (*asset, error) {
+	bytes, err := mysqlMockGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.mock.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlOptionalGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x55\x4d\x6f\x9b\x40\x10\x3d\x9b\x5f\x31\x8d\x5c\x09\x47\x84\xe4\x1c\xc9\x87\x24\x6a\xa3\x1e\x52\x59\x6a\x7a\x8a\xa2\x6a\xc1\x8b\x41\x86\x5d\xba\xbb\x4e\x14\x21\xfe\x7b\x67\x66\xb1\x8d\x63\x43\xda\xde\x76\xe7\x6b\xdf\xcc\x7b\x03\x4d\x73\x01\x45\x06\xf1\x83\x5e\xca\xf2\x51\x2f\x6e\xa1\x6d\x83\x06\x8d\xd3\x7a\xbd\x82\xeb\x39\xdc\xeb\x85\x48\xd7\x62\x25\xbf\x8b\x4a\xf6\xe2\xee\xb4\xca\x8a\x55\xfc\xad\xaa\xb5\x71\x3f\xa4\x79\x29\x52\x09\x17\xdb\x64\x9b\xa3\x95\xd2\xf9\xa0\x38\xf5\xf1\xad\x96\x31\x57\xa1\xb0\xcb\x4b\x68\x9a\xbe\xb1\x6d\xf7\x18\x52\xad\x5e\xa4\x71\x16\x5c\x2e\x8f\xc2\xc0\x69\x28\xd0\x57\x1b\x8d\xa7\x4a\x5a\x8b\xe8\xe2\x20\xdb\xa8\x74\xb8\x64\x88\x9e\x0e\x15\x56\x38\x7f\x1f\x37\x83\x90\x6c\xdc\x74\xdb\xc6\xef\xdd\x11\x48\x63\xb4\x99\x41\x13\x4c\xd0\x57\xed\x80\xc6\x34\xae\x36\x38\xd5\xcd\xe2\xf6\x51\xf3\xf3\x87\xdd\x1c\xa0\xa6\x56\xc4\x51\xe6\x40\x2f\xbb\x82\x21\xd7\x38\x1a\xcb\x58\x03\x5d\x7f\x63\x4d\xed\xf1\x8e\x34\xc5\x01\x96\x5a\xb7\xe3\x24\x59\x6a\x0d\x1d\x85\x39\xec\xd8\x8e\x11\xe5\x0b\x87\x46\xbf\x5a\x78\x7a\x3e\x45\x92\xb7\xfe\x0d\x4d\x46\x5a\xd2\x5f\x25\xd6\xf2\xc3\xac\x52\x2a\x7e\x74\x36\x0b\x26\x99\x36\x50\x44\x80\x57\x4a\x37\x42\x21\x4b\x0c\x08\x6b\x4e\xea\x84\x9f\x20\xcf\xb0\xd2\x30\x1a\xeb\x4c\x70\xab\x28\xf4\xd3\x1c\x54\x51\x72\x36\x62\x72\x1b\xa3\xe8\xce\x65\xd0\xd4\x06\x64\xb5\x4f\xc5\x33\xcc\xa1\x4e\x02\x34\x04\xdb\x30\xb4\x47\x14\x3b\xa8\x2f\xdb\x11\x66\x47\x14\xc6\x3c\x1c\x91\x33\xa8\xb0\x5d\xc9\xb0\x4e\xb6\x1c\x8c\x68\xea\x04\x49\xe3\x1c\x9c\x18\x3c\x3e\xd4\x9b\x7b\x9d\xec\xc7\x4e\x10\x68\x6e\x38\xd0\xc1\xb1\xf7\x96\x22\xf9\xbf\xa9\x63\xf5\xa1\xb1\x37\xfe\xe3\x58\x09\xbb\xce\x0a\x59\x2e\xad\x5f\x8d\x53\x6c\xdc\xd4\x75\xf9\xf6\x95\x82\x1e\x30\x1a\xac\xec\xc8\xe8\xf2\x74\x06\x07\x1f\xa0\x42\x79\xaa\x84\xcb\xd9\x49\x4f\x20\x53\x54\xf9\x30\xeb\x88\x50\x94\x26\xe3\x2c\xd4\x8a\x9d\xa9\x2e\x37\x95\x62\x9a\x37\xf5\x52\x38\x09\xaf\x85\xcb\xa9\xd0\x4f\xbe\xde\x79\x7f\x0c\x37\x65\xd9\xaf\xed\x72\xe1\x20\x15\x0a\x12\xd9\x25\x2e\x41\x18\x49\xc8\xe1\x35\x97\xca\x43\xca\x85\x05\xc5\xb0\x18\xea\x80\x6e\x0e\x9b\xff\xe0\x53\x1b\xc1\xbf\x7f\xbe\x22\x8f\xe6\x9c\xb1\xd3\xb1\x4e\xe2\xdd\x7b\xac\x43\xeb\x0c\x4e\xa4\x2f\x3e\x3f\x5b\x96\x9f\x5d\xc7\xf7\xd2\x2d\xc8\x10\xa2\x48\x90\x52\x16\x1e\xdd\x67\x30\x9f\xc3\x95\x5f\x6e\x4e\x98\xc3\xb6\x58\x03\xc4\xbf\x97\xe2\x14\xa5\x39\xcd\xb6\xd5\x7a\x62\x40\xa4\x58\x6e\x5a\x30\x48\xbc\x48\xb5\xc4\xe3\x19\x9e\xb8\x4b\xfe\xf3\x61\xa2\x37\x91\x13\xff\x7d\xd0\x7a\xc1\x21\x75\xfd\xfd\xd8\xb6\x70\x15\xf5\xe0\x75\x9b\xf1\x2b\x62\x02\x7a\xbb\xc1\x60\x09\xb6\x45\xbe\xd3\xdc\xbb\xe9\x8e\xef\x08\xe2\x63\x37\x2b\xc4\x49\x3f\xdc\xc9\x64\x29\x33\xb1\x29\xdd\xf5\xfb\xa5\xc8\x2a\x17\x7f\xa1\xb9\x65\xe1\x19\x67\xc1\xe7\xdf\x24\x0d\xa5\x5d\x4f\x1d\x67\x1e\xc2\xac\xdb\x1f\x06\x3f\xa7\xa7\xb0\xab\x90\x6e\x3b\x7f\x6f\x99\xbc\x7d\xbf\x4d\x7e\x3c\xfd\xe3\x1f\x3f\x69\xd6\x53\x81\x08\x00\x00"
+
+func mysqlOptionalGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlOptionalGoTpl,
+		"mysql.optional.go.tpl",
+	)
+}
+
+func mysqlOptionalGoTpl() (*asset, error) {
+	bytes, err := mysqlOptionalGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.optional.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x56\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x62\x21\x62\x47\x64\x73\x4f\xc5\xa1\xa5\xf4\x84\x42\x1a\xa2\x2a\x52\x55\x35\xc6\x5e\x13\x4b\x66\x17\x76\xd7\x09\x11\xf2\x7f\xef\xcc\xda\x06\x1b\x50\xbe\xdb\x43\x0f\x21\xde\xdd\xf9\x78\xef\xcd\xec\xd8\xeb\xf5\x29\x74\x84\x34\x3f\x64\x1a\xc3\x79\x1f\x7c\xc1\x81\x5d\x2a\x19\xb1\x2b\x6e\x72\x25\xae\x1f\x17\x1c\xbc\x7b\x3c\xf5\x02\x38\x2d\x0a\x77\x4d\x0e\x0b\x34\xb0\xd6\x3a\xba\xe3\xf3\x10\xd8\xa4\xfa\x6f\x3d\xe9\xe7\x22\x9c\xf3\xad\x43\x9a\x00\x1b\x84\x59\xb6\xd9\x50\xa1\x98\x61\xa2\x2b\xae\xf3\xcc\x4c\xb8\xd1\x80\x07\x67\x67\xb0\x5e\x03\xa3\x94\x8c\xfc\x71\x0f\x52\x0d\x21\x28\xf9\x00\x32\x01\x73\xc7\x41\x59\x0f\xd0\xdc\x58\xdb\xda\xac\x3a\xd5\x46\x2a\x1e\x03\xc1\xe3\x71\xae\x38\x1c\xa3\x51\x89\xb6\x28\x8e\x99\x6b\x88\xcd\x5e\x0e\x6d\x54\x1e\x61\xbc\x26\x32\x6b\xf0\x2d\xe5\x59\x6c\xa1\x39\xcd\x64\xf8\xac\xb8\x0d\x65\xcd\x68\xab\x82\x3e\x90\x19\xfd\xe5\x73\x51\xd9\xda\x90\x5c\xc4\xf4\x58\xb8\xb8\xb2\x8b\xa6\x2c\x07\x25\xa8\xbc\xcb\x33\x0d\x77\x92\x60\xb4\xe9\x6b\xc2\x80\x05\x42\xba\xd3\xc7\xd7\x91\xdf\x89\x7e\x88\x7e\x1b\x55\x8b\xfd\xcf\x5f\x27\xbb\x0a\xee\xb0\x6c\x93\x80\x08\x0b\xaf\x9f\x47\xe8\x93\x0f\xb5\xce\x65\xa8\xc2\x39\xe5\x0d\x8e\x41\x0a\x88\xa7\xbd\x8a\x6a\x2a\x66\x90\x5a\xe2\x1b\x11\x98\x9b\xe4\x22\x6a\xa6\xa3\x30\x91\x59\x2d\x28\x08\x2e\xe3\x29\xdc\x8c\xbf\x7e\xc1\xcd\x99\xb4\x7b\x59\xaa\x0d\xe6\x29\x73\x20\x73\x5e\xfe\x14\x45\x0f\xe4\x02\x83\x33\xc6\x6e\xc6\xe3\x85\x49\xa5\x08\xc0\x3f\xd9\xd7\xab\x07\x5c\x29\xa9\x02\x14\xcc\x21\xde\x2b\x29\xad\x75\xad\x14\xed\xa4\x82\x44\x9d\x73\x61\x2a\x6f\xcf\x03\x6f\xf0\x79\x34\xf2\xac\x11\x0a\xa4\x97\x19\x2c\x73\xae\x1e\x5d\x27\x42\x5f\x43\x1b\xe8\x03\x7d\xb8\x25\x3b\xd8\x11\x26\x92\xd9\x7d\x88\x2a\xb2\xad\x38\xb7\xae\x0d\xa4\x72\x51\x07\xba\x19\x8f\xe4\xcc\x2f\x03\x3d\x45\x38\xc1\x48\x44\x28\x70\x9d\xa5\x65\x43\x57\x39\x9e\x32\xf4\x89\xa7\x89\x00\xef\x3b\xc5\xf3\xb6\x62\x86\x6a\x86\x8b\x57\x05\xc6\xd6\xa6\xc0\x47\x7d\x10\x69\x46\x52\x39\x65\x0d\x69\x69\x73\xba\x0e\x0a\x11\xf3\x84\x2b\x58\xb2\x41\x26\x35\xf7\x03\x64\x84\xb5\x25\x34\xdd\x7d\xdd\xd7\xcd\xc9\xd1\x49\x7b\xd0\xa1\x21\x80\xb6\x3b\xcd\x5a\x5d\xac\x4e\x4a\x0b\x0b\xe4\x68\xc9\x2e\xf8\xca\x6c\xcc\xfc\x60\x0f\x50\x32\x37\x6c\x48\x65\x4d\x7c\xaf\x21\xfd\x39\xcc\x53\xad\xa9\xef\xda\x73\x87\x52\xd7\xf0\xbc\x80\xa8\x34\x6e\x80\x2d\x4b\x26\xc3\xf8\x09\x27\xd7\x49\x24\x11\x27\x5c\x35\x1c\xe2\x52\x9b\x35\x6f\xd7\x9a\x42\xda\x9e\x89\x42\x81\x4f\xa4\x6b\x1f\x7d\x27\xb8\xa4\x02\x25\x34\xa5\x04\xda\xea\x86\x6f\x35\xba\xbc\xae\xf2\xca\x82\x1c\xa8\xc8\x7e\x49\x1c\x9b\x0a\x61\xb3\x1d\xbc\x98\x30\x5c\x2c\x90\xa0\x7f\xe0\xb0\x07\x5d\x65\x45\xa8\x73\x10\x3a\x54\xd3\x0f\x3e\xbd\xa0\x09\x5a\xca\x55\xe7\x98\xa4\x47\x46\x6e\x75\x5c\xb6\xd5\x87\x0f\x96\xbf\x3c\x3d\xec\x9c\xf8\x2f\xc6\xc4\xef\x83\x63\x62\xb8\xe2\xd1\xfb\xa6\x44\x55\x6e\xea\x84\x56\x23\x6c\x8a\x8e\x0d\x75\xf0\x83\xc4\xa8\x74\x36\xe3\xd8\xdb\xa7\x1f\xd9\x16\x50\x6f\x35\x73\xe1\xbb\xf3\x9f\x74\x0b\x05\xa3\xb9\x55\x7f\x8c\xe1\xc5\xdf\x7e\x67\x94\x78\xea\xcf\x8d\x1e\x54\x1f\x12\x45\xf1\xc6\x77\xd1\x64\x38\x1a\x0e\xae\xcb\x36\xbb\x0f\x95\xad\xae\x0d\xe5\x3e\xd7\x76\xa5\xe7\x5b\x1a\xaf\x1e\xcc\x5b\x82\x65\x6e\x55\x8e\xc7\xc3\x54\xdf\xd4\xae\xe5\x0c\xda\x7b\xa3\x5d\xc9\x87\xf7\xb4\x6b\x39\x70\xbb\x88\xf3\xa9\xf7\x9b\x65\x82\x7b\x2d\x32\x17\x69\xb6\x29\x5d\x35\xf6\x1a\xb3\xce\x94\xb3\xae\x39\xe9\x5a\xac\xb7\x17\xf0\xc5\xf7\xaf\x7d\xb7\x5a\x9f\x67\x8d\xc5\x1f\x33\x57\xff\xb8\xfd\x0b\x00\x00"
+
+func mysqlProcGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlProcGoTpl,
+		"mysql.proc.go.tpl",
+	)
+}
+
+func mysqlProcGoTpl() (*asset, error) {
+	bytes, err := mysqlProcGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlPrototestGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x41\x4f\x83\x40\x10\x85\xcf\xf0\x2b\xa6\xc4\x26\xb4\x52\x7a\x27\xe9\xa5\xb1\xde\x34\x8d\xe1\x66\x3c\x2c\x30\x50\xe2\x76\x77\xdd\x5d\x30\x86\xf0\xdf\x9d\xdd\x62\xb5\x89\x36\x26\x1c\x36\xbc\xf7\xbd\x79\x33\xc3\xb0\x82\x1b\x0b\xd9\x06\xd2\xfc\x43\x21\xac\xc6\x31\x5c\xaf\x21\x47\x63\x87\x81\x94\xf4\x91\x1d\x11\xc6\x71\xbf\x7d\x92\x9d\xa8\x72\xdd\x2a\xb0\x24\x1a\x28\xa5\xe8\x51\xdb\x56\x34\x70\xe1\x34\x60\x25\xd8\x03\xb6\x1a\x94\x96\x56\xba\xb8\x23\x1a\xc3\x1a\x34\xc0\x44\x05\x05\x2b\x5f\x13\x78\x6f\xed\x01\x18\xe7\x93\xb5\x6e\x91\x57\x06\x0c\xda\xc4\x9b\xbc\x7c\x92\x44\xc7\x39\x2b\x38\xba\xa0\xc9\xe6\x7e\xa5\x61\xdd\x89\xf2\x6a\xd3\xd8\xc2\xd2\x95\xa5\x8e\x69\xbe\x80\x21\x0c\x4e\xd5\x69\xdb\x23\x53\xcf\xc6\x6a\x52\x5e\x96\x17\x38\x99\x82\xa8\x67\xbc\x43\x13\x65\x0e\x09\x02\xd2\x55\xe1\xc8\x69\x7a\x0a\x35\xe3\xc6\x99\x49\x1d\x13\x07\xb8\x42\x57\xfc\x56\x77\x3f\xec\x63\x18\x06\xb5\xa4\xc5\x68\x22\x1d\x82\x09\x7f\x7f\xcd\x44\x83\xd3\x6d\x5d\x8c\x2a\x12\x40\xad\x9d\x74\x51\xf0\x41\x56\xc8\x73\xb9\xdf\xc6\x8e\x5c\x90\xb3\xad\xbd\x71\xb6\x01\xd1\xf2\x53\x05\x9b\xde\x33\xcb\x78\x1d\x47\x73\x93\xc1\x19\xc9\x60\xde\x47\xc9\x34\x98\x18\x47\xbb\x36\x41\x23\xed\xef\xd3\xf6\xdb\x5c\x7a\x3c\x56\xc5\xbf\x66\x9d\x81\x3f\x67\x51\xc4\x4c\x63\xcd\xb1\xb4\xe9\x1d\xa2\xda\xbd\x75\x8c\xc7\xbe\x81\xdf\xe8\x2b\x75\xa7\xb5\xd4\x53\x2a\xa9\x30\xbf\xed\xa7\x6b\xd1\xeb\x9c\xfc\xcd\xf9\x7c\xfa\xc6\xf0\x13\xe9\x4d\x8e\xd5\xd5\x02\x00\x00"
+
+func mysqlPrototestGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlPrototestGoTpl,
+		"mysql.prototest.go.tpl",
+	)
+}
+
+func mysqlPrototestGoTpl() (*asset, error) {
+	bytes, err := mysqlPrototestGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.prototest.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x6f\xd3\x48\x14\x7e\x4e\x7e\xc5\xac\x15\x21\x1b\x8c\xe1\x61\xb5\x0f\x95\xfa\xc0\x42\x57\x42\xcb\x52\x58\x78\x40\xea\x56\xe0\xd8\x93\xc4\xc2\x99\x49\xc6\x0e\x24\x8a\xf2\xdf\x39\x97\xb1\x3d\x76\x9c\x6e\x55\x68\x97\x6a\xf3\xd0\xd6\x99\xcb\xb9\x9f\xf3\x9d\xe3\x74\xbb\x7d\x2c\x46\xc5\x4c\x9b\x52\x9c\x9c\x0a\x9f\x9e\x54\x3c\x97\x22\x7a\xbf\x59\xc8\xe8\x35\x3e\x7a\xd2\x18\x4f\x78\xc5\x32\x2f\x4a\x7c\x48\xc7\xf0\x6b\x09\x3f\x46\x16\xf0\xfb\xc3\xf9\x2b\x3d\x85\xbf\x13\x05\xbf\x62\x33\x85\xb5\xe8\xed\x4a\x9a\xcd\x9b\xd8\xc4\xf3\x22\x10\x8f\x77\xbb\xe1\x16\xf9\x2c\x71\xf5\xb9\x9e\xcf\xa5\x2a\x0b\xe4\xc7\xe7\xea\x95\xfa\x20\x52\x21\x79\xe8\x06\x7d\x8a\x1c\x3a\xc0\x97\x76\x17\x26\x53\xa5\xf0\x1e\x7a\x8e\xb4\xce\x31\x95\xe5\x78\xcc\x83\xbf\x5e\xbd\x9a\x4d\x44\xf4\x2e\x89\xf3\xd8\x88\xdd\x6e\xbb\x65\x62\x40\xcb\xc8\x12\x48\x08\x3f\x53\xa9\x5c\x5b\x7a\x7f\x64\x32\x4f\x0b\xf1\x34\xa0\x8f\x81\xbd\x80\x64\xe9\x02\x3c\x5c\x75\xe7\x75\x96\x3b\xd7\xa4\x4a\x5d\x05\xca\x46\x32\xda\x06\xb1\x62\x38\x11\x9d\xab\x7c\x73\xae\xe4\x9e\x8c\x25\xb0\x24\xce\x7b\xc4\x50\xa1\xb3\xb5\x4c\x5a\x0b\xd6\xa4\xb4\xf6\xe4\x89\x80\x2b\x53\x9d\xd8\xb5\x7a\xd3\x9e\x97\x79\x21\x9d\x83\xec\xf3\xdd\x4e\x98\x95\x2a\x44\x2c\x92\x55\x51\xea\xb9\x28\xca\xb8\x94\x78\x2d\x14\x20\xcd\xca\xa8\x4c\x4d\x45\x39\x93\x42\xad\xe6\x63\x69\x84\x06\x05\x26\x13\x99\x94\x32\x15\x46\x7f\x2d\x22\xa6\x0d\x82\x5a\x36\x5f\xb3\x72\x06\x6a\xbd\x7d\x25\x88\x15\x72\x7b\x0f\xd7\xc9\xc3\x22\x2b\x4e\x78\x6d\x60\x45\x4d\xc1\x04\xb5\x80\x4c\x64\xb2\x52\x89\x2b\xa0\x0f\xcf\x49\xb9\x5e\x60\x94\xc1\xc7\x74\x2c\x3e\x9c\xbf\xf8\x1d\x16\x4d\xac\xa6\xb2\x15\x83\xb5\x8d\x95\x06\xfd\x5f\xc8\x49\xbc\xca\x51\xff\xb0\xa5\x30\x3e\xa3\xc7\x1a\x1b\x3b\x0f\xa1\xd0\x0b\x08\xd1\x28\x8a\x3e\x9c\x9f\x2f\xca\x4c\xab\x00\x1d\x5f\xfe\xf6\x6b\x28\x20\x3f\xb4\x09\xc4\x76\x38\x40\x71\xd7\x5a\xd3\x3e\x72\xad\x56\x32\x05\xa9\xb3\x62\xf3\x73\x4e\x79\xd6\x6b\xd5\x19\xb2\x03\xe9\x92\xb2\x78\x05\x1b\x80\x36\x81\x8f\x34\x0b\x9d\x27\x33\x99\x7c\xc6\x0d\xef\xa9\x47\x9b\x60\x44\x48\x4b\xbe\xdc\x84\xb7\x9c\x72\x3e\xe1\x89\x2f\x10\x44\x9c\xb9\xe0\x42\xc8\x97\x29\x2f\x51\x4e\x5d\x5c\x12\xe1\x49\x9c\xc8\x2d\x9b\x9a\x4d\x37\x2a\xe4\x94\xd2\xd3\xa5\x44\x81\x0b\x91\x4e\x81\xdb\x44\x2d\x9e\x85\x88\xaa\x8c\x45\x27\xe0\xc0\x3f\x25\x0b\x08\x27\x70\xd5\x39\x04\x66\xea\x44\x46\xc3\x34\x02\x77\x35\xdc\x2a\x7f\xbd\xb1\x1e\x46\x5b\x30\x83\xdd\xce\xaa\xf4\xe8\x54\x7c\x42\xb7\x71\x58\x7d\x6a\xe2\x19\xed\x40\xf7\xc0\xca\x8b\x98\x79\xf5\x5e\x5f\x6b\x0e\x11\x3f\x97\xca\x47\xab\x04\xa1\xc0\x47\xa4\xca\x04\x6c\x78\x04\x81\x4b\x60\xa2\x8d\xf8\x18\x8a\x2f\x68\x0d\x96\x7f\xef\x02\xc7\x43\x75\x61\x40\x16\x3f\x15\xf1\x62\x01\xaa\x13\x27\xb8\xde\xa2\xe9\xa4\xe3\x21\x69\x61\x4d\x95\x33\x0a\x93\xa9\x16\x5e\x2d\xb3\xd7\xb9\xd1\xc7\x0c\x76\xab\x72\xda\xd8\x34\xe8\x3a\xc3\x79\xec\x78\x77\x38\xd8\x3b\xe1\x3e\x3a\x62\xa3\xf1\x5f\xda\x90\x85\xaa\x01\xcb\x10\x72\x98\x49\x7c\x26\x81\xdc\x28\xeb\xc4\xaa\xa2\x93\x94\xb3\xa1\x90\x85\x62\x94\x37\x00\xd1\x04\x5b\x86\x17\x1e\xb9\xd9\x09\xab\xb6\xfe\x76\xe0\x65\x94\x61\xe5\x15\x5c\xd4\x0e\x9c\xe8\x64\x7a\xc5\x01\x95\xb0\x25\x16\xa3\x6b\x84\x55\xf7\x53\x7d\xd0\xd5\x9c\x32\x10\x0a\xa5\xcd\xc0\x01\x61\xa1\xcf\x1a\xe1\x4d\xf2\x03\x5a\x79\x00\x30\x43\x85\x02\xb5\x4a\xc7\x11\x6c\xa6\xe3\x89\x12\x1e\x16\x01\xaf\xa9\x66\xe8\x9c\xca\xe1\x6d\x02\x20\x1c\x5e\xff\xe5\x54\x20\x0c\x40\x6c\x0d\xb8\x0e\x8b\xa7\x44\x17\xbd\x33\xac\x96\xd6\xfa\x6f\x28\xc1\xcf\x6c\x3d\x06\xa8\x2a\x82\xe1\xae\x9d\x1c\x7f\xc5\x8b\x5b\x46\x0c\x32\x49\x17\x2d\x12\x9d\xaf\xe6\x70\x0a\xe0\x02\x3f\x82\x64\x54\xea\x32\x85\xb4\xb4\x49\xa5\x09\x09\x08\xdd\xcd\xb8\x10\xf3\x78\x51\x88\xcf\x72\x03\xe0\x32\xde\x58\x22\x02\xfb\x94\xff\x05\xcc\x5c\x5c\x72\xe9\x0e\xa1\x62\x83\x25\x2e\xf8\x93\x5b\xbc\x6f\x8a\x41\xde\xbb\xb3\x57\x67\xcf\xdf\x7b\xe2\x86\x30\x04\xa1\x18\x0a\xdb\xc5\x1c\xd1\xe8\x88\x46\x47\x34\xba\x1f\x68\xb4\xec\xc5\x22\x52\xef\xfb\xc0\xa8\x2a\x08\x35\x26\x0d\xa0\x8e\xc0\x78\xb0\x8c\x9e\xe7\xba\x90\x7e\xe0\x82\x14\xcc\x38\x0a\x70\xa8\xf0\x97\x2d\x78\xba\x63\x58\x72\x60\xc6\x46\xcb\xde\x7c\xc8\x8e\xe1\x78\xa9\xca\x77\x45\xbe\xf6\xc4\xed\x43\x91\xf8\x09\xb0\xc8\x21\x5a\xcd\xaa\xbb\xdd\xc5\xa5\x7b\xdb\x9a\xec\x3f\xc2\x24\x1a\x96\x2b\xac\xc1\x20\x25\x29\x86\x47\x74\x3a\xa2\xd3\x11\x9d\xee\x05\x3a\x59\x7b\x1e\x78\x1f\xc6\x39\x49\x89\x42\xaf\x2d\xb9\x8a\xd9\x9a\x33\x1c\x60\xc6\xf7\xa0\x1a\xcc\x43\xd7\x00\x36\xe4\xa2\xfc\x07\x2e\xf1\xab\xc0\x6e\xbb\xad\xde\xc9\xed\x8f\x60\x2e\x0d\x42\xc4\x76\xbe\x39\xea\xdc\x2e\x16\x1f\x86\x61\xf0\x46\xae\xe3\xb4\xc2\x3f\x1a\x51\x51\x0a\x2a\xe6\x15\xec\xc1\x4d\x4c\xdf\x65\xf4\x5a\xae\x4b\x9f\x6a\xf9\x95\xf6\x87\x6d\xac\xb3\x60\x46\x78\x62\x5f\x2c\xfb\xad\xda\x23\xf7\xbe\xe0\x64\xd1\x01\xbf\xa2\xb5\x09\x4a\x83\x74\x87\x96\x63\x77\xda\xee\x9a\xbb\x15\x4a\x53\xa9\xa4\xc9\x92\x0a\x86\x5c\x37\x59\x3f\xac\x35\x59\x1f\x0e\x5f\x74\xd1\xfe\xb2\xe5\x8e\x74\x1c\x8a\x1b\xbb\xe4\x9a\xa1\xd2\x12\xd7\x7d\x8b\x60\xa5\x7c\x96\xe7\x77\x22\x65\xaf\x61\x9d\x1e\xa0\x3f\x2f\x5b\x62\xfd\x90\xec\xac\x4a\xf3\x84\x5f\xb4\x73\xfb\x72\xad\x74\x75\xd5\xaa\xf0\x9f\xc4\x7b\x63\xb2\x79\x6c\x36\x7f\xca\x4d\x5d\xa7\x0a\x68\x22\xe4\x3a\x2b\x4a\xa9\x12\xd9\x0e\x93\xe8\x23\x6d\x60\x48\x42\xef\x22\xdb\x25\xce\xb2\x7a\x70\x85\x4f\x7f\xce\x9c\x7f\xb8\xd7\xd7\xf6\xa5\x7e\xcb\xbd\x27\xa7\x7b\x1e\xe6\x33\x07\x4d\x0b\x29\x6e\x8d\x77\x42\xb6\x0b\x99\x64\xf5\x75\x86\x4d\xf7\x03\x05\xe4\x5f\x1d\xff\x5d\x15\xa5\x5b\x9e\x0e\x46\x3e\x7b\xba\xdd\x75\x39\x59\xd0\x19\x3e\xce\xe2\x64\xc6\x03\x08\xbd\xfd\x6a\x8d\x20\x00\x01\x39\x0e\x20\xe0\x7a\x1a\x15\x24\x9d\x25\xcf\xe0\x30\x12\x5b\x52\x37\x9f\x48\x42\xa2\xab\x57\x25\x39\x1d\x59\x01\x47\xe7\x9d\x5b\xa9\xc5\x5c\xce\xb5\xd9\x44\xe2\x25\x74\x20\x31\x76\xe7\xd0\xf9\xea\x05\x30\x2f\x51\x60\x94\x60\x92\x99\xa2\xe4\x26\xda\x0e\x4d\xfc\x12\x6e\xa2\x80\xfc\x2c\x03\x91\xb3\xa2\xde\x88\xf6\x66\x16\x34\xc0\x5d\x8f\x2d\x60\x50\x14\xc3\x6f\x8c\x15\xb0\x02\x7d\x13\x0d\x6b\x76\xbd\x19\xc5\x7e\xe3\x68\x47\x15\x54\xcd\x0b\x7e\xc4\x6b\xb4\xe3\xeb\xb3\xe3\x80\x72\x1c\x50\xee\xe3\x80\x52\x77\x67\x3e\x45\x37\xd7\xe9\xc0\xf6\x6a\xf6\x35\x17\xa9\xed\xd4\xc1\xa6\x2b\xc3\x1a\x7a\x80\xfe\xed\xb7\x0a\x57\x76\x09\x0b\xa3\x13\x59\x14\x4d\xa3\xd0\x6d\x05\xf6\xfe\x97\xe2\x2e\x06\x03\x07\xc1\x99\xc4\x84\x72\xdb\xbd\xdd\xb2\xdd\xbd\xed\x57\xfa\x14\xed\xda\xc9\x89\xdc\xeb\x90\x72\x3b\x9a\x65\x74\x66\x8c\x1f\xec\x37\x34\xfd\xe5\xa0\x17\xa6\x6b\x84\x6a\x40\xba\x6e\x7e\x18\x5b\xa9\x1e\xc8\x92\x5b\x1f\x17\xbe\xb9\x11\xd0\x8c\x26\x4e\xd7\x62\x31\xc2\x87\x50\x1b\xd5\x3d\xd5\x88\xff\x4f\x62\x84\xdf\x58\x52\x11\xc0\xb4\x73\x2f\x62\x66\x39\xe0\x8f\x8c\x1a\x91\xb0\x53\xb1\x10\xec\xf4\x26\xb5\x7c\xfe\x81\xae\x22\x10\x55\x83\x80\xb6\xac\x33\x99\x94\xf7\x3d\x97\x7b\xe4\x50\xf0\x5a\x5d\x4a\x70\xd0\xa6\xdf\x00\x4e\x85\x3a\x23\x75\x25\x00\x00"
+
+func mysqlQueryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlQueryGoTpl,
+		"mysql.query.go.tpl",
+	)
+}
+
+func mysqlQueryGoTpl() (*asset, error) {
+	bytes, err := mysqlQueryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x91\xcd\x6e\xc2\x30\x0c\xc7\xcf\xed\x53\x78\xd5\x24\xe8\x24\xc2\x7d\x12\x27\xc4\x8e\x3b\x0c\x1e\x60\xa1\xb8\x1f\x52\x93\x30\x27\x15\xa0\xaa\xef\x3e\xbb\xcd\xa0\x68\x87\x3a\xae\xfd\xf3\x3f\xb6\xd3\xf7\x2b\x78\xf5\xb5\xa3\x00\xef\x1b\x58\x8e\x9e\xd5\x06\x41\x7d\x8a\xcd\x90\x28\x83\x8c\xdc\x25\xcb\x61\x35\x0c\x69\x2f\x7c\xd0\xc7\x16\x27\xbe\xa8\xd1\x68\x50\xfb\x78\x1e\x24\x33\x59\xa9\x7f\xd4\x34\x25\xa8\xad\x33\x06\x6d\x18\x63\xeb\x35\xf4\x3d\x54\xae\x88\xb1\x7b\x32\xf2\xd8\x7a\x9c\x81\x53\x37\xc3\x00\x84\x67\x42\xcf\xa0\x07\x0d\xdc\x16\x94\xe4\x0c\x2c\x18\x89\x5d\x0d\xc3\x42\x4d\x0a\xf6\x24\x62\xe1\x76\xc6\x27\x05\x1f\xa8\x2b\x02\xf4\x23\x44\xda\x56\x3c\xeb\xce\x1c\xf1\xe4\x05\x4f\x66\x28\xbb\x97\x26\xd4\x80\x92\x0d\xba\xf2\xa0\x44\xe0\x5b\x10\x76\xe4\x8c\x97\xcc\xee\x9b\xa9\x7e\x34\xd8\xfe\x57\x95\x66\x08\xc7\xb6\xd4\x41\x2c\x87\xe2\x90\x5b\xd7\xca\xd7\x19\x1b\xd9\xb9\xf0\x7d\x8f\x15\x5a\xa4\xa6\x18\x85\x65\x3d\x57\xb7\x2f\xb4\x05\xcf\xc6\x8f\x2b\x69\x6c\x70\x10\xea\xa7\xb1\x55\x5a\x76\xb6\x80\xa5\x2c\x6a\x7a\x6e\xbe\xf6\x6d\x06\xe4\x51\x67\x29\x0a\x57\xf7\xe5\x2e\x39\xf0\xe3\x3b\xe2\x4d\x25\xec\xc8\x73\x73\x4a\x8d\x0c\xd7\xfd\x74\x48\xb7\x72\x9a\x50\x3d\x34\xf3\x34\xe1\x16\x85\x7f\xd9\x80\x6d\x5a\xa9\x4e\x78\xdc\x8e\xac\x44\xd3\x84\x7b\xfe\xfb\xe7\x74\xfa\x34\xe2\x2f\x01\x76\xbc\x6d\x8d\x02\x00\x00"
+
+func mysqlQuerytypeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlQuerytypeGoTpl,
+		"mysql.querytype.go.tpl",
+	)
+}
+
+func mysqlQuerytypeGoTpl() (*asset, error) {
+	bytes, err := mysqlQuerytypeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlRepositoryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\xdf\x6b\xdb\x30\x10\x7e\x8e\xff\x8a\x23\x8c\x61\x97\xd6\x7d\x2f\xec\x25\x84\x42\x61\xb4\xb0\xb6\xac\x30\xc6\x50\x6c\x39\x11\xd8\xb2\x27\xc9\xa9\x8b\xc9\xff\xbe\x3b\xcb\x4e\x94\x38\x6e\x33\xea\xad\x2f\xfe\x21\xe9\x3e\x7d\xf7\x9d\xee\x4e\x75\x7d\x01\x9f\xf4\x2a\x57\x06\xae\xbe\x80\xdf\x7c\x49\x96\x71\x08\x6f\xe9\x39\xe5\x4a\x4d\x61\x1a\x2f\xf0\x91\x17\x46\xe3\xcb\x54\xd3\x00\x2e\x36\x1b\xaf\x26\x53\x75\xcc\xd6\x2f\x94\x90\xa6\x83\xf8\xc6\x8b\x5c\x0b\x93\xab\x17\x34\x3c\x0e\xd8\x51\x08\xaf\x05\x4f\x63\xed\xe0\x97\x45\xcc\x0c\x27\x7c\x89\xb8\x09\x4d\xd3\x16\x3a\x2b\x53\x23\xba\xf5\x9d\xb9\x6f\x57\x8b\xa5\xcc\x15\x7a\x10\xe0\x76\xd3\x06\xea\xf2\x12\xea\xba\xe5\xb3\xd9\xdc\x1b\x9a\x16\x1a\xcc\x0a\x5f\xd2\x70\x95\xb0\x88\x43\x92\x2b\xd0\x38\x25\xe4\x12\x98\x8c\x41\x71\xa3\x04\x5f\xd3\xaf\x63\x0c\x2a\x7f\xd6\xa1\x67\x5e\x0a\x7e\x04\x73\x0b\x56\x7b\x13\xdc\xf4\xbb\x30\xab\x87\x8a\x90\x4a\x25\x35\xb0\xbe\x45\xa9\x09\xdf\x54\xe7\xa0\xcb\x68\x05\xcc\x92\x32\x8a\x49\xcd\x22\x23\x72\x09\x79\xd2\x40\x3d\xdd\x59\xb0\xd0\x9b\xd8\x0f\xdf\x54\x38\x38\x9f\x05\x3d\x50\xcf\x9b\xdc\x48\xcd\x95\xf1\x71\x26\x32\x55\xc1\x14\xcb\x70\x12\xff\x5a\xa1\xd0\x8d\x33\xc7\xea\x1c\x28\x14\x10\x86\xe1\xd3\xdd\x5d\x41\xbb\x06\x80\x71\xca\x55\x13\x03\x91\x6c\xc3\x80\x52\x4e\x1e\x9b\xcf\xf7\x43\x4f\xee\xd9\x7a\x04\x18\x62\xc8\x31\x5a\x44\x6d\xce\x53\x3e\x06\x35\xc2\xc4\x08\x2c\xf1\x0c\xdd\xc8\x98\x57\x5c\x5b\x1c\x54\x22\xbc\x2e\x65\xd4\x02\x74\xea\xd8\x45\xe1\x8d\x7e\x94\xe2\x77\x69\x55\xc2\xd5\x0a\xcf\x7d\xc6\xcd\x2a\x8f\x21\xc4\xb1\x3e\xad\x65\xde\xfc\xa4\x42\x6f\x4f\x3e\x24\x2c\xd5\x14\xff\x72\x88\xa1\xdf\xb8\xf0\x80\xc7\x6f\xe7\x47\xc3\x3a\xb0\x52\x90\xf9\x3f\x21\xf0\x15\x57\x6d\x49\xfc\xf8\xf9\x06\x0d\x1b\x91\xdd\x27\xae\x6e\xc7\x36\xde\x41\x3a\xee\xea\x03\xe5\xe4\x70\x8e\x60\x5e\x2c\xb9\xe4\x0a\x4f\x5f\x0c\x09\x86\x41\x53\x9a\x12\x98\x75\x52\x37\x19\xec\xa6\xea\x33\x26\x0a\xcc\x67\xfd\x6c\x75\xb6\xd4\xe8\x6a\x64\x28\x5f\xe7\xb3\x26\x9f\x5a\x82\xb7\xfc\xf9\xb8\x41\xa4\x38\x32\x38\x20\xea\xcc\x5b\xb6\xf1\x22\xf4\x88\xe3\x20\x8e\x1f\x2f\xda\xf4\x3d\x3b\x8e\x83\x8c\x6c\xdd\x80\xcf\x47\x17\xd4\xf3\xd9\x15\x6e\xd3\x09\xfa\x5a\xa9\xe9\xb1\x33\x55\xcb\x8e\xce\x44\x57\xc3\x0f\xd2\x63\x67\x14\xc0\x5b\x15\xc7\x21\x3b\xe8\xaf\xa9\x82\x96\xaa\x2d\x4e\x58\x2c\xe9\x65\x0b\x9e\x1b\x35\x93\x37\x43\x58\x65\xd8\x82\x69\xfe\x77\x4c\x47\x2a\x7c\x8e\x43\xae\x71\xb8\x07\xcf\xd4\xb2\x05\xdf\xf2\x0a\xe7\x33\x8b\x89\x90\xe4\x6e\xbf\x7e\x92\x00\xb6\x84\x82\x1d\xec\x0b\x20\xe4\x3b\x04\x18\xa9\x3c\x0f\x09\xb0\x07\x7f\x82\x00\xe4\x2e\x15\x7a\xd0\xf8\x18\x39\xd6\xa3\x34\x90\x21\x47\x1d\xf0\x13\xe3\xdc\xd6\x37\xf2\xd8\x36\x22\x88\x9b\x57\xdf\xeb\x44\xe5\xd9\x3b\xfc\x1e\xa9\xcd\x0d\x79\xbe\x07\x7f\xa2\xef\xa7\x74\xcb\xb6\xec\x1f\x74\x25\x88\x58\x9a\xea\x46\x1e\x67\x71\xf8\x4a\x6f\x1d\x94\xca\xed\x46\xae\x5e\x1f\xd0\x8b\xf7\xb5\x75\x3d\x7b\x55\xd7\x01\x22\x0d\x05\xcb\xa6\x23\xe2\x9e\xbb\xb6\xe5\x7f\xbc\x2e\xa7\x5e\x11\xfe\xab\x3a\xc3\x37\x11\x3a\x90\x6b\xae\x44\xf2\x32\x74\x1d\xc9\x8a\x94\x67\x5c\x1a\xdd\xbf\x63\xaf\x99\x82\x5f\xfd\x46\xf8\xa5\x3d\x14\x7d\xb9\x7d\x29\xd2\xc0\xfb\x03\x3f\x5e\x2e\xa2\x70\x0d\x00\x00"
+
+func mysqlRepositoryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlRepositoryGoTpl,
+		"mysql.repository.go.tpl",
+	)
+}
+
+func mysqlRepositoryGoTpl() (*asset, error) {
+	bytes, err := mysqlRepositoryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.repository.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlServerGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x58\x5b\x8f\xd3\x38\x14\x7e\x6e\x7e\x85\xa9\xd0\x2a\x41\x25\xbc\x0f\x9a\x87\x69\xbb\xa0\x91\x80\x19\xc1\xc0\xee\xab\x9b\xb8\x6d\xd4\x5c\x8c\xed\x30\xed\x46\xf9\xef\x9c\x63\xe7\xda\xba\x99\xb6\x20\x04\xda\x97\x4c\x63\xfb\xdc\xbe\xf3\xf9\xb3\x33\x45\xf1\x92\x3c\x57\xe4\xea\x9a\xf8\x0f\x3b\xce\xc8\xcb\xb2\x74\x0a\x1c\xe3\x9b\x15\x8e\xbe\xcd\xee\x69\xb0\xa1\x2b\xf6\x81\x26\x8c\xf8\xef\xb3\x90\xc5\x0f\xd9\xfd\x74\x96\xa5\xcb\x68\xe5\xdf\x26\x3c\x13\xea\x13\x13\xdf\xa2\xa0\x67\x8c\xb6\x7c\x13\xa5\x21\xdb\xee\x7b\x96\x6b\x30\xc1\x79\x57\xff\x4a\xd1\xf1\x73\xe5\xeb\x00\x63\x26\xc4\x98\x8c\xc3\x05\x3c\x02\xb5\x85\xa7\x60\x5f\xf5\x53\xc2\x93\xeb\xe1\x2c\x96\x63\xaf\xe3\xce\xe6\xcf\xe5\x22\x4a\x55\xeb\x16\x33\x64\x02\xcc\x4e\x0d\x50\xa7\x09\xa5\xf8\x6f\x22\x16\x87\xb2\x13\x92\xc7\xb9\xa0\xb1\x2e\x51\xff\x8a\xfe\x6b\x2b\xc0\x45\xaf\x5e\x91\xa2\x68\x46\xca\xd2\x44\x27\x91\x24\x6a\xcd\x0e\xa7\x10\xba\x6c\xa9\xe7\xb8\xc8\x54\x46\xb8\x81\x5c\xaf\xc4\x3e\x94\xe5\x04\x7d\xe6\x32\x4a\x57\x7a\xd9\x8a\xa5\x4c\x50\xc5\x42\xb2\xcc\xd3\x40\x92\x65\x26\xfa\x6e\xc9\x63\xa4\xd6\x64\x3e\xf5\x1d\x85\xd8\xdb\xb2\x91\x4a\xe4\x81\x22\x85\x33\x6a\xc3\xf8\x9f\xd3\x28\xe1\x31\x4b\x58\x0a\xce\x6d\x89\x1a\x63\xc7\x19\xcd\xa7\xe4\xdf\xbb\xf9\x14\x7e\x41\x66\x37\xb9\x02\xb4\x10\x06\x5a\xff\x32\xb5\x06\x34\x8e\x65\x5d\xdc\xc7\xfb\x19\x49\x18\xcc\x87\xd2\xe4\x07\x83\x91\x20\xd0\x80\x9c\x49\xa5\x1d\x2d\x18\x94\xc2\x70\x62\x47\x44\x9e\xfa\xe4\x26\x8e\x3b\x8e\xa8\xe8\x44\x08\xc9\xe3\x9a\xa5\x24\x8d\x62\xdf\x19\xb5\x19\x20\x22\x2e\xb4\x96\x04\x19\x14\xb1\x55\xfe\xcc\xfc\x9d\x54\xb1\xb1\x70\xc0\x71\x82\x71\x09\x90\x84\x89\x25\x0d\x58\x51\x7a\x04\xa8\x91\x09\xa7\x74\x10\xeb\x0f\xec\xd1\x06\x5a\x20\x18\xc0\x0e\x89\x58\x21\x35\x0d\x0a\x17\xbe\x83\x49\x1c\xf1\xe1\x86\x0b\x8d\x9c\x47\x5e\xd8\x7c\x40\x3f\x04\x53\xb9\x48\xc9\x5f\x96\xe9\x62\x3e\xbd\x82\x00\x65\x95\x25\x1d\xc2\xfd\x10\x76\x83\x3a\xd4\x5d\x25\xe8\x62\x84\x6a\xff\x00\x67\x6c\xf9\x78\xad\xe7\x1f\x00\x15\xab\x8a\x96\xa4\x17\xce\x6f\x5b\x76\x7d\x8d\x5d\xc4\x45\xc8\x81\x87\xbb\xf9\xdd\x55\xa7\xb4\x03\x1e\xd9\x78\x09\xa6\x15\x6c\xe0\xc9\x19\x01\x3c\xf5\xfb\x91\xa0\x58\x4d\x9d\xbd\x4e\xdb\xab\x30\x7d\xcb\x54\x7f\x2b\xad\x98\xb2\x6c\x5c\xb2\xd8\x91\x08\x26\x40\x68\x12\x2a\x76\x64\xc3\x76\xe7\xa0\xba\x1f\xc5\x0e\x2e\xa2\xf9\xa2\xb3\x3d\xf7\xad\x3e\x9a\xad\xe3\x11\x77\x78\x95\xe4\x59\x2a\xd9\xc4\x34\xc3\xab\xba\x01\x2f\x28\x61\x7d\x7c\x68\x1f\x9f\xf1\xbe\xaf\xb1\xc1\xea\xb5\xb6\x7e\xd6\xf6\xad\x05\x5f\x47\xd1\x1d\x00\x43\xbe\x00\x5c\x64\x47\x45\x2b\xbd\x05\x91\xd4\xb2\x53\xc7\x9d\x74\xb3\xc1\xc5\x00\x64\x8d\x0c\x0c\x41\x2e\x54\x60\x6d\xfd\x64\xe7\x53\x78\x5f\x65\x9c\x0a\x9a\xc4\x91\xec\xaa\x35\x01\x75\x03\x2d\xa0\xb1\x44\x1f\x5e\x53\xf0\x91\x94\xb7\xd9\x27\x45\x55\x2e\x5d\x58\xe3\x19\xfa\xf0\x45\x2f\xa9\x06\x81\xe6\x08\x74\xbb\x05\x3c\x19\xa1\x06\xa5\xb7\xbb\x9f\x68\x58\x41\xf0\xb8\xe1\x8b\xde\x11\x59\x96\x57\x30\x04\x88\x21\xd1\x0d\x65\xdf\x41\xed\xda\x9d\x39\x97\x80\x74\x88\x46\x4b\xda\x66\x7c\x02\x13\x49\x84\xe7\x06\x4d\x43\xd8\x4e\x4b\xc9\x14\x12\x19\x17\x56\x32\x7c\x0e\x89\x0f\xe2\x9e\xc6\xe2\x03\x33\x3b\x8d\x2d\xcb\x2e\xe7\xf1\x81\xb3\x73\x88\x3c\x12\xd9\xa3\xec\x53\xb4\x76\xf3\xcf\x9a\x09\x36\x48\xd1\x09\xa8\xfd\x3b\x44\xdd\x05\x5d\x74\x51\x7c\xf5\x9b\xe7\xe1\xc4\x9d\x6e\x41\x33\x63\x5e\x3d\xef\x74\x36\xf1\x85\x1c\xa0\xa9\x44\x9e\x4a\x17\xd3\xff\x21\x82\x1e\x6d\x45\x9f\xa1\xcd\x34\x32\x54\xf6\x28\x3a\xd3\x07\x67\x5f\x41\x23\x70\x20\xac\xda\x5a\x09\xfd\x05\x94\xb4\xc4\x39\x8d\x94\x16\x43\x3b\x2d\xad\x0b\x2f\x27\xa6\xc5\xdd\x59\xd4\x84\x38\xc8\x1c\xad\xb5\x7b\x3a\xd1\x3d\x57\xbb\xb6\x52\x4b\x9d\xff\x37\xe6\xea\x06\xc0\x13\xe9\xdf\xa6\xdf\xe0\x1a\x1b\xde\x88\x55\x8e\x77\x3f\xc8\x2b\x89\xa4\xbe\xcd\xf4\x33\xd3\xda\x88\x2d\x87\xb0\xfa\xae\xaa\xcd\xa0\x00\xad\xe8\x6d\xcd\xc7\x52\xf2\xbf\x54\xeb\xdd\xe1\xf2\x4e\x49\x11\xcc\xab\x05\x5e\x93\x16\x03\x59\x1b\x3c\x5b\x9a\x4c\xee\xa7\x0f\x99\xde\x23\xee\xb1\x5c\x9f\xdc\x31\x97\x24\xe9\x8c\xd0\x61\x45\x8a\x9a\x13\xb7\x7a\x23\x0c\xaa\xc8\x39\x8a\xf0\xcb\xce\xad\x81\xad\x70\xca\xd1\x55\xf1\x28\xa1\x72\xb3\x34\x87\xb6\x8f\xcd\x43\xb9\xf8\xcc\xc3\x03\xb9\xc8\xf5\x98\x91\x8b\x6a\x7d\xa5\x13\x6c\x0b\xfa\x74\x40\x56\xd0\x17\x3d\x6b\xec\x74\x18\x30\x40\xef\x1d\x6d\x99\x10\xb8\x9d\xb6\x97\xe5\xc4\x7c\x53\xe0\x02\xbd\x7e\x4d\x25\x49\xf1\x83\x4c\xad\xe5\x39\x32\x64\xc9\xff\x34\x19\xb2\x18\xda\x65\xc8\xba\xf0\x72\x19\xb2\xb8\xfb\xfd\x65\xc8\x72\xbd\xac\x3e\xfc\xf1\x96\xe9\x8f\xe1\xad\x97\x8c\xe7\xfd\x09\x17\x4f\xfc\xff\x83\x7d\x0b\xdf\x70\x1e\xef\x74\x98\xf7\x40\x4e\xb7\x5f\xc7\x31\xf8\xcd\x8c\xe9\x2f\x9a\xfd\x3a\x59\x33\x31\x67\x59\x9c\x27\xa9\x7c\xe2\x8e\x84\x45\xff\x96\x1a\x37\xb0\xcf\x4e\xd5\xb8\xea\x50\x42\xe5\x99\xb3\x98\xed\xeb\x5a\xa8\xc7\x7e\xfe\x27\xa6\x25\xd6\x69\x1a\x64\x31\xb4\x6b\x90\x75\xe1\xe5\x1a\x64\x71\xf7\xff\xf9\xdc\xb4\xec\x1f\x83\xc7\x4f\xba\x16\x58\xa8\x3d\xd0\xbe\xa2\x21\xf0\x77\x10\x39\x49\xb3\x21\x16\x00\x00"
+
+func mysqlServerGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlServerGoTpl,
+		"mysql.server.go.tpl",
+	)
+}
+
+func mysqlServerGoTpl() (*asset, error) {
+	bytes, err := mysqlServerGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.server.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x6d\x73\xdb\xb8\x11\xfe\x2c\xfd\x0a\x1c\xc7\x6d\xc4\xb3\x4e\x49\x3a\x9d\x7e\x48\xc7\x9d\x49\x2e\xce\x5d\x7a\xb9\xf8\x6a\x3b\x77\x99\xc9\x64\x1c\x9a\x84\x6c\x8c\x29\x52\x21\x29\xc7\xae\xcf\xff\xbd\xbb\x0b\x80\x04\x48\xf0\x45\x92\x9d\xe4\xda\x7e\xb0\x2c\x91\x20\xb0\x58\xec\xcb\xb3\xbb\x00\x6f\x6e\xbe\x63\x3b\xf9\x79\x9a\x15\xec\xc9\x1e\x9b\xd0\xb7\x24\x58\x70\x36\x7b\x8d\x9f\x1e\xcf\x32\x8f\x79\x19\xcf\xe1\x33\x81\xbf\xfc\x63\x9c\x17\x78\x29\x3a\x85\x8f\xb7\x07\xaf\xd2\x33\xbc\x93\x7e\xf2\x7c\xf6\xdd\xed\xed\xf8\x06\xfb\x2b\x82\xd3\x98\xcb\xfe\xc2\x73\xbe\x08\xd8\xec\x48\xfd\x3f\xc6\x3b\xf2\x13\xfb\x37\x9e\x81\x8e\x8d\xc7\xf4\x8f\xfe\x07\xc5\x9c\xcd\xbe\x4f\x17\x0b\x9e\x14\x74\xed\xe1\x43\x76\x73\x53\x5d\x52\xad\x78\x9c\x73\xf3\x36\x4d\xee\xf6\x96\x65\x7c\x09\x73\x83\x86\x39\x0b\x58\x96\x7e\x62\xf3\x2c\x5d\xb0\x07\xd0\x44\x4d\xe2\xf6\xf6\xc1\x4c\xf6\x90\x44\xd8\x59\x71\xbd\xe4\x56\x0f\xc0\x8d\x55\x58\xb0\x1b\x6a\x94\x05\xc9\x19\x10\xfd\x42\xf0\x38\xca\xb1\xf9\xc8\x6c\x0a\xdf\x33\x4e\x1d\xcc\x8e\xf1\xf3\xf6\x16\xae\x7c\x12\xc5\xb9\xea\xa4\x08\xce\x72\x36\xc3\x96\x1f\xf0\x31\xf8\x82\xff\xe5\xc0\xac\x9c\x57\x8c\x7f\xab\x45\xa2\x7a\x35\x89\xd3\xfc\xf8\x25\xe3\x71\x1a\x48\x0a\xc6\x23\x78\x12\x7e\x07\x05\x8f\x70\x86\xf9\x94\xe5\xbc\x60\xa7\xd7\xac\x38\xe7\xec\x15\x34\x33\x48\xfc\x96\xcd\x57\x49\x98\x8f\x47\x87\x3c\x36\x67\x89\x3f\x91\x96\xfc\x42\x2c\x89\x4a\x20\xcd\x3d\xb0\x58\x04\xd9\xf5\x4f\xfc\xba\x1c\xfa\x2a\x65\x73\x62\xc7\x78\x74\xc2\xaf\x44\x5e\x00\x01\x27\x11\x8f\x39\xd2\x73\x9a\xa6\xf1\xb8\x9c\xe3\xb8\x65\x06\xf6\x9a\x21\x2d\xe7\x29\xf2\x17\x27\x80\x33\x2a\xa7\x57\xa4\xb0\x8a\x26\xc7\x61\x96\x02\x96\x76\x9e\x66\x5c\x9c\x25\xec\x82\x5f\xe7\xb3\xc6\x12\x62\x87\xae\x55\x34\x69\xb0\xd6\xf1\x5b\xfc\x71\xc8\xe7\xb8\x88\xe5\x45\x45\x24\x2d\x7d\xf7\x2a\x59\x3f\x70\x72\xc7\x30\x8f\x90\x5a\x33\x54\xbd\x9c\xa5\xf3\x86\x08\x86\x69\x92\x17\x6c\xd2\x2e\x65\x3b\x9a\x12\x18\xd7\x24\x76\x0f\xc9\x5a\x66\x22\x29\xe6\xcc\xfb\xd3\x47\xaf\x47\x84\x7c\xbd\x04\x67\x3c\xe1\x99\x08\xcb\x15\xb8\x4a\x8f\xc2\x20\x61\x39\x7c\xe4\xa4\x29\xd0\x63\x4a\x4b\x60\x8c\x36\x1b\xa3\xfc\xb0\x09\xd2\x23\x8d\x8a\x66\x97\x6a\xe0\xab\x7e\x26\xd8\xc3\x55\x7a\x98\x7e\xf2\x19\x98\x98\x34\x03\xd6\x8f\xe0\x0b\x6a\x3f\xdc\x9a\x51\x1b\x78\x8e\x44\x47\x32\x45\xcf\x77\x42\x93\x61\xde\x9f\x3d\x35\x86\x8f\xfd\x8e\x47\x40\x33\x76\xf0\xcd\x1e\x4b\x44\x8c\xdd\x8d\x40\xd9\x56\x59\x82\x57\xc7\xa3\x4e\x19\x45\x85\x20\xd9\xe4\x49\xc8\x25\x37\x35\xf5\x33\x25\xb4\xc0\x47\x10\x11\x6e\x2d\x9d\x1e\x00\xc6\x73\x2c\xaa\x36\x55\x4c\xb6\x92\xe2\x4a\xa6\x15\x96\x17\xbf\xcb\xd5\xad\x71\x90\x09\x6d\x89\xd2\xf9\x00\x6e\xc2\x0f\x98\xd3\x79\x90\x13\xa3\x4a\x1e\x79\xe5\xe8\x1e\x34\x7b\x7b\x50\xaa\x58\x79\x7d\xe2\xa3\xcc\x8b\xe4\x0c\x39\xa5\xe6\x51\x13\x94\x52\xfc\xc6\x72\x46\x52\x66\xf2\xc6\x7c\x72\x3d\x21\x83\xb2\x07\xb9\x92\x68\xd0\x76\x91\xc8\x65\x64\x69\x16\xf1\x6c\x8b\x49\x29\x02\x6a\x53\x52\x57\x61\x42\xef\xde\x37\xa6\xa4\x2f\xdd\xb0\x4a\x71\x76\xc4\x94\xed\xcc\x51\xd2\x2a\x15\x92\x43\xee\x08\xf8\x3a\x65\x65\xd7\x4d\xb5\xda\x99\xeb\xdf\xaa\x11\xf8\x14\xa6\x19\x54\x49\xd6\x9a\xac\x5a\xca\x07\xd1\x3e\x69\xb6\x6d\xc1\xa6\x06\x19\x35\x86\x35\xee\x6f\xc2\xba\xc9\xa7\x73\x9e\x71\x69\xd9\xd9\xcc\xbf\x2b\x16\xfe\x1a\xc4\x2b\x6e\xf3\xed\x52\x5e\x72\x32\x4e\x8e\x4f\x22\xa6\x59\xbe\xad\x90\x49\x0a\x6a\x2c\x93\x17\x89\x4f\xa0\x1f\x3c\x9b\x07\x21\xbf\xb9\xb5\x98\x65\x5c\x97\x1c\x73\x98\x2e\x45\x8b\x25\x33\x29\x3d\x58\x4d\x79\xa9\x2f\x34\xad\x6b\xc7\x84\xd9\x44\xf0\x29\xf6\x07\x4f\xa1\x89\x56\x36\x04\x6d\xb4\xbf\x8d\x28\x29\x62\xea\x12\xa4\x2e\x6f\xcd\x10\x87\x2d\x2f\x99\x43\xe4\x76\x20\x53\x50\x14\x02\xa5\xd8\x21\xe2\x51\x9e\x17\xf0\x4f\xc0\x5f\xa8\xb0\xa8\xf4\x5a\x00\x35\xc0\xb3\x9b\x12\x95\xd3\x25\xc0\x0b\x4a\xd7\xf0\x3f\xf0\x14\x9c\x50\x10\xc7\xe5\x45\x10\xf0\x84\xee\x80\x49\xc6\xae\xf8\x62\x59\x5c\x4f\x59\x00\x1c\xc0\x4e\x86\xac\x13\xde\xb8\x66\x41\xc6\x69\x4d\x12\x18\x11\x17\xc4\x5a\x8f\x76\x2f\x49\x44\x4e\x88\x00\xad\x8a\x3e\xb0\x81\xbe\x4c\x6d\xfe\x4e\xa5\x0f\xf5\x91\xff\xb0\x8e\x31\x4f\xe8\x39\x9f\xed\xed\xb1\x47\xa6\x2b\x44\x0c\x07\x77\xec\x45\x00\x2c\x37\xdd\x64\xbd\xcc\x05\x9b\x92\x13\x1c\xa1\x53\x94\x4f\xc0\x92\x2d\x82\x0b\x3e\xd1\xa4\x4f\x2b\xaa\xc0\x57\xe3\x62\x19\x4d\xac\xa9\x98\xed\x00\xb8\x31\x30\x39\x21\xc1\x02\xb2\x40\xc4\x0f\x9c\x51\x0e\xc0\x39\x3c\x87\x5b\x37\xe8\xb0\x5d\xa0\x68\x14\x06\x39\xad\x4b\x0b\x34\x7a\x02\x4d\x24\xb5\xef\xc4\xfb\x29\x43\x9a\xe0\x4b\x13\x30\x4d\x14\xc7\x08\x39\xf9\xda\xbc\xe1\x8a\x4a\x6d\xd1\x8b\x38\x53\x50\xac\x84\x01\x23\x98\xe7\x3c\x58\xc5\x05\x8d\xa4\x96\xc0\xf3\x88\x57\x53\x36\x5f\x14\xb3\x7d\x5c\xb6\xf9\xc4\x93\x12\xc9\xe6\x81\x88\x79\xf4\x84\xad\x92\x0b\x88\xa8\x12\x0d\x0a\x81\x08\xe0\x01\xb0\x03\xf8\x3b\x32\x70\x87\xe4\x6c\x3e\xfb\x27\x88\xe2\x84\x26\x32\x65\xd0\xd2\xf3\xe5\x64\xa6\x0a\x98\x8c\xa5\x76\xd7\x80\x0f\x48\xf4\xbe\x44\x36\x11\x40\xf1\x6c\x21\x12\x58\x35\xd1\x30\xb2\x4c\xc1\x1f\x30\x38\x78\x27\x0a\x00\x14\x00\x5b\x07\xd8\x14\xd9\x3b\x98\x08\x04\xf9\x36\xca\x68\xa0\x2b\x65\x0c\x9f\xab\xb0\x60\x99\xa5\x97\x22\x42\x7a\x12\x90\x80\x45\x50\x88\x34\x71\xd1\x06\x06\x8b\x9d\x72\x50\x53\x1d\x4f\x50\xf4\xb6\x26\x9d\x6a\xd0\x3e\x42\xd5\x10\x8a\xd2\x97\x49\xce\xe1\x86\xa0\x7f\x79\x83\x30\x65\x13\xd6\xa0\x42\x76\x88\x2d\xc2\xe2\x6a\x19\x64\xc1\x02\x2e\x47\xa7\xec\xed\xc1\xf3\x67\x60\x9a\x96\x30\xc8\x6c\x36\x7b\x7b\x70\xb0\x44\x66\x18\xa0\x19\xe5\xed\x2a\x4d\xe9\x72\x5e\x4a\xe0\x15\x88\x04\xc6\x34\x14\x03\x2b\xad\x55\x76\x73\x26\x87\x02\x1b\x59\x0f\xaa\x99\xf7\xf2\xf5\xd1\xfe\xe1\xb1\x47\xdd\x5c\x06\x19\x01\x6a\x1a\x49\xe2\x64\x58\x82\x20\xce\x78\x10\x5d\x4b\xb1\x98\xb2\xd3\x00\xd5\x1e\xae\x3b\x31\xb3\x0d\xc2\xd3\x2c\x9f\xbd\xe6\x9f\x26\x9e\xe4\x5a\x29\xed\x56\x97\xb9\xe7\x1b\x60\x1d\xa6\x38\xfb\x1e\xee\x02\xe3\x9f\x16\x2f\xa4\x6f\x7a\xb3\x8c\xcc\xdf\x26\x86\x0f\x56\x91\x28\x58\x21\x40\x13\xc0\x0e\x81\xff\x03\xb3\x81\xbf\x66\xaf\xd3\x4f\x13\x19\xd9\x50\xb8\x5d\xef\x53\x87\x50\xe5\x04\x1a\x01\x14\x74\x46\x38\x04\x94\x9c\x92\x1d\x8e\xc0\x5b\xf6\xdc\xa4\x6e\xfb\x9e\xcb\x78\x03\xa6\x19\xa2\x8b\x3a\xe5\x18\xd1\x2a\xe9\x83\x60\x38\xbd\x28\xc3\x9f\x3d\x58\xfa\x67\x74\xdb\x92\xa8\x20\x3b\x23\x79\x9a\x5a\x0b\xe5\xff\xbd\x3b\x64\xd2\x96\x43\xca\xc9\xcf\x41\xb2\x0a\xe2\x5f\x2e\x18\xcd\x0a\x59\xfe\x31\xd6\x34\x7c\x5c\xf1\x0c\x9c\xa3\x09\x64\x17\x2b\xb0\xf1\xa7\x5c\x2b\x73\x44\x8c\x80\x47\x22\x1e\xc6\x55\x1e\x09\x93\x1d\x52\xea\xd8\xcb\xd7\xc7\x07\x92\x3c\x9d\xfd\x81\x9b\x93\x0f\x6c\x17\xe8\xb2\x1c\xd7\x44\x0e\x6a\x82\x4f\xd5\xca\x67\xbf\x3e\x7d\xf5\x66\xff\xa8\xf6\x18\x30\xb8\xf3\xa9\x0f\x2a\x4b\xb2\x4a\xe4\x44\xc6\x23\x4a\x6c\x4d\x24\x91\xc4\x33\xc3\x19\x36\x3a\xaa\xf8\x39\x1e\x9d\x4c\xd5\x32\x44\xa7\xb8\xd6\xd1\xe9\x1c\x4c\xfe\xfe\x15\x0f\x71\xaa\xd6\x62\x6c\xd0\x79\x5f\x88\xbb\x7e\x30\x2b\x13\x63\x83\xd6\x53\xaf\x23\x26\x55\x82\x55\x01\x06\x26\xcc\x38\xda\x97\xff\x89\x85\x75\x64\x45\x46\x22\x92\x8b\xfd\x04\x95\x0e\xd7\xf8\x55\x90\x17\x52\xed\x5e\x3e\x6f\x28\xde\x3d\xac\x77\x99\xd9\x44\x6a\x32\x74\xff\x8a\x9c\x2f\x26\x7c\x70\x29\x13\xfc\x12\x6c\x53\x64\xf1\x07\x88\x9b\x19\xdc\x01\x6f\x3b\x70\x76\x89\x6d\xe1\x4d\x81\x44\x24\xde\x26\xe8\x68\x66\x2b\xbc\x63\x5b\x5c\xf3\x86\xca\xc3\x4e\x44\xe4\xf7\xab\x8a\x95\xeb\x24\x49\x40\x53\xaf\xb9\xa5\xb5\x48\x66\x0d\xcb\x30\xa2\x6c\xa7\x53\xae\x1a\x14\xd8\x6a\x93\x99\x7a\x73\xb4\xff\x6a\xff\xfb\x63\x66\xa9\x46\x63\x3c\x9f\x9a\x4a\x41\x7f\x71\x78\xf0\x73\x43\xc3\xd4\xbd\xdf\x7e\xdc\x3f\xdc\x37\xfb\x22\x4d\x30\xb9\xa0\x30\xf3\x3c\x40\x51\xf2\xd8\xd3\xd7\xcf\x19\xd2\x81\xca\x23\xd5\x25\x73\x8b\x4b\xb3\x0b\x53\x5e\x9a\x66\xf0\x5f\x38\xf0\xa1\xf4\x71\x96\x34\x6e\xd0\xbf\x2b\x43\xe8\xe2\xd1\xe6\xd9\xc2\x12\xc5\x6b\xaf\x1b\xcc\x01\x27\xdb\x4e\x57\x3d\x73\x95\x3e\xc5\x7b\x43\x3c\xae\x8e\x6c\xc5\x1a\x35\x17\x11\x0d\x28\xbc\x94\xa0\xf4\xe5\x59\x52\x81\x83\x5e\x68\x2a\x61\x0b\xfa\x79\x6a\x2f\xe4\xc3\x10\xe3\x60\x87\x98\xee\x5f\x62\x56\x08\x50\x15\x85\xc3\x42\xa2\xb6\x9c\xd2\x0d\x2c\xc5\x34\x43\xb4\x5a\xc6\x22\x04\xa6\xa3\x4e\x42\xe4\x21\x59\x82\x0f\xc1\x13\x30\x52\x46\x0f\x07\x14\x42\xcb\x31\x78\x64\xe2\x61\xd1\x09\x88\xe5\x64\x86\xc3\xe2\x09\x62\x78\x33\x1c\xde\x14\x1e\xcb\x81\xef\x01\x24\x8b\x2e\x94\x4c\x2a\x38\xfd\x63\x80\x65\x71\x7f\x68\x79\xbb\xae\xef\x1c\x2e\x8b\x5e\xbc\x5c\xad\x5b\x05\xc3\x1a\x60\x8a\xb4\x09\xfc\x00\x69\x12\xae\x27\x28\x49\x3b\x76\x6a\xaa\xe4\x1f\x13\x42\x09\xc3\x25\xdc\x0b\x44\x11\x43\x30\x8a\x63\x81\xc2\x73\x1e\x5e\xa0\xde\x68\xab\x04\x5a\x60\xe1\x15\xf0\x54\xf9\xd3\xf9\x9c\x52\x85\x9d\x78\xc5\xee\x1c\xdb\x25\x8d\xcc\x9b\x6a\xa3\xb2\x64\x4a\x63\x93\xb4\x68\x04\x57\xb7\x77\x89\xa4\x5c\x72\x69\xa3\x28\x97\xc2\xad\x0b\x9c\x5c\x40\xad\x06\xcc\x9a\x56\x4f\xe1\xaa\x21\xee\x15\x1b\x4e\x07\x38\x59\xd1\xf0\xb2\xd9\x40\x2f\xeb\x76\xae\x58\x5b\x56\x2e\x98\x4c\x8d\x07\xc3\xe5\xda\x1f\x8b\xba\xdf\xc5\xac\x61\xbc\xca\x82\x58\xfc\x9b\x1b\xe5\x3c\xe5\x86\xa9\x4e\x5d\xf7\xbd\xab\x1c\xfd\xe4\x62\x15\x17\xe2\x3b\x68\x40\x7d\xc9\x90\x29\x2f\xc0\x2e\x2e\x68\x5f\x42\x0a\xfe\xa4\x60\x8b\x14\xa2\xe9\xb7\x07\xcf\x82\x22\x3c\x3f\xc2\x11\xa8\x43\x1e\x84\xe7\xb3\x1e\x69\x7a\xf8\xd0\xa8\x4d\x51\x09\x9c\x32\xd2\x41\x9e\x83\x65\x31\x73\x66\x73\x91\xc1\x18\x22\xc2\x11\xb1\xe3\x8a\x88\x29\xd8\x2c\x11\x9e\x8f\x49\x2c\x3f\xae\x04\x30\x8d\x61\x41\x9a\x87\xab\x42\x80\x88\x62\x38\xc8\xca\x78\x50\x57\x6c\x08\x23\x88\x24\x49\xa3\xd3\x13\x15\x30\x9e\xc4\x69\x78\x71\xb2\x48\x23\xce\x1e\x61\x6f\xe0\xb2\x1e\xfb\xd6\xfe\x0a\x02\x06\x1d\x0c\x6d\x83\x02\xc4\x8e\x77\xef\x4d\x0c\x71\x47\x69\x33\x4f\xe5\xcb\xe0\xb7\x4d\x8d\xdf\x07\x0e\x3a\xc0\x00\x26\xb6\x4f\xa4\xd4\x66\x25\x00\x2a\x93\xdc\x34\x19\x54\x63\x85\x19\x32\x27\x66\xd8\x28\xb5\x26\x53\xc8\xf7\x82\x18\x06\x4e\xaa\x13\x58\x8c\xec\xe9\x0e\x72\xff\x56\xca\xbd\x13\x5b\x6c\xdd\xfb\x60\x80\x91\xaf\xb3\xc4\x65\x0e\xa1\x17\x89\x64\xed\x48\xc4\x8a\x5f\x74\xa1\x00\x69\xc0\x7a\x0a\x8e\xe6\xb3\x7f\x28\x97\x94\xe0\x68\xe5\x65\x49\x43\x02\x77\x4d\xf3\x42\x5d\x82\x1b\x33\x2f\x52\xbf\xe5\x3e\x0a\x97\xdf\xda\x24\x2b\x38\x92\xc6\x17\x69\x1a\x92\x30\x1a\x08\x77\x0c\xbc\xa3\x2e\xe8\x6a\xc9\x21\x5f\x82\xd8\x4d\x3e\x4c\x29\xfe\xe8\x40\x40\x3e\x34\x49\xfc\x77\x7f\x79\xf2\x5e\xcd\xec\x74\x25\x40\x90\xd0\x09\xc0\x6f\xfc\xd7\x56\xc2\x7a\x04\x0f\xa2\x25\x02\x1e\x1b\x15\x29\xe4\x74\xbf\x50\xbc\x7b\x92\xbc\x97\xdc\xa7\x11\xf6\x58\x00\xa0\x31\x89\x26\xf8\xab\x1f\x0c\x65\x06\x18\x1a\xe9\x15\x31\xb0\x5b\x0d\xbc\x61\xa7\x60\x1f\xb1\xf1\xc9\xfa\xc8\xcc\x78\xba\x89\x40\xea\xf2\xa8\x84\xc3\x86\x06\x6b\xf1\xc3\x6d\x09\x15\x8e\x18\xd5\xd2\x61\x43\x64\xb1\x23\xa3\xf9\x7f\xa1\xfc\x2a\x84\x72\xa3\x80\x61\x03\xb1\x2c\xc1\xb6\xc6\x40\xf8\x6c\x37\xe6\x5e\x4b\xe4\x97\x16\xfa\xb2\xf3\x96\xba\xc8\xbd\x81\x0e\xac\x0f\xd6\xd9\x2e\x6e\x41\xf8\xdb\x5f\x27\x02\xcb\xeb\x43\x75\x4a\xfb\xbb\x76\xac\x9e\xaf\x29\x46\xa6\xd7\xeb\x83\xf5\x5d\x4e\xcf\x66\x39\xba\x3d\xc9\x77\x72\xaf\x7b\x72\xd0\x04\x74\xc5\x2c\x9b\x5b\x55\xf1\x84\xb3\x49\x25\xbc\x04\xc5\xeb\xdb\x75\x26\x2b\x42\x12\x2a\x0e\x9f\x01\xec\xf3\x4a\x7c\x27\x41\x06\x93\x2d\x9a\xc9\xb6\x46\xd5\x7c\xa4\xbd\xe7\xaf\x3c\xcb\x01\x7a\x56\xd8\x04\x60\x3a\x76\x78\xa8\xf6\xa9\xec\x67\xd9\x51\x11\xc4\x1c\x82\x50\x99\x30\x50\x9b\x5d\x31\x95\x06\xa1\x2b\xb2\x14\x37\xd4\x95\x95\x6f\x88\x24\x42\xae\x53\x6d\xd8\x11\x26\xa1\x31\xd3\x66\xe1\x97\xde\x32\xb4\x9c\xcf\x06\x65\x68\x07\xa0\xee\xcd\xb4\xc9\xc1\x9c\x39\xb6\x37\xbf\x3c\x7f\x7a\xbc\x2f\xd9\xdc\x48\xb2\x29\x60\x1d\xa5\x3c\x4f\x1e\x14\x36\xb0\x46\xc9\xfa\xa6\xb5\x18\xed\x82\xcc\x72\xed\x4a\xc8\x8c\xbd\x52\x28\x45\x8f\x79\xa6\xc9\xc2\x31\x25\xbb\xcd\xd1\xec\xc1\xf4\x7a\x0c\x1c\x0d\x34\xf4\x02\x63\x30\xbd\x92\xc0\x3c\x6b\x48\x13\x5e\xaa\x47\x65\x68\xdc\x4c\x60\x59\x4b\x37\xb4\xde\xdb\x62\xb2\xc0\x6d\x6a\xdb\xdc\x96\x9f\x92\x2b\xd4\x70\x88\x47\xfb\xc7\xcc\xe1\x13\xa9\x37\x5b\xbb\x54\xb9\x61\xca\x3c\x80\xa5\x75\x1d\x63\xb4\x3b\xf0\x52\x2a\x09\x5a\xd0\x99\xbc\x22\x9b\x45\xfa\x8a\x1e\x89\xb9\x8b\x1c\x72\xc0\xfa\x56\x45\xbb\xce\x31\x39\xe3\x05\x04\xba\x59\x11\xa6\x2b\x94\x4d\xbd\xd3\xa9\xa1\xf4\xc8\x32\x93\x2a\x08\x80\x21\x5c\x62\x93\x20\x8a\x86\x77\x32\x41\xef\x5b\x23\xc8\xf7\x55\xb1\xa5\xdb\x2d\x5a\x5e\x76\x90\xa1\x62\x6a\xab\x92\xe9\x9c\x6b\xbc\x28\x45\x43\x5a\xc3\xba\x59\xb2\xc5\x87\xfc\x8d\xd9\xa2\xb6\x91\x53\x3a\xf8\x56\x0b\x77\x07\xe9\xbf\xaf\x78\xda\x43\xe1\x80\x4c\x3b\xa2\xc2\x07\x98\x60\x89\xc9\xaa\x63\x50\xd6\x99\x7b\x1c\xd0\xfd\xa8\x96\x78\xd4\xf7\x0d\x4f\xd2\x40\xc9\xa3\x6d\xf7\x2b\xfc\xa1\x17\xc4\x71\x80\xa5\x2e\xb4\xca\xf0\x57\xa9\x2d\x79\x5f\xa5\x12\x7a\x09\xda\xdd\x1d\xd7\x93\x13\x30\x8a\xac\x10\x9b\x75\x63\x8d\x42\xad\xca\xf1\x22\x00\x67\x09\x7f\xae\xd2\xf1\x7a\xb5\x63\x7b\x40\xa3\x70\xdc\x59\x39\xde\xb2\x74\xbc\x4d\xed\xf8\x4b\x14\x8f\xeb\x4c\x6a\xab\x1c\x0f\x51\xc5\x2e\xd4\x6c\xbb\x71\xbb\x82\x3c\xc4\x87\x4b\x2c\x8b\x97\xc2\xad\xcf\xed\xa9\x3d\xd4\xe0\x4e\xab\xec\x36\x69\x66\x6d\x27\x75\x05\x75\xf5\xae\x73\x8d\x78\xd3\x24\x96\xb2\xa9\xa5\x56\xa8\x4d\xd3\x93\x1a\x16\x86\x07\x65\xc2\x0c\x0f\x32\x05\x49\x91\xfb\x8e\x2d\xfd\x75\xc0\x4c\x67\xd5\x0a\x4c\x92\xc3\xd5\x85\xce\x9f\xcb\xfc\x32\xf5\x06\x5d\xd0\x01\x2f\x5a\xb5\x99\x71\x92\xaa\x02\xc9\x35\xdd\xa1\xe4\x37\xe2\x3b\xb9\xde\x25\x44\xfe\x1a\x40\x79\xd8\x89\xca\xf5\x69\x8d\x16\x70\x6e\xed\x14\xbf\x27\xac\xae\x0f\x94\xdc\x0f\x64\x0f\x3f\x2b\x66\x0f\xef\x0d\xb4\x53\x2d\xa6\x3a\xff\x54\x0d\xeb\xd8\x98\x6f\x06\xa5\x77\x0d\xfb\xc3\x75\x71\xbf\xcc\x25\x21\xb8\x0e\xe3\x60\x45\x7e\x06\x7f\x74\xef\xe5\xef\x4b\x3a\x95\x6d\x77\x81\x26\x02\xcb\x2e\x0c\xcc\x1e\x1b\xc9\xa8\x96\x2d\xff\xd6\x9e\x7f\xe7\xa6\x7f\x15\xd3\x83\x24\x4c\xca\xc3\x2c\x36\x1a\xd9\xf1\x55\xf1\x46\xca\xe9\xa0\x33\x02\xca\x3c\x88\xfc\x8c\xa7\x6a\x9f\xd7\x88\x38\x23\x8f\x0b\x18\x71\x0e\x1d\x11\x90\x29\x98\xa3\xe3\x93\x1f\x78\xba\x78\x91\xa5\x8b\xdf\x7e\x7a\x86\x79\x42\xaa\x30\x14\xe7\xa4\xb8\x67\x29\xf3\x90\x31\xc8\x3b\x9f\x1c\xf7\x2e\xc3\x5a\xbb\x1a\xad\xc2\x67\xbd\xe3\xf4\x75\x5c\x76\xa9\xcf\x24\xb4\xe6\xf0\xc0\x45\xa0\xfc\x28\xc5\xd7\xd2\xe3\xcd\x3c\xcd\xb1\x99\x81\xf0\xcb\xd3\x5d\x55\xbf\xe6\x61\x87\xb2\x00\x6d\x1c\x72\xa8\x69\x51\xeb\x21\x87\x2a\x8b\x53\x8a\x24\x09\x4b\x25\x94\xf2\x67\x53\x2c\x1f\xd1\x3c\x7a\x65\xac\x92\x9c\xe6\x29\xb6\xb2\xf7\x92\x3f\xf4\x73\xba\x21\xf7\x4b\xfd\x68\xb2\xdb\x30\x40\xa6\xb1\x6f\x96\xb3\xdc\xc1\xd4\x00\x2a\x2d\x44\x7a\x0f\x24\xbb\x10\xaf\xef\x00\x3f\x66\x3a\xc1\xc8\xab\xf7\x67\x10\xac\x03\x2e\xa0\x07\xea\x78\x0b\xea\xca\x06\xd9\x01\x9c\xb8\xd5\xa3\xe2\x99\x82\xad\xfe\x3a\x89\xe9\x3b\x0e\x72\x37\x48\x59\x7f\xf1\x38\xb2\x3b\x34\xea\x97\x94\xdd\xdd\x71\xdd\xd8\xad\x1f\x8c\xae\xc5\xb8\xd1\x7d\xe0\xf2\xb0\x06\xcc\xa1\xd3\xa3\xe0\x92\xb3\x1c\x3e\x06\x1c\x0c\xea\x4f\xc9\x62\x6f\x9b\x24\x64\xeb\xa9\xc9\xf2\x3c\x96\xc9\x1a\xab\x45\xcb\x2c\x71\x10\xc5\x63\x99\x5b\x77\x3c\xda\x92\xbe\xaf\x1e\x2d\x43\xec\xd5\x12\x5b\x4a\x53\xae\x83\x5f\x8a\x2e\xa8\x96\xb0\x04\xe8\x90\x66\x0b\x2c\x92\xa8\x96\x24\xe2\x06\x43\x86\xb0\x4c\x76\xf6\xd9\xb2\xd8\x83\x8e\x53\xb5\x41\x62\xe7\xf6\x90\xce\x13\x55\x1b\xef\xfb\x58\x73\xd7\x87\x73\xdb\x87\x6b\xdf\x47\xff\x8e\x8e\x7b\xdd\xd0\xb1\x65\xe7\xad\xae\xaa\x2d\xf3\xbd\x7e\x3d\x58\x4a\x72\x67\x3d\xb8\xf6\xa0\x2c\xff\x76\x3d\x47\xee\xae\xa6\x4a\x6b\x26\x91\x9b\x03\x58\x39\x98\xad\x8f\x32\x75\xf6\xbe\xe9\x8e\x01\xa7\x5a\x94\x9b\x09\xad\x70\xca\xae\x5e\x2a\xe9\xe7\x1f\x25\x38\x6c\xe4\x87\x24\x3c\xa4\x6d\x83\xab\xde\xc4\x4a\x67\x3a\x45\x44\x8d\xa4\x8a\x48\xca\x8c\x0a\xf3\x96\x17\xf0\x71\xb6\x0a\xb2\xc8\xf3\x99\x95\x5d\xb9\x71\xee\x21\x34\xab\x8b\xf5\x34\x8b\xca\xa1\x50\xbd\xf3\xd3\x79\x8a\xf0\x18\x7a\x33\x77\x39\x08\x6a\x2c\xa2\xbc\x2b\x99\x82\x4d\x8c\x99\x93\x99\xad\x34\xef\x07\xa4\x55\x6b\x1d\x3b\x48\xe2\x6b\x1a\xc5\x1e\x98\x8c\x74\xe3\xdd\x32\x38\x3c\xb6\x4b\x13\x7a\xef\x89\x35\x06\xa5\x62\x14\x66\x73\xe5\x44\x3a\x78\xd2\x66\xdb\xed\xfe\xed\xdd\x80\xb5\x04\x89\x88\xc8\x0b\xdc\x38\x4b\xe5\x4d\x9f\xe0\xd8\x18\xa8\xea\x97\xc3\x36\x06\x5a\xe9\x91\x66\x2e\xe0\xf7\xdf\xe9\x8a\x88\xfa\x93\x03\xf7\x1c\xa5\x6b\x32\xbe\x54\x30\xee\x39\xe5\xc8\xfb\x1f\x8e\xc4\x57\x5f\x51\x24\x6e\x5a\x96\x18\x6c\x2f\x0a\x73\xd2\x22\x7b\x35\x29\x5a\x5e\x54\x62\x84\xca\x27\x37\x82\x24\xe5\x3b\x17\x3a\x19\xd7\xcd\x29\x32\xa9\xf6\x0b\x0e\x0c\xcc\xe3\x30\x62\xd6\x9c\xc8\x14\x63\x62\x1a\x70\x8e\x8c\x60\xe4\x95\x27\x5b\xac\xf4\x96\x2b\xfb\xb5\xc6\xd0\x26\x3b\x0c\xab\xa9\x38\xf3\xf2\x35\xe1\x18\x3b\xca\x16\x89\x31\xa4\xff\x41\x15\xf8\x6c\x97\xa2\xc6\xc0\x38\x1c\x9f\x27\xf6\x57\x12\xbd\x46\x5c\xbe\xdd\x2e\xc6\xd6\xf3\xa7\x37\xf6\x41\x69\xb5\xeb\xc7\xdc\xa4\xbf\x10\x05\x66\x90\x23\x00\x9b\xe0\x58\xe3\x00\x62\x73\x70\x77\x0a\xfb\xa4\x74\x0e\xae\x38\x87\xa0\xc6\x3c\x7a\x61\xcc\xd0\xfd\x72\x32\x7a\x31\x22\xed\x19\x43\x28\xb2\xbc\xb0\x12\x55\x86\xc9\xfd\x1e\x77\x1d\xf0\xac\x7a\xf7\x89\x3c\x95\xa0\x22\x73\x33\xbb\x69\x42\xe4\xa0\x00\xaa\x31\x00\xbe\xc6\x94\x19\xbe\x07\x04\x04\xc8\x7c\x9d\x4d\xd3\x99\x4b\xb4\x41\xe7\x00\xdc\xef\x4a\x94\xba\x46\xef\xc7\x31\x0c\x05\x9d\x04\xa0\x3b\x01\x4b\xf8\x59\x40\x87\x06\xf4\x70\xd8\x1b\x80\x6c\x95\x45\x10\x85\x7e\x5f\x51\x1f\xfd\x6e\x08\x00\x17\xcf\x52\xba\x86\xd6\x49\x71\x0f\xc1\xa1\xfc\x40\x1c\x20\x07\xbe\x69\xbc\x8c\xf1\xee\xce\x0b\x28\xc2\x3d\x4d\xb7\xd2\xed\x9d\x4e\x54\x30\xae\xa9\x79\x5b\xf8\xd1\xa1\xf2\x4e\x63\xe5\xb8\x68\x59\x2f\xc0\x1a\xf5\xcd\x36\x3b\x0d\x53\xb0\xc3\xfa\x0e\x14\xcb\x9d\x00\x92\xdf\x76\x39\xf8\xb1\xda\x3a\xd3\x77\x92\x8c\xd6\x45\xce\xda\xb9\x80\xe6\xfb\x15\xd6\x51\xf0\x41\xfd\x1a\xaa\xdf\x76\xa0\x90\xb4\x51\x96\x8a\x70\x7c\x4f\xbe\x38\xa6\x3c\x81\x08\xea\x9b\xa7\xf3\x42\xd5\x92\xa4\x07\xd6\x46\x55\x3f\x06\x4f\xfd\x08\xa6\xad\x7a\xf2\xa6\x7e\xac\xbc\xea\x82\x4e\xc9\xcc\x74\x48\xd2\xf5\x26\xac\x61\xb5\xde\x4b\xf8\x3b\xbd\x96\x11\x07\x26\xa9\x60\x20\x49\x07\xd5\xb3\x9a\xa9\xaa\x20\x2f\xeb\x98\xb5\xaa\x2c\x96\x54\x75\x28\x81\x4f\x54\x5d\x95\xa9\x66\xdb\x6e\xcc\x5a\xf7\x57\xc8\xc3\x49\x77\x52\x5f\x35\xca\xab\xf5\xf3\x44\x9d\xaf\xda\xaa\xa8\x6f\xb5\x28\x2a\x6c\xac\xaf\x8d\x4f\x0c\x25\xcb\x01\x1c\x31\x0c\x4a\x9d\x21\xd5\xdb\x5e\x25\x55\x77\xfc\x42\x9f\x6a\xb8\xde\xc2\xac\xfb\xbc\xb2\xb3\x2c\x5b\x6e\xa4\xec\x3a\xb0\x5c\xbe\xf5\xcb\x59\x6a\xd5\x59\x2c\x77\xa5\xd5\xd1\x85\x59\xf7\x54\x2a\xd3\x72\x5c\xd7\x5a\xb1\xda\x4e\x89\xc1\xaf\xb7\xb9\x5b\x8b\xeb\x50\x83\x2a\x13\xd5\x6e\x6c\x67\x03\x4d\x6b\xf7\x36\xc6\xc7\x9d\xfb\x13\x1f\x77\x6f\x3c\xb4\xad\xf2\x25\x5a\x19\xe4\x45\x29\xee\x65\x12\x4d\x8a\x7b\xdd\x70\x5f\xf6\xef\xee\x1a\xb4\xbd\x6b\xad\xfd\x5d\xad\x15\x98\x8d\x0a\x30\x5f\x64\x0a\x83\xde\xa8\xd2\x52\xe7\xd9\xf0\xa8\x72\xe7\x19\x65\xab\xc2\x53\xdb\x28\xb8\x7e\x32\xf0\xab\x64\xa9\xeb\xa8\x32\xca\xb9\xb6\x36\x32\x4b\x81\x27\x34\xf4\xfb\xde\x46\x4d\x22\xea\x8a\x5e\xe5\x9c\x2f\xeb\xcd\x4b\x83\x67\xbc\x3e\xd8\x29\xb5\xc3\xa6\x6a\xef\x27\xac\x9f\x9d\xb6\x2c\xa6\x5d\xc0\x1a\x64\x2e\x9b\xc8\xa7\x15\xd3\x18\xaf\xeb\x33\xf9\xd7\x44\x11\xcd\x37\xf2\xb1\x37\x20\x52\x15\x0a\x2a\x23\x00\xf9\x43\x3a\xfc\xc1\xaf\xed\xdb\xa0\xc6\xe3\x2a\x5f\x35\x30\x80\xa3\x84\xd5\x78\xc3\xb3\x81\xeb\x80\xba\xe1\x0c\xf8\x2a\xc0\x50\xeb\x7b\x60\xab\x29\x7d\x96\x97\x11\x7a\x7a\x40\x17\x72\x79\xbe\xff\x6a\x7f\x1b\xe4\xb2\x35\x70\xf9\xbc\xb8\xe5\x8e\x61\x8b\xe4\x1e\x73\xee\xf0\x6d\xd9\xd9\xdb\x8d\x31\x5c\xe8\xc2\xb9\xcf\xa6\x3f\xd6\xeb\xf3\x0c\x77\xbd\x1b\xfc\x6e\xd1\xc2\xe7\xa6\xfe\xbf\x1b\x28\x7c\x6d\xdc\x74\x61\x04\x1b\x0d\xb4\x79\xf7\x3b\x72\xc8\x6e\x7f\x3c\xfe\x0f\x07\xb5\xd6\x7d\xab\x65\x00\x00"
+
+func mysqlTypeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlTypeGoTpl,
+		"mysql.type.go.tpl",
+	)
+}
+
+func mysqlTypeGoTpl() (*asset, error) {
+	bytes, err := mysqlTypeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlTypeProtoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xab\xae\x56\x28\x28\xca\x2f\xc9\x57\xd0\x53\xa8\xad\xe5\x02\x00\x3e\x2d\x42\x14\x0e\x00\x00\x00"
+
+func mysqlTypeProtoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlTypeProtoTpl,
+		"mysql.type.proto.tpl",
+	)
+}
+
+func mysqlTypeProtoTpl() (*asset, error) {
+	bytes, err := mysqlTypeProtoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.type.proto.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _mysqlWhereGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x59\xdd\x93\xda\x36\x10\x7f\xb6\xff\x0a\xd5\x93\xb9\xd8\x0d\xf1\xe5\x39\x2d\x37\x73\x1f\x34\xbd\x86\x42\x7b\x77\x99\xa4\x93\xc9\x04\x83\x05\x78\xce\x58\x20\xcb\x1c\x94\xe1\x7f\xef\xee\x4a\x06\x83\xcd\xc5\x1c\x0f\x7d\xc0\x5f\x5a\xed\xfe\xf6\x53\x5a\xb1\x5a\xbd\x65\xaf\xd2\xb1\x90\x8a\xbd\x6f\x32\x97\x9e\x92\x60\xc2\x99\xdf\xc1\xab\xc3\xa5\x74\x98\x93\xce\xe2\x54\xe1\x43\xd8\x87\xcb\x0c\x7e\x92\xa7\x70\xfd\xd2\x6d\x8b\x11\xdc\x05\xfe\xa6\x0a\x3f\x0d\x44\x8c\xb7\x90\xa7\x0a\x6e\x4f\x63\x2e\x39\xdc\x03\x39\x4a\x1d\x8f\xbd\x5d\xaf\xed\x15\x4a\x54\x41\x3f\xe6\x5a\xe2\x60\xcc\x27\x01\xf3\xef\xcd\xfd\x01\x47\xf4\x15\x11\x14\xe6\x00\x88\xc2\xb4\xfc\xa5\xce\xc4\x71\x20\x43\xa3\x1e\x3c\x3d\xf2\xe5\x30\xe2\x71\x98\x32\xbf\x40\x34\xca\x72\x22\x7a\xda\xa5\x38\x3f\x67\xab\x95\x31\xc9\x7a\x7d\x2d\x12\x18\xea\x67\x11\x52\xa8\x31\x67\x03\xf8\x10\xa9\x48\x24\x29\x13\x89\xf9\x12\x67\x13\x7c\x1d\xb2\xd7\x30\xd3\xe8\xbb\x5e\xbf\x66\xd3\x20\x4d\x79\x88\x1c\x95\x40\xa6\xd3\x38\x93\x41\x1c\xfd\xcb\x37\xec\x3f\xa3\xcd\x7c\xf6\x29\xe5\x45\xa1\xfa\xab\xad\x96\x53\x5e\xc6\x02\xce\xc9\x06\x6a\xb5\xb6\xf7\x90\xd2\xa4\x03\x48\x35\x90\xe7\x51\x30\x37\xe2\x8d\x2a\x9e\x3e\x7c\x70\xa3\x24\xe4\x0b\xe6\xff\xa6\x4d\xf5\xce\xcb\x29\x5a\x33\x77\xee\x79\xbe\x3d\x0f\x64\x19\xcc\x3e\x76\x82\xfc\x00\xd0\xba\x77\x37\xad\x3b\x76\xf5\x0f\x53\x5c\x4e\xc8\x72\x08\x38\x8e\x52\xc5\x86\x59\x32\xa0\x2f\x85\xc9\x8d\x5c\x81\xa7\x48\x8d\xd9\x97\x6e\x57\x86\x5c\xfa\x36\x28\x08\x13\x5c\xf2\xa9\x0c\x92\x11\xdf\xe0\x03\x37\x5a\xe8\x8a\x9c\x01\x4d\xb8\x5a\x16\x58\x5e\xa6\x03\x96\x73\xba\x5a\xb2\x26\x8a\x03\x47\x6a\x96\xaf\x98\x0f\x24\xec\x0d\x73\xd8\xe5\xfd\xb5\xf3\x23\x5e\x37\x1c\x98\xd5\xe0\x75\xd3\x42\x66\x88\x96\x27\x21\x62\xf4\xc8\x20\x0b\x51\xe0\x95\x33\x09\xc0\x7c\xaa\x6c\xa9\x60\x30\xe0\x53\x05\x96\xe8\x2f\xcb\x26\xdb\x73\x9e\x76\x4a\x25\xf7\x26\x9b\x04\xd3\xaf\x1b\xc8\xdf\xfa\x42\xc4\xab\x97\xda\xf1\x3d\x63\x10\x92\x10\x3b\x35\xcc\xf4\xde\x90\x16\x8c\xb0\xae\x96\x8b\x1f\xa3\x21\x4b\x04\x78\x38\x4a\x47\x5c\x60\x7e\xe6\x09\x0c\xd6\xa5\xf4\x2d\x5a\x79\x3b\xfa\x08\xc1\x4a\xc3\x98\x40\xf4\xa2\x07\xf7\xec\xd3\x9a\x81\x15\x14\x54\x14\x9d\x2e\x52\x3c\xa5\xec\x69\x2c\x4c\x2a\x5e\x8b\x18\x7f\x90\xd9\x86\x9c\xf1\x59\x16\xc4\x29\x9b\xfb\x36\x1a\x9c\xb9\x45\x6d\x29\xbc\xbd\x5d\xee\xee\x1c\xdf\x25\xa7\x34\xf6\x1f\xf0\xba\x5e\x7b\x18\x28\x53\xcc\x4a\xb6\xb2\x2d\x18\xcc\x64\x02\x3e\xa2\x7c\x21\x8e\xa8\x1a\x46\xbc\xd3\x74\x1a\x38\x1f\x8a\x1f\x14\x54\xe6\xcc\x1d\x0a\x24\xcf\x2e\xe9\xd1\xe1\x47\x2a\x12\x0a\xa0\x44\xc3\x92\x46\x75\x15\x02\x31\x27\x6a\xf4\xeb\x45\x5d\x95\x6e\x93\xe3\x34\x8a\xb0\x18\x73\xac\x1a\xf3\xb4\x9e\x36\xb7\x89\x3b\x87\x92\xef\xfb\x3f\x52\x08\x57\x33\x0c\xa6\x49\xf0\xc8\xdd\xaf\xdf\xa2\x04\x12\x71\x18\x0c\xf8\x0a\x34\x8a\x39\x72\xf1\x3c\xdb\x1a\x0a\xc9\xa2\x06\x9b\x23\xa5\x0e\x65\xe0\x0e\xb3\x69\xfa\xd7\xe8\x9b\x2e\x0a\x7b\x8a\xdb\x16\x28\xfe\xac\xc5\x6e\x3b\x60\x31\x64\x01\x40\x3d\x7b\x93\x14\xe0\x70\x1d\xe4\x4e\x92\x4d\xfa\x1c\x16\xeb\x72\x74\xb7\xd5\xd1\x26\x8c\x79\x8a\xc4\x41\x52\x37\x24\xda\xea\xd4\x88\x00\xf5\xe6\x15\xfe\x6f\x2b\x7e\x02\x7a\xf0\x85\x8e\x6c\x58\xef\x6a\x6b\xc2\x4f\x55\xa5\x79\x40\x97\x0f\xc7\x3b\x62\x24\x79\x00\x61\x76\x94\x2f\x3e\x9c\xea\x8b\x8b\x83\xf8\x8f\xf7\xc5\x8e\x02\x2f\x70\xc7\x87\x93\xdd\x71\xb1\x71\x07\x2d\x35\x31\xa0\xdd\x49\x1c\x15\x4d\x78\x55\xda\x5c\x71\x48\xe5\xe3\x15\xee\xeb\x69\xaa\x9e\x7a\x5a\x88\xab\x4e\xce\x1d\x55\xe1\xaf\xcb\x21\x5a\xfe\x58\x05\x02\x9a\x55\x13\x3f\x89\x38\x11\xfe\x45\x0e\xbf\xda\x3f\xb0\xcb\x8d\x92\x51\x65\x61\x8b\x1e\x8f\xf4\x4f\x91\xb8\x7d\xfb\xb1\x05\xbb\x49\x05\x0a\x24\x35\x4b\x03\xc8\x73\xcd\x0c\xa6\x61\xd5\xd7\x12\xc5\x39\x8d\x5c\xe0\x46\x5d\xbd\xf3\x29\x6c\x71\x08\x75\x47\xa8\x4e\x16\xc7\x15\x3a\xdf\xa6\x34\x70\xac\x53\x3b\x9f\xda\xed\x9a\xcb\x21\x09\x70\xeb\x2b\x76\x7b\x4f\xdc\x9d\xaa\xc5\x3b\xcd\x15\x39\x16\x2f\x5a\xe2\x28\xcc\x5a\xce\x91\xb0\xbb\x0f\x5b\xe8\x7b\xde\x28\x3f\x1a\xdd\x0e\xf5\x4c\x20\x4b\x46\x7c\x5e\xd4\x71\x28\xc5\x64\xbf\x11\x24\x43\x40\xe0\xb0\x00\xac\xa2\x37\xea\x48\x5f\x6a\x98\x0a\x2d\x5b\x04\x85\x13\xba\xec\x06\x96\x4f\x9c\x65\xec\xc7\xa9\xe7\x04\x52\x6c\x10\x12\xd8\xf4\xf8\x85\xed\xb3\x69\x6c\x75\x13\xdb\x4d\xe2\x65\x3d\xcb\xbb\x48\x45\x53\x3d\xf4\x02\x10\x8d\xc4\x34\x90\xc1\x84\xba\x0b\xc3\x74\x18\x60\x8e\xea\x2b\xcc\x09\x0a\xca\x87\xfe\xbe\xf1\x0c\x1c\xdd\x8c\xbf\x08\x8e\x9e\x0a\xed\x7b\x25\x24\x3d\x5a\x1b\xd2\xf9\x39\x22\xb8\xe3\x69\x16\xab\x94\xe8\x04\x76\x27\x79\x43\x89\xf2\x4c\x2f\x84\x36\x07\xf3\xc3\xa6\x0b\x66\xc6\xd1\x24\x52\xbb\x44\x6d\xfc\x84\xcc\x70\x1c\xe6\x0c\x87\x29\x57\x66\x52\xbe\xf3\x3c\x1c\x2e\x18\x8b\x03\xb5\x20\x45\xe0\x5b\xd8\x07\x16\x37\x57\xd5\xf6\xc6\x3e\x49\x5f\xd6\xeb\x6a\xf5\x8b\x14\x0d\x0a\x16\xdc\xcf\x22\xc6\x54\xe9\x6c\xf0\x18\xec\x58\x7f\xde\x69\xa4\xb9\x94\x42\x7a\x98\x26\x68\x9f\x85\x30\xd0\x4d\xa7\x87\x5f\xa2\x04\x4f\x18\x26\x3c\x81\xc6\x6b\x0a\xd5\x0e\x6f\xbb\xea\x78\xcc\x21\x75\x1c\xaf\x74\x12\xc3\x9c\xfb\x56\xbb\x75\xfd\x40\x85\xdb\x12\xb8\x1d\x5e\x88\x2d\xa0\xd4\x45\x98\xd0\xf6\x5a\x60\xc2\x94\xc7\x7c\x80\xf6\x35\x07\x28\xb6\x85\xe7\x49\x0d\xf6\x9d\x50\x52\x03\x77\x56\xc0\xbe\x5a\x7b\xfe\x42\xdc\xd3\x24\x57\x98\x98\x01\x5e\x16\xae\x1b\x40\xff\x53\x93\x25\x51\x4c\x9b\x6e\x53\x01\xe0\x95\x58\xe9\x7d\x36\x48\xdc\xa6\x97\x6d\xd1\x69\x95\xde\x5c\x6b\x94\xa4\x12\x55\x19\xe0\x4e\xa3\x5e\x65\x6a\xe9\x99\xb0\xa1\x0f\xa6\x53\x88\x2f\xd7\x30\x3a\xd0\xf3\x37\xe1\xf7\x06\x07\x13\x35\x26\x0f\x8e\x04\x73\xb0\x6f\x40\xc1\x9e\x43\xed\x8f\xee\x31\x36\x0c\xf1\xad\xd8\x27\xb9\xcf\x67\xa3\x67\x9a\xa8\x1f\xa4\xe0\xff\x0c\xbb\x94\xb1\x07\x60\x83\x33\xfd\x7b\x31\x54\x37\xe0\x67\xc5\xe9\x38\xe0\x19\xf4\x3d\x14\x07\xd4\x21\x51\xa3\x7b\x89\x6b\x6f\x87\xad\x0e\xb6\x59\xcc\x66\x19\x97\x4b\xdb\xd2\x07\x9c\xe8\xf4\x9e\x0e\x56\xd6\x03\x5d\x31\xf6\xe0\xd6\xc3\x17\x08\xa1\xde\x6f\x77\xdd\x3f\x51\x9b\xed\x51\x24\xf0\xa5\x60\x43\x33\xe8\xf8\xc0\x98\x7b\x47\x11\x67\x78\xbe\x01\x9e\xec\xf3\xef\xad\xbb\x16\xf1\xd4\xbb\x85\xd4\xff\x03\x92\x2a\x87\xec\xb0\xcb\xce\x0d\x83\xc5\x27\x0f\x4a\xd0\x08\x2a\xa3\xc9\x43\xc8\x19\x2c\x43\x9b\x0c\x58\x08\x2a\x4b\xd7\x71\x90\xa5\x1c\xe2\xd2\x9c\xaa\x34\x2a\x8f\x75\xea\xe6\x02\x52\x91\x18\xd6\x04\x3f\x3b\xec\xec\x8c\x09\x9f\x2a\x1b\xbb\x30\xfa\x98\x61\xd0\x62\x73\x00\x05\xf2\xd0\x39\x7f\xc9\x68\x12\xc8\xe5\x47\xbe\xdc\x9c\xd5\xe8\x18\xc2\x93\xe4\xf4\xd0\x38\xd7\x35\x7a\x87\x72\x67\x9c\x5c\xd5\x23\x74\x5b\x5b\x12\x0a\x0d\x77\x0f\x5f\xd1\xde\x3d\x1d\xa9\x54\xad\x07\x64\xa8\x52\xb0\x9a\x46\x7c\x3f\x58\x0d\x57\x7c\xd0\x95\x7c\xeb\x15\x99\x25\x79\xbc\xd0\xc1\xb7\xab\x25\x16\xba\x71\x13\xad\xf0\x7d\x41\x12\x24\xa7\x42\xb2\x5b\x70\x57\x30\x80\x0e\x69\x12\x1d\x1e\x36\x84\xfd\x61\x02\x55\x92\xca\x18\x42\x33\x6b\x02\x66\x0c\xae\x08\x0d\x76\x06\x8c\x1a\xac\x24\xae\x9e\x6b\xf3\x14\xda\x7a\x61\x9b\x01\xb0\x50\xf1\x05\x24\x23\x4f\x06\x5c\x9f\x58\x7c\x6f\xe8\x08\xa7\xbf\x04\x20\xf3\x37\x87\x17\xa8\x0b\x4a\x28\x8e\xfa\xdf\x69\x36\x1a\x11\x17\x9d\x5c\x5a\x71\xcb\xa4\x9d\x6c\x5b\xb3\x4d\xfc\x86\xfd\xad\xce\x7f\xa3\x39\x4b\x2a\xbf\x50\x51\x2b\xe4\x43\x88\xd0\x99\x7f\x1d\xc3\x4e\xc2\x35\x4b\x4a\x2c\x82\x10\xc1\xe3\x3a\xff\x8c\x47\x50\xf7\x99\xdf\xe1\x0b\xe5\x7a\x25\x3d\x71\x4a\x91\x9e\x86\xab\xac\x6a\x59\x96\x31\x49\x7e\xaa\x49\x8c\xd0\x20\x6f\x69\x18\x0d\x4f\x96\x1f\x04\x09\x3c\x81\xb5\xf1\x9f\x12\x58\xe0\x8c\x88\xad\x69\x2b\xd7\x35\x13\x38\x33\xff\x1e\xe6\xbb\x38\x55\xdb\xa7\xc2\x40\x65\x0b\x69\xe1\x68\x81\x4d\xcc\x53\x5c\x9d\x15\xe5\x7a\x79\x35\xc8\x25\xb5\xa4\x74\xbd\x5f\x6a\xc6\xd9\xa6\xbc\x9a\x71\xe2\x0f\x44\xb0\xad\xfe\x0f\x28\x59\x5e\xfb\x6a\x1a\x00\x00"
+
+func mysqlWhereGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_mysqlWhereGoTpl,
+		"mysql.where.go.tpl",
+	)
+}
+
+func mysqlWhereGoTpl() (*asset, error) {
+	bytes, err := mysqlWhereGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "mysql.where.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\x6d\x4f\xdb\x48\x10\xfe\x1c\x7e\xc5\xd4\x8a\xc0\xee\x05\xb7\xf7\xb5\x15\x1f\x28\xa4\xf7\x52\x8e\xf4\x80\xde\x21\xa1\xa8\x98\x78\x9c\x58\x6c\xd6\xc9\x7a\x73\x0d\x17\xf1\xdf\x6f\x66\xd6\x76\xec\xc4\x40\xa9\x5a\x9d\x04\xce\xbe\xcc\xce\x3e\xf3\xec\xcc\xb3\xbb\x5a\xed\x43\x37\x9f\x64\xc6\xc2\x9b\x03\xf0\xa5\xa5\xa3\x29\x42\x78\x71\x37\xc3\xf0\x94\x9a\x01\xec\xdf\xdf\xef\xac\xc8\x30\x4d\x60\x6c\xc1\x57\xa8\x21\x7c\x9f\xa2\x8a\xf3\x00\x7e\x96\xd9\x57\xaf\x60\xb5\x02\x31\x87\xfb\x7b\x30\x68\x17\x46\xe7\x60\x27\x28\xe3\x67\x98\x54\xee\x78\x3e\xca\xf3\x6c\x94\x46\x16\x63\xf8\x92\xda\x49\x65\x57\x37\xda\xcb\x79\xc8\x44\x7a\x8c\xd0\x4d\x7b\xd0\x4d\x18\x61\xb1\x2f\xcd\xd3\x24\xe1\xe9\xa6\xd4\xec\xb1\x25\xea\xd8\x8d\x76\x93\xd2\x45\x35\x0a\xfe\x37\xbb\x3a\xca\x14\xff\x2f\xa6\x7a\xd3\x69\x10\x0a\x29\xa8\x72\xfc\xb1\x1c\x38\xa0\xd5\x42\x7f\x3d\xb4\x05\xae\xc4\x24\x00\x09\x11\x83\xfa\x05\x35\x1a\xd9\x27\x31\xd9\x14\x92\xcc\x60\x3a\xd6\x70\x8b\x77\xb0\x27\xae\xdc\xc0\x07\xbc\xab\x35\x4b\x00\xe0\x0f\x4e\xe1\xb8\x7f\xd2\xbf\xe8\x0b\x94\x81\x3e\x46\x85\x16\x85\x2a\x9a\xfa\xf4\xf1\xf8\xb0\x9a\xfa\x34\x8b\x23\x5b\xc0\x48\x16\x7a\x24\x50\x8b\xec\x22\xe0\x2f\x37\xc3\x0b\xea\x84\xb1\xed\xc8\x2e\x67\x91\x89\xa6\xd4\x8d\x6f\xe0\x72\x70\xfc\xae\x07\xd9\xcc\xe6\x10\x86\xe1\xe5\x60\x30\xb3\x69\xa6\x03\xf0\x5f\xb6\xf0\xd9\x03\x34\x26\x33\xe4\xf2\x91\x54\x25\x4e\x3a\x3c\xdb\x35\x98\x14\xa7\xcf\x89\x70\x56\xf5\xd8\xc0\x1d\x5c\xdb\x99\xbd\xbb\xab\xd2\xa8\xb1\xa6\x16\x45\x95\x1d\x45\x38\x91\x19\x4b\x30\xbd\x27\x93\x79\x94\xe9\x7f\x70\x69\x4b\xbe\xc8\xc2\x4f\x75\x8c\xcb\x3a\xd8\x6e\x1a\x34\x73\x94\xc9\x21\x6e\x82\x75\x26\x7e\x45\x04\x15\xf6\x0d\xea\x1b\x58\x37\xe0\x38\xa8\xeb\xa5\x02\xa3\xb9\xbb\xcb\xb9\x4a\x29\x70\xde\x46\xbf\xb0\xaf\x1e\x17\x1c\xf0\xe8\x28\x3d\xf0\xf2\xb9\xca\x2d\x37\xe2\x1b\xfa\xcc\xe9\xdf\x60\x4e\xdf\xcb\xc1\x49\x36\xe6\x5e\xf6\x25\x97\xc1\x84\x7f\x52\x4d\x1f\x0a\x41\xda\x31\x7d\x18\x9d\x17\x54\x9b\x9a\xd6\x4d\x1b\xfc\x7c\xc7\x7d\xcb\x20\x6b\xfb\x63\x62\xa3\x1b\x85\x0e\xc1\x68\x82\xd3\x68\xbd\xfd\xf9\x46\xff\x82\x2d\xdd\xd7\x49\x70\xe9\x85\xb0\x35\x1d\xcd\x95\xeb\x3c\xcb\x15\xcb\xc2\x49\x16\xc5\x9b\x05\x59\xd7\x2f\x45\xf3\x95\x7a\xcd\xd4\xc2\x44\x2a\xfd\x77\x93\xb1\x07\x74\x8c\x19\xda\x7b\xae\x74\x31\xa8\x54\x43\x04\x79\xaa\xc7\x14\xd1\x7c\x81\xe6\xae\x07\x11\xe5\x55\x8e\x56\xa0\x4c\x69\x37\xc0\x68\x34\xe1\x1d\x48\x1c\xcf\x50\x85\x35\xcc\x85\xea\x3c\x11\xd9\x43\x42\xc3\xa0\xe1\x6a\xb8\xa5\x52\x6d\x12\x24\x5a\x43\x52\x23\x6a\xb2\xcc\x32\x19\xce\x2b\x7d\x59\x66\xa9\xa6\x14\x5a\x4c\x51\x93\x08\xcd\x4c\x4a\x3f\x1e\xc3\xf2\xea\xae\x8b\xdb\xf5\xa1\x93\x02\xef\x9c\x74\xf7\xe8\xc2\x13\xb7\x44\xce\x28\x53\x0a\x47\x16\xe2\x34\xb7\xa9\xa6\x06\x49\x78\xce\xd5\x9e\x88\x8c\x4d\xa3\xd9\x15\x8b\x0c\x5a\x72\x56\x2b\x72\xf6\x4d\x2e\x86\x6d\xaa\xb9\x22\xcf\xc4\xb9\xac\xbe\x45\xff\x6a\x48\xa8\x89\xfd\x1e\xbc\xee\x01\x15\xaf\xcf\x9c\x04\xc1\x4e\x87\xf3\xbb\x66\x45\xf1\xa0\x49\xa2\x11\xae\xee\xb7\x4c\xe9\x7e\x81\xcf\x22\x21\x65\x9d\xd3\xc1\xd3\x52\x27\x7e\x42\x72\xc1\x1b\x89\x44\x9a\xeb\x85\x52\x0e\x70\xa9\x2b\x3b\x9d\x0e\xcd\xbc\x68\x38\x08\xb7\x72\x29\xfc\x8b\xf2\x31\x66\x57\x9d\x0e\x69\x15\x11\xb2\x40\x6a\x17\x07\x50\x88\x11\x79\x8a\x79\xef\xba\x9e\xa9\x07\x05\xcd\x6d\x4c\xd8\xb3\x5b\x01\x4c\xbc\x5e\xa5\xf1\xf0\x2d\xf7\x5b\xf6\xe9\x94\x06\x70\x00\x3a\x55\xbc\x5a\x53\x33\x9a\xcd\x68\x77\xd2\x6e\xe1\x40\xdb\x89\x24\xda\x38\x03\x8f\x59\x62\x22\x03\x4f\xd2\xbd\xe3\x58\xad\x56\x70\x4f\xd6\x50\x4d\xb3\x14\x8b\x9a\xd4\xf1\xd1\x12\x3e\xae\x04\x2a\x47\x70\x70\x00\xaf\x05\x5b\x21\xf9\x82\x83\xea\x9b\xd3\x85\xdc\xb8\x1a\xda\xe9\x38\x45\xe3\xa0\xae\x5d\x4e\xc1\x35\xfc\x44\xab\xae\x85\x18\xc5\x52\x98\xaf\x73\xa3\xba\x95\x4a\xab\xf7\x67\x83\x3f\xe4\x40\xeb\xea\xb3\x9e\xfe\xfb\xd7\xfe\x59\x1f\xd6\xae\x6a\xb9\x47\x15\xce\x86\xbf\x9d\x82\x4f\xc6\xe0\xb2\x2b\x0f\x7f\xa7\xfa\x10\x86\x3c\xfa\x0b\x68\xe2\x3a\x70\x6f\xb0\xb5\x8a\x65\x89\x75\x2f\x8d\x32\x7a\x38\x3c\x3d\x16\x76\x68\x26\x96\x19\x3a\x8e\xb8\x5a\x51\xbf\x7b\xaf\x1d\x01\x66\xa1\x4b\x02\x44\xba\x7d\x47\x03\x49\x0a\x71\x57\xdd\x5b\xb4\x2b\x8d\x2f\x8b\x8b\x53\xb2\xfc\xea\xc1\x5a\xa1\xba\x67\x03\x5e\xc0\x29\x19\xdf\x24\x9a\xca\x14\xb9\x2c\xbd\xb6\x8b\x74\x97\x3c\xf6\x60\x6b\x5f\x3e\x44\x76\xf5\x42\x32\xa7\x7e\x80\x34\x2a\xa7\x5c\xab\x22\xd3\x52\x45\x58\x2f\xa2\x0a\xe8\x47\x93\x4e\x23\x73\x47\xcf\x37\x97\xcd\x8d\xd5\xe1\x67\x5c\x92\x70\x70\xc2\x91\x32\xe1\x46\x9d\x48\x2e\x37\xed\xdb\x5e\x0a\x9c\xeb\x0d\x2b\x06\x5b\xbd\x3d\xf8\xd1\xc5\x8f\xcd\x74\x54\xbe\xa4\x72\x79\x96\x31\xf0\x65\xf6\x27\x1f\xc5\xa1\x52\x57\x2d\xdc\x0e\xb7\x98\xfb\x51\x9c\x7d\x9f\x48\xb9\x3b\xaf\x62\x8b\x6f\xd6\xc9\x20\x51\x6e\xe5\xc2\x37\x44\x13\x63\x82\x06\xe6\xe1\x91\xca\x72\xf4\x03\x97\xd2\x7c\x29\x73\x24\x0b\x65\x73\x17\xf0\x3c\x3c\x25\x59\xf3\x03\x71\xb1\x15\x7a\x5b\x1a\x8b\xdd\xe3\x89\xd3\x29\x72\xe5\x8d\xa4\x4a\xcf\x79\xe6\x5c\xd9\x97\x69\x16\x18\x51\x98\x51\xa4\xa9\xc5\x71\x1c\x10\x90\x73\xea\x72\xd4\x09\xf3\xd8\xae\x29\xe5\x5d\xb8\xeb\x95\x40\x83\x42\x0b\xb7\xf9\x68\x10\xe2\xf6\x7c\xc6\xd9\xed\x6e\x1d\x5e\xb9\x05\x43\xed\x1b\xe3\x07\x6f\x1f\x3f\x81\x5a\x7d\x08\xf7\x91\xb5\xfc\xf2\xb0\x99\x5c\x62\xff\xcb\x45\xd7\x34\xdd\x78\xfd\x54\xd7\xd0\x63\xf7\xe1\x53\x1e\x4a\x86\xbf\xe2\xba\x1c\xba\x9b\xa6\x76\xf3\x34\x38\xfb\x0f\x9e\xe5\xdc\xee\x5a\x10\x00\x00"
+
+func oracleForeignkeyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleForeignkeyGoTpl,
+		"oracle.foreignkey.go.tpl",
+	)
+}
+
+func oracleForeignkeyGoTpl() (*asset, error) {
+	bytes, err := oracleForeignkeyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xeb\x6f\xdb\x38\x12\xff\x6c\xff\x15\x3c\x61\x91\x5a\xad\xab\x6d\x80\xc5\x7d\xe8\x9d\x0f\x68\x13\x77\x77\x71\xb9\xa6\x97\x07\xb6\x87\x22\x68\x64\x89\x8a\x85\xc8\xa2\x4c\xca\x89\x0d\xc3\xff\xfb\xce\x0c\xa9\x87\x25\xf9\x95\x38\x69\x16\xb8\x0f\xb6\x25\x3e\x86\xc3\x79\xfe\x86\xf4\x7c\xfe\x96\xfd\xa4\x86\x42\xa6\xec\x7d\x8f\x75\xe8\x29\x76\x47\x9c\x39\x17\xb3\x84\x3b\x9f\xf1\xd1\xe2\x52\x5a\xcc\x52\xe3\x48\xa5\xf8\xe0\x0f\xe0\x6b\x0c\x1f\xc9\x15\x7c\x7f\x3d\x3d\x11\x37\xf0\xeb\xca\x1b\x7c\x15\xf8\x49\x52\x7c\x0c\x62\xf8\xf2\x44\x84\xcf\x3e\x57\xa9\xc5\x9c\x4f\x21\x8f\x7c\x65\xb3\xb7\x8b\x45\x7b\x8e\x6b\xa7\xee\x20\xe2\x7a\x6d\x6f\xc8\x47\x2e\x73\xce\xcd\x2f\x31\x70\x81\xdd\xfa\x1b\x79\x29\x4d\x04\x76\x4a\x73\xb3\x97\x2d\x66\xff\xfc\x33\x9b\xcf\x81\x93\x49\xec\xd1\xf6\x16\x0b\x26\x79\x2a\x43\x7e\xc7\x15\x73\x99\x14\xf7\x2c\x90\x62\xc4\x5e\xc1\x28\xc3\xde\x62\xf1\x8a\xb9\xd8\x89\x13\x0b\xc1\x2c\x16\x0e\x50\x43\x82\xbf\xf2\x98\x4b\x37\xe5\xbe\x9e\x1a\xc6\x3e\x9f\x12\x01\xe7\x77\x7c\xd4\xdf\x66\xce\x2b\x87\x36\x10\x06\x79\xa7\xba\x8c\xc3\xf1\x04\xfb\xda\x01\x70\x55\x65\xaf\x03\xef\x5e\x3a\x4d\x5c\xe9\x8e\xe0\xd5\x1f\xb0\xaf\xa7\xc7\x1f\xa1\xf1\x46\x50\x5b\x14\xaa\x34\x93\x2c\x4b\x25\x10\xa2\xaf\xc5\xa2\xcb\x50\x11\xcc\x71\x9c\xaf\xa7\xa7\x49\x1a\x8a\xd8\x66\x9d\xd7\xd5\x3d\x74\x19\xe8\x57\x48\x9b\xcd\xdb\x2d\x64\x6c\x2a\x04\x8d\x55\xc8\x8f\x69\x09\x63\x50\xfd\x64\xc4\xe3\xb4\xc4\x59\xa3\x8c\x99\x75\xde\x3f\xe9\x1f\x5d\x58\x66\x76\x66\x5d\x20\x65\x50\x54\x75\x6d\xb3\x24\xca\x82\x9a\xbf\xc8\x70\xe4\xca\xd9\xbf\xf9\x8c\xa6\xb7\xbe\xf3\x29\x6c\x4e\xbd\xa7\x1d\x75\x89\x1e\x8f\x7d\x52\x63\x6b\xd1\x6e\xb7\x40\xf4\x8a\x47\xdc\x43\xc9\x83\xa1\x4d\x46\xb1\x6a\xb7\xd0\xe2\xba\xb8\x14\x90\x05\xc3\x98\x02\xa9\xef\x38\x31\x52\xb8\x24\x1a\xa2\x21\x63\xf6\x6e\x18\xcb\x19\x75\xa6\xe2\x9c\x88\x76\xa6\xe2\x04\x96\xd7\xa2\x53\x1d\x14\xa6\xed\x1c\xe9\x65\xec\x76\x0b\xc8\xe3\xec\xbf\xf5\x58\x1c\x46\x28\xbd\x16\xd8\xd1\x44\xc6\xf8\x4a\x84\x0b\x1e\xc7\x11\x03\x05\xcb\x59\xbb\xa5\xbd\x08\x97\xbc\xd6\x82\x62\xd7\xec\x0d\xf2\xae\xe0\xe7\x1a\x5f\x80\xce\xf5\xa7\xb3\xd3\xff\x68\x9e\x32\xc3\x06\xf9\x99\xbe\x3f\x7e\xeb\x9f\xf5\xb1\x13\x26\xa1\xab\x2a\xa2\x9c\x1b\x00\x09\x92\x59\xec\xc3\xe7\x63\x86\x4a\xb8\xd6\x2c\xc8\x49\x9c\xb1\x40\x0e\xdb\xd1\x8c\x00\x19\x78\x58\x61\x46\x8b\x85\x4d\x22\xcf\xe4\x48\x62\xc7\x2d\xf7\xe8\xdd\x81\x2e\x7f\x10\xc4\xcc\xfa\x95\xa7\x56\x61\xa8\x10\x08\xc8\x4c\xbb\xec\xa0\x2c\xd6\x2e\xdb\x7e\xc9\xb7\x5a\x5b\xa5\x05\xfd\x41\xb1\xdc\x7f\x71\x1f\x67\xe2\xbe\xb6\xe6\x76\x0b\x40\x8c\x70\xe3\x0e\xda\x01\x78\x46\xb6\x1c\x99\xc3\xd6\x3a\x35\x8d\x95\xfd\xc1\x98\xf6\x22\x73\xee\x48\x78\xb7\x5e\xe4\x4e\x60\x1b\xd6\x24\xf1\x21\x38\x90\x4b\x34\x84\x9f\x4f\x42\x5e\xd2\x80\x87\xc7\x21\xa4\x1a\x85\xb7\xbc\x4a\xba\x4b\x6c\x84\xf1\x0d\x0b\x53\x36\x89\x53\xd8\x53\x3a\xe4\xb4\x5b\x11\xd0\x63\x2a\xdd\x58\xb9\x1e\x5a\x38\x36\x41\x70\xe9\x84\xe0\x69\x40\x2f\x8c\x21\xce\xfc\x11\xa6\xc3\x8b\xa9\xed\x34\x06\xa6\x9c\xf1\x67\x8c\x50\x46\xf2\xcd\x21\x32\xb3\xbc\x75\x2b\x07\xae\x8e\x03\x5d\xe6\x26\x09\x08\x82\xdc\xba\x0b\x0c\x17\xbb\xb1\x6d\x32\x0c\xad\x4a\x63\x19\x28\x91\x3e\x05\xa3\x6a\xf2\xf0\x79\xca\xe5\x28\x8c\x41\x6b\xa0\x76\xad\xb8\x4c\x91\x3e\x1b\xcc\xaa\xbc\x22\x25\x1d\xd6\x50\xc4\xcb\xda\x35\x72\x6e\x5c\x68\xbf\x42\x1e\x08\x11\xed\x18\xf9\x3b\x89\x0c\xe1\xc7\xd2\xdc\x59\x05\x73\xf6\x36\xa9\xe0\xce\x95\xe4\x5a\xb4\x64\x2d\x2c\x6a\x9f\xf5\xb9\x17\x15\x40\x03\x43\x1e\x46\x6a\x5a\x4e\x07\xb9\x8c\x05\x13\x3a\x0f\x19\x05\x4a\xab\x14\x27\x2d\xa6\xe3\xa3\xc5\x3a\x5b\xc4\x47\xdb\x6e\x8c\x90\xc8\xab\xb8\x65\x28\xa3\x5d\xc3\xe5\x13\x45\xab\x03\x71\xbb\x2e\xe3\x90\x51\xd7\xe3\x93\xb8\x2d\x07\x25\x93\x00\x0d\x64\x39\xe3\x6a\x12\x81\x59\xb8\x92\x33\x21\x7d\x2e\xc1\x58\xef\xc1\xdb\x29\x2a\x80\xa1\x60\x13\xd3\xf6\x00\xae\x02\x4e\x10\x85\xa3\x30\x5d\x1e\x74\x82\x4d\x48\x0c\xfb\x61\x4e\x10\x28\x9e\x9a\x49\xaa\x39\x66\xec\xc5\x8a\x8b\xac\x0c\x96\xfc\xed\xea\x59\x21\x8d\xc0\xdc\xdd\x00\x0c\xd6\xa3\x91\xef\x39\xd2\xe8\x1c\xd4\x40\x10\x28\x39\x87\x1c\xe2\x2f\x08\x30\x5a\x08\xfd\x71\xc5\x6f\x57\xe0\x9d\x5c\x06\xae\xc7\xe7\x8b\x39\x43\x41\x37\xda\xb6\x8e\xc1\x90\xdd\x99\xe1\x1f\x42\x71\x34\xcb\x0c\x07\x64\x8c\xc6\x97\x4b\x6c\x2a\xc8\x18\x8f\x28\x99\x82\x80\xe8\xed\xe3\xac\x0b\x1d\x55\x51\x9a\xae\x6d\x65\x87\xa3\x68\x2d\xd6\xeb\x31\xcb\x62\x07\x07\x4c\x38\x64\xd4\xec\x5f\xec\x1d\xcd\x32\xdd\x20\x9b\xd3\xb3\xe3\xfe\x19\xfb\xf8\x3f\x83\x2c\xab\x80\xd5\x6c\x0d\xd4\x59\x08\x6e\xed\x20\xe3\x8e\x4b\xc3\x97\xfa\x29\xf1\x5c\x13\x9f\x46\xa9\x6f\x7a\x9a\x5d\xcd\x78\x85\xd3\x62\xcc\x35\xb2\x48\xee\x6a\x00\x48\x27\xe2\x71\x51\x7b\x19\x87\x02\xca\x5a\x71\xbd\x2c\x13\xe2\x5b\x37\xa3\x8b\x0f\xda\xa1\xed\xdc\xcc\x56\x80\x48\x88\x0f\x30\x93\x72\xa6\x01\x3f\x06\x76\x63\x20\x32\x86\x51\xf3\xd1\xf9\x0a\x2c\xa9\xfd\xa0\x19\x4e\x02\xb5\x0c\x45\x96\xd6\xdc\x4e\xd7\x6b\xea\x0c\xe3\xb9\xa9\x4e\x35\x3c\xf6\x78\xbb\x15\x08\x89\x4e\x5b\x2d\x60\x00\x2d\xdd\x20\x4e\x53\xb4\xcc\x52\xd5\x60\x6a\x15\xd8\x10\x0a\x38\x5b\xd2\xc0\x87\x72\xfc\x6d\x8d\x73\xd3\xae\xe5\x89\x15\x49\x62\xe7\xdd\xb6\x7c\x1e\x80\xdd\x8e\x9d\xa3\x48\x80\xd3\x98\xe8\x14\x09\xd7\x47\xe6\x31\xf0\x6f\xd2\x0d\x0a\x60\xec\x7c\xe6\xd3\xb4\x63\xd7\x36\xbb\xa2\x96\x5b\x5f\xcc\xd5\xaa\xb9\xa5\x72\x8e\x6c\x8c\x14\x01\xf9\x0e\x4b\xbf\x2e\x43\x88\x0e\xa1\x73\x75\x7d\x56\x0e\x96\xc6\x98\xc6\x55\x74\xdf\x20\xaf\xba\xc0\xf4\xe2\x28\x90\xdc\x19\xc8\xd6\x96\x00\xbe\x5d\xd1\x69\x9e\x66\x69\xa8\xce\xb3\x98\x0c\x8f\x04\x20\xed\x2a\x4a\xf4\xb0\x51\x51\xde\x04\x80\xa8\x1a\xa1\x7d\x19\x35\x36\xd4\x09\x26\xa1\x36\x91\xdf\x2f\x36\x84\x20\xfe\xf7\x5f\x1e\x08\x0e\x89\xbb\xe7\xc1\x86\x26\xbd\x1d\x9d\x5e\x7e\xbe\xe8\xbc\xb6\x9f\xa5\x76\x46\x4e\x63\x46\x02\x7a\x29\xc8\x30\x5e\x17\x13\xde\xd5\x41\x61\x5c\xb6\xd5\x8a\x1d\xf5\x5d\x6f\xc8\x3c\x37\x02\xb0\x00\x0c\x12\xd2\xe3\xd8\xb4\xaa\x18\xdd\x60\xb1\x5d\x22\x21\x26\x29\x45\x1e\x2c\x43\x81\xb4\xb6\x7f\x10\xa1\x60\x23\x3e\x12\x72\xe6\xb0\xdf\x53\x3c\x3d\xc3\x12\x54\xa5\x22\x01\x4c\x9a\xa2\xa3\x20\xc1\x20\x94\xb0\x73\xb2\x0b\xa6\xf9\xd7\x35\x55\x00\xbb\xb8\x1f\x86\xc0\x5a\xa8\xf2\x8e\x66\xc4\x89\x7b\x7a\x84\x7f\x80\x1c\x90\x6a\xbd\x2a\xb5\x35\x5b\xab\x70\xa9\xe6\x79\x27\xe7\x29\xb8\xb6\x90\x69\x6b\x2b\xdf\xd9\x02\xfd\xa1\xe5\xd7\x51\x49\x8e\x35\xfe\x22\x98\x70\x15\xee\x7e\x52\xb4\xf8\x7f\xa0\xf8\xb4\x40\xf1\x06\x0f\xce\x43\x4f\x19\xb0\x48\x32\x9f\x0a\x0a\x8c\x25\xbf\x2d\x20\x20\xfa\x7d\x23\xad\xa7\x06\x57\x6b\x71\x55\x22\x85\xc7\x95\x2a\xa0\xd5\x8f\x06\x4f\x4b\x58\x08\x06\x06\xa8\xd1\x06\xe7\xcf\xb2\xf6\x81\x65\xd8\xb3\x75\xae\x5a\x03\x9a\x4a\x78\x49\xaf\x12\xc4\x9d\x2a\x4c\xda\x62\x7a\x39\x23\x8d\x9d\xbe\x94\x1d\xbb\x8c\xad\x96\x81\x96\x91\x0c\x9e\x32\x74\x62\x91\x56\x2f\x4e\x00\xb2\xf0\xb1\xb1\xdd\x46\x3f\xb2\xd9\xa1\x9d\xa1\xf0\x9f\x92\x5b\x54\x40\x93\x94\x75\xf7\x8e\xb7\x61\xde\xa6\x7b\x31\x6f\x22\x95\xc0\x7e\x72\x34\xf8\x8d\xc1\x2c\xe0\x27\xf4\x4b\xf7\x61\xcd\x27\xc3\x5f\xdc\x9b\xe5\x43\xe1\x04\x1b\x44\x80\x09\x72\x24\x20\x78\x12\xc9\xd5\x80\xd2\x55\x74\x38\x53\xb3\xb6\x6e\x7e\xe2\x03\xa9\x14\x21\xa9\xbe\xb0\x32\x67\x16\x24\xe7\x44\x4b\x86\xdd\x72\x08\x9d\x2a\x75\x65\x4a\xe9\x3b\x80\x50\x8e\x34\x0d\x8e\xd5\x10\xa1\x34\x96\xe9\xdd\xe2\x02\x9a\x23\x1c\xa8\x93\x38\x0d\x1f\x82\x8e\xf4\x10\x4c\xdc\x60\x1c\xd9\x0d\xda\xc5\x90\x17\x09\x7e\x69\x84\x9e\x04\x74\x24\xa7\xc3\xaa\x18\x70\x83\x90\x1a\x46\x37\x67\x7c\x14\xdb\x23\x32\xbe\x59\x1d\x13\x3e\x70\x84\x89\x0d\x6c\x46\x67\x38\x3a\x51\x27\x99\x83\xdb\x34\x62\xe7\xc6\xd3\xa8\x55\xa4\x1e\x82\xb0\x4b\x20\x01\xf7\xb9\x1d\x48\xa8\x02\x6c\xbc\x9f\xa0\x6d\xfc\x93\x1d\xd6\x4a\xc8\xac\x2c\x12\x52\x41\x08\xbb\xef\x68\xc3\x65\xa3\x09\x48\x6c\xc0\xd9\x8d\xe4\x2e\x58\x01\x68\xc4\x05\x7c\x69\xd9\x4d\x87\x50\x1b\x20\xfb\x0f\xc1\x24\xd9\xb4\x72\x7a\x6e\x4e\xa8\x20\x1d\x8c\x32\x9d\xa1\xab\x28\x70\xe6\xbd\xa8\x3c\x5d\xd4\xa0\xf6\x8a\xf9\xd4\x01\xa5\x68\x39\x1f\x97\x36\xb9\x3a\xc3\x66\x97\x11\xd7\x15\x09\x56\x3c\xce\x98\xe4\xb2\x5c\xbd\x17\x23\x58\x7c\x68\x14\x06\x40\x0e\x68\x8f\xd3\xa1\x76\xc3\xe5\xbd\xbf\x18\x8d\xb8\xbe\x5f\x61\xed\xb0\xa6\x99\x1c\xd0\x00\x04\xe1\xa9\x37\x24\xd5\x40\x36\x9b\xa6\x52\xdf\xf8\x40\x35\x93\x5f\x04\x21\xbb\x3a\x5e\x85\x18\xb4\x31\xde\x53\xe4\xde\xf1\x74\x0c\x46\x9a\x50\xd4\x2b\xf2\xe8\x0e\x35\xa7\x09\x55\x6f\x0e\x8b\xd3\x91\x07\x1d\xb5\xed\xb0\xcc\x42\xe3\xb0\x82\x51\x6f\x4b\x12\x7a\x00\xae\x6f\xbd\xce\x12\x26\x66\xea\x7d\xec\x62\xbf\x3c\x6c\xbc\x24\xae\x1f\xce\xaf\x3c\x59\x4c\xd6\x1f\x2d\x26\x9b\xce\x16\x51\xd6\x55\x10\x8d\xa1\x1e\x89\x34\x18\xd5\x9e\x4d\x8a\x84\xab\x35\x62\x20\xfb\x87\x28\xfa\x56\x5d\xf4\xaa\xa6\x95\x17\x67\x55\x0f\xdd\xc8\x0f\x34\xac\xa5\x92\x07\x55\x3e\x36\xd5\x66\x72\x83\xa1\x05\xbe\x9d\x33\xc0\x47\x45\xf5\xf8\x1a\x38\xc8\x9b\x8a\x3f\x3b\xec\xcf\x1a\xc6\x99\x08\xb7\x2d\xbb\x5e\x90\x01\xec\xc0\xfb\x0f\xd4\xf9\x13\x9d\xe9\x27\x9b\xea\xd2\x7a\xe9\xb9\x87\x6a\x33\xd9\xb2\xdc\xac\x48\x61\xed\x41\x7d\xb2\x74\x52\x9f\x11\xed\x65\xf5\xe5\x3f\x76\x72\xae\xec\x8c\x1f\xb6\xe9\x4b\x91\xe8\xff\xcd\xe4\xe9\x1e\x4b\xa4\xc1\x24\x04\x24\x82\xed\x94\xe1\x33\x8c\x46\x47\xc4\xd8\xd0\x8c\xfa\x35\xf8\xe6\x31\xf2\x6d\x03\x40\xd2\xe0\x7a\x9e\xef\x0a\xbe\xbf\xbd\xa7\xc6\x2b\x14\x8c\x4f\xa9\x01\xda\xa8\xe9\xed\xe1\x95\x43\x3b\xbd\x2d\x62\x7a\x8b\x16\xeb\xb1\x83\xd0\x5f\xaa\xaa\xf5\xad\x04\xf4\x2d\xfd\x07\xa0\xb8\x8e\x02\x36\x7c\x48\x9d\x29\x1f\xcc\x74\xf9\x97\x55\xc0\xba\x15\xd7\xb5\x8e\xe9\xd1\x2a\x4d\x51\x22\x48\xf5\x00\xb0\x46\x17\x8c\x8e\x65\x1b\x43\xbe\xcc\x54\x98\xf9\x9b\x2b\xfd\xd2\x6c\x2c\xf1\xf4\x6b\xf5\x62\x04\x09\x1a\x46\x1e\x77\x3d\xd2\x35\xf5\x23\x42\x33\x52\xca\x64\x34\xc0\xbf\x30\x64\xdb\xf4\x75\xc5\xc8\x2e\x15\x9d\x2c\x17\x0c\x56\x39\x22\x28\x47\xfb\xc8\xd8\x29\x17\xa9\x03\x1e\x60\xf5\x89\x06\xa0\xb1\xb9\x19\x3b\x14\xe2\x56\x99\x02\x35\xa5\x33\xf4\xfc\x40\xba\x71\x99\x97\x73\x65\x93\xa9\x69\xf3\x9d\xcd\xe5\x97\xe3\x0f\x17\x7d\x2b\x77\x8c\x2d\x2a\x3e\x3d\xa5\x56\x69\x9c\xf7\x2f\xca\x68\x5d\xaf\x77\x0e\xa6\xa0\x99\xa1\x6d\x67\x45\x44\x6f\xa9\x88\x78\xa7\x4d\xed\x8e\x4b\x85\x5b\xe3\x85\x01\xae\x2f\x5f\x46\x60\xab\xe1\x8a\x1a\xe6\x90\x42\x42\xe3\x7d\x8f\x09\x8a\x85\xd9\xdf\xb9\xd1\x84\x67\xf3\x07\x33\x5d\x5f\x2f\x1f\x72\xde\x6d\xbe\x0c\xca\x21\x47\xe5\x9c\xb2\x3f\xe5\xde\xca\x63\xca\x2d\xe8\xee\x72\x11\x34\x15\x88\x07\x3e\x04\x01\xfd\x63\x85\xe2\x51\xfd\x3f\x6f\x25\xaf\x26\xb9\x57\xfe\xfa\xb6\x85\xdb\x8e\x5c\x5d\x2f\x65\xc7\x46\x55\x22\xb4\x1d\xb5\xa5\xf7\x6e\x0e\x43\xe4\xa4\xd8\x8d\xeb\x95\xe7\x92\x6f\x66\x0d\xa9\x10\x4e\x79\xab\x8f\xf2\xef\x75\x32\x7a\x31\x6e\x9e\x71\xb8\xd9\xcb\x8f\xfb\x27\xfd\x1d\xbd\x5c\x4f\x61\x8f\x38\x54\xd0\xf5\xf6\xfe\xfe\xb6\xfc\x00\x07\x7b\x66\xef\xfa\x13\x04\x77\xe3\x68\x7b\x31\x00\x00"
+
+func oracleIndexGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleIndexGoTpl,
+		"oracle.index.go.tpl",
+	)
+}
+
+func oracleIndexGoTpl() (*asset, error) {
+	bytes, err := oracleIndexGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x9b\x10\xa4\xd2\xe6\xa8\xef\x2d\xf2\x90\x35\x0e\x96\x2e\xb3\x3b\x27\xdb\x02\x14\x45\x22\x4b\x94\xcd\x40\x26\x6d\x8a\x4e\xed\x19\xfe\xdf\x7b\x47\x5a\xb2\x64\xc9\x49\x90\x14\x58\x0a\xec\x21\x0e\x29\x1e\xbf\xfb\xc1\xef\x8e\xc7\xd5\xea\x08\x0e\xf2\xb1\x54\x1a\xde\x1d\x83\x6f\x46\x22\x9a\x30\x08\xaf\x96\x53\x16\xf6\x68\xe8\x31\xa5\x3c\xf0\xf2\x59\x96\x6b\x1a\x24\x43\xfc\x99\xe1\x9f\x62\x39\xfe\x5e\xf7\x2f\xe4\x08\xff\xcb\xa9\xce\xbd\x00\x8e\xd6\x6b\x77\x45\xa8\xaa\x15\x76\xc0\xd2\xe7\x21\x6f\xcc\xac\x2a\x60\xa9\x8e\x86\x19\xb3\x2a\xe2\x31\x9b\x44\x5b\xfc\xcb\x9d\xf9\x15\x49\xda\x5f\x52\x5d\x81\x41\xed\x75\xa4\x59\x66\x27\xcf\xc0\xba\x93\x5c\x34\x6d\xfa\x88\x5f\x6b\x40\xe5\x87\x07\xad\xda\x01\x2b\xcd\x7a\x0e\x5c\xca\x59\x96\xe4\x04\x14\x9e\xd9\x61\x35\x8c\x67\xdb\xd5\x41\x39\x23\x81\xb7\x6f\x61\xb5\x82\xf0\x53\x36\x57\x51\x66\x8e\x6c\xbd\x06\xc5\xf4\x5c\x89\x1c\xf4\x98\xd1\xea\xd4\x2c\xf2\x7f\x77\x0f\x17\x25\xa3\x3c\x97\x31\x8f\x34\x4b\xe0\x2b\xd7\xe3\x62\x47\x58\x13\xd2\x63\x25\xe7\xa3\x31\xbc\xc1\x95\x4a\x00\xd7\xeb\x37\xa1\x9b\xce\x45\x0c\x3e\x2d\x58\x32\xa1\xf8\xcf\xbb\x00\x41\xd3\x44\xda\x11\xeb\xc5\x34\x52\xd1\x04\xa7\xc9\x10\xae\xfb\xa7\xbf\x76\x80\x88\x04\x61\x18\x5e\xf7\xfb\x53\xcd\xa5\x08\xc0\xff\xfc\xc5\x00\xee\x58\xde\x01\x64\xa6\x54\x08\xed\x3a\x14\xa4\x85\x94\x66\x43\x8e\x6b\xc5\x17\x2e\x90\xb4\xf3\x09\x13\x1a\xfc\xa9\x42\xb3\x6b\x39\x13\x7a\x55\x9b\x82\xbd\xe4\x01\xef\xb2\x7b\xd1\xfd\x70\xe5\x19\xe4\xfb\x48\x91\x66\xab\xdd\x75\x1d\x8c\x3f\x9e\x3b\xcc\xe6\x4c\x2d\x49\x2f\xcd\x12\x16\x67\xdb\x9c\xc1\x88\xdc\x5a\x04\xb8\x85\x5f\x5c\xc7\xb9\x25\xdf\x65\x36\xc5\x63\xe5\x0b\xca\xb9\x7c\xab\x7b\x73\xb2\xde\x66\x9f\x95\x3f\x1b\xf4\xff\x00\x13\xe2\x4a\x22\xd0\x31\x17\x02\x1f\xfb\xe7\xbd\x42\xa0\x7a\x3e\x70\x07\x7d\xb3\x50\xaa\xa3\x55\x4b\xc7\x2d\x8d\xbc\x3b\xaf\xca\xaa\xba\xee\x7f\x7e\xeb\x0e\xba\x35\x08\xe3\xaa\xc5\xa8\x00\x78\x70\xd2\x3b\x05\xda\x89\xb2\x3c\xad\xa4\xa6\x4c\xf5\x29\xcb\x98\x66\x46\x9a\xa0\x49\x52\x85\x14\x2b\x5c\x4b\xcc\x5a\x2c\x45\x52\xee\xb1\x20\x4c\x90\xf0\xad\x8d\xb1\x9a\x8b\x22\xc6\xa6\xe6\xf8\x36\xba\x1d\xb2\x4c\x45\x62\xc4\xe0\x80\x77\x30\x89\x4c\x86\x54\x4c\x2b\xcc\x39\xe0\x86\x33\x25\xac\x71\x48\xdc\xb3\x85\x2e\x88\xeb\x73\x91\xb0\x45\x99\x87\x07\x3c\x20\xb8\x8a\x25\x81\x49\x46\xc4\x42\xd5\x0b\xc3\x05\xac\x81\xa4\xaf\x9d\xa0\x2b\x14\x20\xa2\x1c\x1b\x79\xf2\x36\x19\xa6\x02\xc9\x84\xfe\xc6\xda\xdb\xe6\x40\xa4\x46\x26\x03\x3a\x70\x88\x80\x1d\xf8\x2f\x3c\x73\x10\x88\x8c\xfd\xe9\x18\x04\xcf\x28\xa7\x1c\x5b\x43\x68\x6a\x52\xcd\x75\xd6\x85\xff\xa5\xa7\x9f\x14\x9f\x44\x6a\xf9\x3b\x5b\x52\x38\x6c\x2e\x30\x0d\x6c\xc1\x73\xcd\x44\xcc\x5c\x27\x95\x0a\x6e\x8c\x71\xc5\x65\x83\xc7\x8f\x8e\x58\xc7\x28\x7e\xa4\xaa\xb6\x1c\xde\x98\xfd\x39\x06\x0e\x93\x97\x15\x7a\xad\xa5\x76\x98\xe5\x8c\xec\x18\x31\xc1\x14\x8f\xf3\xe2\x2c\x8c\x9d\x84\xbe\x90\x7f\x12\x55\x4e\xb2\xec\x73\xcb\xc1\x7c\x69\xc4\xfd\x75\x47\xdc\xb8\x4b\x2e\xce\x4a\x07\x93\xe1\x96\x4f\xc6\xd5\x06\x9d\x5e\xaf\x4b\x4e\xc2\x52\xa6\x60\x16\x7e\xc8\x64\xce\xfc\xc0\xf2\x26\x93\x51\x42\x7c\x98\x67\x3a\x7f\x42\x62\x11\xaf\x66\x61\x0f\xed\xf3\x83\x26\x85\x68\x6f\xdb\x46\x23\xf7\x10\x85\x1d\xc7\xd9\xb0\xef\x9d\x21\x5f\xc7\x22\x93\x83\x47\x66\x99\x58\x6e\x68\x1e\x47\x02\x47\x36\xbf\x67\x78\xcd\x47\x82\xc2\x6f\x82\xd3\x5e\xd1\x37\x17\x90\x77\xe8\x15\x86\x06\x36\x68\x2d\x51\x6b\x86\xcd\x2a\xa6\xb0\x1c\x43\x34\x9d\xa2\x41\xbe\x21\xfc\x61\xcd\xef\xc0\xc4\x77\x83\x47\x76\x75\x95\xf2\x83\xf7\x4f\xe5\x99\xcd\x30\xb7\x58\x37\x0a\x50\xc8\xc5\x6f\xe8\xf2\x49\x92\x50\x4c\x1b\x8d\x43\xd9\x65\x34\x7a\x8b\xfd\x0d\xc5\x70\x09\x78\x37\x33\xa5\xb9\x18\x41\x04\x4a\x7e\xc5\xb9\x96\x2f\x68\x30\xea\xd6\xed\xeb\x2e\xea\x2c\x69\x6f\x2b\x9a\x1d\x88\xb9\xea\x5f\xd0\x67\xa0\x6d\x9e\x35\x2d\xd8\xdf\x09\x82\x77\xde\xbb\xec\x0e\x1e\xee\x32\x6c\xd0\x1e\x6b\x36\x2c\x10\x9c\xf7\xae\xfa\xad\x4d\x81\x5f\x6b\x43\x36\x74\xad\x15\x85\x0e\x34\xd6\xb6\xfd\x01\xde\xc9\x76\x7f\x00\x7f\x9f\x5c\xfc\xd5\xbd\xdc\x01\xbc\x8f\xb2\xbc\xe2\x67\x63\xd7\xf7\xb8\xd1\x9f\x58\x9c\xea\x15\xad\x15\xb5\xea\xd7\xe3\xb5\x50\xd5\xf5\x6d\x1b\xf3\xd6\x7a\x78\xd3\xd9\x64\x62\xb5\x5e\x77\x17\x2c\x7e\x51\xb9\xfe\x31\x7c\xdf\x14\x11\xaa\x2f\xb6\x7e\x0c\xd8\x44\xde\xb3\x6a\x09\x49\xf8\xe3\x35\x24\x55\x72\xb2\xb7\x86\x98\xd6\x91\x4a\x08\xc7\x94\xa5\x22\x62\xa4\x9f\x5f\x44\x1a\x26\xbe\xae\x3a\x62\xcd\x7b\x4a\x29\x39\xc5\xe7\xc6\x55\xf7\x25\x0f\x16\x8b\x00\xd5\x97\x47\xad\x86\xb4\xbc\x0e\x4c\xb1\x68\x3e\x0e\xd2\x88\xfa\x97\xed\xf3\xc0\x0c\x76\x77\x4c\xf0\xde\xe7\xbb\xa4\xac\xef\xf4\x33\x26\xaa\xc0\x81\xb9\xcf\xbe\xcf\x03\xe1\xc7\x48\xa9\xff\xcb\xc9\xa6\x9c\x7c\x03\x2f\x5b\x7c\x0b\x13\x13\x00\x00"
+
+func oracleManytomanyGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleManytomanyGoTpl,
+		"oracle.manytomany.go.tpl",
+	)
+}
+
+func oracleManytomanyGoTpl() (*asset, error) {
+	bytes, err := oracleManytomanyGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleMapGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x61\x6f\x1a\x39\x10\xfd\x0c\xbf\x62\x6e\x75\x4a\xd8\x1e\xd9\xf6\x73\x24\x4e\x6a\x13\x7a\xd7\xbb\x36\xb4\x25\xd2\x45\x42\xa8\x18\xd6\x80\xd5\x5d\x1b\x6c\x73\x81\x43\xfb\xdf\x3b\x33\x5e\xc0\x01\x54\x45\xd5\x55\x0a\xcb\xda\xf3\x3c\xf3\x66\xe6\x79\xc8\x76\x7b\x05\xbf\xaa\xdc\xc1\x75\x07\x5a\xa5\x58\x2c\x84\x15\x25\x64\x30\x15\x85\x93\x29\x5c\x55\x55\x73\x1b\x20\xc7\x08\x6f\x57\x31\xc0\xcd\x8d\xf5\x8c\xe1\x37\x2d\x4a\x09\xd9\xfd\x66\x21\xb3\x3b\x7a\x4d\xa4\xb5\x09\x24\x6e\x59\x38\x4f\x2f\xf9\x18\x1f\x4b\xfc\x58\xe9\xf0\xf9\xd0\x7b\x6f\x66\x49\x60\x92\x28\x8d\x3b\xc2\xce\xc8\x40\xaf\x8a\x0d\x90\x98\x85\x77\x49\x14\xd2\x8b\x71\x21\x43\xc8\xc9\x5c\x96\x02\xb2\x7e\xfd\xcd\x71\xef\xc9\x1c\x9e\x44\x21\xe6\xba\x2c\xa2\xb3\xbb\xc5\x33\x4e\xbf\x7c\x09\xdb\x2d\x64\x1f\xc4\xe2\xed\x4a\x4f\x38\xb1\xaa\x02\x2b\xbd\x55\xf2\x5f\xe9\xc0\xcf\x25\x58\xf3\xe8\x60\x6a\x4d\x09\x97\x88\xad\x49\x56\xd5\x25\x3c\xce\x8d\x93\xfb\xf3\x4a\x16\x79\xb6\xf3\xa0\x1c\x28\x5d\x7b\xe7\x1a\x54\x55\x1b\xbe\xca\x8d\xcc\x61\xbc\x39\x7b\x24\x8b\xa0\xf0\xa8\xfc\xdc\xac\x3c\x08\x0a\x0e\xc2\x4a\x28\x95\x73\x4a\xcf\x02\x0f\x62\x85\x8d\xcb\x30\x00\xc5\xf8\x43\x6a\x69\x85\x47\xdf\x6c\x55\x3a\x97\x6b\xe6\x9a\xbd\xa3\xd7\xf0\xac\xc3\x5c\x66\xcd\x29\x66\x7a\x26\xeb\x16\x6e\x4d\xfc\x3a\xa8\xa1\xaa\xf2\x31\x3c\xf4\x6e\xdf\xb4\x63\x5a\x83\x21\x2e\xb0\x38\x58\xce\x28\x01\xaa\x2e\xe7\x47\xed\x84\x2c\xcb\x1e\x7a\xbd\x85\x57\x46\xa7\x2c\xaf\xc1\x77\xce\x0c\x5f\x10\x8f\x83\xa8\xc8\x0b\xea\xca\xd8\x14\xb6\xcd\x06\xb5\x76\x6d\x0c\xfb\xa2\xf8\xbb\x1d\xa5\x51\x72\xab\x52\x6a\xff\x34\x87\xb3\x7d\x86\xa4\xdf\x7d\xdf\xbd\xb9\x4f\xd8\x01\x8a\x93\x34\x52\x8a\xaf\xf2\x47\xb8\x15\x52\xb7\x0e\xf5\x48\xd3\x66\xb3\x81\xf5\x5f\xae\xa4\xdd\x80\xf0\x50\x1a\xe7\xb1\x6a\x6f\x84\x9f\xcc\xfb\xea\x3f\x19\xd7\x4e\x50\x37\xbd\x2a\x65\xb3\x31\x35\xf6\xd8\x13\xfc\x0e\xaf\x28\xe5\x86\x26\x7a\x47\x46\xdc\x56\x53\xd0\x88\x79\xe2\x1b\xb7\x11\xde\x89\x37\x71\xab\x42\x4e\x44\x6a\xbc\x52\x45\x0e\x8b\x42\x4c\xe4\xdc\x14\xb9\xb4\x0e\x84\xce\x81\x6e\x21\xf9\xd3\xfb\x32\x0c\x86\x58\x4d\x94\x56\x1b\x34\x45\x22\x40\x64\x53\xda\x4b\x3b\x45\x27\xdb\xaa\x06\x10\x7b\xb5\x93\x05\x65\x86\x60\x2b\xf4\x2c\xce\x76\x70\xad\x87\x81\xa0\xd2\x03\x35\x44\x92\x68\xd3\x7e\xce\xe2\x9a\x19\x9e\x00\xd4\x8e\x10\x6e\x8f\xc0\x9b\x8b\x6b\xf6\xbb\x6f\x47\xc0\xd1\x27\x2a\x66\x27\x8e\xa5\xaf\x87\x75\xce\x78\x3e\x34\x03\x97\x61\x30\x11\xb9\x51\x10\x00\x8c\xe0\x37\x8a\x38\x22\x9d\x9b\x82\xe6\x99\xab\xfb\xcb\x81\xd8\xf1\x0e\xf3\xf6\x73\xef\x03\xc7\xd8\x0f\x93\xc8\xf8\xcf\x9f\xdd\xcf\x5d\x38\xb8\x89\xb4\x73\x63\x0a\x42\xbe\xbb\x83\x16\xa2\x21\x54\xd6\x65\x7f\xa1\x66\x5b\x4a\xb7\x21\xc1\xbf\x14\x0d\xa3\x14\x8f\x63\x53\x43\xfc\xbe\x99\xfa\x5b\x59\x48\x2f\x77\x29\xc3\xeb\xbb\x5b\xae\x08\x5a\x72\xb6\x4c\x0c\x76\x6f\xa7\x4d\xb4\x48\x4d\xb8\x51\x9d\xb9\x5d\xe9\x7d\xe6\x3c\x7a\x5b\x21\xff\x36\x37\x1c\xef\x64\xca\x83\x12\x23\xe2\xfe\x3a\xd4\x94\xe7\x1a\xd6\x67\x70\xaa\xf4\x2d\xd9\xf1\x22\x92\x99\x0e\x64\x08\xc8\xc7\x53\x8d\x97\x09\xc9\x4c\x7c\x72\x98\x17\xd4\x30\x9a\x16\x6d\xb8\x20\x87\x6d\x38\x09\xcc\xea\x25\x67\xbf\x74\x40\xab\x22\xe8\x02\x6f\xdd\xca\x6a\x5a\xf3\x8d\xaf\x5b\x4c\xda\xfa\x12\xb4\x15\x7e\x7e\x62\x79\x31\xdf\x2d\x0b\xe1\xea\x50\xbb\x8f\x56\x95\xc2\x6e\xfe\x96\x9b\x5a\x51\xf1\xe1\xec\x8b\x5c\x2b\x87\x53\xa9\xc3\xbf\x6e\xf5\xd9\x50\xba\xc0\xc2\x0d\x9e\xe0\xcf\x4d\xe6\x5a\x9c\x7b\x10\x73\x65\x3f\xf8\x8b\x4a\x44\x66\x34\x7f\xd5\xc4\x1d\xca\xca\x39\x11\xf3\xb5\xf9\x44\x5d\x79\x5d\x14\x83\xe3\x1a\x0f\x4f\x0a\xf8\xf3\x2b\xf7\x7f\x24\xcc\xeb\xe5\x3e\xc3\x7c\x7c\x10\x07\xe7\x7a\xa2\x8d\x1f\x4f\x2b\x68\xbb\x30\x22\xc7\x29\xed\x56\x85\x77\x75\xa6\xcb\xec\x4e\xae\x7d\x2b\x0d\x47\x8f\x93\x3e\x51\x73\x00\x7d\x4f\x34\x8d\x5a\x28\xd7\xac\x93\x76\xed\x95\x84\x72\x15\x00\x4c\x86\x67\xcc\x44\x68\x7a\x25\xfe\x1d\x24\xd2\xc7\x35\xa5\x3b\xa5\x0a\x9e\x19\x2a\xad\x05\x8e\x00\x0f\xc9\x45\x52\x93\x4c\xeb\x91\x7e\xae\x08\x8d\x65\x76\x53\xe0\x3f\x15\x2d\x06\x9c\xa9\x49\xcd\xe3\xd9\x6d\xbc\x38\xe9\xe3\x81\x79\xd7\x5a\x8e\x13\xc7\x7c\x66\x63\xe2\x5b\x44\x8c\x6a\x0c\xd2\x6a\x13\xb0\x59\x35\xbf\x01\x9b\xa2\xf7\x09\x87\x0a\x00\x00"
+
+func oracleMapGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleMapGoTpl,
+		"oracle.map.go.tpl",
+	)
+}
+
+func oracleMapGoTpl() (*asset, error) {
+	bytes, err := oracleMapGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.map.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleMockGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xcd\x8f\x9b\x46\x14\x3f\xc3\x5f\xf1\x8a\x72\x80\x8a\x90\xfb\x4a\x3e\xb4\x49\x23\xad\x94\xed\x1e\x92\x55\x23\xad\x56\xd5\x04\xc6\x36\x2a\x30\x74\x18\x76\xbd\x75\xf8\xdf\xfb\x66\xde\x80\xc1\x06\x6c\x6f\xd6\xea\xa5\x17\x83\x99\xf7\xf1\x9b\xdf\xfb\x98\x37\xdb\xed\x5b\x78\x53\xad\x85\x54\x70\xb5\x00\xdf\xbc\x15\x2c\xe7\x10\xfd\xae\x7f\x3d\x2e\xa5\x07\x5e\xf2\x0d\x7f\x44\xa9\x2a\x7c\xa8\x8d\x17\xc0\xdb\xa6\x71\xb7\x5a\x35\x1f\xd3\xf5\x4b\x99\x16\xaa\x35\xf1\x59\x09\xc9\x6f\x44\xfc\x17\xea\x8d\xdb\x03\x2f\xe7\x6a\x2d\x12\x7c\x61\x72\xa5\x3f\xc6\x2c\xcb\xcc\xd3\x6b\xd1\x45\x1f\x53\x9e\x25\x55\xcf\x75\x5d\x26\x4c\x71\xed\xba\x40\x97\x4b\xbd\xac\xbd\x57\x79\x9d\xa9\xb4\x95\x6f\xd5\x7d\x92\x4e\x57\x05\x82\x81\x28\x40\x28\x9e\x31\xf5\xee\x1d\x6c\xb7\x16\x6a\xd3\x74\x58\x21\xad\x80\x41\xae\xdf\xf6\x97\x41\xf2\x58\xc8\x24\x2d\x56\x90\xaa\x0a\x0c\xd4\x08\xed\x68\x53\xbf\xb1\x78\x0d\xb4\x19\x5a\x00\xb5\xe6\x90\x33\x15\xaf\xb5\xfc\xc7\xba\x88\xc1\x20\x85\xa7\x35\x2f\xa0\xe2\x2a\x04\x56\x24\x20\x50\x4c\x3e\xa5\x95\x36\xae\x6a\x59\x54\xda\xd8\x3f\x5c\x0a\x78\x64\x59\xcd\xd1\xbe\x7a\x2e\xf9\x38\xd2\x4a\xc9\x3a\x56\xb0\x75\x9d\xeb\xa2\xe2\x52\x91\x13\xfc\xf1\x51\x3c\x56\x9b\x92\x49\x96\xa3\x06\xfe\xb3\x64\x34\x0d\xfc\xdc\x33\x15\x82\x0e\x05\x44\x51\xf4\xf5\xf6\xb6\x54\xa9\x28\x02\xc0\x38\x09\x69\x78\x4e\x97\x1d\xd5\x48\x97\x73\x67\x5e\x5f\xd1\x87\xf3\x99\x3d\x92\x3d\x78\x45\xd4\x1c\x49\xd5\x70\x3f\xf0\x8c\xbf\x2a\x5c\x6d\x5c\xb2\x62\x85\x49\x74\x5d\x24\x7c\xc3\x2b\xb2\x83\x34\x45\xda\x8d\x35\xd0\x52\x47\x42\xd1\x75\x75\x57\xa4\x7f\xd7\x44\x21\x4a\x4b\x5e\x0a\x9b\x26\x11\x7e\x9b\xc1\xb7\x12\xe6\x4f\x96\x56\x5d\x0d\xc0\x92\x65\x98\x29\x18\xf6\x29\xa8\xbe\xd9\xcb\x17\x4c\x99\xdd\x86\x0c\xfc\x80\xc8\xd1\xea\x97\x45\xf2\x09\xa5\x3a\x34\xf7\x0f\x47\xf0\x50\xb0\x76\xaf\x28\x6d\xbf\xb9\x4e\x5e\x63\x62\x40\xf5\x5c\xc4\xd1\x4d\xad\xf8\xc6\x75\xa8\xb0\xee\x1f\xc6\xaa\xe1\x3d\xae\xb9\xa8\x36\x51\xd6\x7a\x99\x4a\x5b\x1b\xb1\x95\xcc\x13\xf8\xf6\x3c\x2a\x3e\x53\x76\xc6\xd2\xae\xf4\xd0\xdf\x0d\xb1\x98\x52\xcd\x9b\x46\x28\x96\xe6\x5d\xfb\x42\x27\x44\x73\xe4\x3a\x56\x12\xb5\xb1\x29\xb8\x46\xf9\x17\xec\x7b\xc0\xb0\xb9\x68\x79\x6c\x82\x75\xce\x0b\xe4\xb2\x67\x00\x19\xdb\xc4\x59\x6d\xfa\x8e\xf9\x26\x0a\x64\x43\xa1\x39\xa3\x7b\xff\x80\x2d\x97\xcb\x25\x8b\xf9\xb6\xb1\x0c\xd0\xf6\xec\xa3\xdb\xb4\x12\x1d\x12\x1d\x68\xd0\x91\x6e\xfb\xf8\x5e\x19\x74\xbb\x0d\xac\x11\x3f\xef\x43\x0f\x35\x52\x13\xf0\x9e\xef\x40\xd3\x31\x30\x19\xe5\x75\xf4\x09\x8d\xf8\x81\xeb\x24\x7c\xc9\x25\x1c\x2c\xdf\x15\x19\x09\xec\xab\x52\xac\x17\xc0\xca\x12\x33\xc2\x1f\x59\x0c\x27\xc3\xb3\x25\x9e\xaf\xec\x76\x43\x43\xf2\x95\xc1\xdc\x04\x96\xa2\xf7\xc6\xbe\x6d\xba\x86\xd7\x2e\x27\x6c\xff\x16\x9d\xba\x90\x30\x48\x1a\x12\xd0\x8d\x5c\x5b\xca\xbb\xf0\xf3\xbc\x54\xcf\x67\x91\x6b\x50\x0c\xb9\x0d\x66\x12\xfc\x07\x19\x26\xdc\x78\x6e\x4e\x7b\xc0\x14\x72\x96\xb8\xdf\x3f\x43\x88\xb5\x24\x75\xbc\xb1\xd0\x20\x14\x07\xfb\x9c\xc5\xbe\x58\xe8\x73\xf5\xfb\x77\xc0\x62\xed\xbe\xd8\x35\x2d\xe9\xec\xc5\xd3\x46\x30\x46\xdc\x0e\xba\xd4\xf5\x4e\xb1\x20\x72\x6d\x90\xfe\x48\xd5\xfa\xcb\xa6\xcb\xe3\xb6\x22\xcc\xc9\xd9\x0f\xdd\xd8\x6e\x42\xa8\x44\xa7\x61\x8e\xd5\x9c\x25\x1c\x9e\xd0\x24\xa8\x8d\x29\xb9\x2e\xa0\x4a\xac\xb8\x3e\x88\xed\x2a\x2a\x99\x73\xb9\x3d\xe2\xcf\x08\x28\x21\xf6\xd1\xc1\xd7\xdb\x0f\xbf\x06\x87\x33\xc4\x41\x04\x6d\x7d\x79\xa4\xe9\x85\x08\x2e\xe8\xc8\x18\x88\x5a\x52\xe8\xb0\x1f\x27\x85\x58\xde\x8d\x03\x67\x61\x27\xb5\x1f\x3e\x29\xa7\xb7\x48\x0e\x3c\x53\xb8\x9d\x51\xb2\x83\x5b\xc6\x64\x1a\xaa\xf5\xa6\x9a\x9f\x70\xd6\x4b\x4d\xfa\x8f\x32\xd3\x13\xb5\xf0\xb1\xd6\xf7\xc0\x93\x1f\x84\x1b\x0c\xd2\x0d\xcd\xba\xcd\xc8\xbc\xa3\x99\xa6\x91\x67\x8e\xe9\xdd\x50\x74\x16\xd3\xa4\x76\x41\xa6\xc9\xc1\xc9\x4c\xf7\x66\xbb\x63\x4c\xef\x44\x5f\xc6\xb4\xe6\x55\x0f\x7e\x73\xac\xb6\x83\xe1\x59\x9c\x6a\xa5\x0b\x32\xaa\xcd\x9f\xcc\x67\x37\xd9\x1e\x63\xb3\x15\x7c\x79\xd6\xb6\xe3\x12\xd2\x4a\x23\xef\x1c\xb1\xbb\xa1\xf8\x2c\x6a\x49\xed\x82\xe4\x92\x83\x93\xe9\xed\xcd\xf6\xc7\x08\xde\x89\xbe\x9c\xe2\x53\xa7\xfe\x37\xf6\xbc\xd3\xc7\xe6\x70\xb8\x6e\xc7\xd2\x56\x02\x19\x9b\x89\xd2\x40\x90\x82\x35\x7d\xa9\x98\x0c\x63\x7f\xe8\xee\xc5\x72\x60\xfc\xe2\xb7\x8e\xe9\x90\x0f\x70\x78\x13\x9e\x8d\x4f\x72\x3f\x97\x0f\x07\x84\x1d\x4d\x8b\x03\x8d\xfd\xec\x98\xe6\x61\x08\x67\x2c\x6d\xc2\x7e\x79\xda\x1b\xd7\x7f\x19\xa6\x53\xaf\x64\xff\x07\x6b\xe6\x3a\x8a\x05\xfc\xc8\x65\xba\x1c\xbf\x2f\x42\x9a\x97\x19\xa7\xab\xdb\xfe\xba\xfb\xc8\x70\x9e\x3e\x9c\x04\x17\xb6\x6e\x0e\x82\xef\x23\xa2\xc0\xfd\x17\x7f\x9e\xed\x58\xa1\x13\x00\x00"
+
+func oracleMockGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleMockGoTpl,
+		"oracle.mock.go.tpl",
+	)
+}
+
+func oracleMockGoTpl() (*asset, error) {
+	bytes, err := oracleMockGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.mock.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleOptionalGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x55\x4d\x6f\x9b\x40\x10\x3d\x9b\x5f\x31\x8d\x5c\x09\x47\x84\xe4\x1c\xc9\x87\x24\x6a\xa3\x1e\x52\x59\x6a\x7a\x8a\xa2\x6a\xc1\x8b\x41\x86\x5d\xba\xbb\x4e\x14\x21\xfe\x7b\x67\x66\xb1\x8d\x63\x43\xda\xde\x76\xe7\x6b\xdf\xcc\x7b\x03\x4d\x73\x01\x45\x06\xf1\x83\x5e\xca\xf2\x51\x2f\x6e\xa1\x6d\x83\x06\x8d\xd3\x7a\xbd\x82\xeb\x39\xdc\xeb\x85\x48\xd7\x62\x25\xbf\x8b\x4a\xf6\xe2\xee\xb4\xca\x8a\x55\xfc\xad\xaa\xb5\x71\x3f\xa4\x79\x29\x52\x09\x17\xdb\x64\x9b\xa3\x95\xd2\xf9\xa0\x38\xf5\xf1\xad\x96\x31\x57\xa1\xb0\xcb\x4b\x68\x9a\xbe\xb1\x6d\xf7\x18\x52\xad\x5e\xa4\x71\x16\x5c\x2e\x8f\xc2\xc0\x69\x28\xd0\x57\x1b\x8d\xa7\x4a\x5a\x8b\xe8\xe2\x20\xdb\xa8\x74\xb8\x64\x88\x9e\x0e\x15\x56\x38\x7f\x1f\x37\x83\x90\x6c\xdc\x74\xdb\xc6\xef\xdd\x11\x48\x63\xb4\x99\x41\x13\x4c\xd0\x57\xed\x80\xc6\x34\xae\x36\x38\xd5\xcd\xe2\xf6\x51\xf3\xf3\x87\xdd\x1c\xa0\xa6\x56\xc4\x51\xe6\x40\x2f\xbb\x82\x21\xd7\x38\x1a\xcb\x58\x03\x5d\x7f\x63\x4d\xed\xf1\x8e\x34\xc5\x01\x96\x5a\xb7\xe3\x24\x59\x6a\x0d\x1d\x85\x39\xec\xd8\x8e\x11\xe5\x0b\x87\x46\xbf\x5a\x78\x7a\x3e\x45\x92\xb7\xfe\x0d\x4d\x46\x5a\xd2\x5f\x25\xd6\xf2\xc3\xac\x52\x2a\x7e\x74\x36\x0b\x26\x99\x36\x50\x44\x80\x57\x4a\x37\x42\x21\x4b\x0c\x08\x6b\x4e\xea\x84\x9f\x20\xcf\xb0\xd2\x30\x1a\xeb\x4c\x70\xab\x28\xf4\xd3\x1c\x54\x51\x72\x36\x62\x72\x1b\xa3\xe8\xce\x65\xd0\xd4\x06\x64\xb5\x4f\xc5\x33\xcc\xa1\x4e\x02\x34\x04\xdb\x30\xb4\x47\x14\x3b\xa8\x2f\xdb\x11\x66\x47\x14\xc6\x3c\x1c\x91\x33\xa8\xb0\x5d\xc9\xb0\x4e\xb6\x1c\x8c\x68\xea\x04\x49\xe3\x1c\x9c\x18\x3c\x3e\xd4\x9b\x7b\x9d\xec\xc7\x4e\x10\x68\x6e\x38\xd0\xc1\xb1\xf7\x96\x22\xf9\xbf\xa9\x63\xf5\xa1\xb1\x37\xfe\xe3\x58\x09\xbb\xce\x0a\x59\x2e\xad\x5f\x8d\x53\x6c\xdc\xd4\x75\xf9\xf6\x95\x82\x1e\x30\x1a\xac\xec\xc8\xe8\xf2\x74\x06\x07\x1f\xa0\x42\x79\xaa\x84\xcb\xd9\x49\x4f\x20\x53\x54\xf9\x30\xeb\x88\x50\x94\x26\xe3\x2c\xd4\x8a\x9d\xa9\x2e\x37\x95\x62\x9a\x37\xf5\x52\x38\x09\xaf\x85\xcb\xa9\xd0\x4f\xbe\xde\x79\x7f\x0c\x37\x65\xd9\xaf\xed\x72\xe1\x20\x15\x0a\x12\xd9\x25\x2e\x41\x18\x49\xc8\xe1\x35\x97\xca\x43\xca\x85\x05\xc5\xb0\x18\xea\x80\x6e\x0e\x9b\xff\xe0\x53\x1b\xc1\xbf\x7f\xbe\x22\x8f\xe6\x9c\xb1\xd3\xb1\x4e\xe2\xdd\x7b\xac\x43\xeb\x0c\x4e\xa4\x2f\x3e\x3f\x5b\x96\x9f\x5d\xc7\xf7\xd2\x2d\xc8\x10\xa2\x48\x90\x52\x16\x1e\xdd\x67\x30\x9f\xc3\x95\x5f\x6e\x4e\x98\xc3\xb6\x58\x03\xc4\xbf\x97\xe2\x14\xa5\x39\xcd\xb6\xd5\x7a\x62\x40\xa4\x58\x6e\x5a\x30\x48\xbc\x48\xb5\xc4\xe3\x19\x9e\xb8\x4b\xfe\xf3\x61\xa2\x37\x91\x13\xff\x7d\xd0\x7a\xc1\x21\x75\xfd\xfd\xd8\xb6\x70\x15\xf5\xe0\x75\x9b\xf1\x2b\x62\x02\x7a\xbb\xc1\x60\x09\xb6\x45\xbe\xd3\xdc\xbb\xe9\x8e\xef\x08\xe2\x63\x37\x2b\xc4\x49\x3f\xdc\xc9\x64\x29\x33\xb1\x29\xdd\xf5\xfb\xa5\xc8\x2a\x17\x7f\xa1\xb9\x65\xe1\x19\x67\xc1\xe7\xdf\x24\x0d\xa5\x5d\x4f\x1d\x67\x1e\xc2\xac\xdb\x1f\x06\x3f\xa7\xa7\xb0\xab\x90\x6e\x3b\x7f\x6f\x99\xbc\x7d\xbf\x4d\x7e\x3c\xfd\xe3\x1f\x3f\x69\xd6\x53\x81\x08\x00\x00"
+
+func oracleOptionalGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleOptionalGoTpl,
+		"oracle.optional.go.tpl",
+	)
+}
+
+func oracleOptionalGoTpl() (*asset, error) {
+	bytes, err := oracleOptionalGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.optional.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oraclePrototestGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x41\x4f\x83\x40\x10\x85\xcf\xf0\x2b\xa6\xc4\x26\xb4\x52\x7a\x27\xe9\xa5\xb1\xde\x34\x8d\xe1\x66\x3c\x2c\x30\x50\xe2\x76\x77\xdd\x5d\x30\x86\xf0\xdf\x9d\xdd\x62\xb5\x89\x36\x26\x1c\x36\xbc\xf7\xbd\x79\x33\xc3\xb0\x82\x1b\x0b\xd9\x06\xd2\xfc\x43\x21\xac\xc6\x31\x5c\xaf\x21\x47\x63\x87\x81\x94\xf4\x91\x1d\x11\xc6\x71\xbf\x7d\x92\x9d\xa8\x72\xdd\x2a\xb0\x24\x1a\x28\xa5\xe8\x51\xdb\x56\x34\x70\xe1\x34\x60\x25\xd8\x03\xb6\x1a\x94\x96\x56\xba\xb8\x23\x1a\xc3\x1a\x34\xc0\x44\x05\x05\x2b\x5f\x13\x78\x6f\xed\x01\x18\xe7\x93\xb5\x6e\x91\x57\x06\x0c\xda\xc4\x9b\xbc\x7c\x92\x44\xc7\x39\x2b\x38\xba\xa0\xc9\xe6\x7e\xa5\x61\xdd\x89\xf2\x6a\xd3\xd8\xc2\xd2\x95\xa5\x8e\x69\xbe\x80\x21\x0c\x4e\xd5\x69\xdb\x23\x53\xcf\xc6\x6a\x52\x5e\x96\x17\x38\x99\x82\xa8\x67\xbc\x43\x13\x65\x0e\x09\x02\xd2\x55\xe1\xc8\x69\x7a\x0a\x35\xe3\xc6\x99\x49\x1d\x13\x07\xb8\x42\x57\xfc\x56\x77\x3f\xec\x63\x18\x06\xb5\xa4\xc5\x68\x22\x1d\x82\x09\x7f\x7f\xcd\x44\x83\xd3\x6d\x5d\x8c\x2a\x12\x40\xad\x9d\x74\x51\xf0\x41\x56\xc8\x73\xb9\xdf\xc6\x8e\x5c\x90\xb3\xad\xbd\x71\xb6\x01\xd1\xf2\x53\x05\x9b\xde\x33\xcb\x78\x1d\x47\x73\x93\xc1\x19\xc9\x60\xde\x47\xc9\x34\x98\x18\x47\xbb\x36\x41\x23\xed\xef\xd3\xf6\xdb\x5c\x7a\x3c\x56\xc5\xbf\x66\x9d\x81\x3f\x67\x51\xc4\x4c\x63\xcd\xb1\xb4\xe9\x1d\xa2\xda\xbd\x75\x8c\xc7\xbe\x81\xdf\xe8\x2b\x75\xa7\xb5\xd4\x53\x2a\xa9\x30\xbf\xed\xa7\x6b\xd1\xeb\x9c\xfc\xcd\xf9\x7c\xfa\xc6\xf0\x13\xe9\x4d\x8e\xd5\xd5\x02\x00\x00"
+
+func oraclePrototestGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oraclePrototestGoTpl,
+		"oracle.prototest.go.tpl",
+	)
+}
+
+func oraclePrototestGoTpl() (*asset, error) {
+	bytes, err := oraclePrototestGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.prototest.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x6f\xd3\x48\x14\x7e\x4e\x7e\xc5\xac\x15\x21\x1b\x8c\xe1\x61\xb5\x0f\x95\xfa\xc0\x42\x57\x42\xcb\x52\x58\x78\x40\xea\x56\xe0\xd8\x93\xc4\xc2\x99\x49\xc6\x0e\x24\x8a\xf2\xdf\x39\x97\xb1\x3d\x76\x9c\x6e\x55\x68\x97\x6a\xf3\xd0\xd6\x99\xcb\xb9\x9f\xf3\x9d\xe3\x74\xbb\x7d\x2c\x46\xc5\x4c\x9b\x52\x9c\x9c\x0a\x9f\x9e\x54\x3c\x97\x22\x7a\xbf\x59\xc8\xe8\x35\x3e\x7a\xd2\x18\x4f\x78\xc5\x32\x2f\x4a\x7c\x48\xc7\xf0\x6b\x09\x3f\x46\x16\xf0\xfb\xc3\xf9\x2b\x3d\x85\xbf\x13\x05\xbf\x62\x33\x85\xb5\xe8\xed\x4a\x9a\xcd\x9b\xd8\xc4\xf3\x22\x10\x8f\x77\xbb\xe1\x16\xf9\x2c\x71\xf5\xb9\x9e\xcf\xa5\x2a\x0b\xe4\xc7\xe7\xea\x95\xfa\x20\x52\x21\x79\xe8\x06\x7d\x8a\x1c\x3a\xc0\x97\x76\x17\x26\x53\xa5\xf0\x1e\x7a\x8e\xb4\xce\x31\x95\xe5\x78\xcc\x83\xbf\x5e\xbd\x9a\x4d\x44\xf4\x2e\x89\xf3\xd8\x88\xdd\x6e\xbb\x65\x62\x40\xcb\xc8\x12\x48\x08\x3f\x53\xa9\x5c\x5b\x7a\x7f\x64\x32\x4f\x0b\xf1\x34\xa0\x8f\x81\xbd\x80\x64\xe9\x02\x3c\x5c\x75\xe7\x75\x96\x3b\xd7\xa4\x4a\x5d\x05\xca\x46\x32\xda\x06\xb1\x62\x38\x11\x9d\xab\x7c\x73\xae\xe4\x9e\x8c\x25\xb0\x24\xce\x7b\xc4\x50\xa1\xb3\xb5\x4c\x5a\x0b\xd6\xa4\xb4\xf6\xe4\x89\x80\x2b\x53\x9d\xd8\xb5\x7a\xd3\x9e\x97\x79\x21\x9d\x83\xec\xf3\xdd\x4e\x98\x95\x2a\x44\x2c\x92\x55\x51\xea\xb9\x28\xca\xb8\x94\x78\x2d\x14\x20\xcd\xca\xa8\x4c\x4d\x45\x39\x93\x42\xad\xe6\x63\x69\x84\x06\x05\x26\x13\x99\x94\x32\x15\x46\x7f\x2d\x22\xa6\x0d\x82\x5a\x36\x5f\xb3\x72\x06\x6a\xbd\x7d\x25\x88\x15\x72\x7b\x0f\xd7\xc9\xc3\x22\x2b\x4e\x78\x6d\x60\x45\x4d\xc1\x04\xb5\x80\x4c\x64\xb2\x52\x89\x2b\xa0\x0f\xcf\x49\xb9\x5e\x60\x94\xc1\xc7\x74\x2c\x3e\x9c\xbf\xf8\x1d\x16\x4d\xac\xa6\xb2\x15\x83\xb5\x8d\x95\x06\xfd\x5f\xc8\x49\xbc\xca\x51\xff\xb0\xa5\x30\x3e\xa3\xc7\x1a\x1b\x3b\x0f\xa1\xd0\x0b\x08\xd1\x28\x8a\x3e\x9c\x9f\x2f\xca\x4c\xab\x00\x1d\x5f\xfe\xf6\x6b\x28\x20\x3f\xb4\x09\xc4\x76\x38\x40\x71\xd7\x5a\xd3\x3e\x72\xad\x56\x32\x05\xa9\xb3\x62\xf3\x73\x4e\x79\xd6\x6b\xd5\x19\xb2\x03\xe9\x92\xb2\x78\x05\x1b\x80\x36\x81\x8f\x34\x0b\x9d\x27\x33\x99\x7c\xc6\x0d\xef\xa9\x47\x9b\x60\x44\x48\x4b\xbe\xdc\x84\xb7\x9c\x72\x3e\xe1\x89\x2f\x10\x44\x9c\xb9\xe0\x42\xc8\x97\x29\x2f\x51\x4e\x5d\x5c\x12\xe1\x49\x9c\xc8\x2d\x9b\x9a\x4d\x37\x2a\xe4\x94\xd2\xd3\xa5\x44\x81\x0b\x91\x4e\x81\xdb\x44\x2d\x9e\x85\x88\xaa\x8c\x45\x27\xe0\xc0\x3f\x25\x0b\x08\x27\x70\xd5\x39\x04\x66\xea\x44\x46\xc3\x34\x02\x77\x35\xdc\x2a\x7f\xbd\xb1\x1e\x46\x5b\x30\x83\xdd\xce\xaa\xf4\xe8\x54\x7c\x42\xb7\x71\x58\x7d\x6a\xe2\x19\xed\x40\xf7\xc0\xca\x8b\x98\x79\xf5\x5e\x5f\x6b\x0e\x11\x3f\x97\xca\x47\xab\x04\xa1\xc0\x47\xa4\xca\x04\x6c\x78\x04\x81\x4b\x60\xa2\x8d\xf8\x18\x8a\x2f\x68\x0d\x96\x7f\xef\x02\xc7\x43\x75\x61\x40\x16\x3f\x15\xf1\x62\x01\xaa\x13\x27\xb8\xde\xa2\xe9\xa4\xe3\x21\x69\x61\x4d\x95\x33\x0a\x93\xa9\x16\x5e\x2d\xb3\xd7\xb9\xd1\xc7\x0c\x76\xab\x72\xda\xd8\x34\xe8\x3a\xc3\x79\xec\x78\x77\x38\xd8\x3b\xe1\x3e\x3a\x62\xa3\xf1\x5f\xda\x90\x85\xaa\x01\xcb\x10\x72\x98\x49\x7c\x26\x81\xdc\x28\xeb\xc4\xaa\xa2\x93\x94\xb3\xa1\x90\x85\x62\x94\x37\x00\xd1\x04\x5b\x86\x17\x1e\xb9\xd9\x09\xab\xb6\xfe\x76\xe0\x65\x94\x61\xe5\x15\x5c\xd4\x0e\x9c\xe8\x64\x7a\xc5\x01\x95\xb0\x25\x16\xa3\x6b\x84\x55\xf7\x53\x7d\xd0\xd5\x9c\x32\x10\x0a\xa5\xcd\xc0\x01\x61\xa1\xcf\x1a\xe1\x4d\xf2\x03\x5a\x79\x00\x30\x43\x85\x02\xb5\x4a\xc7\x11\x6c\xa6\xe3\x89\x12\x1e\x16\x01\xaf\xa9\x66\xe8\x9c\xca\xe1\x6d\x02\x20\x1c\x5e\xff\xe5\x54\x20\x0c\x40\x6c\x0d\xb8\x0e\x8b\xa7\x44\x17\xbd\x33\xac\x96\xd6\xfa\x6f\x28\xc1\xcf\x6c\x3d\x06\xa8\x2a\x82\xe1\xae\x9d\x1c\x7f\xc5\x8b\x5b\x46\x0c\x32\x49\x17\x2d\x12\x9d\xaf\xe6\x70\x0a\xe0\x02\x3f\x82\x64\x54\xea\x32\x85\xb4\xb4\x49\xa5\x09\x09\x08\xdd\xcd\xb8\x10\xf3\x78\x51\x88\xcf\x72\x03\xe0\x32\xde\x58\x22\x02\xfb\x94\xff\x05\xcc\x5c\x5c\x72\xe9\x0e\xa1\x62\x83\x25\x2e\xf8\x93\x5b\xbc\x6f\x8a\x41\xde\xbb\xb3\x57\x67\xcf\xdf\x7b\xe2\x86\x30\x04\xa1\x18\x0a\xdb\xc5\x1c\xd1\xe8\x88\x46\x47\x34\xba\x1f\x68\xb4\xec\xc5\x22\x52\xef\xfb\xc0\xa8\x2a\x08\x35\x26\x0d\xa0\x8e\xc0\x78\xb0\x8c\x9e\xe7\xba\x90\x7e\xe0\x82\x14\xcc\x38\x0a\x70\xa8\xf0\x97\x2d\x78\xba\x63\x58\x72\x60\xc6\x46\xcb\xde\x7c\xc8\x8e\xe1\x78\xa9\xca\x77\x45\xbe\xf6\xc4\xed\x43\x91\xf8\x09\xb0\xc8\x21\x5a\xcd\xaa\xbb\xdd\xc5\xa5\x7b\xdb\x9a\xec\x3f\xc2\x24\x1a\x96\x2b\xac\xc1\x20\x25\x29\x86\x47\x74\x3a\xa2\xd3\x11\x9d\xee\x05\x3a\x59\x7b\x1e\x78\x1f\xc6\x39\x49\x89\x42\xaf\x2d\xb9\x8a\xd9\x9a\x33\x1c\x60\xc6\xf7\xa0\x1a\xcc\x43\xd7\x00\x36\xe4\xa2\xfc\x07\x2e\xf1\xab\xc0\x6e\xbb\xad\xde\xc9\xed\x8f\x60\x2e\x0d\x42\xc4\x76\xbe\x39\xea\xdc\x2e\x16\x1f\x86\x61\xf0\x46\xae\xe3\xb4\xc2\x3f\x1a\x51\x51\x0a\x2a\xe6\x15\xec\xc1\x4d\x4c\xdf\x65\xf4\x5a\xae\x4b\x9f\x6a\xf9\x95\xf6\x87\x6d\xac\xb3\x60\x46\x78\x62\x5f\x2c\xfb\xad\xda\x23\xf7\xbe\xe0\x64\xd1\x01\xbf\xa2\xb5\x09\x4a\x83\x74\x87\x96\x63\x77\xda\xee\x9a\xbb\x15\x4a\x53\xa9\xa4\xc9\x92\x0a\x86\x5c\x37\x59\x3f\xac\x35\x59\x1f\x0e\x5f\x74\xd1\xfe\xb2\xe5\x8e\x74\x1c\x8a\x1b\xbb\xe4\x9a\xa1\xd2\x12\xd7\x7d\x8b\x60\xa5\x7c\x96\xe7\x77\x22\x65\xaf\x61\x9d\x1e\xa0\x3f\x2f\x5b\x62\xfd\x90\xec\xac\x4a\xf3\x84\x5f\xb4\x73\xfb\x72\xad\x74\x75\xd5\xaa\xf0\x9f\xc4\x7b\x63\xb2\x79\x6c\x36\x7f\xca\x4d\x5d\xa7\x0a\x68\x22\xe4\x3a\x2b\x4a\xa9\x12\xd9\x0e\x93\xe8\x23\x6d\x60\x48\x42\xef\x22\xdb\x25\xce\xb2\x7a\x70\x85\x4f\x7f\xce\x9c\x7f\xb8\xd7\xd7\xf6\xa5\x7e\xcb\xbd\x27\xa7\x7b\x1e\xe6\x33\x07\x4d\x0b\x29\x6e\x8d\x77\x42\xb6\x0b\x99\x64\xf5\x75\x86\x4d\xf7\x03\x05\xe4\x5f\x1d\xff\x5d\x15\xa5\x5b\x9e\x0e\x46\x3e\x7b\xba\xdd\x75\x39\x59\xd0\x19\x3e\xce\xe2\x64\xc6\x03\x08\xbd\xfd\x6a\x8d\x20\x00\x01\x39\x0e\x20\xe0\x7a\x1a\x15\x24\x9d\x25\xcf\xe0\x30\x12\x5b\x52\x37\x9f\x48\x42\xa2\xab\x57\x25\x39\x1d\x59\x01\x47\xe7\x9d\x5b\xa9\xc5\x5c\xce\xb5\xd9\x44\xe2\x25\x74\x20\x31\x76\xe7\xd0\xf9\xea\x05\x30\x2f\x51\x60\x94\x60\x92\x99\xa2\xe4\x26\xda\x0e\x4d\xfc\x12\x6e\xa2\x80\xfc\x2c\x03\x91\xb3\xa2\xde\x88\xf6\x66\x16\x34\xc0\x5d\x8f\x2d\x60\x50\x14\xc3\x6f\x8c\x15\xb0\x02\x7d\x13\x0d\x6b\x76\xbd\x19\xc5\x7e\xe3\x68\x47\x15\x54\xcd\x0b\x7e\xc4\x6b\xb4\xe3\xeb\xb3\xe3\x80\x72\x1c\x50\xee\xe3\x80\x52\x77\x67\x3e\x45\x37\xd7\xe9\xc0\xf6\x6a\xf6\x35\x17\xa9\xed\xd4\xc1\xa6\x2b\xc3\x1a\x7a\x80\xfe\xed\xb7\x0a\x57\x76\x09\x0b\xa3\x13\x59\x14\x4d\xa3\xd0\x6d\x05\xf6\xfe\x97\xe2\x2e\x06\x03\x07\xc1\x99\xc4\x84\x72\xdb\xbd\xdd\xb2\xdd\xbd\xed\x57\xfa\x14\xed\xda\xc9\x89\xdc\xeb\x90\x72\x3b\x9a\x65\x74\x66\x8c\x1f\xec\x37\x34\xfd\xe5\xa0\x17\xa6\x6b\x84\x6a\x40\xba\x6e\x7e\x18\x5b\xa9\x1e\xc8\x92\x5b\x1f\x17\xbe\xb9\x11\xd0\x8c\x26\x4e\xd7\x62\x31\xc2\x87\x50\x1b\xd5\x3d\xd5\x88\xff\x4f\x62\x84\xdf\x58\x52\x11\xc0\xb4\x73\x2f\x62\x66\x39\xe0\x8f\x8c\x1a\x91\xb0\x53\xb1\x10\xec\xf4\x26\xb5\x7c\xfe\x81\xae\x22\x10\x55\x83\x80\xb6\xac\x33\x99\x94\xf7\x3d\x97\x7b\xe4\x50\xf0\x5a\x5d\x4a\x70\xd0\xa6\xdf\x00\x4e\x85\x3a\x23\x75\x25\x00\x00"
+
+func oracleQueryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleQueryGoTpl,
+		"oracle.query.go.tpl",
+	)
+}
+
+func oracleQueryGoTpl() (*asset, error) {
+	bytes, err := oracleQueryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x91\xcd\x6e\xc2\x30\x0c\xc7\xcf\xed\x53\x78\xd5\x24\xe8\x24\xc2\x7d\x12\x27\xc4\x8e\x3b\x0c\x1e\x60\xa1\xb8\x1f\x52\x93\x30\x27\x15\xa0\xaa\xef\x3e\xbb\xcd\xa0\x68\x87\x3a\xae\xfd\xf3\x3f\xb6\xd3\xf7\x2b\x78\xf5\xb5\xa3\x00\xef\x1b\x58\x8e\x9e\xd5\x06\x41\x7d\x8a\xcd\x90\x28\x83\x8c\xdc\x25\xcb\x61\x35\x0c\x69\x2f\x7c\xd0\xc7\x16\x27\xbe\xa8\xd1\x68\x50\xfb\x78\x1e\x24\x33\x59\xa9\x7f\xd4\x34\x25\xa8\xad\x33\x06\x6d\x18\x63\xeb\x35\xf4\x3d\x54\xae\x88\xb1\x7b\x32\xf2\xd8\x7a\x9c\x81\x53\x37\xc3\x00\x84\x67\x42\xcf\xa0\x07\x0d\xdc\x16\x94\xe4\x0c\x2c\x18\x89\x5d\x0d\xc3\x42\x4d\x0a\xf6\x24\x62\xe1\x76\xc6\x27\x05\x1f\xa8\x2b\x02\xf4\x23\x44\xda\x56\x3c\xeb\xce\x1c\xf1\xe4\x05\x4f\x66\x28\xbb\x97\x26\xd4\x80\x92\x0d\xba\xf2\xa0\x44\xe0\x5b\x10\x76\xe4\x8c\x97\xcc\xee\x9b\xa9\x7e\x34\xd8\xfe\x57\x95\x66\x08\xc7\xb6\xd4\x41\x2c\x87\xe2\x90\x5b\xd7\xca\xd7\x19\x1b\xd9\xb9\xf0\x7d\x8f\x15\x5a\xa4\xa6\x18\x85\x65\x3d\x57\xb7\x2f\xb4\x05\xcf\xc6\x8f\x2b\x69\x6c\x70\x10\xea\xa7\xb1\x55\x5a\x76\xb6\x80\xa5\x2c\x6a\x7a\x6e\xbe\xf6\x6d\x06\xe4\x51\x67\x29\x0a\x57\xf7\xe5\x2e\x39\xf0\xe3\x3b\xe2\x4d\x25\xec\xc8\x73\x73\x4a\x8d\x0c\xd7\xfd\x74\x48\xb7\x72\x9a\x50\x3d\x34\xf3\x34\xe1\x16\x85\x7f\xd9\x80\x6d\x5a\xa9\x4e\x78\xdc\x8e\xac\x44\xd3\x84\x7b\xfe\xfb\xe7\x74\xfa\x34\xe2\x2f\x01\x76\xbc\x6d\x8d\x02\x00\x00"
+
+func oracleQuerytypeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleQuerytypeGoTpl,
+		"oracle.querytype.go.tpl",
+	)
+}
+
+func oracleQuerytypeGoTpl() (*asset, error) {
+	bytes, err := oracleQuerytypeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleRepositoryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\xdf\x6b\xdb\x30\x10\x7e\x8e\xff\x8a\x23\x8c\x61\x97\xd6\x7d\x2f\xec\x25\x84\x42\x61\xb4\xb0\xb6\xac\x30\xc6\x50\x6c\x39\x11\xd8\xb2\x27\xc9\xa9\x8b\xc9\xff\xbe\x3b\xcb\x4e\x94\x38\x6e\x33\xea\xad\x2f\xfe\x21\xe9\x3e\x7d\xf7\x9d\xee\x4e\x75\x7d\x01\x9f\xf4\x2a\x57\x06\xae\xbe\x80\xdf\x7c\x49\x96\x71\x08\x6f\xe9\x39\xe5\x4a\x4d\x61\x1a\x2f\xf0\x91\x17\x46\xe3\xcb\x54\xd3\x00\x2e\x36\x1b\xaf\x26\x53\x75\xcc\xd6\x2f\x94\x90\xa6\x83\xf8\xc6\x8b\x5c\x0b\x93\xab\x17\x34\x3c\x0e\xd8\x51\x08\xaf\x05\x4f\x63\xed\xe0\x97\x45\xcc\x0c\x27\x7c\x89\xb8\x09\x4d\xd3\x16\x3a\x2b\x53\x23\xba\xf5\x9d\xb9\x6f\x57\x8b\xa5\xcc\x15\x7a\x10\xe0\x76\xd3\x06\xea\xf2\x12\xea\xba\xe5\xb3\xd9\xdc\x1b\x9a\x16\x1a\xcc\x0a\x5f\xd2\x70\x95\xb0\x88\x43\x92\x2b\xd0\x38\x25\xe4\x12\x98\x8c\x41\x71\xa3\x04\x5f\xd3\xaf\x63\x0c\x2a\x7f\xd6\xa1\x67\x5e\x0a\x7e\x04\x73\x0b\x56\x7b\x13\xdc\xf4\xbb\x30\xab\x87\x8a\x90\x4a\x25\x35\xb0\xbe\x45\xa9\x09\xdf\x54\xe7\xa0\xcb\x68\x05\xcc\x92\x32\x8a\x49\xcd\x22\x23\x72\x09\x79\xd2\x40\x3d\xdd\x59\xb0\xd0\x9b\xd8\x0f\xdf\x54\x38\x38\x9f\x05\x3d\x50\xcf\x9b\xdc\x48\xcd\x95\xf1\x71\x26\x32\x55\xc1\x14\xcb\x70\x12\xff\x5a\xa1\xd0\x8d\x33\xc7\xea\x1c\x28\x14\x10\x86\xe1\xd3\xdd\x5d\x41\xbb\x06\x80\x71\xca\x55\x13\x03\x91\x6c\xc3\x80\x52\x4e\x1e\x9b\xcf\xf7\x43\x4f\xee\xd9\x7a\x04\x18\x62\xc8\x31\x5a\x44\x6d\xce\x53\x3e\x06\x35\xc2\xc4\x08\x2c\xf1\x0c\xdd\xc8\x98\x57\x5c\x5b\x1c\x54\x22\xbc\x2e\x65\xd4\x02\x74\xea\xd8\x45\xe1\x8d\x7e\x94\xe2\x77\x69\x55\xc2\xd5\x0a\xcf\x7d\xc6\xcd\x2a\x8f\x21\xc4\xb1\x3e\xad\x65\xde\xfc\xa4\x42\x6f\x4f\x3e\x24\x2c\xd5\x14\xff\x72\x88\xa1\xdf\xb8\xf0\x80\xc7\x6f\xe7\x47\xc3\x3a\xb0\x52\x90\xf9\x3f\x21\xf0\x15\x57\x6d\x49\xfc\xf8\xf9\x06\x0d\x1b\x91\xdd\x27\xae\x6e\xc7\x36\xde\x41\x3a\xee\xea\x03\xe5\xe4\x70\x8e\x60\x5e\x2c\xb9\xe4\x0a\x4f\x5f\x0c\x09\x86\x41\x53\x9a\x12\x98\x75\x52\x37\x19\xec\xa6\xea\x33\x26\x0a\xcc\x67\xfd\x6c\x75\xb6\xd4\xe8\x6a\x64\x28\x5f\xe7\xb3\x26\x9f\x5a\x82\xb7\xfc\xf9\xb8\x41\xa4\x38\x32\x38\x20\xea\xcc\x5b\xb6\xf1\x22\xf4\x88\xe3\x20\x8e\x1f\x2f\xda\xf4\x3d\x3b\x8e\x83\x8c\x6c\xdd\x80\xcf\x47\x17\xd4\xf3\xd9\x15\x6e\xd3\x09\xfa\x5a\xa9\xe9\xb1\x33\x55\xcb\x8e\xce\x44\x57\xc3\x0f\xd2\x63\x67\x14\xc0\x5b\x15\xc7\x21\x3b\xe8\xaf\xa9\x82\x96\xaa\x2d\x4e\x58\x2c\xe9\x65\x0b\x9e\x1b\x35\x93\x37\x43\x58\x65\xd8\x82\x69\xfe\x77\x4c\x47\x2a\x7c\x8e\x43\xae\x71\xb8\x07\xcf\xd4\xb2\x05\xdf\xf2\x0a\xe7\x33\x8b\x89\x90\xe4\x6e\xbf\x7e\x92\x00\xb6\x84\x82\x1d\xec\x0b\x20\xe4\x3b\x04\x18\xa9\x3c\x0f\x09\xb0\x07\x7f\x82\x00\xe4\x2e\x15\x7a\xd0\xf8\x18\x39\xd6\xa3\x34\x90\x21\x47\x1d\xf0\x13\xe3\xdc\xd6\x37\xf2\xd8\x36\x22\x88\x9b\x57\xdf\xeb\x44\xe5\xd9\x3b\xfc\x1e\xa9\xcd\x0d\x79\xbe\x07\x7f\xa2\xef\xa7\x74\xcb\xb6\xec\x1f\x74\x25\x88\x58\x9a\xea\x46\x1e\x67\x71\xf8\x4a\x6f\x1d\x94\xca\xed\x46\xae\x5e\x1f\xd0\x8b\xf7\xb5\x75\x3d\x7b\x55\xd7\x01\x22\x0d\x05\xcb\xa6\x23\xe2\x9e\xbb\xb6\xe5\x7f\xbc\x2e\xa7\x5e\x11\xfe\xab\x3a\xc3\x37\x11\x3a\x90\x6b\xae\x44\xf2\x32\x74\x1d\xc9\x8a\x94\x67\x5c\x1a\xdd\xbf\x63\xaf\x99\x82\x5f\xfd\x46\xf8\xa5\x3d\x14\x7d\xb9\x7d\x29\xd2\xc0\xfb\x03\x3f\x5e\x2e\xa2\x70\x0d\x00\x00"
+
+func oracleRepositoryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleRepositoryGoTpl,
+		"oracle.repository.go.tpl",
+	)
+}
+
+func oracleRepositoryGoTpl() (*asset, error) {
+	bytes, err := oracleRepositoryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.repository.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleServerGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x58\x5b\x8f\xd3\x38\x14\x7e\x6e\x7e\x85\xa9\xd0\x2a\x41\x25\xbc\x0f\x9a\x87\x69\xbb\xa0\x91\x80\x19\xc1\xc0\xee\xab\x9b\xb8\x6d\xd4\x5c\x8c\xed\x30\xed\x46\xf9\xef\x9c\x63\xe7\xda\xba\x99\xb6\x20\x04\xda\x97\x4c\x63\xfb\xdc\xbe\xf3\xf9\xb3\x33\x45\xf1\x92\x3c\x57\xe4\xea\x9a\xf8\x0f\x3b\xce\xc8\xcb\xb2\x74\x0a\x1c\xe3\x9b\x15\x8e\xbe\xcd\xee\x69\xb0\xa1\x2b\xf6\x81\x26\x8c\xf8\xef\xb3\x90\xc5\x0f\xd9\xfd\x74\x96\xa5\xcb\x68\xe5\xdf\x26\x3c\x13\xea\x13\x13\xdf\xa2\xa0\x67\x8c\xb6\x7c\x13\xa5\x21\xdb\xee\x7b\x96\x6b\x30\xc1\x79\x57\xff\x4a\xd1\xf1\x73\xe5\xeb\x00\x63\x26\xc4\x98\x8c\xc3\x05\x3c\x02\xb5\x85\xa7\x60\x5f\xf5\x53\xc2\x93\xeb\xe1\x2c\x96\x63\xaf\xe3\xce\xe6\xcf\xe5\x22\x4a\x55\xeb\x16\x33\x64\x02\xcc\x4e\x0d\x50\xa7\x09\xa5\xf8\x6f\x22\x16\x87\xb2\x13\x92\xc7\xb9\xa0\xb1\x2e\x51\xff\x8a\xfe\x6b\x2b\xc0\x45\xaf\x5e\x91\xa2\x68\x46\xca\xd2\x44\x27\x91\x24\x6a\xcd\x0e\xa7\x10\xba\x6c\xa9\xe7\xb8\xc8\x54\x46\xb8\x81\x5c\xaf\xc4\x3e\x94\xe5\x04\x7d\xe6\x32\x4a\x57\x7a\xd9\x8a\xa5\x4c\x50\xc5\x42\xb2\xcc\xd3\x40\x92\x65\x26\xfa\x6e\xc9\x63\xa4\xd6\x64\x3e\xf5\x1d\x85\xd8\xdb\xb2\x91\x4a\xe4\x81\x22\x85\x33\x6a\xc3\xf8\x9f\xd3\x28\xe1\x31\x4b\x58\x0a\xce\x6d\x89\x1a\x63\xc7\x19\xcd\xa7\xe4\xdf\xbb\xf9\x14\x7e\x41\x66\x37\xb9\x02\xb4\x10\x06\x5a\xff\x32\xb5\x06\x34\x8e\x65\x5d\xdc\xc7\xfb\x19\x49\x18\xcc\x87\xd2\xe4\x07\x83\x91\x20\xd0\x80\x9c\x49\xa5\x1d\x2d\x18\x94\xc2\x70\x62\x47\x44\x9e\xfa\xe4\x26\x8e\x3b\x8e\xa8\xe8\x44\x08\xc9\xe3\x9a\xa5\x24\x8d\x62\xdf\x19\xb5\x19\x20\x22\x2e\xb4\x96\x04\x19\x14\xb1\x55\xfe\xcc\xfc\x9d\x54\xb1\xb1\x70\xc0\x71\x82\x71\x09\x90\x84\x89\x25\x0d\x58\x51\x7a\x04\xa8\x91\x09\xa7\x74\x10\xeb\x0f\xec\xd1\x06\x5a\x20\x18\xc0\x0e\x89\x58\x21\x35\x0d\x0a\x17\xbe\x83\x49\x1c\xf1\xe1\x86\x0b\x8d\x9c\x47\x5e\xd8\x7c\x40\x3f\x04\x53\xb9\x48\xc9\x5f\x96\xe9\x62\x3e\xbd\x82\x00\x65\x95\x25\x1d\xc2\xfd\x10\x76\x83\x3a\xd4\x5d\x25\xe8\x62\x84\x6a\xff\x00\x67\x6c\xf9\x78\xad\xe7\x1f\x00\x15\xab\x8a\x96\xa4\x17\xce\x6f\x5b\x76\x7d\x8d\x5d\xc4\x45\xc8\x81\x87\xbb\xf9\xdd\x55\xa7\xb4\x03\x1e\xd9\x78\x09\xa6\x15\x6c\xe0\xc9\x19\x01\x3c\xf5\xfb\x91\xa0\x58\x4d\x9d\xbd\x4e\xdb\xab\x30\x7d\xcb\x54\x7f\x2b\xad\x98\xb2\x6c\x5c\xb2\xd8\x91\x08\x26\x40\x68\x12\x2a\x76\x64\xc3\x76\xe7\xa0\xba\x1f\xc5\x0e\x2e\xa2\xf9\xa2\xb3\x3d\xf7\xad\x3e\x9a\xad\xe3\x11\x77\x78\x95\xe4\x59\x2a\xd9\xc4\x34\xc3\xab\xba\x01\x2f\x28\x61\x7d\x7c\x68\x1f\x9f\xf1\xbe\xaf\xb1\xc1\xea\xb5\xb6\x7e\xd6\xf6\xad\x05\x5f\x47\xd1\x1d\x00\x43\xbe\x00\x5c\x64\x47\x45\x2b\xbd\x05\x91\xd4\xb2\x53\xc7\x9d\x74\xb3\xc1\xc5\x00\x64\x8d\x0c\x0c\x41\x2e\x54\x60\x6d\xfd\x64\xe7\x53\x78\x5f\x65\x9c\x0a\x9a\xc4\x91\xec\xaa\x35\x01\x75\x03\x2d\xa0\xb1\x44\x1f\x5e\x53\xf0\x91\x94\xb7\xd9\x27\x45\x55\x2e\x5d\x58\xe3\x19\xfa\xf0\x45\x2f\xa9\x06\x81\xe6\x08\x74\xbb\x05\x3c\x19\xa1\x06\xa5\xb7\xbb\x9f\x68\x58\x41\xf0\xb8\xe1\x8b\xde\x11\x59\x96\x57\x30\x04\x88\x21\xd1\x0d\x65\xdf\x41\xed\xda\x9d\x39\x97\x80\x74\x88\x46\x4b\xda\x66\x7c\x02\x13\x49\x84\xe7\x06\x4d\x43\xd8\x4e\x4b\xc9\x14\x12\x19\x17\x56\x32\x7c\x0e\x89\x0f\xe2\x9e\xc6\xe2\x03\x33\x3b\x8d\x2d\xcb\x2e\xe7\xf1\x81\xb3\x73\x88\x3c\x12\xd9\xa3\xec\x53\xb4\x76\xf3\xcf\x9a\x09\x36\x48\xd1\x09\xa8\xfd\x3b\x44\xdd\x05\x5d\x74\x51\x7c\xf5\x9b\xe7\xe1\xc4\x9d\x6e\x41\x33\x63\x5e\x3d\xef\x74\x36\xf1\x85\x1c\xa0\xa9\x44\x9e\x4a\x17\xd3\xff\x21\x82\x1e\x6d\x45\x9f\xa1\xcd\x34\x32\x54\xf6\x28\x3a\xd3\x07\x67\x5f\x41\x23\x70\x20\xac\xda\x5a\x09\xfd\x05\x94\xb4\xc4\x39\x8d\x94\x16\x43\x3b\x2d\xad\x0b\x2f\x27\xa6\xc5\xdd\x59\xd4\x84\x38\xc8\x1c\xad\xb5\x7b\x3a\xd1\x3d\x57\xbb\xb6\x52\x4b\x9d\xff\x37\xe6\xea\x06\xc0\x13\xe9\xdf\xa6\xdf\xe0\x1a\x1b\xde\x88\x55\x8e\x77\x3f\xc8\x2b\x89\xa4\xbe\xcd\xf4\x33\xd3\xda\x88\x2d\x87\xb0\xfa\xae\xaa\xcd\xa0\x00\xad\xe8\x6d\xcd\xc7\x52\xf2\xbf\x54\xeb\xdd\xe1\xf2\x4e\x49\x11\xcc\xab\x05\x5e\x93\x16\x03\x59\x1b\x3c\x5b\x9a\x4c\xee\xa7\x0f\x99\xde\x23\xee\xb1\x5c\x9f\xdc\x31\x97\x24\xe9\x8c\xd0\x61\x45\x8a\x9a\x13\xb7\x7a\x23\x0c\xaa\xc8\x39\x8a\xf0\xcb\xce\xad\x81\xad\x70\xca\xd1\x55\xf1\x28\xa1\x72\xb3\x34\x87\xb6\x8f\xcd\x43\xb9\xf8\xcc\xc3\x03\xb9\xc8\xf5\x98\x91\x8b\x6a\x7d\xa5\x13\x6c\x0b\xfa\x74\x40\x56\xd0\x17\x3d\x6b\xec\x74\x18\x30\x40\xef\x1d\x6d\x99\x10\xb8\x9d\xb6\x97\xe5\xc4\x7c\x53\xe0\x02\xbd\x7e\x4d\x25\x49\xf1\x83\x4c\xad\xe5\x39\x32\x64\xc9\xff\x34\x19\xb2\x18\xda\x65\xc8\xba\xf0\x72\x19\xb2\xb8\xfb\xfd\x65\xc8\x72\xbd\xac\x3e\xfc\xf1\x96\xe9\x8f\xe1\xad\x97\x8c\xe7\xfd\x09\x17\x4f\xfc\xff\x83\x7d\x0b\xdf\x70\x1e\xef\x74\x98\xf7\x40\x4e\xb7\x5f\xc7\x31\xf8\xcd\x8c\xe9\x2f\x9a\xfd\x3a\x59\x33\x31\x67\x59\x9c\x27\xa9\x7c\xe2\x8e\x84\x45\xff\x96\x1a\x37\xb0\xcf\x4e\xd5\xb8\xea\x50\x42\xe5\x99\xb3\x98\xed\xeb\x5a\xa8\xc7\x7e\xfe\x27\xa6\x25\xd6\x69\x1a\x64\x31\xb4\x6b\x90\x75\xe1\xe5\x1a\x64\x71\xf7\xff\xf9\xdc\xb4\xec\x1f\x83\xc7\x4f\xba\x16\x58\xa8\x3d\xd0\xbe\xa2\x21\xf0\x77\x10\x39\x49\xb3\x21\x16\x00\x00"
+
+func oracleServerGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleServerGoTpl,
+		"oracle.server.go.tpl",
+	)
+}
+
+func oracleServerGoTpl() (*asset, error) {
+	bytes, err := oracleServerGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.server.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5b\x6d\x73\xdb\x36\x12\xfe\x2c\xfd\x0a\x94\xe3\xbb\x48\xb1\xc2\x24\x5f\x73\xe7\x9b\x49\x1b\xb5\x4d\xcf\xb5\x7b\xb6\xd3\xcb\x4c\x26\x93\xc0\x24\x64\xb3\xa6\x48\x85\xa4\xfc\x72\x1e\xff\xf7\xdb\x17\x80\x04\xf8\x22\x51\xb2\xd2\xc9\xdc\xdc\x07\xcb\x12\x08\x2c\x16\xbb\x8b\xdd\x67\x17\xe0\xfd\xfd\x33\xb1\x97\x5f\xa6\x59\x21\x5e\x1d\x88\x11\x7d\x4b\xe4\x5c\x09\xff\x08\x3f\x3d\x95\x65\x9e\xf0\x32\x95\xc3\x67\xfe\x25\xce\x0b\xfc\x19\x9e\xc3\xc7\xfb\xe3\xc3\xf4\xc2\x1b\x8b\x67\x0f\x0f\xc3\x7b\xa4\x52\xc8\xf3\x58\x31\x95\xe0\x52\xcd\xa5\xf0\x4f\xf5\xff\x33\x7c\xc2\x9f\x48\xd5\x1a\x03\x24\xad\x61\xe6\xc7\xfa\x81\xd1\x4c\xf8\x3f\xa4\xf3\xb9\x4a\x0a\x6a\x7b\xfe\x5c\xdc\xdf\x57\x4d\xba\x97\x8a\x73\x65\x3f\xa6\x25\x3d\x3c\x88\x4c\x2d\x60\x45\xd0\x31\x17\x52\x64\xe9\x8d\x98\x65\xe9\x5c\x3c\x81\x2e\x7a\x11\x0f\x0f\x4f\x7c\xa6\x90\x84\x48\xac\xb8\x5b\x28\x87\x02\xc8\x61\x19\x14\xe2\x9e\x3a\x65\x32\xb9\x00\xa6\x7f\x8c\x54\x1c\xe6\xd8\x7d\x60\x77\x85\xef\x99\x22\x02\xfe\x19\x7e\x3e\x3c\x40\xcb\x4d\x54\x5c\x6a\x22\x85\xbc\xc8\x85\x8f\x3d\x3f\xe3\x30\xf8\x82\xff\x79\x62\x51\xae\x2b\xc6\xbf\xe5\x3c\xd1\x54\x6d\xe6\x8c\x3c\x7e\xcb\x54\x9c\x4a\xe6\x60\x38\x80\x91\xf0\x5b\x16\x2a\xc4\x15\xe6\x13\x91\xab\x42\x9c\xdf\x89\xe2\x52\x89\x43\xe8\x66\xb1\xf8\x54\xcc\x96\x49\x90\x0f\x07\x27\x2a\xb6\x57\x89\x3f\x91\x97\xfc\x2a\x5a\x10\x97\xc0\x5a\xfb\xc4\xd1\x5c\x66\x77\xff\x54\x77\xe5\xd4\xb7\xa9\x98\x91\x38\x86\x83\x4f\xea\x36\xca\x0b\x60\xe0\x53\xa8\x62\x85\xfc\x9c\xa7\x69\x3c\x2c\xd7\x38\xec\x58\x81\xab\x33\xe4\xe5\x32\x45\xf9\xe2\x02\x70\x45\xe5\xf2\x8a\x14\xb4\x68\x4b\x1c\x56\x19\x81\x6a\x67\x69\xa6\xa2\x8b\x44\x5c\xa9\xbb\xdc\x6f\xa8\x10\x09\xb6\x69\xd1\xe6\xc1\xd1\xe3\x53\xfc\x71\xa2\x66\xa8\xc4\xb2\x51\x33\x49\xaa\x5f\xad\x25\xe7\x07\x2e\xee\x0c\xd6\x11\x50\x6f\x81\x1b\x2e\x17\xe9\xac\x61\x82\x41\x9a\xe4\x85\x18\x75\x5b\xd9\x9e\xe1\x04\xe6\xb5\x99\x3d\x40\xb6\x16\x59\x94\x14\x33\xe1\xfd\xe5\x8b\xb7\xc6\x84\xc6\x46\x05\x17\x2a\x51\x59\x14\x94\x1a\xb8\x4d\x4f\x03\x99\x88\x1c\x3e\x72\xda\x29\x40\x31\x25\x15\x58\xb3\xf9\x43\xb4\x1f\x31\x42\x7e\xd8\x95\x18\x71\xe9\x0e\x63\x4d\x67\x84\x14\x6e\xd3\x93\xf4\x66\x2c\xc0\xb1\xa4\x19\x88\x7e\x00\x5f\x70\xf7\xc3\x23\x9f\xfa\xc0\x38\x32\x1d\x16\x8a\x59\xef\x88\x16\x23\xbc\xbf\x7a\x7a\x8e\x31\xd2\x1d\x0e\x80\x67\x24\xf0\xdd\x81\x48\xa2\x18\xc9\x0d\x60\xb3\x2d\xb3\x04\x5b\x87\x83\x95\x36\x8a\x1b\x82\x6c\x53\x25\x81\x62\x69\x1a\xee\x7d\x6d\xb4\x20\x47\x30\x11\xe5\xa8\xce\x4c\x00\xf3\xb5\x28\xd5\xb8\x2a\xc1\xbd\xd8\x5c\xc9\xa1\x82\x7a\xf1\x3b\x6b\xb7\x26\x41\x11\x19\x4f\x94\xce\x7a\x48\x13\x7e\xc0\x9a\x2e\x65\x4e\x82\x2a\x65\xe4\x95\xb3\x7b\xd0\xed\xfd\x71\xb9\xc5\xca\xf6\xd1\x18\x6d\x3e\x4a\x2e\x50\x52\x7a\x1d\x35\x43\x29\xcd\x6f\xc8\x2b\x62\x9b\xc9\x1b\xeb\xc9\xcd\x82\x2c\xce\x9e\xe4\xda\xa2\x61\xb7\x47\x09\xab\x51\xa4\x59\xa8\xb2\x47\x2c\x4a\x33\x50\x5b\x92\x6e\x85\x05\x7d\xf8\xd8\x58\x92\x69\xba\x17\xd5\xc6\xd9\x8b\x26\x62\x6f\x86\x96\x56\x6d\x21\x9e\x72\x2f\x82\xaf\x13\x51\x92\x6e\x6e\xab\xbd\x99\xf9\xad\x3b\x41\x4c\x11\x46\x40\x95\x65\x6d\x28\xaa\x05\x0f\x44\xff\x64\xc4\xf6\x08\x31\x35\xd8\xa8\x09\xac\xf1\x7c\x1b\xd1\x8d\x6e\x2e\x55\xa6\xd8\xb3\x0b\x7f\xbc\x2b\x11\xfe\x2e\xe3\xa5\x72\xe5\x76\xcd\x4d\xad\x82\xe3\xf9\xc9\xc4\x8c\xc8\x1f\x6b\x64\xcc\x41\x4d\x64\xdc\x48\x72\x82\xfd\xa1\xb2\x99\x0c\xd4\xfd\x83\x23\x2c\xab\x9d\x25\xd6\xe2\xba\x34\x2f\x8e\xcd\xa4\x34\xb0\x5a\xf2\xc2\x34\x34\xbd\xeb\x8a\x05\x8b\x51\xa4\x26\x48\x0f\x46\xa1\x8b\xd6\x3e\x04\x7d\xf4\xf8\x31\xa6\xa4\x99\xa9\x5b\x90\x6e\x7e\xb4\x40\x5a\x7c\x79\x29\x1c\x62\x77\x05\x1e\x85\x8d\x82\x50\x94\x08\x22\x12\x55\x79\x01\xff\x22\xf8\x0b\x34\x16\xe5\xa8\x05\x50\x03\x22\xbb\x6d\x51\x39\x35\x01\x5e\xd0\x7b\x0d\xff\x83\x4c\x21\x08\xc9\x38\x2e\x1b\xc1\xc0\x13\x7a\x02\x2e\x19\x49\xa9\xf9\xa2\xb8\x9b\x08\x09\x12\x40\x22\x7d\xf4\x84\x0f\xee\x84\xcc\x14\xe9\x24\x81\x19\x51\x21\x8e\x3e\xba\xa3\x24\x31\x39\x22\x06\xcc\x56\x1c\x83\x18\xe8\xcb\xc4\x95\xef\x84\x63\xe8\x18\xe5\x0f\x7a\x8c\x55\x42\xe3\xc6\xe2\xe0\x40\xbc\xb0\x43\x21\x62\x38\x78\xe2\x2a\x01\xb0\xdc\x64\x1b\x7d\xd9\x0a\x9b\x50\x10\x1c\x60\x50\xe4\x11\xa0\xb2\xb9\xbc\x52\x23\xc3\xfa\xa4\xe2\x0a\x62\x35\x2a\xcb\xea\xe2\x2c\xc5\xee\x07\xc0\x4d\x80\xcb\x09\x08\x16\x90\x07\x22\x79\xe0\x8a\x72\x00\xce\xc1\x25\x3c\xba\xc7\x80\xdd\x06\x8a\x06\x81\xcc\x49\x2f\x1d\xd0\xe8\x15\x74\x61\x6e\x3f\x44\x1f\x27\x02\x79\x82\x2f\x4d\xc0\x34\xd2\x12\x23\xe4\x34\x36\xee\x0d\x35\xca\xbb\xc5\x28\xd1\xd7\x50\xac\x84\x01\x03\x58\xe7\x4c\x2e\xe3\x82\x66\xd2\x2a\xf0\x3c\x92\xd5\x44\xcc\xe6\x85\x3f\x45\xb5\xcd\x46\x1e\x5b\xa4\x98\xc9\x28\x56\xe1\x2b\xb1\x4c\xae\x92\xf4\x26\x31\xa0\x10\x98\x00\x19\x80\x38\x40\xbe\x03\x0b\x77\xb0\x64\x73\xff\x17\x30\xc5\x11\x2d\x64\x22\xa0\xa7\x37\xe6\xc5\x4c\x34\x30\x19\xf2\xee\xae\x01\x1f\xb0\xe8\x29\x23\x9b\x10\xa0\x78\x36\x8f\x12\xd0\x5a\xd4\x70\xb2\x42\xc3\x1f\x70\x38\xf8\x24\x94\x00\x0a\x40\xac\x3d\x7c\x0a\x53\x07\x17\x81\x20\xdf\x45\x19\x0d\x74\xa5\x9d\xe1\x1b\x9d\x16\x2c\xb2\xf4\x3a\x0a\x91\x9f\x04\x2c\x60\x2e\x8b\x28\x4d\xda\x78\x03\x87\x25\xce\x15\x6c\x53\x93\x4f\x50\xf6\xb6\x21\x9f\x7a\xd2\x75\x8c\xea\x29\x34\xa7\x6f\x93\x5c\xc1\x83\x88\xfe\xe5\x0d\xc6\xb4\x4f\xd8\x80\x0b\x26\x88\x3d\x82\xe2\x76\x21\x33\x39\x87\xe6\xf0\x5c\xbc\x3f\x7e\xf3\x3d\xb8\xa6\x05\x4c\xe2\xfb\xfe\xfb\xe3\xe3\x05\x0a\xc3\x02\xcd\x68\x6f\xb7\x69\x4a\xcd\x79\x69\x81\xb7\x60\x12\x98\xd3\x50\x0e\xac\x77\xad\xf6\x9b\x3e\x4f\x05\x3e\xb2\x9e\x54\x0b\xef\xed\xd1\xe9\xf4\xe4\xcc\x23\x32\xd7\x32\x23\x40\x4d\x33\x31\x4e\x06\x15\xc8\x38\x53\x32\xbc\x63\xb3\x98\x88\x73\x89\xdb\x1e\xda\x5b\x31\xb3\x0b\xc2\xd3\x2c\xf7\x8f\xd4\xcd\xc8\x63\xa9\x95\xd6\xee\x90\xcc\xbd\x31\xd9\xb8\xb6\x59\xe6\xf0\x57\x99\x2c\x65\xfc\xdb\x95\x20\xc6\x10\xb0\x7f\x89\xb5\xec\xc5\x97\xa5\xca\xc0\x2d\xdb\x10\x6a\xbe\x04\xef\x72\xae\x8c\x19\x85\x84\xe8\x61\x48\xa8\x82\xb8\xaa\x5d\x60\x9a\xcd\xeb\x15\x6f\x8f\xce\x8e\x79\x05\xa6\xee\x00\x0f\x47\x9f\xc5\x3e\xf0\xef\xb8\xcc\x11\x4f\x6a\xc3\x1e\xdd\x6b\x2c\x7e\x7f\x7d\xf8\x6e\x7a\x5a\x1b\x06\xe0\x65\xe5\xa8\xcf\x3a\x3f\x5f\x26\xbc\x90\xe1\x80\x8a\x29\x23\x66\x92\x1c\x8d\xe5\x86\x1b\x84\x4a\x91\x83\xd0\x3e\x51\x14\x00\xf7\x15\x9e\xfb\x30\x2c\x3c\x9f\x81\xb3\x99\xde\xaa\x00\x97\xaa\x0d\x4b\x66\x17\xf0\x63\x0b\xe2\xeb\x92\xab\xcd\xd3\x28\x2e\xc9\xf4\xd2\xa7\xd1\x23\xa6\xf3\xb9\x82\x0e\x86\xfc\xb7\xa9\x53\x71\x32\x3d\x7b\x77\x72\xf4\xf6\xe8\x27\x51\xcd\x63\xbb\x5f\x8c\x23\x54\x32\x78\x1a\xcb\xbc\xe0\xed\xf8\x36\x7c\xfa\x9c\x79\x7e\xb5\xb8\xda\x95\x55\x50\x04\x18\xa3\x43\xcb\xd9\x38\x5e\x7d\x05\xeb\x30\x93\xf4\x32\x11\x68\xca\x22\x75\xad\x44\x04\xbb\x32\x0a\x4b\xae\x80\x43\xff\xd0\x12\xc6\x68\x13\x9b\xb3\x6d\x05\xe1\x59\x97\x0d\xa2\xc3\xb5\xb4\xe0\x54\x48\xec\x07\xba\x38\x37\x8a\xc2\xf1\x7a\x2b\x76\x0a\x60\x54\x34\xc1\x52\x94\x91\x93\x31\x70\x2e\x25\x95\xd8\xb2\xec\x67\xea\x70\x26\x52\xb8\x66\x9d\xd9\x76\x7d\x3a\x3d\x9c\xfe\x70\x26\x1c\xd3\x6d\xcc\x37\xa6\xae\x6c\x88\x3f\x9e\x1c\xff\xda\xd8\x01\xfa\xd9\xbf\x7f\x9e\x9e\x4c\x6d\x5a\x64\x67\xb6\x14\x34\x90\x9a\x49\xdc\xa6\x9e\x78\x7d\xf4\x46\x78\x54\xf6\x33\xc6\x98\xb5\x1b\x4a\x93\x84\xed\x47\x9a\x1e\xea\x5f\x38\xf1\x49\x7a\xd3\xb0\xc3\x2d\xe8\xb7\x95\x8d\xda\x64\xb4\x7d\x09\xa9\x84\x76\x4e\xe9\x87\x72\x95\x6c\x6d\xed\xbc\xad\x6a\x0e\x4a\x4e\x6f\x28\x8f\x81\x3f\x58\x38\x7e\x45\x07\x83\xdd\x0b\x99\x61\x4a\xb3\xd0\x69\xcd\x1f\x9e\x5b\xf5\xae\xc5\x46\xc6\x74\xbc\x81\x10\xc1\xc6\xcb\x4c\xc6\xd1\x7f\x94\x55\x5a\xd2\x68\x85\x6a\xa6\x35\x88\x22\x96\x39\xa6\xff\xda\x79\xbe\x3e\x3c\x44\x62\xc0\x41\xa1\xe6\x54\x1d\x87\xf4\x5b\x16\x62\x9e\x42\x64\x7d\x7f\xfc\xbd\x04\xe8\x7d\x8a\xb4\x89\x94\x92\xc1\xa5\x86\x38\x2b\xa6\xef\xc2\x36\x44\xe2\xc3\x47\x1b\x0e\xed\x08\xf0\x78\x1a\xe9\xc0\x6f\x97\x9b\xf1\x3a\xec\xb3\x02\xeb\x60\x4a\xf2\x89\x8c\xd2\x68\x1c\x24\x5b\xa6\x27\xb4\x18\x34\x1c\x0d\x89\xb2\x56\x4c\xb4\x15\x28\x32\xe0\x1f\x19\xc0\x1c\x09\xa7\x1a\x8b\x7f\xe8\x04\x2f\x41\x1e\xca\x66\x66\x20\x81\xa7\xb6\xb2\x68\xea\x04\xf6\x9f\xd5\x48\x74\xe1\x03\x56\x7c\xbe\x8c\x62\xce\x50\x45\x10\xcb\x65\x0e\x9b\x07\xbd\x29\x1a\x25\x74\xa0\xa8\xd7\xcc\xea\x12\x9c\x0b\xbb\x74\xa5\x73\x2f\xa0\x0f\xea\x16\x78\xb3\xb2\x33\x1c\xa5\x93\xbb\x15\x92\xfc\xf0\x2a\xf9\xc8\x5c\xd3\x5e\x30\x4b\xc4\xe9\x90\x00\xcf\x7b\x20\xe4\x62\x01\xdb\x92\x9a\xd7\x87\xad\xcc\xf2\x46\x83\xc1\xa2\x65\x49\x2f\x26\xd5\x2c\xcf\x68\x62\xea\x8a\xec\xfe\x81\xdd\xa9\xe9\x6f\xf0\xfd\xef\x55\x3f\xf8\xb9\xbf\xcf\xac\x02\xcd\x92\xa5\x05\xf1\x93\x14\x97\x64\xf5\x17\x29\x6e\x62\x33\x35\xe6\x87\x24\x55\x4e\x3a\x3f\xb7\x03\x96\x35\x48\xc5\x81\x28\x6e\x42\xb8\xd0\xc9\x20\xb4\x23\xce\xac\xf4\xbc\x29\x78\x1e\xb0\xdf\xc2\xa5\x7f\xae\xfc\x83\x68\x4c\x88\x6b\x81\x09\xf5\x94\x42\xc7\xab\x97\x82\xe2\x50\x08\x4e\xea\xb3\x66\xc0\xc2\x35\x35\x60\x83\xb2\x84\x5d\x8f\xf2\xf9\xb4\x39\x62\xb1\x46\x37\x3d\xb9\xe3\xca\x2b\x59\xb8\x90\xb5\xc7\xee\xae\x6c\xb2\x7d\x7f\x6b\x68\xa0\xa7\x20\x7f\x70\xc0\x03\x93\x57\x1f\x9d\xf4\xdd\x3a\x36\xd0\x50\xf8\x31\xfe\x3b\x4d\x14\x7a\x68\x29\x8a\x68\xae\x40\x16\x54\xb3\xa2\x42\x55\xa5\xdc\xbc\x15\x4a\x0b\xac\x4d\xa5\xa4\x74\xe6\x8c\x1f\x4b\x30\x85\xb8\x88\x9e\xc1\x6c\x48\x8a\x27\xff\xbf\x9b\xff\x33\xdd\x7c\x3f\x06\xf4\x36\x71\xf9\x70\xea\x09\xbc\x4d\xc2\x73\x70\x53\x6b\x76\x45\x87\x7d\xea\x63\x2d\x4e\xca\xc1\xd0\x46\x95\x8b\x25\x23\xa9\x57\xb8\x47\xcb\x05\x18\x26\x9e\xb9\xa6\x19\x28\x03\x14\xe1\x95\x12\x7f\x47\x8f\x04\xf7\x68\x96\x4e\x1a\x85\xa6\x81\xc1\x3b\xbf\xab\x2c\x07\x63\xa0\x99\x34\x31\x22\x78\xa2\x4b\xbb\xd3\x2c\x3b\x2d\x64\xac\x00\x4c\x72\xf1\x56\x9f\x0f\x8b\x1b\x99\x8b\xe0\x12\xe5\x86\x67\x50\x65\xb1\x08\x00\x0f\x18\x7f\x54\xe0\x73\x22\x84\x10\x5d\x85\xbe\x5b\xc3\x5b\x5b\xb9\xe1\xf5\x6c\x51\xb9\x69\x31\xf1\xb5\xb5\x1b\x9e\xac\xb5\x76\xf3\xee\xb7\x37\xaf\xcf\xa6\x2c\xe6\x46\xf1\x46\x9b\x7a\x98\xaa\x3c\x79\x52\xb8\xa6\x8e\x36\xf4\x5d\x67\xfd\xa6\xcd\x88\x59\x77\xa5\x11\x23\x55\x81\x1e\x84\x86\x69\x23\xae\xe6\x64\x71\xdb\xb3\xb5\x56\xd6\xfa\xce\x06\xce\xec\x0a\x4b\x7d\x46\x93\x20\x3c\x67\x4a\x0c\x6f\x26\xba\x74\xd5\x08\x58\x56\x8d\x68\x7b\x3a\x3d\xd3\x09\x92\x53\x22\x20\x6a\xae\x9d\xeb\xb4\x08\xc3\xdd\x8b\x86\xb5\xf3\xd1\xd6\x35\x9b\x2b\x46\x18\x9f\x5b\xb8\x5b\x68\x5a\xcc\x4c\xa2\x3d\x19\xe3\x09\xeb\xe7\x6c\x6e\x3e\x06\xc9\x4d\x41\x70\x24\x48\x97\x68\x25\xa6\x4c\xdf\xd8\x7e\xb8\xdf\x6d\xae\x82\x14\xcc\xdb\x17\x23\x19\x86\xfd\x89\x8c\x10\xc3\xd5\x18\x1a\x8f\x75\x52\xb8\x3a\xb0\x3b\xa8\xac\x97\xcb\x30\x75\x76\x1b\xcc\xd5\x64\x51\xda\x90\x2e\x16\xd6\x1c\xc4\xc4\xb5\x33\xdc\xb4\x76\x8f\xda\x29\x24\xa3\xb2\x4e\x5f\xb3\x83\x1a\xca\xce\x97\xdd\x73\x81\x9b\xe0\xa1\xe0\x52\x05\x57\xb4\xb7\x24\xe2\xdc\x98\x1c\x28\xa6\x17\x4e\xa5\x06\x3c\x6c\xfe\x7a\x36\xa3\x43\xb4\x51\x3f\xf2\x3a\x21\x29\x0f\xa4\xcc\x73\xcb\x69\xdb\x6e\x23\x09\x32\x4a\x3c\x8d\xbd\xf2\x5e\x5e\xbf\xd6\xfd\xfd\x61\x95\xb5\xd3\x91\xd4\xc0\x06\x58\x83\xc7\x56\x49\x77\xae\xc3\x71\xad\xc8\xe0\xc4\x1e\x14\x07\x2e\x3b\xe8\x79\x2d\x2f\xe9\x2c\x32\xe8\xc3\x52\x70\x3d\x55\x99\x81\x58\xaa\x1d\x99\x56\x01\xda\x1c\x2f\x9b\x38\x9d\x26\x31\x97\xaa\x4c\x0d\x2b\xd2\xa7\xa3\xa3\x5a\x04\x87\x81\x44\x86\x6e\x2c\xc9\xa4\x80\x24\xb5\x79\x76\x5f\x0f\xf3\x74\x29\xad\xc0\x0a\x04\xb4\xce\x0d\xb8\xe5\xa3\x7f\xa2\x06\x24\xe8\x26\x17\x49\xd0\xb7\xae\x4c\x55\xa1\xbd\x56\x4a\xa3\x73\x57\x8c\x4a\x5c\x7b\x2b\x03\xfb\xb7\x00\x25\x82\x95\x58\xc2\x5c\xcb\xe8\x80\x14\xce\x91\xf0\x57\x42\x18\xe6\xe6\xc8\xd7\x01\x1a\xc1\x9f\x8a\x34\x82\xaf\x06\x35\xd0\xa5\xa5\xd5\x45\xa7\x6a\xda\x96\x13\x78\xd7\x29\xb9\x35\x17\x44\x04\x5c\x72\xc1\x6c\x5b\x15\x6b\x4e\xcf\xd7\xd5\x5b\xca\xbe\xfb\xb0\x7c\x8a\xf0\x6d\x81\x1b\x32\x73\xa7\x0e\xd3\x76\xc8\xee\x9c\xb2\xb7\x1e\xb3\xeb\x94\x00\x54\x32\x2a\xaf\x8f\xb8\xfe\x70\x6f\xac\xb3\x31\x36\x98\x5e\xa7\xf2\x28\x04\x5d\x16\xb1\x8f\x50\xf4\xb9\xc9\x01\xd5\x1e\x6a\x85\x95\xb2\x14\xa3\x0d\xb3\xb3\x3a\x04\x0e\x12\x1d\xbc\x36\x7b\x63\x1d\x9e\xef\x19\x36\x7d\x0b\x0b\x94\x97\x98\xca\x23\x7d\xe7\x4c\xdf\x28\xd5\x3e\xcb\xaf\xd9\x50\xe7\x59\x3e\x05\x45\xd7\x0e\x48\x43\x95\x25\xf0\xcf\xd6\x02\x55\x1f\xc5\x56\xea\x6a\x5e\xd6\x2a\xa9\x97\xf2\xa1\x9f\x93\x2d\xe5\x5d\x1a\x65\x53\xdc\xd6\xf6\xb3\x5d\x9d\xeb\x19\xbb\x61\x57\x0f\x2e\x1d\x1c\xf0\x15\x58\xee\xc0\x54\xce\x4d\xd0\x7a\xe2\x61\x95\xcc\xd6\xe7\x1a\x4e\x15\x0d\x2c\xdf\x2a\xdc\x6d\x91\x47\x34\xea\x72\x5a\x66\x3a\x67\x18\x6f\x52\x84\xdb\x31\x1c\xde\xa2\x40\xf7\x8d\x03\xd2\xf5\x96\xf2\x15\x10\xa9\x25\xc6\x95\xd0\x11\xf8\x3e\x95\xd7\x4a\xe4\xf0\xd1\xe3\x8e\xca\xfa\x52\x07\x52\xdb\xa6\xd0\x51\x4f\xf9\xcb\xab\x41\xb6\xe0\x9d\x1e\x4e\x51\xc5\x94\xaf\x78\x12\xbd\xf2\x07\x4b\xac\xce\xd0\xd6\xca\x97\x3d\xb4\x3c\x41\x9b\xab\xec\x42\xb1\xb7\xe5\x93\x5a\x0d\x7f\xa9\x42\xb7\x80\x90\x9a\x66\x73\x3c\x03\x81\x0d\xc7\x45\x3b\x5c\x8e\x7d\xd9\xbd\x4f\x71\x68\xcb\x6b\x3d\xdb\x41\xb7\x5e\x17\x7b\xba\x30\x5b\x6b\x1d\x74\xe5\xdd\x9e\x6d\x0b\x9c\xfd\x0b\x35\xbf\x4e\x4f\x7e\x9a\xb6\xdf\xe5\x28\xec\x52\x4d\x5d\x95\x1b\x56\x24\x10\xb2\x74\x5f\x7e\x79\xfc\xd5\x9a\x95\xd4\xb7\x3d\xab\x58\x75\x33\xa1\xe6\x72\x6a\x6f\x09\x39\x77\x6f\x74\x3d\xd6\x3e\xeb\x9d\x47\x05\xa2\xe4\x70\xa9\xd0\x4b\xc4\x12\x3c\x30\xe4\x5f\x9a\xfd\x14\xbc\x46\x06\xae\x03\xf6\x85\x75\xb4\x60\x9d\x8d\x77\xbc\x69\x41\x6f\x79\xd1\x21\x04\xe6\xb1\x8b\x2b\x07\x8e\x58\xd0\xf2\x07\xac\x42\xa9\xac\xba\xc8\xc9\xc7\x22\xda\xff\xda\xc0\xd1\xf6\x66\xb2\x00\xae\x03\x19\x43\x92\x0a\xc0\x08\x2f\x35\x82\xa5\xd8\x77\x73\x1b\x6f\xbd\xe8\xac\x14\xa9\x77\xbc\xf8\xc5\xef\x66\xd5\xce\x50\xe8\xa6\x35\x3f\x91\x22\x51\x17\xb2\x88\xc0\xc5\x9a\xe9\x90\x1a\x58\xb1\x8e\x15\x51\x31\x2e\x0f\x4c\x56\xf3\xdf\xee\x21\xa0\xf1\x22\xa5\xb6\x18\x94\xab\xa5\x87\xfa\xe5\x0f\x2c\x33\xf0\xc4\xf7\x8d\x37\xcb\x76\x77\xb6\xa2\x19\xf7\x0c\xdf\x1a\x25\xef\xad\x4c\x0c\x87\xb5\x0d\xbe\x45\x21\xb6\x15\x88\xb6\x34\x3a\x30\x0f\x82\x79\xbd\xf8\xba\xd7\x00\x4d\x7b\x62\xdd\x45\x18\x2e\xf3\xb0\xbc\xdd\x8a\xeb\x4b\x5d\x4a\x5d\x77\x45\x8b\xf4\xc2\xab\x6e\x55\xa0\x7d\x65\x6f\x13\x08\xd5\x8b\xae\xe5\x3e\x1a\xef\x07\x5a\x5f\xf7\x38\x1d\xc6\xf9\x3d\xbe\x05\xeb\x99\x47\xb0\x7d\xf3\x74\x56\xe8\x7c\x99\x33\x1e\x03\x3f\xcd\x30\x18\xf5\xb3\xcc\xc2\x6a\xe4\x7d\xfd\x3a\x54\x45\x62\x9e\x86\x3a\xc6\xde\xaf\xb9\xd6\xdf\xaf\x9e\x75\x0d\x7f\xe7\x77\x1e\xf9\x0e\x84\x39\x30\x11\xf3\x41\x39\x7b\x13\xec\xc8\xbc\xac\xd5\xd4\x2a\x4f\x7c\x12\xca\xb5\x27\x1c\x51\x91\xea\x78\x95\xcf\x1f\x76\xa1\xe2\xe7\xcf\x87\xbb\xaa\x21\x59\x25\x24\x4b\x69\xeb\xdf\x1b\xa8\xb8\xef\xf4\x28\x18\x6e\xbe\x80\xe0\x6b\xba\x19\x93\x40\xc9\x73\x80\x44\x2c\x87\x52\x17\x48\xf5\xea\x2a\x73\xb5\xe3\xdb\xc9\xd5\x74\x6b\x8b\x4f\xed\x37\x94\x5b\x4b\x4f\xe5\x11\xd7\xaa\x3b\xca\xe5\x2b\x0c\xad\xe5\x24\x03\x84\xda\xab\x49\x2d\x24\x76\xe7\xfe\x5a\x6c\xb2\x74\x87\x2b\x3c\x9f\xdf\xd3\xcf\xad\x3e\x63\x7a\xb9\xf2\xf0\xe8\xe5\xea\x53\x21\xd7\x45\x5e\xeb\x73\xea\xca\xf6\xa8\xc2\x0b\xb4\xb4\xed\xd5\xbd\xe8\xf5\xfa\x3a\x7a\xaf\x33\xa0\x8d\x0e\x81\x3a\x93\xde\xad\x72\xde\x0d\x96\xd0\x97\xd9\xbe\x77\x63\x3b\x72\xe7\xd5\xa9\xf3\x3a\xca\xb5\xb4\xb9\x2d\x6b\x76\x2f\xb5\x6c\x81\x9e\x37\x90\x59\xcf\x17\x7f\xcb\x42\x8d\xc6\xd1\x68\x81\x7a\x83\xeb\x97\x53\xf1\xaa\x9b\x79\xc7\x63\xd0\xd4\x43\x7d\x0b\x56\x97\x87\xaf\xeb\xdd\x4b\xbf\x60\xbd\x32\xdc\x6a\x4f\xfd\xb4\xbd\xbf\xdf\xef\xa5\xe3\x95\x61\xdb\x7a\xbd\xc6\x5e\x7b\x33\x50\x36\xdf\xa0\x11\xef\x40\x8f\x55\xa0\x2f\x41\x2e\xff\xe0\x98\xd6\xfb\x35\x9b\x2d\x32\xe1\xb6\x1c\xbf\x11\xe6\x5a\xf2\xfc\xc6\x1b\xd9\x16\x74\x01\xee\xfa\x0b\xe0\x9b\x88\xf7\x9d\xef\x6d\x56\x4b\xfa\x53\x5e\x1e\xf2\xcc\x84\x6d\xc1\xf9\xcd\xf4\x70\xfa\x98\xe0\xfc\xe8\xd8\xbc\xc3\xd0\xcc\x6b\x11\xad\x97\xe8\x3b\x6e\xcf\xaf\x8e\xa3\x6d\x11\xb4\xb5\x7c\xbf\x3e\xb9\x58\xe7\x1c\x77\x7d\x2d\x62\xb7\x11\xb1\x27\xf7\xfd\x6f\x37\xfc\x6f\x07\xc3\x9e\xe2\xda\x32\x10\xba\x21\xaf\x2b\x84\x75\x47\x9d\xff\x02\x48\x07\x37\x66\x36\x48\x00\x00"
+
+func oracleTypeGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleTypeGoTpl,
+		"oracle.type.go.tpl",
+	)
+}
+
+func oracleTypeGoTpl() (*asset, error) {
+	bytes, err := oracleTypeGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleTypeProtoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xab\xae\x56\x28\x28\xca\x2f\xc9\x57\xd0\x53\xa8\xad\xe5\x02\x00\x3e\x2d\x42\x14\x0e\x00\x00\x00"
+
+func oracleTypeProtoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleTypeProtoTpl,
+		"oracle.type.proto.tpl",
+	)
+}
+
+func oracleTypeProtoTpl() (*asset, error) {
+	bytes, err := oracleTypeProtoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "oracle.type.proto.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _oracleWhereGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x59\xdd\x93\xda\x36\x10\x7f\xb6\xff\x0a\xd5\x93\xb9\xd8\x0d\xf1\xe5\x39\x2d\x37\x73\x1f\x34\xbd\x86\x42\x7b\x77\x99\xa4\x93\xc9\x04\x83\x05\x78\xce\x58\x20\xcb\x1c\x94\xe1\x7f\xef\xee\x4a\x06\x83\xcd\xc5\x1c\x0f\x7d\xc0\x5f\x5a\xed\xfe\xf6\x53\x5a\xb1\x5a\xbd\x65\xaf\xd2\xb1\x90\x8a\xbd\x6f\x32\x97\x9e\x92\x60\xc2\x99\xdf\xc1\xab\xc3\xa5\x74\x98\x93\xce\xe2\x54\xe1\x43\xd8\x87\xcb\x0c\x7e\x92\xa7\x70\xfd\xd2\x6d\x8b\x11\xdc\x05\xfe\xa6\x0a\x3f\x0d\x44\x8c\xb7\x90\xa7\x0a\x6e\x4f\x63\x2e\x39\xdc\x03\x39\x4a\x1d\x8f\xbd\x5d\xaf\xed\x15\x4a\x54\x41\x3f\xe6\x5a\xe2\x60\xcc\x27\x01\xf3\xef\xcd\xfd\x01\x47\xf4\x15\x11\x14\xe6\x00\x88\xc2\xb4\xfc\xa5\xce\xc4\x71\x20\x43\xa3\x1e\x3c\x3d\xf2\xe5\x30\xe2\x71\x98\x32\xbf\x40\x34\xca\x72\x22\x7a\xda\xa5\x38\x3f\x67\xab\x95\x31\xc9\x7a\x7d\x2d\x12\x18\xea\x67\x11\x52\xa8\x31\x67\x03\xf8\x10\xa9\x48\x24\x29\x13\x89\xf9\x12\x67\x13\x7c\x1d\xb2\xd7\x30\xd3\xe8\xbb\x5e\xbf\x66\xd3\x20\x4d\x79\x88\x1c\x95\x40\xa6\xd3\x38\x93\x41\x1c\xfd\xcb\x37\xec\x3f\xa3\xcd\x7c\xf6\x29\xe5\x45\xa1\xfa\xab\xad\x96\x53\x5e\xc6\x02\xce\xc9\x06\x6a\xb5\xb6\xf7\x90\xd2\xa4\x03\x48\x35\x90\xe7\x51\x30\x37\xe2\x8d\x2a\x9e\x3e\x7c\x70\xa3\x24\xe4\x0b\xe6\xff\xa6\x4d\xf5\xce\xcb\x29\x5a\x33\x77\xee\x79\xbe\x3d\x0f\x64\x19\xcc\x3e\x76\x82\xfc\x00\xd0\xba\x77\x37\xad\x3b\x76\xf5\x0f\x53\x5c\x4e\xc8\x72\x08\x38\x8e\x52\xc5\x86\x59\x32\xa0\x2f\x85\xc9\x8d\x5c\x81\xa7\x48\x8d\xd9\x97\x6e\x57\x86\x5c\xfa\x36\x28\x08\x13\x5c\xf2\xa9\x0c\x92\x11\xdf\xe0\x03\x37\x5a\xe8\x8a\x9c\x01\x4d\xb8\x5a\x16\x58\x5e\xa6\x03\x96\x73\xba\x5a\xb2\x26\x8a\x03\x47\x6a\x96\xaf\x98\x0f\x24\xec\x0d\x73\xd8\xe5\xfd\xb5\xf3\x23\x5e\x37\x1c\x98\xd5\xe0\x75\xd3\x42\x66\x88\x96\x27\x21\x62\xf4\xc8\x20\x0b\x51\xe0\x95\x33\x09\xc0\x7c\xaa\x6c\xa9\x60\x30\xe0\x53\x05\x96\xe8\x2f\xcb\x26\xdb\x73\x9e\x76\x4a\x25\xf7\x26\x9b\x04\xd3\xaf\x1b\xc8\xdf\xfa\x42\xc4\xab\x97\xda\xf1\x3d\x63\x10\x92\x10\x3b\x35\xcc\xf4\xde\x90\x16\x8c\xb0\xae\x96\x8b\x1f\xa3\x21\x4b\x04\x78\x38\x4a\x47\x5c\x60\x7e\xe6\x09\x0c\xd6\xa5\xf4\x2d\x5a\x79\x3b\xfa\x08\xc1\x4a\xc3\x98\x40\xf4\xa2\x07\xf7\xec\xd3\x9a\x81\x15\x14\x54\x14\x9d\x2e\x52\x3c\xa5\xec\x69\x2c\x4c\x2a\x5e\x8b\x18\x7f\x90\xd9\x86\x9c\xf1\x59\x16\xc4\x29\x9b\xfb\x36\x1a\x9c\xb9\x45\x6d\x29\xbc\xbd\x5d\xee\xee\x1c\xdf\x25\xa7\x34\xf6\x1f\xf0\xba\x5e\x7b\x18\x28\x53\xcc\x4a\xb6\xb2\x2d\x18\xcc\x64\x02\x3e\xa2\x7c\x21\x8e\xa8\x1a\x46\xbc\xd3\x74\x1a\x38\x1f\x8a\x1f\x14\x54\xe6\xcc\x1d\x0a\x24\xcf\x2e\xe9\xd1\xe1\x47\x2a\x12\x0a\xa0\x44\xc3\x92\x46\x75\x15\x02\x31\x27\x6a\xf4\xeb\x45\x5d\x95\x6e\x93\xe3\x34\x8a\xb0\x18\x73\xac\x1a\xf3\xb4\x9e\x36\xb7\x89\x3b\x87\x92\xef\xfb\x3f\x52\x08\x57\x33\x0c\xa6\x49\xf0\xc8\xdd\xaf\xdf\xa2\x04\x12\x71\x18\x0c\xf8\x0a\x34\x8a\x39\x72\xf1\x3c\xdb\x1a\x0a\xc9\xa2\x06\x9b\x23\xa5\x0e\x65\xe0\x0e\xb3\x69\xfa\xd7\xe8\x9b\x2e\x0a\x7b\x8a\xdb\x16\x28\xfe\xac\xc5\x6e\x3b\x60\x31\x64\x01\x40\x3d\x7b\x93\x14\xe0\x70\x1d\xe4\x4e\x92\x4d\xfa\x1c\x16\xeb\x72\x74\xb7\xd5\xd1\x26\x8c\x79\x8a\xc4\x41\x52\x37\x24\xda\xea\xd4\x88\x00\xf5\xe6\x15\xfe\x6f\x2b\x7e\x02\x7a\xf0\x85\x8e\x6c\x58\xef\x6a\x6b\xc2\x4f\x55\xa5\x79\x40\x97\x0f\xc7\x3b\x62\x24\x79\x00\x61\x76\x94\x2f\x3e\x9c\xea\x8b\x8b\x83\xf8\x8f\xf7\xc5\x8e\x02\x2f\x70\xc7\x87\x93\xdd\x71\xb1\x71\x07\x2d\x35\x31\xa0\xdd\x49\x1c\x15\x4d\x78\x55\xda\x5c\x71\x48\xe5\xe3\x15\xee\xeb\x69\xaa\x9e\x7a\x5a\x88\xab\x4e\xce\x1d\x55\xe1\xaf\xcb\x21\x5a\xfe\x58\x05\x02\x9a\x55\x13\x3f\x89\x38\x11\xfe\x45\x0e\xbf\xda\x3f\xb0\xcb\x8d\x92\x51\x65\x61\x8b\x1e\x8f\xf4\x4f\x91\xb8\x7d\xfb\xb1\x05\xbb\x49\x05\x0a\x24\x35\x4b\x03\xc8\x73\xcd\x0c\xa6\x61\xd5\xd7\x12\xc5\x39\x8d\x5c\xe0\x46\x5d\xbd\xf3\x29\x6c\x71\x08\x75\x47\xa8\x4e\x16\xc7\x15\x3a\xdf\xa6\x34\x70\xac\x53\x3b\x9f\xda\xed\x9a\xcb\x21\x09\x70\xeb\x2b\x76\x7b\x4f\xdc\x9d\xaa\xc5\x3b\xcd\x15\x39\x16\x2f\x5a\xe2\x28\xcc\x5a\xce\x91\xb0\xbb\x0f\x5b\xe8\x7b\xde\x28\x3f\x1a\xdd\x0e\xf5\x4c\x20\x4b\x46\x7c\x5e\xd4\x71\x28\xc5\x64\xbf\x11\x24\x43\x40\xe0\xb0\x00\xac\xa2\x37\xea\x48\x5f\x6a\x98\x0a\x2d\x5b\x04\x85\x13\xba\xec\x06\x96\x4f\x9c\x65\xec\xc7\xa9\xe7\x04\x52\x6c\x10\x12\xd8\xf4\xf8\x85\xed\xb3\x69\x6c\x75\x13\xdb\x4d\xe2\x65\x3d\xcb\xbb\x48\x45\x53\x3d\xf4\x02\x10\x8d\xc4\x34\x90\xc1\x84\xba\x0b\xc3\x74\x18\x60\x8e\xea\x2b\xcc\x09\x0a\xca\x87\xfe\xbe\xf1\x0c\x1c\xdd\x8c\xbf\x08\x8e\x9e\x0a\xed\x7b\x25\x24\x3d\x5a\x1b\xd2\xf9\x39\x22\xb8\xe3\x69\x16\xab\x94\xe8\x04\x76\x27\x79\x43\x89\xf2\x4c\x2f\x84\x36\x07\xf3\xc3\xa6\x0b\x66\xc6\xd1\x24\x52\xbb\x44\x6d\xfc\x84\xcc\x70\x1c\xe6\x0c\x87\x29\x57\x66\x52\xbe\xf3\x3c\x1c\x2e\x18\x8b\x03\xb5\x20\x45\xe0\x5b\xd8\x07\x16\x37\x57\xd5\xf6\xc6\x3e\x49\x5f\xd6\xeb\x6a\xf5\x8b\x14\x0d\x0a\x16\xdc\xcf\x22\xc6\x54\xe9\x6c\xf0\x18\xec\x58\x7f\xde\x69\xa4\xb9\x94\x42\x7a\x98\x26\x68\x9f\x85\x30\xd0\x4d\xa7\x87\x5f\xa2\x04\x4f\x18\x26\x3c\x81\xc6\x6b\x0a\xd5\x0e\x6f\xbb\xea\x78\xcc\x21\x75\x1c\xaf\x74\x12\xc3\x9c\xfb\x56\xbb\x75\xfd\x40\x85\xdb\x12\xb8\x1d\x5e\x88\x2d\xa0\xd4\x45\x98\xd0\xf6\x5a\x60\xc2\x94\xc7\x7c\x80\xf6\x35\x07\x28\xb6\x85\xe7\x49\x0d\xf6\x9d\x50\x52\x03\x77\x56\xc0\xbe\x5a\x7b\xfe\x42\xdc\xd3\x24\x57\x98\x98\x01\x5e\x16\xae\x1b\x40\xff\x53\x93\x25\x51\x4c\x9b\x6e\x53\x01\xe0\x95\x58\xe9\x7d\x36\x48\xdc\xa6\x97\x6d\xd1\x69\x95\xde\x5c\x6b\x94\xa4\x12\x55\x19\xe0\x4e\xa3\x5e\x65\x6a\xe9\x99\xb0\xa1\x0f\xa6\x53\x88\x2f\xd7\x30\x3a\xd0\xf3\x37\xe1\xf7\x06\x07\x13\x35\x26\x0f\x8e\x04\x73\xb0\x6f\x40\xc1\x9e\x43\xed\x8f\xee\x31\x36\x0c\xf1\xad\xd8\x27\xb9\xcf\x67\xa3\x67\x9a\xa8\x1f\xa4\xe0\xff\x0c\xbb\x94\xb1\x07\x60\x83\x33\xfd\x7b\x31\x54\x37\xe0\x67\xc5\xe9\x38\xe0\x19\xf4\x3d\x14\x07\xd4\x21\x51\xa3\x7b\x89\x6b\x6f\x87\xad\x0e\xb6\x59\xcc\x66\x19\x97\x4b\xdb\xd2\x07\x9c\xe8\xf4\x9e\x0e\x56\xd6\x03\x5d\x31\xf6\xe0\xd6\xc3\x17\x08\xa1\xde\x6f\x77\xdd\x3f\x51\x9b\xed\x51\x24\xf0\xa5\x60\x43\x33\xe8\xf8\xc0\x98\x7b\x47\x11\x67\x78\xbe\x01\x9e\xec\xf3\xef\xad\xbb\x16\xf1\xd4\xbb\x85\xd4\xff\x03\x92\x2a\x87\xec\xb0\xcb\xce\x0d\x83\xc5\x27\x0f\x4a\xd0\x08\x2a\xa3\xc9\x43\xc8\x19\x2c\x43\x9b\x0c\x58\x08\x2a\x4b\xd7\x71\x90\xa5\x1c\xe2\xd2\x9c\xaa\x34\x2a\x8f\x75\xea\xe6\x02\x52\x91\x18\xd6\x04\x3f\x3b\xec\xec\x8c\x09\x9f\x2a\x1b\xbb\x30\xfa\x98\x61\xd0\x62\x73\x00\x05\xf2\xd0\x39\x7f\xc9\x68\x12\xc8\xe5\x47\xbe\xdc\x9c\xd5\xe8\x18\xc2\x93\xe4\xf4\xd0\x38\xd7\x35\x7a\x87\x72\x67\x9c\x5c\xd5\x23\x74\x5b\x5b\x12\x0a\x0d\x77\x0f\x5f\xd1\xde\x3d\x1d\xa9\x54\xad\x07\x64\xa8\x52\xb0\x9a\x46\x7c\x3f\x58\x0d\x57\x7c\xd0\x95\x7c\xeb\x15\x99\x25\x79\xbc\xd0\xc1\xb7\xab\x25\x16\xba\x71\x13\xad\xf0\x7d\x41\x12\x24\xa7\x42\xb2\x5b\x70\x57\x30\x80\x0e\x69\x12\x1d\x1e\x36\x84\xfd\x61\x02\x55\x92\xca\x18\x42\x33\x6b\x02\x66\x0c\xae\x08\x0d\x76\x06\x8c\x1a\xac\x24\xae\x9e\x6b\xf3\x14\xda\x7a\x61\x9b\x01\xb0\x50\xf1\x05\x24\x23\x4f\x06\x5c\x9f\x58\x7c\x6f\xe8\x08\xa7\xbf\x04\x20\xf3\x37\x87\x17\xa8\x0b\x4a\x28\x8e\xfa\xdf\x69\x36\x1a\x11\x17\x9d\x5c\x5a\x71\xcb\xa4\x9d\x6c\x5b\xb3\x4d\xfc\x86\xfd\xad\xce\x7f\xa3\x39\x4b\x2a\xbf\x50\x51\x2b\xe4\x43\x88\xd0\x99\x7f\x1d\xc3\x4e\xc2\x35\x4b\x4a\x2c\x82\x10\xc1\xe3\x3a\xff\x8c\x47\x50\xf7\x99\xdf\xe1\x0b\xe5\x7a\x25\x3d\x71\x4a\x91\x9e\x86\xab\xac\x6a\x59\x96\x31\x49\x7e\xaa\x49\x8c\xd0\x20\x6f\x69\x18\x0d\x4f\x96\x1f\x04\x09\x3c\x81\xb5\xf1\x9f\x12\x58\xe0\x8c\x88\xad\x69\x2b\xd7\x35\x13\x38\x33\xff\x1e\xe6\xbb\x38\x55\xdb\xa7\xc2\x40\x65\x0b\x69\xe1\x68\x81\x4d\xcc\x53\x5c\x9d\x15\xe5\x7a\x79\x35\xc8\x25\xb5\xa4\x74\xbd\x5f\x6a\xc6\xd9\xa6\xbc\x9a\x71\xe2\x0f\x44\xb0\xad\xfe\x0f\x28\x59\x5e\xfb\x6a\x1a\x00\x00"
+
+func oracleWhereGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_oracleWhereGoTpl,
+		"oracle.where.go.tpl",
 	)
 }
 
-func mssqlQueryGoTpl() (*asset, error) {
-	bytes, err := mssqlQueryGoTplBytes()
+func oracleWhereGoTpl() (*asset, error) {
+	bytes, err := oracleWhereGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mssql.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "oracle.where.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mssqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _postgresEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x3d\x6f\xdb\x30\x10\x9d\xc5\x5f\xc1\x0a\x46\x23\x05\x8e\xbc\xa7\xf0\xd2\xa2\x43\x0b\x34\x05\xea\xb6\x4b\x90\x81\x96\xa9\x98\x8d\x4c\x35\x24\xe5\x34\x30\xf4\xdf\x7b\x47\xd2\x32\xe9\xcf\x78\xf0\x10\x85\x26\x8f\x8f\xef\xde\xbb\xa3\xb4\x5a\xdd\xd0\x81\x79\xfd\xcb\xe9\xed\x98\x16\x77\x6c\xc1\xe9\x4d\xd7\x91\x15\x4e\xeb\x79\xa3\x0c\xce\x67\x76\x24\x71\xd1\xc5\xa6\x5c\xb6\x8b\xdf\xac\x4e\x69\x6a\xf8\x3f\x03\xff\xa6\x6d\x05\xcf\xe6\x09\x1e\x5a\x95\xf0\x9c\x31\xc3\xf0\x07\xfc\x71\xa5\xd2\x7c\x03\xab\xf8\x92\x2b\xcd\xf1\x2c\x6d\x4f\xfd\xe1\x26\x3e\x35\x52\x1b\x37\x8b\xb1\xa3\x11\x5d\xad\xfc\x79\x5d\x47\x85\xa6\x66\xce\xe9\x15\xcc\x15\x9f\xe1\x74\xfb\xb0\x7c\xbb\xee\x8a\x22\x1f\x6a\x43\x2b\xd5\x2c\xa8\x2e\xe7\x7c\xc1\x5c\xf0\xc4\x8d\x31\xac\x20\x36\x24\x84\xc5\x88\x8f\xac\x7c\x12\xf2\x11\x7e\x12\x52\x22\x09\x9a\x59\xa6\x8a\xc9\x47\x4e\x0b\xc8\xb3\x05\x4e\xb0\x9a\x38\x4e\xa2\xda\x4a\xa2\xeb\x10\xc6\x93\x09\xd0\x61\xc8\x6b\xbd\x3b\x19\x84\x72\x39\xdb\xce\x0e\xce\xb3\xc9\xd9\x73\x6d\x76\xc1\xee\x82\x24\x97\x61\x30\x0e\x4f\xc9\x62\x59\x72\xe2\xe3\xd0\x97\x9c\x6c\x59\xe3\xf5\x51\xdc\xb4\x4a\x6a\xca\xea\xda\xe6\xb2\x74\xd3\x4d\x65\x7f\x45\x29\x54\xad\x2c\x77\x11\xb2\x9c\xde\x3f\x44\xde\x90\xc4\x81\xc6\xf3\xab\x03\xe6\x5c\x46\x98\xa1\x3d\xcd\x8d\x49\xd2\x91\xce\xa6\x3f\x31\x0a\xa5\x59\xe7\x8c\x19\x6a\x37\x65\xd3\x3e\x9c\x35\x2a\xeb\x1b\xcb\x55\xdf\x7a\x3d\xf7\x98\xa0\x82\x47\x72\x79\x42\x46\xfc\x79\x63\x46\xea\x16\x53\xcb\xc6\xab\xe3\xa6\x22\xe4\xdc\xb1\x76\x19\x92\x64\xc9\x14\xf5\x2d\xeb\xa3\x09\x49\xf4\x8b\x30\xe5\x9c\xc6\x84\x0e\x68\x5b\x32\xcd\x2f\x53\xfa\xb7\x60\xdc\x9a\xda\x98\xa6\xfb\x1a\x20\x0d\xcb\x0f\x2c\xe8\x13\xf7\xfb\x42\x87\x9c\x3d\x5f\x34\x4c\x8b\x19\x9d\x71\xc3\xd5\x42\x48\x20\x2a\x76\x1c\xc1\xa6\x6b\xa4\xf5\x4a\x18\xed\xeb\xf5\xb4\x4d\x1e\x1b\x7c\x9a\x36\x4d\x8d\x45\xba\x5f\xc8\x5e\x33\xa7\xe6\x40\x0c\xe9\x60\x69\xef\xbb\x5e\x57\x2f\xa8\xc0\x32\xa3\xbd\x22\x07\x65\x1e\x2c\xcf\x10\x3a\x0a\xee\x81\x37\x9a\x7b\x09\x8d\x6a\x79\xa4\x69\xc5\x00\xcd\xcb\xf8\x8d\x29\x3d\x67\xf5\x4f\xb8\xe2\xe9\xc2\x8d\x75\xac\xa0\x34\x0d\xc5\x37\xc0\x69\xd9\x02\x2c\x90\x2e\xbb\x7f\x98\xbe\x1a\x3e\xa4\xf0\x6e\x68\x54\x1e\xb5\x3a\x2e\x44\x40\xc5\xba\x33\xf2\x21\x95\xa2\xf6\xe4\x7e\xc9\x45\x40\xaf\x95\x7b\x09\xda\xb7\xc1\x41\x82\xd7\x11\xc3\x08\x30\xc3\x4d\x9e\x4c\xee\x58\x06\x56\xfb\x8e\xc3\x98\x3c\x39\xda\x33\xfb\x0b\x1a\x0d\xb8\x8e\xa8\x8c\x2f\xd3\x5d\x64\x33\x22\xc9\x8c\x57\xac\xad\x4d\xe0\xbe\xcd\x4b\x17\x77\xfc\x25\x4b\x85\x5c\xda\x9e\x09\x10\xd3\x3c\x2a\x8d\x8d\xf6\xde\xcc\xaf\x93\xef\x77\x47\x0a\x83\x51\x1b\xe0\xc4\x7a\x73\x85\xe0\x9e\xa3\x15\xf2\x47\x37\xb2\xf0\xc1\x07\xea\x64\xbb\x44\x2c\x8f\x63\x25\x72\x9a\xea\x81\x5a\xb1\x6c\xf1\x5b\x67\xb7\x56\xf0\xd2\xd5\xeb\xeb\x36\xc1\x7b\x5c\x29\xec\x7f\xcb\xbf\x07\xb0\x9b\x87\xf4\xbd\xce\x3f\xd8\x80\x77\x63\xd4\x19\xf7\x07\x26\x45\x36\x44\x29\xc7\x45\xeb\x9b\x47\xf7\x0a\xb8\x8a\xd3\xcc\x08\x5d\x09\xee\x5f\x54\xcf\xf5\x68\xa6\x04\x94\x99\xab\x56\x85\x6e\x71\x55\xb1\x12\xbe\x9e\x90\xfa\x39\x2f\x2d\x8b\x80\x76\x85\x88\x7b\x4c\xdb\xeb\x53\xd8\xce\x93\x92\xc9\x2d\xa2\xa8\xcc\x14\x9a\x68\x04\x8c\x0b\x5c\x97\x67\x73\x8d\x5d\x43\x8c\x0c\x3e\x4f\x37\x20\xab\x6e\xb7\xb9\xe1\x53\x16\x6d\x82\xb8\x22\xc3\xad\x79\x7f\x99\x3b\x79\x83\xfe\x39\x62\x05\xa0\xe4\x7e\x9b\x2b\x81\xb7\x6d\xf3\x0e\xe2\xee\xb8\xf9\x4e\xb7\x2a\x04\xff\x07\x5d\x62\xe9\xdc\xce\x0b\x00\x00"
 
-func mssqlQuerytypeGoTplBytes() ([]byte, error) {
+func postgresEnumGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mssqlQuerytypeGoTpl,
-		"mssql.querytype.go.tpl",
+		_postgresEnumGoTpl,
+		"postgres.enum.go.tpl",
 	)
 }
 
-func mssqlQuerytypeGoTpl() (*asset, error) {
-	bytes, err := mssqlQuerytypeGoTplBytes()
+func postgresEnumGoTpl() (*asset, error) {
+	bytes, err := postgresEnumGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mssql.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.enum.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mssqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4f\x6f\xdb\xb8\x13\x3d\x4b\x9f\x62\x2a\x14\x88\xfd\xfb\xb9\x32\xf6\x1a\xc0\x87\x6e\xe3\x62\x83\x4d\x93\x20\x71\x76\x7b\x8b\x69\x73\x54\x73\x23\x91\x09\x49\xb9\x31\x04\x7d\xf7\x05\xff\x48\x91\x2c\xc5\x91\x9b\x3d\xd4\xaa\xc4\xe1\xe3\x70\x66\xde\x9b\x49\x51\x7c\x82\x8f\x6a\x23\xa4\x86\xd3\x19\x8c\xec\xff\x38\xc9\x10\xe2\x4b\xf3\x1b\xa1\x94\x11\x44\x12\x55\x04\x91\x7a\x4a\x95\x36\xaf\x74\x15\x41\xf4\xfd\xea\x42\xfc\x88\xc6\xf0\xa9\x2c\x43\x8b\xa2\xc9\x2a\x45\x87\xb2\xde\x60\x46\x20\xbe\xf5\xcf\x85\x59\x71\xbf\x06\xf5\x65\x0f\x4b\x20\xfe\x22\xb2\x0c\xb9\xb6\xdf\xa6\x53\x28\x8a\x97\x4f\xde\x0a\x53\x85\xcd\x65\xeb\x59\x59\x82\xc4\x47\x89\x0a\xb9\x56\x40\x40\x8a\x9f\x90\x48\x91\xc1\x49\x51\x54\xbe\x94\xe5\x49\xec\x10\x38\x35\x60\x7a\xf7\x88\x2d\x04\xa5\x65\xbe\xd6\x50\x58\x23\x49\xf8\x0f\x84\xf8\x2b\xc3\x94\x2a\x63\x1e\x34\x4d\x8b\x02\x24\x5a\x80\x78\x61\x7e\xcb\x12\x96\xff\x28\xc1\x4f\x23\xe7\x71\x6a\xfe\xe5\x19\xf7\xf6\xd1\x12\xea\xcb\xec\x2d\x35\x3d\xaa\x82\x70\x2d\x59\x46\xe4\xee\x4f\xdc\x99\xaf\x61\x30\x9d\xc2\xb3\x80\xc4\xba\x12\x06\xf7\xf8\xcc\x94\x56\x13\xb8\xa7\x98\xa2\x46\x0a\x2b\x21\xd2\xb0\x28\x2a\x98\x32\x34\x2f\x5d\xa0\xe9\x14\xe6\x76\x2b\x50\xd4\x28\x33\xc6\x51\x19\x33\xbd\x69\xc7\xc1\xe1\x03\xe3\x76\x85\x12\x4d\x56\x44\x61\x1c\x26\x39\x5f\xc3\xc8\x04\xd4\x95\x48\x59\xc2\xff\x1a\xfb\xc6\x1e\x7d\x34\xb6\x0e\x41\x11\x06\x12\x75\x2e\x39\x34\xb7\xc4\xde\x7d\xe3\xe5\x74\x0a\x67\xfe\x0a\x8f\x52\x6c\x19\x35\xfe\xf0\x44\xc8\x8c\x68\x26\x78\x9f\x6f\x1b\xa2\x60\x85\xc8\xa1\xba\xbb\xcd\xf2\x91\x7e\xfa\x43\xdf\x72\xd4\x1f\xe1\x3d\x3d\xe7\x0a\xa5\x06\x66\x1f\xaa\xe3\x98\x16\xc7\x7a\xe1\x00\x47\x74\x05\xdf\xaf\xce\x7e\x1f\x03\x4a\x29\xa4\x71\x66\x4b\xa4\x79\x71\x1f\x5c\xfa\x59\x02\x24\x95\x48\xe8\xce\x65\x67\x02\x2b\xc2\xd2\x30\x60\x49\x6f\x70\x0d\x4a\x75\x27\x8b\xa2\xe2\x4b\xfc\x39\x8a\x9c\xf3\x90\x10\x96\x22\x3d\x6d\x43\xaa\x68\x1c\x06\x2f\xa5\xe3\x58\xfa\x8d\xf0\x9c\xa4\xd7\x0f\x60\x19\x30\x9d\x82\x7a\x4a\x7d\x08\xe0\x29\x47\xb9\x9b\xc0\xa3\xab\x31\x78\xc0\x1d\x64\xb9\xd2\xb0\xc2\x2a\x9b\x34\x0c\xd6\x82\x2b\x0d\x4e\x2b\x60\x06\xcb\xf3\xcb\xdb\xf9\xcd\x02\xce\x2f\x17\x57\xd0\xa4\x26\x8c\x96\xf0\xff\x30\x08\x96\x45\x01\x6b\x91\x1a\xd1\x51\x0d\xf6\xf9\xc5\x31\xfc\xf5\xf9\xe2\x6e\x7e\xbb\x67\xbd\x25\x69\x9f\xf1\xd2\xc5\x4e\xe6\xdc\xf9\x1a\x06\x56\xa5\x46\xce\x9b\x89\x39\xdf\x72\xaa\x7d\x58\x1d\xcc\x71\x18\xdc\x4f\x6c\x22\x66\x40\x57\xf1\xfc\x19\xd7\x47\x6c\x65\x89\xdd\xfa\x61\x06\x9c\xa5\x7b\xf9\xb0\x71\xb6\xd1\x44\xed\x82\x8f\x7c\x8d\x56\x61\xba\xa9\x9c\x81\x96\x39\x5a\x7a\x1b\xe5\x1b\x94\x87\x2a\xfe\xb0\xda\x01\xa3\xc8\x35\xd3\xbb\xff\x28\x17\x0d\x4d\xa9\x4a\xf9\x88\xe4\x1c\xd8\xfd\xae\x6c\xf5\xe0\x8e\x0d\xab\x95\x4b\xe0\xe9\x91\x19\xec\x87\x1b\x94\x52\x89\x5a\x32\xdc\x22\x30\x1a\x06\x8c\xd6\xe7\x4b\x54\xf1\x05\x51\xda\xb1\xfe\x9c\x8e\x8e\xa9\x91\x66\x6e\x09\xa7\xaf\xd6\x8c\xd1\x97\xae\xeb\x30\x83\xbd\x05\xdf\xb3\x46\x8c\x8e\xdf\xae\x3a\xd7\x54\x6a\x8d\xe4\x2c\x7d\xe9\x30\x1c\x61\x34\x3c\x8c\x63\x88\xa2\xaa\x80\xef\x1e\x29\xd1\x08\xb9\x7d\x74\xe5\xb4\xd3\x7c\x82\x37\xf5\xd4\x21\xf6\xe8\x69\x47\x50\xbd\xa2\x52\x81\x8a\x9f\xe8\xb6\xa2\x9a\xa4\x7c\x78\x55\x53\xfb\x44\xd5\x5d\xa1\x16\x55\x83\x0a\x5c\x78\x58\x23\xaa\x36\x93\xd5\x99\xae\xa7\x34\x4f\xeb\x6d\x3a\x43\x4f\xcb\x88\x7c\x30\x5d\x50\x48\x07\xcd\x04\x6f\x1d\x69\x74\xc2\xd3\xa9\xc3\xff\xbb\xeb\xb3\xcf\x8b\x79\x9b\xfa\xb7\xf3\x05\x38\x46\xb6\xe8\x6f\x21\xea\xf4\x46\x13\x88\x5e\xa7\x72\xb0\x84\xbf\xff\x98\xdf\x58\x60\xbf\xbf\x65\xfc\x45\xa4\xae\x28\x3f\x3a\x83\xb5\xc8\xb9\x3e\xa8\x10\xfe\x2e\x0d\x69\x78\xa7\x36\x4c\x60\x00\x6d\x4c\x18\x7f\xad\x01\xbc\xe7\xc4\x1e\x05\xb8\x25\x5b\x04\x45\xb6\x3d\x34\xe9\x4c\x1d\x6f\xd3\xc4\xa0\xf5\x91\x64\xbf\x12\xeb\x61\xae\x59\x89\x2d\x8b\x9a\x70\x75\xc1\xf5\x59\xd5\x63\x8e\x1d\x2f\xf6\xda\x98\x57\x01\xa5\x89\xc6\xcc\xce\xee\x22\x63\xda\xd4\x3f\xcd\xd1\xdc\x2e\x25\xeb\x07\x10\x89\x1f\x7e\x41\xe8\x0d\x4a\xd0\x1b\xc2\x9b\x9a\xd8\x94\xa9\x7a\xa6\xf4\x54\xeb\xc6\xec\xd7\x27\xc6\xc1\xb3\x5a\xaf\xb2\x1c\x14\x96\x86\xb8\x56\x69\xef\xaa\xc5\x41\xb1\xe8\x41\x68\x90\x7f\x9f\xfb\x67\xf3\x8b\xf9\x62\x0e\x5f\x6f\xae\xbe\xb5\x05\x60\x20\x75\x7f\x1b\xd0\xae\x07\x94\xfb\x21\x7e\x0d\xd8\x3e\xb8\x81\x56\xa3\x7c\xd0\x1f\x3f\xdf\xed\xf6\x7a\x5c\xe3\x2f\xb3\xf0\xdf\x00\x00\x00\xff\xff\x79\x34\xf0\x19\x1a\x0f\x00\x00"
+var _postgresForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\x6d\x4f\xdb\x48\x10\xfe\x1c\x7e\xc5\xd4\x8a\xc0\xee\x05\xb7\xf7\xb5\x15\x1f\x28\xa4\xf7\x52\x8e\xf4\x80\xde\x21\xa1\xa8\x98\x78\x9c\x58\x6c\xd6\xc9\x7a\x73\x0d\x17\xf1\xdf\x6f\x66\xd6\x76\xec\xc4\x40\xa9\x5a\x9d\x04\xce\xbe\xcc\xce\x3e\xf3\xec\xcc\xb3\xbb\x5a\xed\x43\x37\x9f\x64\xc6\xc2\x9b\x03\xf0\xa5\xa5\xa3\x29\x42\x78\x71\x37\xc3\xf0\x94\x9a\x01\xec\xdf\xdf\xef\xac\xc8\x30\x4d\x60\x6c\xc1\x57\xa8\x21\x7c\x9f\xa2\x8a\xf3\x00\x7e\x96\xd9\x57\xaf\x60\xb5\x02\x31\x87\xfb\x7b\x30\x68\x17\x46\xe7\x60\x27\x28\xe3\x67\x98\x54\xee\x78\x3e\xca\xf3\x6c\x94\x46\x16\x63\xf8\x92\xda\x49\x65\x57\x37\xda\xcb\x79\xc8\x44\x7a\x8c\xd0\x4d\x7b\xd0\x4d\x18\x61\xb1\x2f\xcd\xd3\x24\xe1\xe9\xa6\xd4\xec\xb1\x25\xea\xd8\x8d\x76\x93\xd2\x45\x35\x0a\xfe\x37\xbb\x3a\xca\x14\xff\x2f\xa6\x7a\xd3\x69\x10\x0a\x29\xa8\x72\xfc\xb1\x1c\x38\xa0\xd5\x42\x7f\x3d\xb4\x05\xae\xc4\x24\x00\x09\x11\x83\xfa\x05\x35\x1a\xd9\x27\x31\xd9\x14\x92\xcc\x60\x3a\xd6\x70\x8b\x77\xb0\x27\xae\xdc\xc0\x07\xbc\xab\x35\x4b\x00\xe0\x0f\x4e\xe1\xb8\x7f\xd2\xbf\xe8\x0b\x94\x81\x3e\x46\x85\x16\x85\x2a\x9a\xfa\xf4\xf1\xf8\xb0\x9a\xfa\x34\x8b\x23\x5b\xc0\x48\x16\x7a\x24\x50\x8b\xec\x22\xe0\x2f\x37\xc3\x0b\xea\x84\xb1\xed\xc8\x2e\x67\x91\x89\xa6\xd4\x8d\x6f\xe0\x72\x70\xfc\xae\x07\xd9\xcc\xe6\x10\x86\xe1\xe5\x60\x30\xb3\x69\xa6\x03\xf0\x5f\xb6\xf0\xd9\x03\x34\x26\x33\xe4\xf2\x91\x54\x25\x4e\x3a\x3c\xdb\x35\x98\x14\xa7\xcf\x89\x70\x56\xf5\xd8\xc0\x1d\x5c\xdb\x99\xbd\xbb\xab\xd2\xa8\xb1\xa6\x16\x45\x95\x1d\x45\x38\x91\x19\x4b\x30\xbd\x27\x93\x79\x94\xe9\x7f\x70\x69\x4b\xbe\xc8\xc2\x4f\x75\x8c\xcb\x3a\xd8\x6e\x1a\x34\x73\x94\xc9\x21\x6e\x82\x75\x26\x7e\x45\x04\x15\xf6\x0d\xea\x1b\x58\x37\xe0\x38\xa8\xeb\xa5\x02\xa3\xb9\xbb\xcb\xb9\x4a\x29\x70\xde\x46\xbf\xb0\xaf\x1e\x17\x1c\xf0\xe8\x28\x3d\xf0\xf2\xb9\xca\x2d\x37\xe2\x1b\xfa\xcc\xe9\xdf\x60\x4e\xdf\xcb\xc1\x49\x36\xe6\x5e\xf6\x25\x97\xc1\x84\x7f\x52\x4d\x1f\x0a\x41\xda\x31\x7d\x18\x9d\x17\x54\x9b\x9a\xd6\x4d\x1b\xfc\x7c\xc7\x7d\xcb\x20\x6b\xfb\x63\x62\xa3\x1b\x85\x0e\xc1\x68\x82\xd3\x68\xbd\xfd\xf9\x46\xff\x82\x2d\xdd\xd7\x49\x70\xe9\x85\xb0\x35\x1d\xcd\x95\xeb\x3c\xcb\x15\xcb\xc2\x49\x16\xc5\x9b\x05\x59\xd7\x2f\x45\xf3\x95\x7a\xcd\xd4\xc2\x44\x2a\xfd\x77\x93\xb1\x07\x74\x8c\x19\xda\x7b\xae\x74\x31\xa8\x54\x43\x04\x79\xaa\xc7\x14\xd1\x7c\x81\xe6\xae\x07\x11\xe5\x55\x8e\x56\xa0\x4c\x69\x37\xc0\x68\x34\xe1\x1d\x48\x1c\xcf\x50\x85\x35\xcc\x85\xea\x3c\x11\xd9\x43\x42\xc3\xa0\xe1\x6a\xb8\xa5\x52\x6d\x12\x24\x5a\x43\x52\x23\x6a\xb2\xcc\x32\x19\xce\x2b\x7d\x59\x66\xa9\xa6\x14\x5a\x4c\x51\x93\x08\xcd\x4c\x4a\x3f\x1e\xc3\xf2\xea\xae\x8b\xdb\xf5\xa1\x93\x02\xef\x9c\x74\xf7\xe8\xc2\x13\xb7\x44\xce\x28\x53\x0a\x47\x16\xe2\x34\xb7\xa9\xa6\x06\x49\x78\xce\xd5\x9e\x88\x8c\x4d\xa3\xd9\x15\x8b\x0c\x5a\x72\x56\x2b\x72\xf6\x4d\x2e\x86\x6d\xaa\xb9\x22\xcf\xc4\xb9\xac\xbe\x45\xff\x6a\x48\xa8\x89\xfd\x1e\xbc\xee\x01\x15\xaf\xcf\x9c\x04\xc1\x4e\x87\xf3\xbb\x66\x45\xf1\xa0\x49\xa2\x11\xae\xee\xb7\x4c\xe9\x7e\x81\xcf\x22\x21\x65\x9d\xd3\xc1\xd3\x52\x27\x7e\x42\x72\xc1\x1b\x89\x44\x9a\xeb\x85\x52\x0e\x70\xa9\x2b\x3b\x9d\x0e\xcd\xbc\x68\x38\x08\xb7\x72\x29\xfc\x8b\xf2\x31\x66\x57\x9d\x0e\x69\x15\x11\xb2\x40\x6a\x17\x07\x50\x88\x11\x79\x8a\x79\xef\xba\x9e\xa9\x07\x05\xcd\x6d\x4c\xd8\xb3\x5b\x01\x4c\xbc\x5e\xa5\xf1\xf0\x2d\xf7\x5b\xf6\xe9\x94\x06\x70\x00\x3a\x55\xbc\x5a\x53\x33\x9a\xcd\x68\x77\xd2\x6e\xe1\x40\xdb\x89\x24\xda\x38\x03\x8f\x59\x62\x22\x03\x4f\xd2\xbd\xe3\x58\xad\x56\x70\x4f\xd6\x50\x4d\xb3\x14\x8b\x9a\xd4\xf1\xd1\x12\x3e\xae\x04\x2a\x47\x70\x70\x00\xaf\x05\x5b\x21\xf9\x82\x83\xea\x9b\xd3\x85\xdc\xb8\x1a\xda\xe9\x38\x45\xe3\xa0\xae\x5d\x4e\xc1\x35\xfc\x44\xab\xae\x85\x18\xc5\x52\x98\xaf\x73\xa3\xba\x95\x4a\xab\xf7\x67\x83\x3f\xe4\x40\xeb\xea\xb3\x9e\xfe\xfb\xd7\xfe\x59\x1f\xd6\xae\x6a\xb9\x47\x15\xce\x86\xbf\x9d\x82\x4f\xc6\xe0\xb2\x2b\x0f\x7f\xa7\xfa\x10\x86\x3c\xfa\x0b\x68\xe2\x3a\x70\x6f\xb0\xb5\x8a\x65\x89\x75\x2f\x8d\x32\x7a\x38\x3c\x3d\x16\x76\x68\x26\x96\x19\x3a\x8e\xb8\x5a\x51\xbf\x7b\xaf\x1d\x01\x66\xa1\x4b\x02\x44\xba\x7d\x47\x03\x49\x0a\x71\x57\xdd\x5b\xb4\x2b\x8d\x2f\x8b\x8b\x53\xb2\xfc\xea\xc1\x5a\xa1\xba\x67\x03\x5e\xc0\x29\x19\xdf\x24\x9a\xca\x14\xb9\x2c\xbd\xb6\x8b\x74\x97\x3c\xf6\x60\x6b\x5f\x3e\x44\x76\xf5\x42\x32\xa7\x7e\x80\x34\x2a\xa7\x5c\xab\x22\xd3\x52\x45\x58\x2f\xa2\x0a\xe8\x47\x93\x4e\x23\x73\x47\xcf\x37\x97\xcd\x8d\xd5\xe1\x67\x5c\x92\x70\x70\xc2\x91\x32\xe1\x46\x9d\x48\x2e\x37\xed\xdb\x5e\x0a\x9c\xeb\x0d\x2b\x06\x5b\xbd\x3d\xf8\xd1\xc5\x8f\xcd\x74\x54\xbe\xa4\x72\x79\x96\x31\xf0\x65\xf6\x27\x1f\xc5\xa1\x52\x57\x2d\xdc\x0e\xb7\x98\xfb\x51\x9c\x7d\x9f\x48\xb9\x3b\xaf\x62\x8b\x6f\xd6\xc9\x20\x51\x6e\xe5\xc2\x37\x44\x13\x63\x82\x06\xe6\xe1\x91\xca\x72\xf4\x03\x97\xd2\x7c\x29\x73\x24\x0b\x65\x73\x17\xf0\x3c\x3c\x25\x59\xf3\x03\x71\xb1\x15\x7a\x5b\x1a\x8b\xdd\xe3\x89\xd3\x29\x72\xe5\x8d\xa4\x4a\xcf\x79\xe6\x5c\xd9\x97\x69\x16\x18\x51\x98\x51\xa4\xa9\xc5\x71\x1c\x10\x90\x73\xea\x72\xd4\x09\xf3\xd8\xae\x29\xe5\x5d\xb8\xeb\x95\x40\x83\x42\x0b\xb7\xf9\x68\x10\xe2\xf6\x7c\xc6\xd9\xed\x6e\x1d\x5e\xb9\x05\x43\xed\x1b\xe3\x07\x6f\x1f\x3f\x81\x5a\x7d\x08\xf7\x91\xb5\xfc\xf2\xb0\x99\x5c\x62\xff\xcb\x45\xd7\x34\xdd\x78\xfd\x54\xd7\xd0\x63\xf7\xe1\x53\x1e\x4a\x86\xbf\xe2\xba\x1c\xba\x9b\xa6\x76\xf3\x34\x38\xfb\x0f\x9e\xe5\xdc\xee\x5a\x10\x00\x00"
 
-func mssqlTypeGoTplBytes() ([]byte, error) {
+func postgresForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mssqlTypeGoTpl,
-		"mssql.type.go.tpl",
+		_postgresForeignkeyGoTpl,
+		"postgres.foreignkey.go.tpl",
 	)
 }
 
-func mssqlTypeGoTpl() (*asset, error) {
-	bytes, err := mssqlTypeGoTplBytes()
+func postgresForeignkeyGoTpl() (*asset, error) {
+	bytes, err := postgresForeignkeyGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mssql.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\x15\x85\x58\x21\xf1\xd2\x4b\x0f\x81\x3d\x95\x1e\x9b\x43\xd3\xe6\x52\x7a\xd0\x7a\x47\x59\x11\x5b\x6e\x24\x79\x93\x60\xf4\xef\x65\x24\x99\x95\xd3\x4d\x4b\x0a\x7b\x59\xc4\x68\xfc\xe6\xcd\x7b\x4f\xec\x34\x5d\xc2\x7b\xff\xfc\x0b\xe1\x6a\x0d\xcd\xb5\xec\x11\x2e\x43\x60\xb1\xec\x76\x83\xf5\x54\xaf\xe3\xc9\xd0\x65\xea\xe5\x68\xc6\xfe\x56\x76\x1c\xb8\xc7\x27\xcf\x81\x6f\x46\xc5\x81\x0f\xf7\x1c\xb8\xb3\x2d\x17\x07\x14\x8b\x7b\xb4\x0e\x09\xda\xc5\x21\x5f\x53\xe1\xd3\x60\x9c\x4f\x55\xea\x5d\xad\x60\x9a\x32\x7c\x08\xa0\x1d\xf8\x1d\xc2\xd9\x34\x41\xf3\xd9\x8c\x7d\xfc\x89\xf4\x42\x38\x03\x1a\x0f\xb1\x55\xd9\xa1\x07\xd7\xee\xb0\x97\xa9\xf9\x26\x9d\xa9\xad\x61\xb1\xa5\x84\x1d\xb5\xf1\x1f\x3e\x32\xd6\xd2\x70\xa8\x23\x43\x2b\xcd\x1d\x42\x73\x2b\xbb\x11\x1d\x84\xc0\xaa\xc4\x45\xab\x17\xe4\x43\xa0\x01\x99\x44\x81\x3a\x4d\x80\x9d\xfb\xb3\x58\xb4\xa2\xd9\xbe\xdc\xea\x56\x76\x71\xa9\x38\x37\x6e\x55\x7c\xdd\xb0\xea\x34\x0c\xd6\xe5\x94\x7a\xe6\x11\xbd\x98\x89\x08\x96\xdb\xc9\x16\xc1\xc8\x99\x1b\x6f\xb5\xb9\x03\x8b\x7e\xb4\x26\xed\xe0\x52\x69\x1f\x3f\x1a\x54\xac\x2d\x16\x50\xa3\x69\x81\x26\xe4\x1c\x85\x50\xde\x8b\x8c\x59\x8b\x19\x69\x62\xd5\x5e\x5a\xc8\xc9\xca\x55\xc6\x2a\xf7\xa8\x7d\xbb\x83\x25\xd0\x2b\xc6\xb5\xd2\xe1\x69\xac\xbb\x62\x55\x35\x53\x5b\x03\x3f\x66\x20\x2f\x75\xab\x02\x63\x55\xd2\x6b\x5e\x89\x85\xa8\xe5\x17\x69\xdd\x4e\x76\xdf\xf0\xc9\x43\x9f\xce\x6e\x19\x7d\xe3\x07\xa0\x67\xf5\x6f\x0d\x0b\xac\x5a\x40\xfd\xe3\xe7\xe6\xd9\xe3\x05\xa0\xb5\x83\x15\xa4\x68\x66\x90\x2e\x16\x40\xcd\xac\xbf\xb8\x00\xa3\x67\x72\xdf\x4d\x5f\xd0\x1b\xcd\x51\x82\xf1\xcd\xbd\x4a\xf0\x7c\xc1\x70\x01\x58\xd3\x47\x99\x8c\x48\x2c\x89\x64\x76\x38\x39\x1e\x7b\x44\xf5\x57\x87\x8f\xcb\x4f\x16\x9d\x2f\xa8\xac\x4f\x93\x05\x76\x38\xb1\x6a\x8b\x4a\x8e\x9d\xa7\xe1\xb3\xdd\xb4\x97\x6b\xae\xf1\xb1\xe6\xda\xec\x65\xa7\xb7\xa5\x7c\x5c\x2c\xc2\x71\xd0\x3e\x2d\xe2\xa4\xd7\x4e\x69\xcc\xaf\xec\xa1\x5b\x6d\xad\xde\xa3\x4d\x22\x58\x4a\x07\x5a\x25\x5b\x04\x45\xea\xbd\xe5\xc5\x45\x04\xca\x49\x89\x78\x24\x2d\x47\x63\x52\xa6\xe4\xa6\x95\xe6\x05\xd1\xad\xf4\x72\x23\x1d\xae\xdc\x43\xd7\xd0\xbd\x79\x33\xd7\x65\x70\x08\xa3\x76\xb6\x3d\x80\x4c\xa1\xc8\xcc\x66\x54\x17\x30\xdc\xd3\x1f\x8a\xb3\x6d\x93\xa3\x2f\x58\xa5\x15\xbc\x1b\xee\xa9\x05\x00\xfe\xcb\x91\xc5\xfa\xcb\xfc\x6e\x46\x25\x48\x83\xdf\x01\x00\x00\xff\xff\x33\x36\x2f\x65\x36\x07\x00\x00"
+var _postgresIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xeb\x6f\xdb\x38\x12\xff\x6c\xff\x15\x3c\x61\x91\x5a\xad\xab\x6d\x80\xc5\x7d\xe8\x9d\x0f\x68\x13\x77\x77\x71\xb9\xa6\x97\x07\xb6\x87\x22\x68\x64\x89\x8a\x85\xc8\xa2\x4c\xca\x89\x0d\xc3\xff\xfb\xce\x0c\xa9\x87\x25\xf9\x95\x38\x69\x16\xb8\x0f\xb6\x25\x3e\x86\xc3\x79\xfe\x86\xf4\x7c\xfe\x96\xfd\xa4\x86\x42\xa6\xec\x7d\x8f\x75\xe8\x29\x76\x47\x9c\x39\x17\xb3\x84\x3b\x9f\xf1\xd1\xe2\x52\x5a\xcc\x52\xe3\x48\xa5\xf8\xe0\x0f\xe0\x6b\x0c\x1f\xc9\x15\x7c\x7f\x3d\x3d\x11\x37\xf0\xeb\xca\x1b\x7c\x15\xf8\x49\x52\x7c\x0c\x62\xf8\xf2\x44\x84\xcf\x3e\x57\xa9\xc5\x9c\x4f\x21\x8f\x7c\x65\xb3\xb7\x8b\x45\x7b\x8e\x6b\xa7\xee\x20\xe2\x7a\x6d\x6f\xc8\x47\x2e\x73\xce\xcd\x2f\x31\x70\x81\xdd\xfa\x1b\x79\x29\x4d\x04\x76\x4a\x73\xb3\x97\x2d\x66\xff\xfc\x33\x9b\xcf\x81\x93\x49\xec\xd1\xf6\x16\x0b\x26\x79\x2a\x43\x7e\xc7\x15\x73\x99\x14\xf7\x2c\x90\x62\xc4\x5e\xc1\x28\xc3\xde\x62\xf1\x8a\xb9\xd8\x89\x13\x0b\xc1\x2c\x16\x0e\x50\x43\x82\xbf\xf2\x98\x4b\x37\xe5\xbe\x9e\x1a\xc6\x3e\x9f\x12\x01\xe7\x77\x7c\xd4\xdf\x66\xce\x2b\x87\x36\x10\x06\x79\xa7\xba\x8c\xc3\xf1\x04\xfb\xda\x01\x70\x55\x65\xaf\x03\xef\x5e\x3a\x4d\x5c\xe9\x8e\xe0\xd5\x1f\xb0\xaf\xa7\xc7\x1f\xa1\xf1\x46\x50\x5b\x14\xaa\x34\x93\x2c\x4b\x25\x10\xa2\xaf\xc5\xa2\xcb\x50\x11\xcc\x71\x9c\xaf\xa7\xa7\x49\x1a\x8a\xd8\x66\x9d\xd7\xd5\x3d\x74\x19\xe8\x57\x48\x9b\xcd\xdb\x2d\x64\x6c\x2a\x04\x8d\x55\xc8\x8f\x69\x09\x63\x50\xfd\x64\xc4\xe3\xb4\xc4\x59\xa3\x8c\x99\x75\xde\x3f\xe9\x1f\x5d\x58\x66\x76\x66\x5d\x20\x65\x50\x54\x75\x6d\xb3\x24\xca\x82\x9a\xbf\xc8\x70\xe4\xca\xd9\xbf\xf9\x8c\xa6\xb7\xbe\xf3\x29\x6c\x4e\xbd\xa7\x1d\x75\x89\x1e\x8f\x7d\x52\x63\x6b\xd1\x6e\xb7\x40\xf4\x8a\x47\xdc\x43\xc9\x83\xa1\x4d\x46\xb1\x6a\xb7\xd0\xe2\xba\xb8\x14\x90\x05\xc3\x98\x02\xa9\xef\x38\x31\x52\xb8\x24\x1a\xa2\x21\x63\xf6\x6e\x18\xcb\x19\x75\xa6\xe2\x9c\x88\x76\xa6\xe2\x04\x96\xd7\xa2\x53\x1d\x14\xa6\xed\x1c\xe9\x65\xec\x76\x0b\xc8\xe3\xec\xbf\xf5\x58\x1c\x46\x28\xbd\x16\xd8\xd1\x44\xc6\xf8\x4a\x84\x0b\x1e\xc7\x11\x03\x05\xcb\x59\xbb\xa5\xbd\x08\x97\xbc\xd6\x82\x62\xd7\xec\x0d\xf2\xae\xe0\xe7\x1a\x5f\x80\xce\xf5\xa7\xb3\xd3\xff\x68\x9e\x32\xc3\x06\xf9\x99\xbe\x3f\x7e\xeb\x9f\xf5\xb1\x13\x26\xa1\xab\x2a\xa2\x9c\x1b\x00\x09\x92\x59\xec\xc3\xe7\x63\x86\x4a\xb8\xd6\x2c\xc8\x49\x9c\xb1\x40\x0e\xdb\xd1\x8c\x00\x19\x78\x58\x61\x46\x8b\x85\x4d\x22\xcf\xe4\x48\x62\xc7\x2d\xf7\xe8\xdd\x81\x2e\x7f\x10\xc4\xcc\xfa\x95\xa7\x56\x61\xa8\x10\x08\xc8\x4c\xbb\xec\xa0\x2c\xd6\x2e\xdb\x7e\xc9\xb7\x5a\x5b\xa5\x05\xfd\x41\xb1\xdc\x7f\x71\x1f\x67\xe2\xbe\xb6\xe6\x76\x0b\x40\x8c\x70\xe3\x0e\xda\x01\x78\x46\xb6\x1c\x99\xc3\xd6\x3a\x35\x8d\x95\xfd\xc1\x98\xf6\x22\x73\xee\x48\x78\xb7\x5e\xe4\x4e\x60\x1b\xd6\x24\xf1\x21\x38\x90\x4b\x34\x84\x9f\x4f\x42\x5e\xd2\x80\x87\xc7\x21\xa4\x1a\x85\xb7\xbc\x4a\xba\x4b\x6c\x84\xf1\x0d\x0b\x53\x36\x89\x53\xd8\x53\x3a\xe4\xb4\x5b\x11\xd0\x63\x2a\xdd\x58\xb9\x1e\x5a\x38\x36\x41\x70\xe9\x84\xe0\x69\x40\x2f\x8c\x21\xce\xfc\x11\xa6\xc3\x8b\xa9\xed\x34\x06\xa6\x9c\xf1\x67\x8c\x50\x46\xf2\xcd\x21\x32\xb3\xbc\x75\x2b\x07\xae\x8e\x03\x5d\xe6\x26\x09\x08\x82\xdc\xba\x0b\x0c\x17\xbb\xb1\x6d\x32\x0c\xad\x4a\x63\x19\x28\x91\x3e\x05\xa3\x6a\xf2\xf0\x79\xca\xe5\x28\x8c\x41\x6b\xa0\x76\xad\xb8\x4c\x91\x3e\x1b\xcc\xaa\xbc\x22\x25\x1d\xd6\x50\xc4\xcb\xda\x35\x72\x6e\x5c\x68\xbf\x42\x1e\x08\x11\xed\x18\xf9\x3b\x89\x0c\xe1\xc7\xd2\xdc\x59\x05\x73\xf6\x36\xa9\xe0\xce\x95\xe4\x5a\xb4\x64\x2d\x2c\x6a\x9f\xf5\xb9\x17\x15\x40\x03\x43\x1e\x46\x6a\x5a\x4e\x07\xb9\x8c\x05\x13\x3a\x0f\x19\x05\x4a\xab\x14\x27\x2d\xa6\xe3\xa3\xc5\x3a\x5b\xc4\x47\xdb\x6e\x8c\x90\xc8\xab\xb8\x65\x28\xa3\x5d\xc3\xe5\x13\x45\xab\x03\x71\xbb\x2e\xe3\x90\x51\xd7\xe3\x93\xb8\x2d\x07\x25\x93\x00\x0d\x64\x39\xe3\x6a\x12\x81\x59\xb8\x92\x33\x21\x7d\x2e\xc1\x58\xef\xc1\xdb\x29\x2a\x80\xa1\x60\x13\xd3\xf6\x00\xae\x02\x4e\x10\x85\xa3\x30\x5d\x1e\x74\x82\x4d\x48\x0c\xfb\x61\x4e\x10\x28\x9e\x9a\x49\xaa\x39\x66\xec\xc5\x8a\x8b\xac\x0c\x96\xfc\xed\xea\x59\x21\x8d\xc0\xdc\xdd\x00\x0c\xd6\xa3\x91\xef\x39\xd2\xe8\x1c\xd4\x40\x10\x28\x39\x87\x1c\xe2\x2f\x08\x30\x5a\x08\xfd\x71\xc5\x6f\x57\xe0\x9d\x5c\x06\xae\xc7\xe7\x8b\x39\x43\x41\x37\xda\xb6\x8e\xc1\x90\xdd\x99\xe1\x1f\x42\x71\x34\xcb\x0c\x07\x64\x8c\xc6\x97\x4b\x6c\x2a\xc8\x18\x8f\x28\x99\x82\x80\xe8\xed\xe3\xac\x0b\x1d\x55\x51\x9a\xae\x6d\x65\x87\xa3\x68\x2d\xd6\xeb\x31\xcb\x62\x07\x07\x4c\x38\x64\xd4\xec\x5f\xec\x1d\xcd\x32\xdd\x20\x9b\xd3\xb3\xe3\xfe\x19\xfb\xf8\x3f\x83\x2c\xab\x80\xd5\x6c\x0d\xd4\x59\x08\x6e\xed\x20\xe3\x8e\x4b\xc3\x97\xfa\x29\xf1\x5c\x13\x9f\x46\xa9\x6f\x7a\x9a\x5d\xcd\x78\x85\xd3\x62\xcc\x35\xb2\x48\xee\x6a\x00\x48\x27\xe2\x71\x51\x7b\x19\x87\x02\xca\x5a\x71\xbd\x2c\x13\xe2\x5b\x37\xa3\x8b\x0f\xda\xa1\xed\xdc\xcc\x56\x80\x48\x88\x0f\x30\x93\x72\xa6\x01\x3f\x06\x76\x63\x20\x32\x86\x51\xf3\xd1\xf9\x0a\x2c\xa9\xfd\xa0\x19\x4e\x02\xb5\x0c\x45\x96\xd6\xdc\x4e\xd7\x6b\xea\x0c\xe3\xb9\xa9\x4e\x35\x3c\xf6\x78\xbb\x15\x08\x89\x4e\x5b\x2d\x60\x00\x2d\xdd\x20\x4e\x53\xb4\xcc\x52\xd5\x60\x6a\x15\xd8\x10\x0a\x38\x5b\xd2\xc0\x87\x72\xfc\x6d\x8d\x73\xd3\xae\xe5\x89\x15\x49\x62\xe7\xdd\xb6\x7c\x1e\x80\xdd\x8e\x9d\xa3\x48\x80\xd3\x98\xe8\x14\x09\xd7\x47\xe6\x31\xf0\x6f\xd2\x0d\x0a\x60\xec\x7c\xe6\xd3\xb4\x63\xd7\x36\xbb\xa2\x96\x5b\x5f\xcc\xd5\xaa\xb9\xa5\x72\x8e\x6c\x8c\x14\x01\xf9\x0e\x4b\xbf\x2e\x43\x88\x0e\xa1\x73\x75\x7d\x56\x0e\x96\xc6\x98\xc6\x55\x74\xdf\x20\xaf\xba\xc0\xf4\xe2\x28\x90\xdc\x19\xc8\xd6\x96\x00\xbe\x5d\xd1\x69\x9e\x66\x69\xa8\xce\xb3\x98\x0c\x8f\x04\x20\xed\x2a\x4a\xf4\xb0\x51\x51\xde\x04\x80\xa8\x1a\xa1\x7d\x19\x35\x36\xd4\x09\x26\xa1\x36\x91\xdf\x2f\x36\x84\x20\xfe\xf7\x5f\x1e\x08\x0e\x89\xbb\xe7\xc1\x86\x26\xbd\x1d\x9d\x5e\x7e\xbe\xe8\xbc\xb6\x9f\xa5\x76\x46\x4e\x63\x46\x02\x7a\x29\xc8\x30\x5e\x17\x13\xde\xd5\x41\x61\x5c\xb6\xd5\x8a\x1d\xf5\x5d\x6f\xc8\x3c\x37\x02\xb0\x00\x0c\x12\xd2\xe3\xd8\xb4\xaa\x18\xdd\x60\xb1\x5d\x22\x21\x26\x29\x45\x1e\x2c\x43\x81\xb4\xb6\x7f\x10\xa1\x60\x23\x3e\x12\x72\xe6\xb0\xdf\x53\x3c\x3d\xc3\x12\x54\xa5\x22\x01\x4c\x9a\xa2\xa3\x20\xc1\x20\x94\xb0\x73\xb2\x0b\xa6\xf9\xd7\x35\x55\x00\xbb\xb8\x1f\x86\xc0\x5a\xa8\xf2\x8e\x66\xc4\x89\x7b\x7a\x84\x7f\x80\x1c\x90\x6a\xbd\x2a\xb5\x35\x5b\xab\x70\xa9\xe6\x79\x27\xe7\x29\xb8\xb6\x90\x69\x6b\x2b\xdf\xd9\x02\xfd\xa1\xe5\xd7\x51\x49\x8e\x35\xfe\x22\x98\x70\x15\xee\x7e\x52\xb4\xf8\x7f\xa0\xf8\xb4\x40\xf1\x06\x0f\xce\x43\x4f\x19\xb0\x48\x32\x9f\x0a\x0a\x8c\x25\xbf\x2d\x20\x20\xfa\x7d\x23\xad\xa7\x06\x57\x6b\x71\x55\x22\x85\xc7\x95\x2a\xa0\xd5\x8f\x06\x4f\x4b\x58\x08\x06\x06\xa8\xd1\x06\xe7\xcf\xb2\xf6\x81\x65\xd8\xb3\x75\xae\x5a\x03\x9a\x4a\x78\x49\xaf\x12\xc4\x9d\x2a\x4c\xda\x62\x7a\x39\x23\x8d\x9d\xbe\x94\x1d\xbb\x8c\xad\x96\x81\x96\x91\x0c\x9e\x32\x74\x62\x91\x56\x2f\x4e\x00\xb2\xf0\xb1\xb1\xdd\x46\x3f\xb2\xd9\xa1\x9d\xa1\xf0\x9f\x92\x5b\x54\x40\x93\x94\x75\xf7\x8e\xb7\x61\xde\xa6\x7b\x31\x6f\x22\x95\xc0\x7e\x72\x34\xf8\x8d\xc1\x2c\xe0\x27\xf4\x4b\xf7\x61\xcd\x27\xc3\x5f\xdc\x9b\xe5\x43\xe1\x04\x1b\x44\x80\x09\x72\x24\x20\x78\x12\xc9\xd5\x80\xd2\x55\x74\x38\x53\xb3\xb6\x6e\x7e\xe2\x03\xa9\x14\x21\xa9\xbe\xb0\x32\x67\x16\x24\xe7\x44\x4b\x86\xdd\x72\x08\x9d\x2a\x75\x65\x4a\xe9\x3b\x80\x50\x8e\x34\x0d\x8e\xd5\x10\xa1\x34\x96\xe9\xdd\xe2\x02\x9a\x23\x1c\xa8\x93\x38\x0d\x1f\x82\x8e\xf4\x10\x4c\xdc\x60\x1c\xd9\x0d\xda\xc5\x90\x17\x09\x7e\x69\x84\x9e\x04\x74\x24\xa7\xc3\xaa\x18\x70\x83\x90\x1a\x46\x37\x67\x7c\x14\xdb\x23\x32\xbe\x59\x1d\x13\x3e\x70\x84\x89\x0d\x6c\x46\x67\x38\x3a\x51\x27\x99\x83\xdb\x34\x62\xe7\xc6\xd3\xa8\x55\xa4\x1e\x82\xb0\x4b\x20\x01\xf7\xb9\x1d\x48\xa8\x02\x6c\xbc\x9f\xa0\x6d\xfc\x93\x1d\xd6\x4a\xc8\xac\x2c\x12\x52\x41\x08\xbb\xef\x68\xc3\x65\xa3\x09\x48\x6c\xc0\xd9\x8d\xe4\x2e\x58\x01\x68\xc4\x05\x7c\x69\xd9\x4d\x87\x50\x1b\x20\xfb\x0f\xc1\x24\xd9\xb4\x72\x7a\x6e\x4e\xa8\x20\x1d\x8c\x32\x9d\xa1\xab\x28\x70\xe6\xbd\xa8\x3c\x5d\xd4\xa0\xf6\x8a\xf9\xd4\x01\xa5\x68\x39\x1f\x97\x36\xb9\x3a\xc3\x66\x97\x11\xd7\x15\x09\x56\x3c\xce\x98\xe4\xb2\x5c\xbd\x17\x23\x58\x7c\x68\x14\x06\x40\x0e\x68\x8f\xd3\xa1\x76\xc3\xe5\xbd\xbf\x18\x8d\xb8\xbe\x5f\x61\xed\xb0\xa6\x99\x1c\xd0\x00\x04\xe1\xa9\x37\x24\xd5\x40\x36\x9b\xa6\x52\xdf\xf8\x40\x35\x93\x5f\x04\x21\xbb\x3a\x5e\x85\x18\xb4\x31\xde\x53\xe4\xde\xf1\x74\x0c\x46\x9a\x50\xd4\x2b\xf2\xe8\x0e\x35\xa7\x09\x55\x6f\x0e\x8b\xd3\x91\x07\x1d\xb5\xed\xb0\xcc\x42\xe3\xb0\x82\x51\x6f\x4b\x12\x7a\x00\xae\x6f\xbd\xce\x12\x26\x66\xea\x7d\xec\x62\xbf\x3c\x6c\xbc\x24\xae\x1f\xce\xaf\x3c\x59\x4c\xd6\x1f\x2d\x26\x9b\xce\x16\x51\xd6\x55\x10\x8d\xa1\x1e\x89\x34\x18\xd5\x9e\x4d\x8a\x84\xab\x35\x62\x20\xfb\x87\x28\xfa\x56\x5d\xf4\xaa\xa6\x95\x17\x67\x55\x0f\xdd\xc8\x0f\x34\xac\xa5\x92\x07\x55\x3e\x36\xd5\x66\x72\x83\xa1\x05\xbe\x9d\x33\xc0\x47\x45\xf5\xf8\x1a\x38\xc8\x9b\x8a\x3f\x3b\xec\xcf\x1a\xc6\x99\x08\xb7\x2d\xbb\x5e\x90\x01\xec\xc0\xfb\x0f\xd4\xf9\x13\x9d\xe9\x27\x9b\xea\xd2\x7a\xe9\xb9\x87\x6a\x33\xd9\xb2\xdc\xac\x48\x61\xed\x41\x7d\xb2\x74\x52\x9f\x11\xed\x65\xf5\xe5\x3f\x76\x72\xae\xec\x8c\x1f\xb6\xe9\x4b\x91\xe8\xff\xcd\xe4\xe9\x1e\x4b\xa4\xc1\x24\x04\x24\x82\xed\x94\xe1\x33\x8c\x46\x47\xc4\xd8\xd0\x8c\xfa\x35\xf8\xe6\x31\xf2\x6d\x03\x40\xd2\xe0\x7a\x9e\xef\x0a\xbe\xbf\xbd\xa7\xc6\x2b\x14\x8c\x4f\xa9\x01\xda\xa8\xe9\xed\xe1\x95\x43\x3b\xbd\x2d\x62\x7a\x8b\x16\xeb\xb1\x83\xd0\x5f\xaa\xaa\xf5\xad\x04\xf4\x2d\xfd\x07\xa0\xb8\x8e\x02\x36\x7c\x48\x9d\x29\x1f\xcc\x74\xf9\x97\x55\xc0\xba\x15\xd7\xb5\x8e\xe9\xd1\x2a\x4d\x51\x22\x48\xf5\x00\xb0\x46\x17\x8c\x8e\x65\x1b\x43\xbe\xcc\x54\x98\xf9\x9b\x2b\xfd\xd2\x6c\x2c\xf1\xf4\x6b\xf5\x62\x04\x09\x1a\x46\x1e\x77\x3d\xd2\x35\xf5\x23\x42\x33\x52\xca\x64\x34\xc0\xbf\x30\x64\xdb\xf4\x75\xc5\xc8\x2e\x15\x9d\x2c\x17\x0c\x56\x39\x22\x28\x47\xfb\xc8\xd8\x29\x17\xa9\x03\x1e\x60\xf5\x89\x06\xa0\xb1\xb9\x19\x3b\x14\xe2\x56\x99\x02\x35\xa5\x33\xf4\xfc\x40\xba\x71\x99\x97\x73\x65\x93\xa9\x69\xf3\x9d\xcd\xe5\x97\xe3\x0f\x17\x7d\x2b\x77\x8c\x2d\x2a\x3e\x3d\xa5\x56\x69\x9c\xf7\x2f\xca\x68\x5d\xaf\x77\x0e\xa6\xa0\x99\xa1\x6d\x67\x45\x44\x6f\xa9\x88\x78\xa7\x4d\xed\x8e\x4b\x85\x5b\xe3\x85\x01\xae\x2f\x5f\x46\x60\xab\xe1\x8a\x1a\xe6\x90\x42\x42\xe3\x7d\x8f\x09\x8a\x85\xd9\xdf\xb9\xd1\x84\x67\xf3\x07\x33\x5d\x5f\x2f\x1f\x72\xde\x6d\xbe\x0c\xca\x21\x47\xe5\x9c\xb2\x3f\xe5\xde\xca\x63\xca\x2d\xe8\xee\x72\x11\x34\x15\x88\x07\x3e\x04\x01\xfd\x63\x85\xe2\x51\xfd\x3f\x6f\x25\xaf\x26\xb9\x57\xfe\xfa\xb6\x85\xdb\x8e\x5c\x5d\x2f\x65\xc7\x46\x55\x22\xb4\x1d\xb5\xa5\xf7\x6e\x0e\x43\xe4\xa4\xd8\x8d\xeb\x95\xe7\x92\x6f\x66\x0d\xa9\x10\x4e\x79\xab\x8f\xf2\xef\x75\x32\x7a\x31\x6e\x9e\x71\xb8\xd9\xcb\x8f\xfb\x27\xfd\x1d\xbd\x5c\x4f\x61\x8f\x38\x54\xd0\xf5\xf6\xfe\xfe\xb6\xfc\x00\x07\x7b\x66\xef\xfa\x13\x04\x77\xe3\x68\x7b\x31\x00\x00"
 
-func mysqlEnumGoTplBytes() ([]byte, error) {
+func postgresIndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlEnumGoTpl,
-		"mysql.enum.go.tpl",
+		_postgresIndexGoTpl,
+		"postgres.index.go.tpl",
 	)
 }
 
-func mysqlEnumGoTpl() (*asset, error) {
-	bytes, err := mysqlEnumGoTplBytes()
+func postgresIndexGoTpl() (*asset, error) {
+	bytes, err := postgresIndexGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.enum.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _postgresManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x9b\x10\xa4\xd2\xe6\xa8\xef\x2d\xf2\x90\x35\x0e\x96\x2e\xb3\x3b\x27\xdb\x02\x14\x45\x22\x4b\x94\xcd\x40\x26\x6d\x8a\x4e\xed\x19\xfe\xdf\x7b\x47\x5a\xb2\x64\xc9\x49\x90\x14\x58\x0a\xec\x21\x0e\x29\x1e\xbf\xfb\xc1\xef\x8e\xc7\xd5\xea\x08\x0e\xf2\xb1\x54\x1a\xde\x1d\x83\x6f\x46\x22\x9a\x30\x08\xaf\x96\x53\x16\xf6\x68\xe8\x31\xa5\x3c\xf0\xf2\x59\x96\x6b\x1a\x24\x43\xfc\x99\xe1\x9f\x62\x39\xfe\x5e\xf7\x2f\xe4\x08\xff\xcb\xa9\xce\xbd\x00\x8e\xd6\x6b\x77\x45\xa8\xaa\x15\x76\xc0\xd2\xe7\x21\x6f\xcc\xac\x2a\x60\xa9\x8e\x86\x19\xb3\x2a\xe2\x31\x9b\x44\x5b\xfc\xcb\x9d\xf9\x15\x49\xda\x5f\x52\x5d\x81\x41\xed\x75\xa4\x59\x66\x27\xcf\xc0\xba\x93\x5c\x34\x6d\xfa\x88\x5f\x6b\x40\xe5\x87\x07\xad\xda\x01\x2b\xcd\x7a\x0e\x5c\xca\x59\x96\xe4\x04\x14\x9e\xd9\x61\x35\x8c\x67\xdb\xd5\x41\x39\x23\x81\xb7\x6f\x61\xb5\x82\xf0\x53\x36\x57\x51\x66\x8e\x6c\xbd\x06\xc5\xf4\x5c\x89\x1c\xf4\x98\xd1\xea\xd4\x2c\xf2\x7f\x77\x0f\x17\x25\xa3\x3c\x97\x31\x8f\x34\x4b\xe0\x2b\xd7\xe3\x62\x47\x58\x13\xd2\x63\x25\xe7\xa3\x31\xbc\xc1\x95\x4a\x00\xd7\xeb\x37\xa1\x9b\xce\x45\x0c\x3e\x2d\x58\x32\xa1\xf8\xcf\xbb\x00\x41\xd3\x44\xda\x11\xeb\xc5\x34\x52\xd1\x04\xa7\xc9\x10\xae\xfb\xa7\xbf\x76\x80\x88\x04\x61\x18\x5e\xf7\xfb\x53\xcd\xa5\x08\xc0\xff\xfc\xc5\x00\xee\x58\xde\x01\x64\xa6\x54\x08\xed\x3a\x14\xa4\x85\x94\x66\x43\x8e\x6b\xc5\x17\x2e\x90\xb4\xf3\x09\x13\x1a\xfc\xa9\x42\xb3\x6b\x39\x13\x7a\x55\x9b\x82\xbd\xe4\x01\xef\xb2\x7b\xd1\xfd\x70\xe5\x19\xe4\xfb\x48\x91\x66\xab\xdd\x75\x1d\x8c\x3f\x9e\x3b\xcc\xe6\x4c\x2d\x49\x2f\xcd\x12\x16\x67\xdb\x9c\xc1\x88\xdc\x5a\x04\xb8\x85\x5f\x5c\xc7\xb9\x25\xdf\x65\x36\xc5\x63\xe5\x0b\xca\xb9\x7c\xab\x7b\x73\xb2\xde\x66\x9f\x95\x3f\x1b\xf4\xff\x00\x13\xe2\x4a\x22\xd0\x31\x17\x02\x1f\xfb\xe7\xbd\x42\xa0\x7a\x3e\x70\x07\x7d\xb3\x50\xaa\xa3\x55\x4b\xc7\x2d\x8d\xbc\x3b\xaf\xca\xaa\xba\xee\x7f\x7e\xeb\x0e\xba\x35\x08\xe3\xaa\xc5\xa8\x00\x78\x70\xd2\x3b\x05\xda\x89\xb2\x3c\xad\xa4\xa6\x4c\xf5\x29\xcb\x98\x66\x46\x9a\xa0\x49\x52\x85\x14\x2b\x5c\x4b\xcc\x5a\x2c\x45\x52\xee\xb1\x20\x4c\x90\xf0\xad\x8d\xb1\x9a\x8b\x22\xc6\xa6\xe6\xf8\x36\xba\x1d\xb2\x4c\x45\x62\xc4\xe0\x80\x77\x30\x89\x4c\x86\x54\x4c\x2b\xcc\x39\xe0\x86\x33\x25\xac\x71\x48\xdc\xb3\x85\x2e\x88\xeb\x73\x91\xb0\x45\x99\x87\x07\x3c\x20\xb8\x8a\x25\x81\x49\x46\xc4\x42\xd5\x0b\xc3\x05\xac\x81\xa4\xaf\x9d\xa0\x2b\x14\x20\xa2\x1c\x1b\x79\xf2\x36\x19\xa6\x02\xc9\x84\xfe\xc6\xda\xdb\xe6\x40\xa4\x46\x26\x03\x3a\x70\x88\x80\x1d\xf8\x2f\x3c\x73\x10\x88\x8c\xfd\xe9\x18\x04\xcf\x28\xa7\x1c\x5b\x43\x68\x6a\x52\xcd\x75\xd6\x85\xff\xa5\xa7\x9f\x14\x9f\x44\x6a\xf9\x3b\x5b\x52\x38\x6c\x2e\x30\x0d\x6c\xc1\x73\xcd\x44\xcc\x5c\x27\x95\x0a\x6e\x8c\x71\xc5\x65\x83\xc7\x8f\x8e\x58\xc7\x28\x7e\xa4\xaa\xb6\x1c\xde\x98\xfd\x39\x06\x0e\x93\x97\x15\x7a\xad\xa5\x76\x98\xe5\x8c\xec\x18\x31\xc1\x14\x8f\xf3\xe2\x2c\x8c\x9d\x84\xbe\x90\x7f\x12\x55\x4e\xb2\xec\x73\xcb\xc1\x7c\x69\xc4\xfd\x75\x47\xdc\xb8\x4b\x2e\xce\x4a\x07\x93\xe1\x96\x4f\xc6\xd5\x06\x9d\x5e\xaf\x4b\x4e\xc2\x52\xa6\x60\x16\x7e\xc8\x64\xce\xfc\xc0\xf2\x26\x93\x51\x42\x7c\x98\x67\x3a\x7f\x42\x62\x11\xaf\x66\x61\x0f\xed\xf3\x83\x26\x85\x68\x6f\xdb\x46\x23\xf7\x10\x85\x1d\xc7\xd9\xb0\xef\x9d\x21\x5f\xc7\x22\x93\x83\x47\x66\x99\x58\x6e\x68\x1e\x47\x02\x47\x36\xbf\x67\x78\xcd\x47\x82\xc2\x6f\x82\xd3\x5e\xd1\x37\x17\x90\x77\xe8\x15\x86\x06\x36\x68\x2d\x51\x6b\x86\xcd\x2a\xa6\xb0\x1c\x43\x34\x9d\xa2\x41\xbe\x21\xfc\x61\xcd\xef\xc0\xc4\x77\x83\x47\x76\x75\x95\xf2\x83\xf7\x4f\xe5\x99\xcd\x30\xb7\x58\x37\x0a\x50\xc8\xc5\x6f\xe8\xf2\x49\x92\x50\x4c\x1b\x8d\x43\xd9\x65\x34\x7a\x8b\xfd\x0d\xc5\x70\x09\x78\x37\x33\xa5\xb9\x18\x41\x04\x4a\x7e\xc5\xb9\x96\x2f\x68\x30\xea\xd6\xed\xeb\x2e\xea\x2c\x69\x6f\x2b\x9a\x1d\x88\xb9\xea\x5f\xd0\x67\xa0\x6d\x9e\x35\x2d\xd8\xdf\x09\x82\x77\xde\xbb\xec\x0e\x1e\xee\x32\x6c\xd0\x1e\x6b\x36\x2c\x10\x9c\xf7\xae\xfa\xad\x4d\x81\x5f\x6b\x43\x36\x74\xad\x15\x85\x0e\x34\xd6\xb6\xfd\x01\xde\xc9\x76\x7f\x00\x7f\x9f\x5c\xfc\xd5\xbd\xdc\x01\xbc\x8f\xb2\xbc\xe2\x67\x63\xd7\xf7\xb8\xd1\x9f\x58\x9c\xea\x15\xad\x15\xb5\xea\xd7\xe3\xb5\x50\xd5\xf5\x6d\x1b\xf3\xd6\x7a\x78\xd3\xd9\x64\x62\xb5\x5e\x77\x17\x2c\x7e\x51\xb9\xfe\x31\x7c\xdf\x14\x11\xaa\x2f\xb6\x7e\x0c\xd8\x44\xde\xb3\x6a\x09\x49\xf8\xe3\x35\x24\x55\x72\xb2\xb7\x86\x98\xd6\x91\x4a\x08\xc7\x94\xa5\x22\x62\xa4\x9f\x5f\x44\x1a\x26\xbe\xae\x3a\x62\xcd\x7b\x4a\x29\x39\xc5\xe7\xc6\x55\xf7\x25\x0f\x16\x8b\x00\xd5\x97\x47\xad\x86\xb4\xbc\x0e\x4c\xb1\x68\x3e\x0e\xd2\x88\xfa\x97\xed\xf3\xc0\x0c\x76\x77\x4c\xf0\xde\xe7\xbb\xa4\xac\xef\xf4\x33\x26\xaa\xc0\x81\xb9\xcf\xbe\xcf\x03\xe1\xc7\x48\xa9\xff\xcb\xc9\xa6\x9c\x7c\x03\x2f\x5b\x7c\x0b\x13\x13\x00\x00"
 
-func mysqlForeignkeyGoTplBytes() ([]byte, error) {
+func postgresManytomanyGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlForeignkeyGoTpl,
-		"mysql.foreignkey.go.tpl",
+		_postgresManytomanyGoTpl,
+		"postgres.manytomany.go.tpl",
 	)
 }
 
-func mysqlForeignkeyGoTpl() (*asset, error) {
-	bytes, err := mysqlForeignkeyGoTplBytes()
+func postgresManytomanyGoTpl() (*asset, error) {
+	bytes, err := postgresManytomanyGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _postgresMapGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x61\x6f\x1a\x39\x10\xfd\x0c\xbf\x62\x6e\x75\x4a\xd8\x1e\xd9\xf6\x73\x24\x4e\x6a\x13\x7a\xd7\xbb\x36\xb4\x25\xd2\x45\x42\xa8\x18\xd6\x80\xd5\x5d\x1b\x6c\x73\x81\x43\xfb\xdf\x3b\x33\x5e\xc0\x01\x54\x45\xd5\x55\x0a\xcb\xda\xf3\x3c\xf3\x66\xe6\x79\xc8\x76\x7b\x05\xbf\xaa\xdc\xc1\x75\x07\x5a\xa5\x58\x2c\x84\x15\x25\x64\x30\x15\x85\x93\x29\x5c\x55\x55\x73\x1b\x20\xc7\x08\x6f\x57\x31\xc0\xcd\x8d\xf5\x8c\xe1\x37\x2d\x4a\x09\xd9\xfd\x66\x21\xb3\x3b\x7a\x4d\xa4\xb5\x09\x24\x6e\x59\x38\x4f\x2f\xf9\x18\x1f\x4b\xfc\x58\xe9\xf0\xf9\xd0\x7b\x6f\x66\x49\x60\x92\x28\x8d\x3b\xc2\xce\xc8\x40\xaf\x8a\x0d\x90\x98\x85\x77\x49\x14\xd2\x8b\x71\x21\x43\xc8\xc9\x5c\x96\x02\xb2\x7e\xfd\xcd\x71\xef\xc9\x1c\x9e\x44\x21\xe6\xba\x2c\xa2\xb3\xbb\xc5\x33\x4e\xbf\x7c\x09\xdb\x2d\x64\x1f\xc4\xe2\xed\x4a\x4f\x38\xb1\xaa\x02\x2b\xbd\x55\xf2\x5f\xe9\xc0\xcf\x25\x58\xf3\xe8\x60\x6a\x4d\x09\x97\x88\xad\x49\x56\xd5\x25\x3c\xce\x8d\x93\xfb\xf3\x4a\x16\x79\xb6\xf3\xa0\x1c\x28\x5d\x7b\xe7\x1a\x54\x55\x1b\xbe\xca\x8d\xcc\x61\xbc\x39\x7b\x24\x8b\xa0\xf0\xa8\xfc\xdc\xac\x3c\x08\x0a\x0e\xc2\x4a\x28\x95\x73\x4a\xcf\x02\x0f\x62\x85\x8d\xcb\x30\x00\xc5\xf8\x43\x6a\x69\x85\x47\xdf\x6c\x55\x3a\x97\x6b\xe6\x9a\xbd\xa3\xd7\xf0\xac\xc3\x5c\x66\xcd\x29\x66\x7a\x26\xeb\x16\x6e\x4d\xfc\x3a\xa8\xa1\xaa\xf2\x31\x3c\xf4\x6e\xdf\xb4\x63\x5a\x83\x21\x2e\xb0\x38\x58\xce\x28\x01\xaa\x2e\xe7\x47\xed\x84\x2c\xcb\x1e\x7a\xbd\x85\x57\x46\xa7\x2c\xaf\xc1\x77\xce\x0c\x5f\x10\x8f\x83\xa8\xc8\x0b\xea\xca\xd8\x14\xb6\xcd\x06\xb5\x76\x6d\x0c\xfb\xa2\xf8\xbb\x1d\xa5\x51\x72\xab\x52\x6a\xff\x34\x87\xb3\x7d\x86\xa4\xdf\x7d\xdf\xbd\xb9\x4f\xd8\x01\x8a\x93\x34\x52\x8a\xaf\xf2\x47\xb8\x15\x52\xb7\x0e\xf5\x48\xd3\x66\xb3\x81\xf5\x5f\xae\xa4\xdd\x80\xf0\x50\x1a\xe7\xb1\x6a\x6f\x84\x9f\xcc\xfb\xea\x3f\x19\xd7\x4e\x50\x37\xbd\x2a\x65\xb3\x31\x35\xf6\xd8\x13\xfc\x0e\xaf\x28\xe5\x86\x26\x7a\x47\x46\xdc\x56\x53\xd0\x88\x79\xe2\x1b\xb7\x11\xde\x89\x37\x71\xab\x42\x4e\x44\x6a\xbc\x52\x45\x0e\x8b\x42\x4c\xe4\xdc\x14\xb9\xb4\x0e\x84\xce\x81\x6e\x21\xf9\xd3\xfb\x32\x0c\x86\x58\x4d\x94\x56\x1b\x34\x45\x22\x40\x64\x53\xda\x4b\x3b\x45\x27\xdb\xaa\x06\x10\x7b\xb5\x93\x05\x65\x86\x60\x2b\xf4\x2c\xce\x76\x70\xad\x87\x81\xa0\xd2\x03\x35\x44\x92\x68\xd3\x7e\xce\xe2\x9a\x19\x9e\x00\xd4\x8e\x10\x6e\x8f\xc0\x9b\x8b\x6b\xf6\xbb\x6f\x47\xc0\xd1\x27\x2a\x66\x27\x8e\xa5\xaf\x87\x75\xce\x78\x3e\x34\x03\x97\x61\x30\x11\xb9\x51\x10\x00\x8c\xe0\x37\x8a\x38\x22\x9d\x9b\x82\xe6\x99\xab\xfb\xcb\x81\xd8\xf1\x0e\xf3\xf6\x73\xef\x03\xc7\xd8\x0f\x93\xc8\xf8\xcf\x9f\xdd\xcf\x5d\x38\xb8\x89\xb4\x73\x63\x0a\x42\xbe\xbb\x83\x16\xa2\x21\x54\xd6\x65\x7f\xa1\x66\x5b\x4a\xb7\x21\xc1\xbf\x14\x0d\xa3\x14\x8f\x63\x53\x43\xfc\xbe\x99\xfa\x5b\x59\x48\x2f\x77\x29\xc3\xeb\xbb\x5b\xae\x08\x5a\x72\xb6\x4c\x0c\x76\x6f\xa7\x4d\xb4\x48\x4d\xb8\x51\x9d\xb9\x5d\xe9\x7d\xe6\x3c\x7a\x5b\x21\xff\x36\x37\x1c\xef\x64\xca\x83\x12\x23\xe2\xfe\x3a\xd4\x94\xe7\x1a\xd6\x67\x70\xaa\xf4\x2d\xd9\xf1\x22\x92\x99\x0e\x64\x08\xc8\xc7\x53\x8d\x97\x09\xc9\x4c\x7c\x72\x98\x17\xd4\x30\x9a\x16\x6d\xb8\x20\x87\x6d\x38\x09\xcc\xea\x25\x67\xbf\x74\x40\xab\x22\xe8\x02\x6f\xdd\xca\x6a\x5a\xf3\x8d\xaf\x5b\x4c\xda\xfa\x12\xb4\x15\x7e\x7e\x62\x79\x31\xdf\x2d\x0b\xe1\xea\x50\xbb\x8f\x56\x95\xc2\x6e\xfe\x96\x9b\x5a\x51\xf1\xe1\xec\x8b\x5c\x2b\x87\x53\xa9\xc3\xbf\x6e\xf5\xd9\x50\xba\xc0\xc2\x0d\x9e\xe0\xcf\x4d\xe6\x5a\x9c\x7b\x10\x73\x65\x3f\xf8\x8b\x4a\x44\x66\x34\x7f\xd5\xc4\x1d\xca\xca\x39\x11\xf3\xb5\xf9\x44\x5d\x79\x5d\x14\x83\xe3\x1a\x0f\x4f\x0a\xf8\xf3\x2b\xf7\x7f\x24\xcc\xeb\xe5\x3e\xc3\x7c\x7c\x10\x07\xe7\x7a\xa2\x8d\x1f\x4f\x2b\x68\xbb\x30\x22\xc7\x29\xed\x56\x85\x77\x75\xa6\xcb\xec\x4e\xae\x7d\x2b\x0d\x47\x8f\x93\x3e\x51\x73\x00\x7d\x4f\x34\x8d\x5a\x28\xd7\xac\x93\x76\xed\x95\x84\x72\x15\x00\x4c\x86\x67\xcc\x44\x68\x7a\x25\xfe\x1d\x24\xd2\xc7\x35\xa5\x3b\xa5\x0a\x9e\x19\x2a\xad\x05\x8e\x00\x0f\xc9\x45\x52\x93\x4c\xeb\x91\x7e\xae\x08\x8d\x65\x76\x53\xe0\x3f\x15\x2d\x06\x9c\xa9\x49\xcd\xe3\xd9\x6d\xbc\x38\xe9\xe3\x81\x79\xd7\x5a\x8e\x13\xc7\x7c\x66\x63\xe2\x5b\x44\x8c\x6a\x0c\xd2\x6a\x13\xb0\x59\x35\xbf\x01\x9b\xa2\xf7\x09\x87\x0a\x00\x00"
 
-func mysqlIndexGoTplBytes() ([]byte, error) {
+func postgresMapGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlIndexGoTpl,
-		"mysql.index.go.tpl",
+		_postgresMapGoTpl,
+		"postgres.map.go.tpl",
 	)
 }
 
-func mysqlIndexGoTpl() (*asset, error) {
-	bytes, err := mysqlIndexGoTplBytes()
+func postgresMapGoTpl() (*asset, error) {
+	bytes, err := postgresMapGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.map.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6f\x13\x31\x10\x85\xcf\xf6\xaf\x18\x22\x44\x77\xa5\x76\x73\x47\xca\x05\xc8\xad\x6a\x4b\x53\xa1\xde\xa8\xeb\x9d\x4d\x2d\x39\x76\x3a\xf6\x06\x2a\xcb\xff\x1d\x8d\xd7\x6d\x36\x08\x90\x38\xc4\xd1\x7a\xe7\xbd\xef\xcd\xdb\x94\x2e\xe0\xbd\xf3\xf1\x9b\x37\x3d\x7c\x5c\x41\xe3\x10\xba\x1b\xf2\xba\xbb\xc5\x38\x92\xbb\x7b\xd9\x23\x2c\x0e\xde\xf4\x8b\x16\x2e\x72\x96\x45\xb0\x27\xaf\xcb\x74\xd0\x4f\xb8\x53\xd0\x6d\xea\x7f\x51\xf2\x71\xa5\x76\x78\x14\x98\x01\xfe\xe8\x1b\xc9\x6c\xb7\x48\x8b\x32\xb8\x5c\x42\x4a\xd0\xb1\x12\x72\x06\xad\xac\x0d\x10\x9f\x10\x42\xf4\x84\x3d\x30\x14\xfb\x91\x10\xce\x52\xaa\x19\x72\x6e\x58\xc3\xc6\x37\x8a\xd4\x2e\x40\xce\x2d\xbc\x5e\xcd\x59\x39\x9f\x81\x77\xd0\x3f\x76\x72\x18\x9d\x9e\xa3\x9a\xfe\x11\xee\xaf\xbf\x7c\x4a\x09\xb6\x7e\xcf\x36\xd6\x84\x08\x5d\x75\x8c\x34\xe2\x74\xb0\x37\xf3\xcc\x70\xec\x2c\xe7\x94\x80\x30\x32\xa3\xf2\xba\x0a\x3c\x67\x08\x3a\x9e\x41\x22\x4f\x2d\x24\x29\x0e\x8a\x00\xa9\xfc\x3c\x49\x29\x96\x4b\x08\xcf\x16\x9e\x47\xa4\x17\x29\xb4\x77\x21\xf2\x45\x88\x04\x2b\x78\xd8\xac\x2f\xd7\x9f\xef\xe0\xb7\x7d\xb5\xb7\x07\x65\xc3\x5b\xc2\x9c\xdb\x87\xc9\x8a\x46\x57\xad\x6a\xed\xb3\x9c\x13\x9b\x30\xc2\x5f\x13\x4b\x71\x7f\x7d\xe9\xb7\xcd\x14\xe0\x5f\x7d\x0c\xca\x86\x52\x88\x14\xbc\xcd\x8a\x8b\xfd\xca\xe0\x5b\xff\xe3\x7f\xe4\xdd\x46\x2b\xd7\x7c\x20\x8c\xad\x14\x66\x28\xd5\xbc\x5b\x81\x33\x96\xcb\x12\x54\xe2\x4d\x81\x9d\xb1\x27\x99\xaf\x8c\x7d\x2b\x1a\x89\xa4\xc8\x52\xbe\x0a\x08\xe3\x39\x9b\x94\x1a\x70\x62\x9d\x2e\xd7\x4a\xf1\xbd\xe8\xa6\xec\xeb\x9f\xa8\x8f\x6f\xaa\x0b\xbb\x16\x83\xf2\x0d\x65\x9e\x3f\xc8\x5f\x01\x00\x00\xff\xff\x42\xa9\x24\xb4\x3a\x03\x00\x00"
+var _postgresMockGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xcd\x8f\x9b\x46\x14\x3f\xc3\x5f\xf1\x8a\x72\x80\x8a\x90\xfb\x4a\x3e\xb4\x49\x23\xad\x94\xed\x1e\x92\x55\x23\xad\x56\xd5\x04\xc6\x36\x2a\x30\x74\x18\x76\xbd\x75\xf8\xdf\xfb\x66\xde\x80\xc1\x06\x6c\x6f\xd6\xea\xa5\x17\x83\x99\xf7\xf1\x9b\xdf\xfb\x98\x37\xdb\xed\x5b\x78\x53\xad\x85\x54\x70\xb5\x00\xdf\xbc\x15\x2c\xe7\x10\xfd\xae\x7f\x3d\x2e\xa5\x07\x5e\xf2\x0d\x7f\x44\xa9\x2a\x7c\xa8\x8d\x17\xc0\xdb\xa6\x71\xb7\x5a\x35\x1f\xd3\xf5\x4b\x99\x16\xaa\x35\xf1\x59\x09\xc9\x6f\x44\xfc\x17\xea\x8d\xdb\x03\x2f\xe7\x6a\x2d\x12\x7c\x61\x72\xa5\x3f\xc6\x2c\xcb\xcc\xd3\x6b\xd1\x45\x1f\x53\x9e\x25\x55\xcf\x75\x5d\x26\x4c\x71\xed\xba\x40\x97\x4b\xbd\xac\xbd\x57\x79\x9d\xa9\xb4\x95\x6f\xd5\x7d\x92\x4e\x57\x05\x82\x81\x28\x40\x28\x9e\x31\xf5\xee\x1d\x6c\xb7\x16\x6a\xd3\x74\x58\x21\xad\x80\x41\xae\xdf\xf6\x97\x41\xf2\x58\xc8\x24\x2d\x56\x90\xaa\x0a\x0c\xd4\x08\xed\x68\x53\xbf\xb1\x78\x0d\xb4\x19\x5a\x00\xb5\xe6\x90\x33\x15\xaf\xb5\xfc\xc7\xba\x88\xc1\x20\x85\xa7\x35\x2f\xa0\xe2\x2a\x04\x56\x24\x20\x50\x4c\x3e\xa5\x95\x36\xae\x6a\x59\x54\xda\xd8\x3f\x5c\x0a\x78\x64\x59\xcd\xd1\xbe\x7a\x2e\xf9\x38\xd2\x4a\xc9\x3a\x56\xb0\x75\x9d\xeb\xa2\xe2\x52\x91\x13\xfc\xf1\x51\x3c\x56\x9b\x92\x49\x96\xa3\x06\xfe\xb3\x64\x34\x0d\xfc\xdc\x33\x15\x82\x0e\x05\x44\x51\xf4\xf5\xf6\xb6\x54\xa9\x28\x02\xc0\x38\x09\x69\x78\x4e\x97\x1d\xd5\x48\x97\x73\x67\x5e\x5f\xd1\x87\xf3\x99\x3d\x92\x3d\x78\x45\xd4\x1c\x49\xd5\x70\x3f\xf0\x8c\xbf\x2a\x5c\x6d\x5c\xb2\x62\x85\x49\x74\x5d\x24\x7c\xc3\x2b\xb2\x83\x34\x45\xda\x8d\x35\xd0\x52\x47\x42\xd1\x75\x75\x57\xa4\x7f\xd7\x44\x21\x4a\x4b\x5e\x0a\x9b\x26\x11\x7e\x9b\xc1\xb7\x12\xe6\x4f\x96\x56\x5d\x0d\xc0\x92\x65\x98\x29\x18\xf6\x29\xa8\xbe\xd9\xcb\x17\x4c\x99\xdd\x86\x0c\xfc\x80\xc8\xd1\xea\x97\x45\xf2\x09\xa5\x3a\x34\xf7\x0f\x47\xf0\x50\xb0\x76\xaf\x28\x6d\xbf\xb9\x4e\x5e\x63\x62\x40\xf5\x5c\xc4\xd1\x4d\xad\xf8\xc6\x75\xa8\xb0\xee\x1f\xc6\xaa\xe1\x3d\xae\xb9\xa8\x36\x51\xd6\x7a\x99\x4a\x5b\x1b\xb1\x95\xcc\x13\xf8\xf6\x3c\x2a\x3e\x53\x76\xc6\xd2\xae\xf4\xd0\xdf\x0d\xb1\x98\x52\xcd\x9b\x46\x28\x96\xe6\x5d\xfb\x42\x27\x44\x73\xe4\x3a\x56\x12\xb5\xb1\x29\xb8\x46\xf9\x17\xec\x7b\xc0\xb0\xb9\x68\x79\x6c\x82\x75\xce\x0b\xe4\xb2\x67\x00\x19\xdb\xc4\x59\x6d\xfa\x8e\xf9\x26\x0a\x64\x43\xa1\x39\xa3\x7b\xff\x80\x2d\x97\xcb\x25\x8b\xf9\xb6\xb1\x0c\xd0\xf6\xec\xa3\xdb\xb4\x12\x1d\x12\x1d\x68\xd0\x91\x6e\xfb\xf8\x5e\x19\x74\xbb\x0d\xac\x11\x3f\xef\x43\x0f\x35\x52\x13\xf0\x9e\xef\x40\xd3\x31\x30\x19\xe5\x75\xf4\x09\x8d\xf8\x81\xeb\x24\x7c\xc9\x25\x1c\x2c\xdf\x15\x19\x09\xec\xab\x52\xac\x17\xc0\xca\x12\x33\xc2\x1f\x59\x0c\x27\xc3\xb3\x25\x9e\xaf\xec\x76\x43\x43\xf2\x95\xc1\xdc\x04\x96\xa2\xf7\xc6\xbe\x6d\xba\x86\xd7\x2e\x27\x6c\xff\x16\x9d\xba\x90\x30\x48\x1a\x12\xd0\x8d\x5c\x5b\xca\xbb\xf0\xf3\xbc\x54\xcf\x67\x91\x6b\x50\x0c\xb9\x0d\x66\x12\xfc\x07\x19\x26\xdc\x78\x6e\x4e\x7b\xc0\x14\x72\x96\xb8\xdf\x3f\x43\x88\xb5\x24\x75\xbc\xb1\xd0\x20\x14\x07\xfb\x9c\xc5\xbe\x58\xe8\x73\xf5\xfb\x77\xc0\x62\xed\xbe\xd8\x35\x2d\xe9\xec\xc5\xd3\x46\x30\x46\xdc\x0e\xba\xd4\xf5\x4e\xb1\x20\x72\x6d\x90\xfe\x48\xd5\xfa\xcb\xa6\xcb\xe3\xb6\x22\xcc\xc9\xd9\x0f\xdd\xd8\x6e\x42\xa8\x44\xa7\x61\x8e\xd5\x9c\x25\x1c\x9e\xd0\x24\xa8\x8d\x29\xb9\x2e\xa0\x4a\xac\xb8\x3e\x88\xed\x2a\x2a\x99\x73\xb9\x3d\xe2\xcf\x08\x28\x21\xf6\xd1\xc1\xd7\xdb\x0f\xbf\x06\x87\x33\xc4\x41\x04\x6d\x7d\x79\xa4\xe9\x85\x08\x2e\xe8\xc8\x18\x88\x5a\x52\xe8\xb0\x1f\x27\x85\x58\xde\x8d\x03\x67\x61\x27\xb5\x1f\x3e\x29\xa7\xb7\x48\x0e\x3c\x53\xb8\x9d\x51\xb2\x83\x5b\xc6\x64\x1a\xaa\xf5\xa6\x9a\x9f\x70\xd6\x4b\x4d\xfa\x8f\x32\xd3\x13\xb5\xf0\xb1\xd6\xf7\xc0\x93\x1f\x84\x1b\x0c\xd2\x0d\xcd\xba\xcd\xc8\xbc\xa3\x99\xa6\x91\x67\x8e\xe9\xdd\x50\x74\x16\xd3\xa4\x76\x41\xa6\xc9\xc1\xc9\x4c\xf7\x66\xbb\x63\x4c\xef\x44\x5f\xc6\xb4\xe6\x55\x0f\x7e\x73\xac\xb6\x83\xe1\x59\x9c\x6a\xa5\x0b\x32\xaa\xcd\x9f\xcc\x67\x37\xd9\x1e\x63\xb3\x15\x7c\x79\xd6\xb6\xe3\x12\xd2\x4a\x23\xef\x1c\xb1\xbb\xa1\xf8\x2c\x6a\x49\xed\x82\xe4\x92\x83\x93\xe9\xed\xcd\xf6\xc7\x08\xde\x89\xbe\x9c\xe2\x53\xa7\xfe\x37\xf6\xbc\xd3\xc7\xe6\x70\xb8\x6e\xc7\xd2\x56\x02\x19\x9b\x89\xd2\x40\x90\x82\x35\x7d\xa9\x98\x0c\x63\x7f\xe8\xee\xc5\x72\x60\xfc\xe2\xb7\x8e\xe9\x90\x0f\x70\x78\x13\x9e\x8d\x4f\x72\x3f\x97\x0f\x07\x84\x1d\x4d\x8b\x03\x8d\xfd\xec\x98\xe6\x61\x08\x67\x2c\x6d\xc2\x7e\x79\xda\x1b\xd7\x7f\x19\xa6\x53\xaf\x64\xff\x07\x6b\xe6\x3a\x8a\x05\xfc\xc8\x65\xba\x1c\xbf\x2f\x42\x9a\x97\x19\xa7\xab\xdb\xfe\xba\xfb\xc8\x70\x9e\x3e\x9c\x04\x17\xb6\x6e\x0e\x82\xef\x23\xa2\xc0\xfd\x17\x7f\x9e\xed\x58\xa1\x13\x00\x00"
 
-func mysqlProcGoTplBytes() ([]byte, error) {
+func postgresMockGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlProcGoTpl,
-		"mysql.proc.go.tpl",
+		_postgresMockGoTpl,
+		"postgres.mock.go.tpl",
 	)
 }
 
-func mysqlProcGoTpl() (*asset, error) {
-	bytes, err := mysqlProcGoTplBytes()
+func postgresMockGoTpl() (*asset, error) {
+	bytes, err := postgresMockGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.mock.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _postgresOptionalGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x55\x4d\x6f\x9b\x40\x10\x3d\x9b\x5f\x31\x8d\x5c\x09\x47\x84\xe4\x1c\xc9\x87\x24\x6a\xa3\x1e\x52\x59\x6a\x7a\x8a\xa2\x6a\xc1\x8b\x41\x86\x5d\xba\xbb\x4e\x14\x21\xfe\x7b\x67\x66\xb1\x8d\x63\x43\xda\xde\x76\xe7\x6b\xdf\xcc\x7b\x03\x4d\x73\x01\x45\x06\xf1\x83\x5e\xca\xf2\x51\x2f\x6e\xa1\x6d\x83\x06\x8d\xd3\x7a\xbd\x82\xeb\x39\xdc\xeb\x85\x48\xd7\x62\x25\xbf\x8b\x4a\xf6\xe2\xee\xb4\xca\x8a\x55\xfc\xad\xaa\xb5\x71\x3f\xa4\x79\x29\x52\x09\x17\xdb\x64\x9b\xa3\x95\xd2\xf9\xa0\x38\xf5\xf1\xad\x96\x31\x57\xa1\xb0\xcb\x4b\x68\x9a\xbe\xb1\x6d\xf7\x18\x52\xad\x5e\xa4\x71\x16\x5c\x2e\x8f\xc2\xc0\x69\x28\xd0\x57\x1b\x8d\xa7\x4a\x5a\x8b\xe8\xe2\x20\xdb\xa8\x74\xb8\x64\x88\x9e\x0e\x15\x56\x38\x7f\x1f\x37\x83\x90\x6c\xdc\x74\xdb\xc6\xef\xdd\x11\x48\x63\xb4\x99\x41\x13\x4c\xd0\x57\xed\x80\xc6\x34\xae\x36\x38\xd5\xcd\xe2\xf6\x51\xf3\xf3\x87\xdd\x1c\xa0\xa6\x56\xc4\x51\xe6\x40\x2f\xbb\x82\x21\xd7\x38\x1a\xcb\x58\x03\x5d\x7f\x63\x4d\xed\xf1\x8e\x34\xc5\x01\x96\x5a\xb7\xe3\x24\x59\x6a\x0d\x1d\x85\x39\xec\xd8\x8e\x11\xe5\x0b\x87\x46\xbf\x5a\x78\x7a\x3e\x45\x92\xb7\xfe\x0d\x4d\x46\x5a\xd2\x5f\x25\xd6\xf2\xc3\xac\x52\x2a\x7e\x74\x36\x0b\x26\x99\x36\x50\x44\x80\x57\x4a\x37\x42\x21\x4b\x0c\x08\x6b\x4e\xea\x84\x9f\x20\xcf\xb0\xd2\x30\x1a\xeb\x4c\x70\xab\x28\xf4\xd3\x1c\x54\x51\x72\x36\x62\x72\x1b\xa3\xe8\xce\x65\xd0\xd4\x06\x64\xb5\x4f\xc5\x33\xcc\xa1\x4e\x02\x34\x04\xdb\x30\xb4\x47\x14\x3b\xa8\x2f\xdb\x11\x66\x47\x14\xc6\x3c\x1c\x91\x33\xa8\xb0\x5d\xc9\xb0\x4e\xb6\x1c\x8c\x68\xea\x04\x49\xe3\x1c\x9c\x18\x3c\x3e\xd4\x9b\x7b\x9d\xec\xc7\x4e\x10\x68\x6e\x38\xd0\xc1\xb1\xf7\x96\x22\xf9\xbf\xa9\x63\xf5\xa1\xb1\x37\xfe\xe3\x58\x09\xbb\xce\x0a\x59\x2e\xad\x5f\x8d\x53\x6c\xdc\xd4\x75\xf9\xf6\x95\x82\x1e\x30\x1a\xac\xec\xc8\xe8\xf2\x74\x06\x07\x1f\xa0\x42\x79\xaa\x84\xcb\xd9\x49\x4f\x20\x53\x54\xf9\x30\xeb\x88\x50\x94\x26\xe3\x2c\xd4\x8a\x9d\xa9\x2e\x37\x95\x62\x9a\x37\xf5\x52\x38\x09\xaf\x85\xcb\xa9\xd0\x4f\xbe\xde\x79\x7f\x0c\x37\x65\xd9\xaf\xed\x72\xe1\x20\x15\x0a\x12\xd9\x25\x2e\x41\x18\x49\xc8\xe1\x35\x97\xca\x43\xca\x85\x05\xc5\xb0\x18\xea\x80\x6e\x0e\x9b\xff\xe0\x53\x1b\xc1\xbf\x7f\xbe\x22\x8f\xe6\x9c\xb1\xd3\xb1\x4e\xe2\xdd\x7b\xac\x43\xeb\x0c\x4e\xa4\x2f\x3e\x3f\x5b\x96\x9f\x5d\xc7\xf7\xd2\x2d\xc8\x10\xa2\x48\x90\x52\x16\x1e\xdd\x67\x30\x9f\xc3\x95\x5f\x6e\x4e\x98\xc3\xb6\x58\x03\xc4\xbf\x97\xe2\x14\xa5\x39\xcd\xb6\xd5\x7a\x62\x40\xa4\x58\x6e\x5a\x30\x48\xbc\x48\xb5\xc4\xe3\x19\x9e\xb8\x4b\xfe\xf3\x61\xa2\x37\x91\x13\xff\x7d\xd0\x7a\xc1\x21\x75\xfd\xfd\xd8\xb6\x70\x15\xf5\xe0\x75\x9b\xf1\x2b\x62\x02\x7a\xbb\xc1\x60\x09\xb6\x45\xbe\xd3\xdc\xbb\xe9\x8e\xef\x08\xe2\x63\x37\x2b\xc4\x49\x3f\xdc\xc9\x64\x29\x33\xb1\x29\xdd\xf5\xfb\xa5\xc8\x2a\x17\x7f\xa1\xb9\x65\xe1\x19\x67\xc1\xe7\xdf\x24\x0d\xa5\x5d\x4f\x1d\x67\x1e\xc2\xac\xdb\x1f\x06\x3f\xa7\xa7\xb0\xab\x90\x6e\x3b\x7f\x6f\x99\xbc\x7d\xbf\x4d\x7e\x3c\xfd\xe3\x1f\x3f\x69\xd6\x53\x81\x08\x00\x00"
 
-func mysqlQueryGoTplBytes() ([]byte, error) {
+func postgresOptionalGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlQueryGoTpl,
-		"mysql.query.go.tpl",
+		_postgresOptionalGoTpl,
+		"postgres.optional.go.tpl",
 	)
 }
 
-func mysqlQueryGoTpl() (*asset, error) {
-	bytes, err := mysqlQueryGoTplBytes()
+func postgresOptionalGoTpl() (*asset, error) {
+	bytes, err := postgresOptionalGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.optional.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _postgresProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdd\x56\x4d\x6f\xda\x40\x10\x3d\xdb\xbf\x62\x62\x21\x62\x47\x64\x73\x4f\xc5\xa1\xa5\xf4\x84\x42\x1a\xa2\x2a\x52\x55\x35\xc6\x5e\x13\x4b\x66\x17\x76\xd7\x09\x11\xf2\x7f\xef\xcc\xda\x06\x1b\x50\xbe\xdb\x43\x0f\x21\xde\xdd\xf9\x78\xef\xcd\xec\xd8\xeb\xf5\x29\x74\x84\x34\x3f\x64\x1a\xc3\x79\x1f\x7c\xc1\x81\x5d\x2a\x19\xb1\x2b\x6e\x72\x25\xae\x1f\x17\x1c\xbc\x7b\x3c\xf5\x02\x38\x2d\x0a\x77\x4d\x0e\x0b\x34\xb0\xd6\x3a\xba\xe3\xf3\x10\xd8\xa4\xfa\x6f\x3d\xe9\xe7\x22\x9c\xf3\xad\x43\x9a\x00\x1b\x84\x59\xb6\xd9\x50\xa1\x98\x61\xa2\x2b\xae\xf3\xcc\x4c\xb8\xd1\x80\x07\x67\x67\xb0\x5e\x03\xa3\x94\x8c\xfc\x71\x0f\x52\x0d\x21\x28\xf9\x00\x32\x01\x73\xc7\x41\x59\x0f\xd0\xdc\x58\xdb\xda\xac\x3a\xd5\x46\x2a\x1e\x03\xc1\xe3\x71\xae\x38\x1c\xa3\x51\x89\xb6\x28\x8e\x99\x6b\x88\xcd\x5e\x0e\x6d\x54\x1e\x61\xbc\x26\x32\x6b\xf0\x2d\xe5\x59\x6c\xa1\x39\xcd\x64\xf8\xac\xb8\x0d\x65\xcd\x68\xab\x82\x3e\x90\x19\xfd\xe5\x73\x51\xd9\xda\x90\x5c\xc4\xf4\x58\xb8\xb8\xb2\x8b\xa6\x2c\x07\x25\xa8\xbc\xcb\x33\x0d\x77\x92\x60\xb4\xe9\x6b\xc2\x80\x05\x42\xba\xd3\xc7\xd7\x91\xdf\x89\x7e\x88\x7e\x1b\x55\x8b\xfd\xcf\x5f\x27\xbb\x0a\xee\xb0\x6c\x93\x80\x08\x0b\xaf\x9f\x47\xe8\x93\x0f\xb5\xce\x65\xa8\xc2\x39\xe5\x0d\x8e\x41\x0a\x88\xa7\xbd\x8a\x6a\x2a\x66\x90\x5a\xe2\x1b\x11\x98\x9b\xe4\x22\x6a\xa6\xa3\x30\x91\x59\x2d\x28\x08\x2e\xe3\x29\xdc\x8c\xbf\x7e\xc1\xcd\x99\xb4\x7b\x59\xaa\x0d\xe6\x29\x73\x20\x73\x5e\xfe\x14\x45\x0f\xe4\x02\x83\x33\xc6\x6e\xc6\xe3\x85\x49\xa5\x08\xc0\x3f\xd9\xd7\xab\x07\x5c\x29\xa9\x02\x14\xcc\x21\xde\x2b\x29\xad\x75\xad\x14\xed\xa4\x82\x44\x9d\x73\x61\x2a\x6f\xcf\x03\x6f\xf0\x79\x34\xf2\xac\x11\x0a\xa4\x97\x19\x2c\x73\xae\x1e\x5d\x27\x42\x5f\x43\x1b\xe8\x03\x7d\xb8\x25\x3b\xd8\x11\x26\x92\xd9\x7d\x88\x2a\xb2\xad\x38\xb7\xae\x0d\xa4\x72\x51\x07\xba\x19\x8f\xe4\xcc\x2f\x03\x3d\x45\x38\xc1\x48\x44\x28\x70\x9d\xa5\x65\x43\x57\x39\x9e\x32\xf4\x89\xa7\x89\x00\xef\x3b\xc5\xf3\xb6\x62\x86\x6a\x86\x8b\x57\x05\xc6\xd6\xa6\xc0\x47\x7d\x10\x69\x46\x52\x39\x65\x0d\x69\x69\x73\xba\x0e\x0a\x11\xf3\x84\x2b\x58\xb2\x41\x26\x35\xf7\x03\x64\x84\xb5\x25\x34\xdd\x7d\xdd\xd7\xcd\xc9\xd1\x49\x7b\xd0\xa1\x21\x80\xb6\x3b\xcd\x5a\x5d\xac\x4e\x4a\x0b\x0b\xe4\x68\xc9\x2e\xf8\xca\x6c\xcc\xfc\x60\x0f\x50\x32\x37\x6c\x48\x65\x4d\x7c\xaf\x21\xfd\x39\xcc\x53\xad\xa9\xef\xda\x73\x87\x52\xd7\xf0\xbc\x80\xa8\x34\x6e\x80\x2d\x4b\x26\xc3\xf8\x09\x27\xd7\x49\x24\x11\x27\x5c\x35\x1c\xe2\x52\x9b\x35\x6f\xd7\x9a\x42\xda\x9e\x89\x42\x81\x4f\xa4\x6b\x1f\x7d\x27\xb8\xa4\x02\x25\x34\xa5\x04\xda\xea\x86\x6f\x35\xba\xbc\xae\xf2\xca\x82\x1c\xa8\xc8\x7e\x49\x1c\x9b\x0a\x61\xb3\x1d\xbc\x98\x30\x5c\x2c\x90\xa0\x7f\xe0\xb0\x07\x5d\x65\x45\xa8\x73\x10\x3a\x54\xd3\x0f\x3e\xbd\xa0\x09\x5a\xca\x55\xe7\x98\xa4\x47\x46\x6e\x75\x5c\xb6\xd5\x87\x0f\x96\xbf\x3c\x3d\xec\x9c\xf8\x2f\xc6\xc4\xef\x83\x63\x62\xb8\xe2\xd1\xfb\xa6\x44\x55\x6e\xea\x84\x56\x23\x6c\x8a\x8e\x0d\x75\xf0\x83\xc4\xa8\x74\x36\xe3\xd8\xdb\xa7\x1f\xd9\x16\x50\x6f\x35\x73\xe1\xbb\xf3\x9f\x74\x0b\x05\xa3\xb9\x55\x7f\x8c\xe1\xc5\xdf\x7e\x67\x94\x78\xea\xcf\x8d\x1e\x54\x1f\x12\x45\xf1\xc6\x77\xd1\x64\x38\x1a\x0e\xae\xcb\x36\xbb\x0f\x95\xad\xae\x0d\xe5\x3e\xd7\x76\xa5\xe7\x5b\x1a\xaf\x1e\xcc\x5b\x82\x65\x6e\x55\x8e\xc7\xc3\x54\xdf\xd4\xae\xe5\x0c\xda\x7b\xa3\x5d\xc9\x87\xf7\xb4\x6b\x39\x70\xbb\x88\xf3\xa9\xf7\x9b\x65\x82\x7b\x2d\x32\x17\x69\xb6\x29\x5d\x35\xf6\x1a\xb3\xce\x94\xb3\xae\x39\xe9\x5a\xac\xb7\x17\xf0\xc5\xf7\xaf\x7d\xb7\x5a\x9f\x67\x8d\xc5\x1f\x33\x57\xff\xb8\xfd\x0b\x00\x00"
 
-func mysqlQuerytypeGoTplBytes() ([]byte, error) {
+func postgresProcGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlQuerytypeGoTpl,
-		"mysql.querytype.go.tpl",
+		_postgresProcGoTpl,
+		"postgres.proc.go.tpl",
 	)
 }
 
-func mysqlQuerytypeGoTpl() (*asset, error) {
-	bytes, err := mysqlQuerytypeGoTplBytes()
+func postgresProcGoTpl() (*asset, error) {
+	bytes, err := postgresProcGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _mysqlTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x98\xdf\x53\xe3\x36\x10\xc7\x9f\xed\xbf\x62\xcf\xd3\x19\xec\x36\xe7\xb4\xaf\xcc\x64\x3a\xf4\xc8\x4d\x99\x72\xc0\x40\x68\xef\x8d\x28\xf1\x86\xa8\xd8\x52\x90\xe4\x40\xc6\xe3\xff\xbd\x23\xc9\x36\xfe\x45\x70\x28\xf7\x70\xc9\xc5\x96\xbe\x5a\xef\x57\xfb\x59\x99\x2c\xfb\x0c\x3f\xc9\x35\x17\x0a\x8e\x27\xe0\x9b\xff\x31\x92\x20\x84\x17\xfa\xd3\x43\x21\x3c\xf0\x04\x4a\x0f\x3c\xf9\x18\x4b\xa5\x7f\x46\x0b\x0f\xbc\xef\x97\xe7\xfc\xde\x0b\xe0\x73\x9e\xbb\x46\x45\x91\x45\x8c\x56\x65\xb9\xc6\x84\x40\x78\x53\x7c\xcf\xf4\x1d\xfb\xa9\x55\x5f\xe6\xd0\x15\x84\x5f\x78\x92\x20\x53\xe6\xda\x78\x0c\x59\xf6\x72\xa9\x18\x85\xb1\xc4\xfa\x6d\x13\x59\x9e\x83\xc0\x8d\x40\x89\x4c\x49\x20\x20\xf8\x13\xac\x04\x4f\xe0\x28\xcb\xca\x58\xf2\xfc\x28\xb4\x0a\x2c\xd2\x62\x6a\xb7\xc1\x86\x82\x54\x22\x5d\x2a\xc8\xcc\x20\x41\xd8\x3d\x42\xf8\x95\x62\x1c\x49\x3d\xdc\xa9\x0f\xcd\x32\x10\x68\x04\xc2\x99\xfe\xcc\x73\x98\xff\x2b\x39\x3b\xf6\x6c\xc4\xb1\xfe\x97\x26\xac\x18\xef\xcd\xa1\x7a\x98\xd6\xad\x7a\x44\x65\x12\xae\x04\x4d\x88\xd8\xfd\x85\x3b\x7d\xd5\x75\xc6\x63\x78\xe6\xb0\x32\xa1\xb8\xce\x1d\x3e\x53\xa9\xe4\x08\xee\x22\x8c\x51\x61\x04\x0b\xce\x63\x37\xcb\x4a\x99\xdc\xd5\x3f\xba\x42\xe3\x31\x4c\xcd\x54\x88\x50\xa1\x48\x28\x43\xa9\x87\xa9\x75\x33\x0f\x56\x1f\x28\x33\x77\x22\xa2\xc8\x82\x48\x0c\xdd\x55\xca\x96\xe0\xeb\x84\xda\x2d\x92\xe7\xf0\x73\x6d\x5e\x50\xa8\xfb\x81\x09\x08\x32\xd7\x11\xa8\x52\xc1\xa0\x3e\x25\x2c\xc2\xd7\x51\x8e\xc7\x70\x5a\x3c\xc2\x46\xf0\x2d\x8d\x74\x3c\x6c\xc5\x45\x42\x14\xe5\xac\x2f\xb6\x35\x91\xb0\x40\x64\x50\x3e\xbb\x71\xf9\xc0\x38\x8b\x45\xdf\x0a\xb4\x58\xa2\x88\xf4\x8c\x49\x14\x0a\xa8\xf9\x92\x9d\xc0\x14\x3f\x34\x0a\x2b\xe8\x47\x0b\xf8\x7e\x79\xfa\x47\x00\x28\x04\x17\x3a\x98\x2d\x11\xfa\x87\xbd\x60\xed\xa7\x2b\x20\xb1\x40\x12\xed\xac\x3b\x23\x58\x10\x1a\xbb\x0e\x5d\xf5\x26\x57\xab\x94\xcf\x64\x54\x64\x78\x81\x4f\xbe\x67\x83\x87\x15\xa1\x31\x46\xc7\x4d\x49\xe9\x05\xae\x93\xbb\xd5\xde\xb1\x65\xfa\x8d\xb0\x94\xc4\x57\x0f\x60\x4a\x60\x3c\x06\xf9\x18\x17\x39\x80\xc7\x14\xc5\x6e\x04\x1b\xbb\xc9\xe0\x01\x77\x90\xa4\x52\xc1\x02\x4b\x3b\x23\xd7\x59\x72\x26\x15\x58\x58\xc0\x04\xe6\x67\x17\x37\xd3\xeb\x19\x9c\x5d\xcc\x2e\xa1\x5e\x9b\xe0\xcf\xe1\x17\xd7\x71\xe6\x59\x06\x4b\x1e\x6b\xea\xc8\x5a\xf9\x15\x37\x03\xf8\xfb\xe4\xfc\x76\x7a\xd3\x1a\xbd\x25\x71\xdf\xe0\xb9\x4d\x9e\x48\x99\x8d\xd5\x75\x0c\xa6\x7c\x1b\xcd\x48\xaf\x6f\x8a\xaa\xb9\x58\x95\xcd\xc0\x75\xee\x46\xc6\x89\x09\x44\x8b\x70\xfa\x8c\xcb\x03\xa6\xd2\x95\x99\xfa\x69\x02\x8c\xc6\x2d\x43\x4c\xa2\x4d\x36\x51\xd9\xec\x23\x5b\xa2\x41\x4c\xd7\xcb\x09\x28\x91\xa2\xa9\x6f\x8d\xbe\x41\x3e\x94\xf9\x87\xc5\x0e\x48\xaa\x38\x65\x4b\x81\x9a\xa2\x1f\x64\x48\x8d\x2c\xe5\x86\x3e\xc0\xa1\x3d\xb3\xff\x97\x65\x3d\xba\x81\xae\x6d\x69\x5d\x3c\x3e\xd0\xc6\x7e\xb9\x41\xbe\x0a\x54\x82\xe2\x16\x81\x46\xae\x43\xa3\x6a\x7d\x81\x32\x3c\x27\x52\xd9\xda\x3f\x8b\xfc\x43\x36\x4a\xdd\x60\xc2\xa2\x57\x37\x8e\xa6\x4c\x37\x74\x98\x40\xeb\x46\xd1\xb9\x7c\x1a\x05\x6f\x6f\x3d\xdb\x5a\x2a\x52\x32\x1a\xbf\xf4\x19\x86\xe0\xbf\xa4\x31\x49\x63\x45\xf7\xe4\xd2\xde\x08\xc0\xf3\xca\xbd\x7c\xbb\x89\x88\x42\x48\xcd\x57\x17\xad\x9d\x46\xe4\xbc\xc9\x56\xab\xd8\xc3\xd6\x0e\x5c\x0b\xba\x46\x1c\x25\x3b\x52\x4d\xba\x6a\x6b\x3e\xbd\xca\xd7\x3e\xc0\xda\x47\xa8\x00\xab\x55\x81\xf1\x42\x56\x03\xd6\xf8\x59\xae\x69\xfb\x4b\x7d\xb5\xde\x06\x34\x74\xb5\x84\x88\x07\xdd\x11\xb9\xb0\xd2\x94\xb3\x97\x25\xad\x53\xf7\x0a\x7c\x88\x91\x75\xfd\x80\x00\x7e\x33\x7e\x38\x25\x5d\x4c\xfd\xc1\x13\x55\x6b\x58\xf2\x64\xc3\x25\x55\x58\xdf\x83\x7a\x68\x1b\x26\xb7\x57\xa7\x27\xb3\x69\x93\x23\x37\xd3\x19\xd8\xf2\x6e\xc2\xc4\xe8\x37\x37\x8b\x37\x02\x0f\x7e\xed\x09\xae\x04\x84\xe3\xcc\xe1\x9f\x3f\xa7\xd7\x66\x89\x86\x50\xcf\x24\x0f\x4e\x2e\x4e\x41\xef\x32\x4d\x15\xa7\xc5\x15\x67\x1f\x59\x86\xed\x61\xc8\xf3\x0e\x42\x3a\x63\xec\x64\x83\x0e\x67\x60\x3f\xf9\x51\xab\xd7\xb9\xe2\x54\x67\xe9\xae\xe9\x1f\xe2\x6c\xd3\xd4\x57\x91\xdf\xe7\x68\x63\xf4\x17\x1e\x5b\x7a\xfd\x7e\xb0\x8b\x03\x80\x3e\x82\x01\xe8\x3c\xc0\xba\x0f\x5d\xb2\xeb\x97\xa5\x70\xd9\x14\x6e\xc8\x16\x41\x92\x6d\x0f\x33\x3b\xc7\xd1\xb7\x99\xa9\xd5\xfa\x88\xd9\xc6\x52\x75\xca\xaf\x63\xa9\x31\xa2\xa2\x6f\x45\x9f\xbe\x51\xd5\xf9\xd7\x9c\x3b\x5b\xc7\x9b\xa2\x25\x48\x45\x94\x39\xb7\x48\xe0\x09\x55\x1a\x86\x51\x8a\xfa\xe9\x62\xb2\x7c\x00\xbe\x2a\xde\x8a\x80\xab\x35\x0a\x50\x6b\xc2\x1a\x88\xaa\x75\xae\xea\x65\xa3\xe0\x6e\x37\x67\xef\x7f\x95\x18\x7c\x88\xef\x6d\x33\x7b\xbb\x4c\xad\xdf\x96\xb6\x77\x5b\xc7\xde\xce\xd1\x56\x18\xde\x09\x86\x37\x82\x36\x2d\x4e\xa7\xe7\xd3\xd9\x14\xbe\x5e\x5f\x7e\x6b\x22\xe3\xbd\xf0\x6e\x55\xfd\xde\xa2\x7f\x05\x82\x45\x55\x0d\xad\xe3\xfd\x2a\xdd\x43\x5b\xb3\x5a\xcd\xdf\x0b\xea\x80\x6d\xf1\xf5\xdd\x09\xdb\xc7\xc6\xb7\x92\x34\x04\x3a\xfb\xd2\x33\x64\xfe\xd0\xc4\x94\xe7\xc9\xe2\x6c\x5b\xbe\x6b\x3b\xfd\xfb\xb8\x38\x88\xb6\x8e\x9f\x75\xa1\xff\x02\x00\x00\xff\xff\x0b\x99\xa0\x25\xbb\x12\x00\x00"
+var _postgresPrototestGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x41\x4f\x83\x40\x10\x85\xcf\xf0\x2b\xa6\xc4\x26\xb4\x52\x7a\x27\xe9\xa5\xb1\xde\x34\x8d\xe1\x66\x3c\x2c\x30\x50\xe2\x76\x77\xdd\x5d\x30\x86\xf0\xdf\x9d\xdd\x62\xb5\x89\x36\x26\x1c\x36\xbc\xf7\xbd\x79\x33\xc3\xb0\x82\x1b\x0b\xd9\x06\xd2\xfc\x43\x21\xac\xc6\x31\x5c\xaf\x21\x47\x63\x87\x81\x94\xf4\x91\x1d\x11\xc6\x71\xbf\x7d\x92\x9d\xa8\x72\xdd\x2a\xb0\x24\x1a\x28\xa5\xe8\x51\xdb\x56\x34\x70\xe1\x34\x60\x25\xd8\x03\xb6\x1a\x94\x96\x56\xba\xb8\x23\x1a\xc3\x1a\x34\xc0\x44\x05\x05\x2b\x5f\x13\x78\x6f\xed\x01\x18\xe7\x93\xb5\x6e\x91\x57\x06\x0c\xda\xc4\x9b\xbc\x7c\x92\x44\xc7\x39\x2b\x38\xba\xa0\xc9\xe6\x7e\xa5\x61\xdd\x89\xf2\x6a\xd3\xd8\xc2\xd2\x95\xa5\x8e\x69\xbe\x80\x21\x0c\x4e\xd5\x69\xdb\x23\x53\xcf\xc6\x6a\x52\x5e\x96\x17\x38\x99\x82\xa8\x67\xbc\x43\x13\x65\x0e\x09\x02\xd2\x55\xe1\xc8\x69\x7a\x0a\x35\xe3\xc6\x99\x49\x1d\x13\x07\xb8\x42\x57\xfc\x56\x77\x3f\xec\x63\x18\x06\xb5\xa4\xc5\x68\x22\x1d\x82\x09\x7f\x7f\xcd\x44\x83\xd3\x6d\x5d\x8c\x2a\x12\x40\xad\x9d\x74\x51\xf0\x41\x56\xc8\x73\xb9\xdf\xc6\x8e\x5c\x90\xb3\xad\xbd\x71\xb6\x01\xd1\xf2\x53\x05\x9b\xde\x33\xcb\x78\x1d\x47\x73\x93\xc1\x19\xc9\x60\xde\x47\xc9\x34\x98\x18\x47\xbb\x36\x41\x23\xed\xef\xd3\xf6\xdb\x5c\x7a\x3c\x56\xc5\xbf\x66\x9d\x81\x3f\x67\x51\xc4\x4c\x63\xcd\xb1\xb4\xe9\x1d\xa2\xda\xbd\x75\x8c\xc7\xbe\x81\xdf\xe8\x2b\x75\xa7\xb5\xd4\x53\x2a\xa9\x30\xbf\xed\xa7\x6b\xd1\xeb\x9c\xfc\xcd\xf9\x7c\xfa\xc6\xf0\x13\xe9\x4d\x8e\xd5\xd5\x02\x00\x00"
 
-func mysqlTypeGoTplBytes() ([]byte, error) {
+func postgresPrototestGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_mysqlTypeGoTpl,
-		"mysql.type.go.tpl",
+		_postgresPrototestGoTpl,
+		"postgres.prototest.go.tpl",
 	)
 }
 
-func mysqlTypeGoTpl() (*asset, error) {
-	bytes, err := mysqlTypeGoTplBytes()
+func postgresPrototestGoTpl() (*asset, error) {
+	bytes, err := postgresPrototestGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "mysql.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.prototest.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _oracleForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _postgresQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x6f\xd3\x48\x14\x7e\x4e\x7e\xc5\xac\x15\x21\x1b\x8c\xe1\x61\xb5\x0f\x95\xfa\xc0\x42\x57\x42\xcb\x52\x58\x78\x40\xea\x56\xe0\xd8\x93\xc4\xc2\x99\x49\xc6\x0e\x24\x8a\xf2\xdf\x39\x97\xb1\x3d\x76\x9c\x6e\x55\x68\x97\x6a\xf3\xd0\xd6\x99\xcb\xb9\x9f\xf3\x9d\xe3\x74\xbb\x7d\x2c\x46\xc5\x4c\x9b\x52\x9c\x9c\x0a\x9f\x9e\x54\x3c\x97\x22\x7a\xbf\x59\xc8\xe8\x35\x3e\x7a\xd2\x18\x4f\x78\xc5\x32\x2f\x4a\x7c\x48\xc7\xf0\x6b\x09\x3f\x46\x16\xf0\xfb\xc3\xf9\x2b\x3d\x85\xbf\x13\x05\xbf\x62\x33\x85\xb5\xe8\xed\x4a\x9a\xcd\x9b\xd8\xc4\xf3\x22\x10\x8f\x77\xbb\xe1\x16\xf9\x2c\x71\xf5\xb9\x9e\xcf\xa5\x2a\x0b\xe4\xc7\xe7\xea\x95\xfa\x20\x52\x21\x79\xe8\x06\x7d\x8a\x1c\x3a\xc0\x97\x76\x17\x26\x53\xa5\xf0\x1e\x7a\x8e\xb4\xce\x31\x95\xe5\x78\xcc\x83\xbf\x5e\xbd\x9a\x4d\x44\xf4\x2e\x89\xf3\xd8\x88\xdd\x6e\xbb\x65\x62\x40\xcb\xc8\x12\x48\x08\x3f\x53\xa9\x5c\x5b\x7a\x7f\x64\x32\x4f\x0b\xf1\x34\xa0\x8f\x81\xbd\x80\x64\xe9\x02\x3c\x5c\x75\xe7\x75\x96\x3b\xd7\xa4\x4a\x5d\x05\xca\x46\x32\xda\x06\xb1\x62\x38\x11\x9d\xab\x7c\x73\xae\xe4\x9e\x8c\x25\xb0\x24\xce\x7b\xc4\x50\xa1\xb3\xb5\x4c\x5a\x0b\xd6\xa4\xb4\xf6\xe4\x89\x80\x2b\x53\x9d\xd8\xb5\x7a\xd3\x9e\x97\x79\x21\x9d\x83\xec\xf3\xdd\x4e\x98\x95\x2a\x44\x2c\x92\x55\x51\xea\xb9\x28\xca\xb8\x94\x78\x2d\x14\x20\xcd\xca\xa8\x4c\x4d\x45\x39\x93\x42\xad\xe6\x63\x69\x84\x06\x05\x26\x13\x99\x94\x32\x15\x46\x7f\x2d\x22\xa6\x0d\x82\x5a\x36\x5f\xb3\x72\x06\x6a\xbd\x7d\x25\x88\x15\x72\x7b\x0f\xd7\xc9\xc3\x22\x2b\x4e\x78\x6d\x60\x45\x4d\xc1\x04\xb5\x80\x4c\x64\xb2\x52\x89\x2b\xa0\x0f\xcf\x49\xb9\x5e\x60\x94\xc1\xc7\x74\x2c\x3e\x9c\xbf\xf8\x1d\x16\x4d\xac\xa6\xb2\x15\x83\xb5\x8d\x95\x06\xfd\x5f\xc8\x49\xbc\xca\x51\xff\xb0\xa5\x30\x3e\xa3\xc7\x1a\x1b\x3b\x0f\xa1\xd0\x0b\x08\xd1\x28\x8a\x3e\x9c\x9f\x2f\xca\x4c\xab\x00\x1d\x5f\xfe\xf6\x6b\x28\x20\x3f\xb4\x09\xc4\x76\x38\x40\x71\xd7\x5a\xd3\x3e\x72\xad\x56\x32\x05\xa9\xb3\x62\xf3\x73\x4e\x79\xd6\x6b\xd5\x19\xb2\x03\xe9\x92\xb2\x78\x05\x1b\x80\x36\x81\x8f\x34\x0b\x9d\x27\x33\x99\x7c\xc6\x0d\xef\xa9\x47\x9b\x60\x44\x48\x4b\xbe\xdc\x84\xb7\x9c\x72\x3e\xe1\x89\x2f\x10\x44\x9c\xb9\xe0\x42\xc8\x97\x29\x2f\x51\x4e\x5d\x5c\x12\xe1\x49\x9c\xc8\x2d\x9b\x9a\x4d\x37\x2a\xe4\x94\xd2\xd3\xa5\x44\x81\x0b\x91\x4e\x81\xdb\x44\x2d\x9e\x85\x88\xaa\x8c\x45\x27\xe0\xc0\x3f\x25\x0b\x08\x27\x70\xd5\x39\x04\x66\xea\x44\x46\xc3\x34\x02\x77\x35\xdc\x2a\x7f\xbd\xb1\x1e\x46\x5b\x30\x83\xdd\xce\xaa\xf4\xe8\x54\x7c\x42\xb7\x71\x58\x7d\x6a\xe2\x19\xed\x40\xf7\xc0\xca\x8b\x98\x79\xf5\x5e\x5f\x6b\x0e\x11\x3f\x97\xca\x47\xab\x04\xa1\xc0\x47\xa4\xca\x04\x6c\x78\x04\x81\x4b\x60\xa2\x8d\xf8\x18\x8a\x2f\x68\x0d\x96\x7f\xef\x02\xc7\x43\x75\x61\x40\x16\x3f\x15\xf1\x62\x01\xaa\x13\x27\xb8\xde\xa2\xe9\xa4\xe3\x21\x69\x61\x4d\x95\x33\x0a\x93\xa9\x16\x5e\x2d\xb3\xd7\xb9\xd1\xc7\x0c\x76\xab\x72\xda\xd8\x34\xe8\x3a\xc3\x79\xec\x78\x77\x38\xd8\x3b\xe1\x3e\x3a\x62\xa3\xf1\x5f\xda\x90\x85\xaa\x01\xcb\x10\x72\x98\x49\x7c\x26\x81\xdc\x28\xeb\xc4\xaa\xa2\x93\x94\xb3\xa1\x90\x85\x62\x94\x37\x00\xd1\x04\x5b\x86\x17\x1e\xb9\xd9\x09\xab\xb6\xfe\x76\xe0\x65\x94\x61\xe5\x15\x5c\xd4\x0e\x9c\xe8\x64\x7a\xc5\x01\x95\xb0\x25\x16\xa3\x6b\x84\x55\xf7\x53\x7d\xd0\xd5\x9c\x32\x10\x0a\xa5\xcd\xc0\x01\x61\xa1\xcf\x1a\xe1\x4d\xf2\x03\x5a\x79\x00\x30\x43\x85\x02\xb5\x4a\xc7\x11\x6c\xa6\xe3\x89\x12\x1e\x16\x01\xaf\xa9\x66\xe8\x9c\xca\xe1\x6d\x02\x20\x1c\x5e\xff\xe5\x54\x20\x0c\x40\x6c\x0d\xb8\x0e\x8b\xa7\x44\x17\xbd\x33\xac\x96\xd6\xfa\x6f\x28\xc1\xcf\x6c\x3d\x06\xa8\x2a\x82\xe1\xae\x9d\x1c\x7f\xc5\x8b\x5b\x46\x0c\x32\x49\x17\x2d\x12\x9d\xaf\xe6\x70\x0a\xe0\x02\x3f\x82\x64\x54\xea\x32\x85\xb4\xb4\x49\xa5\x09\x09\x08\xdd\xcd\xb8\x10\xf3\x78\x51\x88\xcf\x72\x03\xe0\x32\xde\x58\x22\x02\xfb\x94\xff\x05\xcc\x5c\x5c\x72\xe9\x0e\xa1\x62\x83\x25\x2e\xf8\x93\x5b\xbc\x6f\x8a\x41\xde\xbb\xb3\x57\x67\xcf\xdf\x7b\xe2\x86\x30\x04\xa1\x18\x0a\xdb\xc5\x1c\xd1\xe8\x88\x46\x47\x34\xba\x1f\x68\xb4\xec\xc5\x22\x52\xef\xfb\xc0\xa8\x2a\x08\x35\x26\x0d\xa0\x8e\xc0\x78\xb0\x8c\x9e\xe7\xba\x90\x7e\xe0\x82\x14\xcc\x38\x0a\x70\xa8\xf0\x97\x2d\x78\xba\x63\x58\x72\x60\xc6\x46\xcb\xde\x7c\xc8\x8e\xe1\x78\xa9\xca\x77\x45\xbe\xf6\xc4\xed\x43\x91\xf8\x09\xb0\xc8\x21\x5a\xcd\xaa\xbb\xdd\xc5\xa5\x7b\xdb\x9a\xec\x3f\xc2\x24\x1a\x96\x2b\xac\xc1\x20\x25\x29\x86\x47\x74\x3a\xa2\xd3\x11\x9d\xee\x05\x3a\x59\x7b\x1e\x78\x1f\xc6\x39\x49\x89\x42\xaf\x2d\xb9\x8a\xd9\x9a\x33\x1c\x60\xc6\xf7\xa0\x1a\xcc\x43\xd7\x00\x36\xe4\xa2\xfc\x07\x2e\xf1\xab\xc0\x6e\xbb\xad\xde\xc9\xed\x8f\x60\x2e\x0d\x42\xc4\x76\xbe\x39\xea\xdc\x2e\x16\x1f\x86\x61\xf0\x46\xae\xe3\xb4\xc2\x3f\x1a\x51\x51\x0a\x2a\xe6\x15\xec\xc1\x4d\x4c\xdf\x65\xf4\x5a\xae\x4b\x9f\x6a\xf9\x95\xf6\x87\x6d\xac\xb3\x60\x46\x78\x62\x5f\x2c\xfb\xad\xda\x23\xf7\xbe\xe0\x64\xd1\x01\xbf\xa2\xb5\x09\x4a\x83\x74\x87\x96\x63\x77\xda\xee\x9a\xbb\x15\x4a\x53\xa9\xa4\xc9\x92\x0a\x86\x5c\x37\x59\x3f\xac\x35\x59\x1f\x0e\x5f\x74\xd1\xfe\xb2\xe5\x8e\x74\x1c\x8a\x1b\xbb\xe4\x9a\xa1\xd2\x12\xd7\x7d\x8b\x60\xa5\x7c\x96\xe7\x77\x22\x65\xaf\x61\x9d\x1e\xa0\x3f\x2f\x5b\x62\xfd\x90\xec\xac\x4a\xf3\x84\x5f\xb4\x73\xfb\x72\xad\x74\x75\xd5\xaa\xf0\x9f\xc4\x7b\x63\xb2\x79\x6c\x36\x7f\xca\x4d\x5d\xa7\x0a\x68\x22\xe4\x3a\x2b\x4a\xa9\x12\xd9\x0e\x93\xe8\x23\x6d\x60\x48\x42\xef\x22\xdb\x25\xce\xb2\x7a\x70\x85\x4f\x7f\xce\x9c\x7f\xb8\xd7\xd7\xf6\xa5\x7e\xcb\xbd\x27\xa7\x7b\x1e\xe6\x33\x07\x4d\x0b\x29\x6e\x8d\x77\x42\xb6\x0b\x99\x64\xf5\x75\x86\x4d\xf7\x03\x05\xe4\x5f\x1d\xff\x5d\x15\xa5\x5b\x9e\x0e\x46\x3e\x7b\xba\xdd\x75\x39\x59\xd0\x19\x3e\xce\xe2\x64\xc6\x03\x08\xbd\xfd\x6a\x8d\x20\x00\x01\x39\x0e\x20\xe0\x7a\x1a\x15\x24\x9d\x25\xcf\xe0\x30\x12\x5b\x52\x37\x9f\x48\x42\xa2\xab\x57\x25\x39\x1d\x59\x01\x47\xe7\x9d\x5b\xa9\xc5\x5c\xce\xb5\xd9\x44\xe2\x25\x74\x20\x31\x76\xe7\xd0\xf9\xea\x05\x30\x2f\x51\x60\x94\x60\x92\x99\xa2\xe4\x26\xda\x0e\x4d\xfc\x12\x6e\xa2\x80\xfc\x2c\x03\x91\xb3\xa2\xde\x88\xf6\x66\x16\x34\xc0\x5d\x8f\x2d\x60\x50\x14\xc3\x6f\x8c\x15\xb0\x02\x7d\x13\x0d\x6b\x76\xbd\x19\xc5\x7e\xe3\x68\x47\x15\x54\xcd\x0b\x7e\xc4\x6b\xb4\xe3\xeb\xb3\xe3\x80\x72\x1c\x50\xee\xe3\x80\x52\x77\x67\x3e\x45\x37\xd7\xe9\xc0\xf6\x6a\xf6\x35\x17\xa9\xed\xd4\xc1\xa6\x2b\xc3\x1a\x7a\x80\xfe\xed\xb7\x0a\x57\x76\x09\x0b\xa3\x13\x59\x14\x4d\xa3\xd0\x6d\x05\xf6\xfe\x97\xe2\x2e\x06\x03\x07\xc1\x99\xc4\x84\x72\xdb\xbd\xdd\xb2\xdd\xbd\xed\x57\xfa\x14\xed\xda\xc9\x89\xdc\xeb\x90\x72\x3b\x9a\x65\x74\x66\x8c\x1f\xec\x37\x34\xfd\xe5\xa0\x17\xa6\x6b\x84\x6a\x40\xba\x6e\x7e\x18\x5b\xa9\x1e\xc8\x92\x5b\x1f\x17\xbe\xb9\x11\xd0\x8c\x26\x4e\xd7\x62\x31\xc2\x87\x50\x1b\xd5\x3d\xd5\x88\xff\x4f\x62\x84\xdf\x58\x52\x11\xc0\xb4\x73\x2f\x62\x66\x39\xe0\x8f\x8c\x1a\x91\xb0\x53\xb1\x10\xec\xf4\x26\xb5\x7c\xfe\x81\xae\x22\x10\x55\x83\x80\xb6\xac\x33\x99\x94\xf7\x3d\x97\x7b\xe4\x50\xf0\x5a\x5d\x4a\x70\xd0\xa6\xdf\x00\x4e\x85\x3a\x23\x75\x25\x00\x00"
 
-func oracleForeignkeyGoTplBytes() ([]byte, error) {
+func postgresQueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_oracleForeignkeyGoTpl,
-		"oracle.foreignkey.go.tpl",
+		_postgresQueryGoTpl,
+		"postgres.query.go.tpl",
 	)
 }
 
-func oracleForeignkeyGoTpl() (*asset, error) {
-	bytes, err := oracleForeignkeyGoTplBytes()
+func postgresQueryGoTpl() (*asset, error) {
+	bytes, err := postgresQueryGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "oracle.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _oracleIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _postgresQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x91\xcd\x6e\xc2\x30\x0c\xc7\xcf\xed\x53\x78\xd5\x24\xe8\x24\xc2\x7d\x12\x27\xc4\x8e\x3b\x0c\x1e\x60\xa1\xb8\x1f\x52\x93\x30\x27\x15\xa0\xaa\xef\x3e\xbb\xcd\xa0\x68\x87\x3a\xae\xfd\xf3\x3f\xb6\xd3\xf7\x2b\x78\xf5\xb5\xa3\x00\xef\x1b\x58\x8e\x9e\xd5\x06\x41\x7d\x8a\xcd\x90\x28\x83\x8c\xdc\x25\xcb\x61\x35\x0c\x69\x2f\x7c\xd0\xc7\x16\x27\xbe\xa8\xd1\x68\x50\xfb\x78\x1e\x24\x33\x59\xa9\x7f\xd4\x34\x25\xa8\xad\x33\x06\x6d\x18\x63\xeb\x35\xf4\x3d\x54\xae\x88\xb1\x7b\x32\xf2\xd8\x7a\x9c\x81\x53\x37\xc3\x00\x84\x67\x42\xcf\xa0\x07\x0d\xdc\x16\x94\xe4\x0c\x2c\x18\x89\x5d\x0d\xc3\x42\x4d\x0a\xf6\x24\x62\xe1\x76\xc6\x27\x05\x1f\xa8\x2b\x02\xf4\x23\x44\xda\x56\x3c\xeb\xce\x1c\xf1\xe4\x05\x4f\x66\x28\xbb\x97\x26\xd4\x80\x92\x0d\xba\xf2\xa0\x44\xe0\x5b\x10\x76\xe4\x8c\x97\xcc\xee\x9b\xa9\x7e\x34\xd8\xfe\x57\x95\x66\x08\xc7\xb6\xd4\x41\x2c\x87\xe2\x90\x5b\xd7\xca\xd7\x19\x1b\xd9\xb9\xf0\x7d\x8f\x15\x5a\xa4\xa6\x18\x85\x65\x3d\x57\xb7\x2f\xb4\x05\xcf\xc6\x8f\x2b\x69\x6c\x70\x10\xea\xa7\xb1\x55\x5a\x76\xb6\x80\xa5\x2c\x6a\x7a\x6e\xbe\xf6\x6d\x06\xe4\x51\x67\x29\x0a\x57\xf7\xe5\x2e\x39\xf0\xe3\x3b\xe2\x4d\x25\xec\xc8\x73\x73\x4a\x8d\x0c\xd7\xfd\x74\x48\xb7\x72\x9a\x50\x3d\x34\xf3\x34\xe1\x16\x85\x7f\xd9\x80\x6d\x5a\xa9\x4e\x78\xdc\x8e\xac\x44\xd3\x84\x7b\xfe\xfb\xe7\x74\xfa\x34\xe2\x2f\x01\x76\xbc\x6d\x8d\x02\x00\x00"
 
-func oracleIndexGoTplBytes() ([]byte, error) {
+func postgresQuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_oracleIndexGoTpl,
-		"oracle.index.go.tpl",
+		_postgresQuerytypeGoTpl,
+		"postgres.querytype.go.tpl",
 	)
 }
 
-func oracleIndexGoTpl() (*asset, error) {
-	bytes, err := oracleIndexGoTplBytes()
+func postgresQuerytypeGoTpl() (*asset, error) {
+	bytes, err := postgresQuerytypeGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "oracle.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _oracleQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _postgresRepositoryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\xdf\x6b\xdb\x30\x10\x7e\x8e\xff\x8a\x23\x8c\x61\x97\xd6\x7d\x2f\xec\x25\x84\x42\x61\xb4\xb0\xb6\xac\x30\xc6\x50\x6c\x39\x11\xd8\xb2\x27\xc9\xa9\x8b\xc9\xff\xbe\x3b\xcb\x4e\x94\x38\x6e\x33\xea\xad\x2f\xfe\x21\xe9\x3e\x7d\xf7\x9d\xee\x4e\x75\x7d\x01\x9f\xf4\x2a\x57\x06\xae\xbe\x80\xdf\x7c\x49\x96\x71\x08\x6f\xe9\x39\xe5\x4a\x4d\x61\x1a\x2f\xf0\x91\x17\x46\xe3\xcb\x54\xd3\x00\x2e\x36\x1b\xaf\x26\x53\x75\xcc\xd6\x2f\x94\x90\xa6\x83\xf8\xc6\x8b\x5c\x0b\x93\xab\x17\x34\x3c\x0e\xd8\x51\x08\xaf\x05\x4f\x63\xed\xe0\x97\x45\xcc\x0c\x27\x7c\x89\xb8\x09\x4d\xd3\x16\x3a\x2b\x53\x23\xba\xf5\x9d\xb9\x6f\x57\x8b\xa5\xcc\x15\x7a\x10\xe0\x76\xd3\x06\xea\xf2\x12\xea\xba\xe5\xb3\xd9\xdc\x1b\x9a\x16\x1a\xcc\x0a\x5f\xd2\x70\x95\xb0\x88\x43\x92\x2b\xd0\x38\x25\xe4\x12\x98\x8c\x41\x71\xa3\x04\x5f\xd3\xaf\x63\x0c\x2a\x7f\xd6\xa1\x67\x5e\x0a\x7e\x04\x73\x0b\x56\x7b\x13\xdc\xf4\xbb\x30\xab\x87\x8a\x90\x4a\x25\x35\xb0\xbe\x45\xa9\x09\xdf\x54\xe7\xa0\xcb\x68\x05\xcc\x92\x32\x8a\x49\xcd\x22\x23\x72\x09\x79\xd2\x40\x3d\xdd\x59\xb0\xd0\x9b\xd8\x0f\xdf\x54\x38\x38\x9f\x05\x3d\x50\xcf\x9b\xdc\x48\xcd\x95\xf1\x71\x26\x32\x55\xc1\x14\xcb\x70\x12\xff\x5a\xa1\xd0\x8d\x33\xc7\xea\x1c\x28\x14\x10\x86\xe1\xd3\xdd\x5d\x41\xbb\x06\x80\x71\xca\x55\x13\x03\x91\x6c\xc3\x80\x52\x4e\x1e\x9b\xcf\xf7\x43\x4f\xee\xd9\x7a\x04\x18\x62\xc8\x31\x5a\x44\x6d\xce\x53\x3e\x06\x35\xc2\xc4\x08\x2c\xf1\x0c\xdd\xc8\x98\x57\x5c\x5b\x1c\x54\x22\xbc\x2e\x65\xd4\x02\x74\xea\xd8\x45\xe1\x8d\x7e\x94\xe2\x77\x69\x55\xc2\xd5\x0a\xcf\x7d\xc6\xcd\x2a\x8f\x21\xc4\xb1\x3e\xad\x65\xde\xfc\xa4\x42\x6f\x4f\x3e\x24\x2c\xd5\x14\xff\x72\x88\xa1\xdf\xb8\xf0\x80\xc7\x6f\xe7\x47\xc3\x3a\xb0\x52\x90\xf9\x3f\x21\xf0\x15\x57\x6d\x49\xfc\xf8\xf9\x06\x0d\x1b\x91\xdd\x27\xae\x6e\xc7\x36\xde\x41\x3a\xee\xea\x03\xe5\xe4\x70\x8e\x60\x5e\x2c\xb9\xe4\x0a\x4f\x5f\x0c\x09\x86\x41\x53\x9a\x12\x98\x75\x52\x37\x19\xec\xa6\xea\x33\x26\x0a\xcc\x67\xfd\x6c\x75\xb6\xd4\xe8\x6a\x64\x28\x5f\xe7\xb3\x26\x9f\x5a\x82\xb7\xfc\xf9\xb8\x41\xa4\x38\x32\x38\x20\xea\xcc\x5b\xb6\xf1\x22\xf4\x88\xe3\x20\x8e\x1f\x2f\xda\xf4\x3d\x3b\x8e\x83\x8c\x6c\xdd\x80\xcf\x47\x17\xd4\xf3\xd9\x15\x6e\xd3\x09\xfa\x5a\xa9\xe9\xb1\x33\x55\xcb\x8e\xce\x44\x57\xc3\x0f\xd2\x63\x67\x14\xc0\x5b\x15\xc7\x21\x3b\xe8\xaf\xa9\x82\x96\xaa\x2d\x4e\x58\x2c\xe9\x65\x0b\x9e\x1b\x35\x93\x37\x43\x58\x65\xd8\x82\x69\xfe\x77\x4c\x47\x2a\x7c\x8e\x43\xae\x71\xb8\x07\xcf\xd4\xb2\x05\xdf\xf2\x0a\xe7\x33\x8b\x89\x90\xe4\x6e\xbf\x7e\x92\x00\xb6\x84\x82\x1d\xec\x0b\x20\xe4\x3b\x04\x18\xa9\x3c\x0f\x09\xb0\x07\x7f\x82\x00\xe4\x2e\x15\x7a\xd0\xf8\x18\x39\xd6\xa3\x34\x90\x21\x47\x1d\xf0\x13\xe3\xdc\xd6\x37\xf2\xd8\x36\x22\x88\x9b\x57\xdf\xeb\x44\xe5\xd9\x3b\xfc\x1e\xa9\xcd\x0d\x79\xbe\x07\x7f\xa2\xef\xa7\x74\xcb\xb6\xec\x1f\x74\x25\x88\x58\x9a\xea\x46\x1e\x67\x71\xf8\x4a\x6f\x1d\x94\xca\xed\x46\xae\x5e\x1f\xd0\x8b\xf7\xb5\x75\x3d\x7b\x55\xd7\x01\x22\x0d\x05\xcb\xa6\x23\xe2\x9e\xbb\xb6\xe5\x7f\xbc\x2e\xa7\x5e\x11\xfe\xab\x3a\xc3\x37\x11\x3a\x90\x6b\xae\x44\xf2\x32\x74\x1d\xc9\x8a\x94\x67\x5c\x1a\xdd\xbf\x63\xaf\x99\x82\x5f\xfd\x46\xf8\xa5\x3d\x14\x7d\xb9\x7d\x29\xd2\xc0\xfb\x03\x3f\x5e\x2e\xa2\x70\x0d\x00\x00"
 
-func oracleQueryGoTplBytes() ([]byte, error) {
+func postgresRepositoryGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_oracleQueryGoTpl,
-		"oracle.query.go.tpl",
+		_postgresRepositoryGoTpl,
+		"postgres.repository.go.tpl",
 	)
 }
 
-func oracleQueryGoTpl() (*asset, error) {
-	bytes, err := oracleQueryGoTplBytes()
+func postgresRepositoryGoTpl() (*asset, error) {
+	bytes, err := postgresRepositoryGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "oracle.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.repository.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _oracleQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _postgresServerGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x58\x5b\x8f\xd3\x38\x14\x7e\x6e\x7e\x85\xa9\xd0\x2a\x41\x25\xbc\x0f\x9a\x87\x69\xbb\xa0\x91\x80\x19\xc1\xc0\xee\xab\x9b\xb8\x6d\xd4\x5c\x8c\xed\x30\xed\x46\xf9\xef\x9c\x63\xe7\xda\xba\x99\xb6\x20\x04\xda\x97\x4c\x63\xfb\xdc\xbe\xf3\xf9\xb3\x33\x45\xf1\x92\x3c\x57\xe4\xea\x9a\xf8\x0f\x3b\xce\xc8\xcb\xb2\x74\x0a\x1c\xe3\x9b\x15\x8e\xbe\xcd\xee\x69\xb0\xa1\x2b\xf6\x81\x26\x8c\xf8\xef\xb3\x90\xc5\x0f\xd9\xfd\x74\x96\xa5\xcb\x68\xe5\xdf\x26\x3c\x13\xea\x13\x13\xdf\xa2\xa0\x67\x8c\xb6\x7c\x13\xa5\x21\xdb\xee\x7b\x96\x6b\x30\xc1\x79\x57\xff\x4a\xd1\xf1\x73\xe5\xeb\x00\x63\x26\xc4\x98\x8c\xc3\x05\x3c\x02\xb5\x85\xa7\x60\x5f\xf5\x53\xc2\x93\xeb\xe1\x2c\x96\x63\xaf\xe3\xce\xe6\xcf\xe5\x22\x4a\x55\xeb\x16\x33\x64\x02\xcc\x4e\x0d\x50\xa7\x09\xa5\xf8\x6f\x22\x16\x87\xb2\x13\x92\xc7\xb9\xa0\xb1\x2e\x51\xff\x8a\xfe\x6b\x2b\xc0\x45\xaf\x5e\x91\xa2\x68\x46\xca\xd2\x44\x27\x91\x24\x6a\xcd\x0e\xa7\x10\xba\x6c\xa9\xe7\xb8\xc8\x54\x46\xb8\x81\x5c\xaf\xc4\x3e\x94\xe5\x04\x7d\xe6\x32\x4a\x57\x7a\xd9\x8a\xa5\x4c\x50\xc5\x42\xb2\xcc\xd3\x40\x92\x65\x26\xfa\x6e\xc9\x63\xa4\xd6\x64\x3e\xf5\x1d\x85\xd8\xdb\xb2\x91\x4a\xe4\x81\x22\x85\x33\x6a\xc3\xf8\x9f\xd3\x28\xe1\x31\x4b\x58\x0a\xce\x6d\x89\x1a\x63\xc7\x19\xcd\xa7\xe4\xdf\xbb\xf9\x14\x7e\x41\x66\x37\xb9\x02\xb4\x10\x06\x5a\xff\x32\xb5\x06\x34\x8e\x65\x5d\xdc\xc7\xfb\x19\x49\x18\xcc\x87\xd2\xe4\x07\x83\x91\x20\xd0\x80\x9c\x49\xa5\x1d\x2d\x18\x94\xc2\x70\x62\x47\x44\x9e\xfa\xe4\x26\x8e\x3b\x8e\xa8\xe8\x44\x08\xc9\xe3\x9a\xa5\x24\x8d\x62\xdf\x19\xb5\x19\x20\x22\x2e\xb4\x96\x04\x19\x14\xb1\x55\xfe\xcc\xfc\x9d\x54\xb1\xb1\x70\xc0\x71\x82\x71\x09\x90\x84\x89\x25\x0d\x58\x51\x7a\x04\xa8\x91\x09\xa7\x74\x10\xeb\x0f\xec\xd1\x06\x5a\x20\x18\xc0\x0e\x89\x58\x21\x35\x0d\x0a\x17\xbe\x83\x49\x1c\xf1\xe1\x86\x0b\x8d\x9c\x47\x5e\xd8\x7c\x40\x3f\x04\x53\xb9\x48\xc9\x5f\x96\xe9\x62\x3e\xbd\x82\x00\x65\x95\x25\x1d\xc2\xfd\x10\x76\x83\x3a\xd4\x5d\x25\xe8\x62\x84\x6a\xff\x00\x67\x6c\xf9\x78\xad\xe7\x1f\x00\x15\xab\x8a\x96\xa4\x17\xce\x6f\x5b\x76\x7d\x8d\x5d\xc4\x45\xc8\x81\x87\xbb\xf9\xdd\x55\xa7\xb4\x03\x1e\xd9\x78\x09\xa6\x15\x6c\xe0\xc9\x19\x01\x3c\xf5\xfb\x91\xa0\x58\x4d\x9d\xbd\x4e\xdb\xab\x30\x7d\xcb\x54\x7f\x2b\xad\x98\xb2\x6c\x5c\xb2\xd8\x91\x08\x26\x40\x68\x12\x2a\x76\x64\xc3\x76\xe7\xa0\xba\x1f\xc5\x0e\x2e\xa2\xf9\xa2\xb3\x3d\xf7\xad\x3e\x9a\xad\xe3\x11\x77\x78\x95\xe4\x59\x2a\xd9\xc4\x34\xc3\xab\xba\x01\x2f\x28\x61\x7d\x7c\x68\x1f\x9f\xf1\xbe\xaf\xb1\xc1\xea\xb5\xb6\x7e\xd6\xf6\xad\x05\x5f\x47\xd1\x1d\x00\x43\xbe\x00\x5c\x64\x47\x45\x2b\xbd\x05\x91\xd4\xb2\x53\xc7\x9d\x74\xb3\xc1\xc5\x00\x64\x8d\x0c\x0c\x41\x2e\x54\x60\x6d\xfd\x64\xe7\x53\x78\x5f\x65\x9c\x0a\x9a\xc4\x91\xec\xaa\x35\x01\x75\x03\x2d\xa0\xb1\x44\x1f\x5e\x53\xf0\x91\x94\xb7\xd9\x27\x45\x55\x2e\x5d\x58\xe3\x19\xfa\xf0\x45\x2f\xa9\x06\x81\xe6\x08\x74\xbb\x05\x3c\x19\xa1\x06\xa5\xb7\xbb\x9f\x68\x58\x41\xf0\xb8\xe1\x8b\xde\x11\x59\x96\x57\x30\x04\x88\x21\xd1\x0d\x65\xdf\x41\xed\xda\x9d\x39\x97\x80\x74\x88\x46\x4b\xda\x66\x7c\x02\x13\x49\x84\xe7\x06\x4d\x43\xd8\x4e\x4b\xc9\x14\x12\x19\x17\x56\x32\x7c\x0e\x89\x0f\xe2\x9e\xc6\xe2\x03\x33\x3b\x8d\x2d\xcb\x2e\xe7\xf1\x81\xb3\x73\x88\x3c\x12\xd9\xa3\xec\x53\xb4\x76\xf3\xcf\x9a\x09\x36\x48\xd1\x09\xa8\xfd\x3b\x44\xdd\x05\x5d\x74\x51\x7c\xf5\x9b\xe7\xe1\xc4\x9d\x6e\x41\x33\x63\x5e\x3d\xef\x74\x36\xf1\x85\x1c\xa0\xa9\x44\x9e\x4a\x17\xd3\xff\x21\x82\x1e\x6d\x45\x9f\xa1\xcd\x34\x32\x54\xf6\x28\x3a\xd3\x07\x67\x5f\x41\x23\x70\x20\xac\xda\x5a\x09\xfd\x05\x94\xb4\xc4\x39\x8d\x94\x16\x43\x3b\x2d\xad\x0b\x2f\x27\xa6\xc5\xdd\x59\xd4\x84\x38\xc8\x1c\xad\xb5\x7b\x3a\xd1\x3d\x57\xbb\xb6\x52\x4b\x9d\xff\x37\xe6\xea\x06\xc0\x13\xe9\xdf\xa6\xdf\xe0\x1a\x1b\xde\x88\x55\x8e\x77\x3f\xc8\x2b\x89\xa4\xbe\xcd\xf4\x33\xd3\xda\x88\x2d\x87\xb0\xfa\xae\xaa\xcd\xa0\x00\xad\xe8\x6d\xcd\xc7\x52\xf2\xbf\x54\xeb\xdd\xe1\xf2\x4e\x49\x11\xcc\xab\x05\x5e\x93\x16\x03\x59\x1b\x3c\x5b\x9a\x4c\xee\xa7\x0f\x99\xde\x23\xee\xb1\x5c\x9f\xdc\x31\x97\x24\xe9\x8c\xd0\x61\x45\x8a\x9a\x13\xb7\x7a\x23\x0c\xaa\xc8\x39\x8a\xf0\xcb\xce\xad\x81\xad\x70\xca\xd1\x55\xf1\x28\xa1\x72\xb3\x34\x87\xb6\x8f\xcd\x43\xb9\xf8\xcc\xc3\x03\xb9\xc8\xf5\x98\x91\x8b\x6a\x7d\xa5\x13\x6c\x0b\xfa\x74\x40\x56\xd0\x17\x3d\x6b\xec\x74\x18\x30\x40\xef\x1d\x6d\x99\x10\xb8\x9d\xb6\x97\xe5\xc4\x7c\x53\xe0\x02\xbd\x7e\x4d\x25\x49\xf1\x83\x4c\xad\xe5\x39\x32\x64\xc9\xff\x34\x19\xb2\x18\xda\x65\xc8\xba\xf0\x72\x19\xb2\xb8\xfb\xfd\x65\xc8\x72\xbd\xac\x3e\xfc\xf1\x96\xe9\x8f\xe1\xad\x97\x8c\xe7\xfd\x09\x17\x4f\xfc\xff\x83\x7d\x0b\xdf\x70\x1e\xef\x74\x98\xf7\x40\x4e\xb7\x5f\xc7\x31\xf8\xcd\x8c\xe9\x2f\x9a\xfd\x3a\x59\x33\x31\x67\x59\x9c\x27\xa9\x7c\xe2\x8e\x84\x45\xff\x96\x1a\x37\xb0\xcf\x4e\xd5\xb8\xea\x50\x42\xe5\x99\xb3\x98\xed\xeb\x5a\xa8\xc7\x7e\xfe\x27\xa6\x25\xd6\x69\x1a\x64\x31\xb4\x6b\x90\x75\xe1\xe5\x1a\x64\x71\xf7\xff\xf9\xdc\xb4\xec\x1f\x83\xc7\x4f\xba\x16\x58\xa8\x3d\xd0\xbe\xa2\x21\xf0\x77\x10\x39\x49\xb3\x21\x16\x00\x00"
 
-func oracleQuerytypeGoTplBytes() ([]byte, error) {
+func postgresServerGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_oracleQuerytypeGoTpl,
-		"oracle.querytype.go.tpl",
+		_postgresServerGoTpl,
+		"postgres.server.go.tpl",
 	)
 }
 
-func oracleQuerytypeGoTpl() (*asset, error) {
-	bytes, err := oracleQuerytypeGoTplBytes()
+func postgresServerGoTpl() (*asset, error) {
+	bytes, err := postgresServerGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "oracle.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.server.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _oracleTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x51\x73\xa3\x36\x10\x7e\x86\x5f\xb1\xc7\x74\xe6\xec\xd4\x07\xd3\x57\xcf\xf8\xe1\x7a\xe1\xda\x4c\x53\xe7\x26\x71\xda\x7b\x8b\x65\x6b\x39\xab\x01\xc9\x91\x84\x2f\x1e\x86\xff\xde\x91\x04\x18\x0c\x97\x70\xbd\x3e\x04\x02\x48\x9f\xbe\x5d\xed\xf7\x69\x5d\x14\xef\xe0\x27\xb5\x13\x52\xc3\x7c\x01\x13\xfb\x1f\x27\x19\x42\xb8\x34\xd7\x00\xa5\x0c\x20\x90\xa8\x02\x08\xd4\x53\xaa\xb4\x79\xa4\x9b\x00\x82\xcf\x37\xd7\xe2\x4b\x30\x85\x77\x65\xe9\x5b\x14\x4d\x36\x29\x3a\x94\xed\x0e\x33\x02\xe1\x5d\x75\x5f\x99\x2f\xee\x6a\x50\x4f\x73\x58\x02\xe1\x07\x91\x65\xc8\xb5\x7d\x17\x45\x50\x14\xa7\x57\xd5\x28\x4c\x15\xb6\x3f\x5b\x66\x65\x09\x12\xf7\x12\x15\x72\xad\x80\x80\x14\x5f\x21\x91\x22\x83\xb7\x45\x51\x73\x29\xcb\xb7\xa1\x43\xe0\xd4\x80\xe9\xe3\x1e\x3b\x08\x4a\xcb\x7c\xab\xa1\xb0\x83\x24\xe1\x5f\x10\xc2\x8f\x0c\x53\xaa\xcc\x70\xaf\x3d\xb4\x28\x40\xa2\x05\x08\x57\xe6\x5a\x96\xb0\xfe\x47\x09\x3e\x0f\x1c\xe3\xd4\xfc\xe5\x19\xaf\xc6\x07\x6b\x68\x82\x39\xfb\xd4\x66\x54\x27\xe1\x93\x64\x19\x91\xc7\x3f\xf0\x68\xde\xfa\x5e\x14\xc1\xb3\x80\xc4\x52\xf1\xbd\x07\x7c\x66\x4a\xab\x19\x3c\x50\x4c\x51\x23\x85\x8d\x10\xa9\x5f\x14\x35\x4c\xe9\x9b\x87\x3e\x50\x14\x41\x6c\xa7\x02\x45\x8d\x32\x63\x1c\x95\x19\xa6\x77\xdd\x3c\x38\x7c\x60\xdc\x7e\xa1\x44\x93\x0d\x51\x18\xfa\x49\xce\xb7\x30\x31\x09\x75\x25\x52\x96\x70\xd1\x9a\x37\xad\xd0\x27\x53\x4b\x08\x0a\xdf\x93\xa8\x73\xc9\xa1\x3d\x25\xac\xe8\x1b\x96\x51\x04\x97\x55\x08\x7b\x29\x0e\x8c\x1a\x3e\x3c\x11\x32\x23\x9a\x09\x3e\xc4\x6d\x47\x14\x6c\x10\x39\xd4\xb1\xdb\x5d\xfe\x4e\x9e\xd5\xa2\xaf\x11\xad\x96\xa8\x98\x5e\x71\x85\x52\x03\xb3\x37\xd5\x23\xa6\xc5\xf7\xb2\x70\x80\x13\xba\x81\xcf\x37\x97\xbf\x4e\x01\xa5\x14\xd2\x90\x39\x10\x69\x1e\xdc\x0b\xb7\xfd\x2c\x01\x92\x4a\x24\xf4\xe8\x76\x67\x06\x1b\xc2\x52\xdf\x63\xc9\x60\x72\x0d\x4a\x1d\x93\x45\x51\xe1\x12\xbf\x4e\x02\x47\x1e\x12\xc2\x52\xa4\xf3\x2e\xa4\x0a\xa6\xbe\x57\x55\x9b\x7a\x4a\xe1\x29\x47\x79\xf4\xbd\xad\xe0\x4a\x83\x13\x3b\x2c\x60\x7d\xb5\xbc\x8b\x6f\x57\x70\xb5\x5c\xdd\x40\x5b\x5b\x30\x59\xc3\xcf\xbe\xe7\xad\x8b\x02\xb6\x22\x35\xae\xa1\x1a\xf9\xb4\x0a\xb1\x8e\xbf\x1a\x3d\x85\xbf\xde\x5f\xdf\xc7\x77\x67\xd3\x0f\x24\x1d\x37\xfb\x36\x5e\xdd\xdf\x2e\xaf\x96\xbf\xc1\x69\xdd\xce\x84\x0f\x22\x35\xec\xa2\x8b\x94\x28\xed\x52\x7e\x45\x2f\x22\x17\xc0\x7c\xff\xb8\x76\x11\xcb\x9c\xd7\x11\x5b\x2b\x9b\xb8\x88\x67\x06\xd6\x0a\xaf\x1b\x50\x95\xf1\x01\x66\x33\xe0\x2c\x9d\x9a\x8a\x52\x33\xbb\x8b\xf3\x05\xd0\x4d\x18\x3f\xe3\xf6\x87\x31\x59\x62\x11\xdf\x2c\xcc\xf3\xd9\x1e\x37\x7b\x27\x51\x4b\x86\x07\x04\x46\x7d\x8f\xd1\x86\x84\x44\x15\x5e\xb7\x72\x30\x19\x0b\xa8\x50\xc3\xde\x71\x82\x47\x3c\x02\xe1\xd4\x55\x0c\xf2\x2d\x5a\x5b\x3c\xd5\x9f\x29\xf0\x3e\x7f\x58\xc0\xd9\x87\xca\x34\x27\x8c\x4e\xcf\x10\xea\x0a\x5e\x80\x96\x39\xfa\x8d\x34\x39\x4b\x4f\xc6\xc6\x11\x26\xe3\x33\x38\x85\x20\xb0\x0e\x1e\x45\x70\xbf\xa7\x44\x23\xe4\xf6\xd6\x57\x71\xcf\xf3\xbc\x57\x65\xec\x10\x07\x64\xdc\xd3\x71\x25\x64\x2a\x50\xf1\xb7\xba\x2b\x64\xb3\x15\x6f\xbe\x29\xe5\x21\x2d\xbb\x10\x1a\x2d\x1b\x54\xe0\xa2\x82\x35\x5a\xb6\xfb\x57\xaf\xe9\xac\xac\xbd\xda\xa0\xd7\x8d\x5d\x2d\x23\xf2\xd1\x98\xaf\x90\x0e\x9a\x09\xde\x59\xb2\x65\x20\x3d\x07\xb9\xff\x74\xf9\x7e\x15\x77\xcd\xe3\x2e\x5e\x81\xd3\x74\xc7\x40\x2c\x44\xb3\xbd\xc1\x0c\x82\x6f\x9b\x81\xb7\x86\xbf\x7f\x8f\x6f\xe3\x57\x8c\x60\x01\x73\x37\x60\x2b\x72\xae\x5f\xf4\x98\x2a\x96\x96\x35\xfc\xb0\x37\x8c\x10\x8b\x49\xe3\x83\x53\xed\xff\xe1\x1c\x23\x57\x1c\xd0\xfd\x1d\x39\x20\x28\x72\x18\x90\x49\xef\xb0\x7b\x5d\x26\x06\x6d\x48\x24\xe7\x95\xd8\xf4\x10\xed\x4a\xec\x8c\x68\x04\xd7\x14\xdc\xd0\xa8\xe6\x74\xb5\xa7\x9a\x69\x8e\x4c\xdf\xd8\x75\x01\xa5\x89\xc6\xcc\xb6\x8c\x22\x63\xda\xd4\x3f\xcd\xd1\x44\x97\x92\xed\x23\x88\xa4\xea\xb9\x40\xe8\x1d\x4a\xd0\x3b\xc2\xdb\x4e\xd8\x6a\xb9\x4e\xad\x4c\x25\xb5\x7e\xce\xfe\x7b\xa3\x32\xba\x45\x18\x74\x96\x17\x8d\xa5\x65\xae\xf5\xb6\xf7\xdd\xe2\x45\xb3\x18\x40\x78\xa1\x7b\xb8\x8c\xaf\xe3\x55\x0c\x1f\x6f\x6f\xfe\xec\x1a\xc0\x48\xe9\xfe\x32\xe2\xb8\x1e\x51\xee\x2f\xe9\x6b\xc4\xf4\xd1\xc7\x66\xdd\x41\x7a\xc3\xf9\x1b\x3e\xe3\x5a\x3f\x08\xfc\x7f\x03\x00\x00\xff\xff\x1b\x84\xd0\x48\x91\x0d\x00\x00"
+var _postgresTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x5b\x77\xdb\x36\x12\x7e\x96\x7e\x05\xca\xe3\x4d\xa8\x5a\x55\x92\x87\x7d\xd8\x74\xbd\xe7\xe4\xe2\xb4\xd9\xa6\x76\x6a\x3b\x6d\xce\xc9\xc9\x49\x68\x0a\xb2\x59\x53\xa4\x4c\x52\xbe\xac\xeb\xff\xbe\x73\x01\x41\x80\x04\x25\x4a\xb2\xd3\xec\xe5\xc1\xb2\x44\x02\x83\xc1\x60\x30\xf3\xcd\x60\xc8\x9b\x9b\xef\xc4\x56\x7e\x9a\x66\x85\x78\xba\x23\x7c\xfa\x96\x04\x53\x29\x46\x7b\xf8\xe9\xc9\x2c\xf3\x84\x97\xc9\x1c\x3e\x13\xf8\xcb\xcf\xe3\xbc\xc0\x4b\xe3\x63\xf8\x78\xbf\xff\x26\x3d\xc1\x3b\xe9\xa5\x37\x10\xdf\xdd\xde\xf6\x6f\x90\x5e\x11\x1c\xc7\x92\xe9\x85\xa7\x72\x1a\x88\xd1\xa1\xfa\x7f\x84\x77\xf8\x13\xe9\x1b\x7d\x80\xb0\xd1\xad\xfc\xb1\xbc\x63\x34\x11\xa3\x17\xe9\x74\x2a\x93\x82\xae\x3d\x7a\x24\x6e\x6e\xaa\x4b\xaa\x95\x8c\x73\x69\xde\xa6\xc9\xdd\xde\x8a\x4c\xce\x60\x6e\xd0\x30\x17\x81\xc8\xd2\x4b\x31\xc9\xd2\xa9\x78\x08\x4d\xd4\x24\x6e\x6f\x1f\x8e\x98\x42\x32\x46\x62\xc5\xf5\x4c\x5a\x14\x40\x1a\xf3\xb0\x10\x37\xd4\x28\x0b\x92\x13\x60\xfa\x55\x24\xe3\x71\x8e\xcd\x7b\x66\x53\xf8\x9e\x49\x22\x30\x3a\xc2\xcf\xdb\x5b\xb8\x72\x19\x15\xa7\x8a\x48\x11\x9c\xe4\x62\x84\x2d\x3f\x63\x37\xf8\x82\xff\x79\x60\xa1\xe7\x15\xe3\xdf\x7c\x9a\x28\xaa\x26\x73\xa5\x3c\xde\x66\x32\x4e\x03\xe6\xa0\xdf\x83\x9e\xf0\x3b\x28\xe4\x18\x67\x98\x0f\x45\x2e\x0b\x71\x7c\x2d\x8a\x53\x29\xde\x40\x33\x83\xc5\x6f\xc5\x64\x9e\x84\x79\xbf\x77\x20\x63\x73\x96\xf8\x13\x79\xc9\xcf\xa2\x19\x71\x09\xac\xb9\x07\x8e\xa6\x41\x76\xfd\x93\xbc\xd6\x43\x5f\xa5\x62\x42\xe2\xe8\xf7\x3e\xc9\xab\x28\x2f\x80\x81\x4f\x63\x19\x4b\xe4\xe7\x38\x4d\xe3\xbe\x9e\x63\xbf\x65\x06\xf6\x9a\x21\x2f\xa7\x29\xca\x17\x27\x80\x33\xd2\xd3\x2b\x52\x58\x45\x53\xe2\x30\xcb\x08\x96\x76\x92\x66\x32\x3a\x49\xc4\x99\xbc\xce\x47\x8d\x25\x44\x82\xae\x55\x34\x79\xb0\xd6\xf1\x5b\xfc\x71\x20\x27\xb8\x88\xfa\xa2\x62\x92\x96\x7e\xf1\x2a\x59\x3f\x70\x72\x47\x30\x8f\x90\x5a\x0b\xdc\x7a\xb9\x48\x27\x0d\x15\x0c\xd3\x24\x2f\x84\xdf\xae\x65\x5b\x25\x27\x30\xae\xc9\xec\x0e\xb2\x35\xcb\xa2\xa4\x98\x08\xef\x2f\xe7\xde\x12\x15\x1a\x94\x4b\x70\x22\x13\x99\x45\xa1\x5e\x81\xab\xf4\x30\x0c\x12\x91\xc3\x47\x4e\x3b\x05\x28\xa6\xb4\x04\xc6\x68\xa3\x3e\xea\x8f\xf0\x91\x1f\x36\x2a\xa5\xb8\x54\x83\x81\xa2\xe3\x23\x85\xab\xf4\x20\xbd\x1c\x08\x30\x31\x69\x06\xa2\xef\xc1\x17\xdc\xfd\x70\x6b\x44\x6d\xa0\x1f\xa9\x0e\x0b\xa5\x9c\xaf\x4f\x93\x11\xde\x03\x4f\x8d\x31\x40\xba\xfd\x1e\xf0\x8c\x04\xbe\xd9\x11\x49\x14\x23\xb9\x1e\x6c\xb6\x79\x96\xe0\xd5\x7e\x6f\xa1\x8e\xe2\x86\x20\xdd\x94\x49\x28\x59\x9a\x25\xf7\x23\xa5\xb4\x20\x47\x50\x11\x69\x2d\x5d\x39\x00\x8c\xe7\x58\xd4\xd2\x54\x09\x6e\xc5\xea\x4a\xa6\x15\x96\x17\xbf\xf3\xea\xd6\x24\x28\xa2\xd2\x12\xa5\x93\x0e\xd2\x84\x1f\x30\xa7\xd3\x20\x27\x41\x69\x19\x79\x7a\x74\x0f\x9a\xbd\xdf\xd7\x5b\x4c\x5f\xf7\x07\xa8\xf3\x51\x72\x82\x92\x52\xf3\xa8\x29\x8a\x56\xbf\x3e\xcf\x88\x75\x26\x6f\xcc\x27\x2f\x27\x64\x70\xf6\x30\x57\x1a\x0d\xbb\x3d\x4a\x78\x19\x45\x9a\x8d\x65\xb6\xc1\xa4\x14\x03\xb5\x29\xa9\xab\x30\xa1\x0f\x1f\x1b\x53\x2a\x2f\xdd\x88\x6a\xe3\x6c\x45\x43\xb1\x35\x41\x4d\xab\xb6\x10\x0f\xb9\x15\xc1\xd7\xa1\xd0\xa4\x9b\xdb\x6a\x6b\x52\xfe\x56\x8d\xc0\xa7\x88\x52\x40\x95\x66\xad\x28\xaa\x19\x77\x44\xfb\x54\x8a\x6d\x03\x31\x35\xd8\xa8\x09\xac\x71\x7f\x1d\xd1\xf9\x97\xa7\x32\x93\x6c\xd9\xc5\x68\x70\x57\x22\xfc\x35\x88\xe7\xd2\x96\xdb\x05\x5f\x72\x0a\x8e\xc7\x27\x15\x2b\x45\xbe\xa9\x92\x31\x07\x35\x91\xf1\x45\x92\x13\xec\x0f\x99\x4d\x82\x50\xde\xdc\x5a\xc2\x32\xae\xb3\xc4\x1c\xa6\x4b\xf1\x62\xe9\x4c\x4a\x1d\xab\x29\xcf\xca\x0b\x4d\xeb\xba\x60\xc2\xc2\x8f\xe4\x10\xe9\x41\x2f\x34\xd1\xca\x86\xa0\x8d\x1e\x6c\xa2\x4a\x8a\x99\xba\x06\xa9\xcb\x1b\x0b\xc4\x61\xcb\xb5\x70\x88\xdd\x05\xc8\x14\x36\x0a\x81\x52\x24\x88\x78\x54\xe6\x05\xfc\x8b\xe0\x2f\x54\x58\x94\xbd\x16\x40\x0d\xf0\xec\xa6\x46\xe5\x74\x09\xf0\x82\xda\x6b\xf8\x1f\x64\x0a\x4e\x28\x88\x63\x7d\x11\x14\x3c\xa1\x3b\x60\x92\x91\x94\x9c\xce\x8a\xeb\xa1\x08\x40\x02\x48\xa4\xcb\x3a\xe1\x8d\x6b\x11\x64\x92\xd6\x24\x81\x11\x71\x41\xac\xf5\x68\xf7\x92\xc4\xa4\x4f\x0c\x94\x5b\x71\x00\x62\xa0\x2f\x43\x5b\xbe\x43\xf6\xa1\x03\x94\x3f\xac\x63\x2c\x13\xea\x37\x10\x3b\x3b\xe2\xb1\xe9\x0a\x11\xc3\xc1\x1d\x7b\x11\x00\xcb\x0d\xd7\x59\x2f\x73\xc1\x86\xe4\x04\x7b\xe8\x14\xb9\x07\x2c\xd9\x34\x38\x93\x7e\xc9\xfa\xb0\xe2\x0a\x7c\x35\x2e\x96\xd1\xc4\x9a\x8a\xd9\x0e\x80\x9b\x00\x93\x13\x12\x2c\x20\x0b\x44\xf2\xc0\x19\xe5\x00\x9c\xc3\x53\xb8\x75\x83\x0e\xdb\x05\x8a\x7a\x61\x90\xd3\xba\xb4\x40\xa3\xa7\xd0\x84\xb9\xfd\x10\x7d\x1c\x0a\xe4\x09\xbe\x34\x01\x93\xaf\x24\x46\xc8\x69\x50\x9a\x37\x5c\x51\xde\x2d\xe5\x22\x8e\x14\x14\xd3\x30\xa0\x07\xf3\x9c\x04\xf3\xb8\xa0\x91\xd4\x12\x78\x1e\xc9\x6a\x28\x26\xd3\x62\xb4\x8b\xcb\x36\xf1\x3d\xd6\x48\x31\x09\xa2\x58\x8e\x9f\x8a\x79\x72\x06\x11\x55\x52\x82\x42\x60\x02\x64\x00\xe2\x00\xf9\xf6\x0c\xdc\xc1\x92\xcd\x47\xff\x04\x55\xf4\x69\x22\x43\x01\x2d\xbd\x01\x4f\x66\xa8\x80\x49\x9f\x77\x77\x0d\xf8\x80\x46\xef\x32\xb2\x19\x03\x14\xcf\xa6\x51\x02\xab\x16\x35\x8c\xac\x50\xf0\x07\x0c\x0e\xde\x19\x07\x00\x0a\x40\xac\x1d\x6c\x0a\x53\x07\x13\x81\x20\xdf\x46\x19\x0d\x74\xa5\x8c\xe1\x4b\x15\x16\xcc\xb2\xf4\x22\x1a\x23\x3f\x09\x68\xc0\x34\x28\xa2\x34\x71\xf1\x06\x06\x4b\x1c\x4b\xd8\xa6\x65\x3c\x41\xd1\xdb\x8a\x7c\xaa\x41\x97\x31\xaa\x86\x50\x9c\xbe\x4e\x72\x09\x37\x22\xfa\x97\x37\x18\x53\x36\x61\x05\x2e\x98\x20\xb6\x08\x8b\xab\x59\x90\x05\x53\xb8\x3c\x3e\x16\xef\xf7\x5f\x3e\x07\xd3\x34\x83\x41\x46\xa3\xd1\xfb\xfd\xfd\x19\x0a\xc3\x00\xcd\xa8\x6f\x57\x69\x4a\x97\x73\xad\x81\x57\xa0\x12\x18\xd3\x50\x0c\xac\x76\xad\xb2\x9b\x23\x1e\x0a\x6c\x64\x3d\xa8\x16\xde\xeb\xbd\xc3\xdd\x83\x23\x8f\xc8\x5c\x04\x19\x01\x6a\x1a\x89\x71\x32\x2c\x41\x10\x67\x32\x18\x5f\xb3\x5a\x0c\xc5\x71\x80\xdb\x1e\xae\x3b\x31\xb3\x0d\xc2\xd3\x2c\x1f\xed\xc9\x4b\xdf\x63\xa9\x69\x6d\xb7\x48\xe6\xde\xc0\x00\xeb\x30\xc5\xd1\x0b\xb8\x0b\x82\x7f\x56\xbc\x62\xdf\xf4\x6e\x36\x36\x7f\x9b\x18\x3e\x98\x8f\xa3\x42\x14\x11\xec\x04\xb0\x43\xe0\xff\xc0\x6c\xe0\xaf\xd1\x5e\x7a\xe9\x73\x64\x43\xe1\x76\x9d\x66\x19\x42\xe9\x09\x34\x02\x28\x20\x46\x38\x04\x36\x39\x25\x3b\x1c\x81\x37\x53\x6e\x72\xb7\x39\x65\x1d\x6f\xc0\x34\x43\x74\x51\xc7\x12\x23\x5a\xa5\x7d\x10\x0c\xa7\x67\x3a\xfc\xd9\x81\xa5\x7f\x4e\xb7\x2d\x8d\x0a\xb2\x13\xd2\xa7\xa1\xb5\x50\x83\xef\x17\x87\x4c\xa5\xe5\x60\x3d\xf9\x39\x48\xe6\x41\xfc\xf6\x8c\x26\x85\x12\x3f\x8f\x4b\x16\xce\xe7\x32\x03\xdf\x68\xe2\xd8\xe9\x1c\x4c\xfc\xb1\x2c\xf7\xf2\x98\xe4\x00\x5d\xc6\x32\x8c\xab\x34\x12\xe6\x3a\x58\xe9\xc4\xeb\xbd\xa3\x7d\xe6\xae\x4c\xfe\xc0\x4d\xff\xb3\xd8\x06\xb6\x2c\xbf\xe5\xf3\xa0\x26\xf6\x54\xad\x06\xe2\xd7\x67\x6f\xde\xed\x1e\xd6\xba\x81\x7c\x17\xf7\x3a\xd8\x3d\x7a\x77\xb0\xf7\x7a\xef\x07\x61\x8d\xc3\xc2\x00\x13\x6b\x75\x52\x19\x95\x79\xc2\xb3\xee\xf7\x28\x09\xe6\xf3\x8c\x48\xbe\x86\xe3\x6c\x8c\x5a\xc9\x9e\xe3\xdd\x1d\x31\x3e\x46\xa5\x18\x1f\x4f\xc0\x37\xfc\x82\x14\x0f\x58\x0b\xac\x95\x5b\x99\xba\x2b\x82\x76\x4d\x68\xfd\x68\x9a\x73\x6a\x9d\x74\xa1\xd4\x01\xcc\xc7\xe4\x12\x1a\x94\x61\xf6\xff\xf5\xe1\xbf\x49\x1f\x12\xdb\x1a\x77\xcc\xa8\x54\x66\x2d\x98\x00\x10\xb1\xad\x9a\x1a\xe4\x2a\x7d\x86\xf7\xba\x98\xb4\x32\x74\x88\x96\x26\xb5\x97\xa5\xb2\xb5\x9b\x7f\x7d\x92\x54\xe6\x76\xa9\xb3\x07\xf4\x16\xcb\x1c\xb0\x4b\x01\xaa\x93\x4c\xe2\x28\x84\x3e\xe8\x1c\x90\x20\x44\x66\x34\x7b\x0c\xb5\x31\x40\xf3\xf7\xf7\xc4\x8b\xfd\xbd\x57\x6f\x5e\xbf\x38\x12\x2f\xf7\xc5\xde\xfe\xd1\x8f\xa0\x77\x80\xe0\xf4\xda\x60\x20\x02\xf4\x33\x24\x78\x19\xe4\x8a\x0d\x39\x36\x41\x45\xb4\x10\x55\x30\xff\xdd\xb1\x85\x8f\x40\xc8\x8c\x29\xd6\xc5\x18\x3c\xf0\x3d\x20\x8d\x68\x11\xd4\x98\xc0\xf6\x96\xc3\xff\x0c\xc4\x11\xdd\x1f\xe4\xd8\x8c\xf4\x9d\x63\x8e\x68\x29\xe8\xa8\xd6\x8d\xa3\x1c\xa7\x5b\xc1\x13\x88\x19\x78\x92\x34\xd1\xbb\xeb\x6b\xf6\x24\xee\xed\xfd\x45\x1d\x4c\x74\xbf\x1e\x26\xda\xc8\xc5\x44\x0e\x1f\xb3\xb3\xa3\xf2\x51\xb3\x93\x2b\xb8\x01\x9f\x15\xd8\x00\xae\xb4\xa7\xc1\xe8\x79\x0f\x8f\x10\x78\xf7\x63\xf2\x8b\x0f\x84\x2a\xbb\xdb\x50\x2e\x95\xa5\x68\x77\x67\x2e\x25\x6c\xfa\xb2\xa6\xfd\x59\xc1\x99\x61\xc3\x61\x07\x97\x16\x35\x7c\x5a\xb6\xa2\x4f\x3b\x37\xfc\x1a\x1e\x94\xa9\x33\x5c\xda\xf2\x1e\x0c\x86\x17\x50\x85\xb1\x63\x11\x64\x98\x3f\x9b\xa9\x1c\xda\xef\x75\x27\x88\x49\x91\x78\x9e\x05\x71\xf4\x2f\x69\x9c\x56\x28\x9f\x48\xc7\x70\x0d\x47\x98\xa3\xfb\x9a\xce\xe3\x22\xfa\x0e\x1a\x10\x2d\xde\x91\x30\x5a\x21\xa7\x74\xec\x9a\x82\xa5\x2f\xc4\x34\x85\x68\xe1\xfd\xfe\xf3\xa0\x08\x4f\x0f\x71\x04\x22\x28\x83\xf0\x54\xb9\xb9\x05\x4c\xb4\x39\x36\x22\xf1\xe1\xa3\xe9\x11\xef\x28\x92\xf6\x54\x08\x0d\xbf\x6d\x6e\x06\xcb\x5c\xdd\x02\xd7\x86\xb9\xae\x4f\xbc\xf2\x99\x76\xe7\x3a\xef\x95\x95\x6a\xae\x3c\x60\xe6\xf4\x80\x6b\x45\xdb\x9c\x55\xba\x17\xff\xd7\x71\x52\x0b\xdd\x64\xcf\x9e\x6e\x27\x67\x66\x65\xe1\x16\x7a\xca\x8d\xa9\x77\x76\x97\xf9\x2a\x4b\xac\x8e\x42\x3b\xf8\xd5\xac\xdd\xaf\x5a\x10\xbd\xcc\x1d\x22\x0f\x98\x62\xc5\xd1\x06\xe2\x1f\x2a\x3f\x9c\xe0\x68\xfa\x32\xf3\x90\xc0\x5d\x73\x4b\x12\xc9\x04\xc4\x62\x5c\x24\xba\x6c\x7c\x8f\xe7\x11\xc8\x74\x16\x07\xa1\xc4\xe3\x79\x4c\x8d\x63\xae\x1c\xcd\x0c\x34\x20\x4f\xd9\x4c\x0a\x27\x38\x16\x36\x69\xcb\x06\x3f\x86\x36\xb8\x83\x81\x37\x23\xb9\x8b\xbd\x54\x6e\x78\x81\x30\x3f\x3c\x4d\x3e\x32\xd7\x64\xdd\xca\x29\xe2\x70\xfa\x98\xdb\x95\xdb\x50\x1c\xed\x88\x00\xa0\x46\x32\xa6\x0e\xcb\x1d\x61\xb5\x10\x55\xc5\xc9\xdd\x51\x2b\x33\xca\xbd\x99\x43\x8a\x8f\x87\xd5\xc4\xbe\xa3\xb9\xa2\x80\x48\x42\xbf\x63\x73\xba\xf4\x3d\x7c\xff\x7b\xd5\x0e\x7e\x6e\x6f\xb3\x74\x80\xa6\xe6\x6e\x46\xac\x25\xc5\x29\x99\xd3\x93\x14\x3d\x81\x12\x78\x8f\xc6\xc7\x85\xe4\x34\xb9\xe7\x7b\x62\xdb\x4e\x42\xcf\x54\x02\x1a\xae\x7b\x03\x8f\x94\xa3\x5d\xce\xac\x36\xab\x66\x91\x7a\xec\xe2\x70\x5a\x5d\xf0\x5d\x47\x80\x67\x20\xbc\xcf\xf5\x49\xe1\x8c\xd5\xbc\x14\xcf\x06\x16\xab\x81\x31\x14\x2d\x78\x17\x14\xd7\xa7\x61\xb9\x8b\x4d\xbc\xb5\x7b\x25\xc3\x56\xac\x65\xf4\x6e\x02\x94\xfa\x6e\x56\xe2\xb3\xc1\x49\x07\x13\x53\xed\x0a\xb7\x1f\x51\x48\xa6\x5c\xbb\x52\x8f\xbb\xac\x96\x3b\xcf\xf3\xa7\xae\x98\x6a\xbb\x32\xe2\xee\xbc\xcc\xe7\xce\x65\x26\x58\x7d\xd7\xeb\x6c\x88\x9a\x6d\xab\xb9\xf0\x11\xb2\xf0\x58\x69\xc0\xf7\x22\x82\xbd\x9e\x88\x07\x0f\xc4\x39\xa0\x80\xab\xc2\x87\xfd\x1e\x95\xfb\x9d\xa3\x80\xf3\xae\x78\xdd\x7b\x40\x6a\x13\x7d\xd4\x86\xc0\xc1\x74\xef\x7c\xf4\x22\x4e\x73\xe9\x53\x03\x7b\x0e\x6c\x38\x14\x11\x97\x9e\xd9\xbd\x75\x54\x79\x8e\x08\xdf\xef\xe0\xd7\xb0\x4b\x44\x2d\xba\xa2\xa0\x69\x94\x13\x38\xe5\x96\x74\xe4\x54\xc9\x56\x81\x22\xcb\xaf\xb7\xe3\xfa\x7c\xc5\x5d\x67\x7a\xf7\x65\x21\xc0\x22\xe7\xee\x90\x31\x31\x4a\x30\x62\x87\x07\x4d\x9e\x7e\xb4\x4e\x0c\xad\x03\xc1\x44\x0a\xbf\x5a\x7a\x82\xe9\xf5\x4a\x05\x7f\x4e\x88\x29\xe2\x04\xd8\x08\xe0\xad\xa7\x71\x2c\x83\x29\xc1\x2d\x9a\x59\xb1\xc6\x81\x61\xaf\xf4\x04\xbf\x02\x34\x00\x88\x5d\x61\xb0\x47\x8f\x88\xe0\x81\x3a\xa2\x87\x45\x3f\x2c\x82\x58\x42\x64\xc7\x87\xf0\x65\x58\x87\xd9\xaf\xf0\x14\x45\x8a\xb5\x44\xfa\xd0\x0f\x16\x32\x94\x2a\x3b\x46\x84\xb0\x6a\x0f\xf3\x63\x16\x4e\x5b\x7a\x02\xc7\xf3\x59\xe3\x04\xce\x11\x38\x2c\xcd\x8f\xf1\x60\xce\xcc\xd8\xbb\xb7\x2f\x9f\x1d\xed\xb2\x98\x1b\xa9\x31\x15\x40\x8c\x53\x99\x27\x0f\x0b\x3b\x80\x40\xcd\xfa\xa6\xf5\x1c\xce\xb5\x29\x78\xed\xf4\xa6\x40\xaa\x00\x78\x15\x59\xb5\x0b\xaa\x31\x59\xdc\xe6\x68\xce\x13\xd2\xae\xa3\xc1\x76\x3b\xc3\x23\xdb\x72\x25\x41\x78\xd6\x90\x26\x8c\x56\x5d\x39\x8c\x6e\xa6\x9d\xac\xa5\xeb\x7a\xd4\xd5\x62\x67\xc1\xc1\x29\xcf\x56\x65\x60\x51\x01\x99\x05\x2a\xa1\xe5\xa8\xc1\x99\x71\xe2\xc5\x6b\xb8\xb6\xc3\xdd\x23\xa7\x7b\xb3\x37\x5d\x7d\xb7\x59\xbe\x0e\x62\x7e\x61\x53\x40\x2f\xd7\x9d\x00\xf4\xb9\xe0\x8d\x87\xae\x04\x6b\x15\xe0\x8a\x9a\x54\x79\x45\xfc\xf6\xe3\xee\xc1\xae\xe9\x21\x49\x14\x3c\x48\xbd\xe2\x8b\x32\x25\xc2\x13\xcf\xf6\x5e\xc2\xa7\x7f\x22\x0b\x82\x99\x61\x3a\x47\x3d\x6f\xe1\x68\x40\xe2\xa7\xb1\x15\x37\x61\x0a\x1b\x74\x24\xfc\x60\x3c\xee\x4e\xc4\xc7\x70\xa0\xc6\xd0\xc0\x9c\xae\xdb\xdf\x9f\xc8\xd4\xac\x7a\x59\xea\xe6\x2d\xdf\xd8\xc9\x40\x0a\x55\x1d\x62\xba\xd4\x9a\xdc\xb4\x4a\xaa\xc3\xd5\x9a\x39\xb4\xd5\x96\x02\x52\xb3\x45\xad\x76\x8e\x1d\xf2\xa6\x69\xbd\xaf\x75\x6a\xeb\x94\x01\xb7\xbb\x99\x0d\xf3\x8b\x66\x82\x31\xca\x31\xa6\x8a\xa5\x61\x46\x0c\xaf\xa5\x40\x89\x15\xb7\x75\xc5\x79\x06\xc6\xb0\x8d\x9e\x7d\x12\xd6\xc5\xe2\xb1\xe7\xc7\x4b\xe1\xc6\x0f\x78\xa8\x62\x3b\x30\x14\x55\xe6\x90\x16\xbf\x56\x72\x57\x01\x83\xb2\x3c\xb1\xc4\x07\x69\x12\xf3\x23\x07\x65\x7d\x5d\xa4\xaa\xeb\xfc\x1a\x72\x80\x8e\x9c\x46\xc1\x8a\xf7\x20\x29\xf2\x81\xa3\xf6\xb3\x0e\x2f\xe8\xa1\x86\x42\xd9\xea\x69\x99\x89\xe4\xd2\x51\xa2\x06\x24\xe8\x49\x00\x52\x9e\x91\x51\x72\x5f\x41\x0a\xf5\x48\x84\x4e\x5f\x62\xdd\x1e\x7a\x43\x7e\x0c\x40\x03\x8a\xaf\x01\xc2\x84\x0b\x31\x4c\x59\xd6\xdb\x02\x65\xac\x92\xc2\x7b\x42\x36\x65\xe5\xf1\xfd\x00\x9c\xf0\x8b\x22\x9c\xf0\xde\x20\x0e\x82\xf0\xb4\x2a\x94\xaf\x86\x75\x54\x70\x9a\x10\xfe\xae\x41\x52\xb8\x2a\x4a\xe2\x5c\x1f\xc2\x86\x30\x0e\xe6\x39\x05\xf9\xb2\x58\x52\xf4\xb9\x2c\xcf\xa7\xdb\x6e\x03\x4f\x04\x07\x5c\x5e\x5e\x3c\xb1\xf3\x7f\xae\xda\x50\xab\x38\xd4\x59\x1d\xaa\x22\x20\xd0\x04\x5f\x57\x3d\xdb\x0e\x6f\x6b\xa0\x52\xfa\x2a\xef\xd6\xa5\x98\x54\x99\x87\x88\x81\x86\xea\x88\x92\xe1\x84\x99\x81\xfc\xa8\x96\x94\x93\xcc\x87\x47\x9f\x7e\x90\xe9\xf4\x55\x96\x4e\x7f\xfb\xe9\x39\xa2\xc4\x7a\x06\x4e\xe7\xec\x08\x63\x6e\x8b\xcf\x83\xcf\xe5\x68\x46\x96\x71\xd9\x38\xcb\x08\x6b\x92\x3a\xd5\xd8\x96\xb8\x04\x17\x81\xfa\xa3\x36\x7e\xa9\x3d\xde\xc8\x2b\x25\x36\x32\x5c\xb1\x7e\x0c\xa0\xa2\x6b\x56\xc5\xea\x73\x37\xa3\x1a\xb6\xb6\x8b\x5a\xab\x61\xab\x98\x57\xab\x24\x29\x4b\xa5\x94\xfc\xd3\x99\x30\xed\xa2\x63\x95\xe6\x34\x1f\x77\xd0\xd4\xb5\x7c\xe8\xe7\x70\x4d\xe9\xeb\xfd\xd1\x14\xb7\x61\x80\x4c\x63\xdf\x3c\xe4\x70\xa3\x9e\x0e\x5c\x5a\x28\xec\x1e\x58\x76\xa1\x3c\x9b\xff\x5a\xf0\x65\x27\x0d\x17\x04\x55\x0a\xef\x5b\xd9\x3f\xd8\x07\x55\x1a\xfa\x73\x97\xb8\x47\x47\x0d\x1c\xff\x34\xf2\x89\x4a\x66\x2a\xd6\x59\x29\x0b\xdc\xbe\x2e\x19\x96\x6b\x7f\x81\x0c\x71\x78\x2a\xc3\x33\xf2\x41\x01\x83\x56\x15\xc2\x26\xc3\x2a\x0f\x85\x20\xf7\xd9\x64\x42\x4f\x45\xf8\xc0\x58\x37\xfa\xea\x94\xa8\xe1\xa2\xea\x50\x58\x39\xbb\x24\xcc\xe8\xcc\xb7\x5c\x0f\x0e\xa3\x3b\xa8\xca\xf6\x76\xbf\x6e\xed\x54\x72\xfd\xbe\x24\xd7\x6b\xea\xe6\xe6\xc0\x3c\xac\x21\x73\x20\x7a\x18\x5c\x48\x91\xc3\x47\x87\x12\xf2\xe5\x19\x2c\xa4\xb6\x4e\xfe\xaa\x9e\xc9\xd1\x95\xfb\xa6\x68\xac\x16\x2d\xb3\xc4\x41\x94\x8c\x39\x15\xe9\xe8\xda\x92\xed\xac\xba\x2a\xd1\xbc\x9b\x51\x86\x75\x06\x10\x21\xcd\xa6\x98\xef\x06\xb9\x73\x0e\x17\xd9\x36\x9f\x39\x2d\xd1\xf7\xde\xfe\xd1\xee\x53\xf1\x36\xcd\x8b\x93\x4c\x1e\xfe\xf2\x46\xfc\x6d\xf4\xd7\x6d\x0a\x3c\x3a\xa5\xff\xd6\x2c\xc0\x5f\x0f\x24\x77\x2a\xc1\x6f\x43\xc7\xce\xfa\x81\x85\x55\xf8\x6b\x17\x06\xac\x58\x16\xe0\xac\x0b\x70\x15\x06\x2c\x3f\xf2\xbf\xd7\x13\xff\x0d\x89\xb7\x7a\xad\xb6\xbc\xe0\xea\xe7\x5e\xac\xec\x0b\xcf\xbd\xfc\x66\x3e\x70\x71\x3f\xf2\x7c\x78\x9b\x01\x12\xfb\xbe\xd5\xb2\x60\xcd\x01\xcc\x84\xc3\x1a\x76\x79\x15\xea\xeb\x1e\x8a\x3a\xb7\x85\xae\xd8\x6a\x1c\x8e\x90\xc6\xcb\x73\xc6\x86\xc6\x63\x53\x1c\x3d\x30\x3a\xa4\x7a\xac\xf9\x9a\x35\xc6\x2a\x9b\x12\x8d\x1b\x39\x95\x28\xd1\x09\x15\xe1\xcd\xce\xe0\xe3\x64\x1e\x64\x63\x6f\x20\xac\xe4\xca\x8d\xb3\x18\xcb\x3c\x8a\xa9\x67\x59\x54\x0a\x85\x0e\x87\x2e\x4f\x53\x44\xc7\x40\xcd\x3c\xbc\x8d\xa8\x71\x34\xce\x17\xe5\x52\xb0\x89\x31\x73\xb2\xbe\xd5\x6e\xfb\x01\x79\x2d\x77\x9a\xd8\x27\xc3\x5b\x65\xcd\xd4\xc0\x64\xbb\x1b\xef\x20\xc0\xe1\xb1\x5d\x9a\xd0\xf3\xf1\xd6\x18\x94\x89\x51\x90\xcd\x95\x12\x59\x20\x93\x36\x7b\x6e\xd3\xb7\x4b\xc4\x6a\xf9\x91\x68\x4c\x96\x1f\x9b\x54\xaa\x50\xbe\xc0\xa3\xe9\x07\x1c\xd5\x62\xea\xb0\xa7\x5b\xb5\x98\x95\x1d\x69\xa6\x02\xfe\xf8\x83\xae\x44\xe3\xe5\xb9\x81\x7b\x0e\xd2\x4b\x36\xfe\xac\x58\xdc\x73\xea\x91\xf7\x3f\x1c\x88\xcf\xbf\xa2\x40\xdc\xb4\x2c\x31\xd8\x5b\x54\xe6\xa4\x45\xf7\x6a\x5a\x34\x3b\xab\xd4\x08\x37\x1f\x9f\x9a\x27\xfa\xd9\xdc\x85\x82\x5b\x2c\x29\x32\xa9\xf6\x83\xb0\x06\xce\x71\x18\x31\x6b\x4e\x64\x8a\x31\x2f\x0d\xd8\x86\xe3\x17\xbe\xf2\x74\x83\x95\xde\x70\x65\xbf\xd6\x10\xda\x14\x87\x61\x35\x95\x64\x5e\xef\x11\x76\xb1\x83\xec\x28\x31\x86\x1c\x7c\x56\x47\x48\xb6\x4b\x51\x63\x60\x18\x8e\xfd\x49\xfc\x37\x37\xb6\x04\xbe\x40\x71\x56\xf3\xc5\x32\xfa\xc9\x09\xeb\xa9\x38\x55\x22\x61\x56\x3b\x4f\xa3\x02\x13\xc8\x63\x00\x98\xe0\x58\xe3\x00\x42\x73\x70\x77\x0a\xef\xa4\xf4\x9c\x4f\x71\x0a\xb1\x8e\xb1\x7f\xcc\x67\xab\xdc\x2f\xb1\xa1\x17\x68\x51\x55\x10\x42\x91\xd9\x99\x95\xa7\x32\x4c\xee\x0b\x3c\x56\x95\x59\xf5\x8c\x3c\x97\x77\xab\xb8\xdc\x4c\x6e\x9a\xb0\x38\x28\x80\x6b\x0c\x7f\xaf\x31\x63\x86\xcf\x8b\x83\x02\x99\xaf\x3d\x68\x3a\x73\x46\x1b\x48\xbd\xe5\x9d\x5a\xbc\xd7\xe8\x3d\x0a\x86\xa1\xc0\x97\x58\xf0\x9d\x40\x24\xf2\x24\x28\x22\x08\x8f\xcb\xe1\x90\x1a\x00\x6b\x95\x43\x88\x8a\x81\xae\x0c\x5f\xcc\xbf\x1b\x02\xc0\xc5\x93\x94\xae\xa1\x75\x52\xd2\x43\x40\xc8\x1f\x88\x03\x78\xe0\x9b\xc6\x4b\xbb\xee\xae\x88\x5c\x31\xee\x95\x7c\xab\xbd\xbd\xb5\x10\x15\xf4\x6b\xdb\xbc\x2d\xe4\x58\xb0\xe5\x9d\xc6\xca\x71\xd1\xb2\x5e\x80\x35\xcc\x73\x7b\xdc\xf8\x5b\x0d\x53\xb0\x45\x43\xf3\xd3\x2f\x8b\x8a\x09\x58\xde\x76\x09\xc1\x13\x55\x1b\xb0\xec\x81\x17\x5a\x17\x9e\xb5\x73\x01\xc9\x30\xae\xb1\xc1\x3b\xd1\x35\xb6\x7e\xdb\x33\x53\xb4\x1b\xf9\xa4\x08\xc7\xf7\xf8\x05\x03\xfa\x21\x2b\xd8\xbe\x79\x3a\x29\xd4\x51\x12\x7b\xe0\xd2\xa8\x96\xdd\xa0\xd7\x8f\x60\xda\xaa\x9e\x95\x09\x68\x90\x98\xa6\x63\x8e\xe4\x96\xbe\x31\xa5\xdb\x51\xef\x05\xfc\x1d\x5f\x73\xc4\x81\x29\x2a\x18\x88\xf9\xa0\xe3\xac\x66\xa2\x2a\xc8\xf5\x31\x66\xed\x50\x96\xcb\x40\x39\x94\xc0\x1e\x15\x29\x9d\x69\xb6\xed\xc6\x48\xdb\xb7\x7a\xba\xf4\xd1\xa3\xfe\x5d\x1d\xaf\x1a\xa7\xab\xc6\xa2\x2d\x7f\x25\x4b\xc5\x7d\xab\x45\x51\x61\x63\x7d\x6d\x06\x24\x50\xb2\x1c\x20\x11\xc3\xa0\xd4\x05\x52\xbd\x15\x90\xb9\xba\xe3\x17\x3f\x54\xc3\x2d\x3d\x97\x75\x3f\x92\xe9\x3c\x95\xd5\x55\x67\x8b\x9e\xc9\xd4\x6f\x87\x71\x9e\xb4\x96\x99\x2b\xf7\x41\xab\x83\x84\x79\xec\xa9\xb6\x4c\xcb\x13\x89\xd6\x8a\xd5\x0a\x25\x3a\xbf\x06\xe1\x6e\x2d\xae\x63\x1b\x54\xd9\xa7\x76\x63\x3b\xea\x68\x5a\x17\xd7\x69\x3d\x59\x58\x80\xf5\x64\x41\x65\x55\xc3\x2a\x5f\xa0\x95\x41\x59\x68\x75\xd7\x89\x33\x56\xf7\xba\xe1\xbe\x58\x5e\x3f\xd4\xa9\x80\x68\xa5\xe2\xa8\xd6\x03\x98\xb5\xce\x5f\xfe\x94\x29\x2c\x7b\xf6\xbe\xbf\xe0\x98\x67\xc9\x29\xcf\x32\xd2\xb5\x13\x1e\xd7\x01\x8f\xfd\x98\xc0\x1a\x19\xc0\xaf\x52\xa6\xf5\x87\xba\x70\x07\xa2\xa2\x97\xe6\x86\xd3\x14\x58\x84\x5f\xbe\x18\xa8\xd7\x64\xa2\xbe\xd3\xab\x44\xf3\x45\xbd\xb9\xb6\x78\xc6\x7b\x26\x9d\x6a\xdb\x6d\xaa\xdb\xdb\xee\xc7\xd2\xf8\x00\xcb\x32\x99\xf6\xf9\x55\x27\x7b\xd9\x84\x3e\xad\xa0\xc6\x78\xaf\x93\x29\xbf\x26\x8c\x68\xbe\xba\x49\xbc\x03\x95\xaa\x60\x90\x0e\x01\xf8\x07\x7b\xfc\xce\xef\x77\x5a\xe3\x60\xc7\x75\x7a\xd5\x00\x01\x8e\x13\xac\xc6\xab\x40\x0d\x60\x07\xdc\x75\x17\xc0\x57\x81\x86\x5a\x5f\x18\x58\x4d\xe9\x8b\xbc\xb5\xca\x2b\x07\x74\x41\x97\x97\xbb\x6f\x76\x37\x81\x2e\x1b\x23\x97\x2f\x0b\x5c\xee\x18\xb7\xb0\xf4\xc4\xab\x83\xfd\x9f\x1b\xe0\xc5\x8d\x34\x96\x80\x0c\x17\xbc\x70\xd6\xd9\xac\xfc\x76\x83\x7b\xaf\xa5\xbe\x5b\xb8\xf0\xa5\xb9\xff\x2f\x47\x0a\x5f\x9b\x38\x5d\x20\xc1\x86\x03\x6d\xee\xfd\x8e\x3c\xb2\xdb\x21\xf7\xff\x0d\x2a\x68\x66\x11\xd5\x5f\x00\x00"
 
-func oracleTypeGoTplBytes() ([]byte, error) {
+func postgresTypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_oracleTypeGoTpl,
-		"oracle.type.go.tpl",
+		_postgresTypeGoTpl,
+		"postgres.type.go.tpl",
 	)
 }
 
-func oracleTypeGoTpl() (*asset, error) {
-	bytes, err := oracleTypeGoTplBytes()
+func postgresTypeGoTpl() (*asset, error) {
+	bytes, err := postgresTypeGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "oracle.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresEnumGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\x15\x85\x58\x21\xf1\xd2\x4b\x0f\x81\x3d\x95\x1e\x9b\x43\xd3\xe6\x52\x7a\xd0\x7a\x47\x59\x11\x5b\x6e\x24\x79\x93\x60\xf4\xef\x65\x24\x99\x95\xd3\x4d\x4b\x0a\x7b\x59\xc4\x68\xfc\xe6\xcd\x7b\x4f\xec\x34\x5d\xc2\x7b\xff\xfc\x0b\xe1\x6a\x0d\xcd\xb5\xec\x11\x2e\x43\x60\xb1\xec\x76\x83\xf5\x54\xaf\xe3\xc9\xd0\x65\xea\xe5\x68\xc6\xfe\x56\x76\x1c\xb8\xc7\x27\xcf\x81\x6f\x46\xc5\x81\x0f\xf7\x1c\xb8\xb3\x2d\x17\x07\x14\x8b\x7b\xb4\x0e\x09\xda\xc5\x21\x5f\x53\xe1\xd3\x60\x9c\x4f\x55\xea\x5d\xad\x60\x9a\x32\x7c\x08\xa0\x1d\xf8\x1d\xc2\xd9\x34\x41\xf3\xd9\x8c\x7d\xfc\x89\xf4\x42\x38\x03\x1a\x0f\xb1\x55\xd9\xa1\x07\xd7\xee\xb0\x97\xa9\xf9\x26\x9d\xa9\xad\x61\xb1\xa5\x84\x1d\xb5\xf1\x1f\x3e\x32\xd6\xd2\x70\xa8\x23\x43\x2b\xcd\x1d\x42\x73\x2b\xbb\x11\x1d\x84\xc0\xaa\xc4\x45\xab\x17\xe4\x43\xa0\x01\x99\x44\x81\x3a\x4d\x80\x9d\xfb\xb3\x58\xb4\xa2\xd9\xbe\xdc\xea\x56\x76\x71\xa9\x38\x37\x6e\x55\x7c\xdd\xb0\xea\x34\x0c\xd6\xe5\x94\x7a\xe6\x11\xbd\x98\x89\x08\x96\xdb\xc9\x16\xc1\xc8\x99\x1b\x6f\xb5\xb9\x03\x8b\x7e\xb4\x26\xed\xe0\x52\x69\x1f\x3f\x1a\x54\xac\x2d\x16\x50\xa3\x69\x81\x26\xe4\x1c\x85\x50\xde\x8b\x8c\x59\x8b\x19\x69\x62\xd5\x5e\x5a\xc8\xc9\xca\x55\xc6\x2a\xf7\xa8\x7d\xbb\x83\x25\xd0\x2b\xc6\xb5\xd2\xe1\x69\xac\xbb\x62\x55\x35\x53\x5b\x03\x3f\x66\x20\x2f\x75\xab\x02\x63\x55\xd2\x6b\x5e\x89\x85\xa8\xe5\x17\x69\xdd\x4e\x76\xdf\xf0\xc9\x43\x9f\xce\x6e\x19\x7d\xe3\x07\xa0\x67\xf5\x6f\x0d\x0b\xac\x5a\x40\xfd\xe3\xe7\xe6\xd9\xe3\x05\xa0\xb5\x83\x15\xa4\x68\x66\x90\x2e\x16\x40\xcd\xac\xbf\xb8\x00\xa3\x67\x72\xdf\x4d\x5f\xd0\x1b\xcd\x51\x82\xf1\xcd\xbd\x4a\xf0\x7c\xc1\x70\x01\x58\xd3\x47\x99\x8c\x48\x2c\x89\x64\x76\x38\x39\x1e\x7b\x44\xf5\x57\x87\x8f\xcb\x4f\x16\x9d\x2f\xa8\xac\x4f\x93\x05\x76\x38\xb1\x6a\x8b\x4a\x8e\x9d\xa7\xe1\xb3\xdd\xb4\x97\x6b\xae\xf1\xb1\xe6\xda\xec\x65\xa7\xb7\xa5\x7c\x5c\x2c\xc2\x71\xd0\x3e\x2d\xe2\xa4\xd7\x4e\x69\xcc\xaf\xec\xa1\x5b\x6d\xad\xde\xa3\x4d\x22\x58\x4a\x07\x5a\x25\x5b\x04\x45\xea\xbd\xe5\xc5\x45\x04\xca\x49\x89\x78\x24\x2d\x47\x63\x52\xa6\xe4\xa6\x95\xe6\x05\xd1\xad\xf4\x72\x23\x1d\xae\xdc\x43\xd7\xd0\xbd\x79\x33\xd7\x65\x70\x08\xa3\x76\xb6\x3d\x80\x4c\xa1\xc8\xcc\x66\x54\x17\x30\xdc\xd3\x1f\x8a\xb3\x6d\x93\xa3\x2f\x58\xa5\x15\xbc\x1b\xee\xa9\x05\x00\xfe\xcb\x91\xc5\xfa\xcb\xfc\x6e\x46\x25\x48\x83\xdf\x01\x00\x00\xff\xff\x33\x36\x2f\x65\x36\x07\x00\x00"
+var _postgresTypeProtoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xab\xae\x56\x28\x28\xca\x2f\xc9\x57\xd0\x53\xa8\xad\xe5\x02\x00\x3e\x2d\x42\x14\x0e\x00\x00\x00"
 
-func postgresEnumGoTplBytes() ([]byte, error) {
+func postgresTypeProtoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresEnumGoTpl,
-		"postgres.enum.go.tpl",
+		_postgresTypeProtoTpl,
+		"postgres.type.proto.tpl",
 	)
 }
 
-func postgresEnumGoTpl() (*asset, error) {
-	bytes, err := postgresEnumGoTplBytes()
+func postgresTypeProtoTpl() (*asset, error) {
+	bytes, err := postgresTypeProtoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.enum.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.type.proto.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _postgresWhereGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x59\xdd\x93\xda\x36\x10\x7f\xb6\xff\x0a\xd5\x93\xb9\xd8\x0d\xf1\xe5\x39\x2d\x37\x73\x1f\x34\xbd\x86\x42\x7b\x77\x99\xa4\x93\xc9\x04\x83\x05\x78\xce\x58\x20\xcb\x1c\x94\xe1\x7f\xef\xee\x4a\x06\x83\xcd\xc5\x1c\x0f\x7d\xc0\x5f\x5a\xed\xfe\xf6\x53\x5a\xb1\x5a\xbd\x65\xaf\xd2\xb1\x90\x8a\xbd\x6f\x32\x97\x9e\x92\x60\xc2\x99\xdf\xc1\xab\xc3\xa5\x74\x98\x93\xce\xe2\x54\xe1\x43\xd8\x87\xcb\x0c\x7e\x92\xa7\x70\xfd\xd2\x6d\x8b\x11\xdc\x05\xfe\xa6\x0a\x3f\x0d\x44\x8c\xb7\x90\xa7\x0a\x6e\x4f\x63\x2e\x39\xdc\x03\x39\x4a\x1d\x8f\xbd\x5d\xaf\xed\x15\x4a\x54\x41\x3f\xe6\x5a\xe2\x60\xcc\x27\x01\xf3\xef\xcd\xfd\x01\x47\xf4\x15\x11\x14\xe6\x00\x88\xc2\xb4\xfc\xa5\xce\xc4\x71\x20\x43\xa3\x1e\x3c\x3d\xf2\xe5\x30\xe2\x71\x98\x32\xbf\x40\x34\xca\x72\x22\x7a\xda\xa5\x38\x3f\x67\xab\x95\x31\xc9\x7a\x7d\x2d\x12\x18\xea\x67\x11\x52\xa8\x31\x67\x03\xf8\x10\xa9\x48\x24\x29\x13\x89\xf9\x12\x67\x13\x7c\x1d\xb2\xd7\x30\xd3\xe8\xbb\x5e\xbf\x66\xd3\x20\x4d\x79\x88\x1c\x95\x40\xa6\xd3\x38\x93\x41\x1c\xfd\xcb\x37\xec\x3f\xa3\xcd\x7c\xf6\x29\xe5\x45\xa1\xfa\xab\xad\x96\x53\x5e\xc6\x02\xce\xc9\x06\x6a\xb5\xb6\xf7\x90\xd2\xa4\x03\x48\x35\x90\xe7\x51\x30\x37\xe2\x8d\x2a\x9e\x3e\x7c\x70\xa3\x24\xe4\x0b\xe6\xff\xa6\x4d\xf5\xce\xcb\x29\x5a\x33\x77\xee\x79\xbe\x3d\x0f\x64\x19\xcc\x3e\x76\x82\xfc\x00\xd0\xba\x77\x37\xad\x3b\x76\xf5\x0f\x53\x5c\x4e\xc8\x72\x08\x38\x8e\x52\xc5\x86\x59\x32\xa0\x2f\x85\xc9\x8d\x5c\x81\xa7\x48\x8d\xd9\x97\x6e\x57\x86\x5c\xfa\x36\x28\x08\x13\x5c\xf2\xa9\x0c\x92\x11\xdf\xe0\x03\x37\x5a\xe8\x8a\x9c\x01\x4d\xb8\x5a\x16\x58\x5e\xa6\x03\x96\x73\xba\x5a\xb2\x26\x8a\x03\x47\x6a\x96\xaf\x98\x0f\x24\xec\x0d\x73\xd8\xe5\xfd\xb5\xf3\x23\x5e\x37\x1c\x98\xd5\xe0\x75\xd3\x42\x66\x88\x96\x27\x21\x62\xf4\xc8\x20\x0b\x51\xe0\x95\x33\x09\xc0\x7c\xaa\x6c\xa9\x60\x30\xe0\x53\x05\x96\xe8\x2f\xcb\x26\xdb\x73\x9e\x76\x4a\x25\xf7\x26\x9b\x04\xd3\xaf\x1b\xc8\xdf\xfa\x42\xc4\xab\x97\xda\xf1\x3d\x63\x10\x92\x10\x3b\x35\xcc\xf4\xde\x90\x16\x8c\xb0\xae\x96\x8b\x1f\xa3\x21\x4b\x04\x78\x38\x4a\x47\x5c\x60\x7e\xe6\x09\x0c\xd6\xa5\xf4\x2d\x5a\x79\x3b\xfa\x08\xc1\x4a\xc3\x98\x40\xf4\xa2\x07\xf7\xec\xd3\x9a\x81\x15\x14\x54\x14\x9d\x2e\x52\x3c\xa5\xec\x69\x2c\x4c\x2a\x5e\x8b\x18\x7f\x90\xd9\x86\x9c\xf1\x59\x16\xc4\x29\x9b\xfb\x36\x1a\x9c\xb9\x45\x6d\x29\xbc\xbd\x5d\xee\xee\x1c\xdf\x25\xa7\x34\xf6\x1f\xf0\xba\x5e\x7b\x18\x28\x53\xcc\x4a\xb6\xb2\x2d\x18\xcc\x64\x02\x3e\xa2\x7c\x21\x8e\xa8\x1a\x46\xbc\xd3\x74\x1a\x38\x1f\x8a\x1f\x14\x54\xe6\xcc\x1d\x0a\x24\xcf\x2e\xe9\xd1\xe1\x47\x2a\x12\x0a\xa0\x44\xc3\x92\x46\x75\x15\x02\x31\x27\x6a\xf4\xeb\x45\x5d\x95\x6e\x93\xe3\x34\x8a\xb0\x18\x73\xac\x1a\xf3\xb4\x9e\x36\xb7\x89\x3b\x87\x92\xef\xfb\x3f\x52\x08\x57\x33\x0c\xa6\x49\xf0\xc8\xdd\xaf\xdf\xa2\x04\x12\x71\x18\x0c\xf8\x0a\x34\x8a\x39\x72\xf1\x3c\xdb\x1a\x0a\xc9\xa2\x06\x9b\x23\xa5\x0e\x65\xe0\x0e\xb3\x69\xfa\xd7\xe8\x9b\x2e\x0a\x7b\x8a\xdb\x16\x28\xfe\xac\xc5\x6e\x3b\x60\x31\x64\x01\x40\x3d\x7b\x93\x14\xe0\x70\x1d\xe4\x4e\x92\x4d\xfa\x1c\x16\xeb\x72\x74\xb7\xd5\xd1\x26\x8c\x79\x8a\xc4\x41\x52\x37\x24\xda\xea\xd4\x88\x00\xf5\xe6\x15\xfe\x6f\x2b\x7e\x02\x7a\xf0\x85\x8e\x6c\x58\xef\x6a\x6b\xc2\x4f\x55\xa5\x79\x40\x97\x0f\xc7\x3b\x62\x24\x79\x00\x61\x76\x94\x2f\x3e\x9c\xea\x8b\x8b\x83\xf8\x8f\xf7\xc5\x8e\x02\x2f\x70\xc7\x87\x93\xdd\x71\xb1\x71\x07\x2d\x35\x31\xa0\xdd\x49\x1c\x15\x4d\x78\x55\xda\x5c\x71\x48\xe5\xe3\x15\xee\xeb\x69\xaa\x9e\x7a\x5a\x88\xab\x4e\xce\x1d\x55\xe1\xaf\xcb\x21\x5a\xfe\x58\x05\x02\x9a\x55\x13\x3f\x89\x38\x11\xfe\x45\x0e\xbf\xda\x3f\xb0\xcb\x8d\x92\x51\x65\x61\x8b\x1e\x8f\xf4\x4f\x91\xb8\x7d\xfb\xb1\x05\xbb\x49\x05\x0a\x24\x35\x4b\x03\xc8\x73\xcd\x0c\xa6\x61\xd5\xd7\x12\xc5\x39\x8d\x5c\xe0\x46\x5d\xbd\xf3\x29\x6c\x71\x08\x75\x47\xa8\x4e\x16\xc7\x15\x3a\xdf\xa6\x34\x70\xac\x53\x3b\x9f\xda\xed\x9a\xcb\x21\x09\x70\xeb\x2b\x76\x7b\x4f\xdc\x9d\xaa\xc5\x3b\xcd\x15\x39\x16\x2f\x5a\xe2\x28\xcc\x5a\xce\x91\xb0\xbb\x0f\x5b\xe8\x7b\xde\x28\x3f\x1a\xdd\x0e\xf5\x4c\x20\x4b\x46\x7c\x5e\xd4\x71\x28\xc5\x64\xbf\x11\x24\x43\x40\xe0\xb0\x00\xac\xa2\x37\xea\x48\x5f\x6a\x98\x0a\x2d\x5b\x04\x85\x13\xba\xec\x06\x96\x4f\x9c\x65\xec\xc7\xa9\xe7\x04\x52\x6c\x10\x12\xd8\xf4\xf8\x85\xed\xb3\x69\x6c\x75\x13\xdb\x4d\xe2\x65\x3d\xcb\xbb\x48\x45\x53\x3d\xf4\x02\x10\x8d\xc4\x34\x90\xc1\x84\xba\x0b\xc3\x74\x18\x60\x8e\xea\x2b\xcc\x09\x0a\xca\x87\xfe\xbe\xf1\x0c\x1c\xdd\x8c\xbf\x08\x8e\x9e\x0a\xed\x7b\x25\x24\x3d\x5a\x1b\xd2\xf9\x39\x22\xb8\xe3\x69\x16\xab\x94\xe8\x04\x76\x27\x79\x43\x89\xf2\x4c\x2f\x84\x36\x07\xf3\xc3\xa6\x0b\x66\xc6\xd1\x24\x52\xbb\x44\x6d\xfc\x84\xcc\x70\x1c\xe6\x0c\x87\x29\x57\x66\x52\xbe\xf3\x3c\x1c\x2e\x18\x8b\x03\xb5\x20\x45\xe0\x5b\xd8\x07\x16\x37\x57\xd5\xf6\xc6\x3e\x49\x5f\xd6\xeb\x6a\xf5\x8b\x14\x0d\x0a\x16\xdc\xcf\x22\xc6\x54\xe9\x6c\xf0\x18\xec\x58\x7f\xde\x69\xa4\xb9\x94\x42\x7a\x98\x26\x68\x9f\x85\x30\xd0\x4d\xa7\x87\x5f\xa2\x04\x4f\x18\x26\x3c\x81\xc6\x6b\x0a\xd5\x0e\x6f\xbb\xea\x78\xcc\x21\x75\x1c\xaf\x74\x12\xc3\x9c\xfb\x56\xbb\x75\xfd\x40\x85\xdb\x12\xb8\x1d\x5e\x88\x2d\xa0\xd4\x45\x98\xd0\xf6\x5a\x60\xc2\x94\xc7\x7c\x80\xf6\x35\x07\x28\xb6\x85\xe7\x49\x0d\xf6\x9d\x50\x52\x03\x77\x56\xc0\xbe\x5a\x7b\xfe\x42\xdc\xd3\x24\x57\x98\x98\x01\x5e\x16\xae\x1b\x40\xff\x53\x93\x25\x51\x4c\x9b\x6e\x53\x01\xe0\x95\x58\xe9\x7d\x36\x48\xdc\xa6\x97\x6d\xd1\x69\x95\xde\x5c\x6b\x94\xa4\x12\x55\x19\xe0\x4e\xa3\x5e\x65\x6a\xe9\x99\xb0\xa1\x0f\xa6\x53\x88\x2f\xd7\x30\x3a\xd0\xf3\x37\xe1\xf7\x06\x07\x13\x35\x26\x0f\x8e\x04\x73\xb0\x6f\x40\xc1\x9e\x43\xed\x8f\xee\x31\x36\x0c\xf1\xad\xd8\x27\xb9\xcf\x67\xa3\x67\x9a\xa8\x1f\xa4\xe0\xff\x0c\xbb\x94\xb1\x07\x60\x83\x33\xfd\x7b\x31\x54\x37\xe0\x67\xc5\xe9\x38\xe0\x19\xf4\x3d\x14\x07\xd4\x21\x51\xa3\x7b\x89\x6b\x6f\x87\xad\x0e\xb6\x59\xcc\x66\x19\x97\x4b\xdb\xd2\x07\x9c\xe8\xf4\x9e\x0e\x56\xd6\x03\x5d\x31\xf6\xe0\xd6\xc3\x17\x08\xa1\xde\x6f\x77\xdd\x3f\x51\x9b\xed\x51\x24\xf0\xa5\x60\x43\x33\xe8\xf8\xc0\x98\x7b\x47\x11\x67\x78\xbe\x01\x9e\xec\xf3\xef\xad\xbb\x16\xf1\xd4\xbb\x85\xd4\xff\x03\x92\x2a\x87\xec\xb0\xcb\xce\x0d\x83\xc5\x27\x0f\x4a\xd0\x08\x2a\xa3\xc9\x43\xc8\x19\x2c\x43\x9b\x0c\x58\x08\x2a\x4b\xd7\x71\x90\xa5\x1c\xe2\xd2\x9c\xaa\x34\x2a\x8f\x75\xea\xe6\x02\x52\x91\x18\xd6\x04\x3f\x3b\xec\xec\x8c\x09\x9f\x2a\x1b\xbb\x30\xfa\x98\x61\xd0\x62\x73\x00\x05\xf2\xd0\x39\x7f\xc9\x68\x12\xc8\xe5\x47\xbe\xdc\x9c\xd5\xe8\x18\xc2\x93\xe4\xf4\xd0\x38\xd7\x35\x7a\x87\x72\x67\x9c\x5c\xd5\x23\x74\x5b\x5b\x12\x0a\x0d\x77\x0f\x5f\xd1\xde\x3d\x1d\xa9\x54\xad\x07\x64\xa8\x52\xb0\x9a\x46\x7c\x3f\x58\x0d\x57\x7c\xd0\x95\x7c\xeb\x15\x99\x25\x79\xbc\xd0\xc1\xb7\xab\x25\x16\xba\x71\x13\xad\xf0\x7d\x41\x12\x24\xa7\x42\xb2\x5b\x70\x57\x30\x80\x0e\x69\x12\x1d\x1e\x36\x84\xfd\x61\x02\x55\x92\xca\x18\x42\x33\x6b\x02\x66\x0c\xae\x08\x0d\x76\x06\x8c\x1a\xac\x24\xae\x9e\x6b\xf3\x14\xda\x7a\x61\x9b\x01\xb0\x50\xf1\x05\x24\x23\x4f\x06\x5c\x9f\x58\x7c\x6f\xe8\x08\xa7\xbf\x04\x20\xf3\x37\x87\x17\xa8\x0b\x4a\x28\x8e\xfa\xdf\x69\x36\x1a\x11\x17\x9d\x5c\x5a\x71\xcb\xa4\x9d\x6c\x5b\xb3\x4d\xfc\x86\xfd\xad\xce\x7f\xa3\x39\x4b\x2a\xbf\x50\x51\x2b\xe4\x43\x88\xd0\x99\x7f\x1d\xc3\x4e\xc2\x35\x4b\x4a\x2c\x82\x10\xc1\xe3\x3a\xff\x8c\x47\x50\xf7\x99\xdf\xe1\x0b\xe5\x7a\x25\x3d\x71\x4a\x91\x9e\x86\xab\xac\x6a\x59\x96\x31\x49\x7e\xaa\x49\x8c\xd0\x20\x6f\x69\x18\x0d\x4f\x96\x1f\x04\x09\x3c\x81\xb5\xf1\x9f\x12\x58\xe0\x8c\x88\xad\x69\x2b\xd7\x35\x13\x38\x33\xff\x1e\xe6\xbb\x38\x55\xdb\xa7\xc2\x40\x65\x0b\x69\xe1\x68\x81\x4d\xcc\x53\x5c\x9d\x15\xe5\x7a\x79\x35\xc8\x25\xb5\xa4\x74\xbd\x5f\x6a\xc6\xd9\xa6\xbc\x9a\x71\xe2\x0f\x44\xb0\xad\xfe\x0f\x28\x59\x5e\xfb\x6a\x1a\x00\x00"
 
-func postgresForeignkeyGoTplBytes() ([]byte, error) {
+func postgresWhereGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresForeignkeyGoTpl,
-		"postgres.foreignkey.go.tpl",
+		_postgresWhereGoTpl,
+		"postgres.where.go.tpl",
 	)
 }
 
-func postgresForeignkeyGoTpl() (*asset, error) {
-	bytes, err := postgresForeignkeyGoTplBytes()
+func postgresWhereGoTpl() (*asset, error) {
+	bytes, err := postgresWhereGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "postgres.where.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresIndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _sqlite3ForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\x6d\x4f\xdb\x48\x10\xfe\x1c\x7e\xc5\xd4\x8a\xc0\xee\x05\xb7\xf7\xb5\x15\x1f\x28\xa4\xf7\x52\x8e\xf4\x80\xde\x21\xa1\xa8\x98\x78\x9c\x58\x6c\xd6\xc9\x7a\x73\x0d\x17\xf1\xdf\x6f\x66\xd6\x76\xec\xc4\x40\xa9\x5a\x9d\x04\xce\xbe\xcc\xce\x3e\xf3\xec\xcc\xb3\xbb\x5a\xed\x43\x37\x9f\x64\xc6\xc2\x9b\x03\xf0\xa5\xa5\xa3\x29\x42\x78\x71\x37\xc3\xf0\x94\x9a\x01\xec\xdf\xdf\xef\xac\xc8\x30\x4d\x60\x6c\xc1\x57\xa8\x21\x7c\x9f\xa2\x8a\xf3\x00\x7e\x96\xd9\x57\xaf\x60\xb5\x02\x31\x87\xfb\x7b\x30\x68\x17\x46\xe7\x60\x27\x28\xe3\x67\x98\x54\xee\x78\x3e\xca\xf3\x6c\x94\x46\x16\x63\xf8\x92\xda\x49\x65\x57\x37\xda\xcb\x79\xc8\x44\x7a\x8c\xd0\x4d\x7b\xd0\x4d\x18\x61\xb1\x2f\xcd\xd3\x24\xe1\xe9\xa6\xd4\xec\xb1\x25\xea\xd8\x8d\x76\x93\xd2\x45\x35\x0a\xfe\x37\xbb\x3a\xca\x14\xff\x2f\xa6\x7a\xd3\x69\x10\x0a\x29\xa8\x72\xfc\xb1\x1c\x38\xa0\xd5\x42\x7f\x3d\xb4\x05\xae\xc4\x24\x00\x09\x11\x83\xfa\x05\x35\x1a\xd9\x27\x31\xd9\x14\x92\xcc\x60\x3a\xd6\x70\x8b\x77\xb0\x27\xae\xdc\xc0\x07\xbc\xab\x35\x4b\x00\xe0\x0f\x4e\xe1\xb8\x7f\xd2\xbf\xe8\x0b\x94\x81\x3e\x46\x85\x16\x85\x2a\x9a\xfa\xf4\xf1\xf8\xb0\x9a\xfa\x34\x8b\x23\x5b\xc0\x48\x16\x7a\x24\x50\x8b\xec\x22\xe0\x2f\x37\xc3\x0b\xea\x84\xb1\xed\xc8\x2e\x67\x91\x89\xa6\xd4\x8d\x6f\xe0\x72\x70\xfc\xae\x07\xd9\xcc\xe6\x10\x86\xe1\xe5\x60\x30\xb3\x69\xa6\x03\xf0\x5f\xb6\xf0\xd9\x03\x34\x26\x33\xe4\xf2\x91\x54\x25\x4e\x3a\x3c\xdb\x35\x98\x14\xa7\xcf\x89\x70\x56\xf5\xd8\xc0\x1d\x5c\xdb\x99\xbd\xbb\xab\xd2\xa8\xb1\xa6\x16\x45\x95\x1d\x45\x38\x91\x19\x4b\x30\xbd\x27\x93\x79\x94\xe9\x7f\x70\x69\x4b\xbe\xc8\xc2\x4f\x75\x8c\xcb\x3a\xd8\x6e\x1a\x34\x73\x94\xc9\x21\x6e\x82\x75\x26\x7e\x45\x04\x15\xf6\x0d\xea\x1b\x58\x37\xe0\x38\xa8\xeb\xa5\x02\xa3\xb9\xbb\xcb\xb9\x4a\x29\x70\xde\x46\xbf\xb0\xaf\x1e\x17\x1c\xf0\xe8\x28\x3d\xf0\xf2\xb9\xca\x2d\x37\xe2\x1b\xfa\xcc\xe9\xdf\x60\x4e\xdf\xcb\xc1\x49\x36\xe6\x5e\xf6\x25\x97\xc1\x84\x7f\x52\x4d\x1f\x0a\x41\xda\x31\x7d\x18\x9d\x17\x54\x9b\x9a\xd6\x4d\x1b\xfc\x7c\xc7\x7d\xcb\x20\x6b\xfb\x63\x62\xa3\x1b\x85\x0e\xc1\x68\x82\xd3\x68\xbd\xfd\xf9\x46\xff\x82\x2d\xdd\xd7\x49\x70\xe9\x85\xb0\x35\x1d\xcd\x95\xeb\x3c\xcb\x15\xcb\xc2\x49\x16\xc5\x9b\x05\x59\xd7\x2f\x45\xf3\x95\x7a\xcd\xd4\xc2\x44\x2a\xfd\x77\x93\xb1\x07\x74\x8c\x19\xda\x7b\xae\x74\x31\xa8\x54\x43\x04\x79\xaa\xc7\x14\xd1\x7c\x81\xe6\xae\x07\x11\xe5\x55\x8e\x56\xa0\x4c\x69\x37\xc0\x68\x34\xe1\x1d\x48\x1c\xcf\x50\x85\x35\xcc\x85\xea\x3c\x11\xd9\x43\x42\xc3\xa0\xe1\x6a\xb8\xa5\x52\x6d\x12\x24\x5a\x43\x52\x23\x6a\xb2\xcc\x32\x19\xce\x2b\x7d\x59\x66\xa9\xa6\x14\x5a\x4c\x51\x93\x08\xcd\x4c\x4a\x3f\x1e\xc3\xf2\xea\xae\x8b\xdb\xf5\xa1\x93\x02\xef\x9c\x74\xf7\xe8\xc2\x13\xb7\x44\xce\x28\x53\x0a\x47\x16\xe2\x34\xb7\xa9\xa6\x06\x49\x78\xce\xd5\x9e\x88\x8c\x4d\xa3\xd9\x15\x8b\x0c\x5a\x72\x56\x2b\x72\xf6\x4d\x2e\x86\x6d\xaa\xb9\x22\xcf\xc4\xb9\xac\xbe\x45\xff\x6a\x48\xa8\x89\xfd\x1e\xbc\xee\x01\x15\xaf\xcf\x9c\x04\xc1\x4e\x87\xf3\xbb\x66\x45\xf1\xa0\x49\xa2\x11\xae\xee\xb7\x4c\xe9\x7e\x81\xcf\x22\x21\x65\x9d\xd3\xc1\xd3\x52\x27\x7e\x42\x72\xc1\x1b\x89\x44\x9a\xeb\x85\x52\x0e\x70\xa9\x2b\x3b\x9d\x0e\xcd\xbc\x68\x38\x08\xb7\x72\x29\xfc\x8b\xf2\x31\x66\x57\x9d\x0e\x69\x15\x11\xb2\x40\x6a\x17\x07\x50\x88\x11\x79\x8a\x79\xef\xba\x9e\xa9\x07\x05\xcd\x6d\x4c\xd8\xb3\x5b\x01\x4c\xbc\x5e\xa5\xf1\xf0\x2d\xf7\x5b\xf6\xe9\x94\x06\x70\x00\x3a\x55\xbc\x5a\x53\x33\x9a\xcd\x68\x77\xd2\x6e\xe1\x40\xdb\x89\x24\xda\x38\x03\x8f\x59\x62\x22\x03\x4f\xd2\xbd\xe3\x58\xad\x56\x70\x4f\xd6\x50\x4d\xb3\x14\x8b\x9a\xd4\xf1\xd1\x12\x3e\xae\x04\x2a\x47\x70\x70\x00\xaf\x05\x5b\x21\xf9\x82\x83\xea\x9b\xd3\x85\xdc\xb8\x1a\xda\xe9\x38\x45\xe3\xa0\xae\x5d\x4e\xc1\x35\xfc\x44\xab\xae\x85\x18\xc5\x52\x98\xaf\x73\xa3\xba\x95\x4a\xab\xf7\x67\x83\x3f\xe4\x40\xeb\xea\xb3\x9e\xfe\xfb\xd7\xfe\x59\x1f\xd6\xae\x6a\xb9\x47\x15\xce\x86\xbf\x9d\x82\x4f\xc6\xe0\xb2\x2b\x0f\x7f\xa7\xfa\x10\x86\x3c\xfa\x0b\x68\xe2\x3a\x70\x6f\xb0\xb5\x8a\x65\x89\x75\x2f\x8d\x32\x7a\x38\x3c\x3d\x16\x76\x68\x26\x96\x19\x3a\x8e\xb8\x5a\x51\xbf\x7b\xaf\x1d\x01\x66\xa1\x4b\x02\x44\xba\x7d\x47\x03\x49\x0a\x71\x57\xdd\x5b\xb4\x2b\x8d\x2f\x8b\x8b\x53\xb2\xfc\xea\xc1\x5a\xa1\xba\x67\x03\x5e\xc0\x29\x19\xdf\x24\x9a\xca\x14\xb9\x2c\xbd\xb6\x8b\x74\x97\x3c\xf6\x60\x6b\x5f\x3e\x44\x76\xf5\x42\x32\xa7\x7e\x80\x34\x2a\xa7\x5c\xab\x22\xd3\x52\x45\x58\x2f\xa2\x0a\xe8\x47\x93\x4e\x23\x73\x47\xcf\x37\x97\xcd\x8d\xd5\xe1\x67\x5c\x92\x70\x70\xc2\x91\x32\xe1\x46\x9d\x48\x2e\x37\xed\xdb\x5e\x0a\x9c\xeb\x0d\x2b\x06\x5b\xbd\x3d\xf8\xd1\xc5\x8f\xcd\x74\x54\xbe\xa4\x72\x79\x96\x31\xf0\x65\xf6\x27\x1f\xc5\xa1\x52\x57\x2d\xdc\x0e\xb7\x98\xfb\x51\x9c\x7d\x9f\x48\xb9\x3b\xaf\x62\x8b\x6f\xd6\xc9\x20\x51\x6e\xe5\xc2\x37\x44\x13\x63\x82\x06\xe6\xe1\x91\xca\x72\xf4\x03\x97\xd2\x7c\x29\x73\x24\x0b\x65\x73\x17\xf0\x3c\x3c\x25\x59\xf3\x03\x71\xb1\x15\x7a\x5b\x1a\x8b\xdd\xe3\x89\xd3\x29\x72\xe5\x8d\xa4\x4a\xcf\x79\xe6\x5c\xd9\x97\x69\x16\x18\x51\x98\x51\xa4\xa9\xc5\x71\x1c\x10\x90\x73\xea\x72\xd4\x09\xf3\xd8\xae\x29\xe5\x5d\xb8\xeb\x95\x40\x83\x42\x0b\xb7\xf9\x68\x10\xe2\xf6\x7c\xc6\xd9\xed\x6e\x1d\x5e\xb9\x05\x43\xed\x1b\xe3\x07\x6f\x1f\x3f\x81\x5a\x7d\x08\xf7\x91\xb5\xfc\xf2\xb0\x99\x5c\x62\xff\xcb\x45\xd7\x34\xdd\x78\xfd\x54\xd7\xd0\x63\xf7\xe1\x53\x1e\x4a\x86\xbf\xe2\xba\x1c\xba\x9b\xa6\x76\xf3\x34\x38\xfb\x0f\x9e\xe5\xdc\xee\x5a\x10\x00\x00"
 
-func postgresIndexGoTplBytes() ([]byte, error) {
+func sqlite3ForeignkeyGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresIndexGoTpl,
-		"postgres.index.go.tpl",
+		_sqlite3ForeignkeyGoTpl,
+		"sqlite3.foreignkey.go.tpl",
 	)
 }
 
-func postgresIndexGoTpl() (*asset, error) {
-	bytes, err := postgresIndexGoTplBytes()
+func sqlite3ForeignkeyGoTpl() (*asset, error) {
+	bytes, err := sqlite3ForeignkeyGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresProcGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6f\x13\x31\x10\x85\xcf\xf6\xaf\x18\x22\x44\x77\xa5\x76\x73\x47\xca\x05\xc8\xad\x6a\x4b\x53\xa1\xde\xa8\xeb\x9d\x4d\x2d\x39\x76\x3a\xf6\x06\x2a\xcb\xff\x1d\x8d\xd7\x6d\x36\x08\x90\x38\xc4\xd1\x7a\xe7\xbd\xef\xcd\xdb\x94\x2e\xe0\xbd\xf3\xf1\x9b\x37\x3d\x7c\x5c\x41\xe3\x10\xba\x1b\xf2\xba\xbb\xc5\x38\x92\xbb\x7b\xd9\x23\x2c\x0e\xde\xf4\x8b\x16\x2e\x72\x96\x45\xb0\x27\xaf\xcb\x74\xd0\x4f\xb8\x53\xd0\x6d\xea\x7f\x51\xf2\x71\xa5\x76\x78\x14\x98\x01\xfe\xe8\x1b\xc9\x6c\xb7\x48\x8b\x32\xb8\x5c\x42\x4a\xd0\xb1\x12\x72\x06\xad\xac\x0d\x10\x9f\x10\x42\xf4\x84\x3d\x30\x14\xfb\x91\x10\xce\x52\xaa\x19\x72\x6e\x58\xc3\xc6\x37\x8a\xd4\x2e\x40\xce\x2d\xbc\x5e\xcd\x59\x39\x9f\x81\x77\xd0\x3f\x76\x72\x18\x9d\x9e\xa3\x9a\xfe\x11\xee\xaf\xbf\x7c\x4a\x09\xb6\x7e\xcf\x36\xd6\x84\x08\x5d\x75\x8c\x34\xe2\x74\xb0\x37\xf3\xcc\x70\xec\x2c\xe7\x94\x80\x30\x32\xa3\xf2\xba\x0a\x3c\x67\x08\x3a\x9e\x41\x22\x4f\x2d\x24\x29\x0e\x8a\x00\xa9\xfc\x3c\x49\x29\x96\x4b\x08\xcf\x16\x9e\x47\xa4\x17\x29\xb4\x77\x21\xf2\x45\x88\x04\x2b\x78\xd8\xac\x2f\xd7\x9f\xef\xe0\xb7\x7d\xb5\xb7\x07\x65\xc3\x5b\xc2\x9c\xdb\x87\xc9\x8a\x46\x57\xad\x6a\xed\xb3\x9c\x13\x9b\x30\xc2\x5f\x13\x4b\x71\x7f\x7d\xe9\xb7\xcd\x14\xe0\x5f\x7d\x0c\xca\x86\x52\x88\x14\xbc\xcd\x8a\x8b\xfd\xca\xe0\x5b\xff\xe3\x7f\xe4\xdd\x46\x2b\xd7\x7c\x20\x8c\xad\x14\x66\x28\xd5\xbc\x5b\x81\x33\x96\xcb\x12\x54\xe2\x4d\x81\x9d\xb1\x27\x99\xaf\x8c\x7d\x2b\x1a\x89\xa4\xc8\x52\xbe\x0a\x08\xe3\x39\x9b\x94\x1a\x70\x62\x9d\x2e\xd7\x4a\xf1\xbd\xe8\xa6\xec\xeb\x9f\xa8\x8f\x6f\xaa\x0b\xbb\x16\x83\xf2\x0d\x65\x9e\x3f\xc8\x5f\x01\x00\x00\xff\xff\x42\xa9\x24\xb4\x3a\x03\x00\x00"
+var _sqlite3IndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\xeb\x6f\xdb\x38\x12\xff\x6c\xff\x15\x3c\x61\x91\x5a\xad\xab\x6d\x80\xc5\x7d\xe8\x9d\x0f\x68\x13\x77\x77\x71\xb9\xa6\x97\x07\xb6\x87\x22\x68\x64\x89\x8a\x85\xc8\xa2\x4c\xca\x89\x0d\xc3\xff\xfb\xce\x0c\xa9\x87\x25\xf9\x95\x38\x69\x16\xb8\x0f\xb6\x25\x3e\x86\xc3\x79\xfe\x86\xf4\x7c\xfe\x96\xfd\xa4\x86\x42\xa6\xec\x7d\x8f\x75\xe8\x29\x76\x47\x9c\x39\x17\xb3\x84\x3b\x9f\xf1\xd1\xe2\x52\x5a\xcc\x52\xe3\x48\xa5\xf8\xe0\x0f\xe0\x6b\x0c\x1f\xc9\x15\x7c\x7f\x3d\x3d\x11\x37\xf0\xeb\xca\x1b\x7c\x15\xf8\x49\x52\x7c\x0c\x62\xf8\xf2\x44\x84\xcf\x3e\x57\xa9\xc5\x9c\x4f\x21\x8f\x7c\x65\xb3\xb7\x8b\x45\x7b\x8e\x6b\xa7\xee\x20\xe2\x7a\x6d\x6f\xc8\x47\x2e\x73\xce\xcd\x2f\x31\x70\x81\xdd\xfa\x1b\x79\x29\x4d\x04\x76\x4a\x73\xb3\x97\x2d\x66\xff\xfc\x33\x9b\xcf\x81\x93\x49\xec\xd1\xf6\x16\x0b\x26\x79\x2a\x43\x7e\xc7\x15\x73\x99\x14\xf7\x2c\x90\x62\xc4\x5e\xc1\x28\xc3\xde\x62\xf1\x8a\xb9\xd8\x89\x13\x0b\xc1\x2c\x16\x0e\x50\x43\x82\xbf\xf2\x98\x4b\x37\xe5\xbe\x9e\x1a\xc6\x3e\x9f\x12\x01\xe7\x77\x7c\xd4\xdf\x66\xce\x2b\x87\x36\x10\x06\x79\xa7\xba\x8c\xc3\xf1\x04\xfb\xda\x01\x70\x55\x65\xaf\x03\xef\x5e\x3a\x4d\x5c\xe9\x8e\xe0\xd5\x1f\xb0\xaf\xa7\xc7\x1f\xa1\xf1\x46\x50\x5b\x14\xaa\x34\x93\x2c\x4b\x25\x10\xa2\xaf\xc5\xa2\xcb\x50\x11\xcc\x71\x9c\xaf\xa7\xa7\x49\x1a\x8a\xd8\x66\x9d\xd7\xd5\x3d\x74\x19\xe8\x57\x48\x9b\xcd\xdb\x2d\x64\x6c\x2a\x04\x8d\x55\xc8\x8f\x69\x09\x63\x50\xfd\x64\xc4\xe3\xb4\xc4\x59\xa3\x8c\x99\x75\xde\x3f\xe9\x1f\x5d\x58\x66\x76\x66\x5d\x20\x65\x50\x54\x75\x6d\xb3\x24\xca\x82\x9a\xbf\xc8\x70\xe4\xca\xd9\xbf\xf9\x8c\xa6\xb7\xbe\xf3\x29\x6c\x4e\xbd\xa7\x1d\x75\x89\x1e\x8f\x7d\x52\x63\x6b\xd1\x6e\xb7\x40\xf4\x8a\x47\xdc\x43\xc9\x83\xa1\x4d\x46\xb1\x6a\xb7\xd0\xe2\xba\xb8\x14\x90\x05\xc3\x98\x02\xa9\xef\x38\x31\x52\xb8\x24\x1a\xa2\x21\x63\xf6\x6e\x18\xcb\x19\x75\xa6\xe2\x9c\x88\x76\xa6\xe2\x04\x96\xd7\xa2\x53\x1d\x14\xa6\xed\x1c\xe9\x65\xec\x76\x0b\xc8\xe3\xec\xbf\xf5\x58\x1c\x46\x28\xbd\x16\xd8\xd1\x44\xc6\xf8\x4a\x84\x0b\x1e\xc7\x11\x03\x05\xcb\x59\xbb\xa5\xbd\x08\x97\xbc\xd6\x82\x62\xd7\xec\x0d\xf2\xae\xe0\xe7\x1a\x5f\x80\xce\xf5\xa7\xb3\xd3\xff\x68\x9e\x32\xc3\x06\xf9\x99\xbe\x3f\x7e\xeb\x9f\xf5\xb1\x13\x26\xa1\xab\x2a\xa2\x9c\x1b\x00\x09\x92\x59\xec\xc3\xe7\x63\x86\x4a\xb8\xd6\x2c\xc8\x49\x9c\xb1\x40\x0e\xdb\xd1\x8c\x00\x19\x78\x58\x61\x46\x8b\x85\x4d\x22\xcf\xe4\x48\x62\xc7\x2d\xf7\xe8\xdd\x81\x2e\x7f\x10\xc4\xcc\xfa\x95\xa7\x56\x61\xa8\x10\x08\xc8\x4c\xbb\xec\xa0\x2c\xd6\x2e\xdb\x7e\xc9\xb7\x5a\x5b\xa5\x05\xfd\x41\xb1\xdc\x7f\x71\x1f\x67\xe2\xbe\xb6\xe6\x76\x0b\x40\x8c\x70\xe3\x0e\xda\x01\x78\x46\xb6\x1c\x99\xc3\xd6\x3a\x35\x8d\x95\xfd\xc1\x98\xf6\x22\x73\xee\x48\x78\xb7\x5e\xe4\x4e\x60\x1b\xd6\x24\xf1\x21\x38\x90\x4b\x34\x84\x9f\x4f\x42\x5e\xd2\x80\x87\xc7\x21\xa4\x1a\x85\xb7\xbc\x4a\xba\x4b\x6c\x84\xf1\x0d\x0b\x53\x36\x89\x53\xd8\x53\x3a\xe4\xb4\x5b\x11\xd0\x63\x2a\xdd\x58\xb9\x1e\x5a\x38\x36\x41\x70\xe9\x84\xe0\x69\x40\x2f\x8c\x21\xce\xfc\x11\xa6\xc3\x8b\xa9\xed\x34\x06\xa6\x9c\xf1\x67\x8c\x50\x46\xf2\xcd\x21\x32\xb3\xbc\x75\x2b\x07\xae\x8e\x03\x5d\xe6\x26\x09\x08\x82\xdc\xba\x0b\x0c\x17\xbb\xb1\x6d\x32\x0c\xad\x4a\x63\x19\x28\x91\x3e\x05\xa3\x6a\xf2\xf0\x79\xca\xe5\x28\x8c\x41\x6b\xa0\x76\xad\xb8\x4c\x91\x3e\x1b\xcc\xaa\xbc\x22\x25\x1d\xd6\x50\xc4\xcb\xda\x35\x72\x6e\x5c\x68\xbf\x42\x1e\x08\x11\xed\x18\xf9\x3b\x89\x0c\xe1\xc7\xd2\xdc\x59\x05\x73\xf6\x36\xa9\xe0\xce\x95\xe4\x5a\xb4\x64\x2d\x2c\x6a\x9f\xf5\xb9\x17\x15\x40\x03\x43\x1e\x46\x6a\x5a\x4e\x07\xb9\x8c\x05\x13\x3a\x0f\x19\x05\x4a\xab\x14\x27\x2d\xa6\xe3\xa3\xc5\x3a\x5b\xc4\x47\xdb\x6e\x8c\x90\xc8\xab\xb8\x65\x28\xa3\x5d\xc3\xe5\x13\x45\xab\x03\x71\xbb\x2e\xe3\x90\x51\xd7\xe3\x93\xb8\x2d\x07\x25\x93\x00\x0d\x64\x39\xe3\x6a\x12\x81\x59\xb8\x92\x33\x21\x7d\x2e\xc1\x58\xef\xc1\xdb\x29\x2a\x80\xa1\x60\x13\xd3\xf6\x00\xae\x02\x4e\x10\x85\xa3\x30\x5d\x1e\x74\x82\x4d\x48\x0c\xfb\x61\x4e\x10\x28\x9e\x9a\x49\xaa\x39\x66\xec\xc5\x8a\x8b\xac\x0c\x96\xfc\xed\xea\x59\x21\x8d\xc0\xdc\xdd\x00\x0c\xd6\xa3\x91\xef\x39\xd2\xe8\x1c\xd4\x40\x10\x28\x39\x87\x1c\xe2\x2f\x08\x30\x5a\x08\xfd\x71\xc5\x6f\x57\xe0\x9d\x5c\x06\xae\xc7\xe7\x8b\x39\x43\x41\x37\xda\xb6\x8e\xc1\x90\xdd\x99\xe1\x1f\x42\x71\x34\xcb\x0c\x07\x64\x8c\xc6\x97\x4b\x6c\x2a\xc8\x18\x8f\x28\x99\x82\x80\xe8\xed\xe3\xac\x0b\x1d\x55\x51\x9a\xae\x6d\x65\x87\xa3\x68\x2d\xd6\xeb\x31\xcb\x62\x07\x07\x4c\x38\x64\xd4\xec\x5f\xec\x1d\xcd\x32\xdd\x20\x9b\xd3\xb3\xe3\xfe\x19\xfb\xf8\x3f\x83\x2c\xab\x80\xd5\x6c\x0d\xd4\x59\x08\x6e\xed\x20\xe3\x8e\x4b\xc3\x97\xfa\x29\xf1\x5c\x13\x9f\x46\xa9\x6f\x7a\x9a\x5d\xcd\x78\x85\xd3\x62\xcc\x35\xb2\x48\xee\x6a\x00\x48\x27\xe2\x71\x51\x7b\x19\x87\x02\xca\x5a\x71\xbd\x2c\x13\xe2\x5b\x37\xa3\x8b\x0f\xda\xa1\xed\xdc\xcc\x56\x80\x48\x88\x0f\x30\x93\x72\xa6\x01\x3f\x06\x76\x63\x20\x32\x86\x51\xf3\xd1\xf9\x0a\x2c\xa9\xfd\xa0\x19\x4e\x02\xb5\x0c\x45\x96\xd6\xdc\x4e\xd7\x6b\xea\x0c\xe3\xb9\xa9\x4e\x35\x3c\xf6\x78\xbb\x15\x08\x89\x4e\x5b\x2d\x60\x00\x2d\xdd\x20\x4e\x53\xb4\xcc\x52\xd5\x60\x6a\x15\xd8\x10\x0a\x38\x5b\xd2\xc0\x87\x72\xfc\x6d\x8d\x73\xd3\xae\xe5\x89\x15\x49\x62\xe7\xdd\xb6\x7c\x1e\x80\xdd\x8e\x9d\xa3\x48\x80\xd3\x98\xe8\x14\x09\xd7\x47\xe6\x31\xf0\x6f\xd2\x0d\x0a\x60\xec\x7c\xe6\xd3\xb4\x63\xd7\x36\xbb\xa2\x96\x5b\x5f\xcc\xd5\xaa\xb9\xa5\x72\x8e\x6c\x8c\x14\x01\xf9\x0e\x4b\xbf\x2e\x43\x88\x0e\xa1\x73\x75\x7d\x56\x0e\x96\xc6\x98\xc6\x55\x74\xdf\x20\xaf\xba\xc0\xf4\xe2\x28\x90\xdc\x19\xc8\xd6\x96\x00\xbe\x5d\xd1\x69\x9e\x66\x69\xa8\xce\xb3\x98\x0c\x8f\x04\x20\xed\x2a\x4a\xf4\xb0\x51\x51\xde\x04\x80\xa8\x1a\xa1\x7d\x19\x35\x36\xd4\x09\x26\xa1\x36\x91\xdf\x2f\x36\x84\x20\xfe\xf7\x5f\x1e\x08\x0e\x89\xbb\xe7\xc1\x86\x26\xbd\x1d\x9d\x5e\x7e\xbe\xe8\xbc\xb6\x9f\xa5\x76\x46\x4e\x63\x46\x02\x7a\x29\xc8\x30\x5e\x17\x13\xde\xd5\x41\x61\x5c\xb6\xd5\x8a\x1d\xf5\x5d\x6f\xc8\x3c\x37\x02\xb0\x00\x0c\x12\xd2\xe3\xd8\xb4\xaa\x18\xdd\x60\xb1\x5d\x22\x21\x26\x29\x45\x1e\x2c\x43\x81\xb4\xb6\x7f\x10\xa1\x60\x23\x3e\x12\x72\xe6\xb0\xdf\x53\x3c\x3d\xc3\x12\x54\xa5\x22\x01\x4c\x9a\xa2\xa3\x20\xc1\x20\x94\xb0\x73\xb2\x0b\xa6\xf9\xd7\x35\x55\x00\xbb\xb8\x1f\x86\xc0\x5a\xa8\xf2\x8e\x66\xc4\x89\x7b\x7a\x84\x7f\x80\x1c\x90\x6a\xbd\x2a\xb5\x35\x5b\xab\x70\xa9\xe6\x79\x27\xe7\x29\xb8\xb6\x90\x69\x6b\x2b\xdf\xd9\x02\xfd\xa1\xe5\xd7\x51\x49\x8e\x35\xfe\x22\x98\x70\x15\xee\x7e\x52\xb4\xf8\x7f\xa0\xf8\xb4\x40\xf1\x06\x0f\xce\x43\x4f\x19\xb0\x48\x32\x9f\x0a\x0a\x8c\x25\xbf\x2d\x20\x20\xfa\x7d\x23\xad\xa7\x06\x57\x6b\x71\x55\x22\x85\xc7\x95\x2a\xa0\xd5\x8f\x06\x4f\x4b\x58\x08\x06\x06\xa8\xd1\x06\xe7\xcf\xb2\xf6\x81\x65\xd8\xb3\x75\xae\x5a\x03\x9a\x4a\x78\x49\xaf\x12\xc4\x9d\x2a\x4c\xda\x62\x7a\x39\x23\x8d\x9d\xbe\x94\x1d\xbb\x8c\xad\x96\x81\x96\x91\x0c\x9e\x32\x74\x62\x91\x56\x2f\x4e\x00\xb2\xf0\xb1\xb1\xdd\x46\x3f\xb2\xd9\xa1\x9d\xa1\xf0\x9f\x92\x5b\x54\x40\x93\x94\x75\xf7\x8e\xb7\x61\xde\xa6\x7b\x31\x6f\x22\x95\xc0\x7e\x72\x34\xf8\x8d\xc1\x2c\xe0\x27\xf4\x4b\xf7\x61\xcd\x27\xc3\x5f\xdc\x9b\xe5\x43\xe1\x04\x1b\x44\x80\x09\x72\x24\x20\x78\x12\xc9\xd5\x80\xd2\x55\x74\x38\x53\xb3\xb6\x6e\x7e\xe2\x03\xa9\x14\x21\xa9\xbe\xb0\x32\x67\x16\x24\xe7\x44\x4b\x86\xdd\x72\x08\x9d\x2a\x75\x65\x4a\xe9\x3b\x80\x50\x8e\x34\x0d\x8e\xd5\x10\xa1\x34\x96\xe9\xdd\xe2\x02\x9a\x23\x1c\xa8\x93\x38\x0d\x1f\x82\x8e\xf4\x10\x4c\xdc\x60\x1c\xd9\x0d\xda\xc5\x90\x17\x09\x7e\x69\x84\x9e\x04\x74\x24\xa7\xc3\xaa\x18\x70\x83\x90\x1a\x46\x37\x67\x7c\x14\xdb\x23\x32\xbe\x59\x1d\x13\x3e\x70\x84\x89\x0d\x6c\x46\x67\x38\x3a\x51\x27\x99\x83\xdb\x34\x62\xe7\xc6\xd3\xa8\x55\xa4\x1e\x82\xb0\x4b\x20\x01\xf7\xb9\x1d\x48\xa8\x02\x6c\xbc\x9f\xa0\x6d\xfc\x93\x1d\xd6\x4a\xc8\xac\x2c\x12\x52\x41\x08\xbb\xef\x68\xc3\x65\xa3\x09\x48\x6c\xc0\xd9\x8d\xe4\x2e\x58\x01\x68\xc4\x05\x7c\x69\xd9\x4d\x87\x50\x1b\x20\xfb\x0f\xc1\x24\xd9\xb4\x72\x7a\x6e\x4e\xa8\x20\x1d\x8c\x32\x9d\xa1\xab\x28\x70\xe6\xbd\xa8\x3c\x5d\xd4\xa0\xf6\x8a\xf9\xd4\x01\xa5\x68\x39\x1f\x97\x36\xb9\x3a\xc3\x66\x97\x11\xd7\x15\x09\x56\x3c\xce\x98\xe4\xb2\x5c\xbd\x17\x23\x58\x7c\x68\x14\x06\x40\x0e\x68\x8f\xd3\xa1\x76\xc3\xe5\xbd\xbf\x18\x8d\xb8\xbe\x5f\x61\xed\xb0\xa6\x99\x1c\xd0\x00\x04\xe1\xa9\x37\x24\xd5\x40\x36\x9b\xa6\x52\xdf\xf8\x40\x35\x93\x5f\x04\x21\xbb\x3a\x5e\x85\x18\xb4\x31\xde\x53\xe4\xde\xf1\x74\x0c\x46\x9a\x50\xd4\x2b\xf2\xe8\x0e\x35\xa7\x09\x55\x6f\x0e\x8b\xd3\x91\x07\x1d\xb5\xed\xb0\xcc\x42\xe3\xb0\x82\x51\x6f\x4b\x12\x7a\x00\xae\x6f\xbd\xce\x12\x26\x66\xea\x7d\xec\x62\xbf\x3c\x6c\xbc\x24\xae\x1f\xce\xaf\x3c\x59\x4c\xd6\x1f\x2d\x26\x9b\xce\x16\x51\xd6\x55\x10\x8d\xa1\x1e\x89\x34\x18\xd5\x9e\x4d\x8a\x84\xab\x35\x62\x20\xfb\x87\x28\xfa\x56\x5d\xf4\xaa\xa6\x95\x17\x67\x55\x0f\xdd\xc8\x0f\x34\xac\xa5\x92\x07\x55\x3e\x36\xd5\x66\x72\x83\xa1\x05\xbe\x9d\x33\xc0\x47\x45\xf5\xf8\x1a\x38\xc8\x9b\x8a\x3f\x3b\xec\xcf\x1a\xc6\x99\x08\xb7\x2d\xbb\x5e\x90\x01\xec\xc0\xfb\x0f\xd4\xf9\x13\x9d\xe9\x27\x9b\xea\xd2\x7a\xe9\xb9\x87\x6a\x33\xd9\xb2\xdc\xac\x48\x61\xed\x41\x7d\xb2\x74\x52\x9f\x11\xed\x65\xf5\xe5\x3f\x76\x72\xae\xec\x8c\x1f\xb6\xe9\x4b\x91\xe8\xff\xcd\xe4\xe9\x1e\x4b\xa4\xc1\x24\x04\x24\x82\xed\x94\xe1\x33\x8c\x46\x47\xc4\xd8\xd0\x8c\xfa\x35\xf8\xe6\x31\xf2\x6d\x03\x40\xd2\xe0\x7a\x9e\xef\x0a\xbe\xbf\xbd\xa7\xc6\x2b\x14\x8c\x4f\xa9\x01\xda\xa8\xe9\xed\xe1\x95\x43\x3b\xbd\x2d\x62\x7a\x8b\x16\xeb\xb1\x83\xd0\x5f\xaa\xaa\xf5\xad\x04\xf4\x2d\xfd\x07\xa0\xb8\x8e\x02\x36\x7c\x48\x9d\x29\x1f\xcc\x74\xf9\x97\x55\xc0\xba\x15\xd7\xb5\x8e\xe9\xd1\x2a\x4d\x51\x22\x48\xf5\x00\xb0\x46\x17\x8c\x8e\x65\x1b\x43\xbe\xcc\x54\x98\xf9\x9b\x2b\xfd\xd2\x6c\x2c\xf1\xf4\x6b\xf5\x62\x04\x09\x1a\x46\x1e\x77\x3d\xd2\x35\xf5\x23\x42\x33\x52\xca\x64\x34\xc0\xbf\x30\x64\xdb\xf4\x75\xc5\xc8\x2e\x15\x9d\x2c\x17\x0c\x56\x39\x22\x28\x47\xfb\xc8\xd8\x29\x17\xa9\x03\x1e\x60\xf5\x89\x06\xa0\xb1\xb9\x19\x3b\x14\xe2\x56\x99\x02\x35\xa5\x33\xf4\xfc\x40\xba\x71\x99\x97\x73\x65\x93\xa9\x69\xf3\x9d\xcd\xe5\x97\xe3\x0f\x17\x7d\x2b\x77\x8c\x2d\x2a\x3e\x3d\xa5\x56\x69\x9c\xf7\x2f\xca\x68\x5d\xaf\x77\x0e\xa6\xa0\x99\xa1\x6d\x67\x45\x44\x6f\xa9\x88\x78\xa7\x4d\xed\x8e\x4b\x85\x5b\xe3\x85\x01\xae\x2f\x5f\x46\x60\xab\xe1\x8a\x1a\xe6\x90\x42\x42\xe3\x7d\x8f\x09\x8a\x85\xd9\xdf\xb9\xd1\x84\x67\xf3\x07\x33\x5d\x5f\x2f\x1f\x72\xde\x6d\xbe\x0c\xca\x21\x47\xe5\x9c\xb2\x3f\xe5\xde\xca\x63\xca\x2d\xe8\xee\x72\x11\x34\x15\x88\x07\x3e\x04\x01\xfd\x63\x85\xe2\x51\xfd\x3f\x6f\x25\xaf\x26\xb9\x57\xfe\xfa\xb6\x85\xdb\x8e\x5c\x5d\x2f\x65\xc7\x46\x55\x22\xb4\x1d\xb5\xa5\xf7\x6e\x0e\x43\xe4\xa4\xd8\x8d\xeb\x95\xe7\x92\x6f\x66\x0d\xa9\x10\x4e\x79\xab\x8f\xf2\xef\x75\x32\x7a\x31\x6e\x9e\x71\xb8\xd9\xcb\x8f\xfb\x27\xfd\x1d\xbd\x5c\x4f\x61\x8f\x38\x54\xd0\xf5\xf6\xfe\xfe\xb6\xfc\x00\x07\x7b\x66\xef\xfa\x13\x04\x77\xe3\x68\x7b\x31\x00\x00"
 
-func postgresProcGoTplBytes() ([]byte, error) {
+func sqlite3IndexGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresProcGoTpl,
-		"postgres.proc.go.tpl",
+		_sqlite3IndexGoTpl,
+		"sqlite3.index.go.tpl",
 	)
 }
 
-func postgresProcGoTpl() (*asset, error) {
-	bytes, err := postgresProcGoTplBytes()
+func sqlite3IndexGoTpl() (*asset, error) {
+	bytes, err := sqlite3IndexGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.proc.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresQueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _sqlite3ManytomanyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x9b\x10\xa4\xd2\xe6\xa8\xef\x2d\xf2\x90\x35\x0e\x96\x2e\xb3\x3b\x27\xdb\x02\x14\x45\x22\x4b\x94\xcd\x40\x26\x6d\x8a\x4e\xed\x19\xfe\xdf\x7b\x47\x5a\xb2\x64\xc9\x49\x90\x14\x58\x0a\xec\x21\x0e\x29\x1e\xbf\xfb\xc1\xef\x8e\xc7\xd5\xea\x08\x0e\xf2\xb1\x54\x1a\xde\x1d\x83\x6f\x46\x22\x9a\x30\x08\xaf\x96\x53\x16\xf6\x68\xe8\x31\xa5\x3c\xf0\xf2\x59\x96\x6b\x1a\x24\x43\xfc\x99\xe1\x9f\x62\x39\xfe\x5e\xf7\x2f\xe4\x08\xff\xcb\xa9\xce\xbd\x00\x8e\xd6\x6b\x77\x45\xa8\xaa\x15\x76\xc0\xd2\xe7\x21\x6f\xcc\xac\x2a\x60\xa9\x8e\x86\x19\xb3\x2a\xe2\x31\x9b\x44\x5b\xfc\xcb\x9d\xf9\x15\x49\xda\x5f\x52\x5d\x81\x41\xed\x75\xa4\x59\x66\x27\xcf\xc0\xba\x93\x5c\x34\x6d\xfa\x88\x5f\x6b\x40\xe5\x87\x07\xad\xda\x01\x2b\xcd\x7a\x0e\x5c\xca\x59\x96\xe4\x04\x14\x9e\xd9\x61\x35\x8c\x67\xdb\xd5\x41\x39\x23\x81\xb7\x6f\x61\xb5\x82\xf0\x53\x36\x57\x51\x66\x8e\x6c\xbd\x06\xc5\xf4\x5c\x89\x1c\xf4\x98\xd1\xea\xd4\x2c\xf2\x7f\x77\x0f\x17\x25\xa3\x3c\x97\x31\x8f\x34\x4b\xe0\x2b\xd7\xe3\x62\x47\x58\x13\xd2\x63\x25\xe7\xa3\x31\xbc\xc1\x95\x4a\x00\xd7\xeb\x37\xa1\x9b\xce\x45\x0c\x3e\x2d\x58\x32\xa1\xf8\xcf\xbb\x00\x41\xd3\x44\xda\x11\xeb\xc5\x34\x52\xd1\x04\xa7\xc9\x10\xae\xfb\xa7\xbf\x76\x80\x88\x04\x61\x18\x5e\xf7\xfb\x53\xcd\xa5\x08\xc0\xff\xfc\xc5\x00\xee\x58\xde\x01\x64\xa6\x54\x08\xed\x3a\x14\xa4\x85\x94\x66\x43\x8e\x6b\xc5\x17\x2e\x90\xb4\xf3\x09\x13\x1a\xfc\xa9\x42\xb3\x6b\x39\x13\x7a\x55\x9b\x82\xbd\xe4\x01\xef\xb2\x7b\xd1\xfd\x70\xe5\x19\xe4\xfb\x48\x91\x66\xab\xdd\x75\x1d\x8c\x3f\x9e\x3b\xcc\xe6\x4c\x2d\x49\x2f\xcd\x12\x16\x67\xdb\x9c\xc1\x88\xdc\x5a\x04\xb8\x85\x5f\x5c\xc7\xb9\x25\xdf\x65\x36\xc5\x63\xe5\x0b\xca\xb9\x7c\xab\x7b\x73\xb2\xde\x66\x9f\x95\x3f\x1b\xf4\xff\x00\x13\xe2\x4a\x22\xd0\x31\x17\x02\x1f\xfb\xe7\xbd\x42\xa0\x7a\x3e\x70\x07\x7d\xb3\x50\xaa\xa3\x55\x4b\xc7\x2d\x8d\xbc\x3b\xaf\xca\xaa\xba\xee\x7f\x7e\xeb\x0e\xba\x35\x08\xe3\xaa\xc5\xa8\x00\x78\x70\xd2\x3b\x05\xda\x89\xb2\x3c\xad\xa4\xa6\x4c\xf5\x29\xcb\x98\x66\x46\x9a\xa0\x49\x52\x85\x14\x2b\x5c\x4b\xcc\x5a\x2c\x45\x52\xee\xb1\x20\x4c\x90\xf0\xad\x8d\xb1\x9a\x8b\x22\xc6\xa6\xe6\xf8\x36\xba\x1d\xb2\x4c\x45\x62\xc4\xe0\x80\x77\x30\x89\x4c\x86\x54\x4c\x2b\xcc\x39\xe0\x86\x33\x25\xac\x71\x48\xdc\xb3\x85\x2e\x88\xeb\x73\x91\xb0\x45\x99\x87\x07\x3c\x20\xb8\x8a\x25\x81\x49\x46\xc4\x42\xd5\x0b\xc3\x05\xac\x81\xa4\xaf\x9d\xa0\x2b\x14\x20\xa2\x1c\x1b\x79\xf2\x36\x19\xa6\x02\xc9\x84\xfe\xc6\xda\xdb\xe6\x40\xa4\x46\x26\x03\x3a\x70\x88\x80\x1d\xf8\x2f\x3c\x73\x10\x88\x8c\xfd\xe9\x18\x04\xcf\x28\xa7\x1c\x5b\x43\x68\x6a\x52\xcd\x75\xd6\x85\xff\xa5\xa7\x9f\x14\x9f\x44\x6a\xf9\x3b\x5b\x52\x38\x6c\x2e\x30\x0d\x6c\xc1\x73\xcd\x44\xcc\x5c\x27\x95\x0a\x6e\x8c\x71\xc5\x65\x83\xc7\x8f\x8e\x58\xc7\x28\x7e\xa4\xaa\xb6\x1c\xde\x98\xfd\x39\x06\x0e\x93\x97\x15\x7a\xad\xa5\x76\x98\xe5\x8c\xec\x18\x31\xc1\x14\x8f\xf3\xe2\x2c\x8c\x9d\x84\xbe\x90\x7f\x12\x55\x4e\xb2\xec\x73\xcb\xc1\x7c\x69\xc4\xfd\x75\x47\xdc\xb8\x4b\x2e\xce\x4a\x07\x93\xe1\x96\x4f\xc6\xd5\x06\x9d\x5e\xaf\x4b\x4e\xc2\x52\xa6\x60\x16\x7e\xc8\x64\xce\xfc\xc0\xf2\x26\x93\x51\x42\x7c\x98\x67\x3a\x7f\x42\x62\x11\xaf\x66\x61\x0f\xed\xf3\x83\x26\x85\x68\x6f\xdb\x46\x23\xf7\x10\x85\x1d\xc7\xd9\xb0\xef\x9d\x21\x5f\xc7\x22\x93\x83\x47\x66\x99\x58\x6e\x68\x1e\x47\x02\x47\x36\xbf\x67\x78\xcd\x47\x82\xc2\x6f\x82\xd3\x5e\xd1\x37\x17\x90\x77\xe8\x15\x86\x06\x36\x68\x2d\x51\x6b\x86\xcd\x2a\xa6\xb0\x1c\x43\x34\x9d\xa2\x41\xbe\x21\xfc\x61\xcd\xef\xc0\xc4\x77\x83\x47\x76\x75\x95\xf2\x83\xf7\x4f\xe5\x99\xcd\x30\xb7\x58\x37\x0a\x50\xc8\xc5\x6f\xe8\xf2\x49\x92\x50\x4c\x1b\x8d\x43\xd9\x65\x34\x7a\x8b\xfd\x0d\xc5\x70\x09\x78\x37\x33\xa5\xb9\x18\x41\x04\x4a\x7e\xc5\xb9\x96\x2f\x68\x30\xea\xd6\xed\xeb\x2e\xea\x2c\x69\x6f\x2b\x9a\x1d\x88\xb9\xea\x5f\xd0\x67\xa0\x6d\x9e\x35\x2d\xd8\xdf\x09\x82\x77\xde\xbb\xec\x0e\x1e\xee\x32\x6c\xd0\x1e\x6b\x36\x2c\x10\x9c\xf7\xae\xfa\xad\x4d\x81\x5f\x6b\x43\x36\x74\xad\x15\x85\x0e\x34\xd6\xb6\xfd\x01\xde\xc9\x76\x7f\x00\x7f\x9f\x5c\xfc\xd5\xbd\xdc\x01\xbc\x8f\xb2\xbc\xe2\x67\x63\xd7\xf7\xb8\xd1\x9f\x58\x9c\xea\x15\xad\x15\xb5\xea\xd7\xe3\xb5\x50\xd5\xf5\x6d\x1b\xf3\xd6\x7a\x78\xd3\xd9\x64\x62\xb5\x5e\x77\x17\x2c\x7e\x51\xb9\xfe\x31\x7c\xdf\x14\x11\xaa\x2f\xb6\x7e\x0c\xd8\x44\xde\xb3\x6a\x09\x49\xf8\xe3\x35\x24\x55\x72\xb2\xb7\x86\x98\xd6\x91\x4a\x08\xc7\x94\xa5\x22\x62\xa4\x9f\x5f\x44\x1a\x26\xbe\xae\x3a\x62\xcd\x7b\x4a\x29\x39\xc5\xe7\xc6\x55\xf7\x25\x0f\x16\x8b\x00\xd5\x97\x47\xad\x86\xb4\xbc\x0e\x4c\xb1\x68\x3e\x0e\xd2\x88\xfa\x97\xed\xf3\xc0\x0c\x76\x77\x4c\xf0\xde\xe7\xbb\xa4\xac\xef\xf4\x33\x26\xaa\xc0\x81\xb9\xcf\xbe\xcf\x03\xe1\xc7\x48\xa9\xff\xcb\xc9\xa6\x9c\x7c\x03\x2f\x5b\x7c\x0b\x13\x13\x00\x00"
 
-func postgresQueryGoTplBytes() ([]byte, error) {
+func sqlite3ManytomanyGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresQueryGoTpl,
-		"postgres.query.go.tpl",
+		_sqlite3ManytomanyGoTpl,
+		"sqlite3.manytomany.go.tpl",
 	)
 }
 
-func postgresQueryGoTpl() (*asset, error) {
-	bytes, err := postgresQueryGoTplBytes()
+func sqlite3ManytomanyGoTpl() (*asset, error) {
+	bytes, err := sqlite3ManytomanyGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.query.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.manytomany.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresQuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _sqlite3MapGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x56\x61\x6f\x1a\x39\x10\xfd\x0c\xbf\x62\x6e\x75\x4a\xd8\x1e\xd9\xf6\x73\x24\x4e\x6a\x13\x7a\xd7\xbb\x36\xb4\x25\xd2\x45\x42\xa8\x18\xd6\x80\xd5\x5d\x1b\x6c\x73\x81\x43\xfb\xdf\x3b\x33\x5e\xc0\x01\x54\x45\xd5\x55\x0a\xcb\xda\xf3\x3c\xf3\x66\xe6\x79\xc8\x76\x7b\x05\xbf\xaa\xdc\xc1\x75\x07\x5a\xa5\x58\x2c\x84\x15\x25\x64\x30\x15\x85\x93\x29\x5c\x55\x55\x73\x1b\x20\xc7\x08\x6f\x57\x31\xc0\xcd\x8d\xf5\x8c\xe1\x37\x2d\x4a\x09\xd9\xfd\x66\x21\xb3\x3b\x7a\x4d\xa4\xb5\x09\x24\x6e\x59\x38\x4f\x2f\xf9\x18\x1f\x4b\xfc\x58\xe9\xf0\xf9\xd0\x7b\x6f\x66\x49\x60\x92\x28\x8d\x3b\xc2\xce\xc8\x40\xaf\x8a\x0d\x90\x98\x85\x77\x49\x14\xd2\x8b\x71\x21\x43\xc8\xc9\x5c\x96\x02\xb2\x7e\xfd\xcd\x71\xef\xc9\x1c\x9e\x44\x21\xe6\xba\x2c\xa2\xb3\xbb\xc5\x33\x4e\xbf\x7c\x09\xdb\x2d\x64\x1f\xc4\xe2\xed\x4a\x4f\x38\xb1\xaa\x02\x2b\xbd\x55\xf2\x5f\xe9\xc0\xcf\x25\x58\xf3\xe8\x60\x6a\x4d\x09\x97\x88\xad\x49\x56\xd5\x25\x3c\xce\x8d\x93\xfb\xf3\x4a\x16\x79\xb6\xf3\xa0\x1c\x28\x5d\x7b\xe7\x1a\x54\x55\x1b\xbe\xca\x8d\xcc\x61\xbc\x39\x7b\x24\x8b\xa0\xf0\xa8\xfc\xdc\xac\x3c\x08\x0a\x0e\xc2\x4a\x28\x95\x73\x4a\xcf\x02\x0f\x62\x85\x8d\xcb\x30\x00\xc5\xf8\x43\x6a\x69\x85\x47\xdf\x6c\x55\x3a\x97\x6b\xe6\x9a\xbd\xa3\xd7\xf0\xac\xc3\x5c\x66\xcd\x29\x66\x7a\x26\xeb\x16\x6e\x4d\xfc\x3a\xa8\xa1\xaa\xf2\x31\x3c\xf4\x6e\xdf\xb4\x63\x5a\x83\x21\x2e\xb0\x38\x58\xce\x28\x01\xaa\x2e\xe7\x47\xed\x84\x2c\xcb\x1e\x7a\xbd\x85\x57\x46\xa7\x2c\xaf\xc1\x77\xce\x0c\x5f\x10\x8f\x83\xa8\xc8\x0b\xea\xca\xd8\x14\xb6\xcd\x06\xb5\x76\x6d\x0c\xfb\xa2\xf8\xbb\x1d\xa5\x51\x72\xab\x52\x6a\xff\x34\x87\xb3\x7d\x86\xa4\xdf\x7d\xdf\xbd\xb9\x4f\xd8\x01\x8a\x93\x34\x52\x8a\xaf\xf2\x47\xb8\x15\x52\xb7\x0e\xf5\x48\xd3\x66\xb3\x81\xf5\x5f\xae\xa4\xdd\x80\xf0\x50\x1a\xe7\xb1\x6a\x6f\x84\x9f\xcc\xfb\xea\x3f\x19\xd7\x4e\x50\x37\xbd\x2a\x65\xb3\x31\x35\xf6\xd8\x13\xfc\x0e\xaf\x28\xe5\x86\x26\x7a\x47\x46\xdc\x56\x53\xd0\x88\x79\xe2\x1b\xb7\x11\xde\x89\x37\x71\xab\x42\x4e\x44\x6a\xbc\x52\x45\x0e\x8b\x42\x4c\xe4\xdc\x14\xb9\xb4\x0e\x84\xce\x81\x6e\x21\xf9\xd3\xfb\x32\x0c\x86\x58\x4d\x94\x56\x1b\x34\x45\x22\x40\x64\x53\xda\x4b\x3b\x45\x27\xdb\xaa\x06\x10\x7b\xb5\x93\x05\x65\x86\x60\x2b\xf4\x2c\xce\x76\x70\xad\x87\x81\xa0\xd2\x03\x35\x44\x92\x68\xd3\x7e\xce\xe2\x9a\x19\x9e\x00\xd4\x8e\x10\x6e\x8f\xc0\x9b\x8b\x6b\xf6\xbb\x6f\x47\xc0\xd1\x27\x2a\x66\x27\x8e\xa5\xaf\x87\x75\xce\x78\x3e\x34\x03\x97\x61\x30\x11\xb9\x51\x10\x00\x8c\xe0\x37\x8a\x38\x22\x9d\x9b\x82\xe6\x99\xab\xfb\xcb\x81\xd8\xf1\x0e\xf3\xf6\x73\xef\x03\xc7\xd8\x0f\x93\xc8\xf8\xcf\x9f\xdd\xcf\x5d\x38\xb8\x89\xb4\x73\x63\x0a\x42\xbe\xbb\x83\x16\xa2\x21\x54\xd6\x65\x7f\xa1\x66\x5b\x4a\xb7\x21\xc1\xbf\x14\x0d\xa3\x14\x8f\x63\x53\x43\xfc\xbe\x99\xfa\x5b\x59\x48\x2f\x77\x29\xc3\xeb\xbb\x5b\xae\x08\x5a\x72\xb6\x4c\x0c\x76\x6f\xa7\x4d\xb4\x48\x4d\xb8\x51\x9d\xb9\x5d\xe9\x7d\xe6\x3c\x7a\x5b\x21\xff\x36\x37\x1c\xef\x64\xca\x83\x12\x23\xe2\xfe\x3a\xd4\x94\xe7\x1a\xd6\x67\x70\xaa\xf4\x2d\xd9\xf1\x22\x92\x99\x0e\x64\x08\xc8\xc7\x53\x8d\x97\x09\xc9\x4c\x7c\x72\x98\x17\xd4\x30\x9a\x16\x6d\xb8\x20\x87\x6d\x38\x09\xcc\xea\x25\x67\xbf\x74\x40\xab\x22\xe8\x02\x6f\xdd\xca\x6a\x5a\xf3\x8d\xaf\x5b\x4c\xda\xfa\x12\xb4\x15\x7e\x7e\x62\x79\x31\xdf\x2d\x0b\xe1\xea\x50\xbb\x8f\x56\x95\xc2\x6e\xfe\x96\x9b\x5a\x51\xf1\xe1\xec\x8b\x5c\x2b\x87\x53\xa9\xc3\xbf\x6e\xf5\xd9\x50\xba\xc0\xc2\x0d\x9e\xe0\xcf\x4d\xe6\x5a\x9c\x7b\x10\x73\x65\x3f\xf8\x8b\x4a\x44\x66\x34\x7f\xd5\xc4\x1d\xca\xca\x39\x11\xf3\xb5\xf9\x44\x5d\x79\x5d\x14\x83\xe3\x1a\x0f\x4f\x0a\xf8\xf3\x2b\xf7\x7f\x24\xcc\xeb\xe5\x3e\xc3\x7c\x7c\x10\x07\xe7\x7a\xa2\x8d\x1f\x4f\x2b\x68\xbb\x30\x22\xc7\x29\xed\x56\x85\x77\x75\xa6\xcb\xec\x4e\xae\x7d\x2b\x0d\x47\x8f\x93\x3e\x51\x73\x00\x7d\x4f\x34\x8d\x5a\x28\xd7\xac\x93\x76\xed\x95\x84\x72\x15\x00\x4c\x86\x67\xcc\x44\x68\x7a\x25\xfe\x1d\x24\xd2\xc7\x35\xa5\x3b\xa5\x0a\x9e\x19\x2a\xad\x05\x8e\x00\x0f\xc9\x45\x52\x93\x4c\xeb\x91\x7e\xae\x08\x8d\x65\x76\x53\xe0\x3f\x15\x2d\x06\x9c\xa9\x49\xcd\xe3\xd9\x6d\xbc\x38\xe9\xe3\x81\x79\xd7\x5a\x8e\x13\xc7\x7c\x66\x63\xe2\x5b\x44\x8c\x6a\x0c\xd2\x6a\x13\xb0\x59\x35\xbf\x01\x9b\xa2\xf7\x09\x87\x0a\x00\x00"
 
-func postgresQuerytypeGoTplBytes() ([]byte, error) {
+func sqlite3MapGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresQuerytypeGoTpl,
-		"postgres.querytype.go.tpl",
+		_sqlite3MapGoTpl,
+		"sqlite3.map.go.tpl",
 	)
 }
 
-func postgresQuerytypeGoTpl() (*asset, error) {
-	bytes, err := postgresQuerytypeGoTplBytes()
+func sqlite3MapGoTpl() (*asset, error) {
+	bytes, err := sqlite3MapGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.querytype.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.map.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _postgresTypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x4d\x73\xda\x48\x13\x3e\x4b\xbf\xa2\xa3\x4a\xbd\x81\x37\x8e\xa8\x1c\xf6\xb0\xae\xe2\x90\x35\xca\xae\x6b\x1d\x70\x0c\xec\xe6\x16\x06\xd4\x18\xad\xa5\x19\x3c\x33\xb2\x4d\xa9\xf4\xdf\xb7\x66\x46\x12\xfa\x02\x84\x93\xda\x83\xc1\x68\xba\x7b\x7a\xfa\xe3\xe9\x67\x94\x24\x1f\xe0\xad\xd8\x30\x2e\xe1\x72\x08\x3d\xfd\x1f\x25\x11\x82\x3b\x56\x9f\x0e\x72\xee\x80\xc3\x51\x38\xe0\x88\xc7\x50\x48\xf5\xd3\x5f\x3a\xe0\x7c\x9b\xdc\xb0\x7b\xa7\x0f\x1f\xd2\xd4\xd6\x56\x24\x59\x86\x68\xac\xac\x36\x18\x11\x70\xa7\xd9\xf7\x4c\xad\x98\x4f\x65\x75\xaf\x13\xac\xc1\xbd\x62\x51\x84\x54\xea\x67\x83\x01\x24\xc9\xfe\x51\x26\x85\xa1\xc0\xf2\xb2\xf6\x2c\x4d\x81\xe3\x96\xa3\x40\x2a\x05\x10\xe0\xec\x19\xd6\x9c\x45\xf0\x2e\x49\x72\x5f\xd2\xf4\x9d\x6b\x2c\x50\x5f\x19\x93\xbb\x2d\x56\x2c\x08\xc9\xe3\x95\x84\x44\x0b\x71\x42\xef\x11\xdc\xcf\x01\x86\xbe\x50\xe2\x56\x59\x34\x49\x80\xa3\x36\xe0\xce\xd4\x67\x9a\xc2\xe2\x1f\xc1\xe8\xa5\x63\x3c\x0e\xd5\x5f\x1c\xd1\x4c\xde\x59\x40\x71\x98\xda\x52\xd9\xa3\x3c\x08\xb7\x3c\x88\x08\xdf\xfd\x89\x3b\xf5\xd4\xb6\x06\x03\x78\x61\xb0\xd6\xae\xd8\xd6\x77\x7c\x09\x84\x14\x17\xf0\xdd\xc7\x10\x25\xfa\xb0\x64\x2c\xb4\x93\x24\x37\x93\xda\xea\x47\xd3\xd0\x60\x00\x9e\x56\x05\x1f\x25\xf2\x28\xa0\x28\x94\x98\xdc\x54\xe3\x60\xec\x43\x40\xf5\x8a\x4f\x24\x59\x12\x81\xae\xbd\x8e\xe9\x0a\x7a\x2a\xa0\xa6\x44\xd2\x14\xfe\x5f\xd2\xeb\x67\xd6\x7b\x7d\xed\x10\x24\xb6\xc5\x51\xc6\x9c\x42\x59\xc5\xcd\xdc\x57\x5e\x0e\x06\x30\xca\x8e\xb0\xe5\xec\x29\xf0\x95\x3f\x74\xcd\x78\x44\x64\xc0\x68\x9b\x6f\x1b\x22\x60\x89\x48\x21\x3f\xbb\xce\xf2\x99\x7e\x66\x9b\x9e\x72\x34\xdb\x22\xf3\xf4\x9a\x0a\xe4\x12\x02\xfd\x25\x1a\x8e\x49\x76\xae\x17\xc6\x60\xcf\x5f\xc2\xb7\xc9\xe8\xb7\x3e\x20\xe7\x8c\x2b\x67\x9e\x08\x57\x3f\xcc\x03\x93\xfe\x60\x0d\x24\xe4\x48\xfc\x9d\xc9\xce\x05\x2c\x49\x10\xda\x56\xb0\x6e\x0d\xae\xb2\x92\x9f\x49\x5b\x11\xee\x18\x9f\x7b\x8e\x71\x1e\xd6\x24\x08\xd1\xbf\xac\x9a\x14\x4e\xdf\xb6\xf6\xa5\x63\xba\xf4\x0b\xa1\x31\x09\x6f\x1f\x74\x03\x0c\x06\x20\x1e\xc3\x2c\x02\xf0\x18\x23\xdf\x5d\xc0\xd6\x94\x18\x3c\xe0\x0e\xa2\x58\x48\x58\x62\x9e\x4c\xdf\xb6\x56\x8c\x0a\x09\x06\x2a\x60\x08\x8b\xeb\xf1\xd4\xbb\x9b\xc1\xf5\x78\x36\x81\x72\x67\x42\x6f\x01\xef\x6d\xcb\x5a\x24\x09\xac\x58\xa8\x30\x47\x94\x9a\x2f\x5b\xec\xc3\x5f\x9f\x6e\xe6\xde\xb4\x26\xfd\x44\xc2\x36\xe1\x85\x09\x1d\x8f\xa9\xf1\xd5\xb6\x34\x48\xf5\x8c\x37\x17\x6a\x7f\xdd\x52\xd5\xcd\x8a\x58\xf6\x6d\x4b\x25\x61\x08\xfe\xd2\xfd\xaa\xf4\xef\xd8\x73\x67\x5d\x77\xba\x22\xb4\xf7\xbf\x4a\x6e\x54\xf2\xf7\x0d\x59\xd4\x81\x4e\xa2\xda\xe9\xcd\x10\x68\x10\xd6\x52\xa7\x52\xa2\x3a\x5b\x81\x5e\xa7\x1c\xe4\xb1\x87\xe5\x0e\x04\x3e\xc6\x48\x57\xf8\x93\xf2\xd0\xe2\xfd\x19\x89\x39\xa6\x7d\xe7\xcd\xe6\x77\xe3\xeb\xf1\xef\xb0\xdf\xb7\xa2\x70\xc5\x42\x25\xff\x23\x19\x6d\x8f\xfd\x2b\x53\xdc\x66\xec\xa7\xe7\xdc\xa0\xb9\xc9\x39\x4a\xd3\xa5\x26\x9d\xad\x3d\x3f\x04\xc9\x63\xb4\x0b\x30\xa3\x41\xb8\x1f\x05\x14\xa1\xb7\x3f\x4e\x14\x87\x32\x38\x72\x26\xb3\xd0\x07\xc7\xc9\x8b\x6e\xbe\xf5\x89\x44\x88\xf5\x57\x13\xfd\x1a\xb3\xc2\x3a\x09\x7f\xc6\x62\x0b\xfc\x35\xf0\x2f\x03\x40\x9f\xa1\xa0\xef\x64\x15\x00\x55\x20\xdf\x1c\x84\xc0\x36\x0c\x34\x47\x28\x30\x50\x59\x05\xca\x32\xb3\x0a\x03\x15\x08\x16\x7b\x9a\x11\x50\xde\xad\x75\x46\x74\xdd\x2d\x22\xfc\x41\x0d\x2d\xc6\x8d\xe9\x80\xd1\xfd\x96\x26\x53\xf7\x12\x7a\x10\x22\x6d\xe6\x03\xfa\xf0\x51\xe7\xc3\xca\x61\x40\x77\x01\x3c\x07\x72\x03\x2b\x16\x6d\x99\x08\x24\x96\xd1\x40\x89\xd6\x5b\x7f\x7e\x3b\xfa\x34\xf3\xaa\x5d\x3f\xf5\x66\x79\xeb\x56\x7b\xbf\x5a\x28\x4d\x8f\xf2\x1e\xd6\x5d\x3c\x84\x1e\xd4\x8c\x28\x04\x38\xcb\xc6\xdf\x7f\x78\x77\x5e\x09\x05\x84\x3e\x62\x66\xa2\xa1\xea\xc0\xa7\xf1\x08\x1c\xe8\xdd\xa3\x14\x92\x70\xb9\x62\x31\x95\x87\xf7\xea\xeb\x6e\x33\x40\x62\xd5\xa0\xc4\x3a\x06\x26\xdd\x1a\x06\xd2\xb4\x81\x1b\x0d\x19\xa3\xac\x41\xc0\xb2\xbe\x5f\x40\x81\x40\xde\x0b\xae\xfe\xd3\xdd\xcb\x88\x63\x15\xd4\xba\x59\x60\x3f\x5c\x45\xa7\x67\xc0\xb1\xfa\xe9\xa8\x5d\xaf\x9c\x96\xf9\x01\x43\x78\x6b\x04\x0e\xd6\x49\x61\xf8\xdc\x0a\xe9\x30\x21\x2e\xa0\xdb\x6c\xe8\x5a\x16\x3f\x75\xcb\x66\x31\x98\xf1\x63\x65\x13\x68\x4a\x9e\x10\x04\x79\x6a\x01\xff\x06\xf5\x3d\x0d\xfe\xca\x5a\x1b\xf4\xd7\xf1\xb5\xb8\x51\x94\xf1\xb5\x22\x51\x8c\x91\x02\x46\xdb\xa4\x0a\xae\xdd\x2f\x0e\x34\xdf\x6a\x0a\xb5\x45\xae\x2e\x1c\x02\x08\x85\xd8\x3c\x52\xe8\x5c\xf2\xd6\x55\xe2\x5a\x65\x3c\x99\x79\x97\x70\xcb\x84\xbc\xe7\x38\xfd\x7a\x03\xbf\xba\xbf\xbc\x07\x46\xc3\x5d\xa7\x79\x77\x80\xee\x1f\x9a\x77\xad\x84\xff\x28\xe3\x7f\x15\xe5\x2f\xc6\x5d\xb9\xdf\xcf\xe5\x8b\xc7\x89\x7b\x93\x20\x1e\xa5\xee\x4a\x7c\x32\x86\xab\xc9\xf8\xf3\xcd\xf5\xd5\x4c\x47\x75\x6f\xbb\x05\xf6\xfa\x30\x9a\x40\x86\x48\x65\x10\x3a\xe9\xd4\xb0\x2e\xba\xe5\xb8\x0e\x5e\xaa\x0a\x8e\xf7\xed\xea\x66\x3e\xf2\x46\x4e\x59\x77\x91\x45\xad\x8c\x0f\xe7\xde\x2f\x5e\xd9\xe7\x46\xb7\xc9\x20\xab\x3d\x5c\xa4\xb5\x4a\x1d\x4f\x70\xc7\x2a\x79\xac\xdd\x3c\x32\x12\x28\x24\x91\x18\xe9\x37\x2d\x2c\x0a\xa4\xa2\x3f\x7e\x8c\x0a\x06\x42\xb2\x7a\x00\xb6\xce\x5e\x55\x00\x93\x1b\xe4\x20\x37\x84\x56\x48\x49\x89\xdb\x16\x6f\x00\x32\xa6\xd5\x04\x97\xd7\xdf\xef\x3b\xdf\xac\x5b\x89\xe5\x51\x5e\x59\x0a\x52\x0e\x27\x4d\xb2\x78\x94\x2b\xd6\x2d\x74\xe7\x7e\xdd\xa9\x5f\xbd\x89\x47\xde\x8d\x37\xf3\xe0\xf3\xdd\xe4\x4b\xb5\x89\x0f\xb0\xae\x23\x84\x2b\x1b\x90\xe7\xd4\xff\x01\x2a\x72\x66\x27\x1c\xb7\x72\xba\x27\x6a\x3c\xe7\x04\xec\x1d\x8c\x58\x47\xb2\xf1\xb1\x53\x94\xba\xcc\xe7\x63\xf1\xe9\xa2\xdf\x35\x32\xb5\x3b\x67\xfe\x0a\xcc\x6a\xaf\xe4\xf6\x2b\x67\xd9\xd0\xbf\x01\x00\x00\xff\xff\xc2\xe8\x45\xb3\x52\x16\x00\x00"
+var _sqlite3MockGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xcd\x8f\x9b\x46\x14\x3f\xc3\x5f\xf1\x8a\x72\x80\x8a\x90\xfb\x4a\x3e\xb4\x49\x23\xad\x94\xed\x1e\x92\x55\x23\xad\x56\xd5\x04\xc6\x36\x2a\x30\x74\x18\x76\xbd\x75\xf8\xdf\xfb\x66\xde\x80\xc1\x06\x6c\x6f\xd6\xea\xa5\x17\x83\x99\xf7\xf1\x9b\xdf\xfb\x98\x37\xdb\xed\x5b\x78\x53\xad\x85\x54\x70\xb5\x00\xdf\xbc\x15\x2c\xe7\x10\xfd\xae\x7f\x3d\x2e\xa5\x07\x5e\xf2\x0d\x7f\x44\xa9\x2a\x7c\xa8\x8d\x17\xc0\xdb\xa6\x71\xb7\x5a\x35\x1f\xd3\xf5\x4b\x99\x16\xaa\x35\xf1\x59\x09\xc9\x6f\x44\xfc\x17\xea\x8d\xdb\x03\x2f\xe7\x6a\x2d\x12\x7c\x61\x72\xa5\x3f\xc6\x2c\xcb\xcc\xd3\x6b\xd1\x45\x1f\x53\x9e\x25\x55\xcf\x75\x5d\x26\x4c\x71\xed\xba\x40\x97\x4b\xbd\xac\xbd\x57\x79\x9d\xa9\xb4\x95\x6f\xd5\x7d\x92\x4e\x57\x05\x82\x81\x28\x40\x28\x9e\x31\xf5\xee\x1d\x6c\xb7\x16\x6a\xd3\x74\x58\x21\xad\x80\x41\xae\xdf\xf6\x97\x41\xf2\x58\xc8\x24\x2d\x56\x90\xaa\x0a\x0c\xd4\x08\xed\x68\x53\xbf\xb1\x78\x0d\xb4\x19\x5a\x00\xb5\xe6\x90\x33\x15\xaf\xb5\xfc\xc7\xba\x88\xc1\x20\x85\xa7\x35\x2f\xa0\xe2\x2a\x04\x56\x24\x20\x50\x4c\x3e\xa5\x95\x36\xae\x6a\x59\x54\xda\xd8\x3f\x5c\x0a\x78\x64\x59\xcd\xd1\xbe\x7a\x2e\xf9\x38\xd2\x4a\xc9\x3a\x56\xb0\x75\x9d\xeb\xa2\xe2\x52\x91\x13\xfc\xf1\x51\x3c\x56\x9b\x92\x49\x96\xa3\x06\xfe\xb3\x64\x34\x0d\xfc\xdc\x33\x15\x82\x0e\x05\x44\x51\xf4\xf5\xf6\xb6\x54\xa9\x28\x02\xc0\x38\x09\x69\x78\x4e\x97\x1d\xd5\x48\x97\x73\x67\x5e\x5f\xd1\x87\xf3\x99\x3d\x92\x3d\x78\x45\xd4\x1c\x49\xd5\x70\x3f\xf0\x8c\xbf\x2a\x5c\x6d\x5c\xb2\x62\x85\x49\x74\x5d\x24\x7c\xc3\x2b\xb2\x83\x34\x45\xda\x8d\x35\xd0\x52\x47\x42\xd1\x75\x75\x57\xa4\x7f\xd7\x44\x21\x4a\x4b\x5e\x0a\x9b\x26\x11\x7e\x9b\xc1\xb7\x12\xe6\x4f\x96\x56\x5d\x0d\xc0\x92\x65\x98\x29\x18\xf6\x29\xa8\xbe\xd9\xcb\x17\x4c\x99\xdd\x86\x0c\xfc\x80\xc8\xd1\xea\x97\x45\xf2\x09\xa5\x3a\x34\xf7\x0f\x47\xf0\x50\xb0\x76\xaf\x28\x6d\xbf\xb9\x4e\x5e\x63\x62\x40\xf5\x5c\xc4\xd1\x4d\xad\xf8\xc6\x75\xa8\xb0\xee\x1f\xc6\xaa\xe1\x3d\xae\xb9\xa8\x36\x51\xd6\x7a\x99\x4a\x5b\x1b\xb1\x95\xcc\x13\xf8\xf6\x3c\x2a\x3e\x53\x76\xc6\xd2\xae\xf4\xd0\xdf\x0d\xb1\x98\x52\xcd\x9b\x46\x28\x96\xe6\x5d\xfb\x42\x27\x44\x73\xe4\x3a\x56\x12\xb5\xb1\x29\xb8\x46\xf9\x17\xec\x7b\xc0\xb0\xb9\x68\x79\x6c\x82\x75\xce\x0b\xe4\xb2\x67\x00\x19\xdb\xc4\x59\x6d\xfa\x8e\xf9\x26\x0a\x64\x43\xa1\x39\xa3\x7b\xff\x80\x2d\x97\xcb\x25\x8b\xf9\xb6\xb1\x0c\xd0\xf6\xec\xa3\xdb\xb4\x12\x1d\x12\x1d\x68\xd0\x91\x6e\xfb\xf8\x5e\x19\x74\xbb\x0d\xac\x11\x3f\xef\x43\x0f\x35\x52\x13\xf0\x9e\xef\x40\xd3\x31\x30\x19\xe5\x75\xf4\x09\x8d\xf8\x81\xeb\x24\x7c\xc9\x25\x1c\x2c\xdf\x15\x19\x09\xec\xab\x52\xac\x17\xc0\xca\x12\x33\xc2\x1f\x59\x0c\x27\xc3\xb3\x25\x9e\xaf\xec\x76\x43\x43\xf2\x95\xc1\xdc\x04\x96\xa2\xf7\xc6\xbe\x6d\xba\x86\xd7\x2e\x27\x6c\xff\x16\x9d\xba\x90\x30\x48\x1a\x12\xd0\x8d\x5c\x5b\xca\xbb\xf0\xf3\xbc\x54\xcf\x67\x91\x6b\x50\x0c\xb9\x0d\x66\x12\xfc\x07\x19\x26\xdc\x78\x6e\x4e\x7b\xc0\x14\x72\x96\xb8\xdf\x3f\x43\x88\xb5\x24\x75\xbc\xb1\xd0\x20\x14\x07\xfb\x9c\xc5\xbe\x58\xe8\x73\xf5\xfb\x77\xc0\x62\xed\xbe\xd8\x35\x2d\xe9\xec\xc5\xd3\x46\x30\x46\xdc\x0e\xba\xd4\xf5\x4e\xb1\x20\x72\x6d\x90\xfe\x48\xd5\xfa\xcb\xa6\xcb\xe3\xb6\x22\xcc\xc9\xd9\x0f\xdd\xd8\x6e\x42\xa8\x44\xa7\x61\x8e\xd5\x9c\x25\x1c\x9e\xd0\x24\xa8\x8d\x29\xb9\x2e\xa0\x4a\xac\xb8\x3e\x88\xed\x2a\x2a\x99\x73\xb9\x3d\xe2\xcf\x08\x28\x21\xf6\xd1\xc1\xd7\xdb\x0f\xbf\x06\x87\x33\xc4\x41\x04\x6d\x7d\x79\xa4\xe9\x85\x08\x2e\xe8\xc8\x18\x88\x5a\x52\xe8\xb0\x1f\x27\x85\x58\xde\x8d\x03\x67\x61\x27\xb5\x1f\x3e\x29\xa7\xb7\x48\x0e\x3c\x53\xb8\x9d\x51\xb2\x83\x5b\xc6\x64\x1a\xaa\xf5\xa6\x9a\x9f\x70\xd6\x4b\x4d\xfa\x8f\x32\xd3\x13\xb5\xf0\xb1\xd6\xf7\xc0\x93\x1f\x84\x1b\x0c\xd2\x0d\xcd\xba\xcd\xc8\xbc\xa3\x99\xa6\x91\x67\x8e\xe9\xdd\x50\x74\x16\xd3\xa4\x76\x41\xa6\xc9\xc1\xc9\x4c\xf7\x66\xbb\x63\x4c\xef\x44\x5f\xc6\xb4\xe6\x55\x0f\x7e\x73\xac\xb6\x83\xe1\x59\x9c\x6a\xa5\x0b\x32\xaa\xcd\x9f\xcc\x67\x37\xd9\x1e\x63\xb3\x15\x7c\x79\xd6\xb6\xe3\x12\xd2\x4a\x23\xef\x1c\xb1\xbb\xa1\xf8\x2c\x6a\x49\xed\x82\xe4\x92\x83\x93\xe9\xed\xcd\xf6\xc7\x08\xde\x89\xbe\x9c\xe2\x53\xa7\xfe\x37\xf6\xbc\xd3\xc7\xe6\x70\xb8\x6e\xc7\xd2\x56\x02\x19\x9b\x89\xd2\x40\x90\x82\x35\x7d\xa9\x98\x0c\x63\x7f\xe8\xee\xc5\x72\x60\xfc\xe2\xb7\x8e\xe9\x90\x0f\x70\x78\x13\x9e\x8d\x4f\x72\x3f\x97\x0f\x07\x84\x1d\x4d\x8b\x03\x8d\xfd\xec\x98\xe6\x61\x08\x67\x2c\x6d\xc2\x7e\x79\xda\x1b\xd7\x7f\x19\xa6\x53\xaf\x64\xff\x07\x6b\xe6\x3a\x8a\x05\xfc\xc8\x65\xba\x1c\xbf\x2f\x42\x9a\x97\x19\xa7\xab\xdb\xfe\xba\xfb\xc8\x70\x9e\x3e\x9c\x04\x17\xb6\x6e\x0e\x82\xef\x23\xa2\xc0\xfd\x17\x7f\x9e\xed\x58\xa1\x13\x00\x00"
 
-func postgresTypeGoTplBytes() ([]byte, error) {
+func sqlite3MockGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_postgresTypeGoTpl,
-		"postgres.type.go.tpl",
+		_sqlite3MockGoTpl,
+		"sqlite3.mock.go.tpl",
 	)
 }
 
-func postgresTypeGoTpl() (*asset, error) {
-	bytes, err := postgresTypeGoTplBytes()
+func sqlite3MockGoTpl() (*asset, error) {
+	bytes, err := sqlite3MockGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "postgres.type.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.mock.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _sqlite3ForeignkeyGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x4b\xc4\x30\x10\x85\xcf\xe6\x57\xbc\x83\xb0\xc9\xb2\xdb\xde\x05\x2f\xab\xe8\x41\x50\x10\x0f\x5e\xbb\xed\xd4\x16\xb7\x89\x4c\x52\xb5\x84\xfc\x77\x49\xda\xed\xd6\x65\x0f\x81\xe1\x9b\x37\x99\xf7\xc6\xfb\x2d\xae\x6d\x63\xd8\xe1\xe6\x16\x32\x55\xba\xe8\x08\xd9\xdb\xf0\x45\xd9\x73\xd1\x91\xc2\x36\x04\x91\xe7\xf0\x1e\x09\x20\x04\x30\xb9\x9e\xb5\x85\x6b\x28\xf1\x57\xaa\xe7\x81\xd8\x2f\xac\x35\x65\x5b\x38\xaa\xf0\xd3\xba\x66\xd6\x2d\x45\x2b\x9b\xd0\x43\x4b\x87\x6a\x1e\x94\x27\x74\x67\x0e\xf1\xf5\x9d\x9e\x9a\x2a\x13\x79\x1e\x9d\x3c\x92\x26\x4e\x9f\xd7\x6c\x3a\xd4\x86\xa9\xfd\xd0\xf8\xa4\x01\xab\x34\x3f\x82\x27\x1a\x16\xe5\x71\x6b\x26\xea\x5e\x97\x69\xd1\x94\x3c\x04\xac\xcf\xcd\xa9\x65\x5c\x59\xed\xf1\xfe\x72\xbf\x53\x90\xeb\x0b\x69\x37\x20\x66\xc3\x0a\x5e\x5c\x8d\x87\xb9\x74\x93\xdd\x30\xc1\x7f\x81\x65\xb5\xdf\x44\x75\x69\xf4\x37\xfd\xba\xa3\xa5\xf1\x04\x27\x79\x74\x24\x82\x10\x7f\x01\x00\x00\xff\xff\xea\x89\x96\x81\xb0\x01\x00\x00"
+var _sqlite3OptionalGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x55\x4d\x6f\x9b\x40\x10\x3d\x9b\x5f\x31\x8d\x5c\x09\x47\x84\xe4\x1c\xc9\x87\x24\x6a\xa3\x1e\x52\x59\x6a\x7a\x8a\xa2\x6a\xc1\x8b\x41\x86\x5d\xba\xbb\x4e\x14\x21\xfe\x7b\x67\x66\xb1\x8d\x63\x43\xda\xde\x76\xe7\x6b\xdf\xcc\x7b\x03\x4d\x73\x01\x45\x06\xf1\x83\x5e\xca\xf2\x51\x2f\x6e\xa1\x6d\x83\x06\x8d\xd3\x7a\xbd\x82\xeb\x39\xdc\xeb\x85\x48\xd7\x62\x25\xbf\x8b\x4a\xf6\xe2\xee\xb4\xca\x8a\x55\xfc\xad\xaa\xb5\x71\x3f\xa4\x79\x29\x52\x09\x17\xdb\x64\x9b\xa3\x95\xd2\xf9\xa0\x38\xf5\xf1\xad\x96\x31\x57\xa1\xb0\xcb\x4b\x68\x9a\xbe\xb1\x6d\xf7\x18\x52\xad\x5e\xa4\x71\x16\x5c\x2e\x8f\xc2\xc0\x69\x28\xd0\x57\x1b\x8d\xa7\x4a\x5a\x8b\xe8\xe2\x20\xdb\xa8\x74\xb8\x64\x88\x9e\x0e\x15\x56\x38\x7f\x1f\x37\x83\x90\x6c\xdc\x74\xdb\xc6\xef\xdd\x11\x48\x63\xb4\x99\x41\x13\x4c\xd0\x57\xed\x80\xc6\x34\xae\x36\x38\xd5\xcd\xe2\xf6\x51\xf3\xf3\x87\xdd\x1c\xa0\xa6\x56\xc4\x51\xe6\x40\x2f\xbb\x82\x21\xd7\x38\x1a\xcb\x58\x03\x5d\x7f\x63\x4d\xed\xf1\x8e\x34\xc5\x01\x96\x5a\xb7\xe3\x24\x59\x6a\x0d\x1d\x85\x39\xec\xd8\x8e\x11\xe5\x0b\x87\x46\xbf\x5a\x78\x7a\x3e\x45\x92\xb7\xfe\x0d\x4d\x46\x5a\xd2\x5f\x25\xd6\xf2\xc3\xac\x52\x2a\x7e\x74\x36\x0b\x26\x99\x36\x50\x44\x80\x57\x4a\x37\x42\x21\x4b\x0c\x08\x6b\x4e\xea\x84\x9f\x20\xcf\xb0\xd2\x30\x1a\xeb\x4c\x70\xab\x28\xf4\xd3\x1c\x54\x51\x72\x36\x62\x72\x1b\xa3\xe8\xce\x65\xd0\xd4\x06\x64\xb5\x4f\xc5\x33\xcc\xa1\x4e\x02\x34\x04\xdb\x30\xb4\x47\x14\x3b\xa8\x2f\xdb\x11\x66\x47\x14\xc6\x3c\x1c\x91\x33\xa8\xb0\x5d\xc9\xb0\x4e\xb6\x1c\x8c\x68\xea\x04\x49\xe3\x1c\x9c\x18\x3c\x3e\xd4\x9b\x7b\x9d\xec\xc7\x4e\x10\x68\x6e\x38\xd0\xc1\xb1\xf7\x96\x22\xf9\xbf\xa9\x63\xf5\xa1\xb1\x37\xfe\xe3\x58\x09\xbb\xce\x0a\x59\x2e\xad\x5f\x8d\x53\x6c\xdc\xd4\x75\xf9\xf6\x95\x82\x1e\x30\x1a\xac\xec\xc8\xe8\xf2\x74\x06\x07\x1f\xa0\x42\x79\xaa\x84\xcb\xd9\x49\x4f\x20\x53\x54\xf9\x30\xeb\x88\x50\x94\x26\xe3\x2c\xd4\x8a\x9d\xa9\x2e\x37\x95\x62\x9a\x37\xf5\x52\x38\x09\xaf\x85\xcb\xa9\xd0\x4f\xbe\xde\x79\x7f\x0c\x37\x65\xd9\xaf\xed\x72\xe1\x20\x15\x0a\x12\xd9\x25\x2e\x41\x18\x49\xc8\xe1\x35\x97\xca\x43\xca\x85\x05\xc5\xb0\x18\xea\x80\x6e\x0e\x9b\xff\xe0\x53\x1b\xc1\xbf\x7f\xbe\x22\x8f\xe6\x9c\xb1\xd3\xb1\x4e\xe2\xdd\x7b\xac\x43\xeb\x0c\x4e\xa4\x2f\x3e\x3f\x5b\x96\x9f\x5d\xc7\xf7\xd2\x2d\xc8\x10\xa2\x48\x90\x52\x16\x1e\xdd\x67\x30\x9f\xc3\x95\x5f\x6e\x4e\x98\xc3\xb6\x58\x03\xc4\xbf\x97\xe2\x14\xa5\x39\xcd\xb6\xd5\x7a\x62\x40\xa4\x58\x6e\x5a\x30\x48\xbc\x48\xb5\xc4\xe3\x19\x9e\xb8\x4b\xfe\xf3\x61\xa2\x37\x91\x13\xff\x7d\xd0\x7a\xc1\x21\x75\xfd\xfd\xd8\xb6\x70\x15\xf5\xe0\x75\x9b\xf1\x2b\x62\x02\x7a\xbb\xc1\x60\x09\xb6\x45\xbe\xd3\xdc\xbb\xe9\x8e\xef\x08\xe2\x63\x37\x2b\xc4\x49\x3f\xdc\xc9\x64\x29\x33\xb1\x29\xdd\xf5\xfb\xa5\xc8\x2a\x17\x7f\xa1\xb9\x65\xe1\x19\x67\xc1\xe7\xdf\x24\x0d\xa5\x5d\x4f\x1d\x67\x1e\xc2\xac\xdb\x1f\x06\x3f\xa7\xa7\xb0\xab\x90\x6e\x3b\x7f\x6f\x99\xbc\x7d\xbf\x4d\x7e\x3c\xfd\xe3\x1f\x3f\x69\xd6\x53\x81\x08\x00\x00"
 
-func sqlite3ForeignkeyGoTplBytes() ([]byte, error) {
+func sqlite3OptionalGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlite3ForeignkeyGoTpl,
-		"sqlite3.foreignkey.go.tpl",
+		_sqlite3OptionalGoTpl,
+		"sqlite3.optional.go.tpl",
 	)
 }
 
-func sqlite3ForeignkeyGoTpl() (*asset, error) {
-	bytes, err := sqlite3ForeignkeyGoTplBytes()
+func sqlite3OptionalGoTpl() (*asset, error) {
+	bytes, err := sqlite3OptionalGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sqlite3.foreignkey.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.optional.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _sqlite3IndexGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdd\x4e\x1b\x3d\x10\xbd\xb6\x9f\x62\x3e\xeb\x13\x6c\xda\xb0\xb9\x47\xca\x45\x0b\xa1\xad\x4a\xa1\x05\xaa\x22\x21\xd4\x38\xbb\xb3\xb0\xd2\xc6\xce\xda\x5e\x20\xb2\xfc\xee\xd5\xd8\xbb\x94\x9f\x08\x41\x6f\x1c\xdb\xe3\x99\x73\xe6\x9c\xc9\x7a\xbf\x03\xff\xdb\x6b\x6d\x1c\xec\x4e\x21\x8b\x3b\x25\x97\x08\xf9\xd9\x7a\x85\xf9\x11\x6d\x05\x1a\x23\x40\xd8\xb6\xb1\x8e\x36\xe5\x42\x80\x68\x05\x08\x83\x56\x80\x38\x3f\x3e\xd4\x57\x02\xf2\x83\x1a\x9b\xd2\x8e\x60\x27\x04\x1e\xcb\x3a\xb9\x68\x30\x95\x2d\xae\x71\x29\x21\x3f\xed\x7f\x63\xed\x33\x0a\xa7\x95\x60\x52\xe2\x64\x02\xde\x43\x7e\xd0\xa9\x22\x62\x87\x00\x06\x9d\xa9\xf1\x06\x2d\x48\x30\xfa\x16\x2a\xa3\x97\xb0\xed\xfd\x00\x10\xc2\x36\x48\x0a\x52\xe2\x5f\xd6\x21\xe4\x7c\x32\xa1\x82\x9f\x50\xa1\x91\x0e\xcb\x94\x5a\xab\x12\xef\x62\x81\xfc\x0b\x6d\xd3\xda\xe7\x6c\xe7\xbc\xea\x54\xf1\x94\x44\x56\x2e\xe0\xfc\x78\xff\xa3\xf7\x70\xa5\x57\xd2\xc8\x65\x53\x5b\x37\xf4\x0c\xce\x74\x98\x96\x10\x46\x90\x79\x0f\x75\x05\x4a\xbb\x7b\x04\xfb\x53\xd5\x6d\x0c\x5f\x5c\x7a\x0f\xa8\x4a\x08\xe1\xdd\x53\xc2\x63\x40\x63\xb4\x19\x81\xe7\xec\x46\x1a\x3a\xa5\x1b\xce\xd9\x64\x02\xb6\x6d\xa0\xed\xd0\xac\x39\x2b\xb4\xb2\x0e\x92\x23\x30\x85\xf9\xe9\xec\x70\xb6\x77\x06\x73\x78\xcf\x19\x9b\x7b\x0f\x85\x6e\xc8\x46\xdb\x03\xf4\x3c\x43\x18\x9e\x1c\x9c\x1c\x7f\x83\x87\x1a\x0e\x81\x5f\x9f\x67\x27\x33\x78\x50\x21\x22\xde\x77\x2a\xe0\xc3\xd1\x3e\x08\x08\x61\x9e\x48\x99\x4e\x0d\xa4\xe2\x20\x64\x89\xd4\x4b\x42\x55\xb2\xb1\x51\xa9\x38\x26\x75\xb5\x41\x25\xce\x88\x5b\x9a\xcb\x10\x68\x86\x9e\x6a\xe5\xe9\x49\xca\x8e\xd7\xdf\x4d\xbd\x94\x66\xfd\x15\xd7\x31\x9d\xfd\xc6\xbb\xda\x3a\xbb\x1b\x21\xc7\xb1\x1e\xa9\x4e\x33\xc6\x02\xe7\x8c\xb4\x9d\x42\xb9\xc8\x7f\x10\xf9\x13\x7d\xfb\x16\xe2\xf9\x69\x21\x15\xd9\x5c\x51\x74\x83\xd0\xd9\xca\xd4\xca\x81\xd8\x12\x7d\x17\xa3\xd8\x2f\xab\xab\x68\xea\x7f\x53\x50\x75\x43\x36\x33\x83\xae\x33\x8a\x8e\xd1\xfd\x44\xae\xbf\xdc\x7a\x28\xc2\x98\xde\x44\xc5\x30\xb1\xe0\xac\x8d\x29\xa4\xce\xd0\xc7\x9b\xd4\x7f\x1d\x1b\x56\x62\x85\x06\xda\x7c\xaf\xd1\x16\xb3\x51\xb2\xbd\xd1\xb2\x04\x83\xb6\x6b\x9c\x25\xbe\x96\x58\x5c\x5c\x3e\x1b\x69\x1f\x38\xab\x34\xa5\x1f\xe1\x9d\xcb\xe2\x68\xbf\xc6\xdb\x97\xcd\x7d\xe6\xee\x23\x7b\xa3\x84\xf1\x0f\x53\x48\xc5\x59\x6f\x75\xfb\xcf\xa6\x6d\xd0\xe9\xb9\x50\x09\x94\x84\x98\x82\x5c\xad\x50\x95\x99\x41\x3b\x7e\xec\xe1\xe8\x91\xbd\x31\x7e\x6f\x6a\xfc\x24\xf0\xc0\xf9\x9f\x00\x00\x00\xff\xff\xc3\x4f\x76\x7d\x93\x05\x00\x00"
+var _sqlite3PrototestGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\x90\x41\x4f\x83\x40\x10\x85\xcf\xf0\x2b\xa6\xc4\x26\xb4\x52\x7a\x27\xe9\xa5\xb1\xde\x34\x8d\xe1\x66\x3c\x2c\x30\x50\xe2\x76\x77\xdd\x5d\x30\x86\xf0\xdf\x9d\xdd\x62\xb5\x89\x36\x26\x1c\x36\xbc\xf7\xbd\x79\x33\xc3\xb0\x82\x1b\x0b\xd9\x06\xd2\xfc\x43\x21\xac\xc6\x31\x5c\xaf\x21\x47\x63\x87\x81\x94\xf4\x91\x1d\x11\xc6\x71\xbf\x7d\x92\x9d\xa8\x72\xdd\x2a\xb0\x24\x1a\x28\xa5\xe8\x51\xdb\x56\x34\x70\xe1\x34\x60\x25\xd8\x03\xb6\x1a\x94\x96\x56\xba\xb8\x23\x1a\xc3\x1a\x34\xc0\x44\x05\x05\x2b\x5f\x13\x78\x6f\xed\x01\x18\xe7\x93\xb5\x6e\x91\x57\x06\x0c\xda\xc4\x9b\xbc\x7c\x92\x44\xc7\x39\x2b\x38\xba\xa0\xc9\xe6\x7e\xa5\x61\xdd\x89\xf2\x6a\xd3\xd8\xc2\xd2\x95\xa5\x8e\x69\xbe\x80\x21\x0c\x4e\xd5\x69\xdb\x23\x53\xcf\xc6\x6a\x52\x5e\x96\x17\x38\x99\x82\xa8\x67\xbc\x43\x13\x65\x0e\x09\x02\xd2\x55\xe1\xc8\x69\x7a\x0a\x35\xe3\xc6\x99\x49\x1d\x13\x07\xb8\x42\x57\xfc\x56\x77\x3f\xec\x63\x18\x06\xb5\xa4\xc5\x68\x22\x1d\x82\x09\x7f\x7f\xcd\x44\x83\xd3\x6d\x5d\x8c\x2a\x12\x40\xad\x9d\x74\x51\xf0\x41\x56\xc8\x73\xb9\xdf\xc6\x8e\x5c\x90\xb3\xad\xbd\x71\xb6\x01\xd1\xf2\x53\x05\x9b\xde\x33\xcb\x78\x1d\x47\x73\x93\xc1\x19\xc9\x60\xde\x47\xc9\x34\x98\x18\x47\xbb\x36\x41\x23\xed\xef\xd3\xf6\xdb\x5c\x7a\x3c\x56\xc5\xbf\x66\x9d\x81\x3f\x67\x51\xc4\x4c\x63\xcd\xb1\xb4\xe9\x1d\xa2\xda\xbd\x75\x8c\xc7\xbe\x81\xdf\xe8\x2b\x75\xa7\xb5\xd4\x53\x2a\xa9\x30\xbf\xed\xa7\x6b\xd1\xeb\x9c\xfc\xcd\xf9\x7c\xfa\xc6\xf0\x13\xe9\x4d\x8e\xd5\xd5\x02\x00\x00"
 
-func sqlite3IndexGoTplBytes() ([]byte, error) {
+func sqlite3PrototestGoTplBytes() ([]byte, error) {
 	return bindataRead(
-		_sqlite3IndexGoTpl,
-		"sqlite3.index.go.tpl",
+		_sqlite3PrototestGoTpl,
+		"sqlite3.prototest.go.tpl",
 	)
 }
 
-func sqlite3IndexGoTpl() (*asset, error) {
-	bytes, err := sqlite3IndexGoTplBytes()
+func sqlite3PrototestGoTpl() (*asset, error) {
+	bytes, err := sqlite3PrototestGoTplBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "sqlite3.index.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "sqlite3.prototest.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _sqlite3QueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xfd\x89\x50\xec\xdf\x5c\xe7\xbd\xe0\x97\x75\x0c\x06\xa3\xd9\xbf\x87\x42\x29\x4c\x8d\xe5\x4c\xa0\x48\xb1\x24\x77\x0d\x42\xdf\x7d\x5c\xc9\x76\xec\xa6\x65\xa3\x6f\xca\xf1\xfd\x73\xce\x3d\xf7\xc6\xfb\x4b\x58\xd9\x5f\xda\x38\xb8\xaa\x21\x8f\x2f\xc5\xf6\x1c\xaa\x1f\xc7\x03\xaf\x6e\xf0\x49\xb9\x31\x14\xa8\xed\xa4\x75\xf8\x68\x1e\x28\xd0\x8e\x02\x35\xdc\x52\xa0\xb7\x9b\xcf\x7a\x47\xa1\xfa\xda\x73\x73\xfc\xc2\x0c\xdb\xdb\x02\x2e\x43\x20\xb1\x76\x87\xe8\xb5\xde\xef\xb9\x72\x16\x7b\xa4\xb8\x09\x19\x03\x45\x0b\xd5\x00\x46\x6c\xbd\x06\xef\x4f\xd0\x10\xc5\xa5\xe5\xf3\xcf\x91\x5f\x08\x60\x7a\x65\x81\xc1\xb6\xb7\x4e\xef\x21\xf6\x2c\xc1\x70\xd7\x1b\x25\xd4\x0e\x0c\xb7\xbd\x74\x16\x98\x8d\x59\x27\x69\x21\x54\xa9\xae\x6a\xb0\x45\xdb\xab\xed\xa2\x6e\xde\x3c\xc0\xed\xe6\xc3\x7b\xef\xc1\x30\xb5\xe3\x0b\x95\x10\x42\xb9\x88\x1e\x6b\x43\x08\xde\x0f\x35\x0b\xc8\xbd\x47\x75\x4a\x3b\xa8\x36\x4a\x1e\x37\x0a\x03\xee\xee\xa7\x90\xff\x9f\x73\x2a\x81\x1b\xa3\x4d\x01\x9e\x64\x8f\xcc\xe0\xaf\x84\x10\x92\xad\xd7\x60\x3b\x99\x24\x92\x2c\x95\xae\x3e\x29\xc7\xcd\x41\x4b\xe6\x30\xfd\x91\x19\xac\x8d\xa3\x0a\x61\xab\x95\x75\x53\x2b\x48\x26\x42\x0d\x93\xa2\x95\x28\x61\x25\x4f\xce\x24\xf2\xa2\x85\x95\xc0\x84\x77\x53\x6e\x42\x73\xa1\x1a\xfe\xf4\xdc\xd7\x95\x28\x30\x38\xb9\xf2\x4a\xc4\x7c\x2a\xb3\x0e\x28\x02\xc1\xcb\x10\x7e\x7a\x8f\x54\xd2\x63\xb0\x24\x2a\x36\xbd\x1a\x15\xc7\x6d\xcb\x93\x8c\xd7\x5c\x99\x0d\x7c\x39\x99\x85\x5d\x73\x32\x83\x57\xd3\x26\x9e\x7c\x4a\x0e\x20\xb1\x74\x25\x33\x9b\xc7\x42\x24\x43\x83\x6a\x68\x1e\x12\x8f\x6f\xfa\xf7\x5f\x08\xbe\xcc\xa3\xa8\xbe\x6f\x99\xc2\x75\x69\x05\x97\x0d\x9e\xa1\x1d\x3a\x7d\x44\xc0\x42\x7e\x30\x42\x39\xa0\x17\x74\xa0\x53\x44\xd6\x99\x68\xe3\x8e\xfc\x57\x83\x12\x12\xb7\x26\x4b\xbb\x8f\x3f\xe3\x32\x91\x0c\x27\x39\x80\x17\x73\x35\x25\xc6\x9c\x6e\x0b\xd5\x74\x31\x05\x37\x62\x54\xf4\x36\x39\xff\xc8\x2b\x6b\x78\xcb\x0d\x74\xd5\xb5\xd4\x96\xe7\x45\xb2\x5c\x6a\xd6\x8c\x77\x8b\xcc\xe3\x7f\xc7\xdd\xfd\xd9\xad\xf8\x40\xb2\x56\x63\xfa\x0d\x7f\x72\x79\xbc\x99\x6c\x61\xd7\x55\x7d\xe6\x98\xc7\x69\xc4\x53\xda\x32\x45\xb2\xc1\xbf\xee\xcd\xf3\x7f\x41\xe8\xb9\xd2\x68\x41\x54\x52\x03\x3b\x1c\xb8\x6a\x72\xc3\x6d\xb9\xb4\xa3\x58\x38\x15\xbf\x4f\xfe\xa4\x83\x08\x84\xfc\x09\x00\x00\xff\xff\xb6\xe2\x6b\x23\xb5\x05\x00\x00"
+var _sqlite3QueryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5a\x5b\x6f\xd3\x48\x14\x7e\x4e\x7e\xc5\xac\x15\x21\x1b\x8c\xe1\x61\xb5\x0f\x95\xfa\xc0\x42\x57\x42\xcb\x52\x58\x78\x40\xea\x56\xe0\xd8\x93\xc4\xc2\x99\x49\xc6\x0e\x24\x8a\xf2\xdf\x39\x97\xb1\x3d\x76\x9c\x6e\x55\x68\x97\x6a\xf3\xd0\xd6\x99\xcb\xb9\x9f\xf3\x9d\xe3\x74\xbb\x7d\x2c\x46\xc5\x4c\x9b\x52\x9c\x9c\x0a\x9f\x9e\x54\x3c\x97\x22\x7a\xbf\x59\xc8\xe8\x35\x3e\x7a\xd2\x18\x4f\x78\xc5\x32\x2f\x4a\x7c\x48\xc7\xf0\x6b\x09\x3f\x46\x16\xf0\xfb\xc3\xf9\x2b\x3d\x85\xbf\x13\x05\xbf\x62\x33\x85\xb5\xe8\xed\x4a\x9a\xcd\x9b\xd8\xc4\xf3\x22\x10\x8f\x77\xbb\xe1\x16\xf9\x2c\x71\xf5\xb9\x9e\xcf\xa5\x2a\x0b\xe4\xc7\xe7\xea\x95\xfa\x20\x52\x21\x79\xe8\x06\x7d\x8a\x1c\x3a\xc0\x97\x76\x17\x26\x53\xa5\xf0\x1e\x7a\x8e\xb4\xce\x31\x95\xe5\x78\xcc\x83\xbf\x5e\xbd\x9a\x4d\x44\xf4\x2e\x89\xf3\xd8\x88\xdd\x6e\xbb\x65\x62\x40\xcb\xc8\x12\x48\x08\x3f\x53\xa9\x5c\x5b\x7a\x7f\x64\x32\x4f\x0b\xf1\x34\xa0\x8f\x81\xbd\x80\x64\xe9\x02\x3c\x5c\x75\xe7\x75\x96\x3b\xd7\xa4\x4a\x5d\x05\xca\x46\x32\xda\x06\xb1\x62\x38\x11\x9d\xab\x7c\x73\xae\xe4\x9e\x8c\x25\xb0\x24\xce\x7b\xc4\x50\xa1\xb3\xb5\x4c\x5a\x0b\xd6\xa4\xb4\xf6\xe4\x89\x80\x2b\x53\x9d\xd8\xb5\x7a\xd3\x9e\x97\x79\x21\x9d\x83\xec\xf3\xdd\x4e\x98\x95\x2a\x44\x2c\x92\x55\x51\xea\xb9\x28\xca\xb8\x94\x78\x2d\x14\x20\xcd\xca\xa8\x4c\x4d\x45\x39\x93\x42\xad\xe6\x63\x69\x84\x06\x05\x26\x13\x99\x94\x32\x15\x46\x7f\x2d\x22\xa6\x0d\x82\x5a\x36\x5f\xb3\x72\x06\x6a\xbd\x7d\x25\x88\x15\x72\x7b\x0f\xd7\xc9\xc3\x22\x2b\x4e\x78\x6d\x60\x45\x4d\xc1\x04\xb5\x80\x4c\x64\xb2\x52\x89\x2b\xa0\x0f\xcf\x49\xb9\x5e\x60\x94\xc1\xc7\x74\x2c\x3e\x9c\xbf\xf8\x1d\x16\x4d\xac\xa6\xb2\x15\x83\xb5\x8d\x95\x06\xfd\x5f\xc8\x49\xbc\xca\x51\xff\xb0\xa5\x30\x3e\xa3\xc7\x1a\x1b\x3b\x0f\xa1\xd0\x0b\x08\xd1\x28\x8a\x3e\x9c\x9f\x2f\xca\x4c\xab\x00\x1d\x5f\xfe\xf6\x6b\x28\x20\x3f\xb4\x09\xc4\x76\x38\x40\x71\xd7\x5a\xd3\x3e\x72\xad\x56\x32\x05\xa9\xb3\x62\xf3\x73\x4e\x79\xd6\x6b\xd5\x19\xb2\x03\xe9\x92\xb2\x78\x05\x1b\x80\x36\x81\x8f\x34\x0b\x9d\x27\x33\x99\x7c\xc6\x0d\xef\xa9\x47\x9b\x60\x44\x48\x4b\xbe\xdc\x84\xb7\x9c\x72\x3e\xe1\x89\x2f\x10\x44\x9c\xb9\xe0\x42\xc8\x97\x29\x2f\x51\x4e\x5d\x5c\x12\xe1\x49\x9c\xc8\x2d\x9b\x9a\x4d\x37\x2a\xe4\x94\xd2\xd3\xa5\x44\x81\x0b\x91\x4e\x81\xdb\x44\x2d\x9e\x85\x88\xaa\x8c\x45\x27\xe0\xc0\x3f\x25\x0b\x08\x27\x70\xd5\x39\x04\x66\xea\x44\x46\xc3\x34\x02\x77\x35\xdc\x2a\x7f\xbd\xb1\x1e\x46\x5b\x30\x83\xdd\xce\xaa\xf4\xe8\x54\x7c\x42\xb7\x71\x58\x7d\x6a\xe2\x19\xed\x40\xf7\xc0\xca\x8b\x98\x79\xf5\x5e\x5f\x6b\x0e\x11\x3f\x97\xca\x47\xab\x04\xa1\xc0\x47\xa4\xca\x04\x6c\x78\x04\x81\x4b\x60\xa2\x8d\xf8\x18\x8a\x2f\x68\x0d\x96\x7f\xef\x02\xc7\x43\x75\x61\x40\x16\x3f\x15\xf1\x62\x01\xaa\x13\x27\xb8\xde\xa2\xe9\xa4\xe3\x21\x69\x61\x4d\x95\x33\x0a\x93\xa9\x16\x5e\x2d\xb3\xd7\xb9\xd1\xc7\x0c\x76\xab\x72\xda\xd8\x34\xe8\x3a\xc3\x79\xec\x78\x77\x38\xd8\x3b\xe1\x3e\x3a\x62\xa3\xf1\x5f\xda\x90\x85\xaa\x01\xcb\x10\x72\x98\x49\x7c\x26\x81\xdc\x28\xeb\xc4\xaa\xa2\x93\x94\xb3\xa1\x90\x85\x62\x94\x37\x00\xd1\x04\x5b\x86\x17\x1e\xb9\xd9\x09\xab\xb6\xfe\x76\xe0\x65\x94\x61\xe5\x15\x5c\xd4\x0e\x9c\xe8\x64\x7a\xc5\x01\x95\xb0\x25\x16\xa3\x6b\x84\x55\xf7\x53\x7d\xd0\xd5\x9c\x32\x10\x0a\xa5\xcd\xc0\x01\x61\xa1\xcf\x1a\xe1\x4d\xf2\x03\x5a\x79\x00\x30\x43\x85\x02\xb5\x4a\xc7\x11\x6c\xa6\xe3\x89\x12\x1e\x16\x01\xaf\xa9\x66\xe8\x9c\xca\xe1\x6d\x02\x20\x1c\x5e\xff\xe5\x54\x20\x0c\x40\x6c\x0d\xb8\x0e\x8b\xa7\x44\x17\xbd\x33\xac\x96\xd6\xfa\x6f\x28\xc1\xcf\x6c\x3d\x06\xa8\x2a\x82\xe1\xae\x9d\x1c\x7f\xc5\x8b\x5b\x46\x0c\x32\x49\x17\x2d\x12\x9d\xaf\xe6\x70\x0a\xe0\x02\x3f\x82\x64\x54\xea\x32\x85\xb4\xb4\x49\xa5\x09\x09\x08\xdd\xcd\xb8\x10\xf3\x78\x51\x88\xcf\x72\x03\xe0\x32\xde\x58\x22\x02\xfb\x94\xff\x05\xcc\x5c\x5c\x72\xe9\x0e\xa1\x62\x83\x25\x2e\xf8\x93\x5b\xbc\x6f\x8a\x41\xde\xbb\xb3\x57\x67\xcf\xdf\x7b\xe2\x86\x30\x04\xa1\x18\x0a\xdb\xc5\x1c\xd1\xe8\x88\x46\x47\x34\xba\x1f\x68\xb4\xec\xc5\x22\x52\xef\xfb\xc0\xa8\x2a\x08\x35\x26\x0d\xa0\x8e\xc0\x78\xb0\x8c\x9e\xe7\xba\x90\x7e\xe0\x82\x14\xcc\x38\x0a\x70\xa8\xf0\x97\x2d\x78\xba\x63\x58\x72\x60\xc6\x46\xcb\xde\x7c\xc8\x8e\xe1\x78\xa9\xca\x77\x45\xbe\xf6\xc4\xed\x43\x91\xf8\x09\xb0\xc8\x21\x5a\xcd\xaa\xbb\xdd\xc5\xa5\x7b\xdb\x9a\xec\x3f\xc2\x24\x1a\x96\x2b\xac\xc1\x20\x25\x29\x86\x47\x74\x3a\xa2\xd3\x11\x9d\xee\x05\x3a\x59\x7b\x1e\x78\x1f\xc6\x39\x49\x89\x42\xaf\x2d\xb9\x8a\xd9\x9a\x33\x1c\x60\xc6\xf7\xa0\x1a\xcc\x43\xd7\x00\x36\xe4\xa2\xfc\x07\x2e\xf1\xab\xc0\x6e\xbb\xad\xde\xc9\xed\x8f\x60\x2e\x0d\x42\xc4\x76\xbe\x39\xea\xdc\x2e\x16\x1f\x86\x61\xf0\x46\xae\xe3\xb4\xc2\x3f\x1a\x51\x51\x0a\x2a\xe6\x15\xec\xc1\x4d\x4c\xdf\x65\xf4\x5a\xae\x4b\x9f\x6a\xf9\x95\xf6\x87\x6d\xac\xb3\x60\x46\x78\x62\x5f\x2c\xfb\xad\xda\x23\xf7\xbe\xe0\x64\xd1\x01\xbf\xa2\xb5\x09\x4a\x83\x74\x87\x96\x63\x77\xda\xee\x9a\xbb\x15\x4a\x53\xa9\xa4\xc9\x92\x0a\x86\x5c\x37\x59\x3f\xac\x35\x59\x1f\x0e\x5f\x74\xd1\xfe\xb2\xe5\x8e\x74\x1c\x8a\x1b\xbb\xe4\x9a\xa1\xd2\x12\xd7\x7d\x8b\x60\xa5\x7c\x96\xe7\x77\x22\x65\xaf\x61\x9d\x1e\xa0\x3f\x2f\x5b\x62\xfd\x90\xec\xac\x4a\xf3\x84\x5f\xb4\x73\xfb\x72\xad\x74\x75\xd5\xaa\xf0\x9f\xc4\x7b\x63\xb2\x79\x6c\x36\x7f\xca\x4d\x5d\xa7\x0a\x68\x22\xe4\x3a\x2b\x4a\xa9\x12\xd9\x0e\x93\xe8\x23\x6d\x60\x48\x42\xef\x22\xdb\x25\xce\xb2\x7a\x70\x85\x4f\x7f\xce\x9c\x7f\xb8\xd7\xd7\xf6\xa5\x7e\xcb\xbd\x27\xa7\x7b\x1e\xe6\x33\x07\x4d\x0b\x29\x6e\x8d\x77\x42\xb6\x0b\x99\x64\xf5\x75\x86\x4d\xf7\x03\x05\xe4\x5f\x1d\xff\x5d\x15\xa5\x5b\x9e\x0e\x46\x3e\x7b\xba\xdd\x75\x39\x59\xd0\x19\x3e\xce\xe2\x64\xc6\x03\x08\xbd\xfd\x6a\x8d\x20\x00\x01\x39\x0e\x20\xe0\x7a\x1a\x15\x24\x9d\x25\xcf\xe0\x30\x12\x5b\x52\x37\x9f\x48\x42\xa2\xab\x57\x25\x39\x1d\x59\x01\x47\xe7\x9d\x5b\xa9\xc5\x5c\xce\xb5\xd9\x44\xe2\x25\x74\x20\x31\x76\xe7\xd0\xf9\xea\x05\x30\x2f\x51\x60\x94\x60\x92\x99\xa2\xe4\x26\xda\x0e\x4d\xfc\x12\x6e\xa2\x80\xfc\x2c\x03\x91\xb3\xa2\xde\x88\xf6\x66\x16\x34\xc0\x5d\x8f\x2d\x60\x50\x14\xc3\x6f\x8c\x15\xb0\x02\x7d\x13\x0d\x6b\x76\xbd\x19\xc5\x7e\xe3\x68\x47\x15\x54\xcd\x0b\x7e\xc4\x6b\xb4\xe3\xeb\xb3\xe3\x80\x72\x1c\x50\xee\xe3\x80\x52\x77\x67\x3e\x45\x37\xd7\xe9\xc0\xf6\x6a\xf6\x35\x17\xa9\xed\xd4\xc1\xa6\x2b\xc3\x1a\x7a\x80\xfe\xed\xb7\x0a\x57\x76\x09\x0b\xa3\x13\x59\x14\x4d\xa3\xd0\x6d\x05\xf6\xfe\x97\xe2\x2e\x06\x03\x07\xc1\x99\xc4\x84\x72\xdb\xbd\xdd\xb2\xdd\xbd\xed\x57\xfa\x14\xed\xda\xc9\x89\xdc\xeb\x90\x72\x3b\x9a\x65\x74\x66\x8c\x1f\xec\x37\x34\xfd\xe5\xa0\x17\xa6\x6b\x84\x6a\x40\xba\x6e\x7e\x18\x5b\xa9\x1e\xc8\x92\x5b\x1f\x17\xbe\xb9\x11\xd0\x8c\x26\x4e\xd7\x62\x31\xc2\x87\x50\x1b\xd5\x3d\xd5\x88\xff\x4f\x62\x84\xdf\x58\x52\x11\xc0\xb4\x73\x2f\x62\x66\x39\xe0\x8f\x8c\x1a\x91\xb0\x53\xb1\x10\xec\xf4\x26\xb5\x7c\xfe\x81\xae\x22\x10\x55\x83\x80\xb6\xac\x33\x99\x94\xf7\x3d\x97\x7b\xe4\x50\xf0\x5a\x5d\x4a\x70\xd0\xa6\xdf\x00\x4e\x85\x3a\x23\x75\x25\x00\x00"
 
 func sqlite3QueryGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -638,7 +1503,7 @@ func sqlite3QueryGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3QuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xb1\x0e\x82\x30\x10\x86\x67\x79\x8a\x7f\x30\x41\x07\xca\x6e\xe2\x64\xe2\xe8\x22\x2f\x50\xe1\x50\x92\xb6\x90\xb6\xc4\x98\xcb\xbd\xbb\x29\xa0\xe2\xd0\x6b\x72\xff\xd7\xaf\x3f\x73\x81\x6d\xd4\x37\x43\x38\x1c\xb1\x0b\xf5\x83\xac\x86\xba\x2e\x77\x95\x92\x79\x5e\xb4\xa5\x3d\x0a\x91\x2c\xbd\xe9\x5a\xa8\x53\x6f\x2d\xb9\x38\xed\xca\x12\xcc\xbf\xd5\x42\x91\x09\xb4\x8e\x93\x03\x22\xf0\x34\x78\x0a\xe4\x62\x80\x86\xef\x9f\x68\x7d\x6f\x91\x33\x7f\xba\x88\xe4\x6a\x36\xb8\x26\xc9\xe2\x6b\xa0\x3f\x43\x88\x7e\xac\x23\x78\x82\xbc\x76\x77\x82\x3a\x77\x64\x9a\x90\xf0\xcd\x1a\x65\x86\xa7\x49\xa0\xaa\x34\x45\xf0\x6d\x6b\xd2\x19\xad\x5b\xd8\xf5\x97\x92\x65\xef\x00\x00\x00\xff\xff\x5b\x7f\x83\xf0\x1d\x01\x00\x00"
+var _sqlite3QuerytypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x65\x91\xcd\x6e\xc2\x30\x0c\xc7\xcf\xed\x53\x78\xd5\x24\xe8\x24\xc2\x7d\x12\x27\xc4\x8e\x3b\x0c\x1e\x60\xa1\xb8\x1f\x52\x93\x30\x27\x15\xa0\xaa\xef\x3e\xbb\xcd\xa0\x68\x87\x3a\xae\xfd\xf3\x3f\xb6\xd3\xf7\x2b\x78\xf5\xb5\xa3\x00\xef\x1b\x58\x8e\x9e\xd5\x06\x41\x7d\x8a\xcd\x90\x28\x83\x8c\xdc\x25\xcb\x61\x35\x0c\x69\x2f\x7c\xd0\xc7\x16\x27\xbe\xa8\xd1\x68\x50\xfb\x78\x1e\x24\x33\x59\xa9\x7f\xd4\x34\x25\xa8\xad\x33\x06\x6d\x18\x63\xeb\x35\xf4\x3d\x54\xae\x88\xb1\x7b\x32\xf2\xd8\x7a\x9c\x81\x53\x37\xc3\x00\x84\x67\x42\xcf\xa0\x07\x0d\xdc\x16\x94\xe4\x0c\x2c\x18\x89\x5d\x0d\xc3\x42\x4d\x0a\xf6\x24\x62\xe1\x76\xc6\x27\x05\x1f\xa8\x2b\x02\xf4\x23\x44\xda\x56\x3c\xeb\xce\x1c\xf1\xe4\x05\x4f\x66\x28\xbb\x97\x26\xd4\x80\x92\x0d\xba\xf2\xa0\x44\xe0\x5b\x10\x76\xe4\x8c\x97\xcc\xee\x9b\xa9\x7e\x34\xd8\xfe\x57\x95\x66\x08\xc7\xb6\xd4\x41\x2c\x87\xe2\x90\x5b\xd7\xca\xd7\x19\x1b\xd9\xb9\xf0\x7d\x8f\x15\x5a\xa4\xa6\x18\x85\x65\x3d\x57\xb7\x2f\xb4\x05\xcf\xc6\x8f\x2b\x69\x6c\x70\x10\xea\xa7\xb1\x55\x5a\x76\xb6\x80\xa5\x2c\x6a\x7a\x6e\xbe\xf6\x6d\x06\xe4\x51\x67\x29\x0a\x57\xf7\xe5\x2e\x39\xf0\xe3\x3b\xe2\x4d\x25\xec\xc8\x73\x73\x4a\x8d\x0c\xd7\xfd\x74\x48\xb7\x72\x9a\x50\x3d\x34\xf3\x34\xe1\x16\x85\x7f\xd9\x80\x6d\x5a\xa9\x4e\x78\xdc\x8e\xac\x44\xd3\x84\x7b\xfe\xfb\xe7\x74\xfa\x34\xe2\x2f\x01\x76\xbc\x6d\x8d\x02\x00\x00"
 
 func sqlite3QuerytypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -658,7 +1523,47 @@ func sqlite3QuerytypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _sqlite3TypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x98\xdf\x53\xe3\x36\x10\xc7\x9f\xed\xbf\x62\xcf\xd3\x19\xec\x36\xe7\xb4\xaf\xcc\x64\x3a\xf4\xc8\x4d\x99\x72\xc0\x40\x68\xef\x8d\x28\xf1\x86\xa8\xd8\x52\x90\xe4\x40\xc6\xe3\xff\xbd\x23\xc9\x36\xfe\x45\x70\x28\xf7\x70\xc9\xc5\x96\xbe\x5a\xef\x57\xfb\x59\x99\x2c\xfb\x0c\x3f\xc9\x35\x17\x0a\x8e\x27\xe0\x9b\xff\x31\x92\x20\x84\x17\xfa\xd3\x43\x21\x3c\xf0\x04\x4a\x0f\x3c\xf9\x18\x4b\xa5\x7f\x46\x0b\x0f\xbc\xef\x97\xe7\xfc\xde\x0b\xe0\x73\x9e\xbb\x46\x45\x91\x45\x8c\x56\x65\xb9\xc6\x84\x40\x78\x53\x7c\xcf\xf4\x1d\xfb\xa9\x55\x5f\xe6\xd0\x15\x84\x5f\x78\x92\x20\x53\xe6\xda\x78\x0c\x59\xf6\x72\xa9\x18\x85\xb1\xc4\xfa\x6d\x13\x59\x9e\x83\xc0\x8d\x40\x89\x4c\x49\x20\x20\xf8\x13\xac\x04\x4f\xe0\x28\xcb\xca\x58\xf2\xfc\x28\xb4\x0a\x2c\xd2\x62\x6a\xb7\xc1\x86\x82\x54\x22\x5d\x2a\xc8\xcc\x20\x41\xd8\x3d\x42\xf8\x95\x62\x1c\x49\x3d\xdc\xa9\x0f\xcd\x32\x10\x68\x04\xc2\x99\xfe\xcc\x73\x98\xff\x2b\x39\x3b\xf6\x6c\xc4\xb1\xfe\x97\x26\xac\x18\xef\xcd\xa1\x7a\x98\xd6\xad\x7a\x44\x65\x12\xae\x04\x4d\x88\xd8\xfd\x85\x3b\x7d\xd5\x75\xc6\x63\x78\xe6\xb0\x32\xa1\xb8\xce\x1d\x3e\x53\xa9\xe4\x08\xee\x22\x8c\x51\x61\x04\x0b\xce\x63\x37\xcb\x4a\x99\xdc\xd5\x3f\xba\x42\xe3\x31\x4c\xcd\x54\x88\x50\xa1\x48\x28\x43\xa9\x87\xa9\x75\x33\x0f\x56\x1f\x28\x33\x77\x22\xa2\xc8\x82\x48\x0c\xdd\x55\xca\x96\xe0\xeb\x84\xda\x2d\x92\xe7\xf0\x73\x6d\x5e\x50\xa8\xfb\x81\x09\x08\x32\xd7\x11\xa8\x52\xc1\xa0\x3e\x25\x2c\xc2\xd7\x51\x8e\xc7\x70\x5a\x3c\xc2\x46\xf0\x2d\x8d\x74\x3c\x6c\xc5\x45\x42\x14\xe5\xac\x2f\xb6\x35\x91\xb0\x40\x64\x50\x3e\xbb\x71\xf9\xc0\x38\x8b\x45\xdf\x0a\xb4\x58\xa2\x88\xf4\x8c\x49\x14\x0a\xa8\xf9\x92\x9d\xc0\x14\x3f\x34\x0a\x2b\xe8\x47\x0b\xf8\x7e\x79\xfa\x47\x00\x28\x04\x17\x3a\x98\x2d\x11\xfa\x87\xbd\x60\xed\xa7\x2b\x20\xb1\x40\x12\xed\xac\x3b\x23\x58\x10\x1a\xbb\x0e\x5d\xf5\x26\x57\xab\x94\xcf\x64\x54\x64\x78\x81\x4f\xbe\x67\x83\x87\x15\xa1\x31\x46\xc7\x4d\x49\xe9\x05\xae\x93\xbb\xd5\xde\xb1\x65\xfa\x8d\xb0\x94\xc4\x57\x0f\x60\x4a\x60\x3c\x06\xf9\x18\x17\x39\x80\xc7\x14\xc5\x6e\x04\x1b\xbb\xc9\xe0\x01\x77\x90\xa4\x52\xc1\x02\x4b\x3b\x23\xd7\x59\x72\x26\x15\x58\x58\xc0\x04\xe6\x67\x17\x37\xd3\xeb\x19\x9c\x5d\xcc\x2e\xa1\x5e\x9b\xe0\xcf\xe1\x17\xd7\x71\xe6\x59\x06\x4b\x1e\x6b\xea\xc8\x5a\xf9\x15\x37\x03\xf8\xfb\xe4\xfc\x76\x7a\xd3\x1a\xbd\x25\x71\xdf\xe0\xb9\x4d\x9e\x48\x99\x8d\xd5\x75\x0c\xa6\x7c\x1b\xcd\x48\xaf\x6f\x8a\xaa\xb9\x58\x95\xcd\xc0\x75\xee\x46\xc6\x89\x09\x44\x8b\x70\xfa\x8c\xcb\x03\xa6\xd2\x95\x99\xfa\x69\x02\x8c\xc6\x2d\x43\x4c\xa2\x4d\x36\x51\xd9\xec\x23\x5b\xa2\x41\x4c\xd7\xcb\x09\x28\x91\xa2\xa9\x6f\x8d\xbe\x41\x3e\x94\xf9\x87\xc5\x0e\x48\xaa\x38\x65\x4b\x81\x9a\xa2\x1f\x64\x48\x8d\x2c\xe5\x86\x3e\xc0\xa1\x3d\xb3\xff\x97\x65\x3d\xba\x81\xae\x6d\x69\x5d\x3c\x3e\xd0\xc6\x7e\xb9\x41\xbe\x0a\x54\x82\xe2\x16\x81\x46\xae\x43\xa3\x6a\x7d\x81\x32\x3c\x27\x52\xd9\xda\x3f\x8b\xfc\x43\x36\x4a\xdd\x60\xc2\xa2\x57\x37\x8e\xa6\x4c\x37\x74\x98\x40\xeb\x46\xd1\xb9\x7c\x1a\x05\x6f\x6f\x3d\xdb\x5a\x2a\x52\x32\x1a\xbf\xf4\x19\x86\xe0\xbf\xa4\x31\x49\x63\x45\xf7\xe4\xd2\xde\x08\xc0\xf3\xca\xbd\x7c\xbb\x89\x88\x42\x48\xcd\x57\x17\xad\x9d\x46\xe4\xbc\xc9\x56\xab\xd8\xc3\xd6\x0e\x5c\x0b\xba\x46\x1c\x25\x3b\x52\x4d\xba\x6a\x6b\x3e\xbd\xca\xd7\x3e\xc0\xda\x47\xa8\x00\xab\x55\x81\xf1\x42\x56\x03\xd6\xf8\x59\xae\x69\xfb\x4b\x7d\xb5\xde\x06\x34\x74\xb5\x84\x88\x07\xdd\x11\xb9\xb0\xd2\x94\xb3\x97\x25\xad\x53\xf7\x0a\x7c\x88\x91\x75\xfd\x80\x00\x7e\x33\x7e\x38\x25\x5d\x4c\xfd\xc1\x13\x55\x6b\x58\xf2\x64\xc3\x25\x55\x58\xdf\x83\x7a\x68\x1b\x26\xb7\x57\xa7\x27\xb3\x69\x93\x23\x37\xd3\x19\xd8\xf2\x6e\xc2\xc4\xe8\x37\x37\x8b\x37\x02\x0f\x7e\xed\x09\xae\x04\x84\xe3\xcc\xe1\x9f\x3f\xa7\xd7\x66\x89\x86\x50\xcf\x24\x0f\x4e\x2e\x4e\x41\xef\x32\x4d\x15\xa7\xc5\x15\x67\x1f\x59\x86\xed\x61\xc8\xf3\x0e\x42\x3a\x63\xec\x64\x83\x0e\x67\x60\x3f\xf9\x51\xab\xd7\xb9\xe2\x54\x67\xe9\xae\xe9\x1f\xe2\x6c\xd3\xd4\x57\x91\xdf\xe7\x68\x63\xf4\x17\x1e\x5b\x7a\xfd\x7e\xb0\x8b\x03\x80\x3e\x82\x01\xe8\x3c\xc0\xba\x0f\x5d\xb2\xeb\x97\xa5\x70\xd9\x14\x6e\xc8\x16\x41\x92\x6d\x0f\x33\x3b\xc7\xd1\xb7\x99\xa9\xd5\xfa\x88\xd9\xc6\x52\x75\xca\xaf\x63\xa9\x31\xa2\xa2\x6f\x45\x9f\xbe\x51\xd5\xf9\xd7\x9c\x3b\x5b\xc7\x9b\xa2\x25\x48\x45\x94\x39\xb7\x48\xe0\x09\x55\x1a\x86\x51\x8a\xfa\xe9\x62\xb2\x7c\x00\xbe\x2a\xde\x8a\x80\xab\x35\x0a\x50\x6b\xc2\x1a\x88\xaa\x75\xae\xea\x65\xa3\xe0\x6e\x37\x67\xef\x7f\x95\x18\x7c\x88\xef\x6d\x33\x7b\xbb\x4c\xad\xdf\x96\xb6\x77\x5b\xc7\xde\xce\xd1\x56\x18\xde\x09\x86\x37\x82\x36\x2d\x4e\xa7\xe7\xd3\xd9\x14\xbe\x5e\x5f\x7e\x6b\x22\xe3\xbd\xf0\x6e\x55\xfd\xde\xa2\x7f\x05\x82\x45\x55\x0d\xad\xe3\xfd\x2a\xdd\x43\x5b\xb3\x5a\xcd\xdf\x0b\xea\x80\x6d\xf1\xf5\xdd\x09\xdb\xc7\xc6\xb7\x92\x34\x04\x3a\xfb\xd2\x33\x64\xfe\xd0\xc4\x94\xe7\xc9\xe2\x6c\x5b\xbe\x6b\x3b\xfd\xfb\xb8\x38\x88\xb6\x8e\x9f\x75\xa1\xff\x02\x00\x00\xff\xff\x0b\x99\xa0\x25\xbb\x12\x00\x00"
+var _sqlite3RepositoryGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\xdf\x6b\xdb\x30\x10\x7e\x8e\xff\x8a\x23\x8c\x61\x97\xd6\x7d\x2f\xec\x25\x84\x42\x61\xb4\xb0\xb6\xac\x30\xc6\x50\x6c\x39\x11\xd8\xb2\x27\xc9\xa9\x8b\xc9\xff\xbe\x3b\xcb\x4e\x94\x38\x6e\x33\xea\xad\x2f\xfe\x21\xe9\x3e\x7d\xf7\x9d\xee\x4e\x75\x7d\x01\x9f\xf4\x2a\x57\x06\xae\xbe\x80\xdf\x7c\x49\x96\x71\x08\x6f\xe9\x39\xe5\x4a\x4d\x61\x1a\x2f\xf0\x91\x17\x46\xe3\xcb\x54\xd3\x00\x2e\x36\x1b\xaf\x26\x53\x75\xcc\xd6\x2f\x94\x90\xa6\x83\xf8\xc6\x8b\x5c\x0b\x93\xab\x17\x34\x3c\x0e\xd8\x51\x08\xaf\x05\x4f\x63\xed\xe0\x97\x45\xcc\x0c\x27\x7c\x89\xb8\x09\x4d\xd3\x16\x3a\x2b\x53\x23\xba\xf5\x9d\xb9\x6f\x57\x8b\xa5\xcc\x15\x7a\x10\xe0\x76\xd3\x06\xea\xf2\x12\xea\xba\xe5\xb3\xd9\xdc\x1b\x9a\x16\x1a\xcc\x0a\x5f\xd2\x70\x95\xb0\x88\x43\x92\x2b\xd0\x38\x25\xe4\x12\x98\x8c\x41\x71\xa3\x04\x5f\xd3\xaf\x63\x0c\x2a\x7f\xd6\xa1\x67\x5e\x0a\x7e\x04\x73\x0b\x56\x7b\x13\xdc\xf4\xbb\x30\xab\x87\x8a\x90\x4a\x25\x35\xb0\xbe\x45\xa9\x09\xdf\x54\xe7\xa0\xcb\x68\x05\xcc\x92\x32\x8a\x49\xcd\x22\x23\x72\x09\x79\xd2\x40\x3d\xdd\x59\xb0\xd0\x9b\xd8\x0f\xdf\x54\x38\x38\x9f\x05\x3d\x50\xcf\x9b\xdc\x48\xcd\x95\xf1\x71\x26\x32\x55\xc1\x14\xcb\x70\x12\xff\x5a\xa1\xd0\x8d\x33\xc7\xea\x1c\x28\x14\x10\x86\xe1\xd3\xdd\x5d\x41\xbb\x06\x80\x71\xca\x55\x13\x03\x91\x6c\xc3\x80\x52\x4e\x1e\x9b\xcf\xf7\x43\x4f\xee\xd9\x7a\x04\x18\x62\xc8\x31\x5a\x44\x6d\xce\x53\x3e\x06\x35\xc2\xc4\x08\x2c\xf1\x0c\xdd\xc8\x98\x57\x5c\x5b\x1c\x54\x22\xbc\x2e\x65\xd4\x02\x74\xea\xd8\x45\xe1\x8d\x7e\x94\xe2\x77\x69\x55\xc2\xd5\x0a\xcf\x7d\xc6\xcd\x2a\x8f\x21\xc4\xb1\x3e\xad\x65\xde\xfc\xa4\x42\x6f\x4f\x3e\x24\x2c\xd5\x14\xff\x72\x88\xa1\xdf\xb8\xf0\x80\xc7\x6f\xe7\x47\xc3\x3a\xb0\x52\x90\xf9\x3f\x21\xf0\x15\x57\x6d\x49\xfc\xf8\xf9\x06\x0d\x1b\x91\xdd\x27\xae\x6e\xc7\x36\xde\x41\x3a\xee\xea\x03\xe5\xe4\x70\x8e\x60\x5e\x2c\xb9\xe4\x0a\x4f\x5f\x0c\x09\x86\x41\x53\x9a\x12\x98\x75\x52\x37\x19\xec\xa6\xea\x33\x26\x0a\xcc\x67\xfd\x6c\x75\xb6\xd4\xe8\x6a\x64\x28\x5f\xe7\xb3\x26\x9f\x5a\x82\xb7\xfc\xf9\xb8\x41\xa4\x38\x32\x38\x20\xea\xcc\x5b\xb6\xf1\x22\xf4\x88\xe3\x20\x8e\x1f\x2f\xda\xf4\x3d\x3b\x8e\x83\x8c\x6c\xdd\x80\xcf\x47\x17\xd4\xf3\xd9\x15\x6e\xd3\x09\xfa\x5a\xa9\xe9\xb1\x33\x55\xcb\x8e\xce\x44\x57\xc3\x0f\xd2\x63\x67\x14\xc0\x5b\x15\xc7\x21\x3b\xe8\xaf\xa9\x82\x96\xaa\x2d\x4e\x58\x2c\xe9\x65\x0b\x9e\x1b\x35\x93\x37\x43\x58\x65\xd8\x82\x69\xfe\x77\x4c\x47\x2a\x7c\x8e\x43\xae\x71\xb8\x07\xcf\xd4\xb2\x05\xdf\xf2\x0a\xe7\x33\x8b\x89\x90\xe4\x6e\xbf\x7e\x92\x00\xb6\x84\x82\x1d\xec\x0b\x20\xe4\x3b\x04\x18\xa9\x3c\x0f\x09\xb0\x07\x7f\x82\x00\xe4\x2e\x15\x7a\xd0\xf8\x18\x39\xd6\xa3\x34\x90\x21\x47\x1d\xf0\x13\xe3\xdc\xd6\x37\xf2\xd8\x36\x22\x88\x9b\x57\xdf\xeb\x44\xe5\xd9\x3b\xfc\x1e\xa9\xcd\x0d\x79\xbe\x07\x7f\xa2\xef\xa7\x74\xcb\xb6\xec\x1f\x74\x25\x88\x58\x9a\xea\x46\x1e\x67\x71\xf8\x4a\x6f\x1d\x94\xca\xed\x46\xae\x5e\x1f\xd0\x8b\xf7\xb5\x75\x3d\x7b\x55\xd7\x01\x22\x0d\x05\xcb\xa6\x23\xe2\x9e\xbb\xb6\xe5\x7f\xbc\x2e\xa7\x5e\x11\xfe\xab\x3a\xc3\x37\x11\x3a\x90\x6b\xae\x44\xf2\x32\x74\x1d\xc9\x8a\x94\x67\x5c\x1a\xdd\xbf\x63\xaf\x99\x82\x5f\xfd\x46\xf8\xa5\x3d\x14\x7d\xb9\x7d\x29\xd2\xc0\xfb\x03\x3f\x5e\x2e\xa2\x70\x0d\x00\x00"
+
+func sqlite3RepositoryGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3RepositoryGoTpl,
+		"sqlite3.repository.go.tpl",
+	)
+}
+
+func sqlite3RepositoryGoTpl() (*asset, error) {
+	bytes, err := sqlite3RepositoryGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.repository.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sqlite3ServerGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe5\x58\x5b\x8f\xd3\x38\x14\x7e\x6e\x7e\x85\xa9\xd0\x2a\x41\x25\xbc\x0f\x9a\x87\x69\xbb\xa0\x91\x80\x19\xc1\xc0\xee\xab\x9b\xb8\x6d\xd4\x5c\x8c\xed\x30\xed\x46\xf9\xef\x9c\x63\xe7\xda\xba\x99\xb6\x20\x04\xda\x97\x4c\x63\xfb\xdc\xbe\xf3\xf9\xb3\x33\x45\xf1\x92\x3c\x57\xe4\xea\x9a\xf8\x0f\x3b\xce\xc8\xcb\xb2\x74\x0a\x1c\xe3\x9b\x15\x8e\xbe\xcd\xee\x69\xb0\xa1\x2b\xf6\x81\x26\x8c\xf8\xef\xb3\x90\xc5\x0f\xd9\xfd\x74\x96\xa5\xcb\x68\xe5\xdf\x26\x3c\x13\xea\x13\x13\xdf\xa2\xa0\x67\x8c\xb6\x7c\x13\xa5\x21\xdb\xee\x7b\x96\x6b\x30\xc1\x79\x57\xff\x4a\xd1\xf1\x73\xe5\xeb\x00\x63\x26\xc4\x98\x8c\xc3\x05\x3c\x02\xb5\x85\xa7\x60\x5f\xf5\x53\xc2\x93\xeb\xe1\x2c\x96\x63\xaf\xe3\xce\xe6\xcf\xe5\x22\x4a\x55\xeb\x16\x33\x64\x02\xcc\x4e\x0d\x50\xa7\x09\xa5\xf8\x6f\x22\x16\x87\xb2\x13\x92\xc7\xb9\xa0\xb1\x2e\x51\xff\x8a\xfe\x6b\x2b\xc0\x45\xaf\x5e\x91\xa2\x68\x46\xca\xd2\x44\x27\x91\x24\x6a\xcd\x0e\xa7\x10\xba\x6c\xa9\xe7\xb8\xc8\x54\x46\xb8\x81\x5c\xaf\xc4\x3e\x94\xe5\x04\x7d\xe6\x32\x4a\x57\x7a\xd9\x8a\xa5\x4c\x50\xc5\x42\xb2\xcc\xd3\x40\x92\x65\x26\xfa\x6e\xc9\x63\xa4\xd6\x64\x3e\xf5\x1d\x85\xd8\xdb\xb2\x91\x4a\xe4\x81\x22\x85\x33\x6a\xc3\xf8\x9f\xd3\x28\xe1\x31\x4b\x58\x0a\xce\x6d\x89\x1a\x63\xc7\x19\xcd\xa7\xe4\xdf\xbb\xf9\x14\x7e\x41\x66\x37\xb9\x02\xb4\x10\x06\x5a\xff\x32\xb5\x06\x34\x8e\x65\x5d\xdc\xc7\xfb\x19\x49\x18\xcc\x87\xd2\xe4\x07\x83\x91\x20\xd0\x80\x9c\x49\xa5\x1d\x2d\x18\x94\xc2\x70\x62\x47\x44\x9e\xfa\xe4\x26\x8e\x3b\x8e\xa8\xe8\x44\x08\xc9\xe3\x9a\xa5\x24\x8d\x62\xdf\x19\xb5\x19\x20\x22\x2e\xb4\x96\x04\x19\x14\xb1\x55\xfe\xcc\xfc\x9d\x54\xb1\xb1\x70\xc0\x71\x82\x71\x09\x90\x84\x89\x25\x0d\x58\x51\x7a\x04\xa8\x91\x09\xa7\x74\x10\xeb\x0f\xec\xd1\x06\x5a\x20\x18\xc0\x0e\x89\x58\x21\x35\x0d\x0a\x17\xbe\x83\x49\x1c\xf1\xe1\x86\x0b\x8d\x9c\x47\x5e\xd8\x7c\x40\x3f\x04\x53\xb9\x48\xc9\x5f\x96\xe9\x62\x3e\xbd\x82\x00\x65\x95\x25\x1d\xc2\xfd\x10\x76\x83\x3a\xd4\x5d\x25\xe8\x62\x84\x6a\xff\x00\x67\x6c\xf9\x78\xad\xe7\x1f\x00\x15\xab\x8a\x96\xa4\x17\xce\x6f\x5b\x76\x7d\x8d\x5d\xc4\x45\xc8\x81\x87\xbb\xf9\xdd\x55\xa7\xb4\x03\x1e\xd9\x78\x09\xa6\x15\x6c\xe0\xc9\x19\x01\x3c\xf5\xfb\x91\xa0\x58\x4d\x9d\xbd\x4e\xdb\xab\x30\x7d\xcb\x54\x7f\x2b\xad\x98\xb2\x6c\x5c\xb2\xd8\x91\x08\x26\x40\x68\x12\x2a\x76\x64\xc3\x76\xe7\xa0\xba\x1f\xc5\x0e\x2e\xa2\xf9\xa2\xb3\x3d\xf7\xad\x3e\x9a\xad\xe3\x11\x77\x78\x95\xe4\x59\x2a\xd9\xc4\x34\xc3\xab\xba\x01\x2f\x28\x61\x7d\x7c\x68\x1f\x9f\xf1\xbe\xaf\xb1\xc1\xea\xb5\xb6\x7e\xd6\xf6\xad\x05\x5f\x47\xd1\x1d\x00\x43\xbe\x00\x5c\x64\x47\x45\x2b\xbd\x05\x91\xd4\xb2\x53\xc7\x9d\x74\xb3\xc1\xc5\x00\x64\x8d\x0c\x0c\x41\x2e\x54\x60\x6d\xfd\x64\xe7\x53\x78\x5f\x65\x9c\x0a\x9a\xc4\x91\xec\xaa\x35\x01\x75\x03\x2d\xa0\xb1\x44\x1f\x5e\x53\xf0\x91\x94\xb7\xd9\x27\x45\x55\x2e\x5d\x58\xe3\x19\xfa\xf0\x45\x2f\xa9\x06\x81\xe6\x08\x74\xbb\x05\x3c\x19\xa1\x06\xa5\xb7\xbb\x9f\x68\x58\x41\xf0\xb8\xe1\x8b\xde\x11\x59\x96\x57\x30\x04\x88\x21\xd1\x0d\x65\xdf\x41\xed\xda\x9d\x39\x97\x80\x74\x88\x46\x4b\xda\x66\x7c\x02\x13\x49\x84\xe7\x06\x4d\x43\xd8\x4e\x4b\xc9\x14\x12\x19\x17\x56\x32\x7c\x0e\x89\x0f\xe2\x9e\xc6\xe2\x03\x33\x3b\x8d\x2d\xcb\x2e\xe7\xf1\x81\xb3\x73\x88\x3c\x12\xd9\xa3\xec\x53\xb4\x76\xf3\xcf\x9a\x09\x36\x48\xd1\x09\xa8\xfd\x3b\x44\xdd\x05\x5d\x74\x51\x7c\xf5\x9b\xe7\xe1\xc4\x9d\x6e\x41\x33\x63\x5e\x3d\xef\x74\x36\xf1\x85\x1c\xa0\xa9\x44\x9e\x4a\x17\xd3\xff\x21\x82\x1e\x6d\x45\x9f\xa1\xcd\x34\x32\x54\xf6\x28\x3a\xd3\x07\x67\x5f\x41\x23\x70\x20\xac\xda\x5a\x09\xfd\x05\x94\xb4\xc4\x39\x8d\x94\x16\x43\x3b\x2d\xad\x0b\x2f\x27\xa6\xc5\xdd\x59\xd4\x84\x38\xc8\x1c\xad\xb5\x7b\x3a\xd1\x3d\x57\xbb\xb6\x52\x4b\x9d\xff\x37\xe6\xea\x06\xc0\x13\xe9\xdf\xa6\xdf\xe0\x1a\x1b\xde\x88\x55\x8e\x77\x3f\xc8\x2b\x89\xa4\xbe\xcd\xf4\x33\xd3\xda\x88\x2d\x87\xb0\xfa\xae\xaa\xcd\xa0\x00\xad\xe8\x6d\xcd\xc7\x52\xf2\xbf\x54\xeb\xdd\xe1\xf2\x4e\x49\x11\xcc\xab\x05\x5e\x93\x16\x03\x59\x1b\x3c\x5b\x9a\x4c\xee\xa7\x0f\x99\xde\x23\xee\xb1\x5c\x9f\xdc\x31\x97\x24\xe9\x8c\xd0\x61\x45\x8a\x9a\x13\xb7\x7a\x23\x0c\xaa\xc8\x39\x8a\xf0\xcb\xce\xad\x81\xad\x70\xca\xd1\x55\xf1\x28\xa1\x72\xb3\x34\x87\xb6\x8f\xcd\x43\xb9\xf8\xcc\xc3\x03\xb9\xc8\xf5\x98\x91\x8b\x6a\x7d\xa5\x13\x6c\x0b\xfa\x74\x40\x56\xd0\x17\x3d\x6b\xec\x74\x18\x30\x40\xef\x1d\x6d\x99\x10\xb8\x9d\xb6\x97\xe5\xc4\x7c\x53\xe0\x02\xbd\x7e\x4d\x25\x49\xf1\x83\x4c\xad\xe5\x39\x32\x64\xc9\xff\x34\x19\xb2\x18\xda\x65\xc8\xba\xf0\x72\x19\xb2\xb8\xfb\xfd\x65\xc8\x72\xbd\xac\x3e\xfc\xf1\x96\xe9\x8f\xe1\xad\x97\x8c\xe7\xfd\x09\x17\x4f\xfc\xff\x83\x7d\x0b\xdf\x70\x1e\xef\x74\x98\xf7\x40\x4e\xb7\x5f\xc7\x31\xf8\xcd\x8c\xe9\x2f\x9a\xfd\x3a\x59\x33\x31\x67\x59\x9c\x27\xa9\x7c\xe2\x8e\x84\x45\xff\x96\x1a\x37\xb0\xcf\x4e\xd5\xb8\xea\x50\x42\xe5\x99\xb3\x98\xed\xeb\x5a\xa8\xc7\x7e\xfe\x27\xa6\x25\xd6\x69\x1a\x64\x31\xb4\x6b\x90\x75\xe1\xe5\x1a\x64\x71\xf7\xff\xf9\xdc\xb4\xec\x1f\x83\xc7\x4f\xba\x16\x58\xa8\x3d\xd0\xbe\xa2\x21\xf0\x77\x10\x39\x49\xb3\x21\x16\x00\x00"
+
+func sqlite3ServerGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3ServerGoTpl,
+		"sqlite3.server.go.tpl",
+	)
+}
+
+func sqlite3ServerGoTpl() (*asset, error) {
+	bytes, err := sqlite3ServerGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.server.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sqlite3TypeGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x5c\x6d\x73\xdb\xb8\x11\xfe\x2c\xfd\x0a\x1c\xc7\x6d\xc4\xb3\x4e\x49\x3a\x9d\x7e\x48\xc7\x9d\x49\x2e\xce\x5d\x7a\xb9\xf8\x6a\x3b\x77\x99\xc9\x64\x1c\x9a\x84\x6c\x8c\x29\x52\x21\x29\xc7\xae\xcf\xff\xbd\xbb\x0b\x80\x04\x48\xf0\x45\x92\x9d\xe4\xda\x7e\xb0\x2c\x91\x20\xb0\x58\xec\xcb\xb3\xbb\x00\x6f\x6e\xbe\x63\x3b\xf9\x79\x9a\x15\xec\xc9\x1e\x9b\xd0\xb7\x24\x58\x70\x36\x7b\x8d\x9f\x1e\xcf\x32\x8f\x79\x19\xcf\xe1\x33\x81\xbf\xfc\x63\x9c\x17\x78\x29\x3a\x85\x8f\xb7\x07\xaf\xd2\x33\xbc\x93\x7e\xf2\x7c\xf6\xdd\xed\xed\xf8\x06\xfb\x2b\x82\xd3\x98\xcb\xfe\xc2\x73\xbe\x08\xd8\xec\x48\xfd\x3f\xc6\x3b\xf2\x13\xfb\x37\x9e\x81\x8e\x8d\xc7\xf4\x8f\xfe\x07\xc5\x9c\xcd\xbe\x4f\x17\x0b\x9e\x14\x74\xed\xe1\x43\x76\x73\x53\x5d\x52\xad\x78\x9c\x73\xf3\x36\x4d\xee\xf6\x96\x65\x7c\x09\x73\x83\x86\x39\x0b\x58\x96\x7e\x62\xf3\x2c\x5d\xb0\x07\xd0\x44\x4d\xe2\xf6\xf6\xc1\x4c\xf6\x90\x44\xd8\x59\x71\xbd\xe4\x56\x0f\xc0\x8d\x55\x58\xb0\x1b\x6a\x94\x05\xc9\x19\x10\xfd\x42\xf0\x38\xca\xb1\xf9\xc8\x6c\x0a\xdf\x33\x4e\x1d\xcc\x8e\xf1\xf3\xf6\x16\xae\x7c\x12\xc5\xb9\xea\xa4\x08\xce\x72\x36\xc3\x96\x1f\xf0\x31\xf8\x82\xff\xe5\xc0\xac\x9c\x57\x8c\x7f\xab\x45\xa2\x7a\x35\x89\xd3\xfc\xf8\x25\xe3\x71\x1a\x48\x0a\xc6\x23\x78\x12\x7e\x07\x05\x8f\x70\x86\xf9\x94\xe5\xbc\x60\xa7\xd7\xac\x38\xe7\xec\x15\x34\x33\x48\xfc\x96\xcd\x57\x49\x98\x8f\x47\x87\x3c\x36\x67\x89\x3f\x91\x96\xfc\x42\x2c\x89\x4a\x20\xcd\x3d\xb0\x58\x04\xd9\xf5\x4f\xfc\xba\x1c\xfa\x2a\x65\x73\x62\xc7\x78\x74\xc2\xaf\x44\x5e\x00\x01\x27\x11\x8f\x39\xd2\x73\x9a\xa6\xf1\xb8\x9c\xe3\xb8\x65\x06\xf6\x9a\x21\x2d\xe7\x29\xf2\x17\x27\x80\x33\x2a\xa7\x57\xa4\xb0\x8a\x26\xc7\x61\x96\x02\x96\x76\x9e\x66\x5c\x9c\x25\xec\x82\x5f\xe7\xb3\xc6\x12\x62\x87\xae\x55\x34\x69\xb0\xd6\xf1\x5b\xfc\x71\xc8\xe7\xb8\x88\xe5\x45\x45\x24\x2d\x7d\xf7\x2a\x59\x3f\x70\x72\xc7\x30\x8f\x90\x5a\x33\x54\xbd\x9c\xa5\xf3\x86\x08\x86\x69\x92\x17\x6c\xd2\x2e\x65\x3b\x9a\x12\x18\xd7\x24\x76\x0f\xc9\x5a\x66\x22\x29\xe6\xcc\xfb\xd3\x47\xaf\x47\x84\x7c\xbd\x04\x67\x3c\xe1\x99\x08\xcb\x15\xb8\x4a\x8f\xc2\x20\x61\x39\x7c\xe4\xa4\x29\xd0\x63\x4a\x4b\x60\x8c\x36\x1b\xa3\xfc\xb0\x09\xd2\x23\x8d\x8a\x66\x97\x6a\xe0\xab\x7e\x26\xd8\xc3\x55\x7a\x98\x7e\xf2\x19\x98\x98\x34\x03\xd6\x8f\xe0\x0b\x6a\x3f\xdc\x9a\x51\x1b\x78\x8e\x44\x47\x32\x45\xcf\x77\x42\x93\x61\xde\x9f\x3d\x35\x86\x8f\xfd\x8e\x47\x40\x33\x76\xf0\xcd\x1e\x4b\x44\x8c\xdd\x8d\x40\xd9\x56\x59\x82\x57\xc7\xa3\x4e\x19\x45\x85\x20\xd9\xe4\x49\xc8\x25\x37\x35\xf5\x33\x25\xb4\xc0\x47\x10\x11\x6e\x2d\x9d\x1e\x00\xc6\x73\x2c\xaa\x36\x55\x4c\xb6\x92\xe2\x4a\xa6\x15\x96\x17\xbf\xcb\xd5\xad\x71\x90\x09\x6d\x89\xd2\xf9\x00\x6e\xc2\x0f\x98\xd3\x79\x90\x13\xa3\x4a\x1e\x79\xe5\xe8\x1e\x34\x7b\x7b\x50\xaa\x58\x79\x7d\xe2\xa3\xcc\x8b\xe4\x0c\x39\xa5\xe6\x51\x13\x94\x52\xfc\xc6\x72\x46\x52\x66\xf2\xc6\x7c\x72\x3d\x21\x83\xb2\x07\xb9\x92\x68\xd0\x76\x91\xc8\x65\x64\x69\x16\xf1\x6c\x8b\x49\x29\x02\x6a\x53\x52\x57\x61\x42\xef\xde\x37\xa6\xa4\x2f\xdd\xb0\x4a\x71\x76\xc4\x94\xed\xcc\x51\xd2\x2a\x15\x92\x43\xee\x08\xf8\x3a\x65\x65\xd7\x4d\xb5\xda\x99\xeb\xdf\xaa\x11\xf8\x14\xa6\x19\x54\x49\xd6\x9a\xac\x5a\xca\x07\xd1\x3e\x69\xb6\x6d\xc1\xa6\x06\x19\x35\x86\x35\xee\x6f\xc2\xba\xc9\xa7\x73\x9e\x71\x69\xd9\xd9\xcc\xbf\x2b\x16\xfe\x1a\xc4\x2b\x6e\xf3\xed\x52\x5e\x72\x32\x4e\x8e\x4f\x22\xa6\x59\xbe\xad\x90\x49\x0a\x6a\x2c\x93\x17\x89\x4f\xa0\x1f\x3c\x9b\x07\x21\xbf\xb9\xb5\x98\x65\x5c\x97\x1c\x73\x98\x2e\x45\x8b\x25\x33\x29\x3d\x58\x4d\x79\xa9\x2f\x34\xad\x6b\xc7\x84\xd9\x44\xf0\x29\xf6\x07\x4f\xa1\x89\x56\x36\x04\x6d\xb4\xbf\x8d\x28\x29\x62\xea\x12\xa4\x2e\x6f\xcd\x10\x87\x2d\x2f\x99\x43\xe4\x76\x20\x53\x50\x14\x02\xa5\xd8\x21\xe2\x51\x9e\x17\xf0\x4f\xc0\x5f\xa8\xb0\xa8\xf4\x5a\x00\x35\xc0\xb3\x9b\x12\x95\xd3\x25\xc0\x0b\x4a\xd7\xf0\x3f\xf0\x14\x9c\x50\x10\xc7\xe5\x45\x10\xf0\x84\xee\x80\x49\xc6\xae\xf8\x62\x59\x5c\x4f\x59\x00\x1c\xc0\x4e\x86\xac\x13\xde\xb8\x66\x41\xc6\x69\x4d\x12\x18\x11\x17\xc4\x5a\x8f\x76\x2f\x49\x44\x4e\x88\x00\xad\x8a\x3e\xb0\x81\xbe\x4c\x6d\xfe\x4e\xa5\x0f\xf5\x91\xff\xb0\x8e\x31\x4f\xe8\x39\x9f\xed\xed\xb1\x47\xa6\x2b\x44\x0c\x07\x77\xec\x45\x00\x2c\x37\xdd\x64\xbd\xcc\x05\x9b\x92\x13\x1c\xa1\x53\x94\x4f\xc0\x92\x2d\x82\x0b\x3e\xd1\xa4\x4f\x2b\xaa\xc0\x57\xe3\x62\x19\x4d\xac\xa9\x98\xed\x00\xb8\x31\x30\x39\x21\xc1\x02\xb2\x40\xc4\x0f\x9c\x51\x0e\xc0\x39\x3c\x87\x5b\x37\xe8\xb0\x5d\xa0\x68\x14\x06\x39\xad\x4b\x0b\x34\x7a\x02\x4d\x24\xb5\xef\xc4\xfb\x29\x43\x9a\xe0\x4b\x13\x30\x4d\x14\xc7\x08\x39\xf9\xda\xbc\xe1\x8a\x4a\x6d\xd1\x8b\x38\x53\x50\xac\x84\x01\x23\x98\xe7\x3c\x58\xc5\x05\x8d\xa4\x96\xc0\xf3\x88\x57\x53\x36\x5f\x14\xb3\x7d\x5c\xb6\xf9\xc4\x93\x12\xc9\xe6\x81\x88\x79\xf4\x84\xad\x92\x0b\x88\xa8\x12\x0d\x0a\x81\x08\xe0\x01\xb0\x03\xf8\x3b\x32\x70\x87\xe4\x6c\x3e\xfb\x27\x88\xe2\x84\x26\x32\x65\xd0\xd2\xf3\xe5\x64\xa6\x0a\x98\x8c\xa5\x76\xd7\x80\x0f\x48\xf4\xbe\x44\x36\x11\x40\xf1\x6c\x21\x12\x58\x35\xd1\x30\xb2\x4c\xc1\x1f\x30\x38\x78\x27\x0a\x00\x14\x00\x5b\x07\xd8\x14\xd9\x3b\x98\x08\x04\xf9\x36\xca\x68\xa0\x2b\x65\x0c\x9f\xab\xb0\x60\x99\xa5\x97\x22\x42\x7a\x12\x90\x80\x45\x50\x88\x34\x71\xd1\x06\x06\x8b\x9d\x72\x50\x53\x1d\x4f\x50\xf4\xb6\x26\x9d\x6a\xd0\x3e\x42\xd5\x10\x8a\xd2\x97\x49\xce\xe1\x86\xa0\x7f\x79\x83\x30\x65\x13\xd6\xa0\x42\x76\x88\x2d\xc2\xe2\x6a\x19\x64\xc1\x02\x2e\x47\xa7\xec\xed\xc1\xf3\x67\x60\x9a\x96\x30\xc8\x6c\x36\x7b\x7b\x70\xb0\x44\x66\x18\xa0\x19\xe5\xed\x2a\x4d\xe9\x72\x5e\x4a\xe0\x15\x88\x04\xc6\x34\x14\x03\x2b\xad\x55\x76\x73\x26\x87\x02\x1b\x59\x0f\xaa\x99\xf7\xf2\xf5\xd1\xfe\xe1\xb1\x47\xdd\x5c\x06\x19\x01\x6a\x1a\x49\xe2\x64\x58\x82\x20\xce\x78\x10\x5d\x4b\xb1\x98\xb2\xd3\x00\xd5\x1e\xae\x3b\x31\xb3\x0d\xc2\xd3\x2c\x9f\xbd\xe6\x9f\x26\x9e\xe4\x5a\x29\xed\x56\x97\xb9\xe7\x1b\x60\x1d\xa6\x38\xfb\x1e\xee\x02\xe3\x9f\x16\x2f\xa4\x6f\x7a\xb3\x8c\xcc\xdf\x26\x86\x0f\x56\x91\x28\x58\x21\x40\x13\xc0\x0e\x81\xff\x03\xb3\x81\xbf\x66\xaf\xd3\x4f\x13\x19\xd9\x50\xb8\x5d\xef\x53\x87\x50\xe5\x04\x1a\x01\x14\x74\x46\x38\x04\x94\x9c\x92\x1d\x8e\xc0\x5b\xf6\xdc\xa4\x6e\xfb\x9e\xcb\x78\x03\xa6\x19\xa2\x8b\x3a\xe5\x18\xd1\x2a\xe9\x83\x60\x38\xbd\x28\xc3\x9f\x3d\x58\xfa\x67\x74\xdb\x92\xa8\x20\x3b\x23\x79\x9a\x5a\x0b\xe5\xff\xbd\x3b\x64\xd2\x96\x43\xca\xc9\xcf\x41\xb2\x0a\xe2\x5f\x2e\x18\xcd\x0a\x59\xfe\x31\xd6\x34\x7c\x5c\xf1\x0c\x9c\xa3\x09\x64\x17\x2b\xb0\xf1\xa7\x5c\x2b\x73\x44\x8c\x80\x47\x22\x1e\xc6\x55\x1e\x09\x93\x1d\x52\xea\xd8\xcb\xd7\xc7\x07\x92\x3c\x9d\xfd\x81\x9b\x93\x0f\x6c\x17\xe8\xb2\x1c\xd7\x44\x0e\x6a\x82\x4f\xd5\xca\x67\xbf\x3e\x7d\xf5\x66\xff\xa8\xf6\x18\x30\xb8\xf3\xa9\x0f\x2a\x4b\xb2\x4a\xe4\x44\xc6\x23\x4a\x6c\x4d\x24\x91\xc4\x33\xc3\x19\x36\x3a\xaa\xf8\x39\x1e\x9d\x4c\xd5\x32\x44\xa7\xb8\xd6\xd1\xe9\x1c\x4c\xfe\xfe\x15\x0f\x71\xaa\xd6\x62\x6c\xd0\x79\x5f\x88\xbb\x7e\x30\x2b\x13\x63\x83\xd6\x53\xaf\x23\x26\x55\x82\x55\x01\x06\x26\xcc\x38\xda\x97\xff\x89\x85\x75\x64\x45\x46\x22\x92\x8b\xfd\x04\x95\x0e\xd7\xf8\x55\x90\x17\x52\xed\x5e\x3e\x6f\x28\xde\x3d\xac\x77\x99\xd9\x44\x6a\x32\x74\xff\x8a\x9c\x2f\x26\x7c\x70\x29\x13\xfc\x12\x6c\x53\x64\xf1\x07\x88\x9b\x19\xdc\x01\x6f\x3b\x70\x76\x89\x6d\xe1\x4d\x81\x44\x24\xde\x26\xe8\x68\x66\x2b\xbc\x63\x5b\x5c\xf3\x86\xca\xc3\x4e\x44\xe4\xf7\xab\x8a\x95\xeb\x24\x49\x40\x53\xaf\xb9\xa5\xb5\x48\x66\x0d\xcb\x30\xa2\x6c\xa7\x53\xae\x1a\x14\xd8\x6a\x93\x99\x7a\x73\xb4\xff\x6a\xff\xfb\x63\x66\xa9\x46\x63\x3c\x9f\x9a\x4a\x41\x7f\x71\x78\xf0\x73\x43\xc3\xd4\xbd\xdf\x7e\xdc\x3f\xdc\x37\xfb\x22\x4d\x30\xb9\xa0\x30\xf3\x3c\x40\x51\xf2\xd8\xd3\xd7\xcf\x19\xd2\x81\xca\x23\xd5\x25\x73\x8b\x4b\xb3\x0b\x53\x5e\x9a\x66\xf0\x5f\x38\xf0\xa1\xf4\x71\x96\x34\x6e\xd0\xbf\x2b\x43\xe8\xe2\xd1\xe6\xd9\xc2\x12\xc5\x6b\xaf\x1b\xcc\x01\x27\xdb\x4e\x57\x3d\x73\x95\x3e\xc5\x7b\x43\x3c\xae\x8e\x6c\xc5\x1a\x35\x17\x11\x0d\x28\xbc\x94\xa0\xf4\xe5\x59\x52\x81\x83\x5e\x68\x2a\x61\x0b\xfa\x79\x6a\x2f\xe4\xc3\x10\xe3\x60\x87\x98\xee\x5f\x62\x56\x08\x50\x15\x85\xc3\x42\xa2\xb6\x9c\xd2\x0d\x2c\xc5\x34\x43\xb4\x5a\xc6\x22\x04\xa6\xa3\x4e\x42\xe4\x21\x59\x82\x0f\xc1\x13\x30\x52\x46\x0f\x07\x14\x42\xcb\x31\x78\x64\xe2\x61\xd1\x09\x88\xe5\x64\x86\xc3\xe2\x09\x62\x78\x33\x1c\xde\x14\x1e\xcb\x81\xef\x01\x24\x8b\x2e\x94\x4c\x2a\x38\xfd\x63\x80\x65\x71\x7f\x68\x79\xbb\xae\xef\x1c\x2e\x8b\x5e\xbc\x5c\xad\x5b\x05\xc3\x1a\x60\x8a\xb4\x09\xfc\x00\x69\x12\xae\x27\x28\x49\x3b\x76\x6a\xaa\xe4\x1f\x13\x42\x09\xc3\x25\xdc\x0b\x44\x11\x43\x30\x8a\x63\x81\xc2\x73\x1e\x5e\xa0\xde\x68\xab\x04\x5a\x60\xe1\x15\xf0\x54\xf9\xd3\xf9\x9c\x52\x85\x9d\x78\xc5\xee\x1c\xdb\x25\x8d\xcc\x9b\x6a\xa3\xb2\x64\x4a\x63\x93\xb4\x68\x04\x57\xb7\x77\x89\xa4\x5c\x72\x69\xa3\x28\x97\xc2\xad\x0b\x9c\x5c\x40\xad\x06\xcc\x9a\x56\x4f\xe1\xaa\x21\xee\x15\x1b\x4e\x07\x38\x59\xd1\xf0\xb2\xd9\x40\x2f\xeb\x76\xae\x58\x5b\x56\x2e\x98\x4c\x8d\x07\xc3\xe5\xda\x1f\x8b\xba\xdf\xc5\xac\x61\xbc\xca\x82\x58\xfc\x9b\x1b\xe5\x3c\xe5\x86\xa9\x4e\x5d\xf7\xbd\xab\x1c\xfd\xe4\x62\x15\x17\xe2\x3b\x68\x40\x7d\xc9\x90\x29\x2f\xc0\x2e\x2e\x68\x5f\x42\x0a\xfe\xa4\x60\x8b\x14\xa2\xe9\xb7\x07\xcf\x82\x22\x3c\x3f\xc2\x11\xa8\x43\x1e\x84\xe7\xb3\x1e\x69\x7a\xf8\xd0\xa8\x4d\x51\x09\x9c\x32\xd2\x41\x9e\x83\x65\x31\x73\x66\x73\x91\xc1\x18\x22\xc2\x11\xb1\xe3\x8a\x88\x29\xd8\x2c\x11\x9e\x8f\x49\x2c\x3f\xae\x04\x30\x8d\x61\x41\x9a\x87\xab\x42\x80\x88\x62\x38\xc8\xca\x78\x50\x57\x6c\x08\x23\x88\x24\x49\xa3\xd3\x13\x15\x30\x9e\xc4\x69\x78\x71\xb2\x48\x23\xce\x1e\x61\x6f\xe0\xb2\x1e\xfb\xd6\xfe\x0a\x02\x06\x1d\x0c\x6d\x83\x02\xc4\x8e\x77\xef\x4d\x0c\x71\x47\x69\x33\x4f\xe5\xcb\xe0\xb7\x4d\x8d\xdf\x07\x0e\x3a\xc0\x00\x26\xb6\x4f\xa4\xd4\x66\x25\x00\x2a\x93\xdc\x34\x19\x54\x63\x85\x19\x32\x27\x66\xd8\x28\xb5\x26\x53\xc8\xf7\x82\x18\x06\x4e\xaa\x13\x58\x8c\xec\xe9\x0e\x72\xff\x56\xca\xbd\x13\x5b\x6c\xdd\xfb\x60\x80\x91\xaf\xb3\xc4\x65\x0e\xa1\x17\x89\x64\xed\x48\xc4\x8a\x5f\x74\xa1\x00\x69\xc0\x7a\x0a\x8e\xe6\xb3\x7f\x28\x97\x94\xe0\x68\xe5\x65\x49\x43\x02\x77\x4d\xf3\x42\x5d\x82\x1b\x33\x2f\x52\xbf\xe5\x3e\x0a\x97\xdf\xda\x24\x2b\x38\x92\xc6\x17\x69\x1a\x92\x30\x1a\x08\x77\x0c\xbc\xa3\x2e\xe8\x6a\xc9\x21\x5f\x82\xd8\x4d\x3e\x4c\x29\xfe\xe8\x40\x40\x3e\x34\x49\xfc\x77\x7f\x79\xf2\x5e\xcd\xec\x74\x25\x40\x90\xd0\x09\xc0\x6f\xfc\xd7\x56\xc2\x7a\x04\x0f\xa2\x25\x02\x1e\x1b\x15\x29\xe4\x74\xbf\x50\xbc\x7b\x92\xbc\x97\xdc\xa7\x11\xf6\x58\x00\xa0\x31\x89\x26\xf8\xab\x1f\x0c\x65\x06\x18\x1a\xe9\x15\x31\xb0\x5b\x0d\xbc\x61\xa7\x60\x1f\xb1\xf1\xc9\xfa\xc8\xcc\x78\xba\x89\x40\xea\xf2\xa8\x84\xc3\x86\x06\x6b\xf1\xc3\x6d\x09\x15\x8e\x18\xd5\xd2\x61\x43\x64\xb1\x23\xa3\xf9\x7f\xa1\xfc\x2a\x84\x72\xa3\x80\x61\x03\xb1\x2c\xc1\xb6\xc6\x40\xf8\x6c\x37\xe6\x5e\x4b\xe4\x97\x16\xfa\xb2\xf3\x96\xba\xc8\xbd\x81\x0e\xac\x0f\xd6\xd9\x2e\x6e\x41\xf8\xdb\x5f\x27\x02\xcb\xeb\x43\x75\x4a\xfb\xbb\x76\xac\x9e\xaf\x29\x46\xa6\xd7\xeb\x83\xf5\x5d\x4e\xcf\x66\x39\xba\x3d\xc9\x77\x72\xaf\x7b\x72\xd0\x04\x74\xc5\x2c\x9b\x5b\x55\xf1\x84\xb3\x49\x25\xbc\x04\xc5\xeb\xdb\x75\x26\x2b\x42\x12\x2a\x0e\x9f\x01\xec\xf3\x4a\x7c\x27\x41\x06\x93\x2d\x9a\xc9\xb6\x46\xd5\x7c\xa4\xbd\xe7\xaf\x3c\xcb\x01\x7a\x56\xd8\x04\x60\x3a\x76\x78\xa8\xf6\xa9\xec\x67\xd9\x51\x11\xc4\x1c\x82\x50\x99\x30\x50\x9b\x5d\x31\x95\x06\xa1\x2b\xb2\x14\x37\xd4\x95\x95\x6f\x88\x24\x42\xae\x53\x6d\xd8\x11\x26\xa1\x31\xd3\x66\xe1\x97\xde\x32\xb4\x9c\xcf\x06\x65\x68\x07\xa0\xee\xcd\xb4\xc9\xc1\x9c\x39\xb6\x37\xbf\x3c\x7f\x7a\xbc\x2f\xd9\xdc\x48\xb2\x29\x60\x1d\xa5\x3c\x4f\x1e\x14\x36\xb0\x46\xc9\xfa\xa6\xb5\x18\xed\x82\xcc\x72\xed\x4a\xc8\x8c\xbd\x52\x28\x45\x8f\x79\xa6\xc9\xc2\x31\x25\xbb\xcd\xd1\xec\xc1\xf4\x7a\x0c\x1c\x0d\x34\xf4\x02\x63\x30\xbd\x92\xc0\x3c\x6b\x48\x13\x5e\xaa\x47\x65\x68\xdc\x4c\x60\x59\x4b\x37\xb4\xde\xdb\x62\xb2\xc0\x6d\x6a\xdb\xdc\x96\x9f\x92\x2b\xd4\x70\x88\x47\xfb\xc7\xcc\xe1\x13\xa9\x37\x5b\xbb\x54\xb9\x61\xca\x3c\x80\xa5\x75\x1d\x63\xb4\x3b\xf0\x52\x2a\x09\x5a\xd0\x99\xbc\x22\x9b\x45\xfa\x8a\x1e\x89\xb9\x8b\x1c\x72\xc0\xfa\x56\x45\xbb\xce\x31\x39\xe3\x05\x04\xba\x59\x11\xa6\x2b\x94\x4d\xbd\xd3\xa9\xa1\xf4\xc8\x32\x93\x2a\x08\x80\x21\x5c\x62\x93\x20\x8a\x86\x77\x32\x41\xef\x5b\x23\xc8\xf7\x55\xb1\xa5\xdb\x2d\x5a\x5e\x76\x90\xa1\x62\x6a\xab\x92\xe9\x9c\x6b\xbc\x28\x45\x43\x5a\xc3\xba\x59\xb2\xc5\x87\xfc\x8d\xd9\xa2\xb6\x91\x53\x3a\xf8\x56\x0b\x77\x07\xe9\xbf\xaf\x78\xda\x43\xe1\x80\x4c\x3b\xa2\xc2\x07\x98\x60\x89\xc9\xaa\x63\x50\xd6\x99\x7b\x1c\xd0\xfd\xa8\x96\x78\xd4\xf7\x0d\x4f\xd2\x40\xc9\xa3\x6d\xf7\x2b\xfc\xa1\x17\xc4\x71\x80\xa5\x2e\xb4\xca\xf0\x57\xa9\x2d\x79\x5f\xa5\x12\x7a\x09\xda\xdd\x1d\xd7\x93\x13\x30\x8a\xac\x10\x9b\x75\x63\x8d\x42\xad\xca\xf1\x22\x00\x67\x09\x7f\xae\xd2\xf1\x7a\xb5\x63\x7b\x40\xa3\x70\xdc\x59\x39\xde\xb2\x74\xbc\x4d\xed\xf8\x4b\x14\x8f\xeb\x4c\x6a\xab\x1c\x0f\x51\xc5\x2e\xd4\x6c\xbb\x71\xbb\x82\x3c\xc4\x87\x4b\x2c\x8b\x97\xc2\xad\xcf\xed\xa9\x3d\xd4\xe0\x4e\xab\xec\x36\x69\x66\x6d\x27\x75\x05\x75\xf5\xae\x73\x8d\x78\xd3\x24\x96\xb2\xa9\xa5\x56\xa8\x4d\xd3\x93\x1a\x16\x86\x07\x65\xc2\x0c\x0f\x32\x05\x49\x91\xfb\x8e\x2d\xfd\x75\xc0\x4c\x67\xd5\x0a\x4c\x92\xc3\xd5\x85\xce\x9f\xcb\xfc\x32\xf5\x06\x5d\xd0\x01\x2f\x5a\xb5\x99\x71\x92\xaa\x02\xc9\x35\xdd\xa1\xe4\x37\xe2\x3b\xb9\xde\x25\x44\xfe\x1a\x40\x79\xd8\x89\xca\xf5\x69\x8d\x16\x70\x6e\xed\x14\xbf\x27\xac\xae\x0f\x94\xdc\x0f\x64\x0f\x3f\x2b\x66\x0f\xef\x0d\xb4\x53\x2d\xa6\x3a\xff\x54\x0d\xeb\xd8\x98\x6f\x06\xa5\x77\x0d\xfb\xc3\x75\x71\xbf\xcc\x25\x21\xb8\x0e\xe3\x60\x45\x7e\x06\x7f\x74\xef\xe5\xef\x4b\x3a\x95\x6d\x77\x81\x26\x02\xcb\x2e\x0c\xcc\x1e\x1b\xc9\xa8\x96\x2d\xff\xd6\x9e\x7f\xe7\xa6\x7f\x15\xd3\x83\x24\x4c\xca\xc3\x2c\x36\x1a\xd9\xf1\x55\xf1\x46\xca\xe9\xa0\x33\x02\xca\x3c\x88\xfc\x8c\xa7\x6a\x9f\xd7\x88\x38\x23\x8f\x0b\x18\x71\x0e\x1d\x11\x90\x29\x98\xa3\xe3\x93\x1f\x78\xba\x78\x91\xa5\x8b\xdf\x7e\x7a\x86\x79\x42\xaa\x30\x14\xe7\xa4\xb8\x67\x29\xf3\x90\x31\xc8\x3b\x9f\x1c\xf7\x2e\xc3\x5a\xbb\x1a\xad\xc2\x67\xbd\xe3\xf4\x75\x5c\x76\xa9\xcf\x24\xb4\xe6\xf0\xc0\x45\xa0\xfc\x28\xc5\xd7\xd2\xe3\xcd\x3c\xcd\xb1\x99\x81\xf0\xcb\xd3\x5d\x55\xbf\xe6\x61\x87\xb2\x00\x6d\x1c\x72\xa8\x69\x51\xeb\x21\x87\x2a\x8b\x53\x8a\x24\x09\x4b\x25\x94\xf2\x67\x53\x2c\x1f\xd1\x3c\x7a\x65\xac\x92\x9c\xe6\x29\xb6\xb2\xf7\x92\x3f\xf4\x73\xba\x21\xf7\x4b\xfd\x68\xb2\xdb\x30\x40\xa6\xb1\x6f\x96\xb3\xdc\xc1\xd4\x00\x2a\x2d\x44\x7a\x0f\x24\xbb\x10\xaf\xef\x00\x3f\x66\x3a\xc1\xc8\xab\xf7\x67\x10\xac\x03\x2e\xa0\x07\xea\x78\x0b\xea\xca\x06\xd9\x01\x9c\xb8\xd5\xa3\xe2\x99\x82\xad\xfe\x3a\x89\xe9\x3b\x0e\x72\x37\x48\x59\x7f\xf1\x38\xb2\x3b\x34\xea\x97\x94\xdd\xdd\x71\xdd\xd8\xad\x1f\x8c\xae\xc5\xb8\xd1\x7d\xe0\xf2\xb0\x06\xcc\xa1\xd3\xa3\xe0\x92\xb3\x1c\x3e\x06\x1c\x0c\xea\x4f\xc9\x62\x6f\x9b\x24\x64\xeb\xa9\xc9\xf2\x3c\x96\xc9\x1a\xab\x45\xcb\x2c\x71\x10\xc5\x63\x99\x5b\x77\x3c\xda\x92\xbe\xaf\x1e\x2d\x43\xec\xd5\x12\x5b\x4a\x53\xae\x83\x5f\x8a\x2e\xa8\x96\xb0\x04\xe8\x90\x66\x0b\x2c\x92\xa8\x96\x24\xe2\x06\x43\x86\xb0\x4c\x76\xf6\xd9\xb2\xd8\x83\x8e\x53\xb5\x41\x62\xe7\xf6\x90\xce\x13\x55\x1b\xef\xfb\x58\x73\xd7\x87\x73\xdb\x87\x6b\xdf\x47\xff\x8e\x8e\x7b\xdd\xd0\xb1\x65\xe7\xad\xae\xaa\x2d\xf3\xbd\x7e\x3d\x58\x4a\x72\x67\x3d\xb8\xf6\xa0\x2c\xff\x76\x3d\x47\xee\xae\xa6\x4a\x6b\x26\x91\x9b\x03\x58\x39\x98\xad\x8f\x32\x75\xf6\xbe\xe9\x8e\x01\xa7\x5a\x94\x9b\x09\xad\x70\xca\xae\x5e\x2a\xe9\xe7\x1f\x25\x38\x6c\xe4\x87\x24\x3c\xa4\x6d\x83\xab\xde\xc4\x4a\x67\x3a\x45\x44\x8d\xa4\x8a\x48\xca\x8c\x0a\xf3\x96\x17\xf0\x71\xb6\x0a\xb2\xc8\xf3\x99\x95\x5d\xb9\x71\xee\x21\x34\xab\x8b\xf5\x34\x8b\xca\xa1\x50\xbd\xf3\xd3\x79\x8a\xf0\x18\x7a\x33\x77\x39\x08\x6a\x2c\xa2\xbc\x2b\x99\x82\x4d\x8c\x99\x93\x99\xad\x34\xef\x07\xa4\x55\x6b\x1d\x3b\x48\xe2\x6b\x1a\xc5\x1e\x98\x8c\x74\xe3\xdd\x32\x38\x3c\xb6\x4b\x13\x7a\xef\x89\x35\x06\xa5\x62\x14\x66\x73\xe5\x44\x3a\x78\xd2\x66\xdb\xed\xfe\xed\xdd\x80\xb5\x04\x89\x88\xc8\x0b\xdc\x38\x4b\xe5\x4d\x9f\xe0\xd8\x18\xa8\xea\x97\xc3\x36\x06\x5a\xe9\x91\x66\x2e\xe0\xf7\xdf\xe9\x8a\x88\xfa\x93\x03\xf7\x1c\xa5\x6b\x32\xbe\x54\x30\xee\x39\xe5\xc8\xfb\x1f\x8e\xc4\x57\x5f\x51\x24\x6e\x5a\x96\x18\x6c\x2f\x0a\x73\xd2\x22\x7b\x35\x29\x5a\x5e\x54\x62\x84\xca\x27\x37\x82\x24\xe5\x3b\x17\x3a\x19\xd7\xcd\x29\x32\xa9\xf6\x0b\x0e\x0c\xcc\xe3\x30\x62\xd6\x9c\xc8\x14\x63\x62\x1a\x70\x8e\x8c\x60\xe4\x95\x27\x5b\xac\xf4\x96\x2b\xfb\xb5\xc6\xd0\x26\x3b\x0c\xab\xa9\x38\xf3\xf2\x35\xe1\x18\x3b\xca\x16\x89\x31\xa4\xff\x41\x15\xf8\x6c\x97\xa2\xc6\xc0\x38\x1c\x9f\x27\xf6\x57\x12\xbd\x46\x5c\xbe\xdd\x2e\xc6\xd6\xf3\xa7\x37\xf6\x41\x69\xb5\xeb\xc7\xdc\xa4\xbf\x10\x05\x66\x90\x23\x00\x9b\xe0\x58\xe3\x00\x62\x73\x70\x77\x0a\xfb\xa4\x74\x0e\xae\x38\x87\xa0\xc6\x3c\x7a\x61\xcc\xd0\xfd\x72\x32\x7a\x31\x22\xed\x19\x43\x28\xb2\xbc\xb0\x12\x55\x86\xc9\xfd\x1e\x77\x1d\xf0\xac\x7a\xf7\x89\x3c\x95\xa0\x22\x73\x33\xbb\x69\x42\xe4\xa0\x00\xaa\x31\x00\xbe\xc6\x94\x19\xbe\x07\x04\x04\xc8\x7c\x9d\x4d\xd3\x99\x4b\xb4\x41\xe7\x00\xdc\xef\x4a\x94\xba\x46\xef\xc7\x31\x0c\x05\x9d\x04\xa0\x3b\x01\x4b\xf8\x59\x40\x87\x06\xf4\x70\xd8\x1b\x80\x6c\x95\x45\x10\x85\x7e\x5f\x51\x1f\xfd\x6e\x08\x00\x17\xcf\x52\xba\x86\xd6\x49\x71\x0f\xc1\xa1\xfc\x40\x1c\x20\x07\xbe\x69\xbc\x8c\xf1\xee\xce\x0b\x28\xc2\x3d\x4d\xb7\xd2\xed\x9d\x4e\x54\x30\xae\xa9\x79\x5b\xf8\xd1\xa1\xf2\x4e\x63\xe5\xb8\x68\x59\x2f\xc0\x1a\xf5\xcd\x36\x3b\x0d\x53\xb0\xc3\xfa\x0e\x14\xcb\x9d\x00\x92\xdf\x76\x39\xf8\xb1\xda\x3a\xd3\x77\x92\x8c\xd6\x45\xce\xda\xb9\x80\xe6\xfb\x15\xd6\x51\xf0\x41\xfd\x1a\xaa\xdf\x76\xa0\x90\xb4\x51\x96\x8a\x70\x7c\x4f\xbe\x38\xa6\x3c\x81\x08\xea\x9b\xa7\xf3\x42\xd5\x92\xa4\x07\xd6\x46\x55\x3f\x06\x4f\xfd\x08\xa6\xad\x7a\xf2\xa6\x7e\xac\xbc\xea\x82\x4e\xc9\xcc\x74\x48\xd2\xf5\x26\xac\x61\xb5\xde\x4b\xf8\x3b\xbd\x96\x11\x07\x26\xa9\x60\x20\x49\x07\xd5\xb3\x9a\xa9\xaa\x20\x2f\xeb\x98\xb5\xaa\x2c\x96\x54\x75\x28\x81\x4f\x54\x5d\x95\xa9\x66\xdb\x6e\xcc\x5a\xf7\x57\xc8\xc3\x49\x77\x52\x5f\x35\xca\xab\xf5\xf3\x44\x9d\xaf\xda\xaa\xa8\x6f\xb5\x28\x2a\x6c\xac\xaf\x8d\x4f\x0c\x25\xcb\x01\x1c\x31\x0c\x4a\x9d\x21\xd5\xdb\x5e\x25\x55\x77\xfc\x42\x9f\x6a\xb8\xde\xc2\xac\xfb\xbc\xb2\xb3\x2c\x5b\x6e\xa4\xec\x3a\xb0\x5c\xbe\xf5\xcb\x59\x6a\xd5\x59\x2c\x77\xa5\xd5\xd1\x85\x59\xf7\x54\x2a\xd3\x72\x5c\xd7\x5a\xb1\xda\x4e\x89\xc1\xaf\xb7\xb9\x5b\x8b\xeb\x50\x83\x2a\x13\xd5\x6e\x6c\x67\x03\x4d\x6b\xf7\x36\xc6\xc7\x9d\xfb\x13\x1f\x77\x6f\x3c\xb4\xad\xf2\x25\x5a\x19\xe4\x45\x29\xee\x65\x12\x4d\x8a\x7b\xdd\x70\x5f\xf6\xef\xee\x1a\xb4\xbd\x6b\xad\xfd\x5d\xad\x15\x98\x8d\x0a\x30\x5f\x64\x0a\x83\xde\xa8\xd2\x52\xe7\xd9\xf0\xa8\x72\xe7\x19\x65\xab\xc2\x53\xdb\x28\xb8\x7e\x32\xf0\xab\x64\xa9\xeb\xa8\x32\xca\xb9\xb6\x36\x32\x4b\x81\x27\x34\xf4\xfb\xde\x46\x4d\x22\xea\x8a\x5e\xe5\x9c\x2f\xeb\xcd\x4b\x83\x67\xbc\x3e\xd8\x29\xb5\xc3\xa6\x6a\xef\x27\xac\x9f\x9d\xb6\x2c\xa6\x5d\xc0\x1a\x64\x2e\x9b\xc8\xa7\x15\xd3\x18\xaf\xeb\x33\xf9\xd7\x44\x11\xcd\x37\xf2\xb1\x37\x20\x52\x15\x0a\x2a\x23\x00\xf9\x43\x3a\xfc\xc1\xaf\xed\xdb\xa0\xc6\xe3\x2a\x5f\x35\x30\x80\xa3\x84\xd5\x78\xc3\xb3\x81\xeb\x80\xba\xe1\x0c\xf8\x2a\xc0\x50\xeb\x7b\x60\xab\x29\x7d\x96\x97\x11\x7a\x7a\x40\x17\x72\x79\xbe\xff\x6a\x7f\x1b\xe4\xb2\x35\x70\xf9\xbc\xb8\xe5\x8e\x61\x8b\xe4\x1e\x73\xee\xf0\x6d\xd9\xd9\xdb\x8d\x31\x5c\xe8\xc2\xb9\xcf\xa6\x3f\xd6\xeb\xf3\x0c\x77\xbd\x1b\xfc\x6e\xd1\xc2\xe7\xa6\xfe\xbf\x1b\x28\x7c\x6d\xdc\x74\x61\x04\x1b\x0d\xb4\x79\xf7\x3b\x72\xc8\x6e\x7f\x3c\xfe\x0f\x07\xb5\xd6\x7d\xab\x65\x00\x00"
 
 func sqlite3TypeGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -678,7 +1583,47 @@ func sqlite3TypeGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _xo_dbGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x94\xdf\x6f\x9b\x48\x10\xc7\x9f\xcd\x5f\x31\x41\x77\x2a\xa4\x14\x2e\xf7\x18\xc9\x0f\xd7\x6b\x5f\x4e\xf7\xd3\xa9\x4e\x27\x19\x9f\xbc\x86\xc1\x5e\x05\x76\xc9\xce\x82\x63\x59\xfe\xdf\xab\xd9\x05\x17\xd2\xa6\x2f\x89\x59\xe6\x3b\x3f\x3e\xdf\x61\xb3\x0c\xfe\xfb\xeb\xc3\x7b\x90\x04\xf6\x80\x50\xe8\xa6\xd1\x0a\xa4\xb2\x68\x2a\x51\x20\x54\xda\x40\x29\xac\xd8\x09\x42\xd0\x2d\x1a\x61\xa5\x56\x1c\x2c\x2c\x14\x42\xc1\x0e\xa1\x23\x2c\xe1\x28\xed\x21\xc8\x32\xb0\xa7\x16\x09\x2a\xa3\x1b\xa0\xe2\x80\x8d\x80\x37\xe7\xf3\xf8\x33\x7d\xf0\xff\x2f\x97\x37\x69\x90\x65\x1c\xff\xe9\x20\x09\xe8\xa0\xbb\xba\x84\xa3\x36\x8f\x2e\xd1\xb5\x64\x46\x4f\x75\xfa\xe1\x3d\x08\x55\xce\xcf\x3e\x3d\xa7\x01\x97\x1a\xba\xbf\xf6\x7b\x0e\x16\x1f\x9f\xb1\x88\xc8\x1a\xa9\xf6\x09\xa4\x69\x7a\x7d\x79\xbe\xc4\x10\xb1\x78\x85\xd4\xd5\x36\x01\x34\x46\x9b\x38\x58\xfc\xd3\xa1\x39\xbd\x2e\xb9\x75\x1a\x7d\xa4\x17\x8a\x95\x3e\xbe\x2a\x1a\x35\xc1\x25\x08\x1c\xe3\xdf\xf5\x1e\x5a\xa3\x7b\x59\xa2\x47\x5d\xeb\x3d\x54\x9d\x2a\x3c\xbe\xdd\x09\xf6\xa8\x18\x2f\x96\xf0\xd4\xa1\x91\x48\x69\xd0\x0b\x33\x48\x97\x2e\xf6\xd5\x72\x67\xf0\x75\x1e\x0a\xa1\x14\x9a\x7f\x45\xdd\xa1\xf9\xae\xa9\xde\x27\x67\xa3\x6c\xda\x1a\x1b\x54\x16\x76\xda\x1e\x58\xc2\xa9\x66\xb8\x87\xbc\xce\x07\x7a\xaa\xb3\xd2\xc8\x1e\x4d\x3a\xd6\x19\x33\xd3\x60\xca\x8b\x36\xa6\xee\x4c\xb2\x05\x8b\x59\x9a\x01\xd5\x83\x1b\xf1\xa1\x96\x05\xf2\x00\x02\xc8\xfd\xd4\x15\xf8\xe1\xaf\x35\x26\x71\xeb\x8d\x7f\xe7\x12\x3c\x75\xda\xe2\x47\x2a\x44\x8b\x2b\xdc\xe3\xf3\x88\xc1\xb8\x07\xab\xa1\x11\xb6\x38\x00\xba\x88\x12\x8a\x83\x30\xa2\xb0\x68\x08\xa4\xe2\x72\x2e\x93\x67\xff\x55\xaa\xa5\xcf\xd2\xa6\x7f\x74\x64\x7f\xd5\x4d\x2b\x6b\x8c\xb6\xd1\xfa\xff\x3c\xdf\x44\xeb\x3c\xdf\x9c\x7f\xbe\xc4\xb7\x71\x9e\x87\xdb\xf8\x6a\x08\x90\xb0\x92\x2a\x39\x18\x3f\xe5\x39\xf7\x64\x32\x52\x1a\xb8\xdd\x88\x88\xe0\x76\x72\x1c\xbb\x84\x11\x99\x02\x66\xfe\xbb\xbd\x64\xbc\xbb\xae\x4a\x40\x3f\xc2\xfd\x12\xc8\x14\x69\xb4\xde\xec\x4e\x16\xe3\x60\x21\x2b\xb8\xd1\x8f\x1c\xb2\x30\x68\x3b\xa3\xbc\x86\xd2\x3f\xf1\x18\x85\x52\xf5\xa2\x96\xe5\xb4\x83\x30\x0e\x16\x97\x20\x58\x64\x19\x23\x52\x7b\xf4\x34\x06\x6e\xe4\x1a\x2e\xa8\x87\x56\x18\x62\x2f\xc9\x1a\xae\xfa\x12\x59\xba\xc2\xb6\x16\x05\xfe\x52\xd7\x3e\xf9\xb0\xc3\xd1\xae\xab\xe2\x04\xb6\x3f\xdc\x85\xcc\xca\xc9\x97\x57\x8b\x07\x11\xc7\x26\xb0\xcd\xf3\x2d\xff\xdd\x26\xf0\xee\x2e\xf6\x2d\x19\x6c\x74\x8f\xb0\x33\xbc\x75\x13\xf5\xfa\xee\xbe\x46\xc5\xba\xf8\xdd\xdd\xc6\xc7\xee\x84\xac\x41\x56\xa0\x55\x7d\x02\xad\xd0\xc1\x18\xa3\x60\xb9\x84\x9f\x1c\x96\x5b\x22\x58\x4e\x09\x44\xe3\x5a\x9d\x2f\xf1\x17\x6c\x4a\xd6\x57\x30\x6e\x76\x7f\x63\x31\x0a\x83\xa2\x64\x14\x85\x23\x51\x50\xcf\x70\x57\xee\x30\x1a\x27\x9b\x9d\xc4\x3c\x38\x97\x72\x37\x8b\x13\x99\x94\x5f\x47\xde\x31\x3e\xbc\x59\x72\x49\xd7\x61\xd5\xd8\xf4\x6f\x23\x95\xad\xa2\x10\x9f\xa5\x95\x6a\x7f\x73\x0f\x3f\xf6\xb9\x0a\x5d\x82\x78\x66\xae\xef\xf2\xeb\xa9\x5c\x41\xc6\x38\x19\xc8\x7f\x7a\xee\x3b\x7c\xb1\xad\xaf\x7c\xe9\xdf\xd9\xd7\xd9\xba\x3a\x5d\x14\x43\x34\xcd\x33\xde\xa3\x3c\x54\xcf\x53\x37\xe2\xf1\x0b\xed\xc4\x7b\x43\x0c\x87\xab\xc8\x04\x88\x83\x8c\x5b\x42\x22\x87\xa2\x5f\xcb\x0d\x2c\x61\x1b\x6e\xe1\xed\xb7\xb6\x66\xfe\x3c\x6c\xcf\x36\xcf\x87\x25\x4a\x58\xc9\x07\xa1\x7f\x86\xb7\x7c\xc0\xc4\x46\x2a\xe1\x39\x9c\x64\xfe\x4d\x4b\x15\xf5\x09\x84\x49\xc8\xb1\xe1\x25\x4c\x26\xdc\xbe\x75\x59\xcd\xae\xc0\xeb\x9d\x35\xdc\x56\xb3\x97\x41\xf0\x39\x00\x00\xff\xff\x7a\x63\xe4\xe0\x85\x07\x00\x00"
+var _sqlite3TypeProtoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xab\xae\x56\x28\x28\xca\x2f\xc9\x57\xd0\x53\xa8\xad\xe5\x02\x00\x3e\x2d\x42\x14\x0e\x00\x00\x00"
+
+func sqlite3TypeProtoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3TypeProtoTpl,
+		"sqlite3.type.proto.tpl",
+	)
+}
+
+func sqlite3TypeProtoTpl() (*asset, error) {
+	bytes, err := sqlite3TypeProtoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.type.proto.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _sqlite3WhereGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x59\xdd\x93\xda\x36\x10\x7f\xb6\xff\x0a\xd5\x93\xb9\xd8\x0d\xf1\xe5\x39\x2d\x37\x73\x1f\x34\xbd\x86\x42\x7b\x77\x99\xa4\x93\xc9\x04\x83\x05\x78\xce\x58\x20\xcb\x1c\x94\xe1\x7f\xef\xee\x4a\x06\x83\xcd\xc5\x1c\x0f\x7d\xc0\x5f\x5a\xed\xfe\xf6\x53\x5a\xb1\x5a\xbd\x65\xaf\xd2\xb1\x90\x8a\xbd\x6f\x32\x97\x9e\x92\x60\xc2\x99\xdf\xc1\xab\xc3\xa5\x74\x98\x93\xce\xe2\x54\xe1\x43\xd8\x87\xcb\x0c\x7e\x92\xa7\x70\xfd\xd2\x6d\x8b\x11\xdc\x05\xfe\xa6\x0a\x3f\x0d\x44\x8c\xb7\x90\xa7\x0a\x6e\x4f\x63\x2e\x39\xdc\x03\x39\x4a\x1d\x8f\xbd\x5d\xaf\xed\x15\x4a\x54\x41\x3f\xe6\x5a\xe2\x60\xcc\x27\x01\xf3\xef\xcd\xfd\x01\x47\xf4\x15\x11\x14\xe6\x00\x88\xc2\xb4\xfc\xa5\xce\xc4\x71\x20\x43\xa3\x1e\x3c\x3d\xf2\xe5\x30\xe2\x71\x98\x32\xbf\x40\x34\xca\x72\x22\x7a\xda\xa5\x38\x3f\x67\xab\x95\x31\xc9\x7a\x7d\x2d\x12\x18\xea\x67\x11\x52\xa8\x31\x67\x03\xf8\x10\xa9\x48\x24\x29\x13\x89\xf9\x12\x67\x13\x7c\x1d\xb2\xd7\x30\xd3\xe8\xbb\x5e\xbf\x66\xd3\x20\x4d\x79\x88\x1c\x95\x40\xa6\xd3\x38\x93\x41\x1c\xfd\xcb\x37\xec\x3f\xa3\xcd\x7c\xf6\x29\xe5\x45\xa1\xfa\xab\xad\x96\x53\x5e\xc6\x02\xce\xc9\x06\x6a\xb5\xb6\xf7\x90\xd2\xa4\x03\x48\x35\x90\xe7\x51\x30\x37\xe2\x8d\x2a\x9e\x3e\x7c\x70\xa3\x24\xe4\x0b\xe6\xff\xa6\x4d\xf5\xce\xcb\x29\x5a\x33\x77\xee\x79\xbe\x3d\x0f\x64\x19\xcc\x3e\x76\x82\xfc\x00\xd0\xba\x77\x37\xad\x3b\x76\xf5\x0f\x53\x5c\x4e\xc8\x72\x08\x38\x8e\x52\xc5\x86\x59\x32\xa0\x2f\x85\xc9\x8d\x5c\x81\xa7\x48\x8d\xd9\x97\x6e\x57\x86\x5c\xfa\x36\x28\x08\x13\x5c\xf2\xa9\x0c\x92\x11\xdf\xe0\x03\x37\x5a\xe8\x8a\x9c\x01\x4d\xb8\x5a\x16\x58\x5e\xa6\x03\x96\x73\xba\x5a\xb2\x26\x8a\x03\x47\x6a\x96\xaf\x98\x0f\x24\xec\x0d\x73\xd8\xe5\xfd\xb5\xf3\x23\x5e\x37\x1c\x98\xd5\xe0\x75\xd3\x42\x66\x88\x96\x27\x21\x62\xf4\xc8\x20\x0b\x51\xe0\x95\x33\x09\xc0\x7c\xaa\x6c\xa9\x60\x30\xe0\x53\x05\x96\xe8\x2f\xcb\x26\xdb\x73\x9e\x76\x4a\x25\xf7\x26\x9b\x04\xd3\xaf\x1b\xc8\xdf\xfa\x42\xc4\xab\x97\xda\xf1\x3d\x63\x10\x92\x10\x3b\x35\xcc\xf4\xde\x90\x16\x8c\xb0\xae\x96\x8b\x1f\xa3\x21\x4b\x04\x78\x38\x4a\x47\x5c\x60\x7e\xe6\x09\x0c\xd6\xa5\xf4\x2d\x5a\x79\x3b\xfa\x08\xc1\x4a\xc3\x98\x40\xf4\xa2\x07\xf7\xec\xd3\x9a\x81\x15\x14\x54\x14\x9d\x2e\x52\x3c\xa5\xec\x69\x2c\x4c\x2a\x5e\x8b\x18\x7f\x90\xd9\x86\x9c\xf1\x59\x16\xc4\x29\x9b\xfb\x36\x1a\x9c\xb9\x45\x6d\x29\xbc\xbd\x5d\xee\xee\x1c\xdf\x25\xa7\x34\xf6\x1f\xf0\xba\x5e\x7b\x18\x28\x53\xcc\x4a\xb6\xb2\x2d\x18\xcc\x64\x02\x3e\xa2\x7c\x21\x8e\xa8\x1a\x46\xbc\xd3\x74\x1a\x38\x1f\x8a\x1f\x14\x54\xe6\xcc\x1d\x0a\x24\xcf\x2e\xe9\xd1\xe1\x47\x2a\x12\x0a\xa0\x44\xc3\x92\x46\x75\x15\x02\x31\x27\x6a\xf4\xeb\x45\x5d\x95\x6e\x93\xe3\x34\x8a\xb0\x18\x73\xac\x1a\xf3\xb4\x9e\x36\xb7\x89\x3b\x87\x92\xef\xfb\x3f\x52\x08\x57\x33\x0c\xa6\x49\xf0\xc8\xdd\xaf\xdf\xa2\x04\x12\x71\x18\x0c\xf8\x0a\x34\x8a\x39\x72\xf1\x3c\xdb\x1a\x0a\xc9\xa2\x06\x9b\x23\xa5\x0e\x65\xe0\x0e\xb3\x69\xfa\xd7\xe8\x9b\x2e\x0a\x7b\x8a\xdb\x16\x28\xfe\xac\xc5\x6e\x3b\x60\x31\x64\x01\x40\x3d\x7b\x93\x14\xe0\x70\x1d\xe4\x4e\x92\x4d\xfa\x1c\x16\xeb\x72\x74\xb7\xd5\xd1\x26\x8c\x79\x8a\xc4\x41\x52\x37\x24\xda\xea\xd4\x88\x00\xf5\xe6\x15\xfe\x6f\x2b\x7e\x02\x7a\xf0\x85\x8e\x6c\x58\xef\x6a\x6b\xc2\x4f\x55\xa5\x79\x40\x97\x0f\xc7\x3b\x62\x24\x79\x00\x61\x76\x94\x2f\x3e\x9c\xea\x8b\x8b\x83\xf8\x8f\xf7\xc5\x8e\x02\x2f\x70\xc7\x87\x93\xdd\x71\xb1\x71\x07\x2d\x35\x31\xa0\xdd\x49\x1c\x15\x4d\x78\x55\xda\x5c\x71\x48\xe5\xe3\x15\xee\xeb\x69\xaa\x9e\x7a\x5a\x88\xab\x4e\xce\x1d\x55\xe1\xaf\xcb\x21\x5a\xfe\x58\x05\x02\x9a\x55\x13\x3f\x89\x38\x11\xfe\x45\x0e\xbf\xda\x3f\xb0\xcb\x8d\x92\x51\x65\x61\x8b\x1e\x8f\xf4\x4f\x91\xb8\x7d\xfb\xb1\x05\xbb\x49\x05\x0a\x24\x35\x4b\x03\xc8\x73\xcd\x0c\xa6\x61\xd5\xd7\x12\xc5\x39\x8d\x5c\xe0\x46\x5d\xbd\xf3\x29\x6c\x71\x08\x75\x47\xa8\x4e\x16\xc7\x15\x3a\xdf\xa6\x34\x70\xac\x53\x3b\x9f\xda\xed\x9a\xcb\x21\x09\x70\xeb\x2b\x76\x7b\x4f\xdc\x9d\xaa\xc5\x3b\xcd\x15\x39\x16\x2f\x5a\xe2\x28\xcc\x5a\xce\x91\xb0\xbb\x0f\x5b\xe8\x7b\xde\x28\x3f\x1a\xdd\x0e\xf5\x4c\x20\x4b\x46\x7c\x5e\xd4\x71\x28\xc5\x64\xbf\x11\x24\x43\x40\xe0\xb0\x00\xac\xa2\x37\xea\x48\x5f\x6a\x98\x0a\x2d\x5b\x04\x85\x13\xba\xec\x06\x96\x4f\x9c\x65\xec\xc7\xa9\xe7\x04\x52\x6c\x10\x12\xd8\xf4\xf8\x85\xed\xb3\x69\x6c\x75\x13\xdb\x4d\xe2\x65\x3d\xcb\xbb\x48\x45\x53\x3d\xf4\x02\x10\x8d\xc4\x34\x90\xc1\x84\xba\x0b\xc3\x74\x18\x60\x8e\xea\x2b\xcc\x09\x0a\xca\x87\xfe\xbe\xf1\x0c\x1c\xdd\x8c\xbf\x08\x8e\x9e\x0a\xed\x7b\x25\x24\x3d\x5a\x1b\xd2\xf9\x39\x22\xb8\xe3\x69\x16\xab\x94\xe8\x04\x76\x27\x79\x43\x89\xf2\x4c\x2f\x84\x36\x07\xf3\xc3\xa6\x0b\x66\xc6\xd1\x24\x52\xbb\x44\x6d\xfc\x84\xcc\x70\x1c\xe6\x0c\x87\x29\x57\x66\x52\xbe\xf3\x3c\x1c\x2e\x18\x8b\x03\xb5\x20\x45\xe0\x5b\xd8\x07\x16\x37\x57\xd5\xf6\xc6\x3e\x49\x5f\xd6\xeb\x6a\xf5\x8b\x14\x0d\x0a\x16\xdc\xcf\x22\xc6\x54\xe9\x6c\xf0\x18\xec\x58\x7f\xde\x69\xa4\xb9\x94\x42\x7a\x98\x26\x68\x9f\x85\x30\xd0\x4d\xa7\x87\x5f\xa2\x04\x4f\x18\x26\x3c\x81\xc6\x6b\x0a\xd5\x0e\x6f\xbb\xea\x78\xcc\x21\x75\x1c\xaf\x74\x12\xc3\x9c\xfb\x56\xbb\x75\xfd\x40\x85\xdb\x12\xb8\x1d\x5e\x88\x2d\xa0\xd4\x45\x98\xd0\xf6\x5a\x60\xc2\x94\xc7\x7c\x80\xf6\x35\x07\x28\xb6\x85\xe7\x49\x0d\xf6\x9d\x50\x52\x03\x77\x56\xc0\xbe\x5a\x7b\xfe\x42\xdc\xd3\x24\x57\x98\x98\x01\x5e\x16\xae\x1b\x40\xff\x53\x93\x25\x51\x4c\x9b\x6e\x53\x01\xe0\x95\x58\xe9\x7d\x36\x48\xdc\xa6\x97\x6d\xd1\x69\x95\xde\x5c\x6b\x94\xa4\x12\x55\x19\xe0\x4e\xa3\x5e\x65\x6a\xe9\x99\xb0\xa1\x0f\xa6\x53\x88\x2f\xd7\x30\x3a\xd0\xf3\x37\xe1\xf7\x06\x07\x13\x35\x26\x0f\x8e\x04\x73\xb0\x6f\x40\xc1\x9e\x43\xed\x8f\xee\x31\x36\x0c\xf1\xad\xd8\x27\xb9\xcf\x67\xa3\x67\x9a\xa8\x1f\xa4\xe0\xff\x0c\xbb\x94\xb1\x07\x60\x83\x33\xfd\x7b\x31\x54\x37\xe0\x67\xc5\xe9\x38\xe0\x19\xf4\x3d\x14\x07\xd4\x21\x51\xa3\x7b\x89\x6b\x6f\x87\xad\x0e\xb6\x59\xcc\x66\x19\x97\x4b\xdb\xd2\x07\x9c\xe8\xf4\x9e\x0e\x56\xd6\x03\x5d\x31\xf6\xe0\xd6\xc3\x17\x08\xa1\xde\x6f\x77\xdd\x3f\x51\x9b\xed\x51\x24\xf0\xa5\x60\x43\x33\xe8\xf8\xc0\x98\x7b\x47\x11\x67\x78\xbe\x01\x9e\xec\xf3\xef\xad\xbb\x16\xf1\xd4\xbb\x85\xd4\xff\x03\x92\x2a\x87\xec\xb0\xcb\xce\x0d\x83\xc5\x27\x0f\x4a\xd0\x08\x2a\xa3\xc9\x43\xc8\x19\x2c\x43\x9b\x0c\x58\x08\x2a\x4b\xd7\x71\x90\xa5\x1c\xe2\xd2\x9c\xaa\x34\x2a\x8f\x75\xea\xe6\x02\x52\x91\x18\xd6\x04\x3f\x3b\xec\xec\x8c\x09\x9f\x2a\x1b\xbb\x30\xfa\x98\x61\xd0\x62\x73\x00\x05\xf2\xd0\x39\x7f\xc9\x68\x12\xc8\xe5\x47\xbe\xdc\x9c\xd5\xe8\x18\xc2\x93\xe4\xf4\xd0\x38\xd7\x35\x7a\x87\x72\x67\x9c\x5c\xd5\x23\x74\x5b\x5b\x12\x0a\x0d\x77\x0f\x5f\xd1\xde\x3d\x1d\xa9\x54\xad\x07\x64\xa8\x52\xb0\x9a\x46\x7c\x3f\x58\x0d\x57\x7c\xd0\x95\x7c\xeb\x15\x99\x25\x79\xbc\xd0\xc1\xb7\xab\x25\x16\xba\x71\x13\xad\xf0\x7d\x41\x12\x24\xa7\x42\xb2\x5b\x70\x57\x30\x80\x0e\x69\x12\x1d\x1e\x36\x84\xfd\x61\x02\x55\x92\xca\x18\x42\x33\x6b\x02\x66\x0c\xae\x08\x0d\x76\x06\x8c\x1a\xac\x24\xae\x9e\x6b\xf3\x14\xda\x7a\x61\x9b\x01\xb0\x50\xf1\x05\x24\x23\x4f\x06\x5c\x9f\x58\x7c\x6f\xe8\x08\xa7\xbf\x04\x20\xf3\x37\x87\x17\xa8\x0b\x4a\x28\x8e\xfa\xdf\x69\x36\x1a\x11\x17\x9d\x5c\x5a\x71\xcb\xa4\x9d\x6c\x5b\xb3\x4d\xfc\x86\xfd\xad\xce\x7f\xa3\x39\x4b\x2a\xbf\x50\x51\x2b\xe4\x43\x88\xd0\x99\x7f\x1d\xc3\x4e\xc2\x35\x4b\x4a\x2c\x82\x10\xc1\xe3\x3a\xff\x8c\x47\x50\xf7\x99\xdf\xe1\x0b\xe5\x7a\x25\x3d\x71\x4a\x91\x9e\x86\xab\xac\x6a\x59\x96\x31\x49\x7e\xaa\x49\x8c\xd0\x20\x6f\x69\x18\x0d\x4f\x96\x1f\x04\x09\x3c\x81\xb5\xf1\x9f\x12\x58\xe0\x8c\x88\xad\x69\x2b\xd7\x35\x13\x38\x33\xff\x1e\xe6\xbb\x38\x55\xdb\xa7\xc2\x40\x65\x0b\x69\xe1\x68\x81\x4d\xcc\x53\x5c\x9d\x15\xe5\x7a\x79\x35\xc8\x25\xb5\xa4\x74\xbd\x5f\x6a\xc6\xd9\xa6\xbc\x9a\x71\xe2\x0f\x44\xb0\xad\xfe\x0f\x28\x59\x5e\xfb\x6a\x1a\x00\x00"
+
+func sqlite3WhereGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_sqlite3WhereGoTpl,
+		"sqlite3.where.go.tpl",
+	)
+}
+
+func sqlite3WhereGoTpl() (*asset, error) {
+	bytes, err := sqlite3WhereGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "sqlite3.where.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _xo_dbGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x7d\x6b\x77\xdb\x46\x92\xe8\x67\xe9\x57\x20\x3c\x3b\x0e\x20\xd3\xb0\x9c\x49\xe6\x9c\x95\xa3\x39\xc7\xb6\xe4\x89\xee\xf8\x35\x96\xbc\xc9\xac\xe2\x6b\x83\x20\x28\x22\x06\x01\x1a\x00\x25\x6a\x34\xfa\xef\xb7\x5e\xfd\xc2\x43\x24\x65\x69\xee\x9c\xdd\x58\x04\xba\xab\xab\xab\xab\xab\xab\xeb\x85\xab\xab\x47\xde\x7f\x95\x49\xe5\xed\xed\x7b\x83\xea\x6b\x16\xbe\x4f\xaa\x45\x56\x0f\xbc\xeb\xeb\xab\x2b\x78\x53\x5c\xf0\xab\x1d\x7a\x07\xbf\xac\x37\xce\x0b\x7c\xbe\x7d\x05\xd0\xd2\x89\x17\xbe\x3b\x5b\xaa\x66\x00\x1a\x5a\xcd\xcf\xe2\x22\xcf\xc3\x17\xc5\x6c\x16\xe5\xe3\x93\xe8\xcc\x19\x80\x1a\x2c\x5b\xe0\xcd\x63\x79\x9a\xe4\x63\xef\x11\x0c\xf3\xf8\xb1\xf7\xdb\xdb\x83\xe7\x5e\x5a\x79\xf5\x34\xf1\x62\x80\x5a\xe4\x5e\x9a\xd7\x49\x39\x89\xe2\xc4\x9b\x14\xa5\x37\x8e\xea\x68\x14\x55\x89\x57\xcc\x93\x32\xaa\xd3\x22\xc7\xc6\x51\xed\xc5\x51\xee\x8d\x12\x6f\x51\x25\x63\xef\x22\xad\xa7\x08\xad\xbe\x9c\x03\x9e\x93\xb2\x98\x79\x55\x3c\x4d\x66\x91\xf7\x3d\x0c\x27\x7f\x86\xc7\xfc\xef\xf5\xf5\xf7\x21\x34\x6e\x4c\x12\xbb\x9f\x4c\x01\x93\x6a\x5a\x2c\x32\x00\x59\x94\x5f\x08\xae\x77\x06\xff\x59\x8c\x42\xc0\xee\xf1\x1f\x51\xfc\x25\x7e\x0c\x93\x79\x7c\xfe\x13\x10\x21\xcf\x87\x1e\xce\xec\x64\xe9\x01\x35\x10\x42\x4f\x5b\xfc\x67\x5e\x14\x59\xf8\x0e\xff\xb3\x8d\x68\xca\xcc\xf5\x5c\xaf\xb6\xb7\x0e\x97\x49\xec\x03\x7d\xeb\x64\x59\x23\x74\xfc\x77\xe8\x55\x75\x99\xe6\x67\x43\x2f\x0c\x43\xdd\xfa\xea\x3a\xf0\xfc\xd6\x5a\x0c\xbd\xa4\x2c\x8b\x32\xd8\xde\xfa\xc7\x22\x29\x2f\x37\x02\xc5\xab\xd6\x80\x00\x8f\xd6\x07\x22\x30\xb6\x99\x7b\x92\x0c\x96\x0c\xa9\xfb\xa6\x90\x9e\x36\x5f\x1d\x7f\xcd\xd6\xa7\xf9\xac\x48\xcb\x22\x7f\x0c\xfc\xb9\x0c\x81\x64\x30\x57\x8f\xfe\x3e\x59\x86\x66\xa8\x9b\x80\x29\x16\x42\x10\x0a\x82\xf3\x4c\x43\x82\x17\x00\xe8\xa6\xe5\xe9\x25\xa1\xd9\x73\xcd\x65\xe8\xed\xa2\xf7\x62\x07\xd9\xfb\x3a\xa9\x3e\x2d\x52\x72\xd7\xe5\xcd\xa3\xf5\xad\x72\x7f\x37\xdd\xcb\x26\xd0\xb5\x43\xf7\x3b\x58\xd4\xa1\x5a\x51\xb3\xba\xb8\xbb\x6e\xb7\xbe\xc3\xe6\xe2\xb6\x17\x9c\xb6\x2e\x02\x8c\x2a\xef\x22\xc9\x32\xfc\x37\xca\x2f\xbd\x8b\x32\x9a\x83\x98\xf1\xe6\x65\x71\x9e\x8e\x81\x20\x24\x97\xaa\x68\x96\x78\xb3\xa4\x9e\x16\xe3\xca\xf3\xd3\x64\x48\x82\x29\xcd\x81\x66\x8b\x59\x92\xd7\x24\x95\x82\x75\x59\x48\xb6\xc3\x06\xbb\xb3\x97\xb5\x36\x07\x75\x03\xcb\x6d\x0c\x6c\x15\x2b\xde\x0e\xbb\x5e\x16\xbd\x15\x7e\x7d\xac\xcb\x3f\x04\xf1\xbc\xa8\x3d\x1f\x56\x94\x4e\x02\x9a\x45\x80\x6f\x91\x3f\xce\x93\x32\x9d\x5c\x12\x17\xd8\x0c\x24\x07\x4d\x3a\x9b\x67\x09\x72\x00\x2d\xf5\xf6\x79\x54\x7a\xfe\xf6\xd6\x27\x5e\xf8\x7d\xa1\xf6\xc1\xf3\xc0\xcf\xd3\x2c\x68\xbd\x38\x59\xca\x0b\x0b\x0d\x57\x5c\x36\x7b\x20\xdb\x5a\x7d\x64\x16\xce\x0f\x3e\x53\x4f\x96\xcf\x93\xb3\x34\xcf\x81\x95\xe5\x6c\x75\x0f\xd5\x11\xbd\x55\xfc\x5d\x97\x51\x5e\x45\x31\x9f\xad\x74\x9e\x8e\x2e\x19\xce\xaf\xb0\xbd\x94\x70\x74\x8e\xca\xdb\x9d\x96\xb7\x3a\x25\xed\xb9\xd8\x7b\x89\x9e\x36\xb9\x41\xce\xb2\x93\xa5\x66\xa0\xc6\x71\x64\x84\xd4\x6d\xe4\x14\x28\x13\x5d\x0b\xe5\x4a\x2d\x51\x70\xae\xaf\x57\x4d\x41\x51\xd5\x5d\x73\x6a\xba\xf4\xf5\x76\xb0\xe6\x62\x4b\x43\x6e\x77\xb2\x5c\xb6\x37\x84\x70\xd7\xdb\x39\xad\x68\x2f\xa0\x2e\x59\x7e\x13\x59\x1a\x62\xf6\x26\x5a\xb4\x84\xed\x5d\xd0\x44\x91\x64\x15\x45\xd6\x24\xc8\xcd\xf4\xb0\x77\x13\xef\x02\xaf\x5c\xc0\xf6\x98\xa0\x7e\xea\x45\xf6\x9e\xc1\xdd\xb4\xc8\x85\x46\xa3\x10\xa8\xe7\x6c\x29\xdc\x81\xa8\xd9\xa6\x75\x9d\x10\xf7\x5f\x4c\x93\x1c\xe1\x94\x49\xbd\x28\x01\x24\xec\xe7\x21\x51\x0d\x1a\x96\x45\x96\xe1\xfe\x83\x5d\xd1\x6a\x07\xfa\x2e\xa1\xeb\xc1\xff\xcd\xa3\x3c\x8d\xab\x70\x7b\xb2\xc8\x63\x8d\xa1\x0f\x54\x8e\xeb\xe5\x3c\x2a\xa3\x19\x60\x3f\x1e\x39\x64\x1e\x22\x2c\x6c\xef\xd7\x4b\x12\x2b\x81\xcc\xde\xf3\xe1\x5f\xf5\xf7\x55\x73\xaf\x6f\xd5\x4c\x26\xbc\x24\xc0\xec\x64\xd7\xd5\xcb\xa0\x7b\x5f\x35\x9a\x33\x93\x38\xab\xa9\xf8\x1b\x59\x82\x57\xce\x70\x32\x76\x46\xf1\xa6\xd9\xc5\x5d\xe0\xf5\x60\x77\x80\xee\x85\xcc\x7f\x6e\x01\x1c\x84\xfb\xdd\x3e\xb6\x41\xe1\xb2\xc5\x44\xc7\xa7\xdb\x5b\xc0\x07\x5b\xe3\x64\x02\x9c\x4a\xe4\x0b\xa8\x01\x74\x99\x23\x22\x65\x12\x17\x70\x4a\xf8\xc1\x53\xf8\x6d\x01\x00\x64\xe1\xec\xc9\x32\x5c\x4a\x5f\x50\x65\x92\x02\x2e\x1a\x8b\x00\x5b\xd2\x62\xfa\x73\xfc\xfb\x9a\x21\x37\x90\xd9\x00\x16\xe3\x2d\x90\x10\xcc\xbe\x57\x2f\xe9\x8e\x90\xd6\x37\x76\xbd\xf6\x03\x98\xa6\x4c\x7b\x92\xfb\xb8\xc2\xbc\x01\x96\x05\x9e\xc8\xcf\x26\x93\x24\x06\x0e\xd6\xec\x88\x27\x47\xbe\x98\x8d\x80\x2c\xc5\xc4\xa3\xfb\x5f\xa4\xda\x8c\x2e\x61\x8b\x54\xa0\x18\xd1\xe9\xd8\x3a\x3f\x88\x6b\x5d\xb0\x3e\x5e\x30\x5b\x37\x1a\xe0\x4d\x10\x0e\x7f\xf9\x71\x68\xd8\x53\xa1\x08\xed\x43\x07\x40\x40\x0b\xdc\x90\x67\x7d\x23\x19\x95\x6a\xa3\x21\x1a\xd2\x41\xe8\xf9\x3e\xa9\xcb\x4b\x4f\xdd\x67\xe9\x57\x34\xca\x12\xe8\x3f\x2f\xca\xba\xc2\x8d\x0c\xc4\xa2\x2d\x86\x7b\x5c\x84\x47\x8a\x7a\xc3\x24\x4a\xb3\x45\x99\x00\xe5\x40\x04\x42\xc3\x34\x9e\x22\x61\x11\x92\x26\x1f\xee\x77\x5b\x9e\xc8\xc5\x17\x90\x2c\xd3\x64\xbc\x07\xf0\x5e\x5f\x1e\xff\xe3\x95\x37\x4e\xa2\x71\x56\x80\xe0\xf0\x9f\xfc\xf0\xe4\xcf\x01\x76\xc3\x9f\x24\x72\xa2\xb4\xf6\xea\x74\x96\x14\x8b\x1a\x5f\xef\xfe\x04\xd4\x82\xf7\x91\xf7\xae\xa8\xea\xb3\x32\xc1\xfe\x15\xe8\x3a\x51\x96\xfe\x8b\xd4\x59\x8d\x99\xff\xe3\xee\xee\xee\x13\x84\x86\x80\xcc\x18\x3f\xee\xbe\x83\xc7\x21\x29\x3d\xf6\xa4\xf7\x79\x93\x58\x22\x65\x04\xa7\x39\x52\x15\x5b\x56\x96\x26\x72\xe5\xc1\xa8\xc7\x38\x4b\xd8\x52\xac\xc4\x79\x7a\x2f\x16\x65\x15\x3e\xab\x10\xcc\xd0\x7b\x50\x25\xbc\xe7\x2a\x90\xb1\x40\xa0\x2a\x09\xad\x9e\xf8\x22\x46\x03\xc1\x80\x30\x1d\x0c\xf1\x0f\xc0\x6d\xb0\x67\xf6\x03\xd0\x6f\x91\xf0\xa6\xc0\xcd\xec\xaa\x20\x67\xc5\x23\x60\x87\x47\xe3\x32\x85\x7d\xfc\x78\x76\x89\xbc\x41\x14\x3d\x24\x69\x3b\x85\xbb\x41\x5e\x88\xfe\x2f\xdc\x8f\xa8\xa6\x75\x45\x90\x78\x0f\x80\x1a\x5a\x78\xf1\x34\x01\xd2\xe0\xc6\x98\x25\x55\x15\x9d\xc1\x90\xb3\xea\x0c\xa5\x04\xcc\x23\x24\x70\xc0\x43\x0a\x27\x9e\x72\x45\xc7\x54\x04\xb7\x09\x1f\xda\x02\xf2\x3c\x2a\x2e\xe1\x20\xf0\xfe\xfd\xef\x55\xcd\x76\x7f\x1a\xa8\x8d\x2a\xcb\xf0\xae\xc8\xd2\xf8\x52\x29\x7e\x73\xfe\x85\x5a\x1f\x72\xcc\xa5\xbe\xd4\x28\xf6\xaa\xe8\xec\xb1\x75\x40\x84\x85\xcb\x8f\x4d\xe9\x54\xd3\x27\x0f\x42\x61\x26\x75\xf9\x5c\x24\x02\x10\x59\x9f\xef\x36\x2a\x78\x51\x8a\x6b\x5c\x29\x80\xfc\x0c\xce\xc1\xd9\x1c\x86\x15\x04\x67\xd1\x32\x9d\x2d\x66\x96\x2c\x89\xa4\xc5\x10\x78\x25\xce\x16\xfa\x1e\x36\x49\xcb\x0a\x84\x09\x02\xf9\x9f\x28\x5b\xc0\x36\xce\x8a\x0b\xe8\x52\x4f\x01\xc1\x1f\xbc\x71\x5a\x29\x74\x68\x9a\xd0\xd2\x8c\x95\xd7\xbc\xee\xaf\xd3\xfc\x39\x48\xd1\x62\x32\x51\xe3\x8f\x93\x2c\xba\x84\x0d\x05\x73\x4b\xcc\x30\x0c\x05\xae\x92\xc5\x62\x84\x27\x32\xce\x3c\x89\xe2\x29\x01\x99\x80\x2c\x2e\x2e\x10\x2d\x6a\x15\x7a\xcf\x3c\x20\xdf\xb8\x98\x79\x7f\xe0\x29\x4f\x93\x58\xcc\xbd\xba\x00\xe6\xc9\x26\xd6\x28\xb8\xfb\xe7\xf3\x0c\xb6\x2d\x20\x67\xa1\x82\x5b\x33\x3c\x58\xb0\x7d\x4b\x10\x8d\x96\x0d\x44\x15\xa1\x1c\x84\x23\x85\xc2\xff\x26\x25\x32\x69\x94\x33\xb7\x72\x5b\x1c\xc5\xc0\x71\x47\x51\x3c\x73\x90\x4c\x22\x90\x83\x1d\xac\x33\xe6\x37\xee\x62\xaa\x1d\xdf\xd1\x6d\xdf\x6d\x79\x65\xe8\xbf\xe7\x79\xde\x9f\x87\xf6\x94\xf7\xbc\x27\xbb\xde\x0e\xa3\xf4\x3a\xcd\xb2\xb4\x82\x73\x34\x1f\x0f\x6d\x84\xf7\xf8\xf5\xb1\xbc\x61\x84\x47\x32\x19\xfb\x18\x6a\x2d\x61\x0e\x4c\x2b\x0b\x08\x7c\x5e\xd6\xb8\x54\x51\xed\xed\x8a\xc2\xe4\xcf\x5d\x4c\x03\x05\xd5\x27\xeb\x63\xe0\x52\x0a\xf9\x76\x8c\x9b\x78\x1e\x5a\x4b\xf6\xf3\xcf\xde\x02\xda\xfa\x79\x40\x22\x0b\xde\x19\x42\xff\xd5\xdb\xf5\x1e\x3c\xf0\xfc\xb1\xf7\xf3\x3e\xfc\x09\x9b\x78\x0c\xcf\xec\x26\x2c\xb6\xc6\xde\xbe\xf3\x14\xa5\x13\x02\x93\x7e\x96\x1e\xb2\xcb\x82\x4b\x7e\x8d\xbd\x47\x2e\x8a\x3e\xb2\x5f\x78\x04\xe7\xd8\x9f\x73\x3e\xce\xfc\xf1\xe3\x1f\x82\x87\x4f\x02\x25\x1b\x0e\x40\x3a\x45\x59\x86\x0a\xec\xd0\x08\x02\x38\x15\x78\x83\x6b\xb2\x46\xb8\xa9\x90\x5a\x15\xbe\x44\x29\x50\xb9\x32\x80\x84\xc3\x4a\x31\x30\x14\xfe\x9f\x87\x7a\x0b\x22\xc2\x15\x6b\xc7\x59\x04\x1b\x4c\x43\x43\xb5\x97\xba\xe2\xae\xe8\x59\x9f\x83\xa2\xa1\xdc\x2a\x5d\x56\x2b\xb1\x2c\xa0\x80\x64\x64\x9b\xc1\xe5\xda\x7d\xea\x3d\xf5\xd2\x87\x0f\x89\x8e\xa2\x36\x82\x62\x13\x18\x15\x6b\x9f\x55\x2c\x58\x9f\xf4\xe1\x13\xef\xaf\xfb\x36\xba\xf0\xf0\x3b\x6b\x76\x78\x12\xf1\xa2\x39\xaa\xe1\xd6\x75\xf7\x8d\x05\xde\x30\xef\x66\x49\x32\xf7\xe7\xa1\xe2\xaf\x34\x70\xef\x2c\xd8\x0e\xf1\xa2\xc6\x6f\x92\x8b\x13\xf8\xb7\x6c\xb4\x87\x73\x2f\xc9\x12\x96\x9f\x7c\xd2\xfd\xfc\x08\x28\x11\x1e\x14\x39\x9c\x7f\x74\xca\xd5\xe1\x71\x5d\xcc\xfd\xa0\x85\x9e\x34\x87\xbb\xd0\x9e\x46\x56\x29\xbd\xd7\xdb\xee\x05\x87\xd5\x98\xde\x5b\x0e\x71\x81\x6a\x3b\x74\x0f\x93\x8b\x69\x91\x91\xd2\x62\x77\x88\xe2\xb8\x28\x59\x78\x23\x23\x78\xcf\x08\xee\x8c\x76\x2a\x31\x23\x88\xd5\x19\xef\x58\x60\xae\x22\x8f\x81\x6b\x80\xe7\xf8\xde\x89\xc0\xf0\x6e\x39\x8d\xce\x13\x2f\x21\x05\xac\xf2\x40\x7b\xa9\xd2\x71\x82\xe2\xb5\x61\xb7\x68\xdc\x84\x68\x2a\xab\xae\x43\x0d\x26\xeb\xbf\x1f\x69\xd6\x12\xd2\xce\x43\xcd\x8e\x51\x79\x86\xcc\x68\x71\xa2\xbd\x6b\x1b\x17\x33\x6e\x3c\x1e\xe1\x48\xa8\x71\x37\xce\x6d\x76\x84\x44\x6c\xf2\xe9\x3b\xab\x4b\x75\xd3\x44\x3b\xb6\x45\x60\x84\xa3\x04\x34\x5f\xe2\x9f\xd1\xee\x05\x1a\x1b\x45\x32\x1a\x91\x3e\x9a\xe2\x6e\x34\xb4\x23\xd5\xc5\xe0\x20\xf7\x7e\x24\x3e\x9a\x43\xbd\xa8\xb1\xae\x4f\xd1\x44\xd4\x60\x1a\xb4\x85\x82\x66\x18\x7a\x30\xd1\xf1\x08\xe8\x38\x50\x66\x3b\xf4\xf8\xe0\xb4\x10\x9c\x68\xac\xca\xf0\x8a\x68\x30\xc9\xe0\x7d\x91\x67\x97\x5a\x0c\xf0\xd5\xb7\x02\x45\x57\xdb\xa8\xe0\x7e\xb1\xd7\x47\x0b\x12\x22\x35\x5b\x66\xb1\x89\xa5\xd3\x1e\xe5\xa0\xd5\xd6\x43\xef\xc3\x7c\x0c\xdd\x48\xd3\xf9\x30\xc7\x47\xca\x9e\x3b\xf4\xde\x1f\x9e\x7c\x78\xff\xe6\xe8\xcd\xdf\xb0\x6f\x4a\x12\x29\x2e\xb2\xc5\x0c\x0d\x05\xb3\x05\x08\x2a\xa3\x6b\xbb\x7b\x80\xa6\xee\xea\x3b\x48\x3e\xad\xeb\xc0\x0f\xfc\x1f\x99\x06\xb7\xe4\x88\x74\x38\x4e\x96\x1f\xb6\xbd\xe9\x1e\x97\x09\x20\xca\x6c\xa0\x9e\xad\xe4\x85\xf1\x88\x48\xea\xee\x37\xde\x11\x36\x70\x9f\xb6\x00\x1a\xc8\x5b\xf2\x75\xc7\x8c\x66\xf8\xfc\x81\x7e\x78\x75\xf0\x7c\xcf\x43\xc6\xe5\xf6\x7b\xde\x5c\x09\x0f\xbd\xe0\x68\xda\xa6\xc5\x4e\xe0\x8f\x05\x4e\xe1\x2b\xb2\x80\x7b\xd8\x38\x28\x1a\xed\x54\x89\xfd\xd2\xc2\x23\x68\x83\x6e\x6c\x68\x82\xaf\xad\xbf\xb0\xb9\xaa\xb6\x45\x19\xef\x7a\xca\x7d\x79\x7d\xcd\xd6\x03\x73\xcf\xe3\xfb\x71\x19\xca\xc6\x59\xbd\xab\xd9\x2e\x4d\x7d\x0e\x9e\x87\x7d\x08\x72\x77\x99\x3e\xe2\x05\x68\x05\x4d\x9b\x82\x75\xdb\x56\x70\x9b\x24\xa5\x3d\x44\x34\x25\xa1\x7c\x67\xf4\xd4\x70\x6f\x41\xd0\xaf\x9e\xf6\xf6\x7e\x33\x3d\xbf\x76\x53\xb3\x89\xde\xa6\xe4\xfc\xda\x4f\x4c\x25\x90\x0c\x3d\xd7\x21\x95\xf4\xda\x9c\x5a\xca\x01\x0e\x23\x5a\x56\x85\xf6\x64\xdd\x01\xba\xe7\xdb\xf6\xb3\xb5\xa7\xb7\xbc\x2f\x66\x59\xde\x9a\x5b\x1a\x2e\x9d\xfb\x61\x96\xe5\xbd\x71\x4b\x93\xa2\x6b\xb2\xcb\x2d\xe9\xa5\x89\xb5\x92\x5d\x56\xcf\xb8\x65\xc7\x16\x57\x96\xa5\x6c\x28\xef\x55\x65\xdc\x57\x96\xc3\xc9\xcc\x8f\x3d\x4e\xdb\x56\xe0\x86\x62\xc5\xf7\x70\xe8\xff\x5a\xa6\x75\x72\x0c\x97\xda\xda\x32\x81\xc9\xe3\xd2\xd2\x68\x40\x93\x43\xde\xab\x12\x24\x08\x9c\xc5\xa0\xf4\x8d\x33\x8c\xd6\x20\xcb\x44\x34\x66\x3b\xc4\x05\x76\x83\x6b\xc2\x51\x5d\xe9\xf0\x10\xe5\x7a\xc5\xf3\xae\x71\x04\xd2\xf1\x47\x1a\x28\x0d\x67\x9d\xc6\x06\x03\xdb\x69\x44\x13\xa5\xfb\x35\xb6\x48\x4a\xe7\x1a\xc9\x18\x29\xed\xf2\x2c\xc9\x31\xe0\x84\x2c\x9e\xd1\x98\x34\xc3\xca\xe8\x18\x7f\x4b\xea\xe7\x97\x04\x08\xb1\x7e\x95\x56\xf0\x93\xdb\x04\x70\xe9\x66\xe0\xc0\xbf\x66\x3c\xc1\xa6\x7b\x3c\x50\x86\xbd\x82\x6c\x84\xd6\xdc\xf4\x58\x29\x29\x31\xd5\x90\xe0\x2c\x48\x93\x61\x62\xc1\xbd\x37\x81\xbf\x71\x44\x06\xaf\x46\x34\x7a\xa5\x90\xc1\xe8\x96\x16\x65\x80\x9e\x79\x87\x5a\xd1\x39\x7f\xba\xf6\x11\x09\x88\xe4\x08\x85\x11\x8c\x98\x3c\x65\x02\x1c\x10\x47\x01\x7b\x32\x3a\xe7\x43\x1d\x69\x68\xa5\xa2\xbe\xa7\x65\xc7\x1b\x01\xaa\x87\x55\x92\x98\xa5\x64\x8d\xf1\x32\xa9\x15\x64\x44\x04\xe4\x16\x76\x79\xea\xcd\xa3\xaa\x62\x50\x22\xcb\x10\x9a\xb5\x4c\x79\x92\x28\xab\xd1\x8c\x0c\x9d\xa8\xb2\x3a\xd7\x99\x10\xba\x93\xaf\x5f\xe8\xfc\xdb\xdb\x57\xc5\x19\xee\x65\x73\xfd\x20\xed\x97\x26\x8a\x53\xa2\xd1\x86\xa8\xb7\x92\x3a\x4a\x98\xe3\xca\x49\xcc\xc0\xb8\x41\xed\xb3\x02\x31\x93\xd9\x36\x99\xd2\x51\x13\x69\x04\xd1\x12\x79\x4a\xd6\x12\x36\xb8\x14\x7f\x6a\x11\x74\xc1\x32\x48\xc3\x0c\x3c\x87\xed\x6c\x19\x72\x41\x3b\x55\x60\x36\x38\x51\x70\xec\x05\xea\x70\x96\x0b\x94\x5e\xad\xa9\x08\x3a\xcb\xdf\x3b\xd8\x5d\xe8\x7c\x0d\x7d\xaf\x61\xd3\x17\xac\x37\x54\xde\xd6\xd0\xcc\x36\x9c\xe0\xb7\x28\x61\x4d\x15\x6c\xd5\x14\xd7\xd3\xa8\xd6\x53\x98\x6e\x33\xcd\x3b\x56\xa0\xba\xe7\x77\x5f\x4a\xd4\x6d\x26\x7c\x5b\x7d\xa9\x1d\x00\xb3\x7a\xde\xeb\xa8\x02\x6b\xe9\x36\xb7\x5c\xd9\xbb\xd4\x75\x7a\x57\xf6\xdb\xf4\x1d\xeb\x10\xb4\x75\x1e\x73\x14\x6a\xdd\xc7\x3a\x1d\x95\x0e\x64\xe6\x2e\x7a\x10\xbb\x44\x7b\xd5\x87\xfe\x53\x35\xea\xd2\x29\xe8\xa4\xe1\x4b\xfc\x9e\x3e\x5a\xc4\x0f\xe2\x20\x44\xe7\x18\xdc\xe0\xd3\xba\x4a\xb2\x89\x18\x52\x8b\xf8\x0b\xbb\x21\x22\x09\x4d\xd3\xe0\x10\xd4\x2b\xf4\xd4\x15\x14\xf5\x10\x18\x6b\x81\xad\x2e\x29\xff\x28\x1f\x1c\x62\x1f\x30\xa2\x5e\x1c\x6e\x63\xf1\xb8\xfb\x78\x90\x11\x4b\x92\x5d\xd1\xc6\x6e\xcf\xa8\xd8\xe3\x50\x9d\x43\xd2\x6e\x67\x59\x48\xe8\xc5\xc1\xf3\x3d\xb6\xbe\x8e\xc3\x22\x24\xec\xf6\xf7\xbd\xc1\xc0\xb1\xab\x3e\xb0\x5a\x5f\x21\x51\x0c\x7a\xe1\x78\x84\x7e\xcb\x3d\xec\x7e\x6d\xdc\x79\x6a\xdc\xd1\xf6\x75\xa7\x96\x7a\x82\x06\xdc\x37\xd1\x2c\xf9\xa5\x28\xbe\x68\x25\x55\x3f\x75\x3d\xda\xf8\x00\x35\x20\x32\x69\x53\x30\x54\xda\xd2\x3a\x91\x94\xa3\x4b\xa5\x77\x98\x45\xb5\x74\xc4\x41\x9d\xe4\x51\x5e\x7f\x7a\xf2\x09\x60\x94\xd5\x80\xd4\xdc\x01\xff\x1d\xf0\xe2\xd1\x50\xa9\x44\x5c\xa1\x3d\xac\x62\xcb\x18\x60\x4f\x76\x27\x54\x80\xe2\x02\xda\x50\x3c\xf3\x22\x07\x8d\xa1\xaa\x09\x9f\xf9\xc2\xf2\xa9\x3b\x76\x67\xf6\xcd\x98\xa9\x89\x37\x96\x67\xc3\xfb\x51\xfb\x5a\xaf\x1c\x4b\x74\x4f\x4f\xd8\x6f\x5e\x2b\x9e\xe6\x26\x70\x62\x5c\x56\x7e\x57\x6c\xd9\xb3\x2c\x1c\x8e\xad\xd6\xa4\x73\x3a\xb4\x50\xdc\xae\xb5\x52\x12\xd5\xad\x6c\xc1\x38\x50\x75\xf3\x82\x91\x21\xd3\xd1\x6c\xbb\x16\x4c\x96\x6a\xbe\x18\x81\xda\x79\x47\x6b\xc5\xc4\xb5\x26\x22\xd4\x95\x39\xb4\x28\xa9\x5d\xc4\xf4\xbe\x15\xa3\x05\x5b\x82\x61\xfd\x3d\xb9\x34\xc1\xf3\x4c\xb5\x2f\xf0\x48\x68\xa2\xa0\x27\xb5\x6d\xb8\xe4\x9e\xa2\x94\xda\x80\x58\x25\xbd\xb2\x9d\x02\x12\x31\xaf\x23\x90\x60\x94\x39\x81\x47\xb6\x20\x98\x3a\x64\xa1\x45\x55\x54\xb9\x65\x87\xc8\xe2\x40\xbf\x4a\x06\xb7\xac\xf5\x3c\x46\x37\xa3\x35\xe8\xd3\x78\x6f\x11\x4a\xbd\x41\x80\xe4\x1b\xe6\x88\x1f\x6b\x7a\x57\xd7\x0a\x9c\x31\xbb\xdf\x3b\x67\x11\x89\x00\x93\xbd\x95\xeb\x41\xd2\x5d\x96\x5b\xc5\x88\xe5\x45\x4e\x4c\x07\x1d\x7a\xb9\x70\x25\x0b\x92\x71\xfc\x66\x2e\x5c\x87\xf4\x86\x35\x61\x93\xc2\xb0\xb0\x69\xe1\x4c\x40\x37\x14\x93\xdb\xa1\x74\x10\x4a\x3c\x79\xf0\x14\x1b\x3e\x78\xe0\x55\x18\xce\x24\x82\x5e\xf1\xb6\x23\xbc\x5d\x4e\x37\xf1\x35\x4d\xa1\xf1\xb6\x4e\x32\x23\xc2\x4b\x50\x26\x74\x88\x6b\xcd\xbf\x14\xf3\xcf\xd1\x15\x4e\xde\x5f\x0e\x48\xea\x58\x1f\x45\x12\x81\x43\x00\x42\xf9\xb1\x0f\x17\xd8\x24\x93\x5f\xfe\x60\x59\x0c\xd4\xd1\x7f\x8c\x30\x8f\x01\x3c\x43\xaf\xf4\x70\xed\x9b\x33\xb1\x39\xae\xda\x50\xab\x05\xc5\x5c\x9f\xd3\x83\xe3\xc3\x57\x87\x2f\x4e\x06\x81\x57\x88\xa4\xd4\x07\xb2\x1e\xa3\x7b\x71\x18\x24\x75\x19\x22\x44\xb5\x4a\xed\xd0\x47\x9e\x13\x42\xa2\x73\x3b\xaa\xeb\x92\x12\x81\x4e\x3f\xe2\x9f\xe9\x08\x2e\x68\x21\xac\x19\x2d\x22\x2e\x8e\x79\x7a\x4c\x30\xfd\x01\x9c\xfb\x3a\xf5\x66\x80\xa3\x05\x43\xe5\xa7\xe6\x73\xc0\xac\x2c\x43\xdf\xc7\x18\x07\x58\x37\x9f\x7e\x0e\xbd\x4e\x90\x18\x64\x43\xdd\x07\x32\x0f\x74\x74\x5a\xfc\xa0\x16\x25\x24\x4a\x48\xfc\x1e\xcf\x9a\x66\x44\x3b\x07\x66\xf5\xf7\x14\x06\x32\x93\xc4\x9f\x2f\x32\x0c\xad\x0a\xec\x96\xcf\x14\x0a\x15\x23\x85\x1a\x63\xd0\x73\x2c\xbd\x46\x5f\x4f\x5c\x69\x26\x23\x15\x94\x4e\x29\x1d\x4a\xed\x04\xfe\x7b\x53\x7c\x27\xfe\xcc\xa8\x2c\x16\x18\x4d\xb3\x81\x90\x18\x1a\xad\x8c\xe9\x19\x09\x00\x4d\x75\x39\xa0\x0c\xb7\x4c\x94\x60\x45\x00\x12\x70\x4a\x5d\x01\x43\xf4\x5e\x73\xb8\x4f\x0c\xfb\x1f\x24\x01\x2a\xca\xa9\x18\x8c\xe0\x41\x09\xe3\xce\xcb\x22\x4e\xc6\x0b\x8c\x6f\x53\xb6\x09\x6b\x96\xb6\xbd\x0c\xc6\x78\x9b\xd3\x3b\x5a\x07\x8a\x65\xe5\x99\x4a\xb4\x85\x62\x6b\x27\xdc\x6f\xcb\xee\xe3\xb7\xd8\x74\xdb\x86\x7b\xc8\x81\xaf\x8a\x7e\x13\xdb\x30\x65\x01\x15\x2a\xa1\xcf\x70\xac\xe2\x32\x30\x9a\x1c\x21\xd1\x4d\x49\x85\x83\x72\x88\x21\xd1\x84\xc3\xc8\x90\x5c\xea\x1a\x01\xeb\x93\xdc\xe4\x6a\x24\x70\xe2\x6e\x14\x4b\x16\x74\x60\xdf\x25\xc6\xf2\x25\xe3\xd0\x04\x90\x6e\x99\x19\xb4\xe6\x38\x04\x9d\xd9\x89\xd0\xb0\xad\xdf\xfa\xfc\xb1\xb9\xca\x5e\x02\x45\xe2\x4e\xa1\x45\x27\x05\x86\x2d\xe0\x1a\xe3\x11\xa1\xa4\x18\x75\xb5\xc0\x88\xb8\xc2\x3f\x93\xb1\xe3\x5c\x46\xf8\x4c\x5f\x7b\xd4\x7e\xde\x55\x4e\x56\xd8\xb7\x4a\x6d\xd0\x50\x8d\x21\x0b\x6e\x0f\xf2\x3f\x36\x66\xd1\xbe\x90\xdf\x06\xa9\xad\x26\xa9\x74\x94\x29\xbe\x06\x80\x68\x4f\xab\xf0\xa2\x53\x73\xc8\x8a\x9a\x99\xa0\x87\x1c\x60\xd0\x1b\xca\xfa\xc1\x09\xa9\x44\x27\x83\x31\xbe\xce\xb6\x94\x54\xb7\x1b\x60\x17\x81\xbd\xdf\x0a\xfc\x85\xcb\x84\x2d\x8e\x1e\x98\x19\xd3\x9d\x04\x7d\xa1\x38\xbf\x3d\x81\x20\xc3\xec\x99\xd1\xf6\xe0\xff\xd7\x77\x92\xaa\x15\xa1\x7b\x24\xc0\x53\x17\xf0\x29\x5e\x9e\xd4\xc8\xf7\x69\x1e\x9b\x86\x34\xac\xb3\x71\xa7\xa1\xcc\x66\x0a\x27\x00\x88\x67\x3a\xee\x4c\xb4\x4a\x71\xc1\xc1\x8c\x95\x0e\xca\x9e\x86\x1c\x96\xbd\x89\x57\xd4\x1d\x18\xf7\x92\x33\xec\x50\x62\xc0\xd2\x3c\x4e\x7c\x42\x20\xa0\xe1\x6e\xed\x3e\xdd\x94\xd2\xf7\x60\xa7\xbb\x35\xad\xbf\xf6\x50\x7a\x4d\x8f\xe9\xb7\x93\x7a\x23\xd7\xea\x2d\x69\x7d\x47\xc6\xc2\xdb\x33\x34\x27\x44\x77\x50\x78\x1d\x0b\xe3\xad\x88\xec\x1c\x5d\x20\x88\x4c\xfe\x02\x20\x83\x71\xc3\xbe\xc9\x5b\xb0\x19\x5f\x67\xdb\x6e\xea\x16\xbe\xd5\xc2\xdc\xad\x51\xf3\x7e\x36\xc1\x1a\x9e\xe0\xfb\xde\x05\x77\x44\xed\x3b\xb2\xac\xde\xcf\x36\xb8\x27\x32\x6b\x6e\xef\x64\x72\x27\x27\xeb\x1d\x5c\x72\x31\xab\x62\x51\x29\x25\xca\x55\x66\x30\x2d\xa7\x34\x19\xbc\xeb\xda\xee\x48\xc9\x34\xb0\xd1\xf5\x8c\x97\x81\xa1\x97\x45\xa3\x44\xe9\x64\x5a\x4b\x2f\xe6\x5a\x7f\x6e\xe0\xe3\x44\xbc\x1f\xe5\x2f\xb3\xf4\x6c\x5a\x2b\x55\xcf\xca\x9a\x11\x45\xd7\xe0\x07\xca\xb3\x6e\xbe\x33\xd7\x40\xc3\xbf\x45\x8b\xb3\xe4\x7f\x92\x98\x75\x67\x1d\x9a\xac\x22\xb5\xd5\x6f\x75\xf9\xb5\x14\xa4\x14\xd5\x23\x8c\xa0\x46\xd8\xba\xa3\x0d\xfb\x97\x14\xee\x05\x67\xc0\x5f\x1a\xfe\x21\x6b\xce\x2d\x7c\x9b\x11\x85\x08\x52\xda\xda\x00\x5f\x80\xa6\x06\x0c\x89\xe0\xac\x10\xb7\x06\x89\xec\x48\x37\xf7\x15\x86\xad\x9c\x01\x4e\x49\x29\x79\x16\x6a\x19\xb4\x71\x1b\xde\x87\xde\x71\x52\x2b\xfd\x4d\x02\x5a\xb4\x52\x3f\x95\x87\xcc\x05\x92\x91\x41\x20\xec\xb0\x38\x77\x54\x1f\x80\x7a\xd6\x24\xde\x0b\x0e\x09\x66\xc8\xed\xb4\x71\x34\xa2\x8c\x78\x43\x6e\xd5\xbc\x33\xaf\x06\xea\x6e\x3b\x28\xe6\x03\xb8\x2a\x4c\xf1\xed\x83\x26\x10\xd4\x37\xd5\x6a\xef\xd9\x63\x03\x7a\x6a\xc1\xfd\x26\x13\xbc\x9d\xd7\x15\xd9\xcb\xd1\x84\xb3\xe7\x0d\x96\xc5\x27\xb9\xe2\x7d\x4a\xf3\x4f\x13\x02\x36\x18\x62\x83\x5f\x92\x0c\xd4\xd0\xc1\x9b\x9b\xd8\x8d\x5a\x5e\x0b\x7f\x57\x78\xb5\xd7\x3c\xd2\xc4\xc8\x66\x13\xbf\x8b\x7d\x1a\x98\xc1\xff\x14\x72\x97\x9f\x14\x87\x7e\x12\x5e\xb4\x31\xc4\x86\x07\xbd\x1c\xcc\x28\x6e\x3d\x5f\xc4\x5f\x12\xcc\x24\xb0\x46\x3e\x48\x26\xf2\xb8\x3d\x0b\x66\xcb\xe6\x1c\x0c\x67\xfa\x6d\x7e\xed\xa1\xec\xe5\x27\xbe\x48\x7e\xaa\x8b\x3a\xca\x7a\x48\xdb\xde\x19\x6d\xca\xe2\x7d\x02\x2f\x6d\x9f\xe0\x48\xa0\xd4\xc1\x28\x3f\x4b\x80\x67\x1c\x4c\x32\x0c\xf5\x2e\xca\xab\x69\xa8\x38\x03\x05\xa6\xb9\x46\x4e\x39\x8f\xa8\xba\x56\x59\x88\x72\x18\xe2\x96\x50\x2c\xeb\xc7\xc1\xd3\x56\x0e\xa1\xc8\x53\xca\x36\x55\xb1\xeb\xf6\x15\x67\xaa\xf2\xe7\xb6\x9b\x97\xfe\x0a\x86\xae\x26\x68\x43\x68\x5e\x54\xf5\xb9\x63\x1d\x69\x4d\x26\x0f\xbc\x9b\xad\x01\x7c\x4a\xa9\xc9\x92\xb9\xe6\x15\x92\x8c\x53\x7c\x4c\xfb\x00\xda\xc4\x7e\xe0\x22\x88\xd6\x83\x3b\x42\x6f\xe3\x6b\xfc\xfa\x88\x1f\x24\x88\xf8\x96\x59\xc6\x9b\x1a\xbf\x1d\x55\x49\x79\x9e\xf8\x63\x49\x7c\xa9\xf0\x38\xec\x48\x0a\x55\x8c\xb0\x9a\x62\x3a\xd2\x5f\xbb\x44\x9b\xa7\xa7\xed\x15\x35\x57\x75\xe5\x14\x35\x04\xdd\xef\x90\x84\x37\x84\x87\x1d\xd7\xb3\xfa\x45\x14\x4f\x95\xdb\x02\xbb\x62\xf8\x57\x5f\x59\x82\xda\x2a\xb3\xa0\xe3\xc3\xa4\x20\x01\x5a\xae\x15\x38\x15\x3f\xf4\x9f\xc8\x53\x37\x18\xb7\xe2\xc8\xb6\xb4\x5e\x24\x8d\x94\x56\xd4\x35\x5e\xcb\x32\xab\x87\xd2\xc6\x5b\xca\x4a\xc7\x49\x0e\x9b\x86\x22\x43\x48\x63\xc4\x99\xd3\x98\x28\xce\x31\x2f\x4d\x5c\xf8\x9c\x45\x81\x53\x2b\x61\x79\x94\xfa\xc3\x4d\xd9\x17\x60\xb2\x01\x90\xe2\x59\x84\xf6\x36\xce\x0c\xd2\x66\x48\xaa\x77\xc2\xe1\x8e\xde\xb1\xd1\x9c\x14\x14\xc9\x07\x22\x60\x52\x50\x07\x0d\x81\x09\x7b\x25\xe2\xb2\xa8\xb4\x47\x2a\x4f\xa4\xaa\x04\x48\x48\x3c\xc7\xa9\xba\x83\x2c\xde\x3f\xc4\x2e\x39\x5a\xa4\x59\x8d\xd9\x59\x70\x3a\xe1\x5e\x63\x6b\xa7\xd8\xbe\x46\x11\xfa\x9f\x39\xae\x8e\x86\x89\x91\x0a\x63\x9a\xa7\x37\x4f\x38\x27\x15\x64\x1e\xa8\x91\xb5\x42\xb9\x41\xae\x2a\x9a\x30\x77\x01\x3e\xf1\xa2\x2c\x71\xea\x80\xaa\x5e\x60\xd3\xd8\x31\x65\x99\x95\x07\x11\x39\x5b\xe0\x21\x55\x5d\xe6\x71\xf8\xfe\xd7\xd7\x0b\x58\x40\xd4\x9a\x67\xa8\x99\x44\xf3\x53\x5e\xc0\x8f\x7a\xf9\xa0\xc3\x34\x45\xd5\x6b\x96\x56\x55\x42\xc9\x87\x7f\xf9\xd1\xd6\x84\xcc\x90\xb6\x12\x64\x9e\x9a\xa5\x6d\x86\xcf\xa1\x05\xce\x28\x30\xba\x87\xef\x20\x4c\xe1\xfc\x06\x9a\x13\xd0\xaf\x1f\x53\xfe\xd9\x88\x0e\xdf\xf1\x08\x8f\x2a\x9a\xcf\x5e\xe7\x84\xae\xae\x87\x46\x88\x60\x3b\xc7\x5d\xa6\xf9\xc2\x65\x2d\xb9\x11\x98\xb9\x60\xb2\x19\xbb\xb5\x6a\x4a\xb2\xa0\x95\x54\x92\x39\x76\x70\x0e\x68\x94\x1b\xae\x3e\x5d\xbb\x85\xe2\x12\xc2\xd9\x22\x7c\x8f\x91\x05\x28\xf7\x8c\x9f\x2a\xa4\xd9\x9d\x12\x88\x8f\xaa\xd9\x87\x3c\x93\x86\xb0\x61\xa1\x21\xbb\x30\x8a\x59\x1a\x87\xcf\xc6\xe3\x23\x4a\xa3\x7b\x10\x87\xbc\x96\x4f\xac\x20\xe2\x8a\x8f\x4a\x3a\x3d\x09\x94\x1a\x90\xab\x04\xd0\x23\x0d\x9c\x14\x6a\xce\x0c\x8e\xce\xa2\x34\xc7\xed\xc9\xb1\x91\x64\xdd\xc4\xe8\x47\x4a\x72\xd2\x64\x4c\x6b\xcb\xc9\xd6\xc4\xfd\xe9\xad\x11\x35\x66\xba\xd8\xb9\xd3\x35\x64\x97\x7b\xa3\xeb\x3c\x79\x5a\x9a\x04\x82\xef\xc0\x87\xd9\x9f\x31\x72\x67\x01\xd3\xaa\x2c\xdf\x9f\xad\x79\xac\x8c\x23\x64\xb1\x96\xda\x02\xc9\x72\x3d\x74\x73\xd3\x5d\xd8\x4d\xdb\x55\x98\x28\x42\xc6\xa2\xaa\xc5\xb3\xb7\x22\xa1\x22\xc7\x0a\x13\xea\x46\x41\x89\xdf\x44\xad\x6f\xb1\x7d\xb6\x2a\x4d\xdd\x3f\xb5\xba\xcd\xa0\x9b\xc6\x37\xde\x48\x31\xef\x57\x94\x60\x75\xb3\x42\x03\xba\x8f\x0a\xca\x14\x53\x7d\x86\x02\x2d\x35\x1e\x14\xac\xbd\x90\xd6\x94\x6d\x47\x05\x0c\x6b\xe5\xa2\x82\x46\x1c\xbf\x2c\xb7\x57\x39\xfb\xd0\x1a\xb2\xd6\x0a\xdd\xda\x62\xaa\xd6\xe8\xdb\x97\x26\xbe\xa5\xb5\xf4\x86\x85\xec\xea\xdf\x58\x4b\x54\x4e\x2a\x37\x7c\x4b\x5f\xc8\x58\xa7\x21\x42\x2b\xd5\x44\x29\x0f\x66\xdd\x7c\x14\x99\x81\x0e\xe5\xa1\xd6\x7a\xd5\x23\xbb\x21\xcb\xb2\xa0\x6f\x41\x08\x13\x2c\x50\xd4\x3e\xf8\xed\x10\x4e\x11\x92\xaf\x8a\xc8\x95\xda\x18\x36\xdf\xf1\x4a\x06\x95\xd9\xbe\xc8\x0a\x50\x8b\x63\xfc\x6f\x25\x2a\xde\xac\x38\x97\x6b\x4f\x73\x6a\x55\x1f\xa6\x04\xc5\xce\xac\x59\xe3\x00\xc3\x8b\x80\xbe\xf7\xf0\x1d\x56\x56\xb2\x32\xf7\x58\x91\xf0\xfa\x5a\x8a\x6f\xe0\x42\xcb\xc3\xc1\x75\x54\x71\xcd\x83\x07\x76\xee\x35\x5d\x4d\x39\xb1\x47\x0a\x74\x6c\x71\x56\x83\x2f\xf0\x64\x23\xb9\xbc\x62\xcc\xaf\xfa\x4a\x63\xe9\x7c\xab\xf2\x5a\x0c\x35\xfa\xaf\x2e\x7f\x43\xc3\xa0\x09\x03\xa0\x12\x32\xdd\x97\x16\x9d\xa6\x1a\xf1\x8e\x15\x07\x29\xb5\xb7\xaf\x0c\xc7\xd0\xce\x6f\xee\x40\xa6\xa8\xf2\x80\x62\x13\xab\x66\x1b\x28\xac\xb0\x7d\x41\x65\xa8\xf5\xed\xc8\xd8\x2b\xb9\x02\x1d\x0d\xde\x70\x15\xa7\x14\x53\xca\xdb\x5f\xc2\x5c\x74\xac\x17\xc1\x3f\x3d\xc1\x62\x87\x1f\x5d\xf4\x76\x4e\xb6\xb7\xb8\x85\x4f\xc8\x37\x71\xa3\x3d\xf9\x36\x4f\x58\x54\xe2\x60\xc2\x02\xa6\x22\x4a\x6d\x8a\x67\xa0\xab\x1d\xb5\xda\x13\xed\x96\x55\xfd\x79\xf0\xa1\xf7\xce\xc6\xe7\xe3\xc7\xae\x64\x6d\xa9\x0b\x09\x34\x58\x75\xd6\x9c\xd8\x87\xcc\x39\x72\xde\x3b\x3f\x4f\x2e\xfc\x13\xbc\x3a\x8b\x58\x3b\x0f\x65\x7a\x6b\x49\x2a\x1e\xd7\x88\xaa\x8d\xcf\x25\x40\x2a\xf0\xcf\x03\x5b\xb5\x11\x22\x3c\x03\xad\xef\x66\x22\x72\x31\xa5\xaa\x49\x3d\xe8\x78\x1f\xd4\x3b\xfd\xe8\xd2\xcf\x38\x58\x56\xfb\x18\x9b\x64\x5a\x93\x4a\x22\x67\xbe\x2a\xf1\xc0\x4a\x72\x56\x50\x22\x11\xaa\x58\x15\x39\x96\xd9\xa4\xba\x73\x72\x75\x2d\x42\x27\x7c\x83\x15\x20\xb9\x0e\x43\x73\x99\x45\x8a\xe8\x65\xfe\x6a\x15\x7a\x58\x65\x07\xe3\xe4\x5e\x13\xb9\x44\x2e\x65\x59\x41\x57\xf2\xd0\x9b\xaf\xec\xa5\x70\x97\xf5\x10\x6f\xe1\xcd\x75\x55\xae\x9f\x89\xc4\x5e\xd3\x55\xdd\xda\x1d\xde\x51\xad\x82\x7c\xaa\xba\x98\x93\x1e\x20\xaa\x01\xef\x24\x16\xd3\xb6\x6a\x80\x05\x3c\x38\xea\xb2\x5d\x38\xc3\x42\x65\x43\x4e\x51\xb5\x0f\x60\xce\x3c\xe6\x7a\xcc\xa3\x4f\x91\x7b\x62\x9a\x1b\xf9\x85\xc2\x98\xaa\xca\xb0\xcc\x5d\xf3\x88\xc5\x1e\xdc\x71\x92\xfb\x86\x2b\xd6\xe8\x68\x73\x8e\x61\x1a\xbb\xde\xa7\x54\x44\x63\x3e\x42\x75\xff\x55\x54\xd5\x5c\xb5\xe0\xe8\xc0\x5c\x7d\x7a\x45\x45\x3a\xb6\xce\x04\xe3\xd7\xd2\x56\x34\x75\x70\x70\x0e\x21\x26\x1e\x68\xad\xb2\x3d\xde\x37\x89\x91\x8e\x2a\x6a\x55\x27\x53\x74\x5e\x6a\x36\xe0\x89\xdd\xb6\xb0\xc5\x48\x36\x6b\x22\x9d\x95\xda\x9a\x27\xfc\xf3\x34\x8f\xca\xcb\x0f\x1f\x80\xcc\xea\x8c\x7f\xb3\xc8\x32\x7c\xf0\xfc\x12\x69\x6e\xeb\x95\x7c\x9a\x02\x72\x0b\xae\xc8\x36\x11\x6d\x33\xcb\x38\x4f\x60\x01\xeb\x80\x19\x1b\x08\xe7\xf9\xd1\x9b\x67\xef\xff\xe9\x3f\xf9\x4b\x20\x85\x24\x34\xbd\x1d\xf8\xfe\x82\xba\x85\xea\x61\x60\x55\x46\xbb\x96\xf0\xa4\xef\x16\x18\x5e\x0b\xb0\x5d\x39\xea\xcc\x1d\x34\x35\xe8\x7d\xba\xf7\xb1\x2f\xfb\x21\x9d\x25\xa0\xde\xb1\x90\x51\x76\x58\xfd\x40\x54\x8d\x4c\xfd\xa6\x30\x44\xac\xd4\xd3\xa9\x5a\x58\x9e\x52\x50\x91\xb9\x46\x4b\x39\xc3\xa2\x71\x94\x9d\xa9\x22\xd1\x34\xf4\x7d\x98\x34\x6a\xb4\xea\x01\x2e\xf9\x1c\x98\xa8\x9e\x78\x83\x3f\x7d\x1d\xb4\x90\x53\x21\xb6\x76\x1f\x3a\x16\x1a\x58\x62\x24\xe8\x98\xfe\xab\x69\xeb\x0c\x43\x81\xd2\xca\x52\xb4\x43\x16\x7c\x0d\x0e\x1d\x76\x45\xac\x39\x53\x5e\x36\x3a\x77\xf2\x1f\xd7\x69\xa4\x58\x00\x7b\x01\x00\x9a\x3e\x09\x70\x3e\x44\x39\x2a\xf3\x87\x3f\x60\xb2\x70\xdf\x93\x02\x9f\xa0\x92\x9e\xe3\x7a\xa6\xf5\x25\xbf\xa0\x5f\x7a\x93\x2a\x7e\xb2\x6a\x90\x0c\x5d\x0a\x5b\xc4\xe5\xb8\xcf\x0b\xb4\x21\xc5\x54\x92\x4f\xc5\xab\xd3\xea\x99\x18\x51\x01\xa4\xaf\xa0\x82\xd7\xbf\x30\xb8\x9c\x4c\xad\x07\xcf\x4e\x0e\x4f\x8e\x5e\x1f\x06\xc8\x0c\x5f\x92\xb9\x98\xe9\x08\x72\xaa\xca\x39\x49\xe5\xde\xe6\x00\x0e\x74\x4f\x83\x44\x70\xc7\x27\xcf\x5e\xbf\x13\xa3\x6d\x91\x9f\xb3\xf4\x21\xc3\xd7\x45\xaa\xcd\xaf\x8a\x62\xda\xf2\x5a\x53\xc0\x20\x2f\x19\xbe\xc2\xcb\x07\x92\x68\x07\xab\x08\x6e\x6f\x11\x52\x54\x51\x50\x5d\x01\xb1\x1a\xa2\xeb\x01\x22\xbb\xa0\xd2\xa4\x9b\x1e\xa0\xa5\x0c\x19\x50\x4f\xff\xdc\xeb\x3e\xce\x90\x8f\xb9\x62\xa2\x60\x21\x99\x50\xe7\xac\x49\x36\x12\xa1\x80\x41\x24\xaf\x69\x19\x32\xba\xfb\x9d\x67\x02\x7a\x6b\xde\xc0\x59\x34\x10\x7b\x01\x32\x8a\xf7\xe6\xc3\xab\x57\xcc\x0c\xbc\x32\x03\x55\x07\x74\x67\x19\x62\xed\x5a\x0d\xd2\xa0\x83\xb9\x0c\x93\x28\xab\x92\x86\x54\x20\x64\x74\xab\x3d\x2a\x2e\x05\xe8\x2a\xd4\x88\x78\x5c\x4f\x54\x41\x3b\xc0\x1a\x8a\x75\xf8\xcf\x24\x2a\xb1\x80\x66\x1d\xbe\x2e\xf2\x7a\xca\x7f\x1e\x44\x97\xfc\xc7\x2f\xc5\x42\xbd\x4d\xf3\x05\x16\x5d\xc4\xbf\xd9\x3b\xc5\x7f\xbf\x89\xf2\xa2\xd2\xbf\x0d\x8f\xca\x54\x08\xaf\xd3\x8f\x23\x10\x7b\x56\x9e\xd8\x92\x56\x49\x32\x05\xf8\x48\xa5\x86\xfc\x00\x1b\x22\xc3\x11\xb3\xb1\x83\x41\x74\x20\x4c\xc1\x46\x9f\x4a\x17\x43\x5f\x88\x79\xc6\xe3\x6a\x8e\x0c\x63\x5c\x48\x3a\x39\x1c\x6c\x9c\x94\x32\x63\x36\xe5\xba\x99\x0a\x0e\xbd\x25\xde\x40\xcd\xc1\xbd\xf2\x2a\xbf\x6d\x16\x5d\x62\x53\xcb\x79\xab\x1c\xfe\x3f\xec\xee\xfe\xe5\xd1\xee\x93\x47\xbb\x3f\x78\x4f\x7e\xda\xdb\xfd\x71\x6f\xf7\xa7\xf0\xbf\xd5\xff\x30\x10\xc0\x34\x38\x59\xd5\x60\xc0\xce\x5d\x0a\xb1\x57\x65\x2f\x68\xb9\xde\x21\x8a\x47\xb9\x16\x55\x8c\xce\xd0\x3b\x77\x88\xfe\xb4\x75\xc1\xde\x1a\x95\x49\xf4\x05\xff\xba\xee\x2f\x32\x2b\xcb\x32\x99\xd5\xec\x59\x9c\xb8\x7c\xfa\xa7\xaf\x0e\x97\xc2\xa0\xb2\xba\x52\x26\xd0\x5a\xd9\x5e\x10\x27\x1d\x20\x50\x92\x22\xab\xe3\x1c\xc3\xa3\xdc\x77\xb8\xc7\xda\x52\x16\xaa\xf6\x9e\xa0\xc2\x9e\x96\x34\x96\xeb\xd6\x55\xe3\x2b\x23\xaf\x8a\x33\xa9\xea\x9f\xa8\xb3\xe4\x8c\xd3\x33\x94\x7f\xd1\x1c\x70\x12\x4e\xa1\x1c\x55\xdc\x19\x24\xe1\x59\x56\x8c\x22\x53\xab\x99\x39\xaa\xc2\xee\x4e\x0c\x0e\xbe\x66\x41\x22\x32\x52\xe0\x3d\x25\x9b\x61\x92\xa8\x5a\x03\xec\x80\x2b\xce\xce\x94\x2e\xa7\x22\xf5\x75\xa6\x66\xd4\xf4\x86\x9a\x03\xf6\x4c\xa7\x90\xf5\x54\xbf\xbf\xf2\x94\xf3\x10\x1a\x9f\xf5\x79\x5c\xed\xe1\xbb\x2a\x4b\x45\x0a\x59\xed\x2e\x53\xd0\x1a\x59\x02\xf0\x98\x94\x7d\x84\x58\xb9\xe0\x44\x79\x32\x30\x41\xc5\x1b\xb6\x22\xf9\x75\x3d\xd5\x6f\x8e\xe6\x27\x28\xcd\xe2\x61\x6e\x34\x3f\x4e\xdb\x8d\xe5\x57\xf8\xaf\x36\xa2\x9e\x7e\xb4\xe8\xbc\x5e\x9c\x3f\xd3\xec\x25\x72\x1b\xf9\x6f\x89\xef\xb4\x99\x0a\xb1\x54\x6d\x1a\x64\xa6\x2e\xb4\xcc\x77\x89\xd6\xb6\xbd\x5e\xcd\xe8\x89\xe6\xfa\xaa\x83\x73\xe2\x20\x15\x78\xf7\x41\x30\x2a\xdf\xd8\x67\x30\x86\x9e\x12\x88\x69\x91\xd5\x49\x6b\x58\xc5\xcc\xd0\x04\x84\x8f\x22\xb4\x54\xc9\xd3\xd2\x40\xf6\x08\x66\xe7\xe1\x27\x40\x1a\x3b\x6f\xc8\x57\x77\xc9\xf1\xa6\xd3\x83\x32\xb4\x39\x6b\x34\x40\x0f\x00\x82\xc3\xa4\x47\xe4\xec\xe2\x22\x17\x98\x70\x7b\x5a\x40\xc7\xa8\x22\xed\x28\x1a\x8f\xb9\x16\xa9\xca\x47\x52\xa1\x6b\xcc\x91\x8e\xfd\xd6\x70\x42\x7f\x59\x39\x59\x2d\xb5\x34\xb6\x93\x99\xfb\xd9\x0e\x66\x7e\xb2\x8a\x4a\x9c\x79\x91\xd9\x7e\x66\xea\x68\x32\x2a\x32\x3d\x1e\x79\x9a\x19\xac\xe3\x65\xa6\x47\xba\x68\x1c\xb7\xdd\xf3\xb2\xf5\xf3\x21\x14\x92\xa9\x76\x52\x65\x7a\xa8\xfb\x4c\x83\x58\x99\xe2\x90\xdd\xa2\xf0\x5b\x16\x0a\xcf\x35\xf6\x4c\x07\x8b\xdf\x71\xb2\xc3\x9a\x64\xbc\x87\x1c\x87\x15\xa1\xdb\xd9\x6d\x2a\xbe\xdd\x25\x1d\x37\xcc\x64\xd8\x84\x90\x77\x94\xc0\x70\x53\x54\x76\x07\xf9\xd6\x72\xb7\x7d\x1b\x05\xff\xe3\x69\x0a\x9b\x50\xfd\x6e\xb3\x13\x36\x67\xdf\x35\x42\xe2\xff\x73\xfc\xfb\x6d\xa4\xbc\xa3\xd4\x83\xcd\x19\xf8\xde\x69\xb8\x76\x82\x81\x76\x2b\x8a\x8e\xb1\xca\xa5\xc8\x74\x34\x05\x62\x60\x90\xe3\x3a\xca\x12\xf1\x1a\x36\x5d\xfb\xe6\xae\x61\x15\xa6\x3d\x20\xbf\xa7\xae\x76\x87\x97\x07\xf4\xf1\xe9\xa0\xf7\x08\xb1\xaa\xe8\xdb\x0c\x69\x92\x8d\xcd\x5d\x17\x69\x7a\x01\x0a\x46\x3c\xc5\x3b\xe9\x98\xea\xc4\x10\x2c\xd0\x27\x70\xfa\x14\x79\x15\x11\x20\xb4\xa5\xa1\xb7\x00\x27\x60\xe3\xb8\xef\x98\x27\x2a\x7c\x8c\x60\x25\xe7\xfd\xb7\xb7\xcf\x31\x0e\xef\x38\xfd\x57\xd2\x5f\xb5\x9f\x0e\x01\xac\x2b\x03\x2a\x91\x7c\x00\x04\x18\x25\xb3\x2f\x02\x69\xde\xce\x81\xb6\x22\xfc\xd4\xed\xc6\x0c\xb6\x8f\x9c\x19\x9a\xdf\xb2\x3a\x27\xa4\xa9\xee\xb8\x31\xbe\x6c\x25\xe0\x6a\x37\x51\xe6\x65\xe9\x24\x89\x2f\xe3\x8c\x53\x6e\xaa\xbe\x9c\xda\x6d\xca\xcf\x68\x15\x09\x6e\xac\x05\x91\x5a\x33\x81\xfa\xc0\x09\x29\x68\xa4\x0a\x62\xb1\x6c\xbe\xdd\x71\x71\x43\xb4\xda\xe5\x8f\x2c\x93\x69\x9a\x25\x41\xe8\x3d\x53\xdf\x51\xb0\xf9\x21\xe2\x6c\x85\x36\x97\x70\x90\x1c\xfb\x8f\x18\x91\xa7\xea\x12\x44\x25\x1e\x9e\x73\x06\x36\x4f\x8f\x4a\x3b\xbb\x69\xe3\xa1\x5a\x3b\x6a\xc7\x93\x54\xc9\x32\x8d\xb9\xb0\x33\x19\xd4\x3e\x53\x99\x5b\xf2\xbb\x47\x09\x49\x0d\xf1\x1e\x68\xa5\xb4\x0d\xd3\xfd\x34\x97\x79\xdb\xed\x53\x70\xbd\xcb\xbf\xbd\x7d\x86\x79\xdf\x9b\xa2\xc8\xc9\xe2\x3d\x18\xb6\x20\xda\x08\x5a\x2f\xd7\xc3\x8f\x67\xc4\x0c\x72\x4b\x1a\x72\xe1\xc6\x26\x09\x6d\x90\x6d\x12\xf2\xdb\x0d\x48\xb8\x29\x86\x36\x09\x9b\x08\xb6\x00\xb6\x28\xb8\x09\x7a\x3c\x21\xde\x57\xb7\xa4\xa0\x08\xb5\x06\x05\x6d\x90\x6d\x0a\xf2\xdb\x0d\x28\xb8\x29\x86\x36\x05\x9b\x08\xb6\x00\xb6\x28\xb8\x3e\x7a\xcb\xc2\xde\x55\x3a\xbc\x29\xf1\x9c\xc7\x24\x4a\x40\x18\x9f\x0f\x51\xd9\xb2\xb0\xd7\x7e\x92\xd5\x7b\x73\xe8\xf5\x59\xc5\x01\xe4\x54\x85\xd4\x9e\x87\x7e\x5b\x0c\x04\x3a\x3c\x55\x25\x95\x84\x1d\xe3\xa9\x3a\xf8\x41\x97\xe1\x8e\xa6\x6a\xed\x4f\x6b\xa6\xf6\xd3\xd5\x13\x5d\xb9\xc7\x37\x98\x67\x43\x98\x74\x4c\xb3\x3d\xda\xea\x59\xda\x7b\xbc\xb5\xa0\xf2\x78\xdd\x05\xbd\x69\x2b\x6e\xbc\xa0\x66\xd3\xf7\x2e\xa8\x33\xde\x9a\x0b\xda\x9a\xa9\xfd\x74\xcd\x05\xbd\xa3\x79\x36\x64\x5b\xdf\x82\x6e\x38\x4b\x5b\xe4\xb4\x16\x54\x1e\xaf\xbb\xa0\x37\x49\x86\x8d\x17\xd4\xc8\xa0\xde\x05\x75\xc6\x5b\x73\x41\x5b\x33\xb5\x9f\xae\xb9\xa0\x77\x34\xcf\x86\xa8\xed\x5b\xd0\x8d\x66\x29\xe5\xf0\xda\x96\xf3\xe6\xa9\xd0\x0e\xcc\x1b\xc2\x59\x50\xc5\x65\x3a\x12\x4b\x9b\xae\x82\x86\x3f\x2e\x49\x53\xa5\x70\x41\x24\x90\xfe\xa2\xf0\x68\x91\x7d\x61\x0d\x1d\xcb\x26\x25\x4b\xaa\x38\x83\x26\xef\xd2\x8b\xc6\x33\xd0\x31\x3f\x1c\x61\x04\xaa\xfa\x80\x26\xe3\x66\x1f\x29\xba\x7a\x9f\xfe\xa0\xda\xf6\xd6\x0b\x76\xd0\xc2\x13\xe5\xab\xda\xde\x7a\x57\xa6\xb3\xa8\xbc\xfc\x7b\x72\xd9\xf5\x56\xd2\xc8\x02\xd7\x70\x8b\x9f\xb0\xa0\x9f\xed\x37\x8a\x5a\x52\xb6\x91\x66\x47\x89\x21\x49\xf9\x88\x72\x1c\x8a\xb9\xce\x02\xea\x08\x25\xd0\x33\x52\xfd\x9d\xe4\xe9\x57\xe9\x2c\xad\x57\xdc\x3a\x28\xd3\x17\x17\xaf\xf9\x19\xac\x0c\x3b\x87\x52\x6e\x28\xbb\x54\x1f\xdf\x52\x8b\x96\xa5\x55\xad\x70\xd8\x92\x81\xd4\x87\xc2\xde\x4e\x26\x68\x0a\x6e\xa7\x6c\xcb\x80\xd5\x97\x74\x8e\xe1\x5b\xfa\xe3\x25\x0a\x36\xdd\x15\x14\xd6\xec\x8b\xc0\xfa\x6d\x2b\xc7\x57\x03\x02\x02\xbd\x1f\x04\x26\x70\x27\xf2\x1d\x3f\x55\xf6\x4c\x7e\x2a\x87\x3c\x10\xbc\x49\x06\x69\x02\x83\xa8\xbe\xee\xa7\xc0\xec\xcb\x2f\x8e\x80\x1f\x60\xa4\xfb\x1a\x97\xfc\xe3\x1f\x18\x17\x0d\x8d\x38\x0c\xa1\x61\x3c\xe6\x52\xe5\x85\x97\x8e\xd1\x9f\xc1\x1f\x4c\x9e\x11\x28\xa9\x98\xa7\xad\xe9\xe8\x1e\xc2\x7a\xe5\x6a\x08\x61\x3a\xf1\x21\xc5\x5f\xac\x80\x14\xaa\x6c\x1a\x67\x11\xd5\xee\x9e\xcb\xd8\x11\x67\xa5\x33\x06\x5c\x11\xcb\x42\x84\xc0\x70\xb5\xac\x97\x6f\xdf\x7b\x1f\xde\x61\x6c\x83\xaa\xe1\xa8\x71\xc0\x94\x9b\x32\xf9\x03\x3f\xa9\x94\x72\x68\x6d\x55\xcc\x9c\xa4\x79\x5e\x36\xb1\xdb\xd3\xa5\x2a\x4f\xd4\x77\xb0\xce\xce\xca\xe4\x0c\x6d\xea\xc8\x33\x88\xb1\x3d\x85\x5f\x30\x58\x57\xf1\x3f\xb2\xfd\x0c\xae\xad\xa5\x37\x4d\x29\x81\x0e\x17\x0d\x3f\xab\xf4\x78\xe7\xa1\xb7\xf3\x58\x53\x96\x55\x48\x1d\xf3\x47\x80\xbe\x24\x97\x17\x98\xba\xde\x4a\x87\x96\xe9\xbd\x7e\xf6\xdb\xa7\xc3\xdf\x0e\x5f\x7c\x38\x39\x7a\xfb\xe6\x13\xc6\x5b\xf8\x4f\x76\x77\x77\x83\x01\x12\x97\xb0\xb0\xd1\x3a\x02\xda\x2d\xe9\xa9\x96\x65\xf0\x80\xd0\x22\xac\x0c\x06\x2c\xa4\x74\x15\x54\x78\xf2\xf2\xfd\xdb\xd7\x9c\xc3\xc4\x4b\x21\x8f\x5b\xb4\xb7\x5c\xea\xdf\x57\xde\xe0\xc3\xf1\xa1\x77\xf4\xe6\xe0\xf0\x37\xcf\x1f\xe1\x0d\xf5\x53\x5a\x8d\xf2\x4f\xe9\x78\xc9\x28\x1a\x8c\x6c\x3c\x45\x1c\x69\x0a\xaa\xe8\x12\xfe\x00\x96\xd9\x38\x8c\xbe\x94\xc3\xca\x12\xfe\xb2\x00\x8a\x59\xfa\x3a\x1f\x5a\x47\xb4\xb0\x91\x54\x2b\x01\x04\x8b\x51\x84\xde\x21\xd5\x48\xe3\xed\x41\xf1\x33\xfc\x36\xd4\xd2\xd2\x48\x43\x96\x05\x25\x88\xe4\xe7\x97\x1a\x2d\x20\xd6\x0c\xc5\xf2\x98\x2b\x14\xa8\x90\x5d\x5d\x1d\xde\x08\xb8\x1d\xe9\xba\xc3\x14\xc4\x80\xee\x08\x9d\x3b\x0e\x0a\x9c\x44\x47\x22\x99\xca\x77\x3a\x12\x04\xa5\x07\x97\xfc\x2a\x38\xe8\x11\x3f\x67\x17\x2f\xb2\xa8\x64\x04\xd6\x91\x2c\x82\xfe\xe9\x47\x90\xb1\xfc\x37\xcf\x0b\x06\xc2\x83\x48\x13\x3b\x1f\xa7\x22\x81\x41\x10\xb5\x0e\xb6\x9d\x5f\xa9\xf9\x39\x1d\x0f\x3c\x2c\x7f\x00\xc1\x1d\x5a\x9a\x39\x18\xf0\x40\xa7\x1f\x97\x28\xcc\x78\x10\x35\x99\x68\x66\x96\x9b\x41\x6b\x49\x66\xea\xd7\x5d\xaa\x96\xca\x2d\xce\x21\x07\x43\x44\x94\x00\x75\x20\x0b\x2d\x77\xd4\xc9\x33\x44\xba\x32\x8a\x26\x2e\x9e\x0c\x3d\xac\x71\x70\x24\xd9\x96\x0c\x62\xa5\x40\xf6\x1f\x73\x38\x34\x8a\xc7\xc6\x21\xd7\x11\xa9\xdf\x75\xc8\x49\xb0\xad\x3e\xf3\x94\x9d\x0d\x3f\x24\xd1\x3c\x46\xd5\xe9\xd9\x86\x6c\xd3\x58\x79\x0b\x2d\x00\xfb\xe6\x4c\x6d\x81\x17\xf4\xf3\x7e\xb4\x6f\x04\x6e\xc1\xd6\xa0\x91\x5f\xe9\xc0\xad\x4c\x10\xbb\x6b\xe8\xd2\x20\x51\x80\x9b\x6f\xb6\x51\x57\xf5\xe9\x45\x67\x14\xe3\x54\x24\x7a\x15\x64\xb2\x36\x33\xe4\x20\xdf\x22\xe4\xb1\xf7\xbd\xdc\xfe\xa8\x9d\x9c\xa7\x78\x4e\x57\x56\x40\x75\x7e\x23\x62\x1a\x27\xee\xfd\x2d\x48\xc9\xf8\x06\xab\xfe\x53\x9d\x75\x4d\x39\x9a\x69\x61\x1a\x07\x7b\xc4\xb9\xa2\x40\xb5\xb1\xc6\x50\xda\xfb\x0d\x7f\x7a\x60\x78\xac\x0b\xd1\x06\x92\x6a\xd0\x7d\x6f\xcc\x58\xb6\x0a\xed\xbc\x70\x8f\x7f\x95\x99\xc2\x0f\xe3\x0e\x5d\x40\xa3\xab\x31\x15\x10\x7e\x6c\x55\xe0\x5b\x1f\x45\x85\xc0\xbe\x17\xdb\xcb\x4b\x47\x2f\xab\x05\x9d\x1a\x43\x5b\x0b\x70\xb5\x06\x27\x81\xaf\x0b\x6b\x4a\x89\x12\x60\xb7\xc1\x9b\xcb\x9b\x0b\x3a\x2e\x0b\x20\xaa\x02\x79\xc0\x56\xb7\x81\x21\xf8\xcb\x42\x5d\x89\xb1\x99\xb3\x97\x22\x61\x57\x62\x06\xcc\x96\xcf\x38\x89\x30\xd7\x7a\x82\xf5\x85\x13\x84\x26\x69\xf7\xe4\xa8\x50\x86\x6a\xf5\xf9\x3a\xcc\x39\x23\xf2\x51\x5d\xdf\xab\x1e\xa4\x48\xab\x03\x10\x5d\x44\xd3\xa4\xd2\x28\xfb\x9d\x24\x12\x5a\x36\x62\x7f\xfd\x8e\x01\x03\xf2\x1c\x3a\x5c\xd8\x41\xb2\x6a\x2a\xf9\xca\x86\x62\xc7\xf8\x68\x05\xc1\x28\xfb\xba\xaa\xd5\xf7\x65\x9a\xf4\xd3\xe1\xad\xd6\x27\x2c\x2d\xfa\xdd\x40\x2d\x8d\xcf\xba\xc4\x22\x6c\x6f\x4d\x2b\x1e\xae\x83\x54\x52\x54\x54\x94\xba\xaa\x53\x07\x25\x95\x6f\x95\x0a\xa9\xfd\x19\x37\x2b\xaa\x37\x28\xa9\xed\xed\x84\x68\xf9\x53\xa3\xf0\x6d\xb6\x99\x68\x52\xfb\x84\xbd\x2d\x04\x8c\x16\xa9\x27\x6c\x69\xb6\xd6\x5c\x6f\xd2\x46\x29\xe7\x66\x85\x02\xbc\x52\xf9\x6d\x4f\x58\xe3\x76\xfb\x59\x9b\xe9\xb5\xa7\x7e\x4c\x2a\xf1\x0b\x47\x41\x96\x9b\xa8\xad\x39\xc3\xbf\xea\xca\x90\x8e\x31\xca\x34\x99\x45\x69\x26\x4b\x9c\x73\x71\x70\xa5\x4b\x3b\xaa\xf4\x6a\x35\x5a\x4d\xd4\xc1\xc4\xa7\x01\xc3\x30\xbc\x9d\xa8\x67\xf0\xfb\x84\xb6\x73\x98\x8b\x0a\x9b\x92\xce\xf2\xf6\xfd\xc1\xe1\x7b\xef\xf9\x3f\x49\x11\x57\x18\x1a\x7d\x65\x48\xa1\x56\x4d\x63\x03\x02\xd2\xea\xb8\x51\xc5\x99\x38\xcf\x81\x29\xe4\xdd\x49\x5a\x67\xc9\x41\x52\xc5\xc6\xd4\xa2\x46\x57\x77\x02\x83\x12\xeb\xe0\x6b\x28\x3c\xa8\xa0\xe2\xad\xc1\x28\x18\xd8\xd1\xe7\x9b\x04\x90\x4b\x0f\x72\x4b\x65\x43\x30\xdc\xe7\x51\x0c\xe9\x96\x05\xbd\x7a\xc1\xec\x6b\xa7\xb4\x68\x22\x5a\xac\x8d\x7d\xe9\xb2\x81\x5f\x7b\xa6\x1b\x8a\xd4\x7d\xa7\xb8\x4a\x14\x90\x84\xaf\x2a\x33\x2d\x77\x0f\x73\x25\x42\xa6\xc2\x4f\xa3\xc3\xfc\xfd\x5a\x65\xb0\x46\xd6\x47\xc5\x38\xc4\x75\xac\xcd\x61\x9c\x1f\x10\xc5\x71\x32\xb7\x2d\x83\x16\xce\x42\x22\xeb\xee\x32\xd4\x63\xa0\xa2\xae\x1f\x7f\xc4\xac\x00\xac\xd3\x20\x01\x0a\x26\x98\x03\x8f\x8f\x24\x67\x40\x01\xc6\x45\x3b\x9f\xd6\x1e\x0c\xec\x22\x19\x68\x50\x9c\x45\x5f\x12\x5f\xdd\x00\x87\x56\xdf\x40\xbe\x2e\x3d\xf4\xac\x10\x70\xc6\x4f\xb2\x9e\xbf\x13\xd4\x4e\xeb\x8f\x4e\x50\x35\x0e\x62\x47\x45\x2f\xf2\x2f\x39\xc6\x08\x12\xfb\x20\x73\x80\x94\x1f\x0a\xb1\xfd\x3a\x50\x29\x00\xd5\x69\x4a\xb5\x32\xd4\x73\xc7\x50\x39\x30\x4b\x38\xf0\x1e\x4a\xa3\x2a\xfc\x3f\x45\x9a\xfb\xb0\x8a\xb8\xd9\x1b\xe9\xa7\xfa\xf2\xa5\x2c\x3b\xea\x27\x86\xe0\xca\xe6\x56\x2b\xd5\xc1\xcd\xee\x56\x6a\x5c\xf3\x74\x42\x87\x19\xc4\xd8\xf1\x00\xb4\xa7\x2d\x92\xc5\xdc\x33\x3f\xda\x11\xa2\x1a\x5b\x1e\xc0\x66\x59\xb9\xab\x48\x10\xa5\x73\x67\xc5\x29\xe0\x28\x14\x5d\xbe\x02\x51\x61\x32\x7a\x8e\xf2\xca\x29\xf8\xd5\x19\xd7\xb2\x89\x10\xe3\xbb\xae\x4e\x25\x95\x07\x43\x9b\x32\x57\x30\xe8\x9e\x27\x23\x63\xa1\x68\x1e\x76\x8f\xfe\x7b\x1d\xd8\xbb\x97\x90\xc4\x8e\x6e\x3e\x1a\xe6\x2d\xe8\x0c\x1c\x7d\x6d\xa7\x72\x61\x43\x55\x40\x3e\x2d\x39\x6e\xc6\x99\x2f\x81\xf2\xa9\xa1\x7b\x1f\xa7\x44\x60\x45\x04\x67\x41\x68\x62\x3c\xab\xf6\xe6\xd8\xe5\xfd\x41\x00\x91\x6d\x89\x7c\xa6\x99\x13\xf9\xdb\x6c\xdb\xae\x85\xc7\x78\x21\x1d\x25\xdf\xe6\x4a\x7d\xc3\x3c\x0e\x61\x8d\xf0\x63\x41\x47\x6f\x06\x58\x42\x80\x00\x85\x38\x1a\xef\x68\xfa\x0c\x7a\x83\xf4\x42\xf8\xc1\x13\x78\xb4\x4b\x69\x35\x2d\x50\xd4\x6d\xde\xb3\xe9\x05\x3e\x7d\x55\x5d\x7f\x55\x5e\x15\x3b\xa0\x89\x72\x72\xc5\x9c\x77\x29\xe8\x6c\x79\x3d\x25\x1b\xc2\x59\xe1\x0d\x10\x02\xf5\x7f\x98\x92\xae\x2a\xc9\x17\x3d\x48\xc6\x21\xb0\xc3\xc3\x01\x28\x29\x9e\x3f\x78\xe8\xec\xe5\xb9\xec\xe5\x87\x83\x80\x26\xc1\x34\x36\x5f\x59\xa0\x60\x27\x46\x48\xbe\x75\x4a\xd3\xdc\x80\x42\x6a\xf0\xc1\xc3\x98\x2b\xc2\xda\x39\x1d\x6b\xf5\xa1\x3f\xfa\x08\x30\x20\x55\x75\x1d\xc4\xdd\x8c\x59\x19\x09\xdf\xeb\xfd\xf0\x8e\x4d\x34\x4e\xcd\x0f\xd2\x4f\x23\x1d\x7c\x33\xf6\xe6\x19\x70\xdc\xb4\xc8\xe8\x68\x96\x6d\x92\x64\x96\xa6\x46\xaa\x79\x96\x62\x0d\x31\xb2\xff\xb0\xf6\x66\xd9\x9b\x86\xfc\x6d\x0f\x8e\xd5\xf6\xe6\x45\x25\x62\x93\x0e\x47\xca\xd8\xe2\x52\x56\x28\x48\x77\xb7\x95\xd1\x79\x86\x81\x4a\xd8\x27\x2f\x24\x1c\x0a\xcd\x55\xa0\x78\xd1\xb2\x62\x37\x32\x7e\xca\x7e\xe4\xa9\xf8\x00\x53\xac\x0c\xce\x37\x56\xf2\xf6\x41\x85\x00\x06\x4c\xa1\x2e\x96\xcd\x65\x4b\xfd\x61\xd8\x74\xce\x79\x8b\xa7\x7f\x74\xf0\x67\xfa\xf0\x0f\xe6\x4b\xbb\x06\x4b\x17\xdf\x39\xe5\x29\x5e\x63\x71\x7e\x4e\x70\xd4\xa7\x44\x8b\x7a\x68\x07\x9d\x61\x43\x6d\x75\x93\xb3\x85\xbe\xe0\x61\x6a\xdd\x18\x29\xce\x7a\x5f\xaa\x0e\xc4\x28\x2b\x50\xe9\x50\x05\xe9\x10\x16\xdb\xf0\x39\x89\x4c\x19\x0a\x25\x07\xd5\xce\x0c\x53\x33\xd0\x1f\x52\x11\x9c\xf9\xc3\xd5\x26\x7c\x75\x7e\xc6\x41\x9f\x26\x86\x55\x17\x2c\x32\x31\xac\xae\x48\xec\x36\x0b\x5a\x8a\x86\x0a\x71\x95\x9c\x6e\xca\x21\x23\x75\xd8\xf8\xd6\x44\xe4\x4d\xc6\x66\x8d\xbe\x86\x2f\x51\xd3\x3e\x20\x7f\x21\x1f\x25\x92\xbd\x4e\x7d\xf5\x76\xc1\x5f\xd8\x33\x7c\xc3\xf9\xa9\xee\x47\xa1\xb6\xf8\xb5\xc4\xa7\x7e\x0d\xb5\x63\x6f\x55\x45\x06\xbb\x2c\x43\xd3\x0f\xd4\x53\x90\xa1\x9b\x10\x1d\x45\x1a\x9a\x04\xc1\x5c\x4e\x1b\x49\xe5\x69\x5c\x5d\xa8\xc1\xa9\xd6\xe0\xcc\x9b\x60\xf6\x9d\x38\x7c\xdc\x64\x2c\xc3\xc7\x49\x55\xaf\xd5\xb0\x21\xeb\x69\x00\xc2\x08\x21\xb0\xac\x7f\x80\x0f\xe1\x4f\x55\x1e\x40\x32\x8e\x74\xa1\x80\xaf\x9c\xdb\x88\x1d\x44\xb4\x6d\x3c\x41\xb5\x0e\x5b\x33\x8d\x74\x1f\x07\xb6\xb1\x6f\x9c\xaa\x6a\x02\x80\xc4\x48\xbb\xcd\x79\x06\xa1\xcf\x7b\xca\x78\xcc\x99\xa4\x8e\xe6\x39\x0a\xf4\xf1\x35\x3b\x8d\xf1\x85\x3d\xff\xae\xe2\x19\xb3\x40\x7d\x24\x48\xaf\x36\x05\xde\xb6\xea\xd2\xf6\xf1\xa2\xf5\x69\x2f\xe4\x19\x02\x6a\xeb\xb2\x47\x15\xf9\x38\xd5\x41\x00\xe2\x98\x6e\xa8\xe7\xca\xc3\x85\x57\x54\x96\x13\xca\x8a\x83\xfa\x29\xba\xd5\xe3\x6c\xc1\x49\x38\x6c\x22\x53\x01\xa3\x55\xa2\x6d\x3e\xae\x34\xd3\xb2\x84\x87\x6c\xe6\x0c\xe3\xb5\xc3\xd2\x0b\xcf\x55\xe2\xe5\xbf\xff\x0d\xc8\x4d\xf0\x6a\xcc\x7c\xfe\x76\xe2\x9f\x07\xa1\xc0\x08\xdc\x03\xcd\x39\xcf\x6c\x0f\x43\xad\x44\x24\xcf\xe4\x5c\x9d\x66\x7c\x6c\xa1\xb3\x48\x7f\x4c\xea\xd9\xa2\x9e\x16\x65\xf5\xfc\x12\xe5\x43\x48\x86\x78\xfe\x56\x50\x43\x36\xbb\x2e\x14\xf7\x34\xf2\xbf\x24\x26\xc2\xbb\x31\xcf\xf5\x75\x60\xac\xa6\x18\x8a\x2f\xc5\xce\x42\x35\x0f\x7b\x5c\x2c\x57\x2a\x3b\x55\xb5\x3c\x05\x7c\x3e\x72\x0a\xf3\xb5\x4b\x31\xdb\x8b\x81\xbb\x8c\xbf\x94\x3d\xe7\x10\x05\xbe\x05\xf4\x1c\xed\x56\xf9\x52\xed\x46\x12\xcb\xb0\x66\x17\x97\xc4\xec\x96\x47\x4e\xa9\x28\x96\x25\xca\x2f\x5d\xb2\x31\x32\x3e\x36\xe0\x8b\xea\x9c\x33\xdb\x6c\x6a\x92\xf4\x69\x6a\xd5\x29\x85\xc7\xf0\x7e\x5c\x16\xd6\xbd\x9e\x60\x05\x36\x15\xac\x80\x16\x97\xa9\x10\x70\x10\x1e\x82\x7a\xe3\x07\xe1\x71\x52\xfb\x6d\xae\x73\x6e\x14\x2f\xb0\x14\xe5\x11\x22\x32\x2f\x32\x52\x97\xa8\x38\x65\xd5\xc5\x66\xa9\xdd\xcc\xb8\xca\xb4\x0e\xe5\xaa\x4c\xe6\x50\x8f\x54\xa0\x33\x69\x49\xe7\x2a\x5b\xd6\x32\xcb\xa8\x2b\xbc\x78\x13\x15\x39\x5b\xc8\xf9\xfc\xd1\xaf\x73\x73\x3f\x93\x8e\x96\x89\x49\x47\x05\xc9\xe9\x1a\x19\xd1\xa7\x5a\x0b\x5b\xd2\xe6\x8c\x9a\xd2\xb7\xa5\x78\xda\x37\xf5\x0e\x12\xfc\x09\x6e\x6a\x98\x0d\xcd\x93\x92\x21\x06\xea\xfb\x64\xe7\x81\x4b\x6a\x76\x4e\xf5\xd3\xf8\xd5\xd1\xeb\xa3\x13\x54\x2b\xdf\xbe\x7c\x79\x7c\x78\xd2\x24\xb3\xd0\xd8\x94\xf4\xa2\x61\xf3\x47\x39\x06\x21\xa4\xe7\x09\x97\xc2\x1d\xd1\xb7\x91\x22\xb2\x8e\x51\x21\x16\x97\xa2\xe2\x61\x33\x55\x31\x90\xa2\x0b\x29\x4f\x67\x07\x55\xd1\x23\xe0\x18\xef\x67\x57\xf7\xb4\x29\x42\xa2\xc5\x26\x05\x65\x43\x8b\xa7\x8a\xc8\x6e\xd3\xa2\x2f\x80\xec\x1f\x8b\xa2\x4e\x8e\xc6\x9c\x1f\x5c\xa8\x1a\x37\x12\x40\x92\xe2\x97\xe3\x69\xfa\x79\x17\x0f\x76\xd1\x05\x24\x3b\x9c\xc0\x73\x55\xbb\x91\x61\x5a\x65\x91\xd4\x68\x7e\xa5\x4d\xb1\xad\xcf\x6d\x82\xe6\x47\xfd\x08\x0b\x3c\x7d\x39\x91\x9c\x54\x98\xb2\xa8\x8b\x63\x2c\xcc\x5d\xea\x72\x31\x58\xf4\x6f\xe1\xde\x45\xce\xde\xbf\x7b\x41\x66\x61\x78\xae\x73\xc0\xf1\xc4\xeb\xb1\xa4\xe8\x4f\x00\x2b\x3f\x1a\x8d\x60\x7f\x13\xee\x4d\x51\xbf\xa4\xc5\x8d\x8b\x71\xa2\x0a\xe8\xea\x94\x8f\x09\xbe\xb2\xbf\x1a\x08\x03\xfb\x56\x42\xad\x5a\xdb\xa6\x26\xc6\xe7\x32\x26\x7c\x1c\x51\xfb\xa1\x87\x0a\x31\xac\xf0\x1b\xac\xfd\x56\x29\xe5\x4d\x6b\x59\xed\xf6\xa8\x2d\xb7\xdb\x4b\x36\xb5\xb9\x4f\x20\x42\xcc\x39\x3e\x4e\xa0\x0a\xd5\x7c\xe8\xa0\x97\x37\x8d\x1a\x5b\x92\xd8\xe4\xa6\xf0\x3b\xe2\x91\x8a\x46\x8f\x75\xca\xb2\xf5\x82\x32\x23\x50\x78\x9a\xc2\x30\x0d\xa9\x4a\x12\xda\x3c\x0c\x1a\x00\xa4\xf8\x47\xe1\x3e\xd6\xb2\x05\x20\x18\xe9\x42\xe0\xc8\xea\x33\xaf\xfd\x07\x85\x3b\x8b\xc2\xc4\x4a\xce\xe7\xd9\xa5\xf6\xea\x53\xb4\x44\xc5\x7d\xe9\x9c\xe2\xe8\x81\x49\x8b\x2d\x1a\xa5\x9e\x6e\xf8\x42\x2e\x27\x36\x53\x51\x6b\x15\x5a\x69\x0d\x69\x12\x77\x1b\x07\x94\xf9\x12\x5a\xd1\x73\x00\x71\x65\x64\xed\x82\xe5\x2f\x3d\x82\x62\x23\xde\x4d\xeb\x37\x79\x29\xac\xdf\xc6\x75\x61\x3e\x0f\x09\x78\xec\xb7\x3f\xef\x8c\x19\xc2\xc5\x9e\x57\x74\x7c\xcc\xb9\xf1\xd5\x5b\xf5\x89\x5b\xb4\x6f\x59\x93\xd6\x06\x2f\xb6\xb4\x97\x70\xd3\x8f\x2a\xcb\x40\x48\x65\xd7\x3b\x09\xd3\xf9\x6d\xcd\x7e\x6a\xb5\xbf\xae\xc9\x0d\xf5\xe3\x08\x44\x71\xc6\xe9\xf1\x37\x12\x35\xa6\x86\xf8\x9a\x34\x28\x68\x7d\x2d\x94\x56\xfe\xf8\xbf\x8a\xf8\xa5\xef\x5e\x4a\xf3\x7d\xe7\x2b\xb7\xca\xfd\x4f\x2d\x74\x47\xad\x75\xff\x7f\x59\x34\xc2\x05\x5f\x31\xc6\x6d\xef\xa4\x05\xc6\xca\xdb\xc7\x2d\x71\xa9\x16\x4c\xd0\x1e\x52\x34\xe0\x50\x02\xea\x00\x82\xc1\x4d\xe2\x61\x6c\xa9\xd9\x99\xc6\xae\x2d\xd2\x66\xcc\x46\x85\x77\xcc\xa0\x2f\x3c\x2b\x4a\x86\xb1\x94\x7a\x15\x22\xd6\x2d\xa5\xd1\x8e\xc6\x91\xa8\x27\x95\x4d\x39\x76\xbe\x72\x1e\x70\x2f\xdf\x2d\x59\xee\xd8\x77\xf4\x17\xd0\x99\xd4\xf8\x8d\x5c\x31\x5d\xfc\x12\x55\xef\x40\x87\x4b\x97\xbe\x1c\x6c\xe6\xcb\x9f\xb8\x20\x0c\xf3\x21\xf4\x22\xb3\xbf\x82\xa3\x16\x1e\x7f\x37\xd7\x71\x7d\xe0\xa8\x02\x50\xe1\x56\x69\x4e\x90\x74\x53\x76\x85\x0e\xe0\x12\x97\x22\xe4\x47\x4f\xe4\x56\x89\xd8\xe0\x1d\x54\x37\x90\xbb\x66\xde\x02\x85\x95\xcf\x18\xdc\x69\xba\xf7\x71\xe8\x7d\xef\x7d\x0f\xd0\x72\x1b\x1a\x83\xcb\xe9\xc2\xc9\xbb\x5f\x3d\xe6\x41\x54\x39\x58\xb9\x8f\x32\x39\xf6\x99\xe0\xa7\x7b\x70\x6b\x7d\x68\x51\xc6\x50\xe2\xa1\xa7\x87\x55\xfa\x9e\x22\x57\x83\xe3\x3b\x69\x60\x10\x57\x48\x3b\x24\xe8\xc6\x02\xdd\xe7\xb6\x6b\xe6\x7d\x42\x96\x49\x5f\x0d\x0a\x24\xdd\x79\x8c\x36\xb6\x1d\x0f\xff\x79\xf4\x24\xa0\x6e\xf0\xec\x26\x74\xdd\x8d\x6d\x58\x02\x7e\x3e\xde\xe9\x1d\x4f\xef\xab\x9e\x21\x3d\x3d\xa6\x5b\xb5\x90\x9e\xac\x59\x9a\x7d\xd3\x4d\x72\x7f\x75\x1c\xd4\x29\xb2\xfa\x83\x94\xe3\xd0\xda\xaa\x81\x95\x6a\xbc\x4e\x75\xf5\x5b\x4f\xf8\x1e\x2a\x2e\x74\x4e\xb9\xbb\xb2\xc2\x46\x73\xee\x28\x91\xfe\x6d\xd3\xbe\xa3\xfa\x08\xfd\xf3\xed\x2a\x85\x70\xc3\x94\x37\xaf\x4c\xf0\x8d\x04\xb8\xdb\x52\x05\xfd\x74\x68\xa7\xd3\x6f\xba\xf0\x77\x3c\xf1\x3b\x2a\x2c\x70\xe3\xca\x6f\x34\xe9\x86\x7a\x22\x55\x03\xc9\x70\x62\x55\xe1\x9e\xcd\x8a\xbc\xf9\xa5\x22\x4e\x04\xc5\x7a\xf1\x3a\x19\x08\x2e\xe1\x4c\x1c\xbb\x00\xeb\x63\xbb\x1a\x21\x7d\x82\xfb\x6b\xf6\x98\x8b\xd3\x85\x6a\x1c\x9d\xc1\x2e\x1a\x4b\x03\x0d\x3b\xc3\xc7\x82\x06\x3a\x8c\x0d\x46\x97\xc4\x47\x2a\x1e\x93\x6f\x8b\x34\x2c\x76\x73\xc1\xf5\x42\x7b\x46\x78\x0c\xab\x9d\x09\x76\x27\xe5\x07\x2e\xc0\x87\x78\x95\x4e\xde\x27\x67\xc9\x52\x91\xa1\xa4\x1f\xa0\x70\x91\x93\x8b\x2f\xdb\x64\x3b\x82\x45\x8c\x29\x4d\x89\x52\x1b\x18\x12\x67\xf2\xb7\x40\xed\x33\x94\x79\xf8\x1a\xee\xee\x70\x20\xcd\xd3\x2c\xf1\x3f\xfb\xa7\xff\xf7\xf7\xdf\x3f\xfa\xa7\xf0\x9f\xab\x1f\xae\x83\x9d\xe0\xf7\xdf\x07\x9f\x83\x8d\xaa\x3b\xd2\x9a\x58\x53\x52\xec\x58\x55\xde\x8e\xf5\x58\x8a\x3e\x56\x65\xdc\x93\x56\x36\x5a\x4c\x94\x39\x0e\x1a\x69\xd3\x38\xd7\x62\x75\x13\xca\xec\x7a\x09\x69\xce\xe5\xe8\xac\xa1\x06\x72\x19\xc4\x6b\xcb\x94\xfd\x3c\x48\x0d\xa1\x1b\x07\x73\xc7\xd5\x39\x17\x1d\x2c\xb1\x58\x06\x5b\xc9\x1b\x24\x53\x47\xf8\xb3\x2c\x63\xe0\xaa\x5c\x22\x60\x0a\xac\xfc\xf9\xbf\x9e\x0c\x90\x56\xd4\x7d\xbf\x75\xee\x53\x45\xdd\xcf\xbf\xff\xfe\x19\xff\xfb\x99\x4e\x7b\x46\x89\xbf\x1c\xe0\x8d\x4a\xe4\x3a\xab\xf7\xe9\x93\x3d\x54\xb1\xe0\xaf\xe0\xd1\x93\x8f\xdc\x76\x14\xa5\x19\xca\x47\x0a\x2d\x2b\xf2\x44\xc7\xd3\x60\x2b\xe3\xa4\xdc\xa9\xd0\xae\x6b\x51\x40\xfb\xce\xae\xae\x83\xed\x76\xf5\x5a\x8e\xfe\x07\xed\x8e\x44\x0a\x92\x02\xc3\x35\x91\x14\x31\x7f\x85\xa2\x3a\x47\xe2\xbe\xa7\x87\xbe\x9a\x99\xf3\x04\xcd\x06\xc4\xde\xe6\xd3\x15\x65\x88\xaf\xbb\xbd\x5e\x68\xc3\x7a\x47\x41\x95\xfe\x20\x59\xa6\x68\x5a\xff\x6e\xcf\xfb\xd3\xf9\xef\xf9\x40\xaa\xab\x34\xcb\x4e\x6f\x77\xcc\x8a\x06\x0c\xba\x6c\x5a\xb4\x0f\x1b\xdc\xda\xb3\xd3\x6f\xe0\x57\x87\x5d\xa9\x1f\x7e\x56\xc2\x86\xd3\xaa\x75\xdf\x11\xba\x50\xd9\xc1\x4a\xd6\x47\x1a\x2a\xb6\x57\x9c\xb3\x77\xe7\xf3\xe0\x73\x87\xb6\xd8\xfa\x2d\xdc\x03\x8c\x24\x4c\x34\xc4\x9e\xf8\x60\xf0\x59\xa9\x90\xf0\x80\x74\x54\xe5\xab\xbe\x6a\x85\x24\x9d\xa3\x3b\x79\x40\xea\xe6\xf5\xc0\xf6\xe5\x74\x09\x2b\x47\x04\x6a\x99\x25\xd2\xca\x79\xb9\xbd\xfd\xff\x00\xe4\xb9\x31\x36\xfb\xb6\x00\x00"
 
 func xo_dbGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -698,7 +1643,7 @@ func xo_dbGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _xo_packageGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\x41\x4e\x03\x31\x0c\x05\xd0\x35\x3e\xc5\xd7\x6c\x0a\x9b\xe4\x10\x94\x05\x1b\x8a\x44\x2f\xe0\x26\x6e\x1a\xc1\x24\x83\x6d\x55\xad\x46\x73\x77\x34\x45\xdd\x20\x56\xff\x7d\x5b\xfa\x31\xe2\x9d\xd3\x27\x17\xc1\x3c\x23\xdc\xbd\x2c\x48\xbd\x39\xd7\x66\xf0\x93\xc0\xaf\x93\x18\x8e\x5d\x61\xe9\x24\x23\x63\x33\xcf\x77\x86\x8f\xdf\x5c\x96\x4d\xa0\xe9\xdf\x31\xa2\x18\xf1\xdc\xb3\xa0\x48\x13\x65\x97\x8c\xc3\x15\x97\x1e\xb0\xdd\xe1\x6d\xb7\xc7\xcb\xf6\x75\x1f\x88\xea\x38\x75\x75\x3c\xd2\xc3\x90\xd9\xf9\xc0\x26\xd1\xbe\xbf\x86\x3f\x3d\x66\xad\x67\xd1\xf5\x2c\x2d\xf5\x5c\x5b\x89\xc9\xce\xb7\xae\xda\xd5\x56\x1d\x47\x5f\x43\xa5\xc8\x65\x5a\x65\xae\xb5\x95\xdb\xcf\xeb\x28\x03\x3d\x11\xfd\x04\x00\x00\xff\xff\x7d\x20\x74\xc2\x00\x01\x00\x00"
+var _xo_packageGoTpl = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9d\x53\xbd\x72\xdb\x30\x0c\x9e\xad\xa7\xc0\x69\x49\x3b\x94\x9c\xfa\x04\x4d\x87\x2c\x4d\xef\x9a\xbd\x47\x53\x30\xc5\x54\x24\x54\x10\x76\xe5\xf3\xf9\xdd\x0b\x4a\x76\x7b\x4d\x14\x0f\x9d\xf0\x01\x1f\xfe\x09\x5a\x0b\x5f\x9d\xff\xe1\x02\xc2\xe9\x04\xe6\x8a\xcf\x67\xf0\x94\xc5\xc5\x5c\x40\x7a\x04\x39\x8e\x58\x60\x47\x0c\xc5\xf7\x98\x1c\xdc\xa9\xf7\x05\x9a\x6f\x8b\x3c\x9f\xef\x4c\x33\xae\x26\x6b\x1a\x6b\xe1\x13\x75\x08\x01\x33\xb2\x13\xec\x60\x7b\x84\x89\x0c\xdc\x3f\xc2\x97\xc7\x27\xf8\x7c\xff\xf0\x64\x9a\x26\xa6\x91\x58\xe0\x5d\xb3\x69\x6b\x7d\x9c\xa4\x55\xd8\x39\x71\x5b\x57\xd0\x96\x9f\xc3\x4b\xdd\x76\x1c\x0f\xc8\xd5\x8c\xd9\x53\x17\x73\xb0\xbe\x1c\xfe\xd1\x9f\x0b\xe5\xd9\xc0\x4c\x5c\x2a\xda\xa5\x39\x71\x72\xd2\x5b\x76\xb9\xab\x0a\xe3\x6e\x40\x2f\x0b\x0c\x38\x8d\x15\x15\x61\x6d\xe4\x70\x81\x9a\x6c\x0e\x2f\xc7\xec\xaf\xd2\x3a\xa1\x14\x67\x55\x62\xc2\xb6\x39\x9d\x3e\x40\xdc\x81\xf6\x36\xcd\xa3\x6f\xda\x10\xa5\xdf\x6f\x8d\xa7\x64\x9f\x13\x45\xa6\x5c\x3b\x9f\x16\x57\xcc\x5d\x75\xbb\x44\x8d\x61\x2d\x48\x17\xe9\xad\x52\xf6\xf0\xb1\x7d\x9b\x52\xa1\xbd\xe6\xdb\x1e\xf5\x25\xd7\x0a\x93\xe0\x70\xad\x4c\x86\x46\xd4\xed\x0f\x98\x50\xf8\x68\x22\xd9\x4a\xb7\x37\x38\xdd\x82\xae\x67\xbb\x17\xbc\xe9\x25\xec\xfc\x6a\xf9\xea\x16\x7d\x79\x3d\xfb\xc8\xa4\x5c\x8f\xfb\x62\xfd\x10\x35\xe7\xf7\x40\x83\xd3\x47\xfd\x4b\xac\xee\x91\x49\xa8\x20\xeb\x69\xfc\x99\x8a\xc2\x80\x66\x89\x36\xc4\xc1\x06\x1e\xbd\xd5\x13\xc1\xd2\xbe\xcd\x17\x71\xb2\x52\xe2\x97\x76\x08\xe6\x61\x3e\xd7\xb2\x18\x41\x0f\x49\xaf\xdd\x54\x6d\xd3\xd6\x0f\xa0\xe8\x55\xe0\xcb\x1c\x79\x3f\x0c\xf1\xbf\xd3\xbc\x6f\x9a\xdf\x8b\x17\x2c\xc5\xc2\x03\x00\x00"
 
 func xo_packageGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -772,33 +1717,78 @@ func AssetNames() []string {
 var _bindata = map[string]func() (*asset, error){
 	"mssql.foreignkey.go.tpl": mssqlForeignkeyGoTpl,
 	"mssql.index.go.tpl": mssqlIndexGoTpl,
+	"mssql.manytomany.go.tpl": mssqlManytomanyGoTpl,
+	"mssql.map.go.tpl": mssqlMapGoTpl,
+	"mssql.mock.go.tpl": mssqlMockGoTpl,
+	"mssql.optional.go.tpl": mssqlOptionalGoTpl,
+	"mssql.prototest.go.tpl": mssqlPrototestGoTpl,
 	"mssql.query.go.tpl": mssqlQueryGoTpl,
 	"mssql.querytype.go.tpl": mssqlQuerytypeGoTpl,
+	"mssql.repository.go.tpl": mssqlRepositoryGoTpl,
+	"mssql.server.go.tpl": mssqlServerGoTpl,
 	"mssql.type.go.tpl": mssqlTypeGoTpl,
+	"mssql.type.proto.tpl": mssqlTypeProtoTpl,
+	"mssql.where.go.tpl": mssqlWhereGoTpl,
 	"mysql.enum.go.tpl": mysqlEnumGoTpl,
 	"mysql.foreignkey.go.tpl": mysqlForeignkeyGoTpl,
 	"mysql.index.go.tpl": mysqlIndexGoTpl,
+	"mysql.manytomany.go.tpl": mysqlManytomanyGoTpl,
+	"mysql.map.go.tpl": mysqlMapGoTpl,
+	"mysql.mock.go.tpl": mysqlMockGoTpl,
+	"mysql.optional.go.tpl": mysqlOptionalGoTpl,
 	"mysql.proc.go.tpl": mysqlProcGoTpl,
+	"mysql.prototest.go.tpl": mysqlPrototestGoTpl,
 	"mysql.query.go.tpl": mysqlQueryGoTpl,
 	"mysql.querytype.go.tpl": mysqlQuerytypeGoTpl,
+	"mysql.repository.go.tpl": mysqlRepositoryGoTpl,
+	"mysql.server.go.tpl": mysqlServerGoTpl,
 	"mysql.type.go.tpl": mysqlTypeGoTpl,
+	"mysql.type.proto.tpl": mysqlTypeProtoTpl,
+	"mysql.where.go.tpl": mysqlWhereGoTpl,
 	"oracle.foreignkey.go.tpl": oracleForeignkeyGoTpl,
 	"oracle.index.go.tpl": oracleIndexGoTpl,
+	"oracle.manytomany.go.tpl": oracleManytomanyGoTpl,
+	"oracle.map.go.tpl": oracleMapGoTpl,
+	"oracle.mock.go.tpl": oracleMockGoTpl,
+	"oracle.optional.go.tpl": oracleOptionalGoTpl,
+	"oracle.prototest.go.tpl": oraclePrototestGoTpl,
 	"oracle.query.go.tpl": oracleQueryGoTpl,
 	"oracle.querytype.go.tpl": oracleQuerytypeGoTpl,
+	"oracle.repository.go.tpl": oracleRepositoryGoTpl,
+	"oracle.server.go.tpl": oracleServerGoTpl,
 	"oracle.type.go.tpl": oracleTypeGoTpl,
+	"oracle.type.proto.tpl": oracleTypeProtoTpl,
+	"oracle.where.go.tpl": oracleWhereGoTpl,
 	"postgres.enum.go.tpl": postgresEnumGoTpl,
 	"postgres.foreignkey.go.tpl": postgresForeignkeyGoTpl,
 	"postgres.index.go.tpl": postgresIndexGoTpl,
+	"postgres.manytomany.go.tpl": postgresManytomanyGoTpl,
+	"postgres.map.go.tpl": postgresMapGoTpl,
+	"postgres.mock.go.tpl": postgresMockGoTpl,
+	"postgres.optional.go.tpl": postgresOptionalGoTpl,
 	"postgres.proc.go.tpl": postgresProcGoTpl,
+	"postgres.prototest.go.tpl": postgresPrototestGoTpl,
 	"postgres.query.go.tpl": postgresQueryGoTpl,
 	"postgres.querytype.go.tpl": postgresQuerytypeGoTpl,
+	"postgres.repository.go.tpl": postgresRepositoryGoTpl,
+	"postgres.server.go.tpl": postgresServerGoTpl,
 	"postgres.type.go.tpl": postgresTypeGoTpl,
+	"postgres.type.proto.tpl": postgresTypeProtoTpl,
+	"postgres.where.go.tpl": postgresWhereGoTpl,
 	"sqlite3.foreignkey.go.tpl": sqlite3ForeignkeyGoTpl,
 	"sqlite3.index.go.tpl": sqlite3IndexGoTpl,
+	"sqlite3.manytomany.go.tpl": sqlite3ManytomanyGoTpl,
+	"sqlite3.map.go.tpl": sqlite3MapGoTpl,
+	"sqlite3.mock.go.tpl": sqlite3MockGoTpl,
+	"sqlite3.optional.go.tpl": sqlite3OptionalGoTpl,
+	"sqlite3.prototest.go.tpl": sqlite3PrototestGoTpl,
 	"sqlite3.query.go.tpl": sqlite3QueryGoTpl,
 	"sqlite3.querytype.go.tpl": sqlite3QuerytypeGoTpl,
+	"sqlite3.repository.go.tpl": sqlite3RepositoryGoTpl,
+	"sqlite3.server.go.tpl": sqlite3ServerGoTpl,
 	"sqlite3.type.go.tpl": sqlite3TypeGoTpl,
+	"sqlite3.type.proto.tpl": sqlite3TypeProtoTpl,
+	"sqlite3.where.go.tpl": sqlite3WhereGoTpl,
 	"xo_db.go.tpl": xo_dbGoTpl,
 	"xo_package.go.tpl": xo_packageGoTpl,
 }
@@ -845,33 +1835,78 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"mssql.foreignkey.go.tpl": &bintree{mssqlForeignkeyGoTpl, map[string]*bintree{}},
 	"mssql.index.go.tpl": &bintree{mssqlIndexGoTpl, map[string]*bintree{}},
+	"mssql.manytomany.go.tpl": &bintree{mssqlManytomanyGoTpl, map[string]*bintree{}},
+	"mssql.map.go.tpl": &bintree{mssqlMapGoTpl, map[string]*bintree{}},
+	"mssql.mock.go.tpl": &bintree{mssqlMockGoTpl, map[string]*bintree{}},
+	"mssql.optional.go.tpl": &bintree{mssqlOptionalGoTpl, map[string]*bintree{}},
+	"mssql.prototest.go.tpl": &bintree{mssqlPrototestGoTpl, map[string]*bintree{}},
 	"mssql.query.go.tpl": &bintree{mssqlQueryGoTpl, map[string]*bintree{}},
 	"mssql.querytype.go.tpl": &bintree{mssqlQuerytypeGoTpl, map[string]*bintree{}},
+	"mssql.repository.go.tpl": &bintree{mssqlRepositoryGoTpl, map[string]*bintree{}},
+	"mssql.server.go.tpl": &bintree{mssqlServerGoTpl, map[string]*bintree{}},
 	"mssql.type.go.tpl": &bintree{mssqlTypeGoTpl, map[string]*bintree{}},
+	"mssql.type.proto.tpl": &bintree{mssqlTypeProtoTpl, map[string]*bintree{}},
+	"mssql.where.go.tpl": &bintree{mssqlWhereGoTpl, map[string]*bintree{}},
 	"mysql.enum.go.tpl": &bintree{mysqlEnumGoTpl, map[string]*bintree{}},
 	"mysql.foreignkey.go.tpl": &bintree{mysqlForeignkeyGoTpl, map[string]*bintree{}},
 	"mysql.index.go.tpl": &bintree{mysqlIndexGoTpl, map[string]*bintree{}},
+	"mysql.manytomany.go.tpl": &bintree{mysqlManytomanyGoTpl, map[string]*bintree{}},
+	"mysql.map.go.tpl": &bintree{mysqlMapGoTpl, map[string]*bintree{}},
+	"mysql.mock.go.tpl": &bintree{mysqlMockGoTpl, map[string]*bintree{}},
+	"mysql.optional.go.tpl": &bintree{mysqlOptionalGoTpl, map[string]*bintree{}},
 	"mysql.proc.go.tpl": &bintree{mysqlProcGoTpl, map[string]*bintree{}},
+	"mysql.prototest.go.tpl": &bintree{mysqlPrototestGoTpl, map[string]*bintree{}},
 	"mysql.query.go.tpl": &bintree{mysqlQueryGoTpl, map[string]*bintree{}},
 	"mysql.querytype.go.tpl": &bintree{mysqlQuerytypeGoTpl, map[string]*bintree{}},
+	"mysql.repository.go.tpl": &bintree{mysqlRepositoryGoTpl, map[string]*bintree{}},
+	"mysql.server.go.tpl": &bintree{mysqlServerGoTpl, map[string]*bintree{}},
 	"mysql.type.go.tpl": &bintree{mysqlTypeGoTpl, map[string]*bintree{}},
+	"mysql.type.proto.tpl": &bintree{mysqlTypeProtoTpl, map[string]*bintree{}},
+	"mysql.where.go.tpl": &bintree{mysqlWhereGoTpl, map[string]*bintree{}},
 	"oracle.foreignkey.go.tpl": &bintree{oracleForeignkeyGoTpl, map[string]*bintree{}},
 	"oracle.index.go.tpl": &bintree{oracleIndexGoTpl, map[string]*bintree{}},
+	"oracle.manytomany.go.tpl": &bintree{oracleManytomanyGoTpl, map[string]*bintree{}},
+	"oracle.map.go.tpl": &bintree{oracleMapGoTpl, map[string]*bintree{}},
+	"oracle.mock.go.tpl": &bintree{oracleMockGoTpl, map[string]*bintree{}},
+	"oracle.optional.go.tpl": &bintree{oracleOptionalGoTpl, map[string]*bintree{}},
+	"oracle.prototest.go.tpl": &bintree{oraclePrototestGoTpl, map[string]*bintree{}},
 	"oracle.query.go.tpl": &bintree{oracleQueryGoTpl, map[string]*bintree{}},
 	"oracle.querytype.go.tpl": &bintree{oracleQuerytypeGoTpl, map[string]*bintree{}},
+	"oracle.repository.go.tpl": &bintree{oracleRepositoryGoTpl, map[string]*bintree{}},
+	"oracle.server.go.tpl": &bintree{oracleServerGoTpl, map[string]*bintree{}},
 	"oracle.type.go.tpl": &bintree{oracleTypeGoTpl, map[string]*bintree{}},
+	"oracle.type.proto.tpl": &bintree{oracleTypeProtoTpl, map[string]*bintree{}},
+	"oracle.where.go.tpl": &bintree{oracleWhereGoTpl, map[string]*bintree{}},
 	"postgres.enum.go.tpl": &bintree{postgresEnumGoTpl, map[string]*bintree{}},
 	"postgres.foreignkey.go.tpl": &bintree{postgresForeignkeyGoTpl, map[string]*bintree{}},
 	"postgres.index.go.tpl": &bintree{postgresIndexGoTpl, map[string]*bintree{}},
+	"postgres.manytomany.go.tpl": &bintree{postgresManytomanyGoTpl, map[string]*bintree{}},
+	"postgres.map.go.tpl": &bintree{postgresMapGoTpl, map[string]*bintree{}},
+	"postgres.mock.go.tpl": &bintree{postgresMockGoTpl, map[string]*bintree{}},
+	"postgres.optional.go.tpl": &bintree{postgresOptionalGoTpl, map[string]*bintree{}},
 	"postgres.proc.go.tpl": &bintree{postgresProcGoTpl, map[string]*bintree{}},
+	"postgres.prototest.go.tpl": &bintree{postgresPrototestGoTpl, map[string]*bintree{}},
 	"postgres.query.go.tpl": &bintree{postgresQueryGoTpl, map[string]*bintree{}},
 	"postgres.querytype.go.tpl": &bintree{postgresQuerytypeGoTpl, map[string]*bintree{}},
+	"postgres.repository.go.tpl": &bintree{postgresRepositoryGoTpl, map[string]*bintree{}},
+	"postgres.server.go.tpl": &bintree{postgresServerGoTpl, map[string]*bintree{}},
 	"postgres.type.go.tpl": &bintree{postgresTypeGoTpl, map[string]*bintree{}},
+	"postgres.type.proto.tpl": &bintree{postgresTypeProtoTpl, map[string]*bintree{}},
+	"postgres.where.go.tpl": &bintree{postgresWhereGoTpl, map[string]*bintree{}},
 	"sqlite3.foreignkey.go.tpl": &bintree{sqlite3ForeignkeyGoTpl, map[string]*bintree{}},
 	"sqlite3.index.go.tpl": &bintree{sqlite3IndexGoTpl, map[string]*bintree{}},
+	"sqlite3.manytomany.go.tpl": &bintree{sqlite3ManytomanyGoTpl, map[string]*bintree{}},
+	"sqlite3.map.go.tpl": &bintree{sqlite3MapGoTpl, map[string]*bintree{}},
+	"sqlite3.mock.go.tpl": &bintree{sqlite3MockGoTpl, map[string]*bintree{}},
+	"sqlite3.optional.go.tpl": &bintree{sqlite3OptionalGoTpl, map[string]*bintree{}},
+	"sqlite3.prototest.go.tpl": &bintree{sqlite3PrototestGoTpl, map[string]*bintree{}},
 	"sqlite3.query.go.tpl": &bintree{sqlite3QueryGoTpl, map[string]*bintree{}},
 	"sqlite3.querytype.go.tpl": &bintree{sqlite3QuerytypeGoTpl, map[string]*bintree{}},
+	"sqlite3.repository.go.tpl": &bintree{sqlite3RepositoryGoTpl, map[string]*bintree{}},
+	"sqlite3.server.go.tpl": &bintree{sqlite3ServerGoTpl, map[string]*bintree{}},
 	"sqlite3.type.go.tpl": &bintree{sqlite3TypeGoTpl, map[string]*bintree{}},
+	"sqlite3.type.proto.tpl": &bintree{sqlite3TypeProtoTpl, map[string]*bintree{}},
+	"sqlite3.where.go.tpl": &bintree{sqlite3WhereGoTpl, map[string]*bintree{}},
 	"xo_db.go.tpl": &bintree{xo_dbGoTpl, map[string]*bintree{}},
 	"xo_package.go.tpl": &bintree{xo_packageGoTpl, map[string]*bintree{}},
 }}